@@ -70,8 +70,7 @@ func main() {
 				logsPath,
 				sessionID,
 				logging.BridgeShortAppName,
-				logging.DefaultMaxLogFileSize,
-				logging.DefaultPruningSize,
+				logging.DefaultRotationOptions(),
 				"",
 			)
 			if err != nil {
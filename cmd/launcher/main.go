@@ -71,13 +71,29 @@ func main() { //nolint:funlen
 	crashHandler := crash.NewHandler(reporter.ReportException)
 	defer async.HandlePanic(crashHandler)
 
-	locationsProvider, err := locations.NewDefaultProvider(filepath.Join(constants.VendorName, constants.ConfigName))
+	// Make sure bridge uses the same portable directory, even when started from the updates directory.
+	if portableDir, ok := locations.LookupPortableDir(); ok {
+		if err := os.Setenv(locations.PortableDirEnvVar, portableDir); err != nil {
+			l.WithError(err).Fatal("Failed to set portable directory")
+		}
+	}
+
+	locationsProvider, err := locations.NewProvider(filepath.Join(constants.VendorName, constants.ConfigName))
 	if err != nil {
 		l.WithError(err).Fatal("Failed to get locations provider")
 	}
 
 	locations := locations.New(locationsProvider, constants.ConfigName)
 
+	// The launcher can't read the vault; bridge leaves a marker next to it when reporting is disabled.
+	if settingsPath, err := locations.ProvideSettingsPath(); err != nil {
+		l.WithError(err).Warn("Failed to get settings path, crash reporting stays disabled")
+	} else if !sentry.IsDisabledByMarker(settingsPath) && os.Getenv(sentry.EnvDisableReporting) == "" {
+		if err := sentry.SetEnabled(true); err != nil {
+			l.WithError(err).Error("Failed to enable crash reporting")
+		}
+	}
+
 	logsPath, err := locations.ProvideLogsPath()
 	if err != nil {
 		l.WithError(err).Fatal("Failed to get logs path")
@@ -91,8 +107,11 @@ func main() { //nolint:funlen
 		logsPath,
 		sessionID,
 		logging.LauncherShortAppName,
-		logging.DefaultMaxLogFileSize,
-		logging.NoPruning,
+		logging.RotationOptions{
+			MaxFileSize:  logging.DefaultMaxLogFileSize,
+			MaxTotalSize: logging.NoPruning,
+			Compress:     true,
+		},
 		os.Getenv("VERBOSITY"),
 	); err != nil {
 		l.WithError(err).Fatal("Failed to setup logging")
@@ -26,11 +26,13 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/config"
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/cookies"
 	"github.com/ProtonMail/proton-bridge/v3/internal/crash"
@@ -64,6 +66,8 @@ const (
 	flagLogLevel      = "log-level"
 	flagLogLevelShort = "l"
 
+	flagLogFormat = "log-format"
+
 	flagGRPC      = "grpc"
 	flagGRPCShort = "g"
 
@@ -76,6 +80,17 @@ const (
 	flagLogIMAP = "log-imap"
 	flagLogSMTP = "log-smtp"
 
+	flagConfig = "config"
+
+	flagEphemeral    = "ephemeral"
+	flagEphemeralDir = "ephemeral-dir"
+
+	flagAPIHost         = "api-host"
+	flagInsecureAPIHost = "insecure-api-host"
+
+	flagDoHProvider        = "doh-provider"
+	flagDisableDoHProvider = "disable-doh-provider"
+
 	flagEnableKeychainTest  = "enable-keychain-test"
 	flagDisableKeychainTest = "disable-keychain-test"
 
@@ -138,7 +153,12 @@ func New() *cli.App {
 		&cli.StringFlag{
 			Name:    flagLogLevel,
 			Aliases: []string{flagLogLevelShort},
-			Usage:   "Set the log level (one of panic, fatal, error, warn, info, debug)",
+			Usage:   "Set the log level (one of panic, fatal, error, warn, info, debug), optionally per subsystem (imap, smtp, sync, api, grpc), e.g. info,imap=debug",
+		},
+		&cli.StringFlag{
+			Name:    flagLogFormat,
+			Usage:   "Set the format of the log files (text or json)",
+			EnvVars: []string{"BRIDGE_LOG_FORMAT"},
 		},
 		&cli.BoolFlag{
 			Name:    flagGRPC,
@@ -163,6 +183,41 @@ func New() *cli.App {
 			Name:  flagLogSMTP,
 			Usage: "Enable logging of SMTP communications (may contain decrypted data!)",
 		},
+		&cli.StringFlag{
+			Name:    flagConfig,
+			Usage:   "Load settings and accounts from a TOML or YAML config file (for unattended use)",
+			EnvVars: []string{"BRIDGE_CONFIG"},
+		},
+		&cli.BoolFlag{
+			Name:    flagEphemeral,
+			Usage:   "Keep the message store out of persistent storage; accounts are fully synced again on each start",
+			EnvVars: []string{"BRIDGE_EPHEMERAL"},
+		},
+		&cli.StringFlag{
+			Name:    flagEphemeralDir,
+			Usage:   "Keep the message store in the given directory (e.g. a tmpfs mount), wiped on each start; implies --" + flagEphemeral,
+			EnvVars: []string{"BRIDGE_EPHEMERAL_DIR"},
+		},
+		&cli.StringFlag{
+			Name:    flagAPIHost,
+			Usage:   "Connect to the API at the given address (e.g. a mirror or a test server); certificate pinning and alternative routing are disabled",
+			EnvVars: []string{"BRIDGE_API_HOST"},
+		},
+		&cli.BoolFlag{
+			Name:    flagInsecureAPIHost,
+			Usage:   "Do not verify the certificate of the API host given with --" + flagAPIHost + " (e.g. a test server with a self-signed certificate)",
+			EnvVars: []string{"BRIDGE_INSECURE_API_HOST"},
+		},
+		&cli.StringSliceFlag{
+			Name:    flagDoHProvider,
+			Usage:   "Query the given DoH provider, before the default ones, to find proxies when alternative routing is allowed (can be repeated)",
+			EnvVars: []string{"BRIDGE_DOH_PROVIDERS"},
+		},
+		&cli.StringSliceFlag{
+			Name:    flagDisableDoHProvider,
+			Usage:   "Do not query the given default DoH provider when alternative routing is allowed (can be repeated)",
+			EnvVars: []string{"BRIDGE_DISABLED_DOH_PROVIDERS"},
+		},
 		&cli.BoolFlag{
 			Name:               flagSoftwareRenderer, // This flag is ignored by bridge, but should be passed to launcher in case of restart, so it need to be accepted by the CLI parser.
 			Usage:              "Use software rendering of the GUI for the current execution of the application",
@@ -231,6 +286,12 @@ func run(c *cli.Context) error {
 		return fmt.Errorf("could not create version: %w", err)
 	}
 
+	// Load the config file, if any, used to run bridge unattended.
+	cfg, err := loadConfigFile(c)
+	if err != nil {
+		return fmt.Errorf("could not load config file: %w", err)
+	}
+
 	// Create a user agent that will be used for all requests.
 	identifier := useragent.New()
 
@@ -271,7 +332,7 @@ func run(c *cli.Context) error {
 					}
 
 					// Initialize logging.
-					return withLogging(c, crashHandler, locations, func(closer io.Closer) error {
+					return withLogging(c, cfg, crashHandler, locations, func(closer io.Closer) error {
 						logCloser = closer
 
 						// If there was an error during migration, log it now.
@@ -307,6 +368,13 @@ func run(c *cli.Context) error {
 										}
 									}
 
+									// Apply the config file settings and accounts.
+									if cfg != nil {
+										if err := applyConfigToVault(cfg, v); err != nil {
+											logrus.WithError(err).Error("Failed to apply config file")
+										}
+									}
+
 									logrus.WithFields(logrus.Fields{
 										"lastVersion": v.GetLastVersion().String(),
 										"showAllMail": v.GetShowAllMail(),
@@ -316,12 +384,18 @@ func run(c *cli.Context) error {
 										"DoH":         v.GetProxyAllowed(),
 									}).Info("Vault loaded")
 
+									// Resolve the API address; the flag takes precedence over the settings.
+									apiHost, err := getAPIHost(c, v)
+									if err != nil {
+										return fmt.Errorf("invalid API host: %w", err)
+									}
+
 									// Load the cookies from the vault.
-									return withCookieJar(v, func(cookieJar http.CookieJar) error {
+									return withCookieJar(v, apiHost, func(cookieJar http.CookieJar) error {
 										// Create a new bridge instance.
-										return withBridge(c, exe, locations, version, identifier, crashHandler, reporter, v, cookieJar, keychains, func(b *bridge.Bridge, eventCh <-chan events.Event) error {
+										return withBridge(c, exe, locations, version, identifier, crashHandler, reporter, v, apiHost, cookieJar, keychains, func(b *bridge.Bridge, eventCh <-chan events.Event) error {
 											if insecure {
-												logrus.Warn("The vault key could not be retrieved; the vault will not be encrypted")
+												logrus.Warn("The vault key is not stored in the keychain; the vault will not be encrypted")
 												b.PushError(bridge.ErrVaultInsecure)
 											}
 
@@ -330,11 +404,20 @@ func run(c *cli.Context) error {
 												b.PushError(bridge.ErrVaultCorrupt)
 											}
 
+											// Apply the config file settings that need a running bridge.
+											if cfg != nil {
+												if err := applyConfigToBridge(c.Context, cfg, v, b); err != nil {
+													logrus.WithError(err).Error("Failed to apply config file to bridge")
+												}
+											}
+
 											// Remove old updates files
 											b.RemoveOldUpdates()
 
-											// Run the frontend.
-											return runFrontend(c, crashHandler, restarter, locations, b, eventCh, quitCh, c.Int(flagParentPID))
+											// Run the frontend, keeping systemd informed if we run as one of its services.
+											return withSystemd(crashHandler, func() error {
+												return runFrontend(c, crashHandler, restarter, locations, b, eventCh, quitCh, c.Int(flagParentPID))
+											})
 										})
 									})
 								})
@@ -381,8 +464,38 @@ func withSingleInstance(settingPath, lockFile string, version *semver.Version, f
 	return fn()
 }
 
+// getRotationOptions returns the default log rotation options, overridden by those of the config file, if any.
+func getRotationOptions(cfg *config.Config) logging.RotationOptions {
+	options := logging.DefaultRotationOptions()
+	if cfg == nil {
+		return options
+	}
+
+	if cfg.Logs.MaxFileSizeMB != nil {
+		options.MaxFileSize = *cfg.Logs.MaxFileSizeMB * 1024 * 1024
+	}
+
+	if cfg.Logs.MaxTotalSizeMB != nil {
+		if *cfg.Logs.MaxTotalSizeMB == 0 {
+			options.MaxTotalSize = logging.NoPruning
+		} else {
+			options.MaxTotalSize = *cfg.Logs.MaxTotalSizeMB * 1024 * 1024
+		}
+	}
+
+	if cfg.Logs.MaxAgeDays != nil {
+		options.MaxAge = time.Duration(*cfg.Logs.MaxAgeDays) * 24 * time.Hour
+	}
+
+	if cfg.Logs.Compress != nil {
+		options.Compress = *cfg.Logs.Compress
+	}
+
+	return options
+}
+
 // Initialize our logging system.
-func withLogging(c *cli.Context, crashHandler *crash.Handler, locations *locations.Locations, fn func(closer io.Closer) error) error {
+func withLogging(c *cli.Context, cfg *config.Config, crashHandler *crash.Handler, locations *locations.Locations, fn func(closer io.Closer) error) error {
 	logrus.Debug("Initializing logging")
 	defer logrus.Debug("Logging stopped")
 
@@ -394,6 +507,23 @@ func withLogging(c *cli.Context, crashHandler *crash.Handler, locations *locatio
 
 	logrus.WithField("path", logsPath).Debug("Received logs path")
 
+	// The command line log level and format take precedence over the config file.
+	logLevel, logFormat := c.String(flagLogLevel), c.String(flagLogFormat)
+	if cfg != nil {
+		if logLevel == "" {
+			logLevel = cfg.LogLevel
+		}
+
+		if logFormat == "" {
+			logFormat = cfg.LogFormat
+		}
+	}
+
+	format, err := logging.ParseFormat(logFormat)
+	if err != nil {
+		return fmt.Errorf("could not parse log format: %w", err)
+	}
+
 	// Initialize logging.
 	sessionID := logging.NewSessionIDFromString(c.String(FlagSessionID))
 	var closer io.Closer
@@ -401,13 +531,16 @@ func withLogging(c *cli.Context, crashHandler *crash.Handler, locations *locatio
 		logsPath,
 		sessionID,
 		logging.BridgeShortAppName,
-		logging.DefaultMaxLogFileSize,
-		logging.DefaultPruningSize,
-		c.String(flagLogLevel),
+		getRotationOptions(cfg),
+		logLevel,
 	); err != nil {
 		return fmt.Errorf("could not initialize logging: %w", err)
 	}
 
+	if format != logging.FormatText {
+		logging.SetFormat(format)
+	}
+
 	// Ensure we dump a stack trace if we crash.
 	crashHandler.AddRecoveryAction(logging.DumpStackTrace(logsPath, sessionID, appShortName))
 
@@ -448,8 +581,8 @@ func WithLocations(fn func(*locations.Locations) error) error {
 	logrus.Debug("Creating locations")
 	defer logrus.Debug("Locations stopped")
 
-	// Create a locations provider to determine where to store our files.
-	provider, err := locations.NewDefaultProvider(filepath.Join(constants.VendorName, constants.ConfigName))
+	// Create a locations provider to determine where to store our files, either the standard or the portable ones.
+	provider, err := locations.NewProvider(filepath.Join(constants.VendorName, constants.ConfigName))
 	if err != nil {
 		return fmt.Errorf("could not create locations provider: %w", err)
 	}
@@ -511,14 +644,22 @@ func withCrashHandler(restarter *restarter.Restarter, reporter *sentry.Reporter,
 	// quitCh is closed when the app is quitting.
 	quitCh := make(chan struct{})
 
+	var quitOnce sync.Once
+
+	quit := func() { quitOnce.Do(func() { close(quitCh) }) }
+
 	// On crash, quit the app.
-	crashHandler.AddRecoveryAction(func(any) error { close(quitCh); return nil })
+	crashHandler.AddRecoveryAction(func(any) error { quit(); return nil })
+
+	// On SIGTERM or interrupt, quit the app gracefully.
+	stopSignals := handleQuitSignals(crashHandler, quit)
+	defer stopSignals()
 
 	return fn(crashHandler, quitCh)
 }
 
 // Use a custom cookie jar to persist values across runs.
-func withCookieJar(vault *vault.Vault, fn func(http.CookieJar) error) error {
+func withCookieJar(vault *vault.Vault, apiHost string, fn func(http.CookieJar) error) error {
 	logrus.Debug("Creating cookie jar")
 	defer logrus.Debug("Cookie jar stopped")
 
@@ -534,7 +675,7 @@ func withCookieJar(vault *vault.Vault, fn func(http.CookieJar) error) error {
 		return fmt.Errorf("could not create cookie jar: %w", err)
 	}
 
-	if err := setDeviceCookies(persister); err != nil {
+	if err := setDeviceCookies(persister, apiHost); err != nil {
 		return fmt.Errorf("could not set device cookies: %w", err)
 	}
 
@@ -558,8 +699,8 @@ func WithKeychainList(panicHandler async.PanicHandler, fn func(*keychain.List) e
 	return fn(keychain.NewList())
 }
 
-func setDeviceCookies(jar *cookies.Jar) error {
-	url, err := url.Parse(constants.APIHost)
+func setDeviceCookies(jar *cookies.Jar, apiHost string) error {
+	url, err := url.Parse(apiHost)
 	if err != nil {
 		return err
 	}
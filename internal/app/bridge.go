@@ -20,13 +20,18 @@ package app
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
 	"runtime"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gluon/imap"
 	"github.com/ProtonMail/go-autostart"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/crash"
 	"github.com/ProtonMail/proton-bridge/v3/internal/dialer"
@@ -38,6 +43,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/ProtonMail/proton-bridge/v3/internal/versioner"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -55,6 +61,7 @@ func withBridge(
 	crashHandler *crash.Handler,
 	reporter *sentry.Reporter,
 	vault *vault.Vault,
+	apiHost string,
 	cookieJar http.CookieJar,
 	keychains *keychain.List,
 	fn func(*bridge.Bridge, <-chan events.Event) error,
@@ -71,16 +78,73 @@ func withBridge(
 		}
 	}
 
+	// Keep the message store out of persistent storage if requested.
+	cleanupEphemeralStore, err := setupEphemeralStore(c, locations)
+	if err != nil {
+		return fmt.Errorf("could not set up ephemeral store: %w", err)
+	}
+
+	defer cleanupEphemeralStore()
+
+	// Route connections through a SOCKS5 proxy if one is set; the environment takes precedence over the settings.
+	socksProxy := vault.GetSOCKSProxy()
+	if envProxy := os.Getenv("BRIDGE_SOCKS_PROXY"); envProxy != "" {
+		socksProxy = envProxy
+	}
+
+	if err := dialer.SetSOCKSProxy(socksProxy); err != nil {
+		logrus.WithError(err).Error("Failed to set SOCKS5 proxy, connecting directly")
+	}
+
+	// Select proxies with the PAC script if one is set.
+	if err := dialer.SetPACSource(vault.GetPACSource()); err != nil {
+		logrus.WithError(err).Error("Failed to load PAC script, using the proxy environment variables")
+	}
+
 	// Create the underlying dialer used by the bridge.
 	// It only connects to trusted servers and reports any untrusted servers it finds.
+	// Other API hosts (mirrors, proxy appliances, test servers) present their own certificates, which aren't pinned;
+	// they are verified against the system roots and the host name instead, unless explicitly asked not to.
+	basicDialer := dialer.NewBasicTLSDialer(apiHost)
+
+	var pinChecker dialer.PinChecker = dialer.NewTLSPinChecker(dialer.TrustedAPIPins)
+
+	if apiHost != constants.APIHost {
+		logrus.WithField("host", apiHost).Warn("Using a custom API host, certificate pinning and alternative routing are disabled")
+		pinChecker = dialer.NewAnyPinChecker()
+
+		if c.Bool(flagInsecureAPIHost) {
+			logrus.WithField("host", apiHost).Warn("Not verifying the certificate of the custom API host")
+		} else {
+			basicDialer.VerifyCertificates()
+		}
+	}
+
 	pinningDialer := dialer.NewPinningTLSDialer(
-		dialer.NewBasicTLSDialer(constants.APIHost),
-		dialer.NewTLSReporter(constants.APIHost, constants.AppVersion(version.Original()), identifier, dialer.TrustedAPIPins),
-		dialer.NewTLSPinChecker(dialer.TrustedAPIPins),
+		basicDialer,
+		dialer.NewTLSReporter(apiHost, constants.AppVersion(version.Original()), identifier, dialer.TrustedAPIPins),
+		pinChecker,
 	)
 
 	// Create a proxy dialer which switches to a proxy if the request fails.
-	proxyDialer := dialer.NewProxyTLSDialer(pinningDialer, constants.APIHost, crashHandler)
+	// The proxies found over DoH only serve the production API, so they are never used for other hosts.
+	proxyDialer := dialer.NewProxyTLSDialer(pinningDialer, apiHost, crashHandler)
+
+	if apiHost != constants.APIHost {
+		proxyDialer.ForbidProxy()
+	}
+
+	// Set the DoH providers used to find proxies; the flags take precedence over the settings.
+	if providers, err := dohProviders(c, vault); err != nil {
+		logrus.WithError(err).Error("Invalid DoH providers, using the default ones")
+	} else {
+		proxyDialer.SetDoHProviders(providers)
+	}
+
+	// Remove the temporary attachment files left behind if bridge previously crashed.
+	if err := message.RemoveSpillFiles(vault.GetGluonCacheDir()); err != nil {
+		logrus.WithError(err).Warn("Failed to remove stale attachment files")
+	}
 
 	// Create the autostarter.
 	autostarter := newAutostarter(exe)
@@ -102,7 +166,7 @@ func withBridge(
 		keychains,
 
 		// The API stuff.
-		constants.APIHost,
+		apiHost,
 		cookieJar,
 		identifier,
 		pinningDialer,
@@ -130,6 +194,48 @@ func withBridge(
 	return fn(bridge, eventCh)
 }
 
+// setupEphemeralStore makes the gluon store, the gluon database and the IMAP sync state live in an ephemeral
+// directory when requested, so that only the vault is kept persistently. The directory is wiped on startup and
+// on exit, meaning users are fully synced again each time bridge starts. The returned function wipes it.
+func setupEphemeralStore(c *cli.Context, locations *locations.Locations) (func(), error) {
+	var dir string
+
+	switch {
+	case c.String(flagEphemeralDir) != "":
+		// Only our own subdirectory is wiped, in case the given directory holds anything else.
+		dir = filepath.Join(c.String(flagEphemeralDir), "bridge-ephemeral")
+
+		if err := os.RemoveAll(dir); err != nil {
+			return nil, fmt.Errorf("could not wipe ephemeral dir: %w", err)
+		}
+
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, fmt.Errorf("could not create ephemeral dir: %w", err)
+		}
+
+	case c.Bool(flagEphemeral):
+		tempDir, err := os.MkdirTemp("", "bridge-ephemeral-")
+		if err != nil {
+			return nil, fmt.Errorf("could not create ephemeral dir: %w", err)
+		}
+
+		dir = tempDir
+
+	default:
+		return func() {}, nil
+	}
+
+	logrus.WithField("dir", dir).Warn("The message store is ephemeral, users will be fully synced again on next start")
+
+	locations.SetEphemeralPath(dir)
+
+	return func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.WithError(err).Error("Failed to wipe ephemeral dir")
+		}
+	}, nil
+}
+
 func newAutostarter(exe string) *autostart.App {
 	logrus.Debug("Creating autostarter")
 
@@ -140,6 +246,50 @@ func newAutostarter(exe string) *autostart.App {
 	}
 }
 
+// dohProviders returns the DoH providers to query, from the flags if given or else from the settings.
+func dohProviders(c *cli.Context, vault *vault.Vault) ([]string, error) {
+	custom, disabled := vault.GetDoHProviders()
+
+	if c.IsSet(flagDoHProvider) {
+		custom = c.StringSlice(flagDoHProvider)
+	}
+
+	if c.IsSet(flagDisableDoHProvider) {
+		disabled = c.StringSlice(flagDisableDoHProvider)
+	}
+
+	return dialer.ResolveDoHProviders(custom, disabled)
+}
+
+// getAPIHost returns the API address to use, from the flag if given or else from the settings,
+// falling back to the production API.
+func getAPIHost(c *cli.Context, vault *vault.Vault) (string, error) {
+	host := vault.GetAPIHost()
+
+	if c.IsSet(flagAPIHost) {
+		host = c.String(flagAPIHost)
+	}
+
+	if host == "" {
+		return constants.APIHost, nil
+	}
+
+	hostURL, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+
+	if hostURL.Scheme != "https" && hostURL.Scheme != "http" {
+		return "", fmt.Errorf("unsupported scheme %q", hostURL.Scheme)
+	}
+
+	if hostURL.Host == "" {
+		return "", fmt.Errorf("missing host in %q", host)
+	}
+
+	return strings.TrimSuffix(host, "/"), nil
+}
+
 func newUpdater(locations *locations.Locations) (*updater.Updater, error) {
 	updatesDir, err := locations.ProvideUpdatesPath()
 	if err != nil {
@@ -163,5 +313,6 @@ func newUpdater(locations *locations.Locations) (*updater.Updater, error) {
 		verifier,
 		constants.UpdateName,
 		runtime.GOOS,
+		clock.New(),
 	), nil
 }
@@ -0,0 +1,211 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/config"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
+	"github.com/hashicorp/go-multierror"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// loadConfigFile loads the configuration file passed on the command line, if any.
+// The proxy is applied immediately through the environment so that every HTTP transport picks it up.
+func loadConfigFile(c *cli.Context) (*config.Config, error) {
+	path := c.String(flagConfig)
+	if path == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The flags take precedence over the config file for the ephemeral store mode.
+	if cfg.EphemeralDir != "" && !c.IsSet(flagEphemeralDir) {
+		if err := c.Set(flagEphemeralDir, cfg.EphemeralDir); err != nil {
+			return nil, fmt.Errorf("failed to set ephemeral dir: %w", err)
+		}
+	}
+
+	if cfg.Ephemeral != nil && !c.IsSet(flagEphemeral) {
+		if err := c.Set(flagEphemeral, strconv.FormatBool(*cfg.Ephemeral)); err != nil {
+			return nil, fmt.Errorf("failed to set ephemeral mode: %w", err)
+		}
+	}
+
+	if cfg.IsSOCKSProxy() {
+		if err := os.Setenv("BRIDGE_SOCKS_PROXY", cfg.Proxy); err != nil {
+			return nil, fmt.Errorf("failed to set proxy: %w", err)
+		}
+	} else if cfg.Proxy != "" {
+		for _, key := range []string{"HTTPS_PROXY", "HTTP_PROXY"} {
+			if err := os.Setenv(key, cfg.Proxy); err != nil {
+				return nil, fmt.Errorf("failed to set proxy: %w", err)
+			}
+		}
+	}
+
+	return cfg, nil
+}
+
+// applyConfigSetting applies a setting from the configuration file.
+// If the setting was changed at runtime since it was last applied from an unchanged file,
+// the runtime value is kept and flagged as overriding the file.
+func applyConfigSetting(v *vault.Vault, key, want, have string, set func() error) error {
+	if last, ok := v.GetConfigApplied(key); ok && last == want && have != want {
+		logrus.WithFields(logrus.Fields{
+			"setting": key,
+			"config":  want,
+			"value":   have,
+		}).Warn("Setting was changed at runtime and overrides the config file")
+
+		return nil
+	}
+
+	if have != want {
+		if err := set(); err != nil {
+			return err
+		}
+	}
+
+	return v.SetConfigApplied(key, want)
+}
+
+// applyConfigToVault applies the settings and accounts of the configuration file to the vault before bridge starts.
+func applyConfigToVault(cfg *config.Config, v *vault.Vault) error {
+	logrus.Info("Applying config file")
+
+	var errs error
+
+	apply := func(key, want, have string, set func() error) {
+		if err := applyConfigSetting(v, key, want, have, set); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to apply %v: %w", key, err))
+		}
+	}
+
+	if port := cfg.IMAP.Port; port != nil {
+		apply("imap.port", strconv.Itoa(*port), strconv.Itoa(v.GetIMAPPort()), func() error { return v.SetIMAPPort(*port) })
+	}
+
+	if ssl := cfg.IMAP.SSL; ssl != nil {
+		apply("imap.ssl", strconv.FormatBool(*ssl), strconv.FormatBool(v.GetIMAPSSL()), func() error { return v.SetIMAPSSL(*ssl) })
+	}
+
+	if port := cfg.SMTP.Port; port != nil {
+		apply("smtp.port", strconv.Itoa(*port), strconv.Itoa(v.GetSMTPPort()), func() error { return v.SetSMTPPort(*port) })
+	}
+
+	if ssl := cfg.SMTP.SSL; ssl != nil {
+		apply("smtp.ssl", strconv.FormatBool(*ssl), strconv.FormatBool(v.GetSMTPSSL()), func() error { return v.SetSMTPSSL(*ssl) })
+	}
+
+	if allowed := cfg.AllowAlternativeRouting; allowed != nil {
+		apply("allow_alternative_routing", strconv.FormatBool(*allowed), strconv.FormatBool(v.GetProxyAllowed()), func() error { return v.SetProxyAllowed(*allowed) })
+	}
+
+	if show := cfg.ShowAllMail; show != nil {
+		apply("show_all_mail", strconv.FormatBool(*show), strconv.FormatBool(v.GetShowAllMail()), func() error { return v.SetShowAllMail(*show) })
+	}
+
+	for _, account := range cfg.Accounts {
+		if err := provisionConfigAccount(account, v); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// applyConfigToBridge applies the settings of the configuration file that must go through a running bridge.
+// The cache directory is moved by bridge so that existing data is migrated to the new location.
+func applyConfigToBridge(ctx context.Context, cfg *config.Config, v *vault.Vault, b *bridge.Bridge) error {
+	if cfg.CacheDir == "" {
+		return nil
+	}
+
+	// The message cache stays in the ephemeral directory; the setting applies once bridge runs persistently again.
+	if b.IsEphemeral() {
+		return nil
+	}
+
+	return applyConfigSetting(v, "cache_dir", cfg.CacheDir, b.GetGluonCacheDir(), func() error {
+		return b.SetGluonDir(ctx, cfg.CacheDir)
+	})
+}
+
+// provisionConfigAccount adds an account of the configuration file to the vault.
+// Bridge then logs it in with the stored refresh token like any other known user.
+func provisionConfigAccount(account config.Account, v *vault.Vault) error {
+	l := logrus.WithField("userID", account.UserID)
+
+	if v.HasUser(account.UserID) {
+		l.Debug("Config file account is already provisioned")
+		return nil
+	}
+
+	l.Info("Provisioning account from config file")
+
+	keyPass, err := base64.StdEncoding.DecodeString(account.KeyPass)
+	if err != nil {
+		return fmt.Errorf("failed to decode key pass for user %q: %w", account.UserID, err)
+	}
+
+	user, err := v.AddUser(account.UserID, account.Username, account.Email, account.AuthUID, account.RefreshToken, keyPass)
+	if err != nil {
+		return fmt.Errorf("failed to add user %q: %w", account.UserID, err)
+	}
+
+	defer func() {
+		if err := user.Close(); err != nil {
+			l.WithError(err).Error("Failed to close vault user after provisioning")
+		}
+	}()
+
+	if account.BridgePassword != "" {
+		dec, err := algo.B64RawDecode([]byte(account.BridgePassword))
+		if err != nil {
+			return fmt.Errorf("failed to decode bridge password for user %q: %w", account.UserID, err)
+		}
+
+		if err := user.SetBridgePass(dec); err != nil {
+			return fmt.Errorf("failed to set bridge password for user %q: %w", account.UserID, err)
+		}
+	}
+
+	if account.AddressMode == config.AddressModeSplit {
+		if err := user.SetAddressMode(vault.SplitMode); err != nil {
+			return fmt.Errorf("failed to set split address mode to user %q: %w", account.UserID, err)
+		}
+	}
+
+	l.WithField("username", logging.Sensitive(account.Username)).Info("Provisioned account from config file")
+
+	return nil
+}
@@ -225,3 +225,32 @@ func validateJSONPrefs(t *testing.T, vault *vault.Vault) {
 	// There should be a cookie for the API.
 	require.NotEmpty(t, cookies.Cookies(url))
 }
+
+func TestCopyGluonCache(t *testing.T) {
+	oldDir, newDir := t.TempDir(), t.TempDir()
+
+	v, corrupt, err := vault.New(t.TempDir(), oldDir, []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, corrupt)
+
+	user, err := v.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+	require.NoError(t, user.Close())
+
+	// The message cache is copied over and left in place.
+	store := filepath.Join(oldDir, "backend", "store", "gluonID")
+	require.NoError(t, os.MkdirAll(store, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(store, "messageID"), []byte("body"), 0o600))
+
+	require.NoError(t, copyGluonCache(v, newDir))
+	require.Equal(t, newDir, v.GetGluonCacheDir())
+	require.FileExists(t, filepath.Join(newDir, "backend", "store", "gluonID", "messageID"))
+	require.FileExists(t, filepath.Join(store, "messageID"))
+	require.NoError(t, v.GetUser("userID", func(user *vault.User) { require.False(t, user.GetShouldResync()) }))
+
+	// When the message cache can't be found, e.g. on another machine, the users are synced again.
+	require.NoError(t, v.SetGluonDir(filepath.Join(t.TempDir(), "missing")))
+	require.NoError(t, copyGluonCache(v, newDir))
+	require.Equal(t, newDir, v.GetGluonCacheDir())
+	require.NoError(t, v.GetUser("userID", func(user *vault.User) { require.True(t, user.GetShouldResync()) }))
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/sdnotify"
+	"github.com/sirupsen/logrus"
+)
+
+// withSystemd tells systemd, when bridge runs as one of its services, that bridge is ready,
+// keeps its watchdog fed while fn runs and reports the shutdown once fn returns.
+// Bridge starts listening for IMAP and SMTP before it is returned by bridge.New, so it is ready at this point.
+func withSystemd(panicHandler async.PanicHandler, fn func() error) error {
+	if ok, err := sdnotify.Notify(sdnotify.Ready); err != nil {
+		logrus.WithError(err).Error("Failed to notify systemd of readiness")
+	} else if ok {
+		logrus.Info("Notified systemd of readiness")
+	}
+
+	defer func() {
+		if _, err := sdnotify.Notify(sdnotify.Stopping); err != nil {
+			logrus.WithError(err).Error("Failed to notify systemd of shutdown")
+		}
+	}()
+
+	interval, err := sdnotify.WatchdogInterval()
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get systemd watchdog interval")
+	}
+
+	if interval > 0 {
+		stopCh := make(chan struct{})
+		defer close(stopCh)
+
+		go func() {
+			defer async.HandlePanic(panicHandler)
+
+			// Ping at half the interval, as recommended by sd_watchdog_enabled(3).
+			ticker := time.NewTicker(interval / 2)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-stopCh:
+					return
+
+				case <-ticker.C:
+					if _, err := sdnotify.Notify(sdnotify.Watchdog); err != nil {
+						logrus.WithError(err).Warn("Failed to ping systemd watchdog")
+					}
+				}
+			}
+		}()
+	}
+
+	return fn()
+}
+
+// handleQuitSignals calls quit when the app is asked to terminate, so that bridge is closed gracefully.
+func handleQuitSignals(panicHandler async.PanicHandler, quit func()) func() {
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, syscall.SIGTERM, os.Interrupt)
+
+	stopCh := make(chan struct{})
+
+	go func() {
+		defer async.HandlePanic(panicHandler)
+
+		select {
+		case sig := <-signalCh:
+			logrus.WithField("signal", sig).Info("Received signal, quitting")
+			quit()
+
+		case <-stopCh:
+		}
+	}()
+
+	return func() {
+		signal.Stop(signalCh)
+		close(stopCh)
+	}
+}
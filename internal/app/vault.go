@@ -19,13 +19,18 @@ package app
 
 import (
 	"fmt"
+	"os"
 	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/files"
 	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
 	"github.com/ProtonMail/proton-bridge/v3/internal/sentry"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
 	"github.com/sirupsen/logrus"
@@ -50,6 +55,20 @@ func WithVault(reporter *sentry.Reporter, locations *locations.Locations, keycha
 		logrus.WithError(corrupt).Warn("Failed to load existing vault, vault has been reset")
 	}
 
+	// The launcher can't read the vault, so the kill switch is mirrored where it can find it.
+	if settingsDir, err := locations.ProvideSettingsPath(); err != nil {
+		logrus.WithError(err).Error("Failed to get settings path")
+	} else if err := sentry.SaveDisabledMarker(settingsDir, encVault.GetReportingDisabled()); err != nil {
+		logrus.WithError(err).Error("Failed to save reporting marker")
+	}
+
+	// Crash reporting starts disabled and is only enabled once the kill switch stored in the vault is known to be off.
+	if !encVault.GetReportingDisabled() && os.Getenv(sentry.EnvDisableReporting) == "" {
+		if err := sentry.SetEnabled(true); err != nil {
+			logrus.WithError(err).Error("Failed to enable crash reporting")
+		}
+	}
+
 	cert, _ := encVault.GetBridgeTLSCert()
 	certs.NewInstaller().LogCertInstallStatus(cert)
 
@@ -71,7 +90,19 @@ func newVault(reporter *sentry.Reporter, locations *locations.Locations, keychai
 		insecure bool
 	)
 
-	if key, err := loadVaultKey(vaultDir, keychains); err != nil {
+	fileVault, err := vault.GetUseFileVault(vaultDir)
+	if err != nil {
+		logrus.WithError(err).Warn("Could not load keychain settings")
+	}
+
+	if fileVault {
+		// The user chose to keep the vault in a file, which is where the insecure vault is stored.
+		// It isn't protected by the keychain, so the frontends warn about it as when the keychain is unusable.
+		logrus.Info("Using the file-based vault, the keychain is left unused")
+		insecure = true
+
+		vaultDir = path.Join(vaultDir, "insecure")
+	} else if key, err := loadVaultKey(vaultDir, keychains); err != nil {
 		if reporter != nil {
 			if rerr := reporter.ReportMessageWithContext("Could not load/create vault key", map[string]any{
 				"keychainDefaultHelper":       keychains.GetDefaultHelper(),
@@ -96,14 +127,50 @@ func newVault(reporter *sentry.Reporter, locations *locations.Locations, keychai
 		return nil, false, nil, fmt.Errorf("could not provide gluon path: %w", err)
 	}
 
+	accountsPath := vault.AccountsPath(vaultDir)
+
 	vault, corrupt, err := vault.New(vaultDir, gluonCacheDir, vaultKey, panicHandler)
 	if err != nil {
 		return nil, false, corrupt, fmt.Errorf("could not create vault: %w", err)
 	}
 
+	// The accounts of the vault keyed in the keychain are served read-only when the keychain can't be reached.
+	if vaultKey != nil {
+		if err := vault.KeepAccounts(accountsPath); err != nil {
+			logrus.WithError(err).Error("Could not keep a copy of the vault accounts")
+		}
+	}
+
+	// A vault migrated to the portable directory still points to the message cache in the standard locations.
+	if portableDir := locations.PortableDir(); portableDir != "" && !isWithinDir(vault.GetGluonCacheDir(), portableDir) {
+		logrus.WithField("gluonDir", vault.GetGluonCacheDir()).Warn("Message cache is outside of the portable directory, using the default one")
+
+		if err := copyGluonCache(vault, gluonCacheDir); err != nil {
+			return nil, false, corrupt, fmt.Errorf("could not reset gluon dir: %w", err)
+		}
+	}
+
 	return vault, insecure, corrupt, nil
 }
 
+// copyGluonCache points the vault to the given message cache directory, copying the current cache over.
+// Like the rest of the migration to the portable directory, the original is left untouched.
+// If it can't be copied, e.g. because it lived on another machine's disk, the users are synced again from scratch.
+func copyGluonCache(v *vault.Vault, gluonCacheDir string) error {
+	from := imapsmtpserver.ApplyGluonCachePathSuffix(v.GetGluonCacheDir())
+	to := imapsmtpserver.ApplyGluonCachePathSuffix(gluonCacheDir)
+
+	if err := files.SyncDir(from, to); err != nil {
+		logrus.WithError(err).WithField("gluonDir", v.GetGluonCacheDir()).Warn("Failed to copy the message cache, users will be synced again")
+
+		if err := v.ForUser(1, func(user *vault.User) error { return user.SetShouldSync(true) }); err != nil {
+			return fmt.Errorf("could not schedule resync: %w", err)
+		}
+	}
+
+	return v.SetGluonDir(gluonCacheDir)
+}
+
 func loadVaultKey(vaultDir string, keychains *keychain.List) ([]byte, error) {
 	helper, err := vault.GetHelper(vaultDir)
 	if err != nil {
@@ -127,3 +194,13 @@ func loadVaultKey(vaultDir string, keychains *keychain.List) ([]byte, error) {
 
 	return key, nil
 }
+
+// isWithinDir returns whether the given path is the given directory or lies within it.
+func isWithinDir(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
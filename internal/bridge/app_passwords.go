@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/sirupsen/logrus"
+)
+
+// AppPasswordInfo describes an app password without revealing it.
+type AppPasswordInfo struct {
+	// Name identifies the client the app password was generated for.
+	Name string
+
+	// CreatedAt is when the app password was generated.
+	CreatedAt time.Time
+}
+
+// GetAppPasswords returns the app passwords of the given user.
+func (bridge *Bridge) GetAppPasswords(userID string) ([]AppPasswordInfo, error) {
+	return safe.RLockRetErr(func() ([]AppPasswordInfo, error) {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return nil, ErrNoSuchUser
+		}
+
+		var infos []AppPasswordInfo
+
+		for _, appPass := range user.AppPasswords() {
+			infos = append(infos, AppPasswordInfo{Name: appPass.Name, CreatedAt: appPass.CreatedAt})
+		}
+
+		return infos, nil
+	}, bridge.usersLock)
+}
+
+// CreateAppPassword generates a new app password for the given user and returns it.
+// The app password can be used instead of the bridge password by IMAP and SMTP clients until it is revoked.
+func (bridge *Bridge) CreateAppPassword(userID, name string) ([]byte, error) {
+	name = strings.TrimSpace(name)
+
+	logUser.WithFields(logrus.Fields{
+		"userID": userID,
+		"name":   name,
+	}).Info("Creating app password")
+
+	if name == "" {
+		return nil, ErrInvalidAppPassword
+	}
+
+	return safe.RLockRetErr(func() ([]byte, error) {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return nil, ErrNoSuchUser
+		}
+
+		if slices.ContainsFunc(user.AppPasswords(), func(appPass vault.AppPassword) bool { return appPass.Name == name }) {
+			return nil, ErrAppPasswordExists
+		}
+
+		return user.AddAppPassword(name)
+	}, bridge.usersLock)
+}
+
+// RevokeAppPassword revokes the app password of the given user with the given name.
+func (bridge *Bridge) RevokeAppPassword(userID, name string) error {
+	logUser.WithFields(logrus.Fields{
+		"userID": userID,
+		"name":   name,
+	}).Info("Revoking app password")
+
+	return safe.RLockRet(func() error {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		if !slices.ContainsFunc(user.AppPasswords(), func(appPass vault.AppPassword) bool { return appPass.Name == name }) {
+			return ErrNoSuchAppPassword
+		}
+
+		return user.RemoveAppPassword(name)
+	}, bridge.usersLock)
+}
+
+// DefaultAuthTokenValidity is how long auth tokens are valid for when no validity is given.
+const DefaultAuthTokenValidity = 90 * 24 * time.Hour
+
+// CreateAuthToken mints a local auth token for the given user, valid for the given duration (or the default if zero).
+// Clients that only support OAuth-style authentication can present it with XOAUTH2 or OAUTHBEARER.
+// Tokens are signed with the bridge password, so they are all revoked when it changes.
+func (bridge *Bridge) CreateAuthToken(userID string, validity time.Duration) ([]byte, error) {
+	if validity == 0 {
+		validity = DefaultAuthTokenValidity
+	}
+
+	logUser.WithFields(logrus.Fields{
+		"userID":   userID,
+		"validity": validity,
+	}).Info("Creating auth token")
+
+	if validity < 0 {
+		return nil, ErrInvalidAuthTokenValidity
+	}
+
+	return safe.RLockRetErr(func() ([]byte, error) {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return nil, ErrNoSuchUser
+		}
+
+		return user.NewAuthToken(time.Now().Add(validity)), nil
+	}, bridge.usersLock)
+}
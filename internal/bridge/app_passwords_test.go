@@ -0,0 +1,125 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/emersion/go-sasl"
+	"github.com/emersion/go-smtp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_AppPasswords(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			// New users have no app passwords.
+			appPasses, err := b.GetAppPasswords(userID)
+			require.NoError(t, err)
+			require.Empty(t, appPasses)
+
+			// Create an app password; names must be unique.
+			appPass, err := b.CreateAppPassword(userID, "thunderbird")
+			require.NoError(t, err)
+			require.NotEqual(t, info.BridgePass, appPass)
+
+			_, err = b.CreateAppPassword(userID, "thunderbird")
+			require.ErrorIs(t, err, bridge.ErrAppPasswordExists)
+
+			appPasses, err = b.GetAppPasswords(userID)
+			require.NoError(t, err)
+			require.Len(t, appPasses, 1)
+			require.Equal(t, "thunderbird", appPasses[0].Name)
+
+			// Both the bridge password and the app password can be used to log in.
+			for _, pass := range [][]byte{info.BridgePass, appPass} {
+				client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+				require.NoError(t, err)
+				require.NoError(t, client.Login(info.Addresses[0], string(pass)))
+				require.NoError(t, client.Logout())
+			}
+
+			// Once revoked, the app password can no longer be used.
+			require.NoError(t, b.RevokeAppPassword(userID, "thunderbird"))
+			require.ErrorIs(t, b.RevokeAppPassword(userID, "thunderbird"), bridge.ErrNoSuchAppPassword)
+
+			client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			defer func() { _ = client.Logout() }()
+
+			require.Error(t, client.Login(info.Addresses[0], string(appPass)))
+		})
+	})
+}
+
+func TestBridge_AuthToken(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			_, err = b.CreateAuthToken(userID, -time.Hour)
+			require.ErrorIs(t, err, bridge.ErrInvalidAuthTokenValidity)
+
+			token, err := b.CreateAuthToken(userID, 0)
+			require.NoError(t, err)
+
+			// The token can be presented with OAUTHBEARER over SMTP.
+			smtpClient, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+			require.NoError(t, err)
+			defer smtpClient.Close() //nolint:errcheck
+
+			require.NoError(t, smtpClient.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+			require.NoError(t, smtpClient.Auth(sasl.NewOAuthBearerClient(&sasl.OAuthBearerOptions{
+				Username: info.Addresses[0],
+				Token:    string(token),
+			})))
+
+			// The token can also be used instead of the bridge password over IMAP.
+			client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.NoError(t, client.Login(info.Addresses[0], string(token)))
+			require.NoError(t, client.Logout())
+
+			// A tampered token is rejected.
+			client, err = eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			defer func() { _ = client.Logout() }()
+
+			require.Error(t, client.Login(info.Addresses[0], string(token)+"x"))
+		})
+	})
+}
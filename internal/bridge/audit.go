@@ -0,0 +1,389 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	imapEvents "github.com/ProtonMail/gluon/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
+	"golang.org/x/exp/slices"
+)
+
+// maxRecentIMAPSessions is the number of ended IMAP sessions kept in memory for the status.
+const maxRecentIMAPSessions = 20
+
+// IMAPSession is the audit record of an IMAP session: which client touched which mailboxes of which address.
+// It never holds message content; commands are recorded by name only, without their arguments.
+type IMAPSession struct {
+	ID            int            `json:"id"`
+	RemoteAddr    string         `json:"remote_addr"`
+	ClientName    string         `json:"client_name,omitempty"`
+	ClientVersion string         `json:"client_version,omitempty"`
+	Address       string         `json:"address,omitempty"`
+	Mailboxes     []string       `json:"mailboxes,omitempty"`
+	Commands      map[string]int `json:"commands,omitempty"`
+	BytesIn       int64          `json:"bytes_in"`
+	BytesOut      int64          `json:"bytes_out"`
+	StartedAt     time.Time      `json:"started_at"`
+	EndedAt       time.Time      `json:"ended_at"`
+}
+
+// GetSessionAuditLog returns whether the IMAP sessions are recorded in the audit log.
+func (bridge *Bridge) GetSessionAuditLog() bool {
+	return bridge.vault.GetSessionAuditLog()
+}
+
+// SetSessionAuditLog sets whether the IMAP sessions are recorded in the audit log.
+// Sessions that are already open when it is enabled are not recorded.
+func (bridge *Bridge) SetSessionAuditLog(enabled bool) error {
+	if err := bridge.vault.SetSessionAuditLog(enabled); err != nil {
+		return err
+	}
+
+	bridge.sessionAudit.setEnabled(enabled)
+
+	return nil
+}
+
+// GetRecentIMAPSessions returns the open IMAP sessions, then the most recently ended ones, while the audit log is enabled.
+func (bridge *Bridge) GetRecentIMAPSessions() []IMAPSession {
+	return bridge.sessionAudit.getSessions()
+}
+
+func (bridge *Bridge) auditIMAPEvent(event imapEvents.Event) {
+	if !bridge.sessionAudit.isEnabled() {
+		return
+	}
+
+	switch event := event.(type) {
+	case imapEvents.SessionAdded:
+		remoteAddr := event.RemoteAddr.String()
+
+		traffic, ok := bridge.serverManager.ClaimIMAPTraffic(remoteAddr)
+		if !ok {
+			logPkg.WithField("sessionID", event.SessionID).Warn("Could not find the traffic of the IMAP session")
+		}
+
+		bridge.sessionAudit.start(event.SessionID, remoteAddr, traffic)
+
+	case imapEvents.IMAPID:
+		bridge.sessionAudit.update(event.SessionID, func(session *auditedSession) {
+			session.ClientName = event.IMAPID.Name
+			session.ClientVersion = event.IMAPID.Version
+		})
+
+	case imapEvents.Login:
+		address := bridge.getGluonIDAddress(event.UserID)
+
+		bridge.sessionAudit.update(event.SessionID, func(session *auditedSession) {
+			session.Address = address
+		})
+
+	case imapEvents.Select:
+		bridge.sessionAudit.update(event.SessionID, func(session *auditedSession) {
+			if !slices.Contains(session.Mailboxes, event.Mailbox) {
+				session.Mailboxes = append(session.Mailboxes, event.Mailbox)
+			}
+		})
+
+	case imapEvents.SessionRemoved:
+		bridge.sessionAudit.end(event.SessionID)
+	}
+}
+
+// getGluonIDAddress returns the email of the address served by the given gluon ID, or nothing if it is unknown.
+func (bridge *Bridge) getGluonIDAddress(gluonID string) string {
+	return safe.RLockRet(func() string {
+		for _, u := range bridge.users {
+			if address, ok := u.GetGluonIDAddress(gluonID); ok {
+				return address
+			}
+		}
+
+		return ""
+	}, bridge.usersLock)
+}
+
+type auditedSession struct {
+	IMAPSession
+
+	traffic *imapsmtpserver.Traffic
+}
+
+func (session *auditedSession) record() IMAPSession {
+	record := session.IMAPSession
+
+	record.Mailboxes = slices.Clone(session.Mailboxes)
+	record.Commands = make(map[string]int, len(session.Commands))
+
+	for name, count := range session.Commands {
+		record.Commands[name] = count
+	}
+
+	if session.traffic != nil {
+		record.BytesIn = session.traffic.BytesIn()
+		record.BytesOut = session.traffic.BytesOut()
+	}
+
+	return record
+}
+
+// sessionAudit records the IMAP sessions while enabled. It receives the commands of the clients as written
+// by gluon's client logger, and the rest from the IMAP events.
+type sessionAudit struct {
+	enabled bool
+
+	// log holds the audit log files; it is opened the first time the audit is enabled and stays open afterwards.
+	log io.WriteCloser
+
+	open   map[int]*auditedSession
+	recent []IMAPSession
+
+	// pending counts the commands of the sessions whose start wasn't handled yet, as IMAP events are asynchronous.
+	pending map[int]map[string]int
+
+	lock sync.Mutex
+}
+
+func (audit *sessionAudit) isEnabled() bool {
+	audit.lock.Lock()
+	defer audit.lock.Unlock()
+
+	return audit.enabled
+}
+
+func (audit *sessionAudit) setEnabled(enabled bool) {
+	audit.lock.Lock()
+	defer audit.lock.Unlock()
+
+	audit.enabled = enabled
+
+	if !enabled {
+		audit.open = nil
+		audit.recent = nil
+		audit.pending = nil
+		return
+	}
+
+	if audit.log == nil {
+		log, err := logging.NewAuditLog()
+		if err != nil {
+			logPkg.WithError(err).Warn("Could not open the audit log, IMAP sessions are only kept in memory")
+		} else {
+			audit.log = log
+		}
+	}
+
+	logPkg.Info("IMAP sessions are recorded in the audit log")
+}
+
+func (audit *sessionAudit) close() {
+	audit.lock.Lock()
+	defer audit.lock.Unlock()
+
+	if audit.log != nil {
+		if err := audit.log.Close(); err != nil {
+			logPkg.WithError(err).Error("Failed to close the audit log")
+		}
+
+		audit.log = nil
+	}
+}
+
+func (audit *sessionAudit) start(sessionID int, remoteAddr string, traffic *imapsmtpserver.Traffic) {
+	audit.lock.Lock()
+	defer audit.lock.Unlock()
+
+	if !audit.enabled {
+		return
+	}
+
+	if audit.open == nil {
+		audit.open = make(map[int]*auditedSession)
+	}
+
+	commands, ok := audit.pending[sessionID]
+	if ok {
+		delete(audit.pending, sessionID)
+	} else {
+		commands = make(map[string]int)
+	}
+
+	audit.open[sessionID] = &auditedSession{
+		IMAPSession: IMAPSession{
+			ID:         sessionID,
+			RemoteAddr: remoteAddr,
+			Commands:   commands,
+			StartedAt:  time.Now(),
+		},
+		traffic: traffic,
+	}
+}
+
+func (audit *sessionAudit) update(sessionID int, fn func(*auditedSession)) {
+	audit.lock.Lock()
+	defer audit.lock.Unlock()
+
+	if session, ok := audit.open[sessionID]; ok {
+		fn(session)
+	}
+}
+
+func (audit *sessionAudit) end(sessionID int) {
+	audit.lock.Lock()
+	defer audit.lock.Unlock()
+
+	delete(audit.pending, sessionID)
+
+	session, ok := audit.open[sessionID]
+	if !ok {
+		return
+	}
+
+	delete(audit.open, sessionID)
+
+	record := session.record()
+	record.EndedAt = time.Now()
+
+	if len(audit.recent) >= maxRecentIMAPSessions {
+		audit.recent = audit.recent[1:]
+	}
+
+	audit.recent = append(audit.recent, record)
+
+	if audit.log == nil {
+		return
+	}
+
+	if err := json.NewEncoder(audit.log).Encode(record); err != nil {
+		logPkg.WithError(err).Error("Failed to write to the audit log")
+	}
+}
+
+func (audit *sessionAudit) getSessions() []IMAPSession {
+	audit.lock.Lock()
+	defer audit.lock.Unlock()
+
+	sessions := make([]IMAPSession, 0, len(audit.open)+len(audit.recent))
+
+	for _, session := range audit.open {
+		sessions = append(sessions, session.record())
+	}
+
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].StartedAt.After(sessions[j].StartedAt) })
+
+	for i := len(audit.recent) - 1; i >= 0; i-- {
+		sessions = append(sessions, audit.recent[i])
+	}
+
+	return sessions
+}
+
+// Write receives the lines of gluon's client logger, e.g. "C[3]: A1 SELECT INBOX", and counts the commands of each session.
+func (audit *sessionAudit) Write(p []byte) (int, error) {
+	sessionID, name, ok := parseAuditedCommand(string(p))
+	if !ok {
+		return len(p), nil
+	}
+
+	audit.lock.Lock()
+	defer audit.lock.Unlock()
+
+	if !audit.enabled {
+		return len(p), nil
+	}
+
+	if session, ok := audit.open[sessionID]; ok {
+		session.Commands[name]++
+		return len(p), nil
+	}
+
+	if audit.pending == nil {
+		audit.pending = make(map[int]map[string]int)
+	}
+
+	if audit.pending[sessionID] == nil {
+		audit.pending[sessionID] = make(map[string]int)
+	}
+
+	audit.pending[sessionID][name]++
+
+	return len(p), nil
+}
+
+// parseAuditedCommand returns the session and the name of the command logged in the given line.
+// Lines that don't hold a command, such as literals, continuations and authentication data, are ignored.
+func parseAuditedCommand(line string) (int, string, bool) {
+	rest, ok := strings.CutPrefix(line, "C[")
+	if !ok {
+		return 0, "", false
+	}
+
+	id, rest, ok := strings.Cut(rest, "]: ")
+	if !ok {
+		return 0, "", false
+	}
+
+	sessionID, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, "", false
+	}
+
+	// The first field is the tag, then comes the name of the command.
+	fields := strings.Fields(rest)
+	if len(fields) < 2 {
+		return 0, "", false
+	}
+
+	name := strings.ToUpper(fields[1])
+
+	if name == "UID" && len(fields) > 2 {
+		name += " " + strings.ToUpper(fields[2])
+	}
+
+	if !isIMAPCommandName(name) {
+		return 0, "", false
+	}
+
+	return sessionID, name, true
+}
+
+func isIMAPCommandName(name string) bool {
+	const maxCommandNameLength = 16
+
+	if len(name) > maxCommandNameLength {
+		return false
+	}
+
+	for _, r := range name {
+		if (r < 'A' || r > 'Z') && r != ' ' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	imapid "github.com/emersion/go-imap-id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_SessionAuditLog(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			// Sessions are not recorded by default.
+			require.False(t, b.GetSessionAuditLog())
+			require.NoError(t, b.SetSessionAuditLog(true))
+			require.True(t, b.GetSessionAuditLog())
+
+			imapClient, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+
+			_, err = imapid.NewClient(imapClient).ID(imapid.ID{
+				imapid.FieldName:    "MyFancyClient",
+				imapid.FieldVersion: "0.1.2",
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, imapClient.Login(info.Addresses[0], string(info.BridgePass)))
+
+			_, err = imapClient.Select("INBOX", false)
+			require.NoError(t, err)
+
+			require.NoError(t, imapClient.Logout())
+
+			require.Eventually(t, func() bool {
+				sessions := b.GetRecentIMAPSessions()
+
+				return len(sessions) == 1 && !sessions[0].EndedAt.IsZero()
+			}, 10*time.Second, 100*time.Millisecond)
+
+			session := b.GetRecentIMAPSessions()[0]
+			require.Equal(t, "MyFancyClient", session.ClientName)
+			require.Equal(t, "0.1.2", session.ClientVersion)
+			require.Equal(t, info.Addresses[0], session.Address)
+			require.Equal(t, []string{"INBOX"}, session.Mailboxes)
+			require.Equal(t, 1, session.Commands["LOGIN"])
+			require.Equal(t, 1, session.Commands["SELECT"])
+			require.Positive(t, session.BytesIn)
+			require.Positive(t, session.BytesOut)
+
+			// Disabling the audit log forgets the recorded sessions.
+			require.NoError(t, b.SetSessionAuditLog(false))
+			require.Empty(t, b.GetRecentIMAPSessions())
+		})
+	})
+}
@@ -29,6 +29,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -38,10 +39,13 @@ import (
 	"github.com/ProtonMail/gluon/reporter"
 	"github.com/ProtonMail/gluon/watcher"
 	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/dialer"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/focus"
 	"github.com/ProtonMail/proton-bridge/v3/internal/identifier"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
 	"github.com/ProtonMail/proton-bridge/v3/internal/sentry"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
@@ -70,11 +74,21 @@ type Bridge struct {
 
 	// api manages user API clients.
 	api        *proton.Manager
+	apiURL     string
 	proxyCtl   ProxyController
 	identifier identifier.Identifier
 
+	// rateLimiter holds back all API requests while the API is rate-limiting us.
+	rateLimiter *dialer.RateLimitTransport
+
+	// clock drives the bridge's timers: update checks, auto-lock, API pings, webhook retries and the rate limit backoff.
+	// It is handed to the users for their sync retries and read receipts.
+	clock clock.Clock
+
 	// tlsConfig holds the bridge TLS config used by the IMAP and SMTP servers.
+	// It serves tlsCert, which can be replaced without restarting the servers.
 	tlsConfig *tls.Config
+	tlsCert   atomic.Pointer[tls.Certificate]
 
 	// imapServer is the bridge's IMAP server.
 	imapEventCh chan imapEvents.Event
@@ -114,6 +128,37 @@ type Bridge struct {
 	watchers     []*watcher.Watcher[events.Event]
 	watchersLock sync.RWMutex
 
+	// eventHistory keeps the most recent events for the diagnostics bundle.
+	eventHistory eventHistory
+
+	// sessionAudit records the IMAP sessions while the audit log is enabled.
+	sessionAudit sessionAudit
+
+	// locked is set while bridge doesn't serve its users; lastActivity is when a client last used bridge, in unix nanoseconds.
+	locked       atomic.Bool
+	lastActivity atomic.Int64
+
+	// rotatingKeys is set while the vault and gluon store keys are being rotated.
+	rotatingKeys atomic.Bool
+
+	// keysLock serializes locking and unlocking bridge with re-encrypting or replacing the vault and the gluon stores;
+	// usersUnloaded is set while the users are unloaded for the latter, so that they are not loaded meanwhile.
+	keysLock      sync.Mutex
+	usersUnloaded atomic.Bool
+
+	// credentialStoreUnavailable is set while bridge runs in degraded mode because the keychain couldn't be reached on
+	// startup; keychainAccounts then holds the accounts of the vault keyed in the keychain, which are served read-only.
+	credentialStoreUnavailable atomic.Bool
+	keychainAccounts           atomic.Pointer[vault.Vault]
+
+	// autoconfigURL is the URL of the local autoconfig endpoint, once started.
+	autoconfigURL  string
+	autoconfigLock sync.Mutex
+
+	// webhookCh queues the events to deliver to the registered webhooks.
+	webhookCh     *async.QueuedChannel[webhookDelivery]
+	webhookClient *http.Client
+
 	// errors contains errors encountered during startup.
 	errors []error
 
@@ -140,6 +185,9 @@ type Bridge struct {
 	serverManager *imapsmtpserver.Service
 	syncService   *syncservice.Service
 
+	// syncScheduler bounds how many users sync at once and decides which one goes next.
+	syncScheduler *syncScheduler
+
 	// unleashService is responsible for polling the feature flags and caching
 	unleashService *unleash.Service
 
@@ -175,11 +223,44 @@ func New(
 	logIMAPClient, logIMAPServer bool, // whether to log IMAP client/server activity
 	logSMTP bool, // whether to log SMTP activity
 ) (*Bridge, <-chan events.Event, error) {
+	return NewWithOptions(locator, vault, curVersion,
+		WithAutostarter(autostarter),
+		WithUpdater(updater),
+		WithKeychains(keychains),
+		WithAPIURL(apiURL),
+		WithCookieJar(cookieJar),
+		WithIdentifier(identifier),
+		WithTransport(roundTripper, tlsReporter, proxyCtl),
+		WithPanicHandler(panicHandler),
+		WithReporter(reporter),
+		WithUIDValidityGenerator(uidValidityGenerator),
+		WithHeartbeatManager(heartBeatManager),
+		WithLogging(logIMAPClient, logIMAPServer, logSMTP),
+	)
+}
+
+// NewWithOptions creates a new bridge configured with the given options; anything not configured uses
+// the same defaults as the bridge app, e.g. the production API reached through pinned TLS connections.
+// It lets other programs embed a fully configured bridge.
+func NewWithOptions(
+	locator Locator, // the locator to provide paths to store data
+	vault *vault.Vault, // the bridge's encrypted data store
+	curVersion *semver.Version, // the current version of the bridge
+	opts ...Option,
+) (*Bridge, <-chan events.Event, error) {
+	o, err := newOptions(curVersion, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to apply options: %w", err)
+	}
+
+	// rateLimiter shares a single backoff between all API clients when the API is rate-limiting us.
+	rateLimiter := dialer.NewRateLimitTransport(o.roundTripper, o.clock)
+
 	// api is the user's API manager.
-	api := proton.New(newAPIOptions(apiURL, curVersion, cookieJar, roundTripper, panicHandler)...)
+	api := proton.New(newAPIOptions(o.apiURL, curVersion, o.cookieJar, rateLimiter, o.panicHandler)...)
 
 	// tasks holds all the bridge's background tasks.
-	tasks := async.NewGroup(context.Background(), panicHandler)
+	tasks := async.NewGroup(context.Background(), o.panicHandler)
 
 	// imapEventCh forwards IMAP events from gluon instances to the bridge for processing.
 	imapEventCh := make(chan imapEvents.Event)
@@ -192,19 +273,24 @@ func New(
 
 		locator,
 		vault,
-		autostarter,
-		updater,
+		o.autostarter,
+		o.updater,
 		curVersion,
-		keychains,
-		panicHandler,
-		reporter,
+		o.keychains,
+		o.panicHandler,
+		o.reporter,
 
 		api,
-		identifier,
-		proxyCtl,
-		uidValidityGenerator,
-		heartBeatManager,
-		logIMAPClient, logIMAPServer, logSMTP,
+		o.apiURL,
+		o.identifier,
+		o.proxyCtl,
+		rateLimiter,
+		o.uidValidityGenerator,
+		o.heartbeatManager,
+		o.tlsCert,
+		o.listen,
+		o.clock,
+		o.logIMAPClient, o.logIMAPServer, o.logSMTP,
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create bridge: %w", err)
@@ -213,8 +299,18 @@ func New(
 	// Get an event channel for all events (individual events can be subscribed to later).
 	eventCh, _ := bridge.GetEvents()
 
+	// Subscribers are registered before bridge starts so that they don't miss any event.
+	for _, subscriber := range o.subscribers {
+		subscriberCh, _ := bridge.GetEvents(subscriber.ofType...)
+		handler := subscriber.handler
+
+		bridge.tasks.Once(func(ctx context.Context) {
+			async.RangeContext(ctx, subscriberCh, handler)
+		})
+	}
+
 	// Initialize all of bridge's background tasks and operations.
-	if err := bridge.init(tlsReporter); err != nil {
+	if err := bridge.init(o.tlsReporter); err != nil {
 		return nil, nil, fmt.Errorf("failed to initialize bridge: %w", err)
 	}
 
@@ -236,18 +332,27 @@ func newBridge(
 	reporter reporter.Reporter,
 
 	api *proton.Manager,
+	apiURL string,
 	identifier identifier.Identifier,
 	proxyCtl ProxyController,
+	rateLimiter *dialer.RateLimitTransport,
 	uidValidityGenerator imap.UIDValidityGenerator,
 	heartbeatManager telemetry.HeartbeatManager,
+	customTLSCert *tls.Certificate,
+	listen imapsmtpserver.ListenFunc,
+	clock clock.Clock,
 
 	logIMAPClient, logIMAPServer, logSMTP bool,
 ) (*Bridge, error) {
-	tlsConfig, err := loadTLSConfig(vault)
+	tlsCert, err := loadTLSCert(vault)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load TLS config: %w", err)
 	}
 
+	if customTLSCert != nil {
+		tlsCert = *customTLSCert
+	}
+
 	firstStart := vault.GetFirstStart()
 	if err := vault.SetFirstStart(false); err != nil {
 		return nil, fmt.Errorf("failed to save first start indicator: %w", err)
@@ -260,7 +365,15 @@ func newBridge(
 
 	identifier.SetClientString(vault.GetLastUserAgent())
 
-	focusService, err := focus.NewService(locator, curVersion, panicHandler)
+	// The message cache may have been moved away from its default location.
+	locator.SetGluonCachePath(vault.GetGluonCacheDir())
+
+	// Nothing that could send diagnostic data is started before the reporting kill switch is applied.
+	if err := applyReportingDisabled(vault.GetReportingDisabled() || isReportingDisabledByEnv()); err != nil {
+		return nil, fmt.Errorf("failed to apply reporting setting: %w", err)
+	}
+
+	focusService, err := focus.NewService(locator, curVersion, panicHandler, vault.GetIPCOverTCP())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create focus service: %w", err)
 	}
@@ -269,6 +382,8 @@ func newBridge(
 
 	observabilityService := observability.NewService(ctx, panicHandler)
 
+	syncService := syncservice.NewService(panicHandler, observabilityService, vault.GetMaxSyncMemory())
+
 	bridge := &Bridge{
 		vault: vault,
 
@@ -276,15 +391,21 @@ func newBridge(
 		usersLock: safe.NewRWMutex(),
 
 		api:        api,
+		apiURL:     apiURL,
 		proxyCtl:   proxyCtl,
 		identifier: identifier,
 
-		tlsConfig:   tlsConfig,
+		rateLimiter: rateLimiter,
+		clock:       clock,
+
 		imapEventCh: imapEventCh,
 
 		updater:   updater,
 		installCh: make(chan installJob),
 
+		webhookCh:     async.NewQueuedChannel[webhookDelivery](0, 0, panicHandler, "bridge-webhooks"),
+		webhookClient: newWebhookClient(),
+
 		curVersion:     curVersion,
 		newVersion:     curVersion,
 		newVersionLock: safe.NewRWMutex(),
@@ -307,8 +428,9 @@ func newBridge(
 		firstStart:  firstStart,
 		lastVersion: lastVersion,
 
-		tasks:       tasks,
-		syncService: syncservice.NewService(panicHandler, observabilityService),
+		tasks:         tasks,
+		syncService:   syncService,
+		syncScheduler: newSyncScheduler(syncService, defaultMaxParallelSyncs, panicHandler),
 
 		unleashService: unleashService,
 
@@ -317,6 +439,9 @@ func newBridge(
 		notificationStore: notifications.NewStore(locator.ProvideNotificationsCachePath),
 	}
 
+	bridge.tlsCert.Store(&tlsCert)
+	bridge.tlsConfig = bridge.newTLSConfig()
+
 	bridge.serverManager = imapsmtpserver.NewService(context.Background(),
 		&bridgeSMTPSettings{b: bridge},
 		&bridgeIMAPSettings{b: bridge},
@@ -326,6 +451,8 @@ func newBridge(
 		uidValidityGenerator,
 		&bridgeIMAPSMTPTelemetry{b: bridge},
 		observabilityService,
+		bridge,
+		listen,
 	)
 
 	// Check whether username has changed and correct (macOS only)
@@ -358,6 +485,11 @@ func (bridge *Bridge) init(tlsReporter TLSReporter) error {
 		bridge.proxyCtl.DisallowProxy()
 	}
 
+	// Record the IMAP sessions if the audit log is enabled.
+	if bridge.vault.GetSessionAuditLog() {
+		bridge.sessionAudit.setEnabled(true)
+	}
+
 	// Handle connection up/down events.
 	bridge.api.AddStatusObserver(func(status proton.Status) {
 		logPkg.Info("API status changed: ", status)
@@ -394,6 +526,19 @@ func (bridge *Bridge) init(tlsReporter TLSReporter) error {
 		return nil
 	})
 
+	// Trace all API requests while the API subsystem is being traced, without the emails and IDs they contain.
+	bridge.api.AddPostRequestHook(func(_ *resty.Client, r *resty.Response) error {
+		if logging.IsTracing(logging.SubsystemAPI) {
+			logrus.WithFields(logrus.Fields{
+				"pkg":      "gpa/trace",
+				"duration": r.Time(),
+				"size":     r.Size(),
+			}).Tracef("%v: %v %v", r.Status(), r.Request.Method, logging.Redact(r.Request.URL))
+		}
+
+		return nil
+	})
+
 	// Publish a TLS issue event if a TLS issue is encountered.
 	bridge.tasks.Once(func(ctx context.Context) {
 		async.RangeContext(ctx, tlsReporter.GetTLSIssueCh(), func(struct{}) {
@@ -402,6 +547,14 @@ func (bridge *Bridge) init(tlsReporter TLSReporter) error {
 		})
 	})
 
+	// Publish a rate-limited event each time the API asks us to back off.
+	bridge.tasks.Once(func(ctx context.Context) {
+		async.RangeContext(ctx, bridge.rateLimiter.GetRateLimitedCh(), func(retryAfter time.Duration) {
+			logPkg.WithField("retryAfter", retryAfter).Warn("API rate limit reached, backing off")
+			bridge.publish(events.APIRateLimited{RetryAfter: retryAfter})
+		})
+	})
+
 	// Publish a raise event if the focus service is called.
 	bridge.tasks.Once(func(ctx context.Context) {
 		async.RangeContext(ctx, bridge.focusService.GetRaiseCh(), func(struct{}) {
@@ -433,7 +586,7 @@ func (bridge *Bridge) init(tlsReporter TLSReporter) error {
 	defer bridge.goLoad()
 
 	// Check for updates when triggered.
-	bridge.goUpdate = bridge.tasks.PeriodicOrTrigger(constants.UpdateCheckInterval, 0, func(ctx context.Context) {
+	bridge.goUpdate = bridge.periodicOrTrigger(constants.UpdateCheckInterval, func(ctx context.Context) {
 		logPkg.Info("Checking for updates")
 
 		version, err := bridge.updater.GetVersionInfo(ctx, bridge.api, bridge.vault.GetUpdateChannel())
@@ -445,10 +598,37 @@ func (bridge *Bridge) init(tlsReporter TLSReporter) error {
 	})
 	defer bridge.goUpdate()
 
+	// Lock bridge once it has been inactive for the configured time.
+	bridge.NotifyActivity()
+
+	bridge.periodicOrTrigger(autoLockCheckInterval, func(ctx context.Context) {
+		bridge.checkAutoLock(ctx)
+	})
+
+	// Run in degraded mode if the keychain couldn't be reached, and keep trying to reach it.
+	bridge.checkCredentialStore()
+
+	bridge.periodicOrTrigger(credentialStoreRetryInterval, func(ctx context.Context) {
+		bridge.retryCredentialStore(ctx)
+	})
+
+	// Reload the TLS certificate files when they are renewed.
+	bridge.periodicOrTrigger(tlsCertCheckInterval, func(context.Context) {
+		bridge.checkTLSCertRenewal()
+	})
+
 	// Install updates when available.
 	bridge.tasks.Once(func(ctx context.Context) {
 		async.RangeContext(ctx, bridge.installCh, func(job installJob) {
-			bridge.installUpdate(ctx, job)
+			// The outcome is published as events.
+			_ = bridge.installUpdate(ctx, job)
+		})
+	})
+
+	// Deliver events to the registered webhooks.
+	bridge.tasks.Once(func(ctx context.Context) {
+		async.RangeContext(ctx, bridge.webhookCh.GetChannel(), func(delivery webhookDelivery) {
+			bridge.deliverWebhook(ctx, delivery)
 		})
 	})
 
@@ -480,6 +660,11 @@ func (bridge *Bridge) Close(ctx context.Context) {
 	// Stop heart beat before closing users.
 	bridge.heartbeat.stop()
 
+	// Let the mail currently being sent go out before closing users.
+	if err := bridge.serverManager.FlushSMTP(ctx); err != nil {
+		logPkg.WithError(err).Error("Failed to flush outgoing mail")
+	}
+
 	// Close all users.
 	safe.Lock(func() {
 		for _, user := range bridge.users {
@@ -492,11 +677,17 @@ func (bridge *Bridge) Close(ctx context.Context) {
 		logPkg.WithError(err).Error("Failed to close servers")
 	}
 
+	// Close the audit log once no more sessions can end.
+	bridge.sessionAudit.close()
+
 	bridge.syncService.Close()
 
 	// Stop all ongoing tasks.
 	bridge.tasks.CancelAndWait()
 
+	// Drop the webhook deliveries that didn't go out.
+	bridge.webhookCh.CloseAndDiscardQueued()
+
 	// Close the focus service.
 	bridge.focusService.Close()
 
@@ -514,12 +705,49 @@ func (bridge *Bridge) Close(ctx context.Context) {
 	bridge.watchers = nil
 }
 
+// periodicOrTrigger runs fn each time the given period has passed on the bridge's clock, or when triggered
+// with the returned function.
+func (bridge *Bridge) periodicOrTrigger(period time.Duration, fn func(context.Context)) func() {
+	triggerCh := make(chan struct{}, 1)
+
+	bridge.tasks.Once(func(ctx context.Context) {
+		for {
+			// The timer is stopped when triggered early so that it doesn't outlive the wait.
+			timer := bridge.clock.NewTimer(period)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+
+			case <-triggerCh:
+				timer.Stop()
+
+			case <-timer.C():
+			}
+
+			fn(ctx)
+		}
+	})
+
+	return func() {
+		select {
+		case triggerCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
 func (bridge *Bridge) publish(event events.Event) {
 	bridge.watchersLock.RLock()
 	defer bridge.watchersLock.RUnlock()
 
 	logPkg.WithField("event", event).Debug("Publishing event")
 
+	bridge.eventHistory.add(event)
+
+	bridge.dispatchWebhooks(event)
+
 	for _, watcher := range bridge.watchers {
 		if watcher.IsWatching(event) {
 			if ok := watcher.Send(event); !ok {
@@ -558,29 +786,43 @@ func (bridge *Bridge) remWatcher(watcher *watcher.Watcher[events.Event]) {
 func (bridge *Bridge) onStatusUp(_ context.Context) {
 	logPkg.Info("Handling API status up")
 
+	bridge.reloadPAC()
+
 	bridge.goLoad()
 }
 
 func (bridge *Bridge) onStatusDown(ctx context.Context) {
 	logPkg.Info("Handling API status down")
 
+	// The network may have changed, and with it the proxy the PAC script selects.
+	bridge.reloadPAC()
+
 	for backoff := time.Second; ; backoff = min(backoff*2, 30*time.Second) {
-		select {
-		case <-ctx.Done():
+		if !clock.Sleep(ctx, bridge.clock, backoff) {
 			return
+		}
 
-		case <-time.After(backoff):
-			logPkg.Info("Pinging API")
+		logPkg.Info("Pinging API")
 
-			if err := bridge.api.Ping(ctx); err != nil {
-				logPkg.WithError(err).Warn("Ping failed, API is still unreachable")
-			} else {
-				return
-			}
+		if err := bridge.api.Ping(ctx); err != nil {
+			logPkg.WithError(err).Warn("Ping failed, API is still unreachable")
+		} else {
+			return
 		}
 	}
 }
 
+// reloadPAC loads the PAC script again, if one is set, and forgets the proxies it selected so far.
+func (bridge *Bridge) reloadPAC() {
+	if bridge.vault.GetPACSource() == "" {
+		return
+	}
+
+	if err := bridge.proxyCtl.ReloadPAC(); err != nil {
+		logPkg.WithError(err).Warn("Failed to reload PAC script, keeping the previous one")
+	}
+}
+
 func (bridge *Bridge) Repair() {
 	var wg sync.WaitGroup
 	userIDs := bridge.GetUserIDs()
@@ -624,16 +866,8 @@ func (bridge *Bridge) Repair() {
 	wg.Wait()
 }
 
-func loadTLSConfig(vault *vault.Vault) (*tls.Config, error) {
-	cert, err := tls.X509KeyPair(vault.GetBridgeTLSCert())
-	if err != nil {
-		return nil, err
-	}
-
-	return &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		MinVersion:   tls.VersionTLS12,
-	}, nil
+func loadTLSCert(vault *vault.Vault) (tls.Certificate, error) {
+	return tls.X509KeyPair(vault.GetBridgeTLSCert())
 }
 
 func min(a, b time.Duration) time.Duration { //nolint:predeclared
@@ -648,6 +882,11 @@ func (bridge *Bridge) HasAPIConnection() bool {
 	return bridge.api.GetStatus() == proton.StatusUp
 }
 
+// GetAPIBackoff returns how long API requests are still held back for because the API rate-limited us, if at all.
+func (bridge *Bridge) GetAPIBackoff() time.Duration {
+	return bridge.rateLimiter.GetBackoff()
+}
+
 // verifyUsernameChange - works only on macOS
 // it attempts to check whether a username change has taken place by comparing the gluon DB path (which is static and provided by bridge)
 // to the gluon Cache path - which can be modified by the user and is stored in the vault;
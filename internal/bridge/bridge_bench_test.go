@@ -0,0 +1,159 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/emersion/go-imap/client"
+	"github.com/stretchr/testify/require"
+)
+
+// benchmarkMailboxSizes are the numbers of messages in the mailbox used by the benchmarks.
+// Each size is run as a sub-benchmark named "messages=N" so results can be compared between releases with benchstat.
+var benchmarkMailboxSizes = []int{100, 1000, 5000}
+
+// BenchmarkBridge_Sync measures how quickly a user with the given number of messages is fully synced.
+func BenchmarkBridge_Sync(b *testing.B) {
+	forEachMailboxSize(b, func(b *testing.B, ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte, size int) {
+		withBridge(ctx, b, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](bridge.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				userID, err := bridge.LoginFull(ctx, "imap", password, nil, nil)
+				require.NoError(b, err)
+				require.Equal(b, userID, (<-syncCh).UserID)
+
+				b.StopTimer()
+				require.NoError(b, bridge.DeleteUser(ctx, userID))
+				b.StartTimer()
+			}
+
+			reportMessagesPerSecond(b, size)
+		})
+	})
+}
+
+// BenchmarkBridge_Fetch measures how quickly an IMAP client fetches all messages of a mailbox.
+func BenchmarkBridge_Fetch(b *testing.B) {
+	forEachMailboxSize(b, func(b *testing.B, ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte, size int) {
+		withSyncedIMAPClient(ctx, b, s, netCtl, locator, storeKey, func(client *client.Client) {
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				messages, err := clientFetch(client, "Folders/folder")
+				require.NoError(b, err)
+				require.Len(b, messages, size)
+			}
+
+			reportMessagesPerSecond(b, size)
+		})
+	})
+}
+
+// BenchmarkBridge_Append measures the latency of appending a message to a mailbox of the given size.
+func BenchmarkBridge_Append(b *testing.B) {
+	literal, err := os.ReadFile(filepath.Join("testdata", "text-plain.eml"))
+	require.NoError(b, err)
+
+	forEachMailboxSize(b, func(b *testing.B, ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte, _ int) {
+		withSyncedIMAPClient(ctx, b, s, netCtl, locator, storeKey, func(client *client.Client) {
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, client.Append("Folders/folder", nil, time.Now(), bytes.NewReader(literal)))
+			}
+		})
+	})
+}
+
+// forEachMailboxSize runs the benchmark for each mailbox size, against a fake API server
+// holding a user with that many messages in a custom folder.
+func forEachMailboxSize(
+	b *testing.B,
+	fn func(*testing.B, context.Context, *server.Server, *proton.NetCtl, bridge.Locator, []byte, int),
+) {
+	for _, size := range benchmarkMailboxSizes {
+		size := size
+
+		b.Run(fmt.Sprintf("messages=%d", size), func(b *testing.B) {
+			withEnv(b, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+				userID, addrID, err := s.CreateUser("imap", password)
+				require.NoError(b, err)
+
+				labelID, err := s.CreateLabel(userID, "folder", "", proton.LabelTypeFolder)
+				require.NoError(b, err)
+
+				withClient(ctx, b, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+					createNumMessages(ctx, b, c, addrID, labelID, size)
+				})
+
+				fn(b, ctx, s, netCtl, locator, storeKey, size)
+			})
+		})
+	}
+}
+
+// withSyncedIMAPClient logs the benchmark user in, waits for it to be synced and connects an IMAP client.
+func withSyncedIMAPClient(
+	ctx context.Context,
+	b *testing.B,
+	s *server.Server,
+	netCtl *proton.NetCtl,
+	locator bridge.Locator,
+	storeKey []byte,
+	fn func(*client.Client),
+) {
+	withBridge(ctx, b, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
+		syncCh, done := chToType[events.Event, events.SyncFinished](bridge.GetEvents(events.SyncFinished{}))
+		defer done()
+
+		userID, err := bridge.LoginFull(ctx, "imap", password, nil, nil)
+		require.NoError(b, err)
+		require.Equal(b, userID, (<-syncCh).UserID)
+
+		info, err := bridge.GetUserInfo(userID)
+		require.NoError(b, err)
+
+		client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, bridge.GetIMAPPort()))
+		require.NoError(b, err)
+		require.NoError(b, client.Login(info.Addresses[0], string(info.BridgePass)))
+		defer func() { _ = client.Logout() }()
+
+		fn(client)
+	})
+}
+
+// reportMessagesPerSecond reports the throughput of a benchmark processing the given number of messages per operation.
+func reportMessagesPerSecond(b *testing.B, size int) {
+	b.ReportMetric(float64(size*b.N)/b.Elapsed().Seconds(), "msgs/s")
+}
@@ -18,15 +18,22 @@
 package bridge_test
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -49,12 +56,15 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/user"
 	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/internal/versioner"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
 	"github.com/ProtonMail/proton-bridge/v3/tests"
 	"github.com/bradenaw/juniper/xslices"
+	go_imap "github.com/emersion/go-imap"
 	imapid "github.com/emersion/go-imap-id"
 	"github.com/emersion/go-sasl"
 	"github.com/emersion/go-smtp"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
 )
@@ -65,6 +75,9 @@ var (
 
 	v2_3_0 = semver.MustParse("2.3.0")
 	v2_4_0 = semver.MustParse("2.4.0")
+
+	// generateCert is the real certificate generator, which init replaces with a fast one.
+	generateCert = certs.GenerateCert
 )
 
 func init() {
@@ -105,6 +118,38 @@ func TestBridge_ConnStatus(t *testing.T) {
 	})
 }
 
+func TestBridge_NewWithOptions(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		// Count the listeners created for the IMAP and SMTP servers.
+		var listeners atomic.Int32
+
+		// The subscriber receives the events published while bridge starts.
+		loadedCh := make(chan struct{})
+
+		withBridgeOptions(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			<-loadedCh
+
+			require.NoError(t, b.SetIMAPPort(ctx, 0))
+			require.NoError(t, b.SetSMTPPort(ctx, 0))
+
+			// The servers listen on the listeners created by the given function.
+			require.Positive(t, listeners.Load())
+
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+			require.NotEmpty(t, userID)
+		},
+			bridge.WithListenFunc(func(network, address string) (net.Listener, error) {
+				listeners.Add(1)
+				return net.Listen(network, address)
+			}),
+			bridge.WithEventSubscriber(func(events.Event) {
+				close(loadedCh)
+			}, events.AllUsersLoaded{}),
+		)
+	})
+}
+
 func TestBridge_TLSIssue(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
 		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(bridge *bridge.Bridge, mocks *bridge.Mocks) {
@@ -502,6 +547,155 @@ func TestBridge_ForceUpdate(t *testing.T) {
 	})
 }
 
+func TestBridge_Rollback(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(bridge *bridge.Bridge, mocks *bridge.Mocks) {
+			require.NoError(t, bridge.SetAutoUpdate(true))
+
+			updateCh, done := bridge.GetEvents(events.UpdateInstalled{})
+			defer done()
+
+			// Install an update.
+			mocks.Updater.SetLatestVersion(v2_4_0, v2_3_0)
+			bridge.CheckForUpdates()
+			require.Equal(t, v2_4_0, (<-updateCh).(events.UpdateInstalled).Version.Version)
+
+			// Roll it back; the previous version is installed in its place.
+			mocks.Updater.SetPreviousVersion(v2_3_0)
+			require.NoError(t, bridge.Rollback())
+			require.Equal(t, events.UpdateInstalled{Version: updater.VersionInfo{Version: v2_3_0}}, <-updateCh)
+
+			// The version rolled back from is not installed again.
+			bridge.CheckForUpdates()
+
+			// Rolling back to the base installed version, whose version is unknown, publishes nothing.
+			mocks.Updater.SetPreviousVersion(nil)
+			require.NoError(t, bridge.Rollback())
+
+			select {
+			case event := <-updateCh:
+				require.Fail(t, "unexpected update event", event)
+			case <-time.After(time.Second):
+			}
+		})
+	})
+}
+
+func TestBridge_InstallUpdateFromDir(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			kr := newUpdateKeyRing(t)
+			mocks.Updater.SetUpdater(updater.NewUpdater(versioner.New(t.TempDir()), kr, "bridge", runtime.GOOS, mocks.Clock))
+
+			updateCh, done := b.GetEvents(events.UpdateInstalled{})
+			defer done()
+
+			dir := t.TempDir()
+			writeLocalUpdate(t, kr, dir, b.GetUpdateChannel())
+
+			require.NoError(t, b.InstallUpdateFromDir(ctx, dir))
+			require.Equal(t, v2_4_0, (<-updateCh).(events.UpdateInstalled).Version.Version)
+		})
+	})
+}
+
+func TestBridge_InstallUpdateFromDir_BadSignature(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			kr := newUpdateKeyRing(t)
+			mocks.Updater.SetUpdater(updater.NewUpdater(versioner.New(t.TempDir()), kr, "bridge", runtime.GOOS, mocks.Clock))
+
+			// The update files are signed by another key.
+			dir := t.TempDir()
+			writeLocalUpdate(t, newUpdateKeyRing(t), dir, b.GetUpdateChannel())
+
+			require.Error(t, b.InstallUpdateFromDir(ctx, dir))
+
+			// Only the package is signed by another key.
+			writeLocalUpdate(t, kr, dir, b.GetUpdateChannel())
+			writeSignedFile(t, newUpdateKeyRing(t), dir, "bridge_2.4.0.tgz", makeUpdatePackage(t))
+
+			mocks.Reporter.EXPECT().ReportMessageWithContext("Cannot download or verify update", gomock.Any())
+
+			require.ErrorIs(t, b.InstallUpdateFromDir(ctx, dir), updater.ErrDownloadVerify)
+		})
+	})
+}
+
+func TestBridge_InstallUpdateFromDir_MissingFile(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			kr := newUpdateKeyRing(t)
+			mocks.Updater.SetUpdater(updater.NewUpdater(versioner.New(t.TempDir()), kr, "bridge", runtime.GOOS, mocks.Clock))
+
+			// The directory has no version file.
+			require.ErrorIs(t, b.InstallUpdateFromDir(ctx, t.TempDir()), os.ErrNotExist)
+
+			// The directory has no update package.
+			dir := t.TempDir()
+			writeLocalUpdate(t, kr, dir, b.GetUpdateChannel())
+			require.NoError(t, os.Remove(filepath.Join(dir, "bridge_2.4.0.tgz")))
+
+			mocks.Reporter.EXPECT().ReportMessageWithContext("Cannot download or verify update", gomock.Any())
+
+			require.ErrorIs(t, b.InstallUpdateFromDir(ctx, dir), os.ErrNotExist)
+		})
+	})
+}
+
+func newUpdateKeyRing(t *testing.T) *crypto.KeyRing {
+	key, err := crypto.GenerateKey("test", "test@proton.me", "x25519", 0)
+	require.NoError(t, err)
+
+	kr, err := crypto.NewKeyRing(key)
+	require.NoError(t, err)
+
+	return kr
+}
+
+// writeLocalUpdate writes the signed version file and update package of version 2.4.0 to the given directory.
+func writeLocalUpdate(t *testing.T, kr *crypto.KeyRing, dir string, channel updater.Channel) {
+	versionFile, err := json.Marshal(updater.VersionMap{
+		channel: {
+			Version:           v2_4_0,
+			MinAuto:           v2_3_0,
+			Package:           "https://proton.me/download/bridge/bridge_2.4.0.tgz",
+			RolloutProportion: 1.0,
+		},
+	})
+	require.NoError(t, err)
+
+	writeSignedFile(t, kr, dir, "version_"+runtime.GOOS+".json", versionFile)
+	writeSignedFile(t, kr, dir, "bridge_2.4.0.tgz", makeUpdatePackage(t))
+}
+
+func writeSignedFile(t *testing.T, kr *crypto.KeyRing, dir, name string, b []byte) {
+	sig, err := kr.SignDetached(crypto.NewPlainMessage(b))
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), b, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".sig"), sig.GetBinary(), 0o600))
+}
+
+// makeUpdatePackage returns an update package holding a single executable.
+func makeUpdatePackage(t *testing.T) []byte {
+	var buf bytes.Buffer
+
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	exe := []byte("#!/bin/sh\n")
+
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./", Typeflag: tar.TypeDir, Mode: 0o700}))
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "proton-bridge", Mode: 0o700, Size: int64(len(exe))}))
+	_, err := tw.Write(exe)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	return buf.Bytes()
+}
+
 func TestBridge_BadVaultKey(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
 		var userID string
@@ -579,6 +773,41 @@ func TestBridge_MissingGluonDatabase(t *testing.T) {
 	})
 }
 
+func TestBridge_EphemeralStore(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		ephemeralDir := t.TempDir()
+		locator.(*locations.Locations).SetEphemeralPath(ephemeralDir)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			require.True(t, b.IsEphemeral())
+			require.Equal(t, filepath.Join(ephemeralDir, "gluon"), b.GetGluonCacheDir())
+
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			// The message cache can't be moved out of the ephemeral directory.
+			require.ErrorIs(t, b.SetGluonDir(ctx, t.TempDir()), bridge.ErrEphemeralStore)
+		})
+
+		// The ephemeral directory is wiped, as it would be on reboot.
+		require.NoError(t, os.RemoveAll(ephemeralDir))
+
+		// The user is still known from the vault and is synced again into the ephemeral directory.
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			require.Len(t, b.GetUserIDs(), 1)
+
+			require.Eventually(t, func() bool {
+				entries, err := os.ReadDir(filepath.Join(ephemeralDir, "imap-sync"))
+				return err == nil && len(entries) > 0
+			}, 10*time.Second, 100*time.Millisecond)
+		})
+	})
+}
+
 func TestBridge_AddressWithoutKeys(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
 		m := proton.New(
@@ -757,6 +986,166 @@ func TestBridge_ChangeCacheDirectory(t *testing.T) {
 	})
 }
 
+func TestBridge_MaxCacheSize(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		userID, addrID, err := s.CreateUser("imap", password)
+		require.NoError(t, err)
+
+		labelID, err := s.CreateLabel(userID, "folder", "", proton.LabelTypeFolder)
+		require.NoError(t, err)
+
+		withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+			createNumMessages(ctx, t, c, addrID, labelID, 10)
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// By default, the cache is unlimited.
+			require.Zero(t, b.GetMaxCacheSize())
+
+			// Login the user.
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+			userID, err := b.LoginFull(ctx, "imap", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.NoError(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+			defer func() { _ = client.Logout() }()
+
+			// Fetch all bodies so they are cached.
+			messages, err := clientFetch(client, `Folders/folder`)
+			require.NoError(t, err)
+			require.Len(t, messages, 10)
+
+			cacheSize := b.GetCacheSize()
+			require.NotZero(t, cacheSize)
+
+			// Cap the cache; the least recently used bodies are evicted.
+			require.NoError(t, b.SetMaxCacheSize(cacheSize/2))
+			require.Equal(t, cacheSize/2, b.GetMaxCacheSize())
+			require.LessOrEqual(t, b.GetCacheSize(), cacheSize/2)
+
+			// Evicted bodies are fetched again on demand.
+			messages, err = clientFetch(client, `Folders/folder`)
+			require.NoError(t, err)
+			require.Len(t, messages, 10)
+
+			for _, message := range messages {
+				literal, err := io.ReadAll(message.GetBody(must(go_imap.ParseBodySectionName("BODY[]"))))
+				require.NoError(t, err)
+				require.NotEmpty(t, literal)
+			}
+
+			require.LessOrEqual(t, b.GetCacheSize(), cacheSize/2)
+		})
+	})
+}
+
+func TestBridge_KeepRecentDays(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		userID, addrID, err := s.CreateUser("imap", password)
+		require.NoError(t, err)
+
+		labelID, err := s.CreateLabel(userID, "folder", "", proton.LabelTypeFolder)
+		require.NoError(t, err)
+
+		// Create old and recent messages.
+		withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+			for _, date := range []time.Time{time.Now().AddDate(-1, 0, 0), time.Now().AddDate(0, 0, -1)} {
+				for i := 0; i < 5; i++ {
+					createMessages(ctx, t, c, addrID, labelID, []byte(fmt.Sprintf(
+						"To: someone@pm.me\r\nSubject: Message %v\r\nDate: %v\r\n\r\nHello!",
+						i, date.Format(time.RFC1123Z),
+					)))
+				}
+			}
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// By default, no recent messages are kept.
+			require.Zero(t, b.GetKeepRecentDays())
+
+			// Login the user.
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+			userID, err := b.LoginFull(ctx, "imap", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.NoError(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+			defer func() { _ = client.Logout() }()
+
+			// Fetch all bodies so they are cached.
+			messages, err := clientFetch(client, `Folders/folder`)
+			require.NoError(t, err)
+			require.Len(t, messages, 10)
+
+			// Keep the last month and cap the cache so that only a single body fits.
+			require.NoError(t, b.SetKeepRecentDays(30))
+			require.Equal(t, uint32(30), b.GetKeepRecentDays())
+			require.NoError(t, b.SetMaxCacheSize(1))
+
+			// The bodies of the recent messages are kept.
+			keptSize := b.GetCacheSize()
+			require.NotZero(t, keptSize)
+
+			// Without the window, they are evicted too.
+			require.NoError(t, b.SetKeepRecentDays(0))
+			require.Less(t, b.GetCacheSize(), keptSize)
+		})
+	})
+}
+
+func TestBridge_MaxSyncMemory(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		_, addrID, err := s.CreateUser("imap", password)
+		require.NoError(t, err)
+
+		withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+			createNumMessages(ctx, t, c, addrID, proton.InboxLabel, 10)
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			require.Equal(t, vault.DefaultMaxSyncMemory, b.GetMaxSyncMemory())
+			require.NotZero(t, b.GetMemoryUsage().Limit)
+
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, "imap", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			// Once synced, all the memory reserved by the sync has been returned.
+			require.Eventually(t, func() bool {
+				return b.GetMemoryUsage().Used == 0
+			}, 5*time.Second, 100*time.Millisecond)
+			require.Zero(t, b.GetMemoryUsage().Waiting)
+
+			// The limit is clamped to what the system can afford.
+			require.NoError(t, b.SetMaxSyncMemory(1024*1024*1024))
+			require.Equal(t, uint64(1024*1024*1024), b.GetMaxSyncMemory())
+			require.LessOrEqual(t, b.GetMemoryUsage().Limit, uint64(1024*1024*1024))
+		})
+
+		// The limit is persisted.
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			require.Equal(t, uint64(1024*1024*1024), b.GetMaxSyncMemory())
+			require.LessOrEqual(t, b.GetMemoryUsage().Limit, uint64(1024*1024*1024))
+		})
+	})
+}
+
 func TestBridge_ChangeAddressOrder(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
 		// Create a user.
@@ -821,7 +1210,7 @@ func TestBridge_ChangeAddressOrder(t *testing.T) {
 }
 
 // withEnv creates the full test environment and runs the tests.
-func withEnv(t *testing.T, tests func(context.Context, *server.Server, *proton.NetCtl, bridge.Locator, []byte), opts ...server.Option) {
+func withEnv(t testing.TB, tests func(context.Context, *server.Server, *proton.NetCtl, bridge.Locator, []byte), opts ...server.Option) {
 	opt := goleak.IgnoreCurrent()
 	defer goleak.VerifyNone(t, opt)
 
@@ -851,7 +1240,7 @@ func withEnv(t *testing.T, tests func(context.Context, *server.Server, *proton.N
 }
 
 // withMocks creates the mock objects used in the tests.
-func withMocks(t *testing.T, tests func(*bridge.Mocks)) {
+func withMocks(t testing.TB, tests func(*bridge.Mocks)) {
 	mocks := bridge.NewMocks(t, v2_3_0, v2_3_0)
 	defer mocks.Close()
 
@@ -864,7 +1253,7 @@ var testUIDValidityGenerator = imap.DefaultEpochUIDValidityGenerator()
 // withBridge creates a new bridge which points to the given API URL and uses the given keychain, and closes it when done.
 func withBridgeNoMocks(
 	ctx context.Context,
-	t *testing.T,
+	t testing.TB,
 	mocks *bridge.Mocks,
 	apiURL string,
 	netCtl *proton.NetCtl,
@@ -943,7 +1332,7 @@ func withBridgeNoMocks(
 // withBridge creates a new bridge which points to the given API URL and uses the given keychain, and closes it when done.
 func withBridge(
 	ctx context.Context,
-	t *testing.T,
+	t testing.TB,
 	apiURL string,
 	netCtl *proton.NetCtl,
 	locator bridge.Locator,
@@ -957,6 +1346,47 @@ func withBridge(
 	})
 }
 
+// withBridgeOptions creates a new bridge with NewWithOptions, driven by the fake clock of the mocks and configured
+// with the given options on top of those of withBridge, and closes it when done.
+func withBridgeOptions(
+	ctx context.Context,
+	t *testing.T,
+	apiURL string,
+	netCtl *proton.NetCtl,
+	locator bridge.Locator,
+	vaultKey []byte,
+	tests func(*bridge.Bridge, *bridge.Mocks),
+	opts ...bridge.Option,
+) {
+	withMocks(t, func(mocks *bridge.Mocks) {
+		// Bridge will disable the proxy by default at startup.
+		mocks.ProxyCtl.EXPECT().DisallowProxy()
+
+		vaultDir, err := locator.ProvideSettingsPath()
+		require.NoError(t, err)
+
+		vault, _, err := vault.New(vaultDir, t.TempDir(), vaultKey, async.NoopPanicHandler{})
+		require.NoError(t, err)
+
+		b, _, err := bridge.NewWithOptions(locator, vault, v2_3_0, append([]bridge.Option{
+			bridge.WithAutostarter(mocks.Autostarter),
+			bridge.WithUpdater(mocks.Updater),
+			bridge.WithKeychains(keychain.NewTestKeychainsList()),
+			bridge.WithAPIURL(apiURL),
+			bridge.WithTransport(netCtl.NewRoundTripper(&tls.Config{InsecureSkipVerify: true}), mocks.TLSReporter, mocks.ProxyCtl),
+			bridge.WithPanicHandler(mocks.CrashHandler),
+			bridge.WithReporter(mocks.Reporter),
+			bridge.WithHeartbeatManager(mocks.Heartbeat),
+			bridge.WithUIDValidityGenerator(testUIDValidityGenerator),
+			bridge.WithClock(mocks.Clock),
+		}, opts...)...)
+		require.NoError(t, err)
+		defer b.Close(ctx)
+
+		tests(b, mocks)
+	})
+}
+
 // withBridgeWaitForServers is the same as withBridge, but it will wait until IMAP & SMTP servers are ready.
 func withBridgeWaitForServers(
 	ctx context.Context,
@@ -974,7 +1404,7 @@ func withBridgeWaitForServers(
 	})
 }
 
-func waitForEvent[T any](t *testing.T, eventCh <-chan events.Event, _ T) {
+func waitForEvent[T any](t testing.TB, eventCh <-chan events.Event, _ T) {
 	t.Helper()
 
 	for event := range eventCh {
@@ -20,12 +20,17 @@ package bridge
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/clientconfig"
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
 	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/sirupsen/logrus"
@@ -45,46 +50,146 @@ func (bridge *Bridge) ConfigureAppleMail(ctx context.Context, userID, address st
 			return ErrNoSuchUser
 		}
 
-		emails := user.Emails()
-		displayNames := user.DisplayNames()
-		if (len(emails) == 0) || (len(displayNames) == 0) {
-			return errors.New("could not retrieve user address info")
+		settings, err := bridge.getClientSettings(user, address)
+		if err != nil {
+			return err
 		}
 
-		if address == "" {
-			address = emails[0]
-		}
-
-		var username, displayName, addresses string
-		if user.GetAddressMode() == vault.CombinedMode {
-			username = address
-			displayName = displayNames[username]
-			addresses = strings.Join(emails, ",")
-		} else {
-			username = address
-			addresses = address
-			displayName = displayNames[address]
-			if len(displayName) == 0 {
-				displayName = address
-			}
-		}
-
-		if useragent.IsCatalinaOrNewer() && !bridge.vault.GetSMTPSSL() {
+		if useragent.IsCatalinaOrNewer() && !settings.SMTPSSL {
 			if err := bridge.SetSMTPSSL(ctx, true); err != nil {
 				return err
 			}
+
+			settings.SMTPSSL = true
 		}
 
 		return (&clientconfig.AppleMail{}).Configure(
-			constants.Host,
-			bridge.vault.GetIMAPPort(),
-			bridge.vault.GetSMTPPort(),
-			bridge.vault.GetIMAPSSL(),
-			bridge.vault.GetSMTPSSL(),
-			username,
-			displayName,
-			addresses,
+			settings.Hostname,
+			settings.IMAPPort,
+			settings.SMTPPort,
+			settings.IMAPSSL,
+			settings.SMTPSSL,
+			settings.Username,
+			settings.DisplayName,
+			strings.Join(settings.Addresses, ","),
 			user.BridgePass(),
 		)
 	}, bridge.usersLock)
 }
+
+// GetClientConfig returns the configuration a mail client needs to connect to bridge as the given user and address,
+// in the given format. It never contains the bridge password.
+func (bridge *Bridge) GetClientConfig(userID, address string, format clientconfig.Format) ([]byte, error) {
+	return safe.RLockRetErr(func() ([]byte, error) {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return nil, ErrNoSuchUser
+		}
+
+		settings, err := bridge.getClientSettings(user, address)
+		if err != nil {
+			return nil, err
+		}
+
+		return clientconfig.Generate(settings, format)
+	}, bridge.usersLock)
+}
+
+// StartAutoconfigServer starts a local HTTP endpoint serving the autoconfig XML of the bridge accounts, if not already
+// started, and returns its URL. Clients supporting autoconfig can be pointed at it to discover the settings of an address.
+// It keeps running until bridge is closed.
+func (bridge *Bridge) StartAutoconfigServer() (string, error) {
+	bridge.autoconfigLock.Lock()
+	defer bridge.autoconfigLock.Unlock()
+
+	if bridge.autoconfigURL != "" {
+		return bridge.autoconfigURL, nil
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(constants.Host, "0"))
+	if err != nil {
+		return "", fmt.Errorf("failed to listen for autoconfig requests: %w", err)
+	}
+
+	server := &http.Server{
+		Handler:           clientconfig.NewAutoconfigHandler(bridge.getAutoconfigSettings),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	bridge.tasks.Once(func(ctx context.Context) {
+		go func() {
+			<-ctx.Done()
+			_ = server.Close()
+		}()
+
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logPkg.WithError(err).Error("Autoconfig server failed")
+		}
+	})
+
+	bridge.autoconfigURL = "http://" + listener.Addr().String()
+
+	logPkg.WithField("url", bridge.autoconfigURL).Info("Started autoconfig server")
+
+	return bridge.autoconfigURL, nil
+}
+
+// getAutoconfigSettings returns the client settings of the user owning the given address.
+func (bridge *Bridge) getAutoconfigSettings(email string) (clientconfig.Settings, bool) {
+	settings, err := safe.RLockRetErr(func() (clientconfig.Settings, error) {
+		for _, user := range bridge.users {
+			for _, userEmail := range user.Emails() {
+				if strings.EqualFold(userEmail, email) {
+					return bridge.getClientSettings(user, userEmail)
+				}
+			}
+		}
+
+		return clientconfig.Settings{}, ErrNoSuchUser
+	}, bridge.usersLock)
+
+	return settings, err == nil
+}
+
+// getClientSettings returns how a mail client connects to bridge as the given user and address.
+// If the address is empty, the primary address is used. The caller must hold the users lock.
+func (bridge *Bridge) getClientSettings(user *user.User, address string) (clientconfig.Settings, error) {
+	emails := user.Emails()
+	displayNames := user.DisplayNames()
+	if (len(emails) == 0) || (len(displayNames) == 0) {
+		return clientconfig.Settings{}, errors.New("could not retrieve user address info")
+	}
+
+	if address == "" {
+		address = emails[0]
+	}
+
+	settings := clientconfig.Settings{
+		Hostname:    constants.Host,
+		Username:    address,
+		DisplayName: displayNames[address],
+	}
+
+	if user.GetAddressMode() == vault.CombinedMode {
+		settings.Addresses = emails
+	} else {
+		settings.Addresses = []string{address}
+
+		if len(settings.DisplayName) == 0 {
+			settings.DisplayName = address
+		}
+	}
+
+	// Prefer the implicit TLS listeners when they are enabled.
+	settings.IMAPPort, settings.IMAPSSL = bridge.vault.GetIMAPPort(), bridge.GetIMAPSSL()
+	if port := bridge.vault.GetIMAPImplicitTLSPort(); port != 0 && !settings.IMAPSSL {
+		settings.IMAPPort, settings.IMAPSSL = port, true
+	}
+
+	settings.SMTPPort, settings.SMTPSSL = bridge.vault.GetSMTPPort(), bridge.GetSMTPSSL()
+	if port := bridge.vault.GetSMTPImplicitTLSPort(); port != 0 && !settings.SMTPSSL {
+		settings.SMTPPort, settings.SMTPSSL = port, true
+	}
+
+	return settings, nil
+}
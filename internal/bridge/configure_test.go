@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clientconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_ClientConfig(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			// The generated configurations point at the bridge ports but don't contain the bridge password.
+			for _, format := range []clientconfig.Format{clientconfig.FormatAutoconfig, clientconfig.FormatPrefsJS} {
+				config, err := b.GetClientConfig(userID, "", format)
+				require.NoError(t, err)
+				require.Contains(t, string(config), info.Addresses[0])
+				require.Contains(t, string(config), fmt.Sprint(b.GetIMAPPort()))
+				require.NotContains(t, string(config), string(info.BridgePass))
+			}
+
+			_, err = b.GetClientConfig("no such user", "", clientconfig.FormatAutoconfig)
+			require.ErrorIs(t, err, bridge.ErrNoSuchUser)
+
+			// The autoconfig endpoint serves the settings of the addresses of the bridge accounts.
+			url, err := b.StartAutoconfigServer()
+			require.NoError(t, err)
+
+			sameURL, err := b.StartAutoconfigServer()
+			require.NoError(t, err)
+			require.Equal(t, url, sameURL)
+
+			res, err := http.Get(url + clientconfig.AutoconfigPaths[0] + "?emailaddress=" + info.Addresses[0]) //nolint:noctx
+			require.NoError(t, err)
+			defer res.Body.Close() //nolint:errcheck
+
+			body, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, res.StatusCode)
+			require.Contains(t, string(body), fmt.Sprintf("<port>%v</port>", b.GetSMTPPort()))
+		})
+	})
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+)
+
+// credentialStoreRetryInterval is how often bridge tries to reach the keychain again while running in degraded mode.
+const credentialStoreRetryInterval = time.Minute
+
+// IsCredentialStoreUnavailable returns whether bridge runs in degraded mode because the keychain holding the vault key
+// couldn't be reached on startup. Bridge then runs on the file-based vault, serving the users it holds, and refuses new
+// logins: accounts added now would be lost once bridge uses the keychain again.
+// The accounts of the vault keyed in the keychain are served read-only from the copy kept without their secrets: they
+// are listed as locked and can't be changed. Their mail can't be decrypted, so it's served again once the keychain is
+// back, which bridge switches back to the keychain vault for, or once the vault is migrated.
+func (bridge *Bridge) IsCredentialStoreUnavailable() bool {
+	return bridge.credentialStoreUnavailable.Load()
+}
+
+// MigrateToFileVault leaves degraded mode by keeping the vault in a file rather than keyed in the keychain from now on.
+// If the keychain can be reached, the contents of the vault it holds the key of are moved into the file-based vault;
+// otherwise the file-based vault is kept as it is and the users of the other vault must log in again.
+func (bridge *Bridge) MigrateToFileVault(ctx context.Context) error {
+	if !bridge.credentialStoreUnavailable.Load() {
+		return nil
+	}
+
+	if bridge.rotatingKeys.Load() {
+		return ErrKeyRotationInProgress
+	}
+
+	vaultDir, err := bridge.locator.ProvideSettingsPath()
+	if err != nil {
+		return fmt.Errorf("could not get vault dir: %w", err)
+	}
+
+	if key, err := bridge.getKeychainVaultKey(); err != nil {
+		logPkg.WithError(err).Warn("Keychain is still unavailable, keeping the file-based vault as it is")
+	} else if err := bridge.importVault(ctx, vault.FilePath(vaultDir), key); err != nil {
+		return fmt.Errorf("failed to import the keychain vault: %w", err)
+	}
+
+	if err := vault.SetUseFileVault(vaultDir, true); err != nil {
+		return fmt.Errorf("failed to save keychain settings: %w", err)
+	}
+
+	logPkg.Info("Migrated to the file-based vault")
+
+	bridge.credentialStoreUnavailable.Store(false)
+	bridge.keychainAccounts.Store(nil)
+
+	return nil
+}
+
+// importVault replaces the contents of the vault with those of the vault file at the given path, encrypted with the
+// given key. The users are unloaded meanwhile and loaded again from the new contents afterwards.
+func (bridge *Bridge) importVault(ctx context.Context, path string, key []byte) error {
+	bridge.keysLock.Lock()
+	defer bridge.keysLock.Unlock()
+
+	if bridge.locked.Load() {
+		return ErrBridgeLocked
+	}
+
+	bridge.usersUnloaded.Store(true)
+
+	defer func() {
+		bridge.usersUnloaded.Store(false)
+		bridge.goLoad()
+	}()
+
+	bridge.unloadUsers(ctx)
+
+	return bridge.vault.Import(path, key)
+}
+
+// checkCredentialStore enters degraded mode if bridge runs on the file-based vault while a vault keyed in the keychain
+// exists, which happens when the keychain couldn't be reached on startup and the file-based vault wasn't chosen.
+func (bridge *Bridge) checkCredentialStore() {
+	vaultDir, err := bridge.locator.ProvideSettingsPath()
+	if err != nil {
+		logPkg.WithError(err).Error("Failed to get vault dir")
+		return
+	}
+
+	if bridge.vault.Path() == vault.FilePath(vaultDir) {
+		return
+	}
+
+	if _, err := os.Stat(vault.FilePath(vaultDir)); err != nil {
+		return
+	}
+
+	if fileVault, err := vault.GetUseFileVault(vaultDir); err != nil {
+		logPkg.WithError(err).Error("Failed to load keychain settings")
+		return
+	} else if fileVault {
+		return
+	}
+
+	keyErr := ErrCredentialStoreUnavailable
+
+	if _, err := bridge.getKeychainVaultKey(); err != nil {
+		keyErr = fmt.Errorf("%w: %w", ErrCredentialStoreUnavailable, err)
+	}
+
+	logPkg.WithError(keyErr).Warn("Keychain is unavailable, running in degraded mode")
+
+	if accounts, err := vault.OpenAccounts(vault.AccountsPath(vaultDir)); err != nil {
+		logPkg.WithError(err).Warn("Failed to read the accounts of the keychain vault")
+	} else {
+		bridge.keychainAccounts.Store(accounts)
+	}
+
+	bridge.credentialStoreUnavailable.Store(true)
+
+	bridge.publish(events.CredentialStoreUnavailable{Error: keyErr})
+}
+
+// retryCredentialStore leaves degraded mode once the keychain can be reached again: bridge switches back to the vault
+// keyed in the keychain and loads its users. The file-based vault is left as it is.
+func (bridge *Bridge) retryCredentialStore(ctx context.Context) {
+	if !bridge.credentialStoreUnavailable.Load() {
+		return
+	}
+
+	key, err := bridge.getKeychainVaultKey()
+	if err != nil {
+		logPkg.WithError(err).Debug("Keychain is still unavailable")
+		return
+	}
+
+	if err := bridge.reopenKeychainVault(ctx, key); err != nil {
+		logPkg.WithError(err).Error("Failed to switch back to the keychain vault")
+		return
+	}
+
+	logPkg.Info("Keychain is available again, switched back to the keychain vault")
+
+	bridge.publish(events.CredentialStoreAvailable{})
+}
+
+// reopenKeychainVault replaces the file-based vault bridge runs on in degraded mode by the vault keyed in the keychain,
+// encrypted with the given key. The users are unloaded meanwhile and loaded again from the keychain vault afterwards.
+func (bridge *Bridge) reopenKeychainVault(ctx context.Context, key []byte) error {
+	vaultDir, err := bridge.locator.ProvideSettingsPath()
+	if err != nil {
+		return fmt.Errorf("could not get vault dir: %w", err)
+	}
+
+	bridge.keysLock.Lock()
+	defer bridge.keysLock.Unlock()
+
+	if bridge.locked.Load() {
+		return ErrBridgeLocked
+	}
+
+	// The vault may have been migrated meanwhile.
+	if !bridge.credentialStoreUnavailable.Load() {
+		return nil
+	}
+
+	bridge.usersUnloaded.Store(true)
+
+	defer func() {
+		bridge.usersUnloaded.Store(false)
+		bridge.goLoad()
+	}()
+
+	bridge.unloadUsers(ctx)
+
+	if err := bridge.vault.Reopen(vault.FilePath(vaultDir), key); err != nil {
+		return err
+	}
+
+	if err := bridge.vault.KeepAccounts(vault.AccountsPath(vaultDir)); err != nil {
+		logPkg.WithError(err).Error("Failed to keep a copy of the vault accounts")
+	}
+
+	bridge.credentialStoreUnavailable.Store(false)
+	bridge.keychainAccounts.Store(nil)
+
+	// The servers listen as configured in the keychain vault from now on.
+	if err := bridge.restartIMAP(ctx); err != nil {
+		logPkg.WithError(err).Error("Failed to restart IMAP server")
+	}
+
+	if err := bridge.restartSMTP(ctx); err != nil {
+		logPkg.WithError(err).Error("Failed to restart SMTP server")
+	}
+
+	return nil
+}
+
+// isKeychainAccount returns whether the given user is an account of the vault keyed in the keychain, served read-only
+// in degraded mode.
+func (bridge *Bridge) isKeychainAccount(userID string) bool {
+	accounts := bridge.keychainAccounts.Load()
+
+	return accounts != nil && accounts.HasUser(userID) && !bridge.vault.HasUser(userID)
+}
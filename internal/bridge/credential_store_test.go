@@ -0,0 +1,175 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"crypto/tls"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_CredentialStoreUnavailable(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withDegradedBridge(ctx, t, s, netCtl, locator, vaultKey, func(b *bridge.Bridge, keychains *keychain.List, mocks *bridge.Mocks) {
+			// Logins are refused.
+			_, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.ErrorIs(t, err, bridge.ErrCredentialStoreUnavailable)
+
+			// The accounts of the keychain vault are served read-only.
+			require.Equal(t, []string{"userID"}, b.GetUserIDs())
+
+			info, err := b.GetUserInfo("userID")
+			require.NoError(t, err)
+			require.Equal(t, "username", info.Username)
+			require.Equal(t, []string{"user@pm.me"}, info.Addresses)
+			require.Equal(t, bridge.Locked, info.State)
+
+			require.ErrorIs(t, b.DeleteUser(ctx, "userID"), bridge.ErrCredentialStoreUnavailable)
+			require.ErrorIs(t, b.LogoutUser(ctx, "userID"), bridge.ErrCredentialStoreUnavailable)
+
+			availableCh, done := b.GetEvents(events.CredentialStoreAvailable{})
+			defer done()
+
+			// Once the keychain holds the vault key again, bridge switches back to the keychain vault.
+			setKeychainVaultKey(t, keychains, vaultKey)
+
+			require.Eventually(t, func() bool {
+				mocks.Clock.Advance(time.Minute)
+
+				select {
+				case <-availableCh:
+					return true
+
+				default:
+					return false
+				}
+			}, 10*time.Second, 100*time.Millisecond)
+
+			require.False(t, b.IsCredentialStoreUnavailable())
+			require.False(t, b.GetShowAllMail())
+
+			info, err = b.GetUserInfo("userID")
+			require.NoError(t, err)
+			require.Equal(t, bridge.SignedOut, info.State)
+
+			// Logins are accepted again.
+			_, err = b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+		})
+	})
+}
+
+func TestBridge_CredentialStoreUnavailable_Migrate(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withDegradedBridge(ctx, t, s, netCtl, locator, vaultKey, func(b *bridge.Bridge, keychains *keychain.List, _ *bridge.Mocks) {
+			setKeychainVaultKey(t, keychains, vaultKey)
+
+			// Migrating moves the contents of the keychain vault into the file-based vault.
+			require.True(t, b.GetShowAllMail())
+			require.NoError(t, b.MigrateToFileVault(ctx))
+			require.False(t, b.IsCredentialStoreUnavailable())
+			require.False(t, b.GetShowAllMail())
+			require.Equal(t, []string{"userID"}, b.GetUserIDs())
+
+			vaultDir, err := locator.ProvideSettingsPath()
+			require.NoError(t, err)
+
+			useFileVault, err := vault.GetUseFileVault(vaultDir)
+			require.NoError(t, err)
+			require.True(t, useFileVault)
+
+			// Logins are accepted again.
+			_, err = b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+		})
+	})
+}
+
+// withDegradedBridge runs a bridge in degraded mode: a vault keyed in the keychain exists, holding a signed out user,
+// but the keychain doesn't hold its key.
+func withDegradedBridge(
+	ctx context.Context,
+	t *testing.T,
+	s *server.Server,
+	netCtl *proton.NetCtl,
+	locator bridge.Locator,
+	vaultKey []byte,
+	fn func(*bridge.Bridge, *keychain.List, *bridge.Mocks),
+) {
+	vaultDir, err := locator.ProvideSettingsPath()
+	require.NoError(t, err)
+
+	keychainVault, _, err := vault.New(vaultDir, t.TempDir(), vaultKey, async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, keychainVault.KeepAccounts(vault.AccountsPath(vaultDir)))
+	require.NoError(t, keychainVault.SetShowAllMail(false))
+
+	user, err := keychainVault.AddUser("userID", "username", "user@pm.me", "", "", []byte("keyPass"))
+	require.NoError(t, err)
+	require.NoError(t, user.Close())
+	require.NoError(t, keychainVault.Close())
+
+	// Bridge therefore starts on the file-based vault.
+	fileVault, _, err := vault.New(filepath.Join(vaultDir, "insecure"), t.TempDir(), nil, async.NoopPanicHandler{})
+	require.NoError(t, err)
+
+	keychains := keychain.NewTestKeychainsList()
+
+	withMocks(t, func(mocks *bridge.Mocks) {
+		mocks.ProxyCtl.EXPECT().DisallowProxy()
+
+		b, eventCh, err := bridge.NewWithOptions(locator, fileVault, v2_3_0,
+			bridge.WithAutostarter(mocks.Autostarter),
+			bridge.WithUpdater(mocks.Updater),
+			bridge.WithKeychains(keychains),
+			bridge.WithAPIURL(s.GetHostURL()),
+			bridge.WithTransport(netCtl.NewRoundTripper(&tls.Config{InsecureSkipVerify: true}), mocks.TLSReporter, mocks.ProxyCtl),
+			bridge.WithPanicHandler(mocks.CrashHandler),
+			bridge.WithReporter(mocks.Reporter),
+			bridge.WithHeartbeatManager(mocks.Heartbeat),
+			bridge.WithUIDValidityGenerator(testUIDValidityGenerator),
+			bridge.WithClock(mocks.Clock),
+		)
+		require.NoError(t, err)
+		defer b.Close(ctx)
+
+		waitForEvent(t, eventCh, events.CredentialStoreUnavailable{})
+		require.True(t, b.IsCredentialStoreUnavailable())
+
+		fn(b, keychains, mocks)
+	})
+}
+
+// setKeychainVaultKey stores the given vault key in the keychain bridge reads it from.
+func setKeychainVaultKey(t *testing.T, keychains *keychain.List, vaultKey []byte) {
+	kc, err := keychain.NewKeychain("", constants.KeyChainName, keychains.GetHelpers(), keychains.GetDefaultHelper())
+	require.NoError(t, err)
+	require.NoError(t, vault.SetVaultKey(kc, vaultKey))
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
+)
+
+const (
+	DefaultMaxDiagnosticsLogSize         = 20 * 1024 * 1024
+	DefaultMaxSessionCountForDiagnostics = 3
+
+	// maxEventHistoryLength is the number of recent events kept for the diagnostics bundle.
+	maxEventHistoryLength = 100
+)
+
+type eventRecord struct {
+	Time  time.Time `json:"time"`
+	Event string    `json:"event"`
+}
+
+// eventHistory is a bounded list of the most recently published events.
+type eventHistory struct {
+	records []eventRecord
+	lock    sync.Mutex
+}
+
+func (h *eventHistory) add(event events.Event) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if len(h.records) >= maxEventHistoryLength {
+		h.records = h.records[1:]
+	}
+
+	h.records = append(h.records, eventRecord{Time: time.Now(), Event: fmt.Sprintf("%v", event)})
+}
+
+func (h *eventHistory) get() []eventRecord {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return append([]eventRecord{}, h.records...)
+}
+
+type diagnosticsVersion struct {
+	AppName     string `json:"app_name"`
+	Version     string `json:"version"`
+	LastVersion string `json:"last_version"`
+	Revision    string `json:"revision"`
+	Tag         string `json:"tag"`
+	BuildTime   string `json:"build_time"`
+	BuildEnv    string `json:"build_env"`
+	GoVersion   string `json:"go_version"`
+	OS          string `json:"os"`
+	Arch        string `json:"arch"`
+}
+
+type diagnosticsUser struct {
+	UserID      string   `json:"user_id"`
+	Username    string   `json:"username"`
+	State       string   `json:"state"`
+	AddressMode string   `json:"address_mode"`
+	Addresses   []string `json:"addresses"`
+	UsedSpace   uint64   `json:"used_space"`
+	MaxSpace    uint64   `json:"max_space"`
+}
+
+type diagnosticsSettings struct {
+	GluonCacheDir     string            `json:"gluon_cache_dir"`
+	ProxyAllowed      bool              `json:"proxy_allowed"`
+	SOCKSProxySet     bool              `json:"socks_proxy_set"`
+	PACSourceSet      bool              `json:"pac_source_set"`
+	DoHProviders      []string          `json:"doh_providers"`
+	ShowAllMail       bool              `json:"show_all_mail"`
+	Autostart         bool              `json:"autostart"`
+	AutoUpdate        bool              `json:"auto_update"`
+	UpdateChannel     string            `json:"update_channel"`
+	TelemetryDisabled bool              `json:"telemetry_disabled"`
+	ReportingDisabled bool              `json:"reporting_disabled"`
+	MaxSyncMemory     uint64            `json:"max_sync_memory"`
+	LogLevel          string            `json:"log_level"`
+	SubsystemLevels   map[string]string `json:"subsystem_log_levels"`
+	Users             []diagnosticsUser `json:"users"`
+}
+
+type diagnosticsPort struct {
+	Port int  `json:"port"`
+	SSL  bool `json:"ssl"`
+
+	// InUse is true if the port is bound, either by bridge itself or by another process.
+	InUse bool `json:"in_use"`
+}
+
+type diagnosticsCert struct {
+	Subject     string    `json:"subject"`
+	Issuer      string    `json:"issuer"`
+	NotBefore   time.Time `json:"not_before"`
+	NotAfter    time.Time `json:"not_after"`
+	Fingerprint string    `json:"sha256_fingerprint"`
+	Error       string    `json:"error,omitempty"`
+}
+
+type diagnosticsNetwork struct {
+	IMAP diagnosticsPort `json:"imap"`
+	SMTP diagnosticsPort `json:"smtp"`
+	TLS  diagnosticsCert `json:"tls_certificate"`
+}
+
+// CollectDiagnostics returns a zip archive meant to be attached to support tickets.
+// It contains the logs, a snapshot of the settings, the version, the state of the ports and TLS certificate
+// and the recent events. Email addresses and API IDs (including message IDs) are hashed everywhere.
+func (bridge *Bridge) CollectDiagnostics() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+
+	for name, value := range map[string]any{
+		"version.json":  bridge.getDiagnosticsVersion(),
+		"settings.json": bridge.getDiagnosticsSettings(),
+		"network.json":  bridge.getDiagnosticsNetwork(),
+		"events.json":   bridge.eventHistory.get(),
+	} {
+		if err := writeDiagnosticsJSON(zw, name, value); err != nil {
+			return nil, fmt.Errorf("failed to write %v: %w", name, err)
+		}
+	}
+
+	logsPath, err := bridge.locator.ProvideLogsPath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs path: %w", err)
+	}
+
+	if err := logging.WriteRedactedLogs(zw, "logs", logsPath, DefaultMaxSessionCountForDiagnostics, DefaultMaxDiagnosticsLogSize); err != nil {
+		return nil, fmt.Errorf("failed to write logs: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// SaveDiagnostics writes the diagnostics bundle into the given folder and returns the path of the created file.
+func (bridge *Bridge) SaveDiagnostics(folderPath string) (string, error) {
+	bundle, err := bridge.CollectDiagnostics()
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(folderPath, fmt.Sprintf("bridge-diagnostics-%v.zip", time.Now().Format("20060102-150405")))
+
+	if err := os.WriteFile(path, bundle, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write diagnostics bundle: %w", err)
+	}
+
+	return path, nil
+}
+
+func (bridge *Bridge) getDiagnosticsVersion() diagnosticsVersion {
+	return diagnosticsVersion{
+		AppName:     constants.FullAppName,
+		Version:     bridge.curVersion.String(),
+		LastVersion: bridge.lastVersion.String(),
+		Revision:    constants.Revision,
+		Tag:         constants.Tag,
+		BuildTime:   constants.BuildTime,
+		BuildEnv:    constants.BuildEnv,
+		GoVersion:   runtime.Version(),
+		OS:          runtime.GOOS,
+		Arch:        runtime.GOARCH,
+	}
+}
+
+func (bridge *Bridge) getDiagnosticsSettings() diagnosticsSettings {
+	subsystemLevels := make(map[string]string)
+	for subsystem, level := range logging.GetSubsystemLevels() {
+		subsystemLevels[string(subsystem)] = level.String()
+	}
+
+	settings := diagnosticsSettings{
+		GluonCacheDir:     redactHomeDir(bridge.GetGluonCacheDir()),
+		ProxyAllowed:      bridge.GetProxyAllowed(),
+		SOCKSProxySet:     bridge.GetSOCKSProxy() != "",
+		PACSourceSet:      bridge.GetPACSource() != "",
+		DoHProviders:      bridge.GetDoHProviders(),
+		ShowAllMail:       bridge.GetShowAllMail(),
+		Autostart:         bridge.GetAutostart(),
+		AutoUpdate:        bridge.GetAutoUpdate(),
+		UpdateChannel:     string(bridge.GetUpdateChannel()),
+		TelemetryDisabled: bridge.GetTelemetryDisabled(),
+		ReportingDisabled: bridge.GetReportingDisabled(),
+		MaxSyncMemory:     bridge.GetMaxSyncMemory(),
+		LogLevel:          logging.GetLevel().String(),
+		SubsystemLevels:   subsystemLevels,
+	}
+
+	for _, userID := range bridge.GetUserIDs() {
+		info, err := bridge.GetUserInfo(userID)
+		if err != nil {
+			continue
+		}
+
+		settings.Users = append(settings.Users, diagnosticsUser{
+			UserID:      info.UserID,
+			Username:    logging.RedactValue("username", info.Username),
+			State:       getDiagnosticsUserState(info.State),
+			AddressMode: info.AddressMode.String(),
+			Addresses:   info.Addresses,
+			UsedSpace:   info.UsedSpace,
+			MaxSpace:    info.MaxSpace,
+		})
+	}
+
+	return settings
+}
+
+func getDiagnosticsUserState(state UserState) string {
+	switch state {
+	case SignedOut:
+		return "signed-out"
+	case Locked:
+		return "locked"
+	case Connected:
+		return "connected"
+	default:
+		return "unknown"
+	}
+}
+
+func (bridge *Bridge) getDiagnosticsNetwork() diagnosticsNetwork {
+	return diagnosticsNetwork{
+		IMAP: diagnosticsPort{
+			Port:  bridge.GetIMAPPort(),
+			SSL:   bridge.GetIMAPSSL(),
+			InUse: !ports.IsPortFree(bridge.GetIMAPPort()),
+		},
+		SMTP: diagnosticsPort{
+			Port:  bridge.GetSMTPPort(),
+			SSL:   bridge.GetSMTPSSL(),
+			InUse: !ports.IsPortFree(bridge.GetSMTPPort()),
+		},
+		TLS: getDiagnosticsCert(bridge.GetBridgeTLSCert()),
+	}
+}
+
+func getDiagnosticsCert(certPEM, _ []byte) diagnosticsCert {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return diagnosticsCert{Error: "no PEM certificate found"}
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return diagnosticsCert{Error: err.Error()}
+	}
+
+	fingerprint := sha256.Sum256(cert.Raw)
+
+	return diagnosticsCert{
+		Subject:     cert.Subject.String(),
+		Issuer:      cert.Issuer.String(),
+		NotBefore:   cert.NotBefore,
+		NotAfter:    cert.NotAfter,
+		Fingerprint: hex.EncodeToString(fingerprint[:]),
+	}
+}
+
+// writeDiagnosticsJSON adds the value, encoded as JSON and redacted, to the archive.
+func writeDiagnosticsJSON(zw *zip.Writer, name string, value any) error {
+	b, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write([]byte(logging.Redact(string(b))))
+
+	return err
+}
+
+// redactHomeDir replaces the user's home directory, which usually contains the user name, by ~.
+func redactHomeDir(path string) string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return path
+	}
+
+	return strings.Replace(path, home, "~", 1)
+}
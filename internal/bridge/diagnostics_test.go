@@ -0,0 +1,88 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_CollectDiagnostics(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			// Log in the user.
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			// Wait until the sync has finished.
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			// Collect the diagnostics.
+			bundle, err := b.CollectDiagnostics()
+			require.NoError(t, err)
+
+			zr, err := zip.NewReader(bytes.NewReader(bundle), int64(len(bundle)))
+			require.NoError(t, err)
+
+			files := make(map[string]string)
+			for _, f := range zr.File {
+				files[f.Name] = readZipFile(t, f)
+			}
+
+			for _, name := range []string{"version.json", "settings.json", "network.json", "events.json"} {
+				require.Contains(t, files, name)
+			}
+
+			// The user appears in the settings and the events, but its email addresses do not.
+			require.Contains(t, files["settings.json"], "connected")
+			require.Contains(t, files["events.json"], "UserLoggedIn")
+
+			for name, content := range files {
+				for _, addr := range info.Addresses {
+					require.NotContains(t, content, addr, name)
+				}
+			}
+		})
+	})
+}
+
+func readZipFile(t *testing.T, f *zip.File) string {
+	r, err := f.Open()
+	require.NoError(t, err)
+	defer func() { require.NoError(t, r.Close()) }()
+
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	return string(b)
+}
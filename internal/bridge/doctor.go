@@ -0,0 +1,373 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+)
+
+const (
+	serverCheckTimeout = 5 * time.Second
+	apiCheckTimeout    = 20 * time.Second
+)
+
+var errServerUnreachable = errors.New("server unreachable")
+
+// CheckStatus is the outcome of a configuration check.
+type CheckStatus int
+
+const (
+	CheckPassed CheckStatus = iota
+	CheckWarning
+	CheckFailed
+)
+
+func (status CheckStatus) String() string {
+	switch status {
+	case CheckPassed:
+		return "passed"
+	case CheckWarning:
+		return "warning"
+	case CheckFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// ConfigurationCheck is the result of one of the checks run by CheckConfiguration.
+type ConfigurationCheck struct {
+	Name    string
+	Status  CheckStatus
+	Details string // what was found, and how to fix it if the check didn't pass.
+}
+
+// ConfigurationReport is the result of CheckConfiguration.
+type ConfigurationReport struct {
+	Checks []ConfigurationCheck
+}
+
+// Passed returns whether none of the checks failed.
+func (report ConfigurationReport) Passed() bool {
+	for _, check := range report.Checks {
+		if check.Status == CheckFailed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CheckConfiguration diagnoses the most common reasons why email clients can't connect to bridge.
+// It checks that the IMAP and SMTP servers can be reached and are not shadowed by another application, that the TLS
+// certificate is trusted by the system and that the API can be reached, directly or through alternative routing.
+func (bridge *Bridge) CheckConfiguration(ctx context.Context) ConfigurationReport {
+	certDER := bridge.tlsCert.Load().Certificate[0]
+
+	report := ConfigurationReport{
+		Checks: []ConfigurationCheck{
+			bridge.checkServerPort(ctx, "imap-port", bridge.GetIMAPPort(), bridge.GetIMAPSSL(), imapStartTLS, certDER),
+			bridge.checkServerPort(ctx, "smtp-port", bridge.GetSMTPPort(), bridge.GetSMTPSSL(), smtpStartTLS, certDER),
+		},
+	}
+
+	if port := bridge.GetIMAPImplicitTLSPort(); port != 0 {
+		report.Checks = append(report.Checks, bridge.checkServerPort(ctx, "imap-implicit-tls-port", port, true, nil, certDER))
+	}
+
+	if port := bridge.GetSMTPImplicitTLSPort(); port != 0 {
+		report.Checks = append(report.Checks, bridge.checkServerPort(ctx, "smtp-implicit-tls-port", port, true, nil, certDER))
+	}
+
+	report.Checks = append(report.Checks, checkTLSCert(certDER, time.Now()), bridge.checkAPI(ctx))
+
+	return report
+}
+
+// checkServerPort checks that bridge answers on the given port, by comparing the certificate it presents with its own.
+func (bridge *Bridge) checkServerPort(
+	ctx context.Context,
+	name string,
+	port int,
+	implicitTLS bool,
+	startTLS func(*textproto.Conn) error,
+	certDER []byte,
+) ConfigurationCheck {
+	ctx, cancel := context.WithTimeout(ctx, serverCheckTimeout)
+	defer cancel()
+
+	host := bridge.GetBindAddress()
+	if ip := net.ParseIP(host); ip == nil || ip.IsUnspecified() {
+		host = constants.Host
+	}
+
+	mode := "STARTTLS"
+	if implicitTLS {
+		mode = "SSL"
+	}
+
+	cert, err := probeServer(ctx, net.JoinHostPort(host, strconv.Itoa(port)), implicitTLS, startTLS)
+
+	switch {
+	case errors.Is(err, errServerUnreachable):
+		return ConfigurationCheck{
+			Name:    name,
+			Status:  CheckFailed,
+			Details: fmt.Sprintf("Port %v can't be reached (%v). Check that no firewall or security software blocks it.", port, err),
+		}
+
+	case err != nil:
+		return ConfigurationCheck{
+			Name:    name,
+			Status:  CheckFailed,
+			Details: fmt.Sprintf("Port %v doesn't answer like bridge (%v). Another application probably uses it; choose another port.", port, err),
+		}
+
+	case !bytes.Equal(cert, certDER):
+		return ConfigurationCheck{
+			Name:    name,
+			Status:  CheckFailed,
+			Details: fmt.Sprintf("Port %v is used by another application, which presents another certificate; choose another port.", port),
+		}
+
+	default:
+		return ConfigurationCheck{
+			Name:    name,
+			Status:  CheckPassed,
+			Details: fmt.Sprintf("Bridge answers on port %v (%v).", port, mode),
+		}
+	}
+}
+
+// checkTLSCert checks whether email clients will trust the certificate of the IMAP and SMTP servers.
+func checkTLSCert(certDER []byte, now time.Time) ConfigurationCheck {
+	const name = "tls-certificate"
+
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return ConfigurationCheck{Name: name, Status: CheckFailed, Details: fmt.Sprintf("The certificate can't be parsed (%v).", err)}
+	}
+
+	if now.After(cert.NotAfter) {
+		return ConfigurationCheck{
+			Name:    name,
+			Status:  CheckFailed,
+			Details: fmt.Sprintf("The certificate expired on %v; reset it or provide a new one.", cert.NotAfter.Format(time.DateOnly)),
+		}
+	}
+
+	if _, err := cert.Verify(x509.VerifyOptions{}); err == nil {
+		return ConfigurationCheck{Name: name, Status: CheckPassed, Details: "The certificate is signed by an authority trusted by the system."}
+	}
+
+	installer := certs.NewInstaller()
+
+	if installer.IsCertInstalled(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})) {
+		return ConfigurationCheck{Name: name, Status: CheckPassed, Details: "The certificate is installed in the system certificate store."}
+	}
+
+	if !installer.OSSupportCertInstall() {
+		return ConfigurationCheck{
+			Name:    name,
+			Status:  CheckWarning,
+			Details: "The certificate isn't trusted by the system; email clients must be told to accept it, or to trust the exported certificate.",
+		}
+	}
+
+	return ConfigurationCheck{
+		Name:    name,
+		Status:  CheckFailed,
+		Details: "The certificate isn't trusted by the system; install it so that email clients accept it.",
+	}
+}
+
+// checkAPI checks whether the API can be reached, and whether it requires a proxy or alternative routing.
+func (bridge *Bridge) checkAPI(ctx context.Context) ConfigurationCheck {
+	const name = "api"
+
+	ctx, cancel := context.WithTimeout(ctx, apiCheckTimeout)
+	defer cancel()
+
+	directErr := dialDirectly(ctx, bridge.apiURL)
+	pingErr := bridge.api.Ping(ctx)
+
+	switch {
+	case pingErr == nil && directErr == nil:
+		return ConfigurationCheck{Name: name, Status: CheckPassed, Details: "The API is reachable directly."}
+
+	case pingErr == nil:
+		return ConfigurationCheck{
+			Name:    name,
+			Status:  CheckWarning,
+			Details: fmt.Sprintf("The API is only reachable through a proxy or alternative routing (%v).", directErr),
+		}
+
+	case directErr == nil:
+		return ConfigurationCheck{
+			Name:    name,
+			Status:  CheckFailed,
+			Details: fmt.Sprintf("The API accepts connections but requests fail (%v). A firewall or an intercepting proxy may be in the way.", pingErr),
+		}
+
+	case !bridge.GetProxyAllowed():
+		return ConfigurationCheck{
+			Name:    name,
+			Status:  CheckFailed,
+			Details: fmt.Sprintf("The API is unreachable (%v). Check your internet connection, or enable alternative routing.", pingErr),
+		}
+
+	default:
+		return ConfigurationCheck{
+			Name:    name,
+			Status:  CheckFailed,
+			Details: fmt.Sprintf("The API is unreachable, even through alternative routing (%v). Check your internet connection.", pingErr),
+		}
+	}
+}
+
+// dialDirectly opens a TCP connection to the host of the given URL, without going through any proxy.
+func dialDirectly(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "443"
+
+		if u.Scheme == "http" {
+			port = "80"
+		}
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+// probeServer connects to an IMAP or SMTP server and returns the certificate it presents.
+// Unless the server uses implicit TLS, the connection is upgraded with startTLS first.
+func probeServer(ctx context.Context, address string, implicitTLS bool, startTLS func(*textproto.Conn) error) ([]byte, error) {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errServerUnreachable, err)
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	if !implicitTLS {
+		if err := startTLS(textproto.NewConn(conn)); err != nil {
+			return nil, err
+		}
+	}
+
+	// The certificate is only compared with bridge's one, so it doesn't need to be verified.
+	tlsConn := tls.Client(conn, &tls.Config{InsecureSkipVerify: true}) //nolint:gosec
+
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return nil, fmt.Errorf("TLS handshake failed: %w", err)
+	}
+
+	peerCerts := tlsConn.ConnectionState().PeerCertificates
+	if len(peerCerts) == 0 {
+		return nil, errors.New("no certificate presented")
+	}
+
+	return peerCerts[0].Raw, nil
+}
+
+func imapStartTLS(conn *textproto.Conn) error {
+	greeting, err := conn.ReadLine()
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(greeting, "* OK") {
+		return fmt.Errorf("unexpected IMAP greeting %q", greeting)
+	}
+
+	if err := conn.PrintfLine("A1 STARTTLS"); err != nil {
+		return err
+	}
+
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return err
+		}
+
+		if !strings.HasPrefix(line, "A1 ") {
+			continue
+		}
+
+		if !strings.HasPrefix(line, "A1 OK") {
+			return fmt.Errorf("STARTTLS refused: %q", line)
+		}
+
+		return nil
+	}
+}
+
+func smtpStartTLS(conn *textproto.Conn) error {
+	if _, _, err := conn.ReadResponse(220); err != nil {
+		return err
+	}
+
+	if err := conn.PrintfLine("EHLO localhost"); err != nil {
+		return err
+	}
+
+	if _, _, err := conn.ReadResponse(250); err != nil {
+		return err
+	}
+
+	if err := conn.PrintfLine("STARTTLS"); err != nil {
+		return err
+	}
+
+	if _, _, err := conn.ReadResponse(220); err != nil {
+		return fmt.Errorf("STARTTLS refused: %w", err)
+	}
+
+	return nil
+}
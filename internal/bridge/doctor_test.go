@@ -0,0 +1,61 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_CheckConfiguration(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			statuses := func() map[string]bridge.CheckStatus {
+				res := make(map[string]bridge.CheckStatus)
+
+				for _, check := range b.CheckConfiguration(ctx).Checks {
+					res[check.Name] = check.Status
+				}
+
+				return res
+			}
+
+			// Bridge answers on its ports and the API is reachable.
+			checks := statuses()
+			require.Equal(t, bridge.CheckPassed, checks["imap-port"])
+			require.Equal(t, bridge.CheckPassed, checks["smtp-port"])
+			require.Equal(t, bridge.CheckPassed, checks["api"])
+			require.Contains(t, checks, "tls-certificate")
+
+			// The API requests fail.
+			netCtl.Disable()
+			require.Equal(t, bridge.CheckFailed, statuses()["api"])
+			netCtl.Enable()
+
+			// Bridge is also recognized when it uses implicit TLS.
+			require.NoError(t, b.SetSMTPSSL(ctx, true))
+			require.Eventually(t, func() bool { return statuses()["smtp-port"] == bridge.CheckPassed }, 10*time.Second, 100*time.Millisecond)
+		})
+	})
+}
@@ -30,4 +30,38 @@ var (
 	ErrNotImplemented      = errors.New("not implemented")
 
 	ErrSizeTooLarge = errors.New("file is too big")
+
+	ErrEphemeralStore = errors.New("the message store is ephemeral")
+
+	ErrInvalidImplicitTLSPort = errors.New("invalid implicit TLS port")
+
+	ErrInvalidBindAddress         = errors.New("invalid bind address")
+	ErrBindAddressNotAcknowledged = errors.New("listening on a non-loopback address must be acknowledged")
+
+	ErrNoSuchDoHProvider = errors.New("no such DoH provider")
+
+	ErrInvalidAutoLockTimeout  = errors.New("invalid auto-lock timeout")
+	ErrNoUnlockPassphrase      = errors.New("no unlock passphrase is set")
+	ErrInvalidUnlockPassphrase = errors.New("invalid unlock passphrase")
+	ErrBridgeLocked            = errors.New("bridge is locked")
+	ErrKeyRotationInProgress   = errors.New("encryption keys are being rotated")
+
+	ErrCredentialStoreUnavailable = errors.New("the keychain is unavailable")
+
+	ErrInvalidAppPassword = errors.New("invalid app password name")
+	ErrAppPasswordExists  = errors.New("an app password with this name already exists")
+	ErrNoSuchAppPassword  = errors.New("no such app password")
+
+	ErrInvalidAuthTokenValidity = errors.New("invalid auth token validity")
+
+	ErrNoReadReceiptRequested = errors.New("the message did not request a read receipt")
+
+	ErrInvalidSyncCutoff = errors.New("the sync cutoff can't be in the future")
+
+	ErrInvalidWebhook = errors.New("invalid webhook")
+	ErrNoSuchWebhook  = errors.New("no such webhook")
+
+	ErrUpdateNotNewer     = errors.New("the update is not newer than the current version")
+	ErrUpdateNotRolledOut = errors.New("the update has not been rolled out yet")
+	ErrUpdateIncompatible = errors.New("the update can't be installed automatically over this version")
 )
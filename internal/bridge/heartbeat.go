@@ -116,7 +116,7 @@ func (h *heartBeatState) stop() {
 
 func (bridge *Bridge) IsTelemetryAvailable(ctx context.Context) bool {
 	var flag = true
-	if bridge.GetTelemetryDisabled() {
+	if bridge.GetTelemetryDisabled() || telemetry.IsReportingDisabled() {
 		return false
 	}
 
@@ -20,6 +20,7 @@ package bridge
 import (
 	"context"
 	"crypto/tls"
+	"io"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
@@ -38,6 +39,8 @@ func (bridge *Bridge) restartIMAP(ctx context.Context) error {
 func (bridge *Bridge) handleIMAPEvent(event imapEvents.Event) {
 	log := logrus.WithField("pkg", "bridge/event/imap")
 
+	bridge.auditIMAPEvent(event)
+
 	switch event := event.(type) {
 	case imapEvents.UserAdded:
 		for labelID, count := range event.Counts {
@@ -94,6 +97,10 @@ func (b *bridgeIMAPSettings) LogServer() bool {
 	return b.b.logIMAPServer
 }
 
+func (b *bridgeIMAPSettings) SessionAudit() io.Writer {
+	return &b.b.sessionAudit
+}
+
 func (b *bridgeIMAPSettings) DisableIMAPAuthenticate() bool {
 	return b.b.unleashService.GetFlagValue(unleash.IMAPAuthenticateCommandDisabled)
 }
@@ -106,14 +113,30 @@ func (b *bridgeIMAPSettings) SetPort(i int) error {
 	return b.b.vault.SetIMAPPort(i)
 }
 
+func (b *bridgeIMAPSettings) BindAddress() string {
+	return b.b.GetBindAddress()
+}
+
 func (b *bridgeIMAPSettings) UseSSL() bool {
-	return b.b.vault.GetIMAPSSL()
+	return b.b.GetIMAPSSL()
+}
+
+func (b *bridgeIMAPSettings) ImplicitTLSPort() int {
+	return b.b.vault.GetIMAPImplicitTLSPort()
 }
 
 func (b *bridgeIMAPSettings) CacheDirectory() string {
 	return b.b.GetGluonCacheDir()
 }
 
+func (b *bridgeIMAPSettings) MaxCacheSize() uint64 {
+	return b.b.vault.GetMaxCacheSize()
+}
+
+func (b *bridgeIMAPSettings) KeepRecentDays() uint32 {
+	return b.b.vault.GetKeepRecentDays()
+}
+
 func (b *bridgeIMAPSettings) DataDirectory() (string, error) {
 	return b.b.GetGluonDataDir()
 }
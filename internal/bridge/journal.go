@@ -0,0 +1,53 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"fmt"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/eventjournal"
+)
+
+// GetUserEventJournal returns the journal of the events processed for the given user, from the oldest to the most
+// recent, i.e. which messages and labels were created, moved or deleted and by which event.
+// The journal of a user who is signed out is read from disk.
+func (bridge *Bridge) GetUserEventJournal(userID string) ([]eventjournal.Entry, error) {
+	entries, err := safe.RLockRetErr(func() ([]eventjournal.Entry, error) {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return nil, ErrNoSuchUser
+		}
+
+		return user.GetEventJournal(), nil
+	}, bridge.usersLock)
+	if err == nil {
+		return entries, nil
+	}
+
+	if !bridge.vault.HasUser(userID) {
+		return nil, ErrNoSuchUser
+	}
+
+	journalDir, err := bridge.locator.ProvideEventJournalPath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event journal path: %w", err)
+	}
+
+	return eventjournal.Open(journalDir, userID).Entries(), nil
+}
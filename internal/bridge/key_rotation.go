@@ -0,0 +1,147 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"golang.org/x/exp/maps"
+)
+
+// encryptionKeyLength is the length of the generated vault and gluon store keys.
+const encryptionKeyLength = 32
+
+// RotateEncryptionKeys replaces the vault key and the keys of the users' gluon stores with new random keys.
+// The vault and the cached message bodies are re-encrypted in the background; the users are unloaded while their bodies
+// are re-encrypted and loaded again afterwards. events.KeyRotationFinished is published once done.
+func (bridge *Bridge) RotateEncryptionKeys() error {
+	if bridge.locked.Load() {
+		return ErrBridgeLocked
+	}
+
+	if !bridge.rotatingKeys.CompareAndSwap(false, true) {
+		return ErrKeyRotationInProgress
+	}
+
+	logPkg.Info("Rotating encryption keys")
+
+	bridge.publish(events.KeyRotationStarted{})
+
+	bridge.tasks.Once(func(ctx context.Context) {
+		defer bridge.rotatingKeys.Store(false)
+
+		err := bridge.rotateKeys(ctx)
+		if err != nil {
+			logPkg.WithError(err).Error("Failed to rotate encryption keys")
+		} else {
+			logPkg.Info("Encryption keys rotated")
+		}
+
+		bridge.publish(events.KeyRotationFinished{Error: err})
+	})
+
+	return nil
+}
+
+// IsRotatingEncryptionKeys returns whether the encryption keys are being rotated.
+func (bridge *Bridge) IsRotatingEncryptionKeys() bool {
+	return bridge.rotatingKeys.Load()
+}
+
+func (bridge *Bridge) rotateKeys(ctx context.Context) error {
+	bridge.keysLock.Lock()
+	defer bridge.keysLock.Unlock()
+
+	// Bridge may have been locked since the rotation was requested.
+	if bridge.locked.Load() {
+		return ErrBridgeLocked
+	}
+
+	if err := bridge.rotateVaultKey(); err != nil {
+		return fmt.Errorf("failed to rotate vault key: %w", err)
+	}
+
+	// The users are unloaded so that the IMAP server closes their stores; they are not served in the meantime.
+	bridge.usersUnloaded.Store(true)
+
+	defer func() {
+		bridge.usersUnloaded.Store(false)
+		bridge.goLoad()
+	}()
+
+	bridge.unloadUsers(ctx)
+
+	for _, userID := range bridge.vault.GetUserIDs() {
+		var rotateErr error
+
+		if err := bridge.vault.GetUser(userID, func(user *vault.User) {
+			rotateErr = bridge.rotateGluonKey(user)
+		}); err != nil {
+			return fmt.Errorf("failed to get user %v: %w", userID, err)
+		}
+
+		if rotateErr != nil {
+			return fmt.Errorf("failed to rotate gluon key of user %v: %w", userID, rotateErr)
+		}
+	}
+
+	return nil
+}
+
+// rotateVaultKey re-encrypts the vault with a new key and stores it in the keychain.
+// A vault which isn't protected by the keychain, because none was usable on startup, is left as is.
+func (bridge *Bridge) rotateVaultKey() error {
+	if bridge.vault.CheckKey(nil) {
+		logPkg.Warn("Vault key is not stored in the keychain, not rotating it")
+		return nil
+	}
+
+	kc, err := bridge.getKeychain()
+	if err != nil {
+		return err
+	}
+
+	key, err := vault.RandomToken(encryptionKeyLength)
+	if err != nil {
+		return fmt.Errorf("could not generate vault key: %w", err)
+	}
+
+	return bridge.vault.Rekey(key, func() error {
+		return vault.SetVaultKey(kc, key)
+	})
+}
+
+// rotateGluonKey re-encrypts the user's gluon stores with a new key. The user must not be loaded.
+func (bridge *Bridge) rotateGluonKey(user *vault.User) error {
+	key, err := vault.RandomToken(encryptionKeyLength)
+	if err != nil {
+		return fmt.Errorf("could not generate gluon key: %w", err)
+	}
+
+	return imapsmtpserver.RekeyGluonStores(
+		bridge.GetGluonCacheDir(),
+		maps.Values(user.GetGluonIDs()),
+		user.GluonKey(),
+		key,
+		func() error { return user.SetGluonKey(key) },
+	)
+}
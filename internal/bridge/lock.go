@@ -0,0 +1,231 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+)
+
+// autoLockCheckInterval is how often bridge checks whether it has been inactive for long enough to lock itself.
+const autoLockCheckInterval = time.Minute
+
+// GetAutoLockTimeout returns the inactivity after which bridge locks itself; zero means never.
+func (bridge *Bridge) GetAutoLockTimeout() time.Duration {
+	return bridge.vault.GetAutoLockTimeout()
+}
+
+// SetAutoLockTimeout sets the inactivity after which bridge locks itself; zero means never.
+// An unlock passphrase must be set first, otherwise bridge couldn't be unlocked.
+func (bridge *Bridge) SetAutoLockTimeout(timeout time.Duration) error {
+	if timeout < 0 {
+		return ErrInvalidAutoLockTimeout
+	}
+
+	if timeout > 0 && !bridge.vault.HasUnlockPassphrase() {
+		return ErrNoUnlockPassphrase
+	}
+
+	// Don't lock right away because of the inactivity preceding the change.
+	bridge.NotifyActivity()
+
+	return bridge.vault.SetAutoLockTimeout(timeout)
+}
+
+// HasUnlockPassphrase returns whether a passphrase to unlock bridge is set.
+func (bridge *Bridge) HasUnlockPassphrase() bool {
+	return bridge.vault.HasUnlockPassphrase()
+}
+
+// SetUnlockPassphrase sets the passphrase which unlocks bridge; an empty passphrase removes it.
+// Bridge can't be locked without one, so removing it also disables auto-lock.
+func (bridge *Bridge) SetUnlockPassphrase(passphrase []byte) error {
+	if bridge.locked.Load() {
+		return ErrBridgeLocked
+	}
+
+	if len(passphrase) == 0 {
+		if err := bridge.vault.SetAutoLockTimeout(0); err != nil {
+			return fmt.Errorf("failed to disable auto-lock: %w", err)
+		}
+	}
+
+	return bridge.vault.SetUnlockPassphrase(passphrase)
+}
+
+// NotifyActivity records that a client is using bridge, which postpones auto-lock.
+func (bridge *Bridge) NotifyActivity() {
+	bridge.lastActivity.Store(bridge.clock.Now().UnixNano())
+}
+
+// IsLocked returns whether bridge is locked, i.e. doesn't serve its users until unlocked.
+func (bridge *Bridge) IsLocked() bool {
+	return bridge.locked.Load()
+}
+
+// Lock unloads all users, dropping their decrypted keys, and stops serving them over IMAP and SMTP until Unlock is called.
+// The vault key is dropped too, so the users' credentials can't be read from memory and settings can't be changed.
+// The users stay logged in: their data is kept and they are loaded again from the vault when bridge is unlocked.
+// An unlock passphrase must be set first.
+func (bridge *Bridge) Lock(ctx context.Context) error {
+	if !bridge.vault.HasUnlockPassphrase() {
+		return ErrNoUnlockPassphrase
+	}
+
+	bridge.lock(ctx, false)
+
+	return nil
+}
+
+// Unlock loads the users again after bridge was locked. The given passphrase must be the unlock passphrase; the vault
+// key is then read from the keychain again, which may require the user to authenticate to the OS.
+func (bridge *Bridge) Unlock(passphrase []byte) error {
+	if !bridge.locked.Load() {
+		return nil
+	}
+
+	bridge.keysLock.Lock()
+	defer bridge.keysLock.Unlock()
+
+	if !bridge.locked.Load() {
+		return nil
+	}
+
+	if !bridge.vault.CheckUnlockPassphrase(passphrase) {
+		logPkg.Warn("Failed to unlock bridge with an invalid passphrase")
+		return ErrInvalidUnlockPassphrase
+	}
+
+	key, err := bridge.getVaultKey()
+	if err != nil {
+		return fmt.Errorf("failed to get vault key: %w", err)
+	}
+
+	if err := bridge.vault.Unlock(key); err != nil {
+		return fmt.Errorf("failed to unlock vault: %w", err)
+	}
+
+	logPkg.Info("Unlocking bridge")
+
+	bridge.NotifyActivity()
+
+	bridge.locked.Store(false)
+
+	bridge.publish(events.BridgeUnlocked{})
+
+	bridge.goLoad()
+
+	return nil
+}
+
+func (bridge *Bridge) lock(ctx context.Context, auto bool) {
+	bridge.keysLock.Lock()
+	defer bridge.keysLock.Unlock()
+
+	if !bridge.locked.CompareAndSwap(false, true) {
+		return
+	}
+
+	logPkg.WithField("auto", auto).Info("Locking bridge")
+
+	bridge.unloadUsers(ctx)
+
+	if err := bridge.vault.Lock(); err != nil {
+		logPkg.WithError(err).Error("Failed to lock vault")
+	}
+
+	bridge.publish(events.BridgeLocked{AutoLocked: auto})
+}
+
+// unloadUsers removes all users from the IMAP and SMTP servers and drops them, keeping their data in the vault.
+func (bridge *Bridge) unloadUsers(ctx context.Context) {
+	safe.Lock(func() {
+		for userID, user := range bridge.users {
+			if err := user.Lock(ctx); err != nil {
+				logUser.WithField("userID", userID).WithError(err).Error("Failed to lock user")
+			}
+
+			user.Close()
+
+			delete(bridge.users, userID)
+		}
+
+		bridge.heartbeat.SetNumberConnectedAccounts(0)
+	}, bridge.usersLock)
+}
+
+// checkAutoLock locks bridge if auto-lock is enabled and no client has used it for long enough.
+func (bridge *Bridge) checkAutoLock(ctx context.Context) {
+	timeout := bridge.vault.GetAutoLockTimeout()
+	if timeout == 0 || bridge.locked.Load() {
+		return
+	}
+
+	if bridge.clock.Now().Sub(time.Unix(0, bridge.lastActivity.Load())) < timeout {
+		return
+	}
+
+	bridge.lock(ctx, true)
+}
+
+// getVaultKey returns the key the vault is encrypted with: none for a vault which isn't protected by the keychain,
+// otherwise the one stored in the keychain.
+func (bridge *Bridge) getVaultKey() ([]byte, error) {
+	if bridge.vault.CheckKey(nil) {
+		return nil, nil
+	}
+
+	return bridge.getKeychainVaultKey()
+}
+
+// getKeychainVaultKey reads the vault key from the keychain, the same way it was read on startup.
+func (bridge *Bridge) getKeychainVaultKey() ([]byte, error) {
+	kc, err := bridge.getKeychain()
+	if err != nil {
+		return nil, err
+	}
+
+	return vault.GetVaultKey(kc)
+}
+
+// getKeychain returns the keychain holding the vault key.
+func (bridge *Bridge) getKeychain() (*keychain.Keychain, error) {
+	vaultDir, err := bridge.locator.ProvideSettingsPath()
+	if err != nil {
+		return nil, fmt.Errorf("could not get vault dir: %w", err)
+	}
+
+	helper, err := vault.GetHelper(vaultDir)
+	if err != nil {
+		return nil, fmt.Errorf("could not get keychain helper: %w", err)
+	}
+
+	kc, err := keychain.NewKeychain(helper, constants.KeyChainName, bridge.keychains.GetHelpers(), bridge.keychains.GetDefaultHelper())
+	if err != nil {
+		return nil, fmt.Errorf("could not create keychain: %w", err)
+	}
+
+	return kc, nil
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	go_imap "github.com/emersion/go-imap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_Lock(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		keychains := keychain.NewTestKeychainsList()
+
+		withBridgeOptions(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			lockCh, done := b.GetEvents(events.BridgeLocked{}, events.BridgeUnlocked{})
+			defer done()
+
+			// Bridge can't be locked, nor lock itself, until an unlock passphrase is set.
+			require.False(t, b.HasUnlockPassphrase())
+			require.ErrorIs(t, b.Lock(ctx), bridge.ErrNoUnlockPassphrase)
+			require.ErrorIs(t, b.SetAutoLockTimeout(30*time.Minute), bridge.ErrNoUnlockPassphrase)
+			require.False(t, b.IsLocked())
+
+			require.NoError(t, b.SetUnlockPassphrase([]byte("passphrase")))
+			require.True(t, b.HasUnlockPassphrase())
+
+			// Auto-lock is disabled by default and can't be negative.
+			require.Zero(t, b.GetAutoLockTimeout())
+			require.ErrorIs(t, b.SetAutoLockTimeout(-time.Minute), bridge.ErrInvalidAutoLockTimeout)
+			require.NoError(t, b.SetAutoLockTimeout(30*time.Minute))
+			require.Equal(t, 30*time.Minute, b.GetAutoLockTimeout())
+
+			// The vault key is read from the keychain again on unlock.
+			kc, err := keychain.NewKeychain(keychains.GetDefaultHelper(), constants.KeyChainName, keychains.GetHelpers(), keychains.GetDefaultHelper())
+			require.NoError(t, err)
+			require.NoError(t, vault.SetVaultKey(kc, storeKey))
+
+			// Once locked, the user can no longer log in over IMAP.
+			require.NoError(t, b.Lock(ctx))
+			require.True(t, b.IsLocked())
+			require.Equal(t, events.BridgeLocked{}, <-lockCh)
+
+			client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.Error(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+			require.NoError(t, client.Logout())
+
+			// The passphrase can't be changed while locked.
+			require.ErrorIs(t, b.SetUnlockPassphrase([]byte("other passphrase")), bridge.ErrBridgeLocked)
+
+			// Only the unlock passphrase unlocks bridge; the vault key doesn't.
+			require.ErrorIs(t, b.Unlock([]byte("not the passphrase")), bridge.ErrInvalidUnlockPassphrase)
+			require.ErrorIs(t, b.Unlock(storeKey), bridge.ErrInvalidUnlockPassphrase)
+			require.True(t, b.IsLocked())
+
+			require.NoError(t, b.Unlock([]byte("passphrase")))
+			require.False(t, b.IsLocked())
+			require.Equal(t, events.BridgeUnlocked{}, <-lockCh)
+
+			// The user is loaded again and can log in.
+			require.Eventually(t, func() bool {
+				info, err := b.GetUserInfo(userID)
+				return err == nil && info.State == bridge.Connected
+			}, 10*time.Second, 100*time.Millisecond)
+
+			client, err = eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.NoError(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+			require.NoError(t, client.Logout())
+
+			// Removing the passphrase disables auto-lock.
+			require.NoError(t, b.SetUnlockPassphrase(nil))
+			require.False(t, b.HasUnlockPassphrase())
+			require.Zero(t, b.GetAutoLockTimeout())
+		}, bridge.WithKeychains(keychains))
+	})
+}
+
+func TestBridge_AutoLock(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridgeOptions(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			require.NoError(t, b.SetUnlockPassphrase([]byte("passphrase")))
+			require.NoError(t, b.SetAutoLockTimeout(30*time.Minute))
+
+			// Bridge doesn't lock while it is in use.
+			mocks.Clock.Advance(20 * time.Minute)
+			b.NotifyActivity()
+			mocks.Clock.Advance(20 * time.Minute)
+			require.False(t, b.IsLocked())
+
+			// Bridge locks itself once it has been inactive for long enough.
+			require.Eventually(t, func() bool {
+				mocks.Clock.Advance(time.Minute)
+				return b.IsLocked()
+			}, 10*time.Second, 100*time.Millisecond)
+		})
+	})
+}
+
+func TestBridge_RotateEncryptionKeys(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		userID, addrID, err := s.CreateUser("imap", password)
+		require.NoError(t, err)
+
+		labelID, err := s.CreateLabel(userID, "folder", "", proton.LabelTypeFolder)
+		require.NoError(t, err)
+
+		withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+			createNumMessages(ctx, t, c, addrID, labelID, 10)
+		})
+
+		keychains := keychain.NewTestKeychainsList()
+
+		withBridgeOptions(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			require.NoError(t, b.SetIMAPPort(ctx, 0))
+
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, "imap", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			// Fetch all bodies so they are cached.
+			before := fetchBodies(t, b, info)
+			require.Len(t, before, 10)
+
+			rotateCh, done := chToType[events.Event, events.KeyRotationFinished](b.GetEvents(events.KeyRotationFinished{}))
+			defer done()
+
+			require.NoError(t, b.RotateEncryptionKeys())
+
+			// Bridge isn't reported as locked while its users are unloaded to re-encrypt their stores.
+			require.False(t, b.IsLocked())
+			require.NoError(t, (<-rotateCh).Error)
+			require.False(t, b.IsLocked())
+			require.False(t, b.IsRotatingEncryptionKeys())
+
+			// The vault key changed; the new one is stored in the keychain.
+			kc, err := keychain.NewKeychain(keychains.GetDefaultHelper(), constants.KeyChainName, keychains.GetHelpers(), keychains.GetDefaultHelper())
+			require.NoError(t, err)
+
+			newKey, err := vault.GetVaultKey(kc)
+			require.NoError(t, err)
+
+			require.NotEqual(t, vaultKey, newKey)
+
+			// Unlocking reads the new key from the keychain.
+			require.NoError(t, b.SetUnlockPassphrase([]byte("passphrase")))
+			require.NoError(t, b.Lock(ctx))
+			require.NoError(t, b.Unlock([]byte("passphrase")))
+
+			require.Eventually(t, func() bool {
+				info, err := b.GetUserInfo(userID)
+				return err == nil && info.State == bridge.Connected
+			}, 10*time.Second, 100*time.Millisecond)
+
+			// The cached bodies were re-encrypted and are still served.
+			require.Equal(t, before, fetchBodies(t, b, info))
+		}, bridge.WithKeychains(keychains))
+	})
+}
+
+func fetchBodies(t *testing.T, b *bridge.Bridge, info bridge.UserInfo) []string {
+	client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+	require.NoError(t, err)
+	require.NoError(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+	defer func() { _ = client.Logout() }()
+
+	messages, err := clientFetch(client, `Folders/folder`)
+	require.NoError(t, err)
+
+	bodies := make([]string, 0, len(messages))
+
+	for _, message := range messages {
+		literal, err := io.ReadAll(message.GetBody(must(go_imap.ParseBodySectionName("BODY[]"))))
+		require.NoError(t, err)
+
+		bodies = append(bodies, string(literal))
+	}
+
+	return bodies
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge/mocks"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 	"github.com/golang/mock/gomock"
 )
@@ -26,6 +27,9 @@ type Mocks struct {
 	CrashHandler *mocks.MockPanicHandler
 	Reporter     *mocks.MockReporter
 	Heartbeat    *mocks.MockHeartbeatManager
+
+	// Clock is only used by bridges created with it, e.g. with WithClock.
+	Clock *clock.Fake
 }
 
 func NewMocks(tb testing.TB, version, minAuto *semver.Version) *Mocks {
@@ -42,6 +46,8 @@ func NewMocks(tb testing.TB, version, minAuto *semver.Version) *Mocks {
 		CrashHandler: mocks.NewMockPanicHandler(ctl),
 		Reporter:     mocks.NewMockReporter(ctl),
 		Heartbeat:    mocks.NewMockHeartbeatManager(ctl),
+
+		Clock: clock.NewFake(time.Now()),
 	}
 
 	// When getting the TLS issue channel, we want to return the test channel.
@@ -119,8 +125,10 @@ func (provider *TestLocationsProvider) UserCache() string {
 }
 
 type TestUpdater struct {
-	latest updater.VersionInfo
-	lock   sync.RWMutex
+	latest   updater.VersionInfo
+	previous *semver.Version
+	updater  *updater.Updater
+	lock     sync.RWMutex
 }
 
 func NewTestUpdater(version, minAuto *semver.Version) *TestUpdater {
@@ -146,17 +154,51 @@ func (testUpdater *TestUpdater) SetLatestVersion(version, minAuto *semver.Versio
 	}
 }
 
-func (testUpdater *TestUpdater) GetVersionInfo(_ context.Context, _ updater.Downloader, _ updater.Channel) (updater.VersionInfo, error) {
+// SetUpdater makes the test updater get and install versions with the given updater, through the downloader it is given.
+func (testUpdater *TestUpdater) SetUpdater(updater *updater.Updater) {
+	testUpdater.lock.Lock()
+	defer testUpdater.lock.Unlock()
+
+	testUpdater.updater = updater
+}
+
+func (testUpdater *TestUpdater) GetVersionInfo(ctx context.Context, downloader updater.Downloader, channel updater.Channel) (updater.VersionInfo, error) {
 	testUpdater.lock.RLock()
 	defer testUpdater.lock.RUnlock()
 
+	if testUpdater.updater != nil {
+		return testUpdater.updater.GetVersionInfo(ctx, downloader, channel)
+	}
+
 	return testUpdater.latest, nil
 }
 
-func (testUpdater *TestUpdater) InstallUpdate(_ context.Context, _ updater.Downloader, _ updater.VersionInfo) error {
+func (testUpdater *TestUpdater) InstallUpdate(ctx context.Context, downloader updater.Downloader, version updater.VersionInfo) error {
+	testUpdater.lock.RLock()
+	defer testUpdater.lock.RUnlock()
+
+	if testUpdater.updater != nil {
+		return testUpdater.updater.InstallUpdate(ctx, downloader, version)
+	}
+
 	return nil
 }
 
 func (testUpdater *TestUpdater) RemoveOldUpdates() error {
 	return nil
 }
+
+// SetPreviousVersion sets the version a rollback returns to; nil stands for the base installed version.
+func (testUpdater *TestUpdater) SetPreviousVersion(version *semver.Version) {
+	testUpdater.lock.Lock()
+	defer testUpdater.lock.Unlock()
+
+	testUpdater.previous = version
+}
+
+func (testUpdater *TestUpdater) Rollback(_ *semver.Version) (*semver.Version, error) {
+	testUpdater.lock.RLock()
+	defer testUpdater.lock.RUnlock()
+
+	return testUpdater.previous, nil
+}
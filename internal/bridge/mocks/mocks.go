@@ -94,6 +94,60 @@ func (mr *MockProxyControllerMockRecorder) DisallowProxy() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisallowProxy", reflect.TypeOf((*MockProxyController)(nil).DisallowProxy))
 }
 
+// ReloadPAC mocks base method.
+func (m *MockProxyController) ReloadPAC() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReloadPAC")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReloadPAC indicates an expected call of ReloadPAC.
+func (mr *MockProxyControllerMockRecorder) ReloadPAC() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadPAC", reflect.TypeOf((*MockProxyController)(nil).ReloadPAC))
+}
+
+// SetDoHProviders mocks base method.
+func (m *MockProxyController) SetDoHProviders(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDoHProviders", arg0)
+}
+
+// SetDoHProviders indicates an expected call of SetDoHProviders.
+func (mr *MockProxyControllerMockRecorder) SetDoHProviders(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDoHProviders", reflect.TypeOf((*MockProxyController)(nil).SetDoHProviders), arg0)
+}
+
+// SetPACSource mocks base method.
+func (m *MockProxyController) SetPACSource(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPACSource", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPACSource indicates an expected call of SetPACSource.
+func (mr *MockProxyControllerMockRecorder) SetPACSource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPACSource", reflect.TypeOf((*MockProxyController)(nil).SetPACSource), arg0)
+}
+
+// SetSOCKSProxy mocks base method.
+func (m *MockProxyController) SetSOCKSProxy(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSOCKSProxy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSOCKSProxy indicates an expected call of SetSOCKSProxy.
+func (mr *MockProxyControllerMockRecorder) SetSOCKSProxy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSOCKSProxy", reflect.TypeOf((*MockProxyController)(nil).SetSOCKSProxy), arg0)
+}
+
 // MockAutostarter is a mock of Autostarter interface.
 type MockAutostarter struct {
 	ctrl     *gomock.Controller
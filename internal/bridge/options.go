@@ -0,0 +1,283 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/http/cookiejar"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/gluon/reporter"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/dialer"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/identifier"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
+	"github.com/ProtonMail/proton-bridge/v3/internal/telemetry"
+	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+)
+
+// Option configures a bridge created with NewWithOptions.
+type Option func(*options)
+
+type options struct {
+	autostarter Autostarter
+	updater     Updater
+	keychains   *keychain.List
+
+	apiURL       string
+	insecureAPI  bool
+	cookieJar    http.CookieJar
+	identifier   identifier.Identifier
+	roundTripper http.RoundTripper
+	tlsReporter  TLSReporter
+	proxyCtl     ProxyController
+
+	panicHandler         async.PanicHandler
+	reporter             reporter.Reporter
+	uidValidityGenerator imap.UIDValidityGenerator
+	heartbeatManager     telemetry.HeartbeatManager
+
+	tlsCert     *tls.Certificate
+	listen      imapsmtpserver.ListenFunc
+	subscribers []eventSubscriber
+	clock       clock.Clock
+
+	logIMAPClient, logIMAPServer, logSMTP bool
+}
+
+type eventSubscriber struct {
+	handler func(events.Event)
+	ofType  []events.Event
+}
+
+// WithAutostarter sets the autostarter managing the autostart setting; by default, autostart can't be enabled.
+func WithAutostarter(autostarter Autostarter) Option {
+	return func(o *options) { o.autostarter = autostarter }
+}
+
+// WithUpdater sets the updater fetching and installing updates; by default, there are no updates.
+func WithUpdater(updater Updater) Option {
+	return func(o *options) { o.updater = updater }
+}
+
+// WithKeychains sets the keychains the vault key can be stored in; by default, those found in the OS.
+func WithKeychains(keychains *keychain.List) Option {
+	return func(o *options) { o.keychains = keychains }
+}
+
+// WithAPIURL sets the URL of the API to use; by default, the production API.
+func WithAPIURL(apiURL string) Option {
+	return func(o *options) { o.apiURL = apiURL }
+}
+
+// WithInsecureAPIURL stops the certificate of the API set with WithAPIURL from being verified, e.g. for a test server.
+// It has no effect on the production API, whose certificates are pinned, nor with a custom transport.
+func WithInsecureAPIURL() Option {
+	return func(o *options) { o.insecureAPI = true }
+}
+
+// WithCookieJar sets the cookie jar of the API requests; by default, cookies are kept in memory.
+func WithCookieJar(cookieJar http.CookieJar) Option {
+	return func(o *options) { o.cookieJar = cookieJar }
+}
+
+// WithIdentifier sets the identifier keeping track of the user agent.
+func WithIdentifier(identifier identifier.Identifier) Option {
+	return func(o *options) { o.identifier = identifier }
+}
+
+// WithTransport sets the round tripper used for API requests, along with the TLS reporter and the proxy controller
+// of its underlying dialer. By default, the production API is only reached through connections presenting pinned
+// certificates, switching to a proxy if it is blocked and alternative routing is allowed; other API hosts are
+// reached directly, without pinning.
+func WithTransport(roundTripper http.RoundTripper, tlsReporter TLSReporter, proxyCtl ProxyController) Option {
+	return func(o *options) {
+		o.roundTripper = roundTripper
+		o.tlsReporter = tlsReporter
+		o.proxyCtl = proxyCtl
+	}
+}
+
+// WithPanicHandler sets the handler of the panics of the bridge's goroutines; by default, panics aren't recovered.
+func WithPanicHandler(panicHandler async.PanicHandler) Option {
+	return func(o *options) { o.panicHandler = panicHandler }
+}
+
+// WithReporter sets the reporter of crashes and errors; by default, nothing is reported.
+func WithReporter(reporter reporter.Reporter) Option {
+	return func(o *options) { o.reporter = reporter }
+}
+
+// WithUIDValidityGenerator sets the generator of the UIDVALIDITY of the IMAP mailboxes.
+func WithUIDValidityGenerator(generator imap.UIDValidityGenerator) Option {
+	return func(o *options) { o.uidValidityGenerator = generator }
+}
+
+// WithHeartbeatManager sets the manager of the telemetry heartbeat; by default, bridge manages it itself.
+func WithHeartbeatManager(heartbeatManager telemetry.HeartbeatManager) Option {
+	return func(o *options) { o.heartbeatManager = heartbeatManager }
+}
+
+// WithTLSCertificate sets the certificate served by the IMAP and SMTP servers instead of the one stored in the vault.
+func WithTLSCertificate(cert tls.Certificate) Option {
+	return func(o *options) { o.tlsCert = &cert }
+}
+
+// WithListenFunc sets the function creating the listeners of the IMAP and SMTP servers; by default, net.Listen.
+func WithListenFunc(listen imapsmtpserver.ListenFunc) Option {
+	return func(o *options) { o.listen = listen }
+}
+
+// WithEventSubscriber calls the given handler with every event of the given types, or every event if none is given,
+// starting with those published while bridge starts. The handler is called from a single goroutine.
+func WithEventSubscriber(handler func(events.Event), ofType ...events.Event) Option {
+	return func(o *options) {
+		o.subscribers = append(o.subscribers, eventSubscriber{handler: handler, ofType: ofType})
+	}
+}
+
+// WithClock sets the clock driving the bridge's timers, e.g. update checks, auto-lock, the API rate limit backoff
+// and the users' sync retries; by default, the wall clock. Tests can fast-forward time with a fake clock.
+func WithClock(clock clock.Clock) Option {
+	return func(o *options) { o.clock = clock }
+}
+
+// WithLogging sets whether IMAP client, IMAP server and SMTP activity is logged; by default, none is.
+func WithLogging(imapClient, imapServer, smtp bool) Option {
+	return func(o *options) {
+		o.logIMAPClient = imapClient
+		o.logIMAPServer = imapServer
+		o.logSMTP = smtp
+	}
+}
+
+// newOptions applies the given options on top of the defaults.
+func newOptions(curVersion *semver.Version, opts ...Option) (*options, error) {
+	o := &options{
+		autostarter:          noopAutostarter{},
+		updater:              noopUpdater{},
+		apiURL:               constants.APIHost,
+		identifier:           useragent.New(),
+		panicHandler:         async.NoopPanicHandler{},
+		reporter:             noopReporter{},
+		uidValidityGenerator: imap.DefaultEpochUIDValidityGenerator(),
+		clock:                clock.New(),
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	// Looking for the OS keychains probes their helpers, so it is only done when none were given.
+	if o.keychains == nil {
+		o.keychains = keychain.NewList()
+	}
+
+	if o.cookieJar == nil {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		o.cookieJar = jar
+	}
+
+	if o.roundTripper == nil {
+		// Certificate issues are reported with the user agent when it is known.
+		userAgent, ok := o.identifier.(*useragent.UserAgent)
+		if !ok {
+			userAgent = useragent.New()
+		}
+
+		// Other API hosts present their own certificates, which are verified against the system roots instead of
+		// the pinned keys, and aren't served by the proxies.
+		basicDialer := dialer.NewBasicTLSDialer(o.apiURL)
+
+		var pinChecker dialer.PinChecker = dialer.NewTLSPinChecker(dialer.TrustedAPIPins)
+		if o.apiURL != constants.APIHost {
+			if !o.insecureAPI {
+				basicDialer.VerifyCertificates()
+			}
+
+			pinChecker = dialer.NewAnyPinChecker()
+		}
+
+		pinningDialer := dialer.NewPinningTLSDialer(
+			basicDialer,
+			dialer.NewTLSReporter(o.apiURL, constants.AppVersion(curVersion.Original()), userAgent, dialer.TrustedAPIPins),
+			pinChecker,
+		)
+
+		proxyDialer := dialer.NewProxyTLSDialer(pinningDialer, o.apiURL, o.panicHandler)
+		if o.apiURL != constants.APIHost {
+			proxyDialer.ForbidProxy()
+		}
+
+		o.roundTripper = dialer.CreateTransportWithDialer(proxyDialer)
+		o.tlsReporter = pinningDialer
+		o.proxyCtl = proxyDialer
+	}
+
+	if o.tlsReporter == nil || o.proxyCtl == nil {
+		return nil, errors.New("a custom transport needs a TLS reporter and a proxy controller")
+	}
+
+	return o, nil
+}
+
+var errNotSupported = errors.New("not supported by this bridge")
+
+type noopAutostarter struct{}
+
+func (noopAutostarter) Enable() error   { return errNotSupported }
+func (noopAutostarter) Disable() error  { return nil }
+func (noopAutostarter) IsEnabled() bool { return false }
+
+type noopUpdater struct{}
+
+func (noopUpdater) GetVersionInfo(context.Context, updater.Downloader, updater.Channel) (updater.VersionInfo, error) {
+	return updater.VersionInfo{}, errNotSupported
+}
+
+func (noopUpdater) InstallUpdate(context.Context, updater.Downloader, updater.VersionInfo) error {
+	return errNotSupported
+}
+
+func (noopUpdater) RemoveOldUpdates() error {
+	return nil
+}
+
+func (noopUpdater) Rollback(*semver.Version) (*semver.Version, error) {
+	return nil, errNotSupported
+}
+
+type noopReporter struct{}
+
+func (noopReporter) ReportException(any) error                               { return nil }
+func (noopReporter) ReportMessage(string) error                              { return nil }
+func (noopReporter) ReportMessageWithContext(string, reporter.Context) error { return nil }
+func (noopReporter) ReportExceptionWithContext(any, reporter.Context) error  { return nil }
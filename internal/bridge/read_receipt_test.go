@@ -0,0 +1,123 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/emersion/go-imap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_ReadReceiptPrompt_NotRequested(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		// Create a sender user, to which the read receipt would be sent.
+		_, _, err := s.CreateUser("sender", password)
+		require.NoError(t, err)
+
+		sender := "sender@" + s.GetDomain()
+
+		// Receive a message requesting a read receipt.
+		// The test server only keeps a few headers of imported messages and drops Disposition-Notification-To,
+		// so bridge sees a message without a read receipt request; detecting the request is covered by the
+		// imapservice and message package tests.
+		var messageID string
+
+		withClient(ctx, t, s, username, password, func(ctx context.Context, c *proton.Client) {
+			addrs, err := c.GetAddresses(ctx)
+			require.NoError(t, err)
+
+			messageID = createMessages(ctx, t, c, addrs[0].ID, proton.InboxLabel, []byte(fmt.Sprintf(
+				"Return-Path: <%[1]v>\r\nFrom: %[1]v\r\nTo: %[2]v\r\nSubject: Receipt\r\nDisposition-Notification-To: %[1]v\r\n\r\nHello!",
+				sender, addrs[0].Email,
+			)))[0]
+
+			// Imported messages are read; the message has to be unread for the client to mark it as read.
+			require.NoError(t, c.MarkMessagesUnread(ctx, messageID))
+		})
+
+		// The user should be fully synced.
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			require.Equal(t, userID, (<-syncCh).UserID)
+		})
+
+		withBridgeWaitForServers(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			userInfo, err := b.QueryUserInfo(username)
+			require.NoError(t, err)
+
+			// Read receipt requests are ignored by default.
+			require.Equal(t, vault.ReadReceiptIgnore, userInfo.ReadReceiptMode)
+			require.NoError(t, b.SetUserReadReceiptMode(userInfo.UserID, vault.ReadReceiptPrompt))
+
+			receiptCh, done := chToType[events.Event, events.UserReadReceiptRequested](b.GetEvents(events.UserReadReceiptRequested{}))
+			defer done()
+
+			imapClient, err := eventuallyDial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetIMAPPort())))
+			require.NoError(t, err)
+			require.NoError(t, imapClient.Login(userInfo.Addresses[0], string(userInfo.BridgePass)))
+			defer imapClient.Logout() //nolint:errcheck
+
+			// Read the message.
+			_, err = imapClient.Select("INBOX", false)
+			require.NoError(t, err)
+			require.NoError(t, clientStore(imapClient, 1, 1, false, imap.FormatFlagsOp(imap.AddFlags, true), imap.SeenFlag))
+
+			// The message is marked as read on the server.
+			withClient(ctx, t, s, username, password, func(ctx context.Context, c *proton.Client) {
+				require.Eventually(t, func() bool {
+					msg, err := c.GetMessage(ctx, messageID)
+					require.NoError(t, err)
+
+					return !bool(msg.Unread)
+				}, 10*time.Second, 100*time.Millisecond)
+			})
+
+			// Without the header, the user is not asked to send a read receipt.
+			select {
+			case event := <-receiptCh:
+				require.FailNow(t, "unexpected read receipt request", "%+v", event)
+			case <-time.After(3 * time.Second):
+			}
+
+			require.ErrorIs(t, b.SendUserReadReceipt(ctx, userInfo.UserID, messageID), bridge.ErrNoReadReceiptRequested)
+		})
+
+		// The sender receives no read receipt.
+		withClient(ctx, t, s, "sender", password, func(ctx context.Context, c *proton.Client) {
+			messages, err := c.GetMessageMetadataPage(ctx, 0, 10, proton.MessageFilter{})
+			require.NoError(t, err)
+			require.Empty(t, messages)
+		})
+	})
+}
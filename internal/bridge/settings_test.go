@@ -19,13 +19,29 @@ package bridge_test
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/go-proton-api/server"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/dialer"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/sentry"
+	"github.com/ProtonMail/proton-bridge/v3/internal/telemetry"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
+	"github.com/ProtonMail/proton-bridge/v3/tests"
+	"github.com/emersion/go-sasl"
+	"github.com/emersion/go-smtp"
 	"github.com/stretchr/testify/require"
 )
 
@@ -171,6 +187,251 @@ func TestBridge_Settings_Proxy(t *testing.T) {
 	})
 }
 
+func TestBridge_Settings_DoHProviders(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			// By default, only the default providers are queried.
+			require.Equal(t, dialer.DoHProviders, b.GetDoHProviders())
+
+			// Add a custom provider; it is queried first.
+			custom := "https://doh.example/dns-query"
+			mocks.ProxyCtl.EXPECT().SetDoHProviders(append([]string{custom}, dialer.DoHProviders...))
+			require.NoError(t, b.AddDoHProvider(custom))
+			require.Equal(t, append([]string{custom}, dialer.DoHProviders...), b.GetDoHProviders())
+
+			// Disable a default provider.
+			mocks.ProxyCtl.EXPECT().SetDoHProviders([]string{custom, dialer.Quad9Provider, dialer.Quad9PortProvider})
+			require.NoError(t, b.RemoveDoHProvider(dialer.GoogleProvider))
+			require.Equal(t, []string{custom, dialer.Quad9Provider, dialer.Quad9PortProvider}, b.GetDoHProviders())
+
+			// Invalid or unknown providers are rejected.
+			require.ErrorIs(t, b.AddDoHProvider("http://doh.example/dns-query"), dialer.ErrInvalidDoHProvider)
+			require.ErrorIs(t, b.RemoveDoHProvider("https://unknown.example/dns-query"), bridge.ErrNoSuchDoHProvider)
+
+			// Go back to the defaults.
+			mocks.ProxyCtl.EXPECT().SetDoHProviders(dialer.DoHProviders)
+			require.NoError(t, b.ResetDoHProviders())
+			require.Equal(t, dialer.DoHProviders, b.GetDoHProviders())
+		})
+	})
+}
+
+func TestBridge_Settings_ReportingDisabled(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
+			// By default, reporting is enabled.
+			require.False(t, bridge.GetReportingDisabled())
+			require.False(t, telemetry.IsReportingDisabled())
+
+			// Disable reporting; the crash reporting client is dropped.
+			require.NoError(t, bridge.SetReportingDisabled(true))
+			require.True(t, bridge.GetReportingDisabled())
+			require.True(t, telemetry.IsReportingDisabled())
+			require.False(t, sentry.IsEnabled())
+			require.False(t, bridge.IsTelemetryAvailable(ctx))
+
+			// The launcher, which can't read the vault, is told through a marker.
+			settingsDir, err := locator.ProvideSettingsPath()
+			require.NoError(t, err)
+			require.True(t, sentry.IsDisabledByMarker(settingsDir))
+
+			// Enable reporting again.
+			require.NoError(t, bridge.SetReportingDisabled(false))
+			require.False(t, telemetry.IsReportingDisabled())
+			require.True(t, sentry.IsEnabled())
+			require.False(t, sentry.IsDisabledByMarker(settingsDir))
+		})
+	})
+}
+
+func TestBridge_Settings_BindAddress(t *testing.T) {
+	// The fast certificate generator ignores the hosts the certificate must be valid for.
+	certs.GenerateCert = generateCert
+	defer func() { certs.GenerateCert = tests.FastGenerateCert }()
+
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// By default, bridge only listens on the loopback address.
+			require.Equal(t, constants.Host, b.GetBindAddress())
+			require.False(t, b.GetIMAPSSL())
+
+			// Invalid addresses are rejected.
+			require.ErrorIs(t, b.SetBindAddress(ctx, "not-an-address", true), bridge.ErrInvalidBindAddress)
+
+			// Listening on other addresses must be acknowledged.
+			require.ErrorIs(t, b.SetBindAddress(ctx, "0.0.0.0", false), bridge.ErrBindAddressNotAcknowledged)
+			require.Equal(t, constants.Host, b.GetBindAddress())
+
+			// Listen on all addresses; TLS is then enforced.
+			require.NoError(t, b.SetBindAddress(ctx, "0.0.0.0", true))
+			require.Equal(t, "0.0.0.0", b.GetBindAddress())
+			require.True(t, b.GetIMAPSSL())
+			require.True(t, b.GetSMTPSSL())
+
+			// The regenerated certificate is valid for the loopback address as well.
+			certPEM, _ := b.GetBridgeTLSCert()
+			roots := x509.NewCertPool()
+			require.True(t, roots.AppendCertsFromPEM(certPEM))
+
+			require.Eventually(t, func() bool {
+				conn, err := tls.Dial("tcp", net.JoinHostPort(constants.Host, fmt.Sprint(b.GetIMAPPort())), &tls.Config{
+					ServerName: constants.Host,
+					RootCAs:    roots,
+					MinVersion: tls.VersionTLS12,
+				})
+				if err != nil {
+					return false
+				}
+
+				return conn.Close() == nil
+			}, 10*time.Second, 100*time.Millisecond)
+
+			// Restore the loopback address.
+			require.NoError(t, b.SetBindAddress(ctx, "", false))
+			require.Equal(t, constants.Host, b.GetBindAddress())
+			require.False(t, b.GetIMAPSSL())
+		})
+	})
+}
+
+func TestBridge_Settings_ImplicitTLSPorts(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// By default, there are no implicit TLS listeners.
+			require.Zero(t, b.GetIMAPImplicitTLSPort())
+			require.Zero(t, b.GetSMTPImplicitTLSPort())
+
+			// The implicit TLS ports can't clash with the main ones.
+			require.ErrorIs(t, b.SetIMAPImplicitTLSPort(ctx, b.GetIMAPPort()), bridge.ErrInvalidImplicitTLSPort)
+			require.ErrorIs(t, b.SetSMTPImplicitTLSPort(ctx, b.GetIMAPPort()), bridge.ErrInvalidImplicitTLSPort)
+
+			imapPort := ports.FindFreePortFrom(b.GetIMAPPort()+1, b.GetSMTPPort())
+			smtpPort := ports.FindFreePortFrom(imapPort+1, b.GetSMTPPort())
+
+			// Enable the implicit TLS listeners next to the STARTTLS ones.
+			require.NoError(t, b.SetIMAPImplicitTLSPort(ctx, imapPort))
+			require.NoError(t, b.SetSMTPImplicitTLSPort(ctx, smtpPort))
+			require.False(t, b.GetIMAPSSL())
+			require.False(t, b.GetSMTPSSL())
+
+			// Both listeners present the bridge certificate right away.
+			certPEM, _ := b.GetBridgeTLSCert()
+			require.Equal(t, certPEM, getServedCert(t, imapPort))
+			require.Equal(t, certPEM, getServedCert(t, smtpPort))
+
+			// Disable the implicit TLS listeners again.
+			require.NoError(t, b.SetIMAPImplicitTLSPort(ctx, 0))
+			require.NoError(t, b.SetSMTPImplicitTLSPort(ctx, 0))
+
+			require.Eventually(t, func() bool {
+				return ports.IsPortFree(imapPort) && ports.IsPortFree(smtpPort)
+			}, 10*time.Second, 100*time.Millisecond)
+		})
+	})
+}
+
+func TestBridge_Settings_SSLRequired(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			// By default, SSL is not required.
+			require.False(t, b.GetIMAPSSLRequired())
+			require.False(t, b.GetSMTPSSLRequired())
+
+			// Requiring SSL for IMAP switches its listener to implicit TLS.
+			require.NoError(t, b.SetIMAPSSLRequired(ctx, true))
+			require.True(t, b.GetIMAPSSL())
+
+			certPEM, _ := b.GetBridgeTLSCert()
+			require.Equal(t, certPEM, getServedCert(t, b.GetIMAPPort()))
+
+			// Requiring SSL for SMTP rejects authentication before STARTTLS.
+			require.NoError(t, b.SetSMTPSSLRequired(ctx, true))
+			require.False(t, b.GetSMTPSSL())
+
+			client, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+			require.NoError(t, err)
+			defer client.Close() //nolint:errcheck
+
+			require.Error(t, client.Auth(sasl.NewPlainClient(info.Addresses[0], info.Addresses[0], string(info.BridgePass))))
+
+			// Once the connection is upgraded, authentication succeeds.
+			tlsClient, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+			require.NoError(t, err)
+			defer tlsClient.Close() //nolint:errcheck
+
+			require.NoError(t, tlsClient.StartTLS(&tls.Config{InsecureSkipVerify: true})) //nolint:gosec
+			require.NoError(t, tlsClient.Auth(sasl.NewPlainClient(info.Addresses[0], info.Addresses[0], string(info.BridgePass))))
+
+			// Disabling the requirement restores STARTTLS for IMAP.
+			require.NoError(t, b.SetIMAPSSLRequired(ctx, false))
+			require.False(t, b.GetIMAPSSL())
+		})
+	})
+}
+
+func TestBridge_Settings_TLSCertPath(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// Use SSL so that the certificate is presented as soon as clients connect.
+			require.NoError(t, b.SetIMAPSSL(ctx, true))
+
+			// Write a certificate to a certbot-like directory.
+			template, err := certs.NewTLSTemplate()
+			require.NoError(t, err)
+
+			// The fast certificate generator would return the certificate bridge already uses.
+			certPEM, keyPEM, err := generateCert(template)
+			require.NoError(t, err)
+
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "fullchain.pem"), certPEM, 0o600))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "privkey.pem"), keyPEM, 0o600))
+
+			// Directories without certificate files are rejected.
+			require.ErrorIs(t, b.SetBridgeTLSCertDir(t.TempDir()), certs.ErrNoKeyPair)
+
+			// The certificate is served right away, without restarting the servers.
+			require.NoError(t, b.SetBridgeTLSCertDir(dir))
+
+			certPath, _ := b.GetBridgeTLSCertPath()
+			require.Equal(t, filepath.Join(dir, "fullchain.pem"), certPath)
+			require.Equal(t, certPEM, getServedCert(t, b.GetIMAPPort()))
+
+			// Use the self-signed certificate again.
+			require.NoError(t, b.ClearBridgeTLSCertPath())
+			require.NotEqual(t, certPEM, getServedCert(t, b.GetIMAPPort()))
+		})
+	})
+}
+
+func getServedCert(t *testing.T, port int) []byte {
+	var conn *tls.Conn
+
+	require.Eventually(t, func() bool {
+		c, err := tls.Dial("tcp", net.JoinHostPort(constants.Host, fmt.Sprint(port)), &tls.Config{
+			InsecureSkipVerify: true, //nolint:gosec
+			MinVersion:         tls.VersionTLS12,
+		})
+		if err != nil {
+			return false
+		}
+
+		conn = c
+
+		return true
+	}, 10*time.Second, 100*time.Millisecond)
+
+	defer func() { _ = conn.Close() }()
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: conn.ConnectionState().PeerCertificates[0].Raw})
+}
+
 func TestBridge_Settings_Autostart(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
 		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, mocks *bridge.Mocks) {
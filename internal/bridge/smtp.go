@@ -48,8 +48,20 @@ func (b *bridgeSMTPSettings) SetPort(i int) error {
 	return b.b.vault.SetSMTPPort(i)
 }
 
+func (b *bridgeSMTPSettings) BindAddress() string {
+	return b.b.GetBindAddress()
+}
+
 func (b *bridgeSMTPSettings) UseSSL() bool {
-	return b.b.vault.GetSMTPSSL()
+	return b.b.GetSMTPSSL()
+}
+
+func (b *bridgeSMTPSettings) RequireTLS() bool {
+	return b.b.GetSMTPSSLRequired()
+}
+
+func (b *bridgeSMTPSettings) ImplicitTLSPort() int {
+	return b.b.vault.GetSMTPImplicitTLSPort()
 }
 
 func (b *bridgeSMTPSettings) Identifier() identifier.UserAgentUpdater {
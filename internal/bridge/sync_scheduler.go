@@ -0,0 +1,204 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/syncservice"
+)
+
+// defaultMaxParallelSyncs is how many accounts can sync their messages at once; the other ones wait for their turn.
+const defaultMaxParallelSyncs = 2
+
+// PrioritizeSync makes the user the next one to sync its messages when several users are waiting for their turn.
+func (bridge *Bridge) PrioritizeSync(userID string) error {
+	return safe.RLockRet(func() error {
+		if _, ok := bridge.users[userID]; !ok {
+			return ErrNoSuchUser
+		}
+
+		bridge.syncScheduler.prioritize(userID)
+
+		return nil
+	}, bridge.usersLock)
+}
+
+// GetSyncPriority returns the user which was prioritized with PrioritizeSync, if any.
+func (bridge *Bridge) GetSyncPriority() string {
+	return bridge.syncScheduler.getPriority()
+}
+
+// GetSyncQueue returns the users waiting for their turn to sync, in the order they will start.
+func (bridge *Bridge) GetSyncQueue() []string {
+	return bridge.syncScheduler.getWaiting()
+}
+
+// syncScheduler sits in front of the sync service and bounds how many accounts sync at once.
+// Waiting accounts are started in the order they asked to sync, except for the prioritized one which goes first.
+type syncScheduler struct {
+	regulator    syncservice.Regulator
+	panicHandler async.PanicHandler
+
+	lock     safe.Mutex
+	running  int
+	max      int
+	waiting  []*syncTicket
+	priority string
+}
+
+type syncTicket struct {
+	userID  string
+	readyCh chan struct{}
+	granted bool
+}
+
+func newSyncScheduler(regulator syncservice.Regulator, maxParallelSyncs int, panicHandler async.PanicHandler) *syncScheduler {
+	return &syncScheduler{
+		regulator:    regulator,
+		panicHandler: panicHandler,
+		lock:         safe.NewMutex(),
+		max:          maxParallelSyncs,
+	}
+}
+
+// Sync waits for the account's turn and hands the job over to the sync service.
+// The turn is held until all the work of the job has completed.
+func (s *syncScheduler) Sync(ctx context.Context, job *syncservice.Job) error {
+	if err := s.acquire(ctx, job.UserID()); err != nil {
+		return err
+	}
+
+	if err := s.regulator.Sync(ctx, job); err != nil {
+		s.release()
+		return err
+	}
+
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+		defer s.release()
+
+		<-job.Done()
+	}()
+
+	return nil
+}
+
+// prioritize makes the account the next one to start syncing.
+func (s *syncScheduler) prioritize(userID string) {
+	safe.Lock(func() {
+		s.priority = userID
+	}, s.lock)
+}
+
+// getPriority returns the prioritized account, if any.
+func (s *syncScheduler) getPriority() string {
+	return safe.LockRet(func() string {
+		return s.priority
+	}, s.lock)
+}
+
+// getWaiting returns the accounts waiting for their turn, in the order they will start.
+func (s *syncScheduler) getWaiting() []string {
+	return safe.LockRet(func() []string {
+		if len(s.waiting) == 0 {
+			return nil
+		}
+
+		next := s.next()
+		userIDs := []string{s.waiting[next].userID}
+
+		for idx, ticket := range s.waiting {
+			if idx != next {
+				userIDs = append(userIDs, ticket.userID)
+			}
+		}
+
+		return userIDs
+	}, s.lock)
+}
+
+func (s *syncScheduler) acquire(ctx context.Context, userID string) error {
+	ticket := &syncTicket{userID: userID, readyCh: make(chan struct{})}
+
+	safe.Lock(func() {
+		s.waiting = append(s.waiting, ticket)
+		s.dispatch()
+	}, s.lock)
+
+	select {
+	case <-ticket.readyCh:
+		return nil
+
+	case <-ctx.Done():
+		safe.Lock(func() {
+			if ticket.granted {
+				s.running--
+			} else {
+				s.remove(ticket)
+			}
+
+			s.dispatch()
+		}, s.lock)
+
+		return ctx.Err()
+	}
+}
+
+func (s *syncScheduler) release() {
+	safe.Lock(func() {
+		s.running--
+		s.dispatch()
+	}, s.lock)
+}
+
+// dispatch starts as many waiting accounts as there are free turns. It must be called with the lock held.
+func (s *syncScheduler) dispatch() {
+	for s.running < s.max && len(s.waiting) > 0 {
+		ticket := s.waiting[s.next()]
+
+		s.remove(ticket)
+		s.running++
+
+		ticket.granted = true
+		close(ticket.readyCh)
+	}
+}
+
+// next returns the index of the ticket to start next. It must be called with the lock held.
+func (s *syncScheduler) next() int {
+	for idx, ticket := range s.waiting {
+		if ticket.userID == s.priority {
+			return idx
+		}
+	}
+
+	return 0
+}
+
+// remove drops the ticket from the waiting list. It must be called with the lock held.
+func (s *syncScheduler) remove(ticket *syncTicket) {
+	for idx, waiting := range s.waiting {
+		if waiting == ticket {
+			s.waiting = append(s.waiting[:idx], s.waiting[idx+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncScheduler_BoundsParallelSyncs(t *testing.T) {
+	scheduler := newSyncScheduler(nil, 2, nil)
+
+	require.NoError(t, scheduler.acquire(context.Background(), "user1"))
+	require.NoError(t, scheduler.acquire(context.Background(), "user2"))
+
+	startedCh := startSyncs(t, scheduler, "user3")
+	require.Eventually(t, func() bool { return len(scheduler.getWaiting()) == 1 }, time.Second, 10*time.Millisecond)
+
+	requireNotStarted(t, startedCh)
+
+	scheduler.release()
+
+	require.Equal(t, "user3", <-startedCh)
+	require.Empty(t, scheduler.getWaiting())
+}
+
+func TestSyncScheduler_StartsInOrder(t *testing.T) {
+	scheduler := newSyncScheduler(nil, 1, nil)
+
+	require.NoError(t, scheduler.acquire(context.Background(), "user1"))
+
+	startedCh := startSyncs(t, scheduler, "user2", "user3", "user4")
+	require.Equal(t, []string{"user2", "user3", "user4"}, scheduler.getWaiting())
+
+	for _, userID := range []string{"user2", "user3", "user4"} {
+		scheduler.release()
+		require.Equal(t, userID, <-startedCh)
+	}
+}
+
+func TestSyncScheduler_Prioritize(t *testing.T) {
+	scheduler := newSyncScheduler(nil, 1, nil)
+
+	require.NoError(t, scheduler.acquire(context.Background(), "user1"))
+
+	startedCh := startSyncs(t, scheduler, "user2", "user3", "user4")
+
+	scheduler.prioritize("user4")
+	require.Equal(t, "user4", scheduler.getPriority())
+	require.Equal(t, []string{"user4", "user2", "user3"}, scheduler.getWaiting())
+
+	for _, userID := range []string{"user4", "user2", "user3"} {
+		scheduler.release()
+		require.Equal(t, userID, <-startedCh)
+	}
+}
+
+func TestSyncScheduler_Cancel(t *testing.T) {
+	scheduler := newSyncScheduler(nil, 1, nil)
+
+	require.NoError(t, scheduler.acquire(context.Background(), "user1"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, scheduler.acquire(ctx, "user2"), context.DeadlineExceeded)
+	require.Empty(t, scheduler.getWaiting())
+
+	// The cancelled sync did not take a turn.
+	scheduler.release()
+	require.NoError(t, scheduler.acquire(context.Background(), "user3"))
+}
+
+// startSyncs queues syncs for the given users one after the other and reports them as they start.
+func startSyncs(t *testing.T, scheduler *syncScheduler, userIDs ...string) <-chan string {
+	startedCh := make(chan string, len(userIDs))
+
+	for _, userID := range userIDs {
+		go func(userID string) {
+			require.NoError(t, scheduler.acquire(context.Background(), userID))
+			startedCh <- userID
+		}(userID)
+
+		require.Eventually(t, func() bool {
+			waiting := scheduler.getWaiting()
+			return len(waiting) > 0 && waiting[len(waiting)-1] == userID
+		}, time.Second, 10*time.Millisecond)
+	}
+
+	return startedCh
+}
+
+func requireNotStarted(t *testing.T, startedCh <-chan string) {
+	select {
+	case userID := <-startedCh:
+		t.Fatalf("sync of %v should not have started", userID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -206,6 +206,88 @@ func _TestBridge_Sync_BadMessage(t *testing.T) { //nolint:unused,deadcode
 	})
 }
 
+func TestBridge_SyncCutoff(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		userID, addrID, err := s.CreateUser("imap", password)
+		require.NoError(t, err)
+
+		labelID, err := s.CreateLabel(userID, "folder", "", proton.LabelTypeFolder)
+		require.NoError(t, err)
+
+		// The test server dates imported messages at the epoch, whatever their Date header; which messages are
+		// older than the cutoff is covered by the sync service tests.
+		withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+			createNumMessages(ctx, t, c, addrID, labelID, 5)
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, "imap", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+			require.True(t, info.SyncCutoff.IsZero())
+
+			// Widening the sync window again may recreate the IMAP databases, which disconnects the clients.
+			countMessages := func() uint32 {
+				client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+				require.NoError(t, err)
+				require.NoError(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+				defer func() { _ = client.Logout() }()
+
+				status, err := client.Status(`Folders/folder`, []imap.StatusItem{imap.StatusMessages})
+				require.NoError(t, err)
+
+				return status.Messages
+			}
+
+			require.Equal(t, uint32(5), countMessages())
+
+			// The cutoff can't be in the future.
+			require.ErrorIs(t, b.SetUserSyncCutoff(ctx, userID, time.Now().Add(time.Hour)), bridge.ErrInvalidSyncCutoff)
+
+			// Narrowing the sync window removes the older messages.
+			cutoff := time.Now().Add(-24 * time.Hour)
+			require.NoError(t, b.SetUserSyncCutoff(ctx, userID, cutoff))
+			require.Equal(t, userID, (<-syncCh).UserID)
+			require.Zero(t, countMessages())
+
+			info, err = b.GetUserInfo(userID)
+			require.NoError(t, err)
+			require.Equal(t, cutoff.Unix(), info.SyncCutoff.Unix())
+
+			// Searching by subject fetches the older messages matching it from the server.
+			func() {
+				client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+				require.NoError(t, err)
+				require.NoError(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+				defer func() { _ = client.Logout() }()
+
+				_, err = client.Select(`Folders/folder`, false)
+				require.NoError(t, err)
+
+				criteria := imap.NewSearchCriteria()
+				criteria.Header.Add("Subject", "Test")
+
+				uids, err := client.UidSearch(criteria)
+				require.NoError(t, err)
+				require.Len(t, uids, 5)
+			}()
+
+			require.Equal(t, uint32(5), countMessages())
+
+			// Widening it syncs them again.
+			require.NoError(t, b.SetUserSyncCutoff(ctx, userID, time.Time{}))
+			require.Equal(t, userID, (<-syncCh).UserID)
+			require.Equal(t, uint32(5), countMessages())
+		})
+	})
+}
+
 func TestBridge_SyncWithOngoingEvents(t *testing.T) {
 	numMsg := 1 << 8
 	messageSplitIndex := numMsg * 2 / 3
@@ -690,7 +772,7 @@ func TestBridge_AddressOrderChangeDuringSyncInCombinedModeDoesNotTriggerBadEvent
 	})
 }
 
-func withClient(ctx context.Context, t *testing.T, s *server.Server, username string, password []byte, fn func(context.Context, *proton.Client)) { //nolint:unparam
+func withClient(ctx context.Context, t testing.TB, s *server.Server, username string, password []byte, fn func(context.Context, *proton.Client)) { //nolint:unparam
 	m := proton.New(
 		proton.WithHostURL(s.GetHostURL()),
 		proton.WithTransport(proton.InsecureTransport()),
@@ -760,18 +842,18 @@ func clientList(client *client.Client) []*imap.MailboxInfo {
 	return iterator.Collect(iterator.Chan(resCh))
 }
 
-func createNumMessages(ctx context.Context, t *testing.T, c *proton.Client, addrID, labelID string, count int) []string {
+func createNumMessages(ctx context.Context, t testing.TB, c *proton.Client, addrID, labelID string, count int) []string {
 	literal, err := os.ReadFile(filepath.Join("testdata", "text-plain.eml"))
 	require.NoError(t, err)
 
 	return createMessages(ctx, t, c, addrID, labelID, xslices.Repeat(literal, count)...)
 }
 
-func createMessages(ctx context.Context, t *testing.T, c *proton.Client, addrID, labelID string, messages ...[]byte) []string {
+func createMessages(ctx context.Context, t testing.TB, c *proton.Client, addrID, labelID string, messages ...[]byte) []string {
 	return createMessagesWithFlags(ctx, t, c, addrID, labelID, 0, messages...)
 }
 
-func createMessagesWithFlags(ctx context.Context, t *testing.T, c *proton.Client, addrID, labelID string, flags proton.MessageFlag, messages ...[]byte) []string {
+func createMessagesWithFlags(ctx context.Context, t testing.TB, c *proton.Client, addrID, labelID string, flags proton.MessageFlag, messages ...[]byte) []string {
 	user, err := c.GetUser(ctx)
 	require.NoError(t, err)
 
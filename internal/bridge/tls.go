@@ -17,10 +17,139 @@
 
 package bridge
 
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+)
+
 func (bridge *Bridge) GetBridgeTLSCert() ([]byte, []byte) {
 	return bridge.vault.GetBridgeTLSCert()
 }
 
+// GetBridgeTLSCertPath returns the paths to the certificate and key files used instead of the self-signed certificate.
+func (bridge *Bridge) GetBridgeTLSCertPath() (string, string) {
+	return bridge.vault.GetBridgeTLSCertPath()
+}
+
+// SetBridgeTLSCertPath makes the IMAP and SMTP servers use the given certificate and key files instead of the
+// self-signed certificate. The files are checked for renewal periodically and reloaded without restarting the servers.
 func (bridge *Bridge) SetBridgeTLSCertPath(certPath, keyPath string) error {
-	return bridge.vault.SetBridgeTLSCertPath(certPath, keyPath)
+	if err := bridge.vault.SetBridgeTLSCertPath(certPath, keyPath); err != nil {
+		return err
+	}
+
+	return bridge.reloadTLSCert()
+}
+
+// SetBridgeTLSCertDir is like SetBridgeTLSCertPath, for the certificate and key files found in the given directory,
+// such as a certbot live directory.
+func (bridge *Bridge) SetBridgeTLSCertDir(dir string) error {
+	certPath, keyPath, err := certs.FindKeyPair(dir)
+	if err != nil {
+		return err
+	}
+
+	return bridge.SetBridgeTLSCertPath(certPath, keyPath)
+}
+
+// ClearBridgeTLSCertPath makes the IMAP and SMTP servers use the self-signed certificate again.
+func (bridge *Bridge) ClearBridgeTLSCertPath() error {
+	if err := bridge.vault.ClearBridgeTLSCertPath(); err != nil {
+		return err
+	}
+
+	return bridge.reloadTLSCert()
+}
+
+// tlsCertCheckInterval is how often certificate files are checked for renewal.
+const tlsCertCheckInterval = 10 * time.Minute
+
+// newTLSConfig returns the TLS config of the IMAP and SMTP servers.
+// It always serves the current bridge certificate, so that it can be replaced while the servers run.
+func (bridge *Bridge) newTLSConfig() *tls.Config {
+	return &tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return bridge.tlsCert.Load(), nil
+		},
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+// reloadTLSCert loads the bridge certificate from the vault and serves it to new connections.
+func (bridge *Bridge) reloadTLSCert() error {
+	cert, err := loadTLSCert(bridge.vault)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	bridge.tlsCert.Store(&cert)
+
+	return nil
+}
+
+// checkTLSCertRenewal reloads the certificate files, if any, when they were renewed.
+// Files that can't be used, e.g. while they are being replaced, are ignored until the next check.
+func (bridge *Bridge) checkTLSCertRenewal() {
+	certPath, keyPath := bridge.vault.GetBridgeTLSCertPath()
+	if certPath == "" || keyPath == "" {
+		return
+	}
+
+	certPEM, err := os.ReadFile(filepath.Clean(certPath))
+	if err != nil {
+		logPkg.WithError(err).Warn("Failed to read TLS certificate file")
+		return
+	}
+
+	keyPEM, err := os.ReadFile(filepath.Clean(keyPath))
+	if err != nil {
+		logPkg.WithError(err).Warn("Failed to read TLS key file")
+		return
+	}
+
+	if err := certs.ValidateKeyPair(certPEM, keyPEM); err != nil {
+		logPkg.WithError(err).Warn("TLS certificate files can't be used")
+		return
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return
+	}
+
+	if current := bridge.tlsCert.Load(); current != nil && bytes.Equal(current.Certificate[0], cert.Certificate[0]) {
+		return
+	}
+
+	logPkg.Info("TLS certificate files were renewed, reloading them")
+
+	bridge.tlsCert.Store(&cert)
+}
+
+// regenerateTLSCert replaces the self-signed bridge certificate with a new one, also valid for the given hosts.
+// Clients that trusted the previous certificate have to trust the new one.
+func (bridge *Bridge) regenerateTLSCert(hosts ...string) error {
+	template, err := certs.NewTLSTemplate()
+	if err != nil {
+		return fmt.Errorf("failed to create TLS template: %w", err)
+	}
+
+	certs.AddTLSTemplateHosts(template, hosts...)
+
+	certPEM, keyPEM, err := certs.GenerateCert(template)
+	if err != nil {
+		return fmt.Errorf("failed to generate TLS certificate: %w", err)
+	}
+
+	if err := bridge.vault.SetBridgeTLSCertKey(certPEM, keyPEM); err != nil {
+		return fmt.Errorf("failed to save TLS certificate: %w", err)
+	}
+
+	return bridge.reloadTLSCert()
 }
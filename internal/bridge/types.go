@@ -20,6 +20,7 @@ package bridge
 import (
 	"context"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 )
 
@@ -27,11 +28,14 @@ type Locator interface {
 	ProvideSettingsPath() (string, error)
 	ProvideLogsPath() (string, error)
 	ProvideGluonCachePath() (string, error)
+	SetGluonCachePath(string)
+	IsEphemeral() bool
 	ProvideGluonDataPath() (string, error)
 	GetLicenseFilePath() string
 	GetDependencyLicensesLink() string
 	Clear(...string) error
 	ProvideIMAPSyncConfigPath() (string, error)
+	ProvideEventJournalPath() (string, error)
 	ProvideUnleashCachePath() (string, error)
 	ProvideNotificationsCachePath() (string, error)
 }
@@ -39,6 +43,10 @@ type Locator interface {
 type ProxyController interface {
 	AllowProxy()
 	DisallowProxy()
+	SetSOCKSProxy(string) error
+	SetPACSource(string) error
+	ReloadPAC() error
+	SetDoHProviders([]string)
 }
 
 type TLSReporter interface {
@@ -55,4 +63,5 @@ type Updater interface {
 	GetVersionInfo(context.Context, updater.Downloader, updater.Channel) (updater.VersionInfo, error)
 	InstallUpdate(context.Context, updater.Downloader, updater.VersionInfo) error
 	RemoveOldUpdates() error
+	Rollback(*semver.Version) (*semver.Version, error)
 }
@@ -20,7 +20,9 @@ package bridge
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gluon/reporter"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
@@ -36,6 +38,49 @@ func (bridge *Bridge) InstallUpdate(version updater.VersionInfo) {
 	bridge.installCh <- installJob{version: version, silent: false}
 }
 
+// InstallUpdateFromDir installs an update from a local directory holding the signed version file and update package,
+// as published on the update servers, for machines that can't reach them. It returns once the update is installed.
+// The update goes through the same checks as an online one, except that it is installed even if auto-update is disabled.
+func (bridge *Bridge) InstallUpdateFromDir(ctx context.Context, dir string) error {
+	downloader := updater.NewLocalDownloader(dir, bridge.clock)
+
+	version, err := bridge.updater.GetVersionInfo(ctx, downloader, bridge.vault.GetUpdateChannel())
+	if err != nil {
+		return fmt.Errorf("failed to read local update: %w", err)
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"version": version.Version,
+		"current": bridge.curVersion,
+		"dir":     dir,
+	})
+
+	if err := bridge.checkUpdate(version); err != nil {
+		log.WithError(err).Info("The local update can't be installed")
+		return err
+	}
+
+	log.Info("Installing local update")
+
+	return bridge.installUpdate(ctx, installJob{version: version, silent: false, downloader: downloader})
+}
+
+// checkUpdate returns why the given version can't be installed automatically, if it can't.
+func (bridge *Bridge) checkUpdate(version updater.VersionInfo) error {
+	switch {
+	case !version.Version.GreaterThan(bridge.curVersion):
+		return ErrUpdateNotNewer
+
+	case version.RolloutProportion < bridge.vault.GetUpdateRollout():
+		return ErrUpdateNotRolledOut
+
+	case bridge.curVersion.LessThan(version.MinAuto):
+		return ErrUpdateIncompatible
+	}
+
+	return nil
+}
+
 func (bridge *Bridge) handleUpdate(version updater.VersionInfo) {
 	log := logrus.WithFields(logrus.Fields{
 		"version": version.Version,
@@ -47,18 +92,18 @@ func (bridge *Bridge) handleUpdate(version updater.VersionInfo) {
 		Version: version,
 	})
 
-	switch {
-	case !version.Version.GreaterThan(bridge.curVersion):
+	switch err := bridge.checkUpdate(version); {
+	case errors.Is(err, ErrUpdateNotNewer):
 		log.Debug("No update available")
 
 		bridge.publish(events.UpdateNotAvailable{})
 
-	case version.RolloutProportion < bridge.vault.GetUpdateRollout():
+	case errors.Is(err, ErrUpdateNotRolledOut):
 		log.Info("An update is available but has not been rolled out yet")
 
 		bridge.publish(events.UpdateNotAvailable{})
 
-	case bridge.curVersion.LessThan(version.MinAuto):
+	case errors.Is(err, ErrUpdateIncompatible):
 		log.Info("An update is available but is incompatible with this version")
 
 		bridge.publish(events.UpdateAvailable{
@@ -76,6 +121,15 @@ func (bridge *Bridge) handleUpdate(version updater.VersionInfo) {
 			Silent:     false,
 		})
 
+	case isRolledBack(version, bridge.vault.GetRolledBackVersion()):
+		log.Info("An update is available but a rollback from it was requested")
+
+		bridge.publish(events.UpdateAvailable{
+			Version:    version,
+			Compatible: true,
+			Silent:     false,
+		})
+
 	default:
 		safe.RLock(func() {
 			bridge.installCh <- installJob{version: version, silent: true}
@@ -86,10 +140,15 @@ func (bridge *Bridge) handleUpdate(version updater.VersionInfo) {
 type installJob struct {
 	version updater.VersionInfo
 	silent  bool
+
+	// downloader provides the update files; it is the API if nil.
+	downloader updater.Downloader
 }
 
-func (bridge *Bridge) installUpdate(ctx context.Context, job installJob) {
-	safe.Lock(func() {
+// installUpdate installs the update of the given job and returns why it wasn't installed, if it wasn't.
+// The frontends are notified through events; failures to download or verify the update are only reported to Sentry.
+func (bridge *Bridge) installUpdate(ctx context.Context, job installJob) error {
+	return safe.LockRet(func() error {
 		log := logrus.WithFields(logrus.Fields{
 			"version": job.version.Version,
 			"current": bridge.curVersion,
@@ -97,7 +156,7 @@ func (bridge *Bridge) installUpdate(ctx context.Context, job installJob) {
 		})
 
 		if !job.version.Version.GreaterThan(bridge.newVersion) {
-			return
+			return updater.ErrUpdateAlreadyInstalled
 		}
 
 		log.WithField("silent", job.silent).Info("An update is available")
@@ -113,7 +172,12 @@ func (bridge *Bridge) installUpdate(ctx context.Context, job installJob) {
 			Silent:  job.silent,
 		})
 
-		err := bridge.updater.InstallUpdate(ctx, bridge.api, job.version)
+		var downloader updater.Downloader = bridge.api
+		if job.downloader != nil {
+			downloader = job.downloader
+		}
+
+		err := bridge.updater.InstallUpdate(ctx, downloader, job.version)
 
 		switch {
 		case errors.Is(err, updater.ErrDownloadVerify):
@@ -149,9 +213,53 @@ func (bridge *Bridge) installUpdate(ctx context.Context, job installJob) {
 
 			bridge.newVersion = job.version.Version
 		}
+
+		return err
+	}, bridge.newVersionLock)
+}
+
+// Rollback removes the newest installed update so that the previous version kept on disk is started at the next restart.
+// The version rolled back from, and older ones, are no longer installed automatically.
+func (bridge *Bridge) Rollback() error {
+	return safe.LockRet(func() error {
+		log := logrus.WithFields(logrus.Fields{
+			"version": bridge.newVersion,
+			"current": bridge.curVersion,
+		})
+
+		previous, err := bridge.updater.Rollback(bridge.newVersion)
+		if err != nil {
+			log.WithError(err).Error("The update could not be rolled back")
+			return err
+		}
+
+		if err := bridge.vault.SetRolledBackVersion(bridge.newVersion); err != nil {
+			return err
+		}
+
+		// The base installed version has no version known to bridge; the frontends ask for a restart themselves.
+		if previous == nil {
+			log.Info("The update was rolled back to the base installed version")
+			return nil
+		}
+
+		log.WithField("previous", previous).Info("The update was rolled back")
+
+		// The previous version is installed in place of the current one; a restart is needed to use it.
+		bridge.publish(events.UpdateInstalled{
+			Version: updater.VersionInfo{Version: previous},
+			Silent:  false,
+		})
+
+		return nil
 	}, bridge.newVersionLock)
 }
 
+// isRolledBack returns whether the given version is one that was rolled back from, or older.
+func isRolledBack(version updater.VersionInfo, rolledBack *semver.Version) bool {
+	return rolledBack != nil && !version.Version.GreaterThan(rolledBack)
+}
+
 func (bridge *Bridge) RemoveOldUpdates() {
 	if err := bridge.updater.RemoveOldUpdates(); err != nil {
 		logrus.WithError(err).Error("Remove old updates fails")
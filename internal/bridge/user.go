@@ -22,6 +22,8 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"slices"
+	"time"
 
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/gluon/imap"
@@ -31,13 +33,16 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/hv"
 	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/eventjournal"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
 	"github.com/ProtonMail/proton-bridge/v3/internal/try"
 	"github.com/ProtonMail/proton-bridge/v3/internal/unleash"
 	"github.com/ProtonMail/proton-bridge/v3/internal/user"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/go-resty/resty/v2"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
 )
 
 var logUser = logrus.WithField("pkg", "bridge/user") //nolint:gochecknoglobals
@@ -68,6 +73,18 @@ type UserInfo struct {
 	// AddressMode is the user's address mode.
 	AddressMode vault.AddressMode
 
+	// HideAllMail is true if the All Mail mailbox is hidden for this user.
+	HideAllMail bool
+
+	// RepairMIME is true if malformed MIME is repaired when building this user's messages.
+	RepairMIME bool
+
+	// ReadReceiptMode is how read receipt requests of this user's received messages are handled.
+	ReadReceiptMode vault.ReadReceiptMode
+
+	// SyncCutoff is the time before which this user's messages are not synced; zero means all messages are.
+	SyncCutoff time.Time
+
 	// BridgePass is the user's bridge password.
 	BridgePass []byte
 
@@ -76,16 +93,33 @@ type UserInfo struct {
 
 	// MaxSpace is the total amount of space available to the user.
 	MaxSpace uint64
+
+	// PendingReauth is true if the user's session was revoked and the user should sign in again to resume.
+	PendingReauth bool
+
+	// DataKept is true if the user was removed with its local data kept; signing in again re-links it.
+	DataKept bool
 }
 
 // GetUserIDs returns the IDs of all known users (authorized or not).
+// In degraded mode, they include the accounts of the vault keyed in the keychain.
 func (bridge *Bridge) GetUserIDs() []string {
-	return bridge.vault.GetUserIDs()
+	userIDs := bridge.vault.GetUserIDs()
+
+	if accounts := bridge.keychainAccounts.Load(); accounts != nil {
+		for _, userID := range accounts.GetUserIDs() {
+			if !slices.Contains(userIDs, userID) {
+				userIDs = append(userIDs, userID)
+			}
+		}
+	}
+
+	return userIDs
 }
 
 // HasUser returns true iff the given user is known (authorized or not).
 func (bridge *Bridge) HasUser(userID string) bool {
-	return bridge.vault.HasUser(userID)
+	return bridge.vault.HasUser(userID) || bridge.isKeychainAccount(userID)
 }
 
 // GetUserInfo returns info about the given user.
@@ -95,6 +129,19 @@ func (bridge *Bridge) GetUserInfo(userID string) (UserInfo, error) {
 			return getConnUserInfo(user), nil
 		}
 
+		// The accounts of the vault keyed in the keychain are locked until the keychain is back.
+		if bridge.isKeychainAccount(userID) {
+			var info UserInfo
+
+			if err := bridge.keychainAccounts.Load().GetUser(userID, func(user *vault.User) {
+				info = getUserInfo(user.UserID(), user.Username(), user.PrimaryEmail(), Locked, user.AddressMode())
+			}); err != nil {
+				return UserInfo{}, fmt.Errorf("failed to get user info: %w", err)
+			}
+
+			return info, nil
+		}
+
 		var info UserInfo
 
 		if err := bridge.vault.GetUser(userID, func(user *vault.User) {
@@ -103,6 +150,8 @@ func (bridge *Bridge) GetUserInfo(userID string) (UserInfo, error) {
 				state = SignedOut
 			}
 			info = getUserInfo(user.UserID(), user.Username(), user.PrimaryEmail(), state, user.AddressMode())
+			info.PendingReauth = state == SignedOut && user.PendingReauth()
+			info.DataKept = user.Removed()
 		}); err != nil {
 			return UserInfo{}, fmt.Errorf("failed to get user info: %w", err)
 		}
@@ -131,6 +180,18 @@ func (bridge *Bridge) LoginAuth(ctx context.Context, username string, password [
 	if username == "crash@bandicoot" {
 		panic("Your wish is my command.. I crash!")
 	}
+
+	if bridge.locked.Load() {
+		return nil, proton.Auth{}, ErrBridgeLocked
+	}
+
+	if bridge.rotatingKeys.Load() {
+		return nil, proton.Auth{}, ErrKeyRotationInProgress
+	}
+
+	if bridge.credentialStoreUnavailable.Load() {
+		return nil, proton.Auth{}, ErrCredentialStoreUnavailable
+	}
 	client, auth, err := bridge.api.NewClientWithLoginWithHVToken(ctx, username, password, hvDetails)
 	if err != nil {
 		if hv.IsHvRequest(err) {
@@ -250,6 +311,10 @@ func (bridge *Bridge) LoginFull(
 func (bridge *Bridge) LogoutUser(ctx context.Context, userID string) error {
 	logUser.WithField("userID", userID).Info("Logging out user")
 
+	if bridge.isKeychainAccount(userID) {
+		return ErrCredentialStoreUnavailable
+	}
+
 	return safe.LockRet(func() error {
 		user, ok := bridge.users[userID]
 		if !ok {
@@ -270,11 +335,20 @@ func (bridge *Bridge) LogoutUser(ctx context.Context, userID string) error {
 func (bridge *Bridge) DeleteUser(ctx context.Context, userID string) error {
 	logUser.WithField("userID", userID).Info("Deleting user")
 
+	if bridge.isKeychainAccount(userID) {
+		return ErrCredentialStoreUnavailable
+	}
+
 	syncConfigDir, err := bridge.locator.ProvideIMAPSyncConfigPath()
 	if err != nil {
 		return fmt.Errorf("failed to get sync config path")
 	}
 
+	journalDir, err := bridge.locator.ProvideEventJournalPath()
+	if err != nil {
+		return fmt.Errorf("failed to get event journal path: %w", err)
+	}
+
 	return safe.LockRet(func() error {
 		if !bridge.vault.HasUser(userID) {
 			return ErrNoSuchUser
@@ -282,12 +356,18 @@ func (bridge *Bridge) DeleteUser(ctx context.Context, userID string) error {
 
 		if user, ok := bridge.users[userID]; ok {
 			bridge.logoutUser(ctx, user, true, true)
+		} else if err := bridge.deleteKeptUserData(userID); err != nil {
+			logUser.WithError(err).Error("Failed to delete kept IMAP data")
 		}
 
 		if err := imapservice.DeleteSyncState(syncConfigDir, userID); err != nil {
 			return fmt.Errorf("failed to delete use sync config")
 		}
 
+		if err := eventjournal.DeleteJournal(journalDir, userID); err != nil {
+			logUser.WithError(err).Error("Failed to delete event journal")
+		}
+
 		if err := bridge.vault.DeleteUser(userID); err != nil {
 			logUser.WithError(err).Error("Failed to delete vault user")
 		}
@@ -300,6 +380,45 @@ func (bridge *Bridge) DeleteUser(ctx context.Context, userID string) error {
 	}, bridge.usersLock)
 }
 
+// RemoveUserKeepData removes the given user from bridge but keeps its local data: the encrypted message cache, the
+// sync state and the last event ID. The user's credentials are dropped. When the same account logs in again, it is
+// re-linked to its data and resumes from the last event instead of syncing again.
+func (bridge *Bridge) RemoveUserKeepData(ctx context.Context, userID string) error {
+	logUser.WithField("userID", userID).Info("Removing user, keeping its data")
+
+	return safe.LockRet(func() error {
+		if !bridge.HasUser(userID) {
+			return ErrNoSuchUser
+		}
+
+		if user, ok := bridge.users[userID]; ok {
+			bridge.logoutUser(ctx, user, true, false)
+		}
+
+		var removeErr error
+
+		if err := bridge.vault.GetUser(userID, func(user *vault.User) {
+			if removeErr = user.Clear(); removeErr != nil {
+				return
+			}
+
+			removeErr = user.SetRemoved(true)
+		}); err != nil {
+			return fmt.Errorf("failed to get vault user: %w", err)
+		}
+
+		if removeErr != nil {
+			return fmt.Errorf("failed to mark user as removed: %w", removeErr)
+		}
+
+		bridge.publish(events.UserDeleted{
+			UserID: userID,
+		})
+
+		return nil
+	}, bridge.usersLock)
+}
+
 // SetAddressMode sets the address mode for the given user.
 func (bridge *Bridge) SetAddressMode(ctx context.Context, userID string, mode vault.AddressMode) error {
 	logUser.WithField("userID", userID).WithField("mode", mode).Info("Setting address mode")
@@ -336,6 +455,110 @@ func (bridge *Bridge) SetAddressMode(ctx context.Context, userID string, mode va
 	}, bridge.usersLock)
 }
 
+// SetUserHideAllMail sets whether the All Mail mailbox is hidden for the given user.
+// Hiding All Mail avoids clients downloading every message twice, e.g. in combined mode.
+func (bridge *Bridge) SetUserHideAllMail(userID string, hide bool) error {
+	logUser.WithField("userID", userID).WithField("hide", hide).Info("Setting hide all mail")
+
+	return safe.RLockRet(func() error {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		if err := user.SetHideAllMail(hide); err != nil {
+			return fmt.Errorf("failed to set hide all mail: %w", err)
+		}
+
+		user.SetShowAllMail(bridge.vault.GetShowAllMail())
+
+		return nil
+	}, bridge.usersLock)
+}
+
+// SetUserRepairMIME sets whether malformed MIME is repaired when building the given user's messages.
+// The original message is kept as an attachment of the repaired one. The user is resynced to rebuild its messages.
+func (bridge *Bridge) SetUserRepairMIME(userID string, repair bool) error {
+	logUser.WithField("userID", userID).WithField("repair", repair).Info("Setting repair MIME")
+
+	return safe.RLockRet(func() error {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		if err := user.SetRepairMIME(repair); err != nil {
+			return fmt.Errorf("failed to set repair MIME: %w", err)
+		}
+
+		return nil
+	}, bridge.usersLock)
+}
+
+// SetUserSyncCutoff sets the time before which the given user's messages are not synced; zero means all messages are.
+// Older messages are removed from the local store, and the messages a wider sync window now covers are synced.
+func (bridge *Bridge) SetUserSyncCutoff(ctx context.Context, userID string, cutoff time.Time) error {
+	logUser.WithField("userID", userID).WithField("cutoff", cutoff).Info("Setting sync cutoff")
+
+	if cutoff.After(bridge.clock.Now()) {
+		return ErrInvalidSyncCutoff
+	}
+
+	return safe.RLockRet(func() error {
+		u, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		if err := u.SetSyncCutoff(ctx, cutoff); err != nil {
+			return fmt.Errorf("failed to set sync cutoff: %w", err)
+		}
+
+		return nil
+	}, bridge.usersLock)
+}
+
+// SetUserReadReceiptMode sets how read receipt requests of the given user's received messages are handled.
+func (bridge *Bridge) SetUserReadReceiptMode(userID string, mode vault.ReadReceiptMode) error {
+	logUser.WithField("userID", userID).WithField("mode", mode).Info("Setting read receipt mode")
+
+	return safe.RLockRet(func() error {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		if err := user.SetReadReceiptMode(mode); err != nil {
+			return fmt.Errorf("failed to set read receipt mode: %w", err)
+		}
+
+		return nil
+	}, bridge.usersLock)
+}
+
+// SendUserReadReceipt sends the read receipt requested by the given message of the given user.
+// It is used to answer an events.UserReadReceiptRequested event.
+func (bridge *Bridge) SendUserReadReceipt(ctx context.Context, userID, messageID string) error {
+	logUser.WithField("userID", userID).WithField("messageID", messageID).Info("Sending read receipt")
+
+	return safe.RLockRet(func() error {
+		u, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		if err := u.SendReadReceipt(ctx, messageID); err != nil {
+			if errors.Is(err, user.ErrNoReadReceiptRequested) {
+				return ErrNoReadReceiptRequested
+			}
+
+			return fmt.Errorf("failed to send read receipt: %w", err)
+		}
+
+		return nil
+	}, bridge.usersLock)
+}
+
 // SendBadEventUserFeedback passes the feedback to the given user.
 func (bridge *Bridge) SendBadEventUserFeedback(_ context.Context, userID string, doResync bool) error {
 	logUser.WithField("userID", userID).WithField("doResync", doResync).Info("Passing bad event feedback to user")
@@ -408,6 +631,25 @@ func (bridge *Bridge) loadUsers(ctx context.Context) error {
 
 		if user.AuthUID() == "" {
 			log.Info("User is not connected (skipping)")
+
+			// Remind the frontends that the user was asked to sign in again.
+			if user.PendingReauth() && bridge.vault.GetReauthOnDeauth() {
+				bridge.publish(events.UserReauthRequired{
+					UserID:   user.UserID(),
+					Username: user.Username(),
+				})
+			}
+
+			return nil
+		}
+
+		if bridge.locked.Load() {
+			log.Info("Bridge is locked (skipping)")
+			return nil
+		}
+
+		if bridge.usersUnloaded.Load() {
+			log.Info("Users are unloaded while the vault or their stores are re-encrypted (skipping)")
 			return nil
 		}
 
@@ -491,6 +733,25 @@ func (bridge *Bridge) addUser(
 		return fmt.Errorf("failed to add vault user: %w", err)
 	}
 
+	if !isNew && vaultUser.Removed() {
+		// The account logged in again after being removed with its data kept; it resumes from its last event.
+		// If that event is no longer valid, the user is resynced when loaded.
+		logUser.WithField("userID", apiUser.ID).WithField("eventID", vaultUser.EventID()).Info("Re-linking user to its kept data")
+
+		if err := vaultUser.SetRemoved(false); err != nil {
+			return fmt.Errorf("failed to re-link vault user: %w", err)
+		}
+	}
+
+	if !isNew && vaultUser.PendingReauth() {
+		// The user signed in again after its session was revoked; it resumes from its last event with its local data.
+		logUser.WithField("userID", apiUser.ID).WithField("eventID", vaultUser.EventID()).Info("User signed in again, resuming from kept data")
+
+		if err := vaultUser.SetPendingReauth(false); err != nil {
+			return fmt.Errorf("failed to clear pending re-authentication: %w", err)
+		}
+	}
+
 	if err := bridge.addUserWithVault(ctx, client, apiUser, vaultUser, isNew); err != nil {
 		if _, ok := err.(*resty.ResponseError); ok || isLogin {
 			logUser.WithError(err).Error("Failed to add user, clearing its secrets from vault")
@@ -533,6 +794,11 @@ func (bridge *Bridge) addUserWithVault(
 		return fmt.Errorf("failed to get IMAP sync config path: %w", err)
 	}
 
+	journalPath, err := bridge.locator.ProvideEventJournalPath()
+	if err != nil {
+		return fmt.Errorf("failed to get event journal path: %w", err)
+	}
+
 	user, err := user.New(
 		ctx,
 		vault,
@@ -541,17 +807,20 @@ func (bridge *Bridge) addUserWithVault(
 		apiUser,
 		bridge.panicHandler,
 		bridge.vault.GetShowAllMail(),
-		bridge.vault.GetMaxSyncMemory(),
+		bridge.syncService.MemoryBudget(),
 		bridge,
 		bridge.serverManager,
 		bridge.serverManager,
 		&bridgeEventSubscription{b: bridge},
-		bridge.syncService,
+		bridge.syncScheduler,
 		bridge.observabilityService,
 		syncSettingsPath,
 		isNew,
 		bridge.notificationStore,
+		eventjournal.Open(journalPath, apiUser.ID),
 		bridge.unleashService.GetFlagValue,
+		bridge.vault.GetGluonCacheDir,
+		bridge.clock,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create user: %w", err)
@@ -627,6 +896,30 @@ func (bridge *Bridge) logoutUser(ctx context.Context, user *user.User, withAPI,
 	user.Close()
 }
 
+// deleteKeptUserData deletes the IMAP data of a user that is not loaded, such as one removed with its data kept.
+func (bridge *Bridge) deleteKeptUserData(userID string) error {
+	gluonDataDir, err := bridge.GetGluonDataDir()
+	if err != nil {
+		return fmt.Errorf("failed to get gluon data dir: %w", err)
+	}
+
+	var gluonIDs []string
+
+	if err := bridge.vault.GetUser(userID, func(user *vault.User) {
+		gluonIDs = maps.Values(user.GetGluonIDs())
+	}); err != nil {
+		return fmt.Errorf("failed to get vault user: %w", err)
+	}
+
+	for _, gluonID := range gluonIDs {
+		if err := imapsmtpserver.DeleteUserData(bridge.vault.GetGluonCacheDir(), gluonDataDir, gluonID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // getUserInfo returns information about a disconnected user.
 func getUserInfo(userID, username, primaryEmail string, state UserState, addressMode vault.AddressMode) UserInfo {
 	var addresses []string
@@ -646,14 +939,18 @@ func getUserInfo(userID, username, primaryEmail string, state UserState, address
 // getConnUserInfo returns information about a connected user.
 func getConnUserInfo(user *user.User) UserInfo {
 	return UserInfo{
-		State:       Connected,
-		UserID:      user.ID(),
-		Username:    user.Name(),
-		Addresses:   user.Emails(),
-		AddressMode: user.GetAddressMode(),
-		BridgePass:  user.BridgePass(),
-		UsedSpace:   user.UsedSpace(),
-		MaxSpace:    user.MaxSpace(),
+		State:           Connected,
+		UserID:          user.ID(),
+		Username:        user.Name(),
+		Addresses:       user.Emails(),
+		AddressMode:     user.GetAddressMode(),
+		HideAllMail:     user.GetHideAllMail(),
+		RepairMIME:      user.GetRepairMIME(),
+		ReadReceiptMode: user.GetReadReceiptMode(),
+		SyncCutoff:      user.GetSyncCutoff(),
+		BridgePass:      user.BridgePass(),
+		UsedSpace:       user.UsedSpace(),
+		MaxSpace:        user.MaxSpace(),
 	}
 }
 
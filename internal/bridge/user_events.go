@@ -25,6 +25,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
 	"github.com/ProtonMail/proton-bridge/v3/internal/user"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/sirupsen/logrus"
 )
 
@@ -42,9 +43,32 @@ func (bridge *Bridge) handleUserEvent(ctx context.Context, user *user.User, even
 }
 
 func (bridge *Bridge) handleUserDeauth(ctx context.Context, user *user.User) {
+	userID, username := user.ID(), user.Name()
+
 	safe.Lock(func() {
 		bridge.logoutUser(ctx, user, false, false)
 	}, bridge.usersLock)
+
+	if !bridge.vault.GetReauthOnDeauth() {
+		return
+	}
+
+	// The local data was kept by the logout; remember that the user should sign in again to resume from it.
+	if err := bridge.vault.GetUser(userID, func(user *vault.User) {
+		if err := user.SetPendingReauth(true); err != nil {
+			logUser.WithError(err).Error("Failed to mark user as pending re-authentication")
+		}
+	}); err != nil {
+		logUser.WithError(err).Error("Failed to get vault user")
+		return
+	}
+
+	logUser.WithField("userID", userID).Info("User session was revoked, asking user to sign in again")
+
+	bridge.publish(events.UserReauthRequired{
+		UserID:   userID,
+		Username: username,
+	})
 }
 
 func (bridge *Bridge) handleUserBadEvent(ctx context.Context, user *user.User, event events.UserBadEvent) {
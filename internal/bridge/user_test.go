@@ -22,12 +22,14 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/go-proton-api/server"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/stretchr/testify/require"
@@ -179,6 +181,107 @@ func TestBridge_LoginDeleteLogin(t *testing.T) {
 	})
 }
 
+func TestBridge_LoginRemoveKeepDataLogin(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		userID, addrID, err := s.CreateUser("imap", password)
+		require.NoError(t, err)
+
+		labelID, err := s.CreateLabel(userID, "folder", "", proton.LabelTypeFolder)
+		require.NoError(t, err)
+
+		withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+			createNumMessages(ctx, t, c, addrID, labelID, 10)
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			// Login the user and wait for it to sync.
+			require.Equal(t, userID, must(b.LoginFull(ctx, "imap", password, nil, nil)))
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			uidValidity, numMessages := getFolderStatus(t, b, userID)
+			require.Equal(t, uint32(10), numMessages)
+
+			// Remove the user, keeping its data.
+			require.NoError(t, b.RemoveUserKeepData(ctx, userID))
+
+			// The user is disconnected but still listed, with its data kept.
+			require.Equal(t, []string{userID}, b.GetUserIDs())
+			require.Empty(t, getConnectedUserIDs(t, b))
+			require.True(t, b.HasUser(userID))
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+			require.Equal(t, bridge.SignedOut, info.State)
+			require.True(t, info.DataKept)
+
+			// New messages arrive in the meantime.
+			withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+				createNumMessages(ctx, t, c, addrID, labelID, 5)
+			})
+
+			// Login the user again; it is re-linked to its data.
+			require.Equal(t, userID, must(b.LoginFull(ctx, "imap", password, nil, nil)))
+			require.Equal(t, []string{userID}, b.GetUserIDs())
+			require.Equal(t, []string{userID}, getConnectedUserIDs(t, b))
+
+			// The mailbox was not resynced: it kept its UID validity and catches up with the new messages from events.
+			require.Eventually(t, func() bool {
+				newUIDValidity, newNumMessages := getFolderStatus(t, b, userID)
+				return newUIDValidity == uidValidity && newNumMessages == 15
+			}, 10*time.Second, 100*time.Millisecond)
+		})
+	})
+}
+
+func TestBridge_LoginRemoveKeepDataDelete(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID := must(b.LoginFull(ctx, username, password, nil, nil))
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			// Remove the user, keeping its data.
+			require.NoError(t, b.RemoveUserKeepData(ctx, userID))
+
+			gluonDir, err := b.GetGluonDataDir()
+			require.NoError(t, err)
+
+			dbFiles, err := filepath.Glob(filepath.Join(gluonDir, "backend", "db", "*.db"))
+			require.NoError(t, err)
+			require.NotEmpty(t, dbFiles)
+
+			// The removed user can still be deleted, along with its kept data.
+			require.NoError(t, b.DeleteUser(ctx, userID))
+			require.Empty(t, b.GetUserIDs())
+			require.False(t, b.HasUser(userID))
+
+			dbFiles, err = filepath.Glob(filepath.Join(gluonDir, "backend", "db", "*.db"))
+			require.NoError(t, err)
+			require.Empty(t, dbFiles)
+		})
+	})
+}
+
+func getFolderStatus(t *testing.T, b *bridge.Bridge, userID string) (uint32, uint32) {
+	info, err := b.GetUserInfo(userID)
+	require.NoError(t, err)
+
+	client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+	require.NoError(t, err)
+	require.NoError(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+	defer func() { _ = client.Logout() }()
+
+	status, err := client.Select(`Folders/folder`, false)
+	require.NoError(t, err)
+
+	return status.UidValidity, status.Messages
+}
+
 func TestBridge_LoginDeauthLogin(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
 		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
@@ -251,6 +354,50 @@ func TestBridge_LoginDeauthRestartLogin(t *testing.T) {
 	})
 }
 
+func TestBridge_LoginDeauthReauth(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		var userID string
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			require.NoError(t, b.SetReauthOnDeauth(true))
+
+			// Login the user.
+			userID = must(b.LoginFull(ctx, username, password, nil, nil))
+
+			// Get a channel to receive the re-auth event.
+			eventCh, done := b.GetEvents(events.UserReauthRequired{})
+			defer done()
+
+			// Deauth the user.
+			require.NoError(t, s.RevokeUser(userID))
+
+			// The user is asked to sign in again.
+			require.Equal(t, events.UserReauthRequired{UserID: userID, Username: username}, <-eventCh)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+			require.Equal(t, bridge.SignedOut, info.State)
+			require.True(t, info.PendingReauth)
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// The user is still pending re-authentication after a restart.
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+			require.True(t, info.PendingReauth)
+
+			// Sign in again.
+			newUserID := must(b.LoginFull(ctx, username, password, nil, nil))
+			require.Equal(t, userID, newUserID)
+
+			info, err = b.GetUserInfo(userID)
+			require.NoError(t, err)
+			require.Equal(t, bridge.Connected, info.State)
+			require.False(t, info.PendingReauth)
+		})
+	})
+}
+
 func TestBridge_LoginExpireLogin(t *testing.T) {
 	const authLife = 2 * time.Second
 
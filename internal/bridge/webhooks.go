@@ -0,0 +1,246 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/sirupsen/logrus"
+)
+
+// The events webhooks can subscribe to.
+const (
+	WebhookMessageReceived = "message_received"
+	WebhookSendFailed      = "send_failed"
+	WebhookUserDeauth      = "user_deauth"
+	WebhookUpdateAvailable = "update_available"
+)
+
+// WebhookEvents lists the events webhooks can subscribe to.
+var WebhookEvents = []string{ //nolint:gochecknoglobals
+	WebhookMessageReceived,
+	WebhookSendFailed,
+	WebhookUserDeauth,
+	WebhookUpdateAvailable,
+}
+
+const (
+	// WebhookSignatureHeader carries the hex encoded HMAC-SHA256 of the request body, keyed with the webhook secret.
+	WebhookSignatureHeader = "X-Bridge-Signature"
+
+	// WebhookEventHeader carries the name of the event being delivered.
+	WebhookEventHeader = "X-Bridge-Event"
+
+	webhookTimeout     = 10 * time.Second
+	webhookMaxAttempts = 5
+	webhookRetryDelay  = time.Second
+)
+
+type webhookPayload struct {
+	Event string         `json:"event"`
+	Time  time.Time      `json:"time"`
+	Data  map[string]any `json:"data"`
+}
+
+type webhookDelivery struct {
+	webhook vault.Webhook
+	event   string
+	body    []byte
+}
+
+// GetWebhooks returns the URLs notified of bridge events.
+func (bridge *Bridge) GetWebhooks() []vault.Webhook {
+	return bridge.vault.GetWebhooks()
+}
+
+// AddWebhook registers a URL notified of the given events, replacing any previous registration of the same URL.
+// When the secret is not empty, each request is signed with it.
+func (bridge *Bridge) AddWebhook(rawURL, secret string, eventNames ...string) error {
+	if u, err := url.Parse(rawURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: %q is not an HTTP URL", ErrInvalidWebhook, rawURL)
+	}
+
+	if len(eventNames) == 0 {
+		return fmt.Errorf("%w: no events selected", ErrInvalidWebhook)
+	}
+
+	for _, name := range eventNames {
+		if !slices.Contains(WebhookEvents, name) {
+			return fmt.Errorf("%w: unknown event %q", ErrInvalidWebhook, name)
+		}
+	}
+
+	webhooks := slices.DeleteFunc(slices.Clone(bridge.vault.GetWebhooks()), func(webhook vault.Webhook) bool {
+		return webhook.URL == rawURL
+	})
+
+	return bridge.vault.SetWebhooks(append(webhooks, vault.Webhook{
+		URL:    rawURL,
+		Secret: secret,
+		Events: slices.Clone(eventNames),
+	}))
+}
+
+// RemoveWebhook stops notifying the given URL of bridge events.
+func (bridge *Bridge) RemoveWebhook(rawURL string) error {
+	webhooks := bridge.vault.GetWebhooks()
+
+	idx := slices.IndexFunc(webhooks, func(webhook vault.Webhook) bool { return webhook.URL == rawURL })
+	if idx < 0 {
+		return ErrNoSuchWebhook
+	}
+
+	return bridge.vault.SetWebhooks(slices.Delete(slices.Clone(webhooks), idx, idx+1))
+}
+
+// dispatchWebhooks queues the delivery of the event to the webhooks subscribed to it.
+func (bridge *Bridge) dispatchWebhooks(event events.Event) {
+	name, data, ok := getWebhookPayload(event)
+	if !ok {
+		return
+	}
+
+	var body []byte
+
+	for _, webhook := range bridge.vault.GetWebhooks() {
+		if !slices.Contains(webhook.Events, name) {
+			continue
+		}
+
+		if body == nil {
+			b, err := json.Marshal(webhookPayload{Event: name, Time: bridge.clock.Now().UTC(), Data: data})
+			if err != nil {
+				logPkg.WithError(err).Error("Failed to encode webhook payload")
+				return
+			}
+
+			body = b
+		}
+
+		bridge.webhookCh.Enqueue(webhookDelivery{webhook: webhook, event: name, body: body})
+	}
+}
+
+// deliverWebhook posts the event to the webhook, retrying with an exponential backoff on network and server errors.
+func (bridge *Bridge) deliverWebhook(ctx context.Context, delivery webhookDelivery) {
+	log := logPkg.WithFields(logrus.Fields{
+		"url":   logging.Sensitive(delivery.webhook.URL),
+		"event": delivery.event,
+	})
+
+	delay := webhookRetryDelay
+
+	for attempt := 1; ; attempt++ {
+		retry, err := bridge.postWebhook(ctx, delivery)
+		if err == nil {
+			log.Debug("Webhook delivered")
+			return
+		}
+
+		if !retry || attempt == webhookMaxAttempts {
+			log.WithError(err).WithField("attempts", attempt).Warn("Failed to deliver webhook")
+			return
+		}
+
+		log.WithError(err).WithField("attempt", attempt).Debug("Webhook delivery failed, retrying")
+
+		if !clock.Sleep(ctx, bridge.clock, delay) {
+			return
+		}
+
+		delay *= 2
+	}
+}
+
+// postWebhook sends a single delivery attempt, returning whether a failure is worth retrying.
+func (bridge *Bridge) postWebhook(ctx context.Context, delivery webhookDelivery) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.webhook.URL, bytes.NewReader(delivery.body))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.event)
+
+	if delivery.webhook.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(delivery.webhook.Secret, delivery.body))
+	}
+
+	res, err := bridge.webhookClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return false, nil
+
+	case res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected status %v", res.Status)
+
+	default:
+		return false, fmt.Errorf("unexpected status %v", res.Status)
+	}
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 of the payload, as sent in the WebhookSignatureHeader.
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookClient() *http.Client {
+	return &http.Client{Timeout: webhookTimeout}
+}
+
+// getWebhookPayload returns the webhook event name and data of the bridge event, if webhooks can subscribe to it.
+func getWebhookPayload(event events.Event) (string, map[string]any, bool) {
+	switch event := event.(type) {
+	case events.MessageReceived:
+		return WebhookMessageReceived, map[string]any{"user_id": event.UserID, "message_id": event.MessageID}, true
+
+	case events.MessageSendFailed:
+		return WebhookSendFailed, map[string]any{"user_id": event.UserID, "error": fmt.Sprint(event.Error)}, true
+
+	case events.UserDeauth:
+		return WebhookUserDeauth, map[string]any{"user_id": event.UserID}, true
+
+	case events.UpdateAvailable:
+		return WebhookUpdateAvailable, map[string]any{"version": fmt.Sprint(event.Version.Version), "compatible": event.Compatible}, true
+
+	default:
+		return "", nil, false
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_Webhooks(t *testing.T) {
+	type delivery struct {
+		header http.Header
+		body   []byte
+	}
+
+	deliveryCh := make(chan delivery, 1)
+
+	var attempts atomic.Int32
+
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Fail the first attempt to check that deliveries are retried.
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		select {
+		case deliveryCh <- delivery{header: r.Header, body: body}:
+		default:
+		}
+	}))
+	defer hook.Close()
+
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// Invalid webhooks are rejected.
+			require.ErrorIs(t, b.AddWebhook("ftp://hooks.example", "", bridge.WebhookUserDeauth), bridge.ErrInvalidWebhook)
+			require.ErrorIs(t, b.AddWebhook(hook.URL, ""), bridge.ErrInvalidWebhook)
+			require.ErrorIs(t, b.AddWebhook(hook.URL, "", "no_such_event"), bridge.ErrInvalidWebhook)
+			require.ErrorIs(t, b.RemoveWebhook(hook.URL), bridge.ErrNoSuchWebhook)
+
+			// Register a signed webhook for deauth events.
+			require.NoError(t, b.AddWebhook(hook.URL, "secret", bridge.WebhookUserDeauth))
+			require.Len(t, b.GetWebhooks(), 1)
+
+			// Log in the user, then revoke its session.
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+			require.NoError(t, s.RevokeUser(userID))
+
+			// The event is eventually delivered, signed with the secret.
+			var got delivery
+
+			select {
+			case got = <-deliveryCh:
+			case <-time.After(30 * time.Second):
+				require.FailNow(t, "webhook was not delivered")
+			}
+
+			require.Equal(t, bridge.WebhookUserDeauth, got.header.Get(bridge.WebhookEventHeader))
+			require.Equal(t, "sha256="+bridge.SignWebhookPayload("secret", got.body), got.header.Get(bridge.WebhookSignatureHeader))
+
+			var payload struct {
+				Event string            `json:"event"`
+				Data  map[string]string `json:"data"`
+			}
+
+			require.NoError(t, json.Unmarshal(got.body, &payload))
+			require.Equal(t, bridge.WebhookUserDeauth, payload.Event)
+			require.Equal(t, userID, payload.Data["user_id"])
+
+			// Remove the webhook.
+			require.NoError(t, b.RemoveWebhook(hook.URL))
+			require.Empty(t, b.GetWebhooks())
+		})
+	})
+}
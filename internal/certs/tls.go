@@ -28,13 +28,32 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"os"
+	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
-// ErrTLSCertExpiresSoon is returned when the TLS certificate is about to expire.
-var ErrTLSCertExpiresSoon = fmt.Errorf("TLS certificate will expire soon")
+var (
+	// ErrTLSCertExpiresSoon is returned when the TLS certificate is about to expire.
+	ErrTLSCertExpiresSoon = fmt.Errorf("TLS certificate will expire soon")
+
+	// ErrTLSCertNotValid is returned when the TLS certificate is expired or not valid yet.
+	ErrTLSCertNotValid = fmt.Errorf("TLS certificate is not currently valid")
+
+	// ErrNoKeyPair is returned when no certificate and key files are found in a directory.
+	ErrNoKeyPair = fmt.Errorf("no certificate and key files found")
+)
+
+// keyPairNames are the names of the certificate and key files looked for in a directory, as laid out by common tools:
+// certbot, generic PEM exports and kubernetes TLS secrets.
+var keyPairNames = [][2]string{ //nolint:gochecknoglobals
+	{"fullchain.pem", "privkey.pem"},
+	{"cert.pem", "key.pem"},
+	{"tls.crt", "tls.key"},
+}
 
 // NewTLSTemplate creates a new TLS template certificate with a random serial number.
 func NewTLSTemplate() (*x509.Certificate, error) {
@@ -63,6 +82,20 @@ func NewTLSTemplate() (*x509.Certificate, error) {
 	}, nil
 }
 
+// AddTLSTemplateHosts adds the given hosts, either IP addresses or DNS names, to the subject alternative names
+// of the template, so that clients connecting through them can verify the certificate.
+func AddTLSTemplateHosts(template *x509.Certificate, hosts ...string) {
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			if !slices.ContainsFunc(template.IPAddresses, ip.Equal) {
+				template.IPAddresses = append(template.IPAddresses, ip)
+			}
+		} else if host != "" && !slices.Contains(template.DNSNames, host) {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+}
+
 // GenerateCert generates a new TLS certificate and returns it as PEM.
 var GenerateCert = func(template *x509.Certificate) ([]byte, []byte, error) { //nolint:gochecknoglobals
 	priv, err := rsa.GenerateKey(rand.Reader, 2048)
@@ -90,6 +123,44 @@ var GenerateCert = func(template *x509.Certificate) ([]byte, []byte, error) { //
 	return certPEM.Bytes(), keyPEM.Bytes(), nil
 }
 
+// ValidateKeyPair checks that the PEM-encoded certificate matches the key and is currently valid.
+func ValidateKeyPair(certPEM, keyPEM []byte) error {
+	c, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return errors.Wrap(err, "failed to load keypair")
+	}
+
+	leaf, err := x509.ParseCertificate(c.Certificate[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to parse certificate")
+	}
+
+	if now := time.Now(); now.Before(leaf.NotBefore) || now.After(leaf.NotAfter) {
+		return ErrTLSCertNotValid
+	}
+
+	return nil
+}
+
+// FindKeyPair returns the paths of the certificate and key files in the given directory.
+func FindKeyPair(dir string) (string, string, error) {
+	for _, names := range keyPairNames {
+		certPath, keyPath := filepath.Join(dir, names[0]), filepath.Join(dir, names[1])
+
+		if isFile(certPath) && isFile(keyPath) {
+			return certPath, keyPath, nil
+		}
+	}
+
+	return "", "", ErrNoKeyPair
+}
+
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+
+	return err == nil && info.Mode().IsRegular()
+}
+
 // GetConfig tries to load TLS config or generate new one which is then returned.
 func GetConfig(certPEM, keyPEM []byte) (*tls.Config, error) {
 	c, err := tls.X509KeyPair(certPEM, keyPEM)
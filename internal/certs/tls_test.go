@@ -19,6 +19,8 @@ package certs
 
 import (
 	"crypto/tls"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -77,3 +79,62 @@ func TestNewConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, cert)
 }
+
+func TestAddTLSTemplateHosts(t *testing.T) {
+	tlsTemplate, err := NewTLSTemplate()
+	require.NoError(t, err)
+
+	ipCount := len(tlsTemplate.IPAddresses)
+
+	// Add an address, a name and the same address again.
+	AddTLSTemplateHosts(tlsTemplate, "192.168.1.10", "bridge.local", "192.168.1.10", "")
+	require.Len(t, tlsTemplate.IPAddresses, ipCount+1)
+	require.Equal(t, []string{"bridge.local"}, tlsTemplate.DNSNames)
+
+	pemCert, pemKey, err := GenerateCert(tlsTemplate)
+	require.NoError(t, err)
+
+	// The certificate is valid for the added hosts.
+	config, err := GetConfig(pemCert, pemKey)
+	require.NoError(t, err)
+	require.NoError(t, config.Certificates[0].Leaf.VerifyHostname("192.168.1.10"))
+	require.NoError(t, config.Certificates[0].Leaf.VerifyHostname("bridge.local"))
+}
+
+func TestValidateKeyPair(t *testing.T) {
+	tlsTemplate, err := NewTLSTemplate()
+	require.NoError(t, err)
+
+	certPEM, keyPEM, err := GenerateCert(tlsTemplate)
+	require.NoError(t, err)
+	require.NoError(t, ValidateKeyPair(certPEM, keyPEM))
+
+	// A key that does not match the certificate is rejected.
+	_, otherKeyPEM, err := GenerateCert(tlsTemplate)
+	require.NoError(t, err)
+	require.Error(t, ValidateKeyPair(certPEM, otherKeyPEM))
+
+	// An expired certificate is rejected.
+	tlsTemplate.NotBefore = time.Now().Add(-48 * time.Hour)
+	tlsTemplate.NotAfter = time.Now().Add(-24 * time.Hour)
+
+	certPEM, keyPEM, err = GenerateCert(tlsTemplate)
+	require.NoError(t, err)
+	require.ErrorIs(t, ValidateKeyPair(certPEM, keyPEM), ErrTLSCertNotValid)
+}
+
+func TestFindKeyPair(t *testing.T) {
+	dir := t.TempDir()
+
+	_, _, err := FindKeyPair(dir)
+	require.ErrorIs(t, err, ErrNoKeyPair)
+
+	// A certbot live directory.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "fullchain.pem"), []byte("cert"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "privkey.pem"), []byte("key"), 0o600))
+
+	certPath, keyPath, err := FindKeyPair(dir)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "fullchain.pem"), certPath)
+	require.Equal(t, filepath.Join(dir, "privkey.pem"), keyPath)
+}
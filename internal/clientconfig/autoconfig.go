@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package clientconfig
+
+import (
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+)
+
+// AutoconfigPaths are the paths at which clients look for the autoconfig XML of an email address.
+var AutoconfigPaths = []string{ //nolint:gochecknoglobals
+	"/mail/config-v1.1.xml",
+	"/.well-known/autoconfig/mail/config-v1.1.xml",
+}
+
+// NewAutoconfigHandler returns an HTTP handler serving the autoconfig XML of the account owning the email address
+// given in the emailaddress query parameter, as requested by Thunderbird and other clients.
+// The lookup returns false if no account owns the address.
+func NewAutoconfigHandler(lookup func(email string) (Settings, bool)) http.Handler {
+	mux := http.NewServeMux()
+
+	for _, path := range AutoconfigPaths {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+				return
+			}
+
+			settings, ok := lookup(r.URL.Query().Get("emailaddress"))
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+
+			b, err := ThunderbirdAutoconfig(settings)
+			if err != nil {
+				logrus.WithField("pkg", "clientconfig").WithError(err).Error("Failed to generate autoconfig")
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+
+			_, _ = w.Write(b)
+		})
+	}
+
+	return mux
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package clientconfig
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Settings describes how a mail client connects to bridge for one account.
+type Settings struct {
+	Hostname string
+
+	IMAPPort int
+	IMAPSSL  bool
+
+	SMTPPort int
+	SMTPSSL  bool
+
+	// Username is the address the client authenticates with.
+	Username    string
+	DisplayName string
+
+	// Addresses are the addresses the client can send from with this account.
+	Addresses []string
+}
+
+// Format is the format of a generated client configuration.
+type Format int
+
+const (
+	// FormatAutoconfig is the Thunderbird autoconfig XML, also understood by other clients (e.g. K-9 Mail, Evolution).
+	FormatAutoconfig Format = iota
+
+	// FormatPrefsJS is a snippet to add to the prefs.js file of a Thunderbird profile.
+	FormatPrefsJS
+)
+
+// passwordHint tells the user which password to use; generated configurations never contain the bridge password.
+const passwordHint = "Use the bridge password shown in Proton Mail Bridge as the password."
+
+// Generate returns the client configuration for the given settings in the given format.
+func Generate(settings Settings, format Format) ([]byte, error) {
+	switch format {
+	case FormatAutoconfig:
+		return ThunderbirdAutoconfig(settings)
+
+	case FormatPrefsJS:
+		return ThunderbirdPrefs(settings), nil
+
+	default:
+		return nil, fmt.Errorf("unknown client configuration format: %v", format)
+	}
+}
+
+type autoconfigServer struct {
+	Type           string `xml:"type,attr"`
+	Hostname       string `xml:"hostname"`
+	Port           int    `xml:"port"`
+	SocketType     string `xml:"socketType"`
+	Username       string `xml:"username"`
+	Authentication string `xml:"authentication"`
+}
+
+type autoconfigDoc struct {
+	URL   string `xml:"url,attr"`
+	Descr struct {
+		Lang string `xml:"lang,attr"`
+		Text string `xml:",chardata"`
+	} `xml:"descr"`
+}
+
+type autoconfig struct {
+	XMLName  xml.Name `xml:"clientConfig"`
+	Version  string   `xml:"version,attr"`
+	Provider struct {
+		ID               string           `xml:"id,attr"`
+		Domains          []string         `xml:"domain"`
+		DisplayName      string           `xml:"displayName"`
+		DisplayShortName string           `xml:"displayShortName"`
+		Incoming         autoconfigServer `xml:"incomingServer"`
+		Outgoing         autoconfigServer `xml:"outgoingServer"`
+	} `xml:"emailProvider"`
+	Documentation autoconfigDoc `xml:"documentation"`
+}
+
+// ThunderbirdAutoconfig returns the autoconfig XML (version 1.1) describing the given settings.
+func ThunderbirdAutoconfig(settings Settings) ([]byte, error) {
+	var config autoconfig
+
+	config.Version = "1.1"
+	config.Provider.ID = "bridge." + domainOf(settings.Username)
+	config.Provider.Domains = domainsOf(settings.Addresses)
+	config.Provider.DisplayName = "Proton Mail Bridge"
+	config.Provider.DisplayShortName = "Bridge"
+	config.Provider.Incoming = autoconfigServer{
+		Type:           "imap",
+		Hostname:       settings.Hostname,
+		Port:           settings.IMAPPort,
+		SocketType:     socketType(settings.IMAPSSL),
+		Username:       settings.Username,
+		Authentication: "password-cleartext",
+	}
+	config.Provider.Outgoing = autoconfigServer{
+		Type:           "smtp",
+		Hostname:       settings.Hostname,
+		Port:           settings.SMTPPort,
+		SocketType:     socketType(settings.SMTPSSL),
+		Username:       settings.Username,
+		Authentication: "password-cleartext",
+	}
+	config.Documentation.URL = "https://proton.me/support/protonmail-bridge-clients"
+	config.Documentation.Descr.Lang = "en"
+	config.Documentation.Descr.Text = passwordHint
+
+	b, err := xml.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal autoconfig: %w", err)
+	}
+
+	return append([]byte(xml.Header), append(b, '\n')...), nil
+}
+
+// ThunderbirdPrefs returns the prefs.js lines creating a Thunderbird account for the given settings.
+func ThunderbirdPrefs(settings Settings) []byte {
+	var b strings.Builder
+
+	pref := func(name string, value any) {
+		enc, _ := json.Marshal(value) //nolint:errchkjson // strings and ints always marshal.
+		fmt.Fprintf(&b, "user_pref(%q, %s);\n", name, enc)
+	}
+
+	fmt.Fprintf(&b, "// Proton Mail Bridge account for %v.\n", settings.Username)
+	fmt.Fprintf(&b, "// %v\n", passwordHint)
+	fmt.Fprintf(&b, "// Add account_bridge to the comma-separated list in mail.accountmanager.accounts.\n")
+
+	pref("mail.server.server_bridge.type", "imap")
+	pref("mail.server.server_bridge.name", settings.Username)
+	pref("mail.server.server_bridge.hostname", settings.Hostname)
+	pref("mail.server.server_bridge.port", settings.IMAPPort)
+	pref("mail.server.server_bridge.socketType", prefsSocketType(settings.IMAPSSL))
+	pref("mail.server.server_bridge.userName", settings.Username)
+	pref("mail.server.server_bridge.authMethod", prefsAuthPasswordCleartext)
+
+	pref("mail.smtpserver.smtp_bridge.hostname", settings.Hostname)
+	pref("mail.smtpserver.smtp_bridge.port", settings.SMTPPort)
+	pref("mail.smtpserver.smtp_bridge.try_ssl", prefsSocketType(settings.SMTPSSL))
+	pref("mail.smtpserver.smtp_bridge.username", settings.Username)
+	pref("mail.smtpserver.smtp_bridge.authMethod", prefsAuthPasswordCleartext)
+
+	pref("mail.identity.id_bridge.useremail", settings.Username)
+	pref("mail.identity.id_bridge.fullName", settings.DisplayName)
+	pref("mail.identity.id_bridge.smtpServer", "smtp_bridge")
+
+	pref("mail.account.account_bridge.server", "server_bridge")
+	pref("mail.account.account_bridge.identities", "id_bridge")
+
+	return []byte(b.String())
+}
+
+// Values of the socketType and authMethod Thunderbird preferences.
+const (
+	prefsSocketTypeSTARTTLS    = 2
+	prefsSocketTypeSSL         = 3
+	prefsAuthPasswordCleartext = 3
+)
+
+func prefsSocketType(ssl bool) int {
+	if ssl {
+		return prefsSocketTypeSSL
+	}
+
+	return prefsSocketTypeSTARTTLS
+}
+
+func socketType(ssl bool) string {
+	if ssl {
+		return "SSL"
+	}
+
+	return "STARTTLS"
+}
+
+func domainOf(address string) string {
+	_, domain, _ := strings.Cut(address, "@")
+
+	return strings.ToLower(domain)
+}
+
+func domainsOf(addresses []string) []string {
+	var domains []string
+
+	for _, address := range addresses {
+		if domain := domainOf(address); domain != "" && !slices.Contains(domains, domain) {
+			domains = append(domains, domain)
+		}
+	}
+
+	return domains
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package clientconfig
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testSettings() Settings {
+	return Settings{
+		Hostname:    "127.0.0.1",
+		IMAPPort:    1143,
+		IMAPSSL:     false,
+		SMTPPort:    1025,
+		SMTPSSL:     true,
+		Username:    "user@proton.me",
+		DisplayName: `User "Name"`,
+		Addresses:   []string{"user@proton.me", "alias@proton.me", "user@example.com"},
+	}
+}
+
+func TestThunderbirdAutoconfig(t *testing.T) {
+	b, err := ThunderbirdAutoconfig(testSettings())
+	require.NoError(t, err)
+
+	var config autoconfig
+
+	require.NoError(t, xml.Unmarshal(b, &config))
+	require.Equal(t, []string{"proton.me", "example.com"}, config.Provider.Domains)
+
+	require.Equal(t, "imap", config.Provider.Incoming.Type)
+	require.Equal(t, 1143, config.Provider.Incoming.Port)
+	require.Equal(t, "STARTTLS", config.Provider.Incoming.SocketType)
+	require.Equal(t, "user@proton.me", config.Provider.Incoming.Username)
+
+	require.Equal(t, "smtp", config.Provider.Outgoing.Type)
+	require.Equal(t, 1025, config.Provider.Outgoing.Port)
+	require.Equal(t, "SSL", config.Provider.Outgoing.SocketType)
+}
+
+func TestThunderbirdPrefs(t *testing.T) {
+	prefs := string(ThunderbirdPrefs(testSettings()))
+
+	require.Contains(t, prefs, `user_pref("mail.server.server_bridge.port", 1143);`)
+	require.Contains(t, prefs, `user_pref("mail.server.server_bridge.socketType", 2);`)
+	require.Contains(t, prefs, `user_pref("mail.smtpserver.smtp_bridge.try_ssl", 3);`)
+	require.Contains(t, prefs, `user_pref("mail.identity.id_bridge.fullName", "User \"Name\"");`)
+}
+
+func TestAutoconfigHandler(t *testing.T) {
+	srv := httptest.NewServer(NewAutoconfigHandler(func(email string) (Settings, bool) {
+		return testSettings(), strings.EqualFold(email, "alias@proton.me")
+	}))
+	defer srv.Close()
+
+	for _, path := range AutoconfigPaths {
+		res, err := http.Get(srv.URL + path + "?emailaddress=alias@proton.me") //nolint:noctx
+		require.NoError(t, err)
+
+		b, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Contains(t, string(b), "<port>1143</port>")
+	}
+
+	res, err := http.Get(srv.URL + AutoconfigPaths[0] + "?emailaddress=other@proton.me") //nolint:noctx
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+}
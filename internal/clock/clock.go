@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package clock abstracts the passing of time so that tests can fast-forward it.
+package clock
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock tells the time and waits for it to pass.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer fires once, after the duration it was created with. Unlike After, it can be stopped
+// so that nothing lingers when the wait is abandoned.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+}
+
+// Sleep waits for the given duration to pass on the clock; it returns false if the context is done first.
+func Sleep(ctx context.Context, clock Clock, d time.Duration) bool {
+	timer := clock.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+
+	case <-timer.C():
+		return true
+	}
+}
+
+// New returns the wall clock.
+func New() Clock {
+	return realClock{}
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{Timer: time.NewTimer(d)}
+}
+
+type realTimer struct {
+	*time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+// Fake is a clock whose time only passes when advanced.
+type Fake struct {
+	lock    sync.Mutex
+	now     time.Time
+	waiters []waiter
+}
+
+type waiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// NewFake returns a fake clock set to the given time.
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the fake clock's time.
+func (c *Fake) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.now
+}
+
+// After returns a channel which receives the time once the clock has been advanced by the given duration.
+func (c *Fake) After(d time.Duration) <-chan time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	ch := make(chan time.Time, 1)
+
+	if d <= 0 {
+		ch <- c.now
+	} else {
+		c.waiters = append(c.waiters, waiter{at: c.now.Add(d), ch: ch})
+	}
+
+	return ch
+}
+
+// NewTimer returns a timer which fires once the clock has been advanced by the given duration.
+func (c *Fake) NewTimer(d time.Duration) Timer {
+	return &fakeTimer{clock: c, ch: c.After(d)}
+}
+
+type fakeTimer struct {
+	clock *Fake
+	ch    <-chan time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+// Stop releases the timer's waiter; it returns false if the timer had already fired or been stopped.
+func (t *fakeTimer) Stop() bool {
+	t.clock.lock.Lock()
+	defer t.clock.lock.Unlock()
+
+	for i, w := range t.clock.waiters {
+		if w.ch == t.ch {
+			t.clock.waiters = append(t.clock.waiters[:i], t.clock.waiters[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Advance moves the clock forward, releasing the waiters whose time has come.
+func (c *Fake) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.now = c.now.Add(d)
+
+	waiters := c.waiters[:0]
+
+	for _, w := range c.waiters {
+		if w.at.After(c.now) {
+			waiters = append(waiters, w)
+		} else {
+			w.ch <- c.now
+		}
+	}
+
+	c.waiters = waiters
+}
+
+// Waiters returns how many callers are waiting for the clock to be advanced.
+func (c *Fake) Waiters() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return len(c.waiters)
+}
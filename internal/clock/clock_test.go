@@ -0,0 +1,88 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package clock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFake(start)
+
+	afterCh := clock.After(time.Minute)
+	require.Equal(t, 1, clock.Waiters())
+
+	// Not enough time has passed.
+	clock.Advance(30 * time.Second)
+	require.Equal(t, start.Add(30*time.Second), clock.Now())
+
+	select {
+	case <-afterCh:
+		t.Fatal("released too early")
+	default:
+	}
+
+	// The waiter is released once its time has come.
+	clock.Advance(30 * time.Second)
+	require.Equal(t, start.Add(time.Minute), <-afterCh)
+	require.Zero(t, clock.Waiters())
+
+	// Waiting for no time at all returns right away.
+	require.Equal(t, start.Add(time.Minute), <-clock.After(0))
+}
+
+func TestFake_Timer(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFake(start)
+
+	// A stopped timer no longer waits for the clock.
+	stopped := clock.NewTimer(time.Minute)
+	require.Equal(t, 1, clock.Waiters())
+	require.True(t, stopped.Stop())
+	require.Zero(t, clock.Waiters())
+
+	// A timer which has fired can't be stopped anymore.
+	fired := clock.NewTimer(time.Minute)
+	clock.Advance(time.Minute)
+	require.Equal(t, start.Add(time.Minute), <-fired.C())
+	require.False(t, fired.Stop())
+}
+
+func TestSleep(t *testing.T) {
+	clock := NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	doneCh := make(chan bool)
+
+	go func() { doneCh <- Sleep(context.Background(), clock, time.Minute) }()
+
+	require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	clock.Advance(time.Minute)
+	require.True(t, <-doneCh)
+
+	// The wait is abandoned, and the timer stopped, when the context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.False(t, Sleep(ctx, clock, time.Minute))
+	require.Zero(t, clock.Waiters())
+}
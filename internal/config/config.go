@@ -0,0 +1,234 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package config loads the declarative configuration file used to run bridge unattended, e.g. in containers.
+//
+// The file may be written in TOML (.toml) or YAML (.yaml, .yml). All fields are optional;
+// settings that are left out keep whatever value is stored in the vault.
+//
+//	log_level = "info"
+//	cache_dir = "/data/cache"
+//	ephemeral_dir = "/run/bridge"
+//	proxy = "http://proxy.local:3128"
+//
+//	[logs]
+//	max_file_size_mb = 5
+//	max_age_days = 30
+//
+//	[imap]
+//	port = 1143
+//	ssl = false
+//
+//	[[accounts]]
+//	user_id = "..."
+//	username = "alice"
+//	auth_uid = "..."
+//	refresh_token = "..."
+//	key_pass = "..."
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrUnknownFormat = errors.New("unknown config file format")
+
+const (
+	AddressModeCombined = "combined"
+	AddressModeSplit    = "split"
+)
+
+// Config is the content of a bridge configuration file.
+type Config struct {
+	// LogLevel is the default log level, optionally followed by subsystem levels, e.g. "info,imap=debug".
+	LogLevel  string `toml:"log_level" yaml:"log_level"`
+	LogFormat string `toml:"log_format" yaml:"log_format"`
+	CacheDir  string `toml:"cache_dir" yaml:"cache_dir"`
+
+	// Ephemeral keeps the message store, its database and the sync state out of persistent storage; only the vault is
+	// kept and users are fully synced again each time bridge starts. EphemeralDir, typically a tmpfs mount, implies it.
+	Ephemeral    *bool  `toml:"ephemeral" yaml:"ephemeral"`
+	EphemeralDir string `toml:"ephemeral_dir" yaml:"ephemeral_dir"`
+
+	// Logs controls the rotation, compression and pruning of the log files.
+	Logs Logs `toml:"logs" yaml:"logs"`
+
+	// Proxy is the URL of an HTTP(S) or SOCKS5 proxy used for all connections to the Proton API.
+	Proxy string `toml:"proxy" yaml:"proxy"`
+
+	// AllowAlternativeRouting controls whether bridge may fall back to DoH proxies when the API is unreachable.
+	AllowAlternativeRouting *bool `toml:"allow_alternative_routing" yaml:"allow_alternative_routing"`
+
+	ShowAllMail *bool `toml:"show_all_mail" yaml:"show_all_mail"`
+
+	IMAP Server `toml:"imap" yaml:"imap"`
+	SMTP Server `toml:"smtp" yaml:"smtp"`
+
+	Accounts []Account `toml:"accounts" yaml:"accounts"`
+}
+
+// Logs holds the log rotation settings. Sizes are in megabytes and ages in days; zero disables the corresponding limit.
+type Logs struct {
+	MaxFileSizeMB  *int64 `toml:"max_file_size_mb" yaml:"max_file_size_mb"`
+	MaxTotalSizeMB *int64 `toml:"max_total_size_mb" yaml:"max_total_size_mb"`
+	MaxAgeDays     *int   `toml:"max_age_days" yaml:"max_age_days"`
+	Compress       *bool  `toml:"compress" yaml:"compress"`
+}
+
+// Server holds the settings of one of the mail servers.
+type Server struct {
+	Port *int  `toml:"port" yaml:"port"`
+	SSL  *bool `toml:"ssl" yaml:"ssl"`
+}
+
+// Account is a pre-provisioned account, logged in with a stored refresh token rather than interactively.
+type Account struct {
+	UserID       string `toml:"user_id" yaml:"user_id"`
+	Username     string `toml:"username" yaml:"username"`
+	Email        string `toml:"email" yaml:"email"`
+	AuthUID      string `toml:"auth_uid" yaml:"auth_uid"`
+	RefreshToken string `toml:"refresh_token" yaml:"refresh_token"`
+
+	// KeyPass is the base64-encoded salted mailbox passphrase.
+	KeyPass string `toml:"key_pass" yaml:"key_pass"`
+
+	// BridgePassword is the password IMAP/SMTP clients use; a random one is generated if empty.
+	BridgePassword string `toml:"bridge_password" yaml:"bridge_password"`
+
+	// AddressMode is either "combined" (the default) or "split".
+	AddressMode string `toml:"address_mode" yaml:"address_mode"`
+}
+
+// Load reads and validates the configuration file at the given path.
+func Load(path string) (*Config, error) {
+	b, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var cfg Config
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".toml":
+		if err := toml.NewDecoder(bytes.NewReader(b)).DisallowUnknownFields().Decode(&cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config file: %w", err)
+		}
+
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(b))
+		dec.KnownFields(true)
+
+		if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("failed to parse config file: %w", err)
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, ext)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config file: %w", err)
+	}
+
+	return &cfg, nil
+}
+
+// Validate checks that the configuration values are usable.
+func (cfg *Config) Validate() error {
+	if _, _, err := logging.ParseLevels(cfg.LogLevel); err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
+
+	if cfg.LogFormat != "" && cfg.LogFormat != "text" && cfg.LogFormat != "json" {
+		return fmt.Errorf("invalid log format %q", cfg.LogFormat)
+	}
+
+	if cfg.Logs.MaxFileSizeMB != nil && *cfg.Logs.MaxFileSizeMB < 1 {
+		return fmt.Errorf("invalid maximum log file size: %v", *cfg.Logs.MaxFileSizeMB)
+	}
+
+	if cfg.Logs.MaxTotalSizeMB != nil && *cfg.Logs.MaxTotalSizeMB < 0 {
+		return fmt.Errorf("invalid maximum total log size: %v", *cfg.Logs.MaxTotalSizeMB)
+	}
+
+	if cfg.Logs.MaxAgeDays != nil && *cfg.Logs.MaxAgeDays < 0 {
+		return fmt.Errorf("invalid maximum log age: %v", *cfg.Logs.MaxAgeDays)
+	}
+
+	if cfg.EphemeralDir != "" && !filepath.IsAbs(cfg.EphemeralDir) {
+		return fmt.Errorf("invalid ephemeral dir %q: the path must be absolute", cfg.EphemeralDir)
+	}
+
+	if cfg.Proxy != "" {
+		proxyURL, err := url.Parse(cfg.Proxy)
+		if err != nil {
+			return fmt.Errorf("invalid proxy: %w", err)
+		}
+
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5", "socks5h":
+
+		default:
+			return fmt.Errorf("invalid proxy: unsupported scheme %q", proxyURL.Scheme)
+		}
+	}
+
+	for name, server := range map[string]Server{"imap": cfg.IMAP, "smtp": cfg.SMTP} {
+		if server.Port != nil && (*server.Port < 1 || *server.Port > 65535) {
+			return fmt.Errorf("invalid %v port: %v", name, *server.Port)
+		}
+	}
+
+	seen := make(map[string]struct{})
+
+	for idx, account := range cfg.Accounts {
+		if account.UserID == "" || account.AuthUID == "" || account.RefreshToken == "" || account.KeyPass == "" {
+			return fmt.Errorf("account %v: user_id, auth_uid, refresh_token and key_pass are required", idx)
+		}
+
+		if _, ok := seen[account.UserID]; ok {
+			return fmt.Errorf("account %v: duplicate user_id %q", idx, account.UserID)
+		}
+
+		seen[account.UserID] = struct{}{}
+
+		switch account.AddressMode {
+		case "", AddressModeCombined, AddressModeSplit:
+
+		default:
+			return fmt.Errorf("account %v: invalid address mode %q", idx, account.AddressMode)
+		}
+	}
+
+	return nil
+}
+
+// IsSOCKSProxy returns whether the proxy is a SOCKS5 proxy rather than an HTTP(S) one.
+func (cfg *Config) IsSOCKSProxy() bool {
+	return strings.HasPrefix(cfg.Proxy, "socks5://") || strings.HasPrefix(cfg.Proxy, "socks5h://")
+}
@@ -23,6 +23,8 @@ import (
 	"net"
 	"net/http"
 	"time"
+
+	"golang.org/x/net/proxy"
 )
 
 type TLSDialer interface {
@@ -51,7 +53,7 @@ func CreateTransportWithDialer(dialer TLSDialer) *http.Transport {
 	t := &http.Transport{
 		DialTLSContext: dialer.DialTLSContext,
 
-		Proxy: http.ProxyFromEnvironment,
+		Proxy: proxyFromEnvironment,
 	}
 
 	SetBasicTransportTimeouts(t)
@@ -62,6 +64,7 @@ func CreateTransportWithDialer(dialer TLSDialer) *http.Transport {
 // BasicTLSDialer implements TLSDialer.
 type BasicTLSDialer struct {
 	hostURL string
+	verify  bool
 }
 
 // NewBasicTLSDialer returns a new BasicTLSDialer.
@@ -71,14 +74,49 @@ func NewBasicTLSDialer(hostURL string) *BasicTLSDialer {
 	}
 }
 
+// VerifyCertificates makes the dialer verify the servers' certificates against the system roots and hostnames.
+// Otherwise they must be checked by the caller, e.g. against the pinned keys of the production API.
+func (d *BasicTLSDialer) VerifyCertificates() {
+	d.verify = true
+}
+
 // DialTLSContext returns a connection to the given address using the given network.
+// The connection goes through the SOCKS5 proxy, if one is set.
 func (d *BasicTLSDialer) DialTLSContext(ctx context.Context, network, address string) (conn net.Conn, err error) {
+	if socksDialer := getSOCKSDialer(); socksDialer != nil {
+		return d.dialTLSThroughSOCKS(ctx, socksDialer, network, address)
+	}
+
 	return (&tls.Dialer{
 		NetDialer: &net.Dialer{
 			Timeout: 30 * time.Second,
 		},
 		Config: &tls.Config{
-			InsecureSkipVerify: address != d.hostURL, //nolint:gosec
+			InsecureSkipVerify: !d.verify, //nolint:gosec
 		},
 	}).DialContext(ctx, network, address)
 }
+
+func (d *BasicTLSDialer) dialTLSThroughSOCKS(ctx context.Context, socksDialer proxy.ContextDialer, network, address string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := socksDialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConn := tls.Client(conn, &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: !d.verify, //nolint:gosec
+	})
+
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return tlsConn, nil
+}
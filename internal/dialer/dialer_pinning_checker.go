@@ -21,6 +21,7 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"net"
 
 	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
 )
@@ -38,6 +39,19 @@ func NewTLSPinChecker(trustedPins []string) *TLSPinChecker {
 	}
 }
 
+// AnyPinChecker accepts any certificate; it is used for API hosts whose keys are not pinned.
+// Their certificates must then be verified by the TLS dialer, see BasicTLSDialer.VerifyCertificates.
+type AnyPinChecker struct{}
+
+func NewAnyPinChecker() *AnyPinChecker {
+	return &AnyPinChecker{}
+}
+
+// CheckCertificate accepts any connection.
+func (p *AnyPinChecker) CheckCertificate(net.Conn) error {
+	return nil
+}
+
 func certFingerprint(cert *x509.Certificate) string {
 	return fmt.Sprintf(`pin-sha256=%q`, algo.HashBase64SHA256(string(cert.RawSubjectPublicKeyInfo)))
 }
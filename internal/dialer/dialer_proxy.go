@@ -39,6 +39,7 @@ type ProxyTLSDialer struct {
 	directAddress    string
 	proxyAddress     string
 	allowProxy       bool
+	forbidProxy      bool
 	proxyProvider    *proxyProvider
 	proxyUseDuration time.Duration
 
@@ -154,9 +155,44 @@ func (d *ProxyTLSDialer) AllowProxy() {
 	d.locker.Lock()
 	defer d.locker.Unlock()
 
+	if d.forbidProxy {
+		return
+	}
+
 	d.allowProxy = true
 }
 
+// ForbidProxy prevents the dialer from ever switching to a proxy, even if AllowProxy is called afterwards.
+// It is used when the API is not reached on its production address, which the proxies don't serve.
+func (d *ProxyTLSDialer) ForbidProxy() {
+	d.locker.Lock()
+	defer d.locker.Unlock()
+
+	d.forbidProxy = true
+	d.allowProxy = false
+	d.proxyAddress = d.directAddress
+}
+
+// SetDoHProviders sets the DoH providers queried, in order, to find proxies.
+func (d *ProxyTLSDialer) SetDoHProviders(providers []string) {
+	d.proxyProvider.setProviders(providers)
+}
+
+// SetSOCKSProxy sets the SOCKS5 proxy that all connections, including those of alternative routing, go through.
+func (d *ProxyTLSDialer) SetSOCKSProxy(proxyURL string) error {
+	return SetSOCKSProxy(proxyURL)
+}
+
+// SetPACSource sets the URL or file path of the PAC script used to select the proxy of each connection.
+func (d *ProxyTLSDialer) SetPACSource(source string) error {
+	return SetPACSource(source)
+}
+
+// ReloadPAC loads the PAC script again, e.g. after the network changed.
+func (d *ProxyTLSDialer) ReloadPAC() error {
+	return ReloadPAC()
+}
+
 // DisallowProxy prevents the dialer from switching to a proxy if need be.
 func (d *ProxyTLSDialer) DisallowProxy() {
 	d.locker.Lock()
@@ -20,6 +20,8 @@ package dialer
 import (
 	"context"
 	"encoding/base64"
+	"net/url"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -50,6 +52,53 @@ var DoHProviders = []string{ //nolint:gochecknoglobals
 	GoogleProvider,
 }
 
+var (
+	ErrInvalidDoHProvider = errors.New("invalid DoH provider")
+	ErrNoDoHProvider      = errors.New("no DoH provider left")
+)
+
+// ValidateDoHProvider checks that the given DoH provider is an HTTPS URL.
+func ValidateDoHProvider(provider string) error {
+	u, err := url.Parse(provider)
+	if err != nil {
+		return errors.Wrap(ErrInvalidDoHProvider, err.Error())
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return errors.Wrapf(ErrInvalidDoHProvider, "%q is not an HTTPS URL", provider)
+	}
+
+	return nil
+}
+
+// ResolveDoHProviders returns the DoH providers to query, in order: the custom ones first,
+// then the default ones that are not disabled.
+func ResolveDoHProviders(custom, disabled []string) ([]string, error) {
+	var providers []string
+
+	for _, provider := range custom {
+		if err := ValidateDoHProvider(provider); err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(providers, provider) {
+			providers = append(providers, provider)
+		}
+	}
+
+	for _, provider := range DoHProviders {
+		if !slices.Contains(disabled, provider) && !slices.Contains(providers, provider) {
+			providers = append(providers, provider)
+		}
+	}
+
+	if len(providers) == 0 {
+		return nil, ErrNoDoHProvider
+	}
+
+	return providers, nil
+}
+
 // proxyProvider manages known proxies.
 type proxyProvider struct {
 	dialer TLSDialer
@@ -59,9 +108,10 @@ type proxyProvider struct {
 	// dohLookup is used to look up the given query at the given DoH provider, returning the TXT records>
 	dohLookup func(ctx context.Context, query, provider string) (urls []string, err error)
 
-	providers  []string // List of known doh providers.
-	query      string   // The query string used to find proxies.
-	proxyCache []string // All known proxies, cached in case DoH providers are unreachable.
+	providers     []string // List of known doh providers.
+	providersLock sync.RWMutex
+	query         string   // The query string used to find proxies.
+	proxyCache    []string // All known proxies, cached in case DoH providers are unreachable.
 
 	cacheRefreshTimeout time.Duration
 	dohTimeout          time.Duration
@@ -92,6 +142,22 @@ func newProxyProvider(dialer TLSDialer, hostURL string, providers []string, pani
 	return
 }
 
+// getProviders returns the DoH providers to query, in order.
+func (p *proxyProvider) getProviders() []string {
+	p.providersLock.RLock()
+	defer p.providersLock.RUnlock()
+
+	return p.providers
+}
+
+// setProviders replaces the DoH providers to query.
+func (p *proxyProvider) setProviders(providers []string) {
+	p.providersLock.Lock()
+	defer p.providersLock.Unlock()
+
+	p.providers = providers
+}
+
 // findReachableServer returns a working API server (either proxy or standard API).
 //
 //nolint:nakedret
@@ -160,7 +226,7 @@ func (p *proxyProvider) refreshProxyCache() error {
 	go func() {
 		defer async.HandlePanic(p.panicHandler)
 
-		for _, provider := range p.providers {
+		for _, provider := range p.getProviders() {
 			if proxies, err := p.dohLookup(ctx, p.query, provider); err == nil {
 				resultChan <- proxies
 				return
@@ -230,7 +296,7 @@ func (p *proxyProvider) defaultDoHLookup(ctx context.Context, query, dohProvider
 		encodedRequest := base64.RawURLEncoding.EncodeToString(rawRequest)
 
 		// Make DoH request to the given DoH provider.
-		rawResponse, err := resty.New().R().SetContext(ctx).SetQueryParam("dns", encodedRequest).Get(dohProvider)
+		rawResponse, err := resty.New().SetTransport(newSOCKSAwareTransport()).R().SetContext(ctx).SetQueryParam("dns", encodedRequest).Get(dohProvider)
 		if err != nil {
 			errChan <- errors.Wrap(err, "failed to make DoH request")
 			return
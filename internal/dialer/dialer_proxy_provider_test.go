@@ -19,6 +19,7 @@ package dialer
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"testing"
 	"time"
@@ -191,3 +192,52 @@ func TestProxyProvider_DoHLookup_FindProxyFirstProviderUnreachable(t *testing.T)
 	r.NoError(t, err)
 	r.NotEmpty(t, url)
 }
+
+func TestResolveDoHProviders(t *testing.T) {
+	providers, err := ResolveDoHProviders(nil, nil)
+	r.NoError(t, err)
+	r.Equal(t, DoHProviders, providers)
+
+	// Custom providers come first, duplicates are dropped and disabled defaults are skipped.
+	providers, err = ResolveDoHProviders(
+		[]string{"https://doh.example/dns-query", GoogleProvider, "https://doh.example/dns-query"},
+		[]string{Quad9PortProvider},
+	)
+	r.NoError(t, err)
+	r.Equal(t, []string{"https://doh.example/dns-query", GoogleProvider, Quad9Provider}, providers)
+
+	_, err = ResolveDoHProviders([]string{"http://doh.example/dns-query"}, nil)
+	r.ErrorIs(t, err, ErrInvalidDoHProvider)
+
+	_, err = ResolveDoHProviders([]string{"doh.example"}, nil)
+	r.ErrorIs(t, err, ErrInvalidDoHProvider)
+
+	_, err = ResolveDoHProviders(nil, DoHProviders)
+	r.ErrorIs(t, err, ErrNoDoHProvider)
+}
+
+func TestProxyProvider_FindProxy_SetProviders(t *testing.T) {
+	proxy := getTrustedServer()
+	defer closeServer(proxy)
+
+	p := newProxyProvider(NewBasicTLSDialer(""), "", []string{"https://unused.example"}, async.NoopPanicHandler{})
+
+	var queried []string
+
+	p.dohLookup = func(_ context.Context, _, provider string) ([]string, error) {
+		queried = append(queried, provider)
+
+		if provider != "https://second.example" {
+			return nil, errors.New("unreachable")
+		}
+
+		return []string{proxy.URL}, nil
+	}
+
+	p.setProviders([]string{"https://first.example", "https://second.example"})
+
+	url, err := p.findReachableServer()
+	r.NoError(t, err)
+	r.Equal(t, proxy.URL, url)
+	r.Equal(t, []string{"https://first.example", "https://second.example"}, queried)
+}
@@ -264,6 +264,20 @@ func TestProxyDialer_UseProxy_FindSecondAlternativeIfFirstFailsAndAPIIsStillBloc
 	require.Equal(t, formatAsAddress(proxy2.URL), d.proxyAddress)
 }
 
+func TestProxyDialer_ForbidProxy(t *testing.T) {
+	d := NewProxyTLSDialer(NewBasicTLSDialer(""), "", async.NoopPanicHandler{})
+
+	d.AllowProxy()
+	require.True(t, d.allowProxy)
+
+	// Once forbidden, the proxy can't be allowed again.
+	d.ForbidProxy()
+	require.False(t, d.allowProxy)
+
+	d.AllowProxy()
+	require.False(t, d.allowProxy)
+}
+
 func TestFormatAsAddress(t *testing.T) {
 	r := require.New(t)
 	testData := map[string]string{
@@ -18,8 +18,13 @@
 package dialer
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	r "github.com/stretchr/testify/require"
 	"golang.org/x/net/http/httpproxy"
 )
 
@@ -31,3 +36,24 @@ func skipIfProxyIsSet(t *testing.T) {
 		t.SkipNow()
 	}
 }
+
+func TestBasicTLSDialer_VerifyCertificates(t *testing.T) {
+	skipIfProxyIsSet(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	address := strings.TrimPrefix(server.URL, "https://")
+
+	// The self-signed certificate is accepted when it is checked otherwise...
+	conn, err := NewBasicTLSDialer(server.URL).DialTLSContext(context.Background(), "tcp", address)
+	r.NoError(t, err)
+	r.NoError(t, conn.Close())
+
+	// ...but not when it must be verified against the system roots.
+	dialer := NewBasicTLSDialer(server.URL)
+	dialer.VerifyCertificates()
+
+	_, err = dialer.DialTLSContext(context.Background(), "tcp", address)
+	r.Error(t, err)
+}
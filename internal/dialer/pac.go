@@ -0,0 +1,220 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package dialer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/pkg/pac"
+	"github.com/sirupsen/logrus"
+)
+
+var ErrInvalidPAC = errors.New("invalid PAC file")
+
+// maxPACSize bounds the size of the PAC files we load.
+const maxPACSize = 1 << 20
+
+// pacProxy is the proxy auto-config script used to select the proxy of each connection, if set.
+// Results are cached per scheme and host until the script is reloaded.
+var pacProxy struct { //nolint:gochecknoglobals
+	lock   sync.RWMutex
+	source string
+	script *pac.PAC
+	cache  map[string]*url.URL
+}
+
+// SetPACSource sets the URL or file path of the PAC script used to select proxies.
+// An empty source disables it.
+func SetPACSource(source string) error {
+	if source == "" {
+		pacProxy.lock.Lock()
+		defer pacProxy.lock.Unlock()
+
+		pacProxy.source, pacProxy.script, pacProxy.cache = "", nil, nil
+
+		return nil
+	}
+
+	script, err := loadPAC(source)
+	if err != nil {
+		return err
+	}
+
+	pacProxy.lock.Lock()
+	defer pacProxy.lock.Unlock()
+
+	pacProxy.source, pacProxy.script, pacProxy.cache = source, script, make(map[string]*url.URL)
+
+	return nil
+}
+
+// GetPACSource returns the URL or file path of the PAC script, or an empty string if there is none.
+func GetPACSource() string {
+	pacProxy.lock.RLock()
+	defer pacProxy.lock.RUnlock()
+
+	return pacProxy.source
+}
+
+// ReloadPAC forgets the proxies selected so far and loads the PAC script again.
+// It is meant to be called when the network changes; if the script can't be loaded, the previous one is kept.
+func ReloadPAC() error {
+	source := GetPACSource()
+	if source == "" {
+		return nil
+	}
+
+	pacProxy.lock.Lock()
+	pacProxy.cache = make(map[string]*url.URL)
+	pacProxy.lock.Unlock()
+
+	script, err := loadPAC(source)
+	if err != nil {
+		return err
+	}
+
+	pacProxy.lock.Lock()
+	defer pacProxy.lock.Unlock()
+
+	if pacProxy.source == source {
+		pacProxy.script = script
+	}
+
+	return nil
+}
+
+// proxyFromPAC returns the proxy selected by the PAC script for the given request.
+// The boolean is false if there is no PAC script.
+func proxyFromPAC(req *http.Request) (*url.URL, bool) {
+	pacProxy.lock.RLock()
+	script := pacProxy.script
+	pacProxy.lock.RUnlock()
+
+	if script == nil {
+		return nil, false
+	}
+
+	key := req.URL.Scheme + "://" + req.URL.Host
+
+	pacProxy.lock.RLock()
+	proxyURL, ok := pacProxy.cache[key]
+	pacProxy.lock.RUnlock()
+
+	if ok {
+		return proxyURL, true
+	}
+
+	proxies, err := script.FindProxyForURL(req.URL)
+	if err != nil {
+		logrus.WithError(err).WithField("host", req.URL.Host).Warn("Failed to evaluate PAC script, connecting directly")
+		return nil, true
+	}
+
+	// The transport can only use one proxy, so we take the first one.
+	proxyURL = proxies[0].URL()
+
+	pacProxy.lock.Lock()
+	defer pacProxy.lock.Unlock()
+
+	if pacProxy.script == script {
+		pacProxy.cache[key] = proxyURL
+	}
+
+	return proxyURL, true
+}
+
+// loadPAC loads and compiles the PAC script found at the given URL or file path.
+func loadPAC(source string) (*pac.PAC, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	switch {
+	case strings.HasPrefix(source, "http://"), strings.HasPrefix(source, "https://"):
+		data, err = fetchPAC(source)
+
+	case strings.HasPrefix(source, "file://"):
+		u, parseErr := url.Parse(source)
+		if parseErr != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPAC, parseErr)
+		}
+
+		data, err = readPAC(u.Path)
+
+	default:
+		data, err = readPAC(source)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPAC, err)
+	}
+
+	script, err := pac.Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPAC, err)
+	}
+
+	return script, nil
+}
+
+// fetchPAC downloads the PAC script. It is fetched directly, as PAC files are usually served on the local network.
+func fetchPAC(rawURL string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{Transport: &http.Transport{}}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close() //nolint:errcheck
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %v", res.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(res.Body, maxPACSize))
+}
+
+func readPAC(path string) ([]byte, error) {
+	file, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close() //nolint:errcheck
+
+	return io.ReadAll(io.LimitReader(file, maxPACSize))
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package dialer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetPACSource_File(t *testing.T) {
+	defer func() { require.NoError(t, SetPACSource("")) }()
+
+	path := filepath.Join(t.TempDir(), "proxy.pac")
+
+	require.NoError(t, os.WriteFile(path, []byte(`function FindProxyForURL(url, host) {
+		return dnsDomainIs(host, ".proton.me") ? "PROXY proxy.example:3128" : "DIRECT";
+	}`), 0o600))
+
+	require.NoError(t, SetPACSource(path))
+	require.Equal(t, path, GetPACSource())
+
+	req, err := http.NewRequest(http.MethodGet, "https://mail-api.proton.me/tests/ping", nil)
+	require.NoError(t, err)
+
+	proxyURL, err := proxyFromEnvironment(req)
+	require.NoError(t, err)
+	require.Equal(t, "http://proxy.example:3128", proxyURL.String())
+
+	req, err = http.NewRequest(http.MethodGet, "https://example.com/", nil)
+	require.NoError(t, err)
+
+	proxyURL, err = proxyFromEnvironment(req)
+	require.NoError(t, err)
+	require.Nil(t, proxyURL)
+
+	// The SOCKS5 proxy takes precedence over the PAC script.
+	require.NoError(t, SetSOCKSProxy("socks5://127.0.0.1:1080"))
+	defer func() { require.NoError(t, SetSOCKSProxy("")) }()
+
+	proxyURL, err = proxyFromEnvironment(req)
+	require.NoError(t, err)
+	require.Nil(t, proxyURL)
+}
+
+func TestSetPACSource_URL(t *testing.T) {
+	defer func() { require.NoError(t, SetPACSource("")) }()
+
+	script := `function FindProxyForURL(url, host) { return "PROXY first.example:3128"; }`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/x-ns-proxy-autoconfig")
+		_, _ = w.Write([]byte(script))
+	}))
+	defer server.Close()
+
+	require.NoError(t, SetPACSource(server.URL+"/proxy.pac"))
+
+	req, err := http.NewRequest(http.MethodGet, "https://mail-api.proton.me/", nil)
+	require.NoError(t, err)
+
+	proxyURL, err := proxyFromEnvironment(req)
+	require.NoError(t, err)
+	require.Equal(t, "first.example:3128", proxyURL.Host)
+
+	// After a network change, the script is fetched again and the proxy selected anew.
+	script = `function FindProxyForURL(url, host) { return "SOCKS5 second.example:1080"; }`
+
+	require.NoError(t, ReloadPAC())
+
+	proxyURL, err = proxyFromEnvironment(req)
+	require.NoError(t, err)
+	require.Equal(t, "socks5://second.example:1080", proxyURL.String())
+
+	// If the script can't be fetched anymore, the previous one is kept.
+	server.Close()
+
+	require.ErrorIs(t, ReloadPAC(), ErrInvalidPAC)
+
+	proxyURL, err = proxyFromEnvironment(req)
+	require.NoError(t, err)
+	require.Equal(t, "socks5://second.example:1080", proxyURL.String())
+}
+
+func TestSetPACSource_Invalid(t *testing.T) {
+	require.ErrorIs(t, SetPACSource(filepath.Join(t.TempDir(), "missing.pac")), ErrInvalidPAC)
+
+	path := filepath.Join(t.TempDir(), "proxy.pac")
+	require.NoError(t, os.WriteFile(path, []byte(`var proxy = "DIRECT";`), 0o600))
+	require.ErrorIs(t, SetPACSource(path), ErrInvalidPAC)
+
+	require.Equal(t, "", GetPACSource())
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package dialer
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
+)
+
+const (
+	// minRateLimitBackoff and maxRateLimitBackoff bound the backoff used when the API doesn't say when to retry.
+	minRateLimitBackoff = 2 * time.Second
+	maxRateLimitBackoff = 5 * time.Minute
+
+	// maxRateLimitJitter spreads out the requests released once the backoff is over.
+	maxRateLimitJitter = time.Second
+)
+
+// RateLimitTransport wraps a transport to hold back all requests while the API is rate-limiting us.
+// Each rate-limited response extends the backoff, either as requested by the API with Retry-After
+// or, failing that, exponentially; any other response resets it.
+type RateLimitTransport struct {
+	transport http.RoundTripper
+
+	lock         sync.RWMutex
+	backoffUntil time.Time
+	failures     int
+
+	rateLimitedCh chan time.Duration
+
+	clock  clock.Clock
+	jitter func() time.Duration
+}
+
+// NewRateLimitTransport returns a transport which holds back requests made through the given transport
+// while the API is rate-limiting us. The backoff is measured on the given clock.
+func NewRateLimitTransport(transport http.RoundTripper, clock clock.Clock) *RateLimitTransport {
+	return &RateLimitTransport{
+		transport:     transport,
+		rateLimitedCh: make(chan time.Duration, 1),
+		clock:         clock,
+		jitter:        func() time.Duration { return time.Duration(rand.Int63n(int64(maxRateLimitJitter))) }, //nolint:gosec
+	}
+}
+
+// RoundTrip waits for any ongoing backoff to end before making the request.
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if wait := t.GetBackoff(); wait > 0 {
+		if !clock.Sleep(req.Context(), t.clock, wait+t.jitter()) {
+			return nil, req.Context().Err()
+		}
+	}
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusTooManyRequests {
+		t.onRateLimited(res.Header.Get("Retry-After"))
+	} else {
+		t.onNotRateLimited()
+	}
+
+	return res, nil
+}
+
+// GetBackoff returns how long requests are still held back for, if at all.
+func (t *RateLimitTransport) GetBackoff() time.Duration {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if wait := t.backoffUntil.Sub(t.clock.Now()); wait > 0 {
+		return wait
+	}
+
+	return 0
+}
+
+// GetRateLimitedCh returns a channel which receives the backoff each time the API rate-limits us.
+func (t *RateLimitTransport) GetRateLimitedCh() <-chan time.Duration {
+	return t.rateLimitedCh
+}
+
+func (t *RateLimitTransport) onRateLimited(retryAfter string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.failures++
+
+	backoff, ok := parseRetryAfter(retryAfter, t.clock.Now())
+	if !ok || backoff <= 0 {
+		backoff = minRateLimitBackoff
+		for i := 1; i < t.failures && backoff < maxRateLimitBackoff; i++ {
+			backoff *= 2
+		}
+	}
+
+	if backoff > maxRateLimitBackoff {
+		backoff = maxRateLimitBackoff
+	}
+
+	if until := t.clock.Now().Add(backoff); until.After(t.backoffUntil) {
+		t.backoffUntil = until
+	}
+
+	// Drop the notification if the previous one hasn't been handled yet.
+	select {
+	case t.rateLimitedCh <- backoff:
+	default:
+	}
+}
+
+func (t *RateLimitTransport) onNotRateLimited() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.failures = 0
+}
+
+// parseRetryAfter parses the value of a Retry-After header, either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, seconds >= 0
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return date.Sub(now), true
+	}
+
+	return 0, false
+}
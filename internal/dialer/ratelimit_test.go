@@ -0,0 +1,128 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package dialer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitTransport_RetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	clock := clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	transport := NewRateLimitTransport(http.DefaultTransport, clock)
+
+	res, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+
+	// The backoff requested by the API is reported and held until that much time has passed.
+	require.Equal(t, 30*time.Second, <-transport.GetRateLimitedCh())
+	require.Equal(t, 30*time.Second, transport.GetBackoff())
+
+	clock.Advance(30 * time.Second)
+	require.Zero(t, transport.GetBackoff())
+}
+
+func TestRateLimitTransport_Wait(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	clock := clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	transport := NewRateLimitTransport(http.DefaultTransport, clock)
+	transport.jitter = func() time.Duration { return 0 }
+
+	transport.onRateLimited("10")
+	<-transport.GetRateLimitedCh()
+
+	doneCh := make(chan error)
+
+	go func() {
+		res, err := (&http.Client{Transport: transport}).Get(server.URL)
+		if err == nil {
+			err = res.Body.Close()
+		}
+
+		doneCh <- err
+	}()
+
+	// The request is held back until the backoff is over.
+	require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	require.Zero(t, requests.Load())
+
+	clock.Advance(10 * time.Second)
+	require.NoError(t, <-doneCh)
+	require.Equal(t, int32(1), requests.Load())
+}
+
+func TestRateLimitTransport_Exponential(t *testing.T) {
+	transport := NewRateLimitTransport(http.DefaultTransport, clock.New())
+
+	// Without Retry-After, the backoff doubles with each rate-limited response.
+	transport.onRateLimited("")
+	require.Equal(t, minRateLimitBackoff, <-transport.GetRateLimitedCh())
+
+	transport.onRateLimited("")
+	require.Equal(t, 2*minRateLimitBackoff, <-transport.GetRateLimitedCh())
+
+	var backoff time.Duration
+
+	for i := 0; i < 20; i++ {
+		transport.onRateLimited("")
+		backoff = <-transport.GetRateLimitedCh()
+	}
+	require.Equal(t, maxRateLimitBackoff, backoff)
+
+	// Any other response resets it.
+	transport.onNotRateLimited()
+	transport.onRateLimited("")
+	require.Equal(t, minRateLimitBackoff, <-transport.GetRateLimitedCh())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	backoff, ok := parseRetryAfter("120", now)
+	require.True(t, ok)
+	require.Equal(t, 2*time.Minute, backoff)
+
+	backoff, ok = parseRetryAfter(now.Add(time.Minute).Format(http.TimeFormat), now)
+	require.True(t, ok)
+	require.Equal(t, time.Minute, backoff)
+
+	_, ok = parseRetryAfter("", now)
+	require.False(t, ok)
+
+	_, ok = parseRetryAfter("soon", now)
+	require.False(t, ok)
+}
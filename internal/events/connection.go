@@ -17,6 +17,11 @@
 
 package events
 
+import (
+	"fmt"
+	"time"
+)
+
 type TLSIssue struct {
 	eventBase
 }
@@ -40,3 +45,14 @@ type ConnStatusDown struct {
 func (event ConnStatusDown) String() string {
 	return "ConnStatusDown"
 }
+
+// APIRateLimited is emitted when the API rate-limits us; all API requests are held back for RetryAfter.
+type APIRateLimited struct {
+	eventBase
+
+	RetryAfter time.Duration
+}
+
+func (event APIRateLimited) String() string {
+	return fmt.Sprintf("APIRateLimited: RetryAfter: %v", event.RetryAfter)
+}
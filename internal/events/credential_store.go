@@ -0,0 +1,43 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import "fmt"
+
+// CredentialStoreUnavailable is emitted on startup when the keychain holding the vault key can't be reached.
+// Bridge then runs in degraded mode on the file-based vault and refuses new logins until the keychain is back
+// or the user migrates to the file-based vault.
+type CredentialStoreUnavailable struct {
+	eventBase
+
+	Error error
+}
+
+func (event CredentialStoreUnavailable) String() string {
+	return fmt.Sprintf("CredentialStoreUnavailable: Error: %s", event.Error)
+}
+
+// CredentialStoreAvailable is emitted when the keychain can be reached again while bridge runs in degraded mode.
+// Bridge has then switched back to the vault it holds the key of and loads its users.
+type CredentialStoreAvailable struct {
+	eventBase
+}
+
+func (event CredentialStoreAvailable) String() string {
+	return "CredentialStoreAvailable"
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import "fmt"
+
+// BridgeLocked is emitted when bridge drops the users' decrypted keys, either after inactivity or on request.
+type BridgeLocked struct {
+	eventBase
+
+	// AutoLocked is whether bridge locked itself after inactivity.
+	AutoLocked bool
+}
+
+func (event BridgeLocked) String() string {
+	if event.AutoLocked {
+		return "BridgeLocked: after inactivity"
+	}
+
+	return "BridgeLocked"
+}
+
+// BridgeUnlocked is emitted when bridge serves its users again after having been locked.
+type BridgeUnlocked struct {
+	eventBase
+}
+
+func (event BridgeUnlocked) String() string {
+	return "BridgeUnlocked"
+}
+
+// KeyRotationStarted is emitted when bridge starts replacing the vault and gluon store keys.
+type KeyRotationStarted struct {
+	eventBase
+}
+
+func (event KeyRotationStarted) String() string {
+	return "KeyRotationStarted"
+}
+
+// KeyRotationFinished is emitted once the vault and gluon store keys have been replaced, or the rotation failed.
+type KeyRotationFinished struct {
+	eventBase
+
+	Error error
+}
+
+func (event KeyRotationFinished) String() string {
+	if event.Error != nil {
+		return fmt.Sprintf("KeyRotationFinished: Error: %s", event.Error)
+	}
+
+	return "KeyRotationFinished"
+}
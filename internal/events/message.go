@@ -0,0 +1,57 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import "fmt"
+
+// MessageReceived is emitted when a new incoming message has been added to a user's mailbox.
+type MessageReceived struct {
+	eventBase
+
+	UserID    string
+	MessageID string
+}
+
+func (event MessageReceived) String() string {
+	return fmt.Sprintf("MessageReceived: UserID: %s, MessageID: %s", event.UserID, event.MessageID)
+}
+
+// MessageSendFailed is emitted when a message submitted over SMTP could not be sent.
+type MessageSendFailed struct {
+	eventBase
+
+	UserID string
+	Error  error
+}
+
+func (event MessageSendFailed) String() string {
+	return fmt.Sprintf("MessageSendFailed: UserID: %s, Error: %s", event.UserID, event.Error)
+}
+
+// MessageTooLarge is emitted when a message submitted over SMTP exceeds the size accepted by the API.
+type MessageTooLarge struct {
+	eventBase
+
+	UserID string
+	Size   int64
+	Limit  int64
+}
+
+func (event MessageTooLarge) String() string {
+	return fmt.Sprintf("MessageTooLarge: UserID: %s, Size: %d, Limit: %d", event.UserID, event.Size, event.Limit)
+}
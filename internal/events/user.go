@@ -99,6 +99,34 @@ func (event UserDeauth) String() string {
 	return fmt.Sprintf("UserDeauth: UserID: %s", event.UserID)
 }
 
+// UserReauthRequired is emitted when a user whose session was revoked should sign in again.
+// The user's local data is kept and, once signed in, the user resumes from its last event.
+type UserReauthRequired struct {
+	eventBase
+
+	UserID   string
+	Username string
+}
+
+func (event UserReauthRequired) String() string {
+	return fmt.Sprintf("UserReauthRequired: UserID: %s, Username: %s", event.UserID, event.Username)
+}
+
+// UserReadReceiptRequested is emitted when a received message asking for a read receipt is read
+// and the user chose to be asked whether to send it.
+type UserReadReceiptRequested struct {
+	eventBase
+
+	UserID    string
+	MessageID string
+	Recipient string
+	Subject   string
+}
+
+func (event UserReadReceiptRequested) String() string {
+	return fmt.Sprintf("UserReadReceiptRequested: UserID: %s, MessageID: %s", event.UserID, event.MessageID)
+}
+
 // UserBadEvent is emitted when a user cannot apply an event.
 type UserBadEvent struct {
 	eventBase
@@ -182,6 +210,21 @@ func (event UsedSpaceChanged) String() string {
 	return fmt.Sprintf("UsedSpaceChanged: UserID: %s, UsedSpace: %v", event.UserID, event.UsedSpace)
 }
 
+// UserStorageAlmostFull is emitted when the storage space used by the user gets close to its quota.
+// Past the quota, messages can no longer be received, appended or sent.
+type UserStorageAlmostFull struct {
+	eventBase
+
+	UserID string
+
+	UsedSpace uint64
+	MaxSpace  uint64
+}
+
+func (event UserStorageAlmostFull) String() string {
+	return fmt.Sprintf("UserStorageAlmostFull: UserID: %s, UsedSpace: %v, MaxSpace: %v", event.UserID, event.UsedSpace, event.MaxSpace)
+}
+
 type IMAPLoginFailed struct {
 	eventBase
 
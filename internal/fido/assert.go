@@ -0,0 +1,146 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package fido
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const (
+	tokenTool  = "fido2-token"
+	assertTool = "fido2-assert"
+)
+
+var (
+	ErrNoTool   = errors.New("the libfido2 tools (fido2-token and fido2-assert) are not installed")
+	ErrNoDevice = errors.New("no security key is connected")
+)
+
+// Assert signs the challenge with a security key connected to this machine, using the libfido2 command line tools.
+// The user has to touch the key. Each connected key is tried with each credential of the account.
+func Assert(ctx context.Context, challenge *Challenge) (Assertion, error) {
+	for _, tool := range []string{tokenTool, assertTool} {
+		if _, err := exec.LookPath(tool); err != nil {
+			return Assertion{}, ErrNoTool
+		}
+	}
+
+	out, err := exec.CommandContext(ctx, tokenTool, "-L").Output()
+	if err != nil {
+		return Assertion{}, fmt.Errorf("failed to list security keys: %w", err)
+	}
+
+	devices := parseDevices(out)
+	if len(devices) == 0 {
+		return Assertion{}, ErrNoDevice
+	}
+
+	var assertErr error
+
+	for _, device := range devices {
+		for _, credentialID := range challenge.CredentialIDs {
+			assertion, err := assertWith(ctx, challenge, device, credentialID)
+			if err == nil {
+				return assertion, nil
+			}
+
+			if ctx.Err() != nil {
+				return Assertion{}, ctx.Err()
+			}
+
+			assertErr = err
+		}
+	}
+
+	if assertErr == nil {
+		return Assertion{}, errors.New("the account has no security key credential")
+	}
+
+	return Assertion{}, assertErr
+}
+
+// assertWith asks the given device to sign the challenge with the given credential.
+func assertWith(ctx context.Context, challenge *Challenge, device string, credentialID []byte) (Assertion, error) {
+	var stdin, stderr bytes.Buffer
+
+	// The input of fido2-assert: the client data hash, the relying party ID and the credential ID.
+	stdin.WriteString(base64.StdEncoding.EncodeToString(challenge.ClientDataHash()) + "\n")
+	stdin.WriteString(challenge.RelyingPartyID + "\n")
+	stdin.WriteString(base64.StdEncoding.EncodeToString(credentialID) + "\n")
+
+	cmd := exec.CommandContext(ctx, assertTool, "-G", "-p", device)
+	cmd.Stdin = &stdin
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return Assertion{}, fmt.Errorf("security key %v: %w: %v", device, err, strings.TrimSpace(stderr.String()))
+	}
+
+	assertion, err := parseAssertion(out)
+	if err != nil {
+		return Assertion{}, fmt.Errorf("security key %v: %w", device, err)
+	}
+
+	assertion.CredentialID = credentialID
+
+	return assertion, nil
+}
+
+// parseDevices returns the device paths listed by fido2-token -L, one "path: description" line per device.
+func parseDevices(out []byte) []string {
+	var devices []string
+
+	for _, line := range strings.Split(string(out), "\n") {
+		if path, _, ok := strings.Cut(strings.TrimSpace(line), ": "); ok && path != "" {
+			devices = append(devices, path)
+		}
+	}
+
+	return devices
+}
+
+// parseAssertion returns the assertion output by fido2-assert -G: the client data hash, the relying party ID,
+// then the authenticator data and the signature, base64 encoded.
+func parseAssertion(out []byte) (Assertion, error) {
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) < 4 {
+		return Assertion{}, fmt.Errorf("unexpected assertion output of %v lines", len(lines))
+	}
+
+	authenticatorData, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[2]))
+	if err != nil {
+		return Assertion{}, fmt.Errorf("failed to decode authenticator data: %w", err)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[3]))
+	if err != nil {
+		return Assertion{}, fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	return Assertion{
+		AuthenticatorData: authenticatorData,
+		Signature:         signature,
+	}, nil
+}
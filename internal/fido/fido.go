@@ -0,0 +1,201 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package fido implements the WebAuthn assertion step of logging in to accounts protected with a security key.
+// The assertion itself is made by a security key connected to this machine through the libfido2 tools (see Assert),
+// or by the frontend with a platform authenticator.
+package fido
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ProtonMail/go-proton-api"
+)
+
+const defaultRelyingPartyID = "proton.me"
+
+var ErrNoChallenge = errors.New("the login has no security key challenge")
+
+// Challenge is the WebAuthn assertion request of a login, to be signed by one of the account's security keys.
+type Challenge struct {
+	// RelyingPartyID is the relying party the security key must sign for.
+	RelyingPartyID string
+
+	// ClientDataJSON is the WebAuthn client data; the security key signs its SHA-256 hash.
+	ClientDataJSON []byte
+
+	// CredentialIDs are the credentials of the security keys registered on the account.
+	CredentialIDs [][]byte
+
+	options any
+}
+
+// Assertion is the answer of a security key to a challenge.
+type Assertion struct {
+	AuthenticatorData []byte
+	Signature         []byte
+	CredentialID      []byte
+}
+
+// IsEnabled returns whether the login can be completed with a security key.
+func IsEnabled(auth proton.Auth) bool {
+	return auth.TwoFA.Enabled&proton.HasFIDO2 != 0
+}
+
+// NewChallenge returns the security key challenge of the login.
+func NewChallenge(auth proton.Auth) (*Challenge, error) {
+	if !IsEnabled(auth) || auth.TwoFA.FIDO2.AuthenticationOptions == nil {
+		return nil, ErrNoChallenge
+	}
+
+	b, err := json.Marshal(auth.TwoFA.FIDO2.AuthenticationOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode authentication options: %w", err)
+	}
+
+	var options authenticationOptions
+
+	if err := json.Unmarshal(b, &options); err != nil {
+		return nil, fmt.Errorf("failed to decode authentication options: %w", err)
+	}
+
+	if len(options.PublicKey.Challenge) == 0 {
+		return nil, ErrNoChallenge
+	}
+
+	rpID := options.PublicKey.RPID
+	if rpID == "" {
+		rpID = defaultRelyingPartyID
+	}
+
+	clientData, err := json.Marshal(clientData{
+		Type:      "webauthn.get",
+		Challenge: base64.RawURLEncoding.EncodeToString(options.PublicKey.Challenge),
+		Origin:    "https://" + rpID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode client data: %w", err)
+	}
+
+	credentialIDs := make([][]byte, 0, len(options.PublicKey.AllowCredentials))
+
+	for _, credential := range options.PublicKey.AllowCredentials {
+		credentialIDs = append(credentialIDs, credential.ID)
+	}
+
+	return &Challenge{
+		RelyingPartyID: rpID,
+		ClientDataJSON: clientData,
+		CredentialIDs:  credentialIDs,
+		options:        auth.TwoFA.FIDO2.AuthenticationOptions,
+	}, nil
+}
+
+// ClientDataHash returns the hash of the client data, which is what the security key signs.
+func (c *Challenge) ClientDataHash() []byte {
+	hash := sha256.Sum256(c.ClientDataJSON)
+
+	return hash[:]
+}
+
+// Auth2FAReq returns the second factor request completing the login with the given assertion.
+func (c *Challenge) Auth2FAReq(assertion Assertion) proton.Auth2FAReq {
+	return proton.Auth2FAReq{
+		FIDO2: proton.FIDO2Req{
+			AuthenticationOptions: c.options,
+			ClientData:            base64.StdEncoding.EncodeToString(c.ClientDataJSON),
+			AuthenticatorData:     base64.StdEncoding.EncodeToString(DecodeAuthenticatorData(assertion.AuthenticatorData)),
+			Signature:             base64.StdEncoding.EncodeToString(assertion.Signature),
+			CredentialID:          base64.StdEncoding.EncodeToString(assertion.CredentialID),
+		},
+	}
+}
+
+// DecodeAuthenticatorData returns the raw authenticator data, unwrapping it if it is CBOR encoded as fido2-assert does.
+func DecodeAuthenticatorData(data []byte) []byte {
+	const (
+		cborBytes1 = 0x58 // byte string with a one byte length.
+		cborBytes2 = 0x59 // byte string with a two byte length.
+	)
+
+	switch {
+	case len(data) > 2 && data[0] == cborBytes1 && int(data[1]) == len(data)-2:
+		return data[2:]
+
+	case len(data) > 3 && data[0] == cborBytes2 && int(data[1])<<8|int(data[2]) == len(data)-3:
+		return data[3:]
+
+	default:
+		return data
+	}
+}
+
+type authenticationOptions struct {
+	PublicKey struct {
+		Challenge        byteArray `json:"challenge"`
+		RPID             string    `json:"rpId"`
+		AllowCredentials []struct {
+			ID byteArray `json:"id"`
+		} `json:"allowCredentials"`
+	} `json:"publicKey"`
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// byteArray decodes binary WebAuthn values, which the API sends as arrays of numbers rather than base64 strings.
+type byteArray []byte
+
+func (b *byteArray) UnmarshalJSON(data []byte) error {
+	var values []int
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		var encoded string
+
+		if err := json.Unmarshal(data, &encoded); err != nil {
+			return err
+		}
+
+		decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+		if err != nil {
+			return err
+		}
+
+		*b = decoded
+
+		return nil
+	}
+
+	*b = make([]byte, len(values))
+
+	for i, value := range values {
+		if value < 0 || value > 255 {
+			return fmt.Errorf("invalid byte value %v", value)
+		}
+
+		(*b)[i] = byte(value)
+	}
+
+	return nil
+}
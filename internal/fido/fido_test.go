@@ -0,0 +1,153 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package fido
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChallenge(t *testing.T) {
+	var auth proton.Auth
+
+	auth.TwoFA.Enabled = proton.HasFIDO2
+	auth.TwoFA.FIDO2.AuthenticationOptions = map[string]any{
+		"publicKey": map[string]any{
+			"challenge": []int{1, 2, 3},
+			"rpId":      "account.proton.me",
+			"allowCredentials": []any{
+				map[string]any{"id": []int{4, 5}, "type": "public-key"},
+				map[string]any{"id": "BgcI", "type": "public-key"},
+			},
+		},
+	}
+
+	challenge, err := NewChallenge(auth)
+	require.NoError(t, err)
+
+	// The challenge is bound to the relying party and lists the account's keys.
+	require.Equal(t, "account.proton.me", challenge.RelyingPartyID)
+	require.Equal(t, [][]byte{{4, 5}, {6, 7, 8}}, challenge.CredentialIDs)
+
+	// The client data carries the server challenge.
+	var data clientData
+	require.NoError(t, json.Unmarshal(challenge.ClientDataJSON, &data))
+	require.Equal(t, clientData{Type: "webauthn.get", Challenge: "AQID", Origin: "https://account.proton.me"}, data)
+
+	// The security key signs the hash of the client data.
+	hash := sha256.Sum256(challenge.ClientDataJSON)
+	require.Equal(t, hash[:], challenge.ClientDataHash())
+}
+
+func TestChallenge_Auth2FAReq(t *testing.T) {
+	var auth proton.Auth
+
+	auth.TwoFA.Enabled = proton.HasFIDO2
+	auth.TwoFA.FIDO2.AuthenticationOptions = map[string]any{
+		"publicKey": map[string]any{
+			"challenge":        []int{1, 2, 3},
+			"rpId":             "account.proton.me",
+			"allowCredentials": []any{map[string]any{"id": []int{4, 5}, "type": "public-key"}},
+		},
+	}
+
+	challenge, err := NewChallenge(auth)
+	require.NoError(t, err)
+
+	// The assertion is sent back along with the original options, all binary fields base64 encoded.
+	req := challenge.Auth2FAReq(Assertion{
+		AuthenticatorData: []byte{0x58, 2, 9, 10},
+		Signature:         []byte{11},
+		CredentialID:      []byte{4, 5},
+	})
+	require.Equal(t, auth.TwoFA.FIDO2.AuthenticationOptions, req.FIDO2.AuthenticationOptions)
+	require.Equal(t, base64.StdEncoding.EncodeToString(challenge.ClientDataJSON), req.FIDO2.ClientData)
+	require.Equal(t, base64.StdEncoding.EncodeToString([]byte{9, 10}), req.FIDO2.AuthenticatorData)
+	require.Equal(t, base64.StdEncoding.EncodeToString([]byte{11}), req.FIDO2.Signature)
+	require.Equal(t, base64.StdEncoding.EncodeToString([]byte{4, 5}), req.FIDO2.CredentialID)
+}
+
+func TestNewChallenge_NotEnabled(t *testing.T) {
+	var auth proton.Auth
+
+	auth.TwoFA.Enabled = proton.HasTOTP
+
+	_, err := NewChallenge(auth)
+	require.ErrorIs(t, err, ErrNoChallenge)
+}
+
+func TestDecodeAuthenticatorData(t *testing.T) {
+	// Raw authenticator data is left untouched.
+	require.Equal(t, []byte{1, 2, 3}, DecodeAuthenticatorData([]byte{1, 2, 3}))
+
+	// CBOR byte strings are unwrapped.
+	require.Equal(t, []byte{1, 2}, DecodeAuthenticatorData([]byte{0x58, 2, 1, 2}))
+	require.Equal(t, []byte{1, 2}, DecodeAuthenticatorData([]byte{0x59, 0, 2, 1, 2}))
+}
+
+func TestAssert(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake libfido2 tools are shell scripts")
+	}
+
+	challenge := &Challenge{
+		RelyingPartyID: "proton.me",
+		ClientDataJSON: []byte("{}"),
+		CredentialIDs:  [][]byte{{1}, {2}},
+	}
+
+	// Without the tools, the frontend has to ask for the assertion itself.
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := Assert(context.Background(), challenge)
+	require.ErrorIs(t, err, ErrNoTool)
+
+	// The key only knows the second credential.
+	dir := t.TempDir()
+	t.Setenv("PATH", dir)
+
+	writeTool(t, dir, tokenTool, `echo "/dev/hidraw0: vendor=0x1050, product=0x0407 (Yubico YubiKey)"`)
+	writeTool(t, dir, assertTool, `
+[ "$1 $2 $3" = "-G -p /dev/hidraw0" ] || exit 2
+read hash; read rp; read cred
+[ "$cred" = "Ag==" ] || { echo "no credentials" >&2; exit 1; }
+printf "%s\n%s\nWAIJCg==\nCw==\n" "$hash" "$rp"`)
+
+	assertion, err := Assert(context.Background(), challenge)
+	require.NoError(t, err)
+	require.Equal(t, Assertion{AuthenticatorData: []byte{0x58, 2, 9, 10}, Signature: []byte{11}, CredentialID: []byte{2}}, assertion)
+
+	// Without a key, there is nothing to try.
+	writeTool(t, dir, tokenTool, "true")
+
+	_, err = Assert(context.Background(), challenge)
+	require.ErrorIs(t, err, ErrNoDevice)
+}
+
+func writeTool(t *testing.T, dir, name, script string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script+"\n"), 0o700)) //nolint:gosec
+}
@@ -86,6 +86,73 @@ func CopyDir(from, to string) error {
 	return nil
 }
 
+// SyncDir makes the content of to identical to that of from: files that are missing or differ in size or
+// modification time are copied, and files that no longer exist in from are removed. Unchanged files are left
+// untouched, so syncing again after a first pass only transfers what changed in between.
+func SyncDir(from, to string) error {
+	entries, err := os.ReadDir(from)
+	if err != nil {
+		return err
+	}
+
+	if err := CreateIfNotExists(to, 0o700); err != nil {
+		return err
+	}
+
+	names := make(map[string]struct{}, len(entries))
+
+	for _, entry := range entries {
+		names[entry.Name()] = struct{}{}
+
+		sourcePath := filepath.Join(from, entry.Name())
+		destPath := filepath.Join(to, entry.Name())
+
+		if entry.IsDir() {
+			if err := SyncDir(sourcePath, destPath); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := syncFile(sourcePath, destPath); err != nil {
+			return err
+		}
+	}
+
+	destEntries, err := os.ReadDir(to)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range destEntries {
+		if _, ok := names[entry.Name()]; !ok {
+			if err := os.RemoveAll(filepath.Join(to, entry.Name())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func syncFile(srcFile, dstFile string) error {
+	srcInfo, err := os.Stat(srcFile)
+	if err != nil {
+		return err
+	}
+
+	if dstInfo, err := os.Stat(dstFile); err == nil && dstInfo.Size() == srcInfo.Size() && dstInfo.ModTime().Equal(srcInfo.ModTime()) {
+		return nil
+	}
+
+	if err := CopyFile(srcFile, dstFile); err != nil {
+		return err
+	}
+
+	return os.Chtimes(dstFile, srcInfo.ModTime(), srcInfo.ModTime())
+}
+
 func CopyFile(srcFile, dstFile string) error {
 	out, err := os.Create(filepath.Clean(dstFile))
 	defer func(out *os.File) {
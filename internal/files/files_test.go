@@ -71,3 +71,55 @@ func TestMoveDir(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSyncDir(t *testing.T) {
+	from, to := t.TempDir(), t.TempDir()
+
+	// Create some files in from.
+	if err := os.WriteFile(filepath.Join(from, "a"), []byte("a"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(from, "c"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(from, "c", "d"), []byte("d"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// First pass.
+	if err := SyncDir(from, to); err != nil {
+		t.Fatal(err)
+	}
+
+	// Change the source in between: add, modify and remove files.
+	if err := os.WriteFile(filepath.Join(from, "b"), []byte("b"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(from, "c", "d"), []byte("dd"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(from, "a")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Second pass.
+	if err := SyncDir(from, to); err != nil {
+		t.Fatal(err)
+	}
+
+	// Check that the destination matches the source.
+	if _, err := os.Stat(filepath.Join(to, "a")); !os.IsNotExist(err) {
+		t.Fatal("a should have been removed")
+	}
+	if b, err := os.ReadFile(filepath.Join(to, "b")); err != nil || string(b) != "b" {
+		t.Fatal("b should have been copied", err)
+	}
+	if b, err := os.ReadFile(filepath.Join(to, "c", "d")); err != nil || string(b) != "dd" {
+		t.Fatal("d should have been updated", err)
+	}
+
+	// The source is left untouched.
+	if _, err := os.Stat(filepath.Join(from, "c", "d")); err != nil {
+		t.Fatal(err)
+	}
+}
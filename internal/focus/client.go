@@ -89,8 +89,11 @@ func withClientConn(ctx context.Context, settingsPath string, fn func(context.Co
 	}
 	cc, err := grpc.DialContext(
 		ctx,
-		net.JoinHostPort(Host, fmt.Sprint(config.Port)),
+		"passthrough:///focus",
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return service.Dial(ctx, &config)
+		}),
 	)
 	if err != nil {
 		return err
@@ -30,7 +30,7 @@ func TestFocus_Raise(t *testing.T) {
 	tmpDir := t.TempDir()
 	locations := locations.New(newTestLocationsProvider(tmpDir), "config-name")
 	// Start the focus service.
-	service, err := NewService(locations, semver.MustParse("1.2.3"), nil)
+	service, err := NewService(locations, semver.MustParse("1.2.3"), nil, false)
 	require.NoError(t, err)
 
 	settingsFolder, err := locations.ProvideSettingsPath()
@@ -52,7 +52,7 @@ func TestFocus_Version(t *testing.T) {
 	tmpDir := t.TempDir()
 	locations := locations.New(newTestLocationsProvider(tmpDir), "config-name")
 	// Start the focus service.
-	_, err := NewService(locations, semver.MustParse("1.2.3"), nil)
+	_, err := NewService(locations, semver.MustParse("1.2.3"), nil, false)
 	require.NoError(t, err)
 
 	settingsFolder, err := locations.ProvideSettingsPath()
@@ -64,6 +64,24 @@ func TestFocus_Version(t *testing.T) {
 	require.Equal(t, "1.2.3", version.String())
 }
 
+func TestFocus_RaiseOverTCP(t *testing.T) {
+	tmpDir := t.TempDir()
+	locations := locations.New(newTestLocationsProvider(tmpDir), "config-name")
+	// Start the focus service on a TCP port.
+	service, err := NewService(locations, semver.MustParse("1.2.3"), nil, true)
+	require.NoError(t, err)
+	defer service.Close()
+
+	settingsFolder, err := locations.ProvideSettingsPath()
+	require.NoError(t, err)
+
+	// Try to dial it, it should succeed.
+	require.True(t, TryRaise(settingsFolder))
+
+	// The service should report a raise call.
+	<-service.GetRaiseCh()
+}
+
 type TestLocationsProvider struct {
 	config, data, cache string
 }
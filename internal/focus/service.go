@@ -21,7 +21,6 @@ package focus
 import (
 	"context"
 	"fmt"
-	"net"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gluon/async"
@@ -33,10 +32,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-const (
-	Host                 = "127.0.0.1"
-	serverConfigFileName = "grpcFocusServerConfig.json"
-)
+const serverConfigFileName = "grpcFocusServerConfig.json"
 
 // Service is a gRPC service that can be used to raise the application.
 type Service struct {
@@ -51,8 +47,8 @@ type Service struct {
 }
 
 // NewService creates a new focus service.
-// It listens on the local host and port 1042 (by default).
-func NewService(locator service.Locator, version *semver.Version, panicHandler async.PanicHandler) (*Service, error) {
+// It listens on a socket only the current user can access or, if overTCP is set, on a localhost TCP port.
+func NewService(locator service.Locator, version *semver.Version, panicHandler async.PanicHandler, overTCP bool) (*Service, error) {
 	serv := &Service{
 		server:       grpc.NewServer(),
 		raiseCh:      make(chan struct{}, 1),
@@ -63,16 +59,11 @@ func NewService(locator service.Locator, version *semver.Version, panicHandler a
 
 	proto.RegisterFocusServer(serv.server, serv)
 
-	if listener, err := net.Listen("tcp", net.JoinHostPort(Host, fmt.Sprint(0))); err != nil {
+	config := service.Config{}
+
+	if listener, err := service.Listen("focus", overTCP, &config); err != nil {
 		serv.log.WithError(err).Warn("Failed to start focus service")
 	} else {
-		config := service.Config{}
-		// retrieve the port assigned by the system, so that we can put it in the config file.
-		address, ok := listener.Addr().(*net.TCPAddr)
-		if !ok {
-			return nil, fmt.Errorf("could not retrieve gRPC service listener address")
-		}
-		config.Port = address.Port
 		if path, err := service.SaveGRPCServerConfigFile(locator, &config, serverConfigFileName); err != nil {
 			serv.log.WithError(err).WithField("path", path).Warn("Could not write focus gRPC service config file")
 		} else {
@@ -864,6 +864,16 @@ void QMLBackend::login2FA(QString const &username, QString const &code) const {
 }
 
 
+//****************************************************************************************************************************************************
+/// \param[in] username The username.
+//****************************************************************************************************************************************************
+void QMLBackend::loginFido2Local(QString const &username) const {
+    HANDLE_EXCEPTION(
+        app().grpc().loginFido2Local(username);
+    )
+}
+
+
 //****************************************************************************************************************************************************
 /// \param[in] username The username.
 /// \param[in] password The mailbox password.
@@ -1094,6 +1104,53 @@ void QMLBackend::sendBadEventUserFeedback(QString const &userID, bool doResync)
     )
 }
 
+//****************************************************************************************************************************************************
+/// \param[in] send Should the read receipt be sent.
+//****************************************************************************************************************************************************
+void QMLBackend::answerReadReceiptRequest(bool send) {
+    HANDLE_EXCEPTION(
+        if (readReceiptRequestQueue_.isEmpty()) {
+            return;
+        }
+
+        ReadReceiptRequest const request = readReceiptRequestQueue_.takeFirst();
+        if (send) {
+            app().grpc().sendUserReadReceipt(request.userID, request.messageID);
+        }
+
+        if (!readReceiptRequestQueue_.isEmpty()) {
+            // we introduce a small delay here, so that the user notices the dialog disappear and pops up again.
+            QTimer::singleShot(500, [&]() { this->displayReadReceiptRequest(); });
+        }
+    )
+}
+
+
+//****************************************************************************************************************************************************
+/// \param[in] signIn Should the login screen of the account be shown.
+//****************************************************************************************************************************************************
+void QMLBackend::answerReauthRequest(bool signIn) {
+    HANDLE_EXCEPTION(
+        if (reauthRequestQueue_.isEmpty()) {
+            return;
+        }
+
+        QString const userID = reauthRequestQueue_.takeFirst().first;
+        reauthRequestDisplayed_ = false;
+        if (signIn) {
+            // The account is signed out, so selecting it displays its login screen. The other requests are displayed once the login is finished.
+            this->selectUser(userID, true, "re-authentication requested");
+            return;
+        }
+
+        if (!reauthRequestQueue_.isEmpty()) {
+            // we introduce a small delay here, so that the user notices the dialog disappear and pops up again.
+            QTimer::singleShot(500, [&]() { this->displayReauthRequest(); });
+        }
+    )
+}
+
+
 //****************************************************************************************************************************************************
 //
 //****************************************************************************************************************************************************
@@ -1191,6 +1248,18 @@ void QMLBackend::onLoginFinished(QString const &userID, bool wasSignedOut) {
         this->retrieveUserList();
         qint32 const index = users_->rowOfUserID(userID);
         emit loginFinished(index, wasSignedOut);
+
+        // The account may have been signed in again without its re-authentication request being answered.
+        for (qsizetype i = reauthRequestDisplayed_ ? 1 : 0; i < reauthRequestQueue_.size();) {
+            if (reauthRequestQueue_[i].first == userID) {
+                reauthRequestQueue_.removeAt(i);
+            } else {
+                ++i;
+            }
+        }
+        if (!reauthRequestDisplayed_ && !reauthRequestQueue_.isEmpty()) {
+            this->displayReauthRequest();
+        }
     )
 }
 
@@ -1230,6 +1299,43 @@ void QMLBackend::onUserBadEvent(QString const &userID, QString const &) {
 }
 
 
+//****************************************************************************************************************************************************
+/// \param[in] userID The userID.
+/// \param[in] messageID The ID of the message requesting the receipt.
+/// \param[in] recipient The address the receipt would be sent to.
+/// \param[in] subject The subject of the message.
+//****************************************************************************************************************************************************
+void QMLBackend::onReadReceiptRequested(QString const &userID, QString const &messageID, QString const &recipient, QString const &subject) {
+    HANDLE_EXCEPTION(
+        readReceiptRequestQueue_.append({ userID, messageID, recipient, subject });
+        if (readReceiptRequestQueue_.size() == 1) { // there was no other request in the queue, we can display the dialog immediately.
+            this->displayReadReceiptRequest();
+        }
+    )
+}
+
+
+//****************************************************************************************************************************************************
+/// \param[in] userID The userID.
+/// \param[in] username The username.
+//****************************************************************************************************************************************************
+void QMLBackend::onUserReauthRequired(QString const &userID, QString const &username) {
+    HANDLE_EXCEPTION(
+        // Bridge reminds us of the request on each start, and the same account may not be queued twice.
+        for (QPair<QString, QString> const &request: reauthRequestQueue_) {
+            if (request.first == userID) {
+                return;
+            }
+        }
+
+        reauthRequestQueue_.append({ userID, username });
+        if (!reauthRequestDisplayed_) {
+            this->displayReauthRequest();
+        }
+    )
+}
+
+
 //****************************************************************************************************************************************************
 /// \param[in] username The username (or primary email address)
 //****************************************************************************************************************************************************
@@ -1347,6 +1453,7 @@ void QMLBackend::connectGrpcEvents() {
     connect(client, &GRPCClient::login2FARequested, this, &QMLBackend::login2FARequested);
     connect(client, &GRPCClient::login2FAError, this, &QMLBackend::login2FAError);
     connect(client, &GRPCClient::login2FAErrorAbort, this, &QMLBackend::login2FAErrorAbort);
+    connect(client, &GRPCClient::loginFido2Requested, this, &QMLBackend::loginFido2Requested);
     connect(client, &GRPCClient::login2PasswordRequested, this, &QMLBackend::login2PasswordRequested);
     connect(client, &GRPCClient::login2PasswordError, this, &QMLBackend::login2PasswordError);
     connect(client, &GRPCClient::login2PasswordErrorAbort, this, &QMLBackend::login2PasswordErrorAbort);
@@ -1394,6 +1501,8 @@ void QMLBackend::connectGrpcEvents() {
     connect(client, &GRPCClient::userDisconnected, this, &QMLBackend::userDisconnected);
     connect(client, &GRPCClient::userBadEvent, this, &QMLBackend::onUserBadEvent);
     connect(client, &GRPCClient::imapLoginFailed, this, &QMLBackend::onIMAPLoginFailed);
+    connect(client, &GRPCClient::readReceiptRequested, this, &QMLBackend::onReadReceiptRequested);
+    connect(client, &GRPCClient::userReauthRequired, this, &QMLBackend::onUserReauthRequired);
 
     users_->connectGRPCEvents();
 }
@@ -1417,6 +1526,43 @@ void QMLBackend::displayBadEventDialog(QString const &userID) {
     )
 }
 
+//****************************************************************************************************************************************************
+//
+//****************************************************************************************************************************************************
+void QMLBackend::displayReadReceiptRequest() {
+    HANDLE_EXCEPTION(
+        if (readReceiptRequestQueue_.isEmpty()) {
+            return;
+        }
+
+        ReadReceiptRequest const &request = readReceiptRequestQueue_.front();
+        SPUser const user = users_->getUserWithID(request.userID);
+        QString const account = user ? elideLongString(user->primaryEmailOrUsername(), 30) : request.userID;
+        emit readReceiptRequested(tr("The sender of the message \"%1\" received by %2 asked to be notified when you read it. Do you want to send a "
+            "read receipt to %3?").arg(elideLongString(request.subject, 50), account, request.recipient));
+    )
+}
+
+
+//****************************************************************************************************************************************************
+//
+//****************************************************************************************************************************************************
+void QMLBackend::displayReauthRequest() {
+    HANDLE_EXCEPTION(
+        if (reauthRequestQueue_.isEmpty()) {
+            return;
+        }
+
+        reauthRequestDisplayed_ = true;
+        QPair<QString, QString> const &request = reauthRequestQueue_.front();
+        SPUser const user = users_->getUserWithID(request.first);
+        QString const account = elideLongString(user ? user->primaryEmailOrUsername() : request.second, 30);
+        emit userReauthRequired(tr("The session of the account %1 was revoked, for instance after a password change. Sign in again to resume "
+            "where Bridge stopped: the local data of the account was kept.").arg(account));
+        emit showMainWindow();
+    )
+}
+
 void QMLBackend::triggerRepair() const {
     HANDLE_EXCEPTION(
             app().grpc().triggerRepair();
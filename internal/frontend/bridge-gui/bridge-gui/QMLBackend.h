@@ -31,6 +31,17 @@
 #include <stack>
 
 
+//****************************************************************************************************************************************************
+/// \brief A request for a read receipt waiting for the user to answer it.
+//****************************************************************************************************************************************************
+struct ReadReceiptRequest {
+    QString userID; ///< The userID.
+    QString messageID; ///< The ID of the message requesting the receipt.
+    QString recipient; ///< The address the receipt would be sent to.
+    QString subject; ///< The subject of the message.
+};
+
+
 //****************************************************************************************************************************************************
 /// \brief Bridge C++ backend class.
 //****************************************************************************************************************************************************
@@ -193,6 +204,7 @@ public slots: // slot for signals received from QML -> To be forwarded to Bridge
     void login(QString const &username, QString const &password) const; ///< Slot for the login button (initial login).
     void loginHv(QString const &username, QString const &password) const; ///< Slot for the login button (after HV challenge completed).
     void login2FA(QString const &username, QString const &code) const; ///< Slot for the login button (2FA login).
+    void loginFido2Local(QString const &username) const; ///< Slot for the login button (security key login).
     void login2Password(QString const &username, QString const &password) const; ///< Slot for the login button (mailbox password login).
     void loginAbort(QString const &username) const; ///< Slot for the login abort procedure.
     void toggleDoH(bool active); ///, Slot for the DoH toggle.
@@ -213,6 +225,8 @@ public slots: // slot for signals received from QML -> To be forwarded to Bridge
     void onVersionChanged(); ///< Slot for the version change signal.
     void setMailServerSettings(int imapPort, int smtpPort, bool useSSLForIMAP, bool useSSLForSMTP) const; ///< Forwards a connection mode change request from QML to gRPC
     void sendBadEventUserFeedback(QString const &userID, bool doResync); ///< Slot the providing user feedback for a bad event.
+    void answerReadReceiptRequest(bool send); ///< Slot for the answer to the read receipt request being displayed.
+    void answerReauthRequest(bool signIn); ///< Slot for the answer to the re-authentication request being displayed.
     void triggerRepair() const; ///< Slot for the triggering of the bridge repair function i.e. 'resync'.
     void userNotificationDismissed(); ///< Slot to pop the notification from the stack and display the rest.
 
@@ -230,6 +244,8 @@ public slots: // slot for signals received from gRPC that need transformation in
     void onLoginAlreadyLoggedIn(QString const &userID); ///< Slot for the LoginAlreadyLoggedIn gRPC event.
     void onUserBadEvent(QString const& userID, QString const& errorMessage); ///< Slot for the userBadEvent gRPC event.
     void onIMAPLoginFailed(QString const& username); ///< Slot the the imapLoginFailed event.
+    void onReadReceiptRequested(QString const &userID, QString const &messageID, QString const &recipient, QString const &subject); ///< Slot for the readReceiptRequested gRPC event.
+    void onUserReauthRequired(QString const &userID, QString const &username); ///< Slot for the userReauthRequired gRPC event.
     void processUserNotification(bridgepp::UserNotification const& notification); ///< Slot for the userNotificationReceived gRCP event.
 
 signals: // Signals received from the Go backend, to be forwarded to QML
@@ -242,6 +258,7 @@ signals: // Signals received from the Go backend, to be forwarded to QML
     void login2FARequested(QString const &username); ///< Signal for the 'login2FARequested' gRPC stream event.
     void login2FAError(QString const &errorMsg); ///< Signal for the 'login2FAError' gRPC stream event.
     void login2FAErrorAbort(QString const &errorMsg); ///< Signal for the 'login2FAErrorAbort' gRPC stream event.
+    void loginFido2Requested(QString const &username, bool totpAvailable); ///< Signal for the 'loginFido2Requested' gRPC stream event.
     void login2PasswordRequested(QString const &username); ///< Signal for the 'login2PasswordRequested' gRPC stream event.
     void login2PasswordError(QString const &errorMsg); ///< Signal for the 'login2PasswordError' gRPC stream event.
     void login2PasswordErrorAbort(QString const &errorMsg); ///< Signal for the 'login2PasswordErrorAbort' gRPC stream event.
@@ -273,6 +290,8 @@ signals: // Signals received from the Go backend, to be forwarded to QML
     void apiCertIssue(); ///< Signal for the 'apiCertIssue' gRPC stream event.
     void userDisconnected(QString const &username); ///< Signal for the 'userDisconnected' gRPC stream event.
     void userBadEvent(QString const &userID, QString const &description); ///< Signal for the 'userBadEvent' gRPC stream event.
+    void readReceiptRequested(QString const &description); ///< Signal for the 'readReceiptRequested' gRPC stream event.
+    void userReauthRequired(QString const &description); ///< Signal for the 'userReauthRequired' gRPC stream event.
     void internetOff(); ///< Signal for the 'internetOff' gRPC stream event.
     void internetOn(); ///< Signal for the 'internetOn' gRPC stream event.
     void resetFinished(); ///< Signal for the 'resetFinished' gRPC stream event.
@@ -301,6 +320,8 @@ private: // member functions
     void retrieveUserList(); ///< Retrieve the list of users via gRPC.
     void connectGrpcEvents(); ///< Connect gRPC that need to be forwarded to QML via backend signals
     void displayBadEventDialog(QString const& userID); ///< Displays the bad event dialog for a user.
+    void displayReadReceiptRequest(); ///< Displays the dialog for the read receipt request at the front of the queue.
+    void displayReauthRequest(); ///< Displays the dialog for the re-authentication request at the front of the queue.
 
 private: // data members
     UserList *users_ { nullptr }; ///< The user list. Owned by backend.
@@ -315,6 +336,9 @@ private: // data members
     bool useSSLForSMTP_ { false }; ///< The cached value for useSSLForSMTP.
     bool isInternetOn_ { true }; ///< Does bridge consider internet as on?
     QList<QString> badEventDisplayQueue_; ///< THe queue for displaying 'bad event feedback request dialog'.
+    QList<ReadReceiptRequest> readReceiptRequestQueue_; ///< The queue of read receipt requests waiting for an answer.
+    QList<QPair<QString, QString>> reauthRequestQueue_; ///< The userIDs and usernames of the accounts waiting to be signed in again.
+    bool reauthRequestDisplayed_ { false }; ///< Is the request at the front of the re-authentication queue being displayed.
     std::unique_ptr<TrayIcon> trayIcon_; ///< The tray icon for the application.
     bridgepp::BugReportFlow reportFlow_;  ///< The bug report flow.
     std::stack<bridgepp::UserNotification> userNotificationStack_; ///< The stack which holds all of the active notifications that the user needs to acknowledge.
@@ -208,7 +208,7 @@ void focusOtherInstance() {
         }
 
         QString error;
-        if (!client.connectToServer(5000, sc.port, &error)) {
+        if (!client.connectToServer(5000, sc, &error)) {
             throw Exception("Could not connect to bridge focus service for a raise call.", error);
         }
         if (!client.raise("focusOtherInstance").ok()) {
@@ -97,6 +97,14 @@ Item {
         colorScheme: root.colorScheme
         notification: root.notifications.userBadEvent
     }
+    NotificationDialog {
+        colorScheme: root.colorScheme
+        notification: root.notifications.readReceiptRequested
+    }
+    NotificationDialog {
+        colorScheme: root.colorScheme
+        notification: root.notifications.userReauthRequired
+    }
     NotificationDialog {
         colorScheme: root.colorScheme
         notification: root.notifications.genericError
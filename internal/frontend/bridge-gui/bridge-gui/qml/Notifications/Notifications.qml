@@ -62,7 +62,7 @@ QtObject {
             target: Backend
         }
     }
-    property var all: [root.noInternet, root.imapPortStartupError, root.smtpPortStartupError, root.imapPortChangeError, root.smtpPortChangeError, root.imapConnectionModeChangeError, root.smtpConnectionModeChangeError, root.updateManualReady, root.updateManualRestartNeeded, root.updateManualError, root.updateForce, root.updateForceError, root.updateSilentRestartNeeded, root.updateSilentError, root.updateIsLatestVersion, root.loginConnectionError, root.onlyPaidUsers, root.alreadyLoggedIn, root.enableBeta, root.bugReportSendSuccess, root.bugReportSendError, root.bugReportSendFallback, root.cacheCantMove, root.cacheLocationChangeSuccess, root.enableSplitMode, root.resetBridge, root.changeAllMailVisibility, root.deleteAccount, root.noKeychain, root.rebuildKeychain, root.addressChanged, root.apiCertIssue, root.userBadEvent, root.imapLoginWhileSignedOut, root.genericError, root.genericQuestion, root.hvErrorEvent, root.repairBridge, root.userNotification]
+    property var all: [root.noInternet, root.imapPortStartupError, root.smtpPortStartupError, root.imapPortChangeError, root.smtpPortChangeError, root.imapConnectionModeChangeError, root.smtpConnectionModeChangeError, root.updateManualReady, root.updateManualRestartNeeded, root.updateManualError, root.updateForce, root.updateForceError, root.updateSilentRestartNeeded, root.updateSilentError, root.updateIsLatestVersion, root.loginConnectionError, root.onlyPaidUsers, root.alreadyLoggedIn, root.enableBeta, root.bugReportSendSuccess, root.bugReportSendError, root.bugReportSendFallback, root.cacheCantMove, root.cacheLocationChangeSuccess, root.enableSplitMode, root.resetBridge, root.changeAllMailVisibility, root.deleteAccount, root.noKeychain, root.rebuildKeychain, root.addressChanged, root.apiCertIssue, root.userBadEvent, root.readReceiptRequested, root.userReauthRequired, root.imapLoginWhileSignedOut, root.genericError, root.genericQuestion, root.hvErrorEvent, root.repairBridge, root.userNotification]
     property Notification alreadyLoggedIn: Notification {
         brief: qsTr("Already signed in")
         description: qsTr("This account is already signed in.")
@@ -1132,6 +1132,78 @@ QtObject {
             target: Backend
         }
     }
+    property Notification readReceiptRequested: Notification {
+        brief: title
+        description: "#PlaceHolderText"
+        group: Notifications.Group.Dialogs
+        icon: "./icons/ic-info-circle-filled.svg"
+        title: qsTr("Read receipt requested")
+        type: Notification.NotificationType.Info
+
+        action: [
+            Action {
+                text: qsTr("Send receipt")
+
+                onTriggered: {
+                    root.readReceiptRequested.active = false;
+                    Backend.answerReadReceiptRequest(true);
+                }
+            },
+            Action {
+                text: qsTr("Don't send")
+
+                onTriggered: {
+                    root.readReceiptRequested.active = false;
+                    Backend.answerReadReceiptRequest(false);
+                }
+            }
+        ]
+
+        Connections {
+            function onReadReceiptRequested(description) {
+                root.readReceiptRequested.description = description;
+                root.readReceiptRequested.active = true;
+            }
+
+            target: Backend
+        }
+    }
+    property Notification userReauthRequired: Notification {
+        brief: title
+        description: "#PlaceHolderText"
+        group: Notifications.Group.Connection | Notifications.Group.Dialogs
+        icon: "./icons/ic-exclamation-circle-filled.svg"
+        title: qsTr("Sign in again")
+        type: Notification.NotificationType.Warning
+
+        action: [
+            Action {
+                text: qsTr("Sign in")
+
+                onTriggered: {
+                    root.userReauthRequired.active = false;
+                    Backend.answerReauthRequest(true);
+                }
+            },
+            Action {
+                text: qsTr("Later")
+
+                onTriggered: {
+                    root.userReauthRequired.active = false;
+                    Backend.answerReauthRequest(false);
+                }
+            }
+        ]
+
+        Connections {
+            function onUserReauthRequired(description) {
+                root.userReauthRequired.description = description;
+                root.userReauthRequired.active = true;
+            }
+
+            target: Backend
+        }
+    }
     property Notification hvErrorEvent: Notification {
         group: Notifications.Group.Configuration
         icon: "./icons/ic-exclamation-circle-filled.svg"
@@ -22,7 +22,8 @@ FocusScope {
         Login,
         TOTP,
         MailboxPassword,
-        HV
+        HV,
+        Fido2
     }
 
     property alias currentIndex: stackLayout.currentIndex
@@ -30,6 +31,7 @@ FocusScope {
     property var wizard
     property string hvLinkUrl: ""
     property bool hvLinkClicked: false
+    property bool totpAvailable: false
 
     signal loginAbort(string username, bool wasSignedOut)
 
@@ -42,6 +44,7 @@ FocusScope {
         stackLayout.currentIndex = Login.RootStack.Login;
         loginLayout.reset(clearUsername);
         totpLayout.reset();
+        fido2Layout.reset();
         mailboxPasswordLayout.reset();
         if (username.length === 0) {
             usernameTextField.forceActiveFocus();
@@ -75,7 +78,12 @@ FocusScope {
         anchors.fill: parent
 
         Connections {
-            function onLogin2FAError(_) {
+            function onLogin2FAError(errorMsg) {
+                if (stackLayout.currentIndex === Login.RootStack.Fido2) {
+                    fido2Button.loading = false;
+                    fido2ErrorLabel.text = errorMsg !== "" ? errorMsg : qsTr("Your security key could not be verified");
+                    return;
+                }
                 console.assert(stackLayout.currentIndex === Login.RootStack.TOTP, "Unexpected login2FAError");
                 twoFAButton.loading = false;
                 twoFactorPasswordTextField.enabled = true;
@@ -84,7 +92,7 @@ FocusScope {
                 twoFactorPasswordTextField.focus = true;
             }
             function onLogin2FAErrorAbort(_) {
-                console.assert(stackLayout.currentIndex === Login.RootStack.TOTP, "Unexpected login2FAErrorAbort");
+                console.assert(stackLayout.currentIndex === Login.RootStack.TOTP || stackLayout.currentIndex === Login.RootStack.Fido2, "Unexpected login2FAErrorAbort");
                 root.reset();
                 errorLabel.text = qsTr("Incorrect login credentials. Please try again.");
             }
@@ -94,6 +102,13 @@ FocusScope {
                 stackLayout.currentIndex = Login.RootStack.TOTP;
                 twoFactorPasswordTextField.focus = true;
             }
+            function onLoginFido2Requested(username, totpAvailable) {
+                console.assert(stackLayout.currentIndex === Login.RootStack.Login || stackLayout.currentIndex === Login.RootStack.TOTP, "Unexpected loginFido2Requested");
+                root.totpAvailable = totpAvailable;
+                fido2UsernameLabel.text = username;
+                stackLayout.currentIndex = Login.RootStack.Fido2;
+                fido2Button.focus = true;
+            }
             function onLogin2PasswordError(_) {
                 console.assert(stackLayout.currentIndex === Login.RootStack.MailboxPassword, "Unexpected login2PasswordError");
                 secondPasswordButton.loading = false;
@@ -108,7 +123,7 @@ FocusScope {
                 errorLabel.text = qsTr("Incorrect login credentials. Please try again.");
             }
             function onLogin2PasswordRequested(username) {
-                console.assert(stackLayout.currentIndex === Login.RootStack.Login || stackLayout.currentIndex === Login.RootStack.TOTP, "Unexpected login2PasswordRequested");
+                console.assert(stackLayout.currentIndex === Login.RootStack.Login || stackLayout.currentIndex === Login.RootStack.TOTP || stackLayout.currentIndex === Login.RootStack.Fido2, "Unexpected login2PasswordRequested");
                 stackLayout.currentIndex = Login.RootStack.MailboxPassword;
                 mailboxPasswordUsernameLabel.text = username;
                 secondPasswordTextField.focus = true;
@@ -610,5 +625,103 @@ FocusScope {
                 }
             }
         }
+        Item {
+            ColumnLayout {
+                id: fido2Layout
+                function reset() {
+                    fido2Button.loading = false;
+                    fido2ErrorLabel.text = "";
+                }
+
+                anchors.left: parent.left
+                anchors.right: parent.right
+                anchors.verticalCenter: parent.verticalCenter
+                spacing: ProtonStyle.wizard_spacing_medium
+
+                ColumnLayout {
+                    Layout.fillWidth: true
+                    spacing: ProtonStyle.wizard_spacing_small
+
+                    Label {
+                        Layout.alignment: Qt.AlignHCenter
+                        Layout.fillWidth: true
+                        colorScheme: wizard.colorScheme
+                        horizontalAlignment: Text.AlignHCenter
+                        text: qsTr("Security key")
+                        type: Label.LabelType.Title
+                    }
+                    Label {
+                        id: fido2UsernameLabel
+                        Layout.alignment: Qt.AlignHCenter
+                        Layout.fillWidth: true
+                        color: wizard.colorScheme.text_weak
+                        colorScheme: wizard.colorScheme
+                        horizontalAlignment: Text.AlignHCenter
+                        text: ""
+                        type: Label.LabelType.Body
+                    }
+                }
+                Label {
+                    Layout.alignment: Qt.AlignHCenter
+                    Layout.fillWidth: true
+                    colorScheme: wizard.colorScheme
+                    horizontalAlignment: Text.AlignHCenter
+                    text: fido2Button.loading ? qsTr("Touch your security key to sign in.") : qsTr("Your account is protected with a security key. Insert it in this computer, then click Authenticate.")
+                    type: Label.LabelType.Body
+                    wrapMode: Text.WordWrap
+                }
+                Label {
+                    id: fido2ErrorLabel
+                    Layout.fillWidth: true
+                    color: wizard.colorScheme.signal_danger
+                    colorScheme: wizard.colorScheme
+                    horizontalAlignment: Text.AlignHCenter
+                    type: Label.LabelType.Caption_semibold
+                    visible: text.length > 0
+                    wrapMode: Text.WordWrap
+                }
+                Button {
+                    id: fido2Button
+                    Layout.fillWidth: true
+                    colorScheme: wizard.colorScheme
+                    enabled: !loading
+                    text: loading ? qsTr("Authenticating") : qsTr("Authenticate")
+
+                    onClicked: {
+                        fido2ErrorLabel.text = "";
+                        loading = true;
+                        Backend.loginFido2Local(usernameTextField.text);
+                    }
+                }
+                Button {
+                    Layout.fillWidth: true
+                    colorScheme: wizard.colorScheme
+                    enabled: !fido2Button.loading
+                    secondary: true
+                    secondaryIsOpaque: true
+                    text: qsTr("Use an authenticator code instead")
+                    visible: root.totpAvailable
+
+                    onClicked: {
+                        fido2Layout.reset();
+                        twoFactorUsernameLabel.text = fido2UsernameLabel.text;
+                        stackLayout.currentIndex = Login.RootStack.TOTP;
+                        twoFactorPasswordTextField.focus = true;
+                    }
+                }
+                Button {
+                    Layout.fillWidth: true
+                    colorScheme: wizard.colorScheme
+                    enabled: !fido2Button.loading
+                    secondary: true
+                    secondaryIsOpaque: true
+                    text: qsTr("Cancel")
+
+                    onClicked: {
+                        root.abort();
+                    }
+                }
+            }
+        }
     }
 }
@@ -200,6 +200,9 @@ Item {
                         function onLogin2PasswordRequested() {
                             leftContent.showLoginMailboxPassword();
                         }
+                        function onLoginFido2Requested() {
+                            leftContent.showLogin2FA();
+                        }
 
                         target: Backend
                     }
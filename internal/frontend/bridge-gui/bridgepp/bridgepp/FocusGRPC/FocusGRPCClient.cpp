@@ -18,6 +18,7 @@
 
 #include "FocusGRPCClient.h"
 #include "../Exception/Exception.h"
+#include "../GRPC/GRPCUtils.h"
 
 
 using namespace focus;
@@ -79,13 +80,15 @@ void FocusGRPCClient::removeServiceConfigFile(QString const &configDir) {
 
 //****************************************************************************************************************************************************
 /// \param[in] timeoutMs The timeout for the connection.
-/// \param[in] port The gRPC server port.
+/// \param[in] config The focus service configuration.
 /// \param[out] outError if not null and the function returns false.
 /// \return true iff the connection was successfully established.
 //****************************************************************************************************************************************************
-bool FocusGRPCClient::connectToServer(qint64 timeoutMs, quint16 port, QString *outError) {
+bool FocusGRPCClient::connectToServer(qint64 timeoutMs, GRPCConfig const &config, QString *outError) {
     try {
-        QString const address = QString("%1:%2").arg(hostname).arg(port);
+        // Bridge listens on a TCP port when set to, or when it cannot create the socket.
+        QString const address = (useFileSocketForGRPC() && !config.fileSocketPath.isEmpty()) ?
+            QString("unix://" + config.fileSocketPath) : QString("%1:%2").arg(hostname).arg(config.port);
         channel_ = grpc::CreateChannel(address.toStdString(), grpc::InsecureChannelCredentials());
         if (!channel_) {
             throw Exception("Could not create focus service channel.");
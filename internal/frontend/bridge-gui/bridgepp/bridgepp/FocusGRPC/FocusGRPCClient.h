@@ -23,6 +23,7 @@
 #include "grpc++/grpc++.h"
 #include "focus.grpc.pb.h"
 #include "../Log/Log.h"
+#include "../GRPC/GRPCConfig.h"
 
 
 namespace bridgepp {
@@ -44,7 +45,7 @@ public: // member functions.
     FocusGRPCClient &operator=(FocusGRPCClient const &) = delete; ///< Disabled assignment operator.
     FocusGRPCClient &operator=(FocusGRPCClient &&) = delete; ///< Disabled move assignment operator.
 
-    bool connectToServer(qint64 timeoutMs, quint16 port, QString *outError = nullptr); ///< Connect to the focus server
+    bool connectToServer(qint64 timeoutMs, GRPCConfig const &config, QString *outError = nullptr); ///< Connect to the focus server
     grpc::Status raise(QString const &reason); ///< Performs the 'raise' call.
     grpc::Status version(QString &outVersion); ///< Performs the 'version' call.
 
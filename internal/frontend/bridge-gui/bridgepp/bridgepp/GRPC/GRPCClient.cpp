@@ -36,6 +36,7 @@ namespace {
 Empty empty; // re-used across client calls.
 qint64 const grpcConnectionWaitTimeoutMs = 60000; ///< Timeout for the connection to the already running gRPC server in milliseconds.
 qint64 const grpcConnectionRetryDelayMs = 10000; ///< Retry delay for the gRPC connection in milliseconds.
+qint32 const clientAPIVersion = 7; ///< The version of the gRPC API whose stream events the GUI knows. Bridge does not send the GUI newer ones.
 
 
 } // anonymous namespace
@@ -125,7 +126,7 @@ void GRPCClient::connectToServer(QString const &sessionID, QString const &config
         serverToken_ = config.token.toStdString();
         QString address;
         grpc::ChannelArguments chanArgs;
-        if (useFileSocketForGRPC()) {
+        if (useFileSocketForGRPC() && !config.fileSocketPath.isEmpty()) { // Bridge falls back to TCP when set to, or when it cannot create the socket.
             address = QString("unix://" + config.fileSocketPath);
             chanArgs.SetSslTargetNameOverride("127.0.0.1"); // for file socket, we skip name verification to avoid a confusion localhost/127.0.0.1
         } else {
@@ -191,6 +192,12 @@ void GRPCClient::connectToServer(QString const &sessionID, QString const &config
         }
 
         log_->info("gRPC token was validated");
+
+        // Bridges that predate the call don't send events the GUI doesn't know about anyway.
+        status = this->getServiceInfo();
+        if (!status.ok()) {
+            this->logInfo(QString("Could not negotiate the gRPC API version: %1").arg(QString::fromStdString(status.error_message())));
+        }
     }
     catch (Exception const &e) {
         throw Exception("Cannot connect to Go backend via gRPC: " + e.qwhat(), e.details(), __FUNCTION__, e.attachment());
@@ -224,6 +231,22 @@ grpc::Status GRPCClient::checkTokens(QString const &clientConfigPath, QString &o
 }
 
 
+//****************************************************************************************************************************************************
+/// \return The status code for the call.
+//****************************************************************************************************************************************************
+grpc::Status GRPCClient::getServiceInfo() {
+    ServiceInfoRequest request;
+    request.set_clientapiversion(clientAPIVersion);
+    request.set_clientname("bridge-gui");
+    ServiceInfo response;
+    Status status = stub_->GetServiceInfo(this->clientContext().get(), request, &response);
+    if (status.ok()) {
+        this->logInfo(QString("Bridge implements version %1 of the gRPC API, the GUI version %2.").arg(response.apiversion()).arg(clientAPIVersion));
+    }
+    return status;
+}
+
+
 //****************************************************************************************************************************************************
 /// \param[in] level The level of the log entry.
 /// \param[in] package The package (component) that triggered the entry.
@@ -633,6 +656,17 @@ grpc::Status GRPCClient::login2FA(QString const &username, QString const &code)
 }
 
 
+//****************************************************************************************************************************************************
+/// \param[in] username The username.
+/// \return the status for the gRPC call.
+//****************************************************************************************************************************************************
+grpc::Status GRPCClient::loginFido2Local(QString const &username) {
+    LoginFido2LocalRequest request;
+    request.set_username(username.toStdString());
+    return this->logGRPCCallStatus(stub_->LoginFido2Local(this->clientContext().get(), request, &empty), __FUNCTION__);
+}
+
+
 //****************************************************************************************************************************************************
 /// \param[in] username The username.
 /// \param[in] password The password.
@@ -746,6 +780,18 @@ grpc::Status GRPCClient::sendBadEventUserFeedback(QString const &userID, bool do
 }
 
 
+//****************************************************************************************************************************************************
+/// \param[in] userID The userID.
+/// \param[in] messageID The ID of the message whose read receipt should be sent.
+//****************************************************************************************************************************************************
+grpc::Status GRPCClient::sendUserReadReceipt(QString const &userID, QString const &messageID) {
+    UserReadReceiptRequest request;
+    request.set_userid(userID.toStdString());
+    request.set_messageid(messageID.toStdString());
+    return this->logGRPCCallStatus(stub_->SendUserReadReceipt(this->clientContext().get(), request, &empty), __FUNCTION__);
+}
+
+
 //****************************************************************************************************************************************************
 /// \param[out] outUsers The user list.
 /// \return The status code for the gRPC call.
@@ -1217,6 +1263,13 @@ void GRPCClient::processAppEvent(AppEvent const &event) {
         emit userNotificationReceived(notification);
         break;
     }
+    case AppEvent::kBridgeLocked:
+    case AppEvent::kBridgeUnlocked:
+    case AppEvent::kApiRateLimited:
+    case AppEvent::kKeyRotationStarted:
+    case AppEvent::kKeyRotationFinished:
+        this->logTrace("App event received and ignored by the GUI.");
+        break;
     default:
         this->logError("Unknown App event received.");
     }
@@ -1266,6 +1319,12 @@ void GRPCClient::processLoginEvent(LoginEvent const &event) {
         this->logTrace("Login event received: TfaRequested.");
         emit login2FARequested(QString::fromStdString(event.tfarequested().username()));
         break;
+    case LoginEvent::kFido2Requested: {
+        this->logTrace("Login event received: Fido2Requested.");
+        LoginFido2RequestedEvent const &fido2 = event.fido2requested();
+        emit loginFido2Requested(QString::fromStdString(fido2.username()), fido2.totpavailable());
+        break;
+    }
     case LoginEvent::kTwoPasswordRequested:
         this->logTrace("Login event received: TwoPasswordRequested.");
         emit login2PasswordRequested(QString::fromStdString(event.twopasswordrequested().username()));
@@ -1473,6 +1532,9 @@ void GRPCClient::processMailEvent(MailEvent const &event) {
         emit apiCertIssue();
         this->logTrace("Mail event received: ApiCertIssue.");
         break;
+    case MailEvent::kMessageTooLarge:
+        this->logTrace("Mail event received and ignored by the GUI: MessageTooLarge.");
+        break;
     default:
         this->logError("Unknown Mail event received.");
     }
@@ -1547,6 +1609,25 @@ void GRPCClient::processUserEvent(UserEvent const &event) {
         emit syncProgress(userID, e.progress(), e.elapsedms(), e.remainingms());
         break;
     }
+    case UserEvent::kStorageAlmostFullEvent:
+        this->logTrace("User event received and ignored by the GUI: StorageAlmostFull.");
+        break;
+    case UserEvent::kReadReceiptRequestedEvent: {
+        UserReadReceiptRequestedEvent const &e = event.readreceiptrequestedevent();
+        QString const userID = QString::fromStdString(e.userid());
+        QString const messageID = QString::fromStdString(e.messageid());
+        this->logTrace(QString("User event received: ReadReceiptRequested (userID = %1, messageID = %2).").arg(userID, messageID));
+        emit readReceiptRequested(userID, messageID, QString::fromStdString(e.recipient()), QString::fromStdString(e.subject()));
+        break;
+    }
+    case UserEvent::kReauthRequiredEvent: {
+        UserReauthRequiredEvent const &e = event.reauthrequiredevent();
+        QString const userID = QString::fromStdString(e.userid());
+        QString const username = QString::fromStdString(e.username());
+        this->logTrace(QString("User event received: ReauthRequired (userID = %1, username = %2).").arg(userID, username));
+        emit userReauthRequired(userID, username);
+        break;
+    }
     default:
         this->logError("Unknown User event received.");
     }
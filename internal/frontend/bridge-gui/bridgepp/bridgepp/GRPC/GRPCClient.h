@@ -96,6 +96,7 @@ public: // member functions.
     bool isConnected() const; ///< Check whether the gRPC client is connected to the server.
 
     grpc::Status checkTokens(QString const &clientConfigPath, QString &outReturnedClientToken); ///< Performs a token check.
+    grpc::Status getServiceInfo(); ///< Performs the "GetServiceInfo" gRPC call, negotiating the API version.
     grpc::Status addLogEntry(Log::Level level, QString const &package, QString const &message); ///< Performs the "AddLogEntry" gRPC call.
     grpc::Status guiReady(bool &outShowSplashScreen); ///< performs the "GuiReady" gRPC call.
     grpc::Status isAutostartOn(bool &outIsOn); ///< Performs the "isAutostartOn" gRPC call.
@@ -175,6 +176,7 @@ signals:
 public: // login related calls
     grpc::Status login(QString const &username, QString const &password); ///< Performs the 'login' call.
     grpc::Status login2FA(QString const &username, QString const &code); ///< Performs the 'login2FA' call.
+    grpc::Status loginFido2Local(QString const &username); ///< Performs the 'loginFido2Local' call.
     grpc::Status login2Passwords(QString const &username, QString const &password); ///< Performs the 'login2Passwords' call.
     grpc::Status loginAbort(QString const &username); ///< Performs the 'loginAbort' call.
     grpc::Status loginHv(QString const &username, QString const &password); ///< Performs the 'login' call with additional useHv flag
@@ -186,6 +188,7 @@ signals:
     void login2FARequested(QString const &username);
     void login2FAError(QString const &errMsg);
     void login2FAErrorAbort(QString const &errMsg);
+    void loginFido2Requested(QString const &username, bool totpAvailable);
     void login2PasswordRequested(QString const &username);
     void login2PasswordError(QString const &errMsg);
     void login2PasswordErrorAbort(QString const &errMsg);
@@ -220,6 +223,7 @@ public: // user related calls
     grpc::Status configureAppleMail(QString const &userID, QString const &address); ///< Performs the 'configureAppleMail' call.
     grpc::Status setUserSplitMode(QString const &userID, bool active); ///< Performs the 'SetUserSplitMode' call.
     grpc::Status sendBadEventUserFeedback(QString const& userID, bool doResync); ///< Performs the 'SendBadEventUserFeedback' call.
+    grpc::Status sendUserReadReceipt(QString const &userID, QString const &messageID); ///< Performs the 'SendUserReadReceipt' call.
 
 signals:
     void toggleSplitModeFinished(QString const &userID);
@@ -228,6 +232,8 @@ signals:
     void userBadEvent(QString const &userID, QString const& errorMessage);
     void usedBytesChanged(QString const &userID, qint64 usedBytes);
     void imapLoginFailed(QString const& username);
+    void readReceiptRequested(QString const &userID, QString const &messageID, QString const &recipient, QString const &subject);
+    void userReauthRequired(QString const &userID, QString const &username);
     void syncStarted(QString const &userID);
     void syncFinished(QString const &userID);
     void syncProgress(QString const &userID, double progress, qint64 elapsedMs, qint64 remainingMs);
@@ -51,10 +51,10 @@ QString grpcClientConfigBaseFilename() {
 
 
 //****************************************************************************************************************************************************
-/// return true if gRPC connection should use file socket instead of TCP socket.
+/// return true if gRPC connection should use file socket instead of TCP socket. Windows supports Unix domain sockets too.
 //****************************************************************************************************************************************************
 bool useFileSocketForGRPC() {
-    return !onWindows();
+    return true;
 }
 
 
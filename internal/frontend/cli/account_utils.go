@@ -80,6 +80,17 @@ func (f *frontendCLI) askUserByIndexOrName(c *ishell.Context) bridge.UserInfo {
 	return user
 }
 
+// argsAfterUser returns the parameters following the account chosen by askUserByIndexOrName.
+// With a single account, the account may be omitted.
+func (f *frontendCLI) argsAfterUser(c *ishell.Context, user bridge.UserInfo) []string {
+	args := c.Args
+	if len(args) > 0 && (len(f.bridge.GetUserIDs()) > 1 || args[0] == user.Username || args[0] == "0") {
+		args = args[1:]
+	}
+
+	return args
+}
+
 func (f *frontendCLI) getUserByIndexOrName(arg string) bridge.UserInfo {
 	userIDs := f.bridge.GetUserIDs()
 	numberOfAccounts := len(userIDs)
@@ -19,15 +19,23 @@ package cli
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clientconfig"
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/fido"
 	"github.com/ProtonMail/proton-bridge/v3/internal/hv"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/eventjournal"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
 	"github.com/abiosoft/ishell"
 )
 
@@ -44,6 +52,11 @@ func (f *frontendCLI) listAccounts(_ *ishell.Context) {
 		switch user.State {
 		case bridge.SignedOut:
 			state = "signed out"
+			if user.PendingReauth {
+				state = "sign in again"
+			} else if user.DataKept {
+				state = "removed"
+			}
 		case bridge.Locked:
 			state = "locked"
 		case bridge.Connected:
@@ -107,6 +120,9 @@ func (f *frontendCLI) showAccountAddressInfo(user bridge.UserInfo, address strin
 		user.BridgePass,
 		imapSecurity,
 	)
+	if port := f.bridge.GetIMAPImplicitTLSPort(); port != 0 {
+		f.Printf("SSL port:  %d\n", port)
+	}
 	f.Println("")
 	f.Printf("SMTP Settings\nAddress:   %s\nSMTP port: %d\nUsername:  %s\nPassword:  %s\nSecurity:  %s\n",
 		constants.Host,
@@ -115,6 +131,9 @@ func (f *frontendCLI) showAccountAddressInfo(user bridge.UserInfo, address strin
 		user.BridgePass,
 		smtpSecurity,
 	)
+	if port := f.bridge.GetSMTPImplicitTLSPort(); port != 0 {
+		f.Printf("SSL port:  %d\n", port)
+	}
 	f.Println("")
 }
 
@@ -174,17 +193,8 @@ func (f *frontendCLI) loginAccount(c *ishell.Context) {
 		return
 	}
 
-	if auth.TwoFA.Enabled&proton.HasTOTP != 0 {
-		code := f.readStringInAttempts("Two factor code", c.ReadLine, isNotEmpty)
-		if code == "" {
-			f.printAndLogError("Cannot login: need two factor code")
-			return
-		}
-
-		if err := client.Auth2FA(context.Background(), proton.Auth2FAReq{TwoFactorCode: code}); err != nil {
-			f.printAndLogError("Cannot login: ", err)
-			return
-		}
+	if !f.authSecondFactor(c, client, auth) {
+		return
 	}
 
 	var keyPass []byte
@@ -233,17 +243,8 @@ func (f *frontendCLI) loginAccountHv(c *ishell.Context, loginName string, passwo
 		return
 	}
 
-	if auth.TwoFA.Enabled&proton.HasTOTP != 0 {
-		code := f.readStringInAttempts("Two factor code", c.ReadLine, isNotEmpty)
-		if code == "" {
-			f.printAndLogError("Cannot login: need two factor code")
-			return
-		}
-
-		if err := client.Auth2FA(context.Background(), proton.Auth2FAReq{TwoFactorCode: code}); err != nil {
-			f.printAndLogError("Cannot login: ", err)
-			return
-		}
+	if !f.authSecondFactor(c, client, auth) {
+		return
 	}
 
 	userID, err := f.bridge.LoginUser(context.Background(), client, auth, keyPass, hvDetails)
@@ -261,6 +262,92 @@ func (f *frontendCLI) loginAccountHv(c *ishell.Context, loginName string, passwo
 	f.Printf("Account %s was added successfully.\n", bold(user.Username))
 }
 
+// authSecondFactor completes the second factor of the login, if any. It returns false if the login cannot continue.
+func (f *frontendCLI) authSecondFactor(c *ishell.Context, client *proton.Client, auth proton.Auth) bool {
+	hasTOTP := auth.TwoFA.Enabled&proton.HasTOTP != 0
+
+	if fido.IsEnabled(auth) && (!hasTOTP || f.yesNoQuestion("Use a security key")) {
+		return f.authSecurityKey(c, client, auth)
+	}
+
+	if !hasTOTP {
+		return true
+	}
+
+	code := f.readStringInAttempts("Two factor code", c.ReadLine, isNotEmpty)
+	if code == "" {
+		f.printAndLogError("Cannot login: need two factor code")
+		return false
+	}
+
+	if err := client.Auth2FA(context.Background(), proton.Auth2FAReq{TwoFactorCode: code}); err != nil {
+		f.printAndLogError("Cannot login: ", err)
+		return false
+	}
+
+	return true
+}
+
+// authSecurityKey completes the login with an assertion made by a security key connected to this machine.
+// If the libfido2 tools can't make it, the user is asked to paste an assertion made elsewhere.
+func (f *frontendCLI) authSecurityKey(c *ishell.Context, client *proton.Client, auth proton.Auth) bool {
+	challenge, err := fido.NewChallenge(auth)
+	if err != nil {
+		f.printAndLogError("Cannot login: ", err)
+		return false
+	}
+
+	f.Println("Touch your security key...")
+
+	assertion, err := fido.Assert(context.Background(), challenge)
+	if err != nil {
+		f.Println("Cannot use the security key:", err)
+
+		if assertion, err = f.readSecurityKeyAssertion(c, challenge); err != nil {
+			f.printAndLogError("Cannot login: ", err)
+			return false
+		}
+	}
+
+	if err := client.Auth2FA(context.Background(), challenge.Auth2FAReq(assertion)); err != nil {
+		f.printAndLogError("Cannot login: ", err)
+		return false
+	}
+
+	return true
+}
+
+// readSecurityKeyAssertion asks the user for an assertion of the challenge made by hand, e.g. with fido2-assert.
+func (f *frontendCLI) readSecurityKeyAssertion(c *ishell.Context, challenge *fido.Challenge) (fido.Assertion, error) {
+	f.Println("Sign the following challenge with your security key (values are base64 encoded):")
+	f.Println("Client data hash:", base64.StdEncoding.EncodeToString(challenge.ClientDataHash()))
+	f.Println("Relying party ID:", challenge.RelyingPartyID)
+
+	for _, credentialID := range challenge.CredentialIDs {
+		f.Println("Credential ID:   ", base64.StdEncoding.EncodeToString(credentialID))
+	}
+
+	var assertion fido.Assertion
+
+	for _, field := range []struct {
+		title string
+		value *[]byte
+	}{
+		{"Authenticator data", &assertion.AuthenticatorData},
+		{"Signature", &assertion.Signature},
+		{"Credential ID", &assertion.CredentialID},
+	} {
+		value := f.readStringInAttempts(field.title, c.ReadLine, isBase64)
+		if value == "" {
+			return fido.Assertion{}, errors.New("need security key assertion")
+		}
+
+		*field.value, _ = base64.StdEncoding.DecodeString(value)
+	}
+
+	return assertion, nil
+}
+
 func (f *frontendCLI) logoutAccount(c *ishell.Context) {
 	f.ShowPrompt(false)
 	defer f.ShowPrompt(true)
@@ -286,11 +373,21 @@ func (f *frontendCLI) deleteAccount(c *ishell.Context) {
 		return
 	}
 
-	if f.yesNoQuestion("Are you sure you want to " + bold("remove account "+user.Username)) {
-		if err := f.bridge.DeleteUser(context.Background(), user.UserID); err != nil {
-			f.printAndLogError("Cannot delete account: ", err)
-			return
+	if !f.yesNoQuestion("Are you sure you want to " + bold("remove account "+user.Username)) {
+		return
+	}
+
+	if f.yesNoQuestion("Do you want to keep the local message cache, so that signing in again doesn't download all messages") {
+		if err := f.bridge.RemoveUserKeepData(context.Background(), user.UserID); err != nil {
+			f.printAndLogError("Cannot remove account: ", err)
 		}
+
+		return
+	}
+
+	if err := f.bridge.DeleteUser(context.Background(), user.UserID); err != nil {
+		f.printAndLogError("Cannot delete account: ", err)
+		return
 	}
 }
 
@@ -335,6 +432,101 @@ func (f *frontendCLI) deleteEverything(c *ishell.Context) {
 	f.Stop()
 }
 
+func (f *frontendCLI) listAppPasswords(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	appPasses, err := f.bridge.GetAppPasswords(user.UserID)
+	if err != nil {
+		f.printAndLogError("Cannot list app passwords: ", err)
+		return
+	}
+
+	if len(appPasses) == 0 {
+		f.Printf("Account %s has no app passwords.\n", bold(user.Username))
+	}
+
+	for _, appPass := range appPasses {
+		f.Printf("%-20s created %s\n", appPass.Name, appPass.CreatedAt.Format(time.RFC822))
+	}
+}
+
+func (f *frontendCLI) createAppPassword(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	if len(c.Args) < 2 {
+		f.Println("Please provide the name of the client the app password is for.")
+		return
+	}
+
+	appPass, err := f.bridge.CreateAppPassword(user.UserID, strings.Join(c.Args[1:], " "))
+	if err != nil {
+		f.printAndLogError("Cannot create app password: ", err)
+		return
+	}
+
+	f.Println("App password:", string(appPass))
+	f.Println("It will not be shown again; use it instead of the bridge password in your client.")
+}
+
+func (f *frontendCLI) revokeAppPassword(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	if len(c.Args) < 2 {
+		f.Println("Please provide the name of the app password to revoke.")
+		return
+	}
+
+	name := strings.Join(c.Args[1:], " ")
+
+	if !f.yesNoQuestion("Are you sure you want to revoke app password " + bold(name) + " of account " + bold(user.Username)) {
+		return
+	}
+
+	if err := f.bridge.RevokeAppPassword(user.UserID, name); err != nil {
+		f.printAndLogError("Cannot revoke app password: ", err)
+	}
+}
+
+func (f *frontendCLI) createAuthToken(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	var validity time.Duration
+
+	if len(c.Args) > 1 {
+		days, err := strconv.Atoi(c.Args[1])
+		if err != nil || days <= 0 {
+			f.Println("The validity must be a positive number of days.")
+			return
+		}
+
+		validity = time.Duration(days) * 24 * time.Hour
+	}
+
+	token, err := f.bridge.CreateAuthToken(user.UserID, validity)
+	if err != nil {
+		f.printAndLogError("Cannot create auth token: ", err)
+		return
+	}
+
+	f.Println("Auth token:", string(token))
+	f.Println("Use it as the OAuth access token in your client; it is revoked when the bridge password changes.")
+}
+
 func (f *frontendCLI) changeMode(c *ishell.Context) {
 	user := f.askUserByIndexOrName(c)
 	if user.UserID == "" {
@@ -360,6 +552,293 @@ func (f *frontendCLI) changeMode(c *ishell.Context) {
 	f.Printf("Address mode for account %s changed to %s\n", user.Username, targetMode)
 }
 
+// maxHistoryEntries is the number of most recent journal entries shown by the history command.
+const maxHistoryEntries = 100
+
+func (f *frontendCLI) showAccountHistory(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	// With a single account, the account may be omitted and all parameters are the search text.
+	args := f.argsAfterUser(c, user)
+
+	entries, err := f.bridge.GetUserEventJournal(user.UserID)
+	if err != nil {
+		f.printAndLogError("Cannot get account history:", err)
+		return
+	}
+
+	if len(args) > 0 {
+		entries = eventjournal.Search(entries, strings.Join(args, " "))
+	}
+
+	if len(entries) == 0 {
+		f.Println("Nothing happened to account " + bold(user.Username) + " yet.")
+		return
+	}
+
+	if len(entries) > maxHistoryEntries {
+		entries = entries[len(entries)-maxHistoryEntries:]
+	}
+
+	for _, entry := range entries {
+		f.Println(formatJournalEntry(entry))
+	}
+}
+
+func formatJournalEntry(entry eventjournal.Entry) string {
+	line := entry.Time.Local().Format(time.DateTime) + "  "
+
+	if entry.Kind == eventjournal.KindRefresh {
+		return line + "full resync requested by the server (event " + entry.EventID + ")"
+	}
+
+	line += fmt.Sprintf("%s %s %s", entry.Kind, entry.ID, bold(entry.Action))
+
+	if entry.Subject != "" {
+		line += fmt.Sprintf(" %q", entry.Subject)
+	}
+
+	if entry.Sender != "" {
+		line += " from " + entry.Sender
+	}
+
+	if len(entry.Added) > 0 || len(entry.Removed) > 0 {
+		for _, name := range entry.Added {
+			line += " +" + name
+		}
+
+		for _, name := range entry.Removed {
+			line += " -" + name
+		}
+	} else if len(entry.Labels) > 0 {
+		line += " in " + strings.Join(entry.Labels, ", ")
+	}
+
+	return line + " (event " + entry.EventID + ")"
+}
+
+func (f *frontendCLI) prioritizeAccountSync(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	if err := f.bridge.PrioritizeSync(user.UserID); err != nil {
+		f.printAndLogError("Cannot prioritize sync:", err)
+		return
+	}
+
+	f.Println("Account " + bold(user.Username) + " will be the next one to sync.")
+}
+
+func (f *frontendCLI) changeUserAllMail(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	if user.HideAllMail {
+		f.Println("All Mail folder is hidden for account " + bold(user.Username) + ".")
+	} else {
+		f.Println("All Mail folder is listed for account " + bold(user.Username) + ".")
+	}
+
+	question := "Do you want to hide All Mail folder for this account"
+	if user.HideAllMail {
+		question = "Do you want to show All Mail folder for this account"
+	}
+
+	if !f.yesNoQuestion(question) {
+		return
+	}
+
+	if err := f.bridge.SetUserHideAllMail(user.UserID, !user.HideAllMail); err != nil {
+		f.printAndLogError("Cannot change All Mail visibility:", err)
+		return
+	}
+
+	if user.HideAllMail && !f.bridge.GetShowAllMail() {
+		f.Println("All Mail folder remains hidden, as it is hidden for all accounts in the bridge settings.")
+	}
+}
+
+func (f *frontendCLI) changeUserRepairMIME(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	if user.RepairMIME {
+		f.Println("Malformed messages are repaired for account " + bold(user.Username) + ".")
+	} else {
+		f.Println("Malformed messages are served as-is for account " + bold(user.Username) + ".")
+	}
+
+	question := "Do you want to repair malformed messages for this account"
+	if user.RepairMIME {
+		question = "Do you want to stop repairing malformed messages for this account"
+	}
+
+	if !f.yesNoQuestion(question) {
+		return
+	}
+
+	if err := f.bridge.SetUserRepairMIME(user.UserID, !user.RepairMIME); err != nil {
+		f.printAndLogError("Cannot change MIME repair:", err)
+		return
+	}
+
+	if !user.RepairMIME {
+		f.Println("Repaired messages keep the original as an attachment named " + message.RepairedOriginalName + ".")
+	}
+
+	f.Println("The messages of the account are downloaded again to apply the change.")
+}
+
+func (f *frontendCLI) changeUserReadReceipts(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	f.Println("Read receipt requests of account " + bold(user.Username) + " are handled with mode " + bold(user.ReadReceiptMode.String()) + ".")
+
+	args := f.argsAfterUser(c, user)
+	if len(args) == 0 {
+		f.Println("Use " + bold("change read-receipts <account> ignore|prompt|auto") + " to change it.")
+		return
+	}
+
+	mode, ok := parseReadReceiptMode(args[0])
+	if !ok {
+		f.Printf("Wrong mode '%s'. Choose ignore, prompt or auto.\n", bold(args[0]))
+		return
+	}
+
+	if err := f.bridge.SetUserReadReceiptMode(user.UserID, mode); err != nil {
+		f.printAndLogError("Cannot change read receipt mode:", err)
+		return
+	}
+
+	switch mode {
+	case vault.ReadReceiptIgnore:
+		f.Println("Read receipt requests are now ignored.")
+	case vault.ReadReceiptPrompt:
+		f.Println("You will be asked whether to send a read receipt when reading a message which requests one.")
+	case vault.ReadReceiptAuto:
+		f.Println("Read receipts are now sent automatically, unless they would not go back to the sender of the message.")
+	}
+}
+
+func (f *frontendCLI) changeUserSyncWindow(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	if user.SyncCutoff.IsZero() {
+		f.Println("All messages of account " + bold(user.Username) + " are synced.")
+	} else {
+		f.Println("Messages of account " + bold(user.Username) + " older than " + bold(user.SyncCutoff.Format(time.DateOnly)) + " are not synced.")
+	}
+
+	args := f.argsAfterUser(c, user)
+	if len(args) == 0 {
+		f.Println("Use " + bold("change sync-window <account> <days>|all") + " to change it.")
+		return
+	}
+
+	var cutoff time.Time
+
+	if args[0] != "all" {
+		days, err := strconv.Atoi(args[0])
+		if err != nil || days <= 0 {
+			f.Printf("Wrong number of days '%s'. Use a positive number or all.\n", bold(args[0]))
+			return
+		}
+
+		cutoff = time.Now().AddDate(0, 0, -days)
+	}
+
+	if err := f.bridge.SetUserSyncCutoff(context.Background(), user.UserID, cutoff); err != nil {
+		f.printAndLogError("Cannot change sync window:", err)
+		return
+	}
+
+	if cutoff.IsZero() {
+		f.Println("All messages are now synced.")
+	} else {
+		f.Println("Only the messages received since " + bold(cutoff.Format(time.DateOnly)) + " are now synced.")
+	}
+}
+
+func (f *frontendCLI) sendReadReceipt(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	args := f.argsAfterUser(c, user)
+	if len(args) != 1 {
+		f.Println("Please provide the ID of the message to send the read receipt of.")
+		return
+	}
+
+	if err := f.bridge.SendUserReadReceipt(context.Background(), user.UserID, args[0]); err != nil {
+		f.printAndLogError("Cannot send read receipt:", err)
+		return
+	}
+
+	f.Println("Read receipt sent.")
+}
+
+func parseReadReceiptMode(arg string) (vault.ReadReceiptMode, bool) {
+	for _, mode := range []vault.ReadReceiptMode{vault.ReadReceiptIgnore, vault.ReadReceiptPrompt, vault.ReadReceiptAuto} {
+		if strings.EqualFold(arg, mode.String()) {
+			return mode, true
+		}
+	}
+
+	return vault.ReadReceiptIgnore, false
+}
+
+func (f *frontendCLI) printAutoconfig(c *ishell.Context) {
+	f.printClientConfig(c, clientconfig.FormatAutoconfig)
+}
+
+func (f *frontendCLI) printPrefsJS(c *ishell.Context) {
+	f.printClientConfig(c, clientconfig.FormatPrefsJS)
+}
+
+func (f *frontendCLI) printClientConfig(c *ishell.Context, format clientconfig.Format) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	config, err := f.bridge.GetClientConfig(user.UserID, "", format)
+	if err != nil {
+		f.printAndLogError("Cannot generate client configuration: ", err)
+		return
+	}
+
+	f.Println(string(config))
+}
+
+func (f *frontendCLI) serveAutoconfig(_ *ishell.Context) {
+	url, err := f.bridge.StartAutoconfigServer()
+	if err != nil {
+		f.printAndLogError("Cannot start autoconfig endpoint: ", err)
+		return
+	}
+
+	f.Println("Autoconfig endpoint:", bold(url+clientconfig.AutoconfigPaths[0]+"?emailaddress=<address>"))
+	f.Println("It runs until bridge is closed.")
+}
+
 func (f *frontendCLI) configureAppleMail(c *ishell.Context) {
 	user := f.askUserByIndexOrName(c)
 	if user.UserID == "" {
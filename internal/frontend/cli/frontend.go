@@ -101,11 +101,65 @@ func New(
 		Func:      fe.changeMode,
 		Completer: fe.completeUsernames,
 	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name:      "all-mail",
+		Help:      "hide or show the All Mail folder for account. Use index or account name as parameter.",
+		Func:      fe.changeUserAllMail,
+		Completer: fe.completeUsernames,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name:      "repair-mime",
+		Help:      "enable or disable repairing of malformed messages for account. Use index or account name as parameter.",
+		Func:      fe.changeUserRepairMIME,
+		Completer: fe.completeUsernames,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name:      "read-receipts",
+		Help:      "choose how read receipt requests of received messages are handled: ignore, prompt or auto. Use index or account name and the mode as parameters.",
+		Func:      fe.changeUserReadReceipts,
+		Completer: fe.completeUsernames,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name:      "sync-window",
+		Help:      "only sync the messages of the last given number of days, or all of them. Use index or account name and the number of days or 'all' as parameters.",
+		Func:      fe.changeUserSyncWindow,
+		Completer: fe.completeUsernames,
+	})
 	changeCmd.AddCmd(&ishell.Cmd{
 		Name: "change-location",
 		Help: "change the location of the encrypted message cache",
 		Func: fe.setGluonLocation,
 	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "cache-size",
+		Help: "limit the disk space used by cached message bodies; least recently used ones are fetched again when needed",
+		Func: fe.setCacheSizeLimit,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "keep-recent",
+		Help: "always keep the messages of the last days fully downloaded, whatever the cache size limit",
+		Func: fe.setKeepRecentDays,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "memory-limit",
+		Help: "change the memory bridge may use to sync and build messages; work beyond it is queued",
+		Func: fe.setMemoryLimit,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "auto-lock",
+		Help: "change the inactivity in minutes after which bridge locks itself, 0 to never lock",
+		Func: fe.changeAutoLockTimeout,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "unlock-passphrase",
+		Help: "change the passphrase which unlocks bridge, required to lock it",
+		Func: fe.changeUnlockPassphrase,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "bind-address",
+		Help: "change the address the IMAP and SMTP servers listen on (e.g. 0.0.0.0 to allow other machines)",
+		Func: fe.changeBindAddress,
+	})
 	changeCmd.AddCmd(&ishell.Cmd{
 		Name: "imap-port",
 		Help: "change port number of IMAP server.",
@@ -116,6 +170,26 @@ func New(
 		Help: "change port number of SMTP server.",
 		Func: fe.changeSMTPPort,
 	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "imap-ssl-port",
+		Help: "change port number of the additional implicit TLS IMAP listener, 0 to disable it.",
+		Func: fe.changeIMAPImplicitTLSPort,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "smtp-ssl-port",
+		Help: "change port number of the additional implicit TLS SMTP listener, 0 to disable it.",
+		Func: fe.changeSMTPImplicitTLSPort,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "imap-ssl-required",
+		Help: "toggle rejecting IMAP authentication over unencrypted connections.",
+		Func: fe.changeIMAPSSLRequired,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "smtp-ssl-required",
+		Help: "toggle rejecting SMTP authentication over unencrypted connections.",
+		Func: fe.changeSMTPSSLRequired,
+	})
 	changeCmd.AddCmd(&ishell.Cmd{
 		Name:    "imap-security",
 		Help:    "change IMAP SSL settings servers.(alias: ssl-imap, starttls-imap)",
@@ -128,6 +202,21 @@ func New(
 		Aliases: []string{"ssl-smtp", "starttls-smtp"},
 		Func:    fe.changeSMTPSecurity,
 	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "socks-proxy",
+		Help: "change the SOCKS5 proxy used to connect to Proton servers. Use the proxy URL as parameter, or none to connect directly.",
+		Func: fe.changeSOCKSProxy,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "pac",
+		Help: "change the proxy auto-config (PAC) script used to select the proxy to connect to Proton servers. Use the PAC URL or file path as parameter, or none to disable it.",
+		Func: fe.changePACSource,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "api-host",
+		Help: "change the API address, e.g. a mirror or a test server, used instead of the production one (restart required). Use the address as parameter, or none to restore it.",
+		Func: fe.changeAPIHost,
+	})
 	fe.AddCmd(changeCmd)
 
 	// DoH commands.
@@ -145,8 +234,82 @@ func New(
 		Help: "disallow bridge to securely connect to proton via a third party when it is being blocked",
 		Func: fe.disallowProxy,
 	})
+	dohCmd.AddCmd(&ishell.Cmd{
+		Name: "providers",
+		Help: "list the DoH providers queried, in order, to find a third party when bridge is being blocked",
+		Func: fe.listDoHProviders,
+	})
+	dohCmd.AddCmd(&ishell.Cmd{
+		Name: "add-provider",
+		Help: "query the given DoH provider before the default ones, or re-enable a default one. Use the provider URL as parameter.",
+		Func: fe.addDoHProvider,
+	})
+	dohCmd.AddCmd(&ishell.Cmd{
+		Name: "remove-provider",
+		Help: "stop querying the given DoH provider. Use the provider URL as parameter.",
+		Func: fe.removeDoHProvider,
+	})
+	dohCmd.AddCmd(&ishell.Cmd{
+		Name: "reset-providers",
+		Help: "query only the default DoH providers",
+		Func: fe.resetDoHProviders,
+	})
 	fe.AddCmd(dohCmd)
 
+	// App password commands.
+	appPassCmd := &ishell.Cmd{
+		Name:    "app-passwords",
+		Help:    "manage the named passwords clients can use instead of the bridge password (alias: ap)",
+		Aliases: []string{"ap"},
+	}
+	appPassCmd.AddCmd(&ishell.Cmd{
+		Name:      "list",
+		Help:      "list the app passwords of an account. Use index or account name as parameter.",
+		Func:      fe.listAppPasswords,
+		Completer: fe.completeUsernames,
+	})
+	appPassCmd.AddCmd(&ishell.Cmd{
+		Name:      "create",
+		Help:      "generate a new app password for an account. Use index or account name followed by the client name as parameters.",
+		Func:      fe.createAppPassword,
+		Completer: fe.completeUsernames,
+	})
+	appPassCmd.AddCmd(&ishell.Cmd{
+		Name:      "revoke",
+		Help:      "revoke an app password of an account. Use index or account name followed by the client name as parameters.",
+		Func:      fe.revokeAppPassword,
+		Completer: fe.completeUsernames,
+	})
+	appPassCmd.AddCmd(&ishell.Cmd{
+		Name:      "token",
+		Help:      "mint an auth token for clients that only support XOAUTH2 or OAUTHBEARER. Use index or account name and optionally the validity in days as parameters.",
+		Func:      fe.createAuthToken,
+		Completer: fe.completeUsernames,
+	})
+	fe.AddCmd(appPassCmd)
+
+	// Webhook commands.
+	webhookCmd := &ishell.Cmd{
+		Name: "webhooks",
+		Help: "manage the URLs notified of bridge events",
+	}
+	webhookCmd.AddCmd(&ishell.Cmd{
+		Name: "list",
+		Help: "list the registered webhooks and the events they receive",
+		Func: fe.listWebhooks,
+	})
+	webhookCmd.AddCmd(&ishell.Cmd{
+		Name: "add",
+		Help: "notify a URL of the given events. Use the URL followed by the event names as parameters.",
+		Func: fe.addWebhook,
+	})
+	webhookCmd.AddCmd(&ishell.Cmd{
+		Name: "remove",
+		Help: "stop notifying a URL of bridge events. Use the URL as parameter.",
+		Func: fe.removeWebhook,
+	})
+	fe.AddCmd(webhookCmd)
+
 	//goland:noinspection GoBoolExpressions
 	if runtime.GOOS == "darwin" {
 		// Apple Mail commands.
@@ -158,6 +321,30 @@ func New(
 		fe.AddCmd(configureCmd)
 	}
 
+	// Client configuration commands.
+	clientConfigCmd := &ishell.Cmd{
+		Name: "client-config",
+		Help: "generate the settings mail clients need to connect to bridge",
+	}
+	clientConfigCmd.AddCmd(&ishell.Cmd{
+		Name:      "thunderbird",
+		Help:      "print the Thunderbird autoconfig XML of an account. Use index or account name as parameter.",
+		Func:      fe.printAutoconfig,
+		Completer: fe.completeUsernames,
+	})
+	clientConfigCmd.AddCmd(&ishell.Cmd{
+		Name:      "prefs-js",
+		Help:      "print a Thunderbird prefs.js snippet creating an account. Use index or account name as parameter.",
+		Func:      fe.printPrefsJS,
+		Completer: fe.completeUsernames,
+	})
+	clientConfigCmd.AddCmd(&ishell.Cmd{
+		Name: "serve",
+		Help: "start a local autoconfig endpoint clients can discover the settings from",
+		Func: fe.serveAutoconfig,
+	})
+	fe.AddCmd(clientConfigCmd)
+
 	// TLS commands.
 	certCmd := &ishell.Cmd{
 		Name: "cert",
@@ -192,6 +379,16 @@ func New(
 		Help: "Import a TLS certificate to be used by Bridge",
 		Func: fe.importTLSCerts,
 	})
+	certCmd.AddCmd(&ishell.Cmd{
+		Name: "import-dir",
+		Help: "Import a TLS certificate from a directory, such as one managed by certbot",
+		Func: fe.importTLSCertDir,
+	})
+	certCmd.AddCmd(&ishell.Cmd{
+		Name: "reset",
+		Help: "Stop using the imported TLS certificate and return to the self-signed one",
+		Func: fe.resetTLSCert,
+	})
 	fe.AddCmd(certCmd)
 
 	// All mail visibility commands.
@@ -211,6 +408,23 @@ func New(
 	})
 	fe.AddCmd(allMailCmd)
 
+	// Local service transport commands.
+	ipcCmd := &ishell.Cmd{
+		Name: "ipc-transport",
+		Help: "choose how the GUI and other bridge instances connect to this one (takes effect after a restart)",
+	}
+	ipcCmd.AddCmd(&ishell.Cmd{
+		Name: "socket",
+		Help: "use Unix domain sockets only the current user can access (default)",
+		Func: fe.useIPCSocket,
+	})
+	ipcCmd.AddCmd(&ishell.Cmd{
+		Name: "tcp",
+		Help: "use localhost TCP ports",
+		Func: fe.useIPCOverTCP,
+	})
+	fe.AddCmd(ipcCmd)
+
 	// Updates commands.
 	updatesCmd := &ishell.Cmd{
 		Name: "updates",
@@ -236,6 +450,16 @@ func New(
 		Help: "require bridge to be manually updated",
 		Func: fe.disableAutoUpdates,
 	})
+	updatesCmd.AddCmd(&ishell.Cmd{
+		Name: "install-local",
+		Help: "install an update from a local directory holding the signed version file and package. Use the directory as parameter.",
+		Func: fe.installLocalUpdate,
+	})
+	updatesCmd.AddCmd(&ishell.Cmd{
+		Name: "rollback",
+		Help: "go back to the previous version of Bridge kept on disk",
+		Func: fe.rollbackUpdate,
+	})
 	updatesChannelCmd := &ishell.Cmd{
 		Name: "channel",
 		Help: "switch updates channel",
@@ -260,6 +484,46 @@ func New(
 		Aliases: []string{"log", "logs"},
 		Func:    fe.printLogDir,
 	})
+	fe.AddCmd(&ishell.Cmd{
+		Name: "log-level",
+		Help: "print or change the log level. Use a level, or a subsystem (imap, smtp, sync, api, grpc) and a level or default, as parameters.",
+		Func: fe.changeLogLevel,
+	})
+	traceCmd := &ishell.Cmd{
+		Name: "log-trace",
+		Help: "log the wire-level activity of a subsystem (imap, smtp or api) for a limited time, for support sessions.",
+	}
+	traceCmd.AddCmd(&ishell.Cmd{
+		Name: "list",
+		Help: "print the running traces.",
+		Func: fe.listTraces,
+	})
+	traceCmd.AddCmd(&ishell.Cmd{
+		Name: "start",
+		Help: "start tracing a subsystem. Use a subsystem and optionally a duration in minutes as parameters.",
+		Func: fe.startTrace,
+	})
+	traceCmd.AddCmd(&ishell.Cmd{
+		Name: "stop",
+		Help: "stop tracing a subsystem. Use a subsystem as parameter.",
+		Func: fe.stopTrace,
+	})
+	fe.AddCmd(traceCmd)
+	auditCmd := &ishell.Cmd{
+		Name: "audit-log",
+		Help: "record which IMAP clients touched which mailboxes, without message content, in a log rotated with the others. Recent sessions are shown by status.",
+	}
+	auditCmd.AddCmd(&ishell.Cmd{
+		Name: "enable",
+		Help: "record the IMAP sessions in the audit log",
+		Func: fe.enableSessionAuditLog,
+	})
+	auditCmd.AddCmd(&ishell.Cmd{
+		Name: "disable",
+		Help: "stop recording the IMAP sessions (default)",
+		Func: fe.disableSessionAuditLog,
+	})
+	fe.AddCmd(auditCmd)
 	fe.AddCmd(&ishell.Cmd{
 		Name:    "manual",
 		Help:    "print URL with instructions. (alias: man)",
@@ -272,6 +536,28 @@ func New(
 		Func: fe.printCredits,
 	})
 
+	// Lock commands.
+	fe.AddCmd(&ishell.Cmd{
+		Name: "lock",
+		Help: "stop serving the accounts and drop their keys until bridge is unlocked. An unlock passphrase must be set.",
+		Func: fe.lockBridge,
+	})
+	fe.AddCmd(&ishell.Cmd{
+		Name: "unlock",
+		Help: "serve the accounts again after bridge was locked. Enter the unlock passphrase; restarting bridge also unlocks it.",
+		Func: fe.unlockBridge,
+	})
+	fe.AddCmd(&ishell.Cmd{
+		Name: "rotate-keys",
+		Help: "replace the vault key and the keys of the encrypted message cache, re-encrypting them in the background.",
+		Func: fe.rotateEncryptionKeys,
+	})
+	fe.AddCmd(&ishell.Cmd{
+		Name: "migrate-vault",
+		Help: "keep the vault in a file rather than in the keychain, leaving the degraded mode entered when the keychain is unavailable.",
+		Func: fe.migrateToFileVault,
+	})
+
 	// Account commands.
 	fe.AddCmd(&ishell.Cmd{
 		Name:    "list",
@@ -307,6 +593,24 @@ func New(
 		Aliases:   []string{"del", "rm", "remove"},
 		Completer: fe.completeUsernames,
 	})
+	fe.AddCmd(&ishell.Cmd{
+		Name:      "sync-first",
+		Help:      "sync the account before the other ones waiting to sync. Use index or account name as parameter.",
+		Func:      fe.noAccountWrapper(fe.prioritizeAccountSync),
+		Completer: fe.completeUsernames,
+	})
+	fe.AddCmd(&ishell.Cmd{
+		Name:      "send-receipt",
+		Help:      "send the read receipt requested by a message. Use index or account name and the message ID as parameters.",
+		Func:      fe.noAccountWrapper(fe.sendReadReceipt),
+		Completer: fe.completeUsernames,
+	})
+	fe.AddCmd(&ishell.Cmd{
+		Name:      "history",
+		Help:      "show what recently happened to the messages and labels of the account. Use index or account name and optionally a message ID, subject or sender to search for as parameters.",
+		Func:      fe.noAccountWrapper(fe.showAccountHistory),
+		Completer: fe.completeUsernames,
+	})
 	fe.AddCmd(&ishell.Cmd{
 		Name:    "repair",
 		Help:    "reload all accounts and cached data, re-download emails. Email clients remain connected. Logged out users will be repaired on next login. (aliases: rep)",
@@ -330,6 +634,23 @@ func New(
 	})
 	fe.AddCmd(badEventCmd)
 
+	// Re-authentication commands.
+	reauthCmd := &ishell.Cmd{
+		Name: "reauth",
+		Help: "choose whether accounts whose session was revoked are asked to sign in again, resuming from their local data",
+	}
+	reauthCmd.AddCmd(&ishell.Cmd{
+		Name: "enable",
+		Help: "ask to sign in again when the session of an account is revoked",
+		Func: fe.enableReauthOnDeauth,
+	})
+	reauthCmd.AddCmd(&ishell.Cmd{
+		Name: "disable",
+		Help: "only sign out accounts whose session was revoked (default)",
+		Func: fe.disableReauthOnDeauth,
+	})
+	fe.AddCmd(reauthCmd)
+
 	// Telemetry commands
 	telemetryCmd := &ishell.Cmd{
 		Name: "telemetry",
@@ -347,6 +668,42 @@ func New(
 	})
 	fe.AddCmd(telemetryCmd)
 
+	fe.AddCmd(&ishell.Cmd{
+		Name: "status",
+		Help: "show the connection to Proton servers and whether requests are paused because of rate limiting",
+		Func: fe.showStatus,
+	})
+
+	fe.AddCmd(&ishell.Cmd{
+		Name: "diagnostics",
+		Help: "save a sanitized diagnostics bundle to attach to a support ticket",
+		Func: fe.collectDiagnostics,
+	})
+
+	fe.AddCmd(&ishell.Cmd{
+		Name:    "check-configuration",
+		Help:    "check why email clients can't connect: ports, TLS certificate and connection to Proton servers. (alias: doctor)",
+		Aliases: []string{"doctor"},
+		Func:    fe.checkConfiguration,
+	})
+
+	// Reporting commands
+	reportingCmd := &ishell.Cmd{
+		Name: "reporting",
+		Help: "choose whether anything (crash reports, usage diagnostics, heartbeats) is sent for diagnostic purposes",
+	}
+	reportingCmd.AddCmd(&ishell.Cmd{
+		Name: "enable",
+		Help: "Crash reports, usage diagnostics and heartbeats will be allowed",
+		Func: fe.enableReporting,
+	})
+	reportingCmd.AddCmd(&ishell.Cmd{
+		Name: "disable",
+		Help: "Crash reports, usage diagnostics and heartbeats will never be sent",
+		Func: fe.disableReporting,
+	})
+	fe.AddCmd(reportingCmd)
+
 	dbgCmd := &ishell.Cmd{
 		Name: "debug",
 		Help: "Debug diagnostics ",
@@ -392,9 +749,36 @@ func (f *frontendCLI) watchEvents(eventCh <-chan events.Event) { // nolint:gocyc
 		case events.ConnStatusDown:
 			f.notifyInternetOff()
 
+		case events.APIRateLimited:
+			f.Printf("Proton servers are rate-limiting Bridge; requests are paused for %v.\n", event.RetryAfter)
+
 		case events.IMAPServerError:
 			f.Println("IMAP server error:", event.Error)
 
+		case events.MessageTooLarge:
+			f.Printf("A message could not be sent: its attachments (%v bytes) exceed the limit of %v bytes.\n", event.Size, event.Limit)
+
+		case events.BridgeLocked:
+			f.Println("Bridge is locked. Use the unlock command to serve your accounts again.")
+
+		case events.BridgeUnlocked:
+			f.Println("Bridge is unlocked.")
+
+		case events.CredentialStoreUnavailable:
+			f.Println("The keychain is unavailable:", event.Error)
+			f.Println("Bridge runs in degraded mode: accounts stored with the keychain are read-only and their mail is not available, new logins are refused.")
+			f.Println("Use the migrate-vault command to keep the vault in a file instead.")
+
+		case events.CredentialStoreAvailable:
+			f.Println("The keychain is available again. The accounts stored with it are being loaded.")
+
+		case events.KeyRotationFinished:
+			if event.Error != nil {
+				f.Println("Encryption keys could not be rotated:", event.Error)
+			} else {
+				f.Println("Encryption keys were rotated.")
+			}
+
 		case events.SMTPServerError:
 			f.Println("SMTP server error:", event.Error)
 
@@ -406,6 +790,34 @@ func (f *frontendCLI) watchEvents(eventCh <-chan events.Event) { // nolint:gocyc
 
 			f.notifyLogout(user.Username)
 
+		case events.UserReauthRequired:
+			f.Printf(
+				"The session of account %s was revoked, e.g. after a password change. Use `login %s` to sign in again: its local data was kept and it will resume where it stopped.\n",
+				bold(event.Username), event.Username,
+			)
+
+		case events.UserReadReceiptRequested:
+			user, err := f.bridge.GetUserInfo(event.UserID)
+			if err != nil {
+				return
+			}
+
+			f.Printf(
+				"The message \"%s\" read by account %s requests a read receipt to %s. Use `send-receipt %s %s` to send it.\n",
+				event.Subject, bold(user.Username), event.Recipient, user.Username, event.MessageID,
+			)
+
+		case events.UserStorageAlmostFull:
+			user, err := f.bridge.GetUserInfo(event.UserID)
+			if err != nil {
+				return
+			}
+
+			f.Printf(
+				"The storage of account %s is almost full: %v used out of %v. New messages may no longer be received, appended or sent.\n",
+				user.Username, formatStorage(event.UsedSpace), formatStorage(event.MaxSpace),
+			)
+
 		case events.UserBadEvent:
 			user, err := f.bridge.GetUserInfo(event.UserID)
 			if err != nil {
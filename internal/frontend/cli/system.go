@@ -21,15 +21,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
 	"github.com/abiosoft/ishell"
+	"github.com/sirupsen/logrus"
 )
 
 func (f *frontendCLI) printLogDir(_ *ishell.Context) {
@@ -40,6 +45,109 @@ func (f *frontendCLI) printLogDir(_ *ishell.Context) {
 	}
 }
 
+func (f *frontendCLI) changeLogLevel(c *ishell.Context) {
+	switch len(c.Args) {
+	case 0:
+		f.Println("Default log level:", logging.GetLevel())
+
+		levels := logging.GetSubsystemLevels()
+
+		for _, subsystem := range logging.Subsystems {
+			f.Printf("%6s: %v\n", subsystem, levels[subsystem])
+		}
+
+	case 1:
+		level, err := logrus.ParseLevel(c.Args[0])
+		if err != nil {
+			f.printAndLogError(err)
+			return
+		}
+
+		logging.SetLevel(level)
+
+	case 2:
+		subsystem := logging.Subsystem(c.Args[0])
+
+		if c.Args[1] == "default" {
+			if err := logging.ResetSubsystemLevel(subsystem); err != nil {
+				f.printAndLogError(err)
+			}
+
+			return
+		}
+
+		level, err := logrus.ParseLevel(c.Args[1])
+		if err != nil {
+			f.printAndLogError(err)
+			return
+		}
+
+		if err := logging.SetSubsystemLevel(subsystem, level); err != nil {
+			f.printAndLogError(err)
+		}
+
+	default:
+		f.Println("Please provide a level, or a subsystem and a level.")
+	}
+}
+
+func (f *frontendCLI) listTraces(_ *ishell.Context) {
+	traces := logging.GetTraces()
+	if len(traces) == 0 {
+		f.Println("No subsystem is being traced.")
+		return
+	}
+
+	for _, subsystem := range logging.TraceableSubsystems {
+		if expiresAt, ok := traces[subsystem]; ok {
+			f.Printf("%6s: until %v\n", subsystem, expiresAt.Format(time.Kitchen))
+		}
+	}
+}
+
+func (f *frontendCLI) startTrace(c *ishell.Context) {
+	if len(c.Args) == 0 || len(c.Args) > 2 {
+		f.Println("Please provide a subsystem (imap, smtp or api), and optionally a duration in minutes.")
+		return
+	}
+
+	var duration time.Duration
+
+	if len(c.Args) == 2 {
+		minutes, err := strconv.Atoi(c.Args[1])
+		if err != nil {
+			f.printAndLogError("Invalid duration:", c.Args[1])
+			return
+		}
+
+		duration = time.Duration(minutes) * time.Minute
+	}
+
+	subsystem := logging.Subsystem(c.Args[0])
+
+	if subsystem != logging.SubsystemAPI {
+		f.Println("The logs will contain decrypted message data while the trace runs.")
+	}
+
+	if err := logging.StartTrace(subsystem, duration); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.listTraces(c)
+}
+
+func (f *frontendCLI) stopTrace(c *ishell.Context) {
+	if len(c.Args) != 1 {
+		f.Println("Please provide a subsystem (imap, smtp or api).")
+		return
+	}
+
+	if err := logging.StopTrace(logging.Subsystem(c.Args[0])); err != nil {
+		f.printAndLogError(err)
+	}
+}
+
 func (f *frontendCLI) printManual(_ *ishell.Context) {
 	f.Println("More instructions about the Bridge can be found at\n\n  https://proton.me/mail/bridge")
 }
@@ -61,184 +169,780 @@ func (f *frontendCLI) changeIMAPSecurity(_ *ishell.Context) {
 
 	msg := fmt.Sprintf("Are you sure you want to change IMAP setting to %q", newSecurity)
 
-	if f.yesNoQuestion(msg) {
-		if err := f.bridge.SetIMAPSSL(context.Background(), !f.bridge.GetIMAPSSL()); err != nil {
+	if f.yesNoQuestion(msg) {
+		if err := f.bridge.SetIMAPSSL(context.Background(), !f.bridge.GetIMAPSSL()); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+	}
+}
+
+func (f *frontendCLI) changeSMTPSecurity(_ *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	newSecurity := "SSL"
+	if f.bridge.GetSMTPSSL() {
+		newSecurity = "STARTTLS"
+	}
+
+	msg := fmt.Sprintf("Are you sure you want to change SMTP setting to %q", newSecurity)
+
+	if f.yesNoQuestion(msg) {
+		if err := f.bridge.SetSMTPSSL(context.Background(), !f.bridge.GetSMTPSSL()); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+	}
+}
+
+func (f *frontendCLI) changeIMAPSSLRequired(_ *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	msg := "Are you sure you want to require SSL for IMAP (clients using STARTTLS must switch to SSL)"
+	if f.bridge.GetIMAPSSLRequired() {
+		msg = "Are you sure you want to allow IMAP authentication without SSL"
+	}
+
+	if f.yesNoQuestion(msg) {
+		if err := f.bridge.SetIMAPSSLRequired(context.Background(), !f.bridge.GetIMAPSSLRequired()); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+	}
+}
+
+func (f *frontendCLI) changeSMTPSSLRequired(_ *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	msg := "Are you sure you want to reject SMTP authentication over unencrypted connections"
+	if f.bridge.GetSMTPSSLRequired() {
+		msg = "Are you sure you want to allow SMTP authentication without SSL"
+	}
+
+	if f.yesNoQuestion(msg) {
+		if err := f.bridge.SetSMTPSSLRequired(context.Background(), !f.bridge.GetSMTPSSLRequired()); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+	}
+}
+
+func (f *frontendCLI) changeIMAPPort(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	newIMAPPort := f.readStringInAttempts(fmt.Sprintf("Set IMAP port (current %v)", f.bridge.GetIMAPPort()), c.ReadLine, f.isPortFree)
+	if newIMAPPort == "" {
+		f.printAndLogError(errors.New("failed to get new port"))
+		return
+	}
+
+	newIMAPPortInt, err := strconv.Atoi(newIMAPPort)
+	if err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	if err := f.bridge.SetIMAPPort(context.Background(), newIMAPPortInt); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+}
+
+func (f *frontendCLI) changeSMTPPort(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	newSMTPPort := f.readStringInAttempts(fmt.Sprintf("Set SMTP port (current %v)", f.bridge.GetSMTPPort()), c.ReadLine, f.isPortFree)
+	if newSMTPPort == "" {
+		f.printAndLogError(errors.New("failed to get new port"))
+		return
+	}
+
+	newSMTPPortInt, err := strconv.Atoi(newSMTPPort)
+	if err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	if err := f.bridge.SetSMTPPort(context.Background(), newSMTPPortInt); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+}
+
+func (f *frontendCLI) changeIMAPImplicitTLSPort(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	newPort := f.readStringInAttempts(fmt.Sprintf("Set implicit TLS IMAP port, 0 to disable (current %v)", f.bridge.GetIMAPImplicitTLSPort()), c.ReadLine, f.isPortFree)
+	if newPort == "" {
+		f.printAndLogError(errors.New("failed to get new port"))
+		return
+	}
+
+	newPortInt, err := strconv.Atoi(newPort)
+	if err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	if err := f.bridge.SetIMAPImplicitTLSPort(context.Background(), newPortInt); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+}
+
+func (f *frontendCLI) changeSMTPImplicitTLSPort(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	newPort := f.readStringInAttempts(fmt.Sprintf("Set implicit TLS SMTP port, 0 to disable (current %v)", f.bridge.GetSMTPImplicitTLSPort()), c.ReadLine, f.isPortFree)
+	if newPort == "" {
+		f.printAndLogError(errors.New("failed to get new port"))
+		return
+	}
+
+	newPortInt, err := strconv.Atoi(newPort)
+	if err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	if err := f.bridge.SetSMTPImplicitTLSPort(context.Background(), newPortInt); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+}
+
+func (f *frontendCLI) listDoHProviders(_ *ishell.Context) {
+	for idx, provider := range f.bridge.GetDoHProviders() {
+		f.Printf("%2d: %s\n", idx+1, provider)
+	}
+}
+
+func (f *frontendCLI) addDoHProvider(c *ishell.Context) {
+	if len(c.Args) != 1 {
+		f.Println("Please provide the DoH provider URL (e.g. https://doh.example/dns-query).")
+		return
+	}
+
+	if err := f.bridge.AddDoHProvider(c.Args[0]); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+}
+
+func (f *frontendCLI) removeDoHProvider(c *ishell.Context) {
+	if len(c.Args) != 1 {
+		f.Println("Please provide the DoH provider URL.")
+		return
+	}
+
+	if err := f.bridge.RemoveDoHProvider(c.Args[0]); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+}
+
+func (f *frontendCLI) resetDoHProviders(_ *ishell.Context) {
+	if err := f.bridge.ResetDoHProviders(); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+}
+
+func (f *frontendCLI) listWebhooks(_ *ishell.Context) {
+	webhooks := f.bridge.GetWebhooks()
+	if len(webhooks) == 0 {
+		f.Println("No webhooks registered.")
+	}
+
+	for idx, webhook := range webhooks {
+		signed := ""
+		if webhook.Secret != "" {
+			signed = " (signed)"
+		}
+
+		f.Printf("%2d: %s%s: %s\n", idx+1, webhook.URL, signed, strings.Join(webhook.Events, ", "))
+	}
+
+	f.Println("Available events:", strings.Join(bridge.WebhookEvents, ", "))
+}
+
+func (f *frontendCLI) addWebhook(c *ishell.Context) {
+	if len(c.Args) < 2 {
+		f.Println("Please provide the webhook URL followed by the events to send, among:", strings.Join(bridge.WebhookEvents, ", "))
+		return
+	}
+
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	f.Print("Secret used to sign the requests, leave empty to not sign them: ")
+	secret := c.ReadPassword()
+
+	if err := f.bridge.AddWebhook(c.Args[0], secret, c.Args[1:]...); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+}
+
+func (f *frontendCLI) removeWebhook(c *ishell.Context) {
+	if len(c.Args) != 1 {
+		f.Println("Please provide the webhook URL.")
+		return
+	}
+
+	if err := f.bridge.RemoveWebhook(c.Args[0]); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+}
+
+func (f *frontendCLI) changeSOCKSProxy(c *ishell.Context) {
+	if len(c.Args) != 1 {
+		if current := f.bridge.GetSOCKSProxy(); current != "" {
+			f.Println("Bridge is currently using the SOCKS5 proxy", current)
+		} else {
+			f.Println("Bridge is currently NOT using a SOCKS5 proxy.")
+		}
+
+		f.Println("Please provide the proxy URL (e.g. socks5://127.0.0.1:9050) or none.")
+
+		return
+	}
+
+	proxyURL := c.Args[0]
+	if proxyURL == "none" {
+		proxyURL = ""
+	}
+
+	if err := f.bridge.SetSOCKSProxy(proxyURL); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+}
+
+func (f *frontendCLI) changePACSource(c *ishell.Context) {
+	if len(c.Args) != 1 {
+		if current := f.bridge.GetPACSource(); current != "" {
+			f.Println("Bridge is currently using the PAC script", current)
+		} else {
+			f.Println("Bridge is currently NOT using a PAC script.")
+		}
+
+		f.Println("Please provide the PAC URL (e.g. http://wpad.example/proxy.pac), file path or none.")
+
+		return
+	}
+
+	source := c.Args[0]
+	if source == "none" {
+		source = ""
+	}
+
+	if err := f.bridge.SetPACSource(source); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+}
+
+func (f *frontendCLI) changeAPIHost(c *ishell.Context) {
+	if len(c.Args) != 1 {
+		if current := f.bridge.GetAPIHost(); current != "" {
+			f.Println("Bridge is currently set to use the API at", current)
+		} else {
+			f.Println("Bridge is currently set to use the production API.")
+		}
+
+		f.Println("Please provide the API address (e.g. https://mail-api.mirror.example) or none.")
+
+		return
+	}
+
+	host := c.Args[0]
+	if host == "none" {
+		host = ""
+	}
+
+	if err := f.bridge.SetAPIHost(host); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("The API address will be used once Bridge is restarted.")
+}
+
+func (f *frontendCLI) allowProxy(_ *ishell.Context) {
+	if f.bridge.GetProxyAllowed() {
+		f.Println("Bridge is already set to use alternative routing to connect to Proton if it is being blocked.")
+		return
+	}
+
+	f.Println("Bridge is currently set to NOT use alternative routing to connect to Proton if it is being blocked.")
+
+	if f.yesNoQuestion("Are you sure you want to allow bridge to do this") {
+		if err := f.bridge.SetProxyAllowed(true); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+	}
+}
+
+func (f *frontendCLI) disallowProxy(_ *ishell.Context) {
+	if !f.bridge.GetProxyAllowed() {
+		f.Println("Bridge is already set to NOT use alternative routing to connect to Proton if it is being blocked.")
+		return
+	}
+
+	f.Println("Bridge is currently set to use alternative routing to connect to Proton if it is being blocked.")
+
+	if f.yesNoQuestion("Are you sure you want to stop bridge from doing this") {
+		if err := f.bridge.SetProxyAllowed(false); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+	}
+}
+
+func (f *frontendCLI) hideAllMail(_ *ishell.Context) {
+	if !f.bridge.GetShowAllMail() {
+		f.Println("All Mail folder is not listed in your local client.")
+		return
+	}
+
+	f.Println("All Mail folder is listed in your client right now.")
+
+	if f.yesNoQuestion("Do you want to hide All Mail folder") {
+		if err := f.bridge.SetShowAllMail(false); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+	}
+}
+
+func (f *frontendCLI) showAllMail(_ *ishell.Context) {
+	if f.bridge.GetShowAllMail() {
+		f.Println("All Mail folder is listed in your local client.")
+		return
+	}
+
+	f.Println("All Mail folder is not listed in your client right now.")
+
+	if f.yesNoQuestion("Do you want to show All Mail folder") {
+		if err := f.bridge.SetShowAllMail(true); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+	}
+}
+
+func (f *frontendCLI) useIPCSocket(_ *ishell.Context) {
+	if !f.bridge.GetIPCOverTCP() {
+		f.Println("Local services already use sockets.")
+		return
+	}
+
+	if err := f.bridge.SetIPCOverTCP(false); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Local services will use sockets after bridge is restarted.")
+}
+
+func (f *frontendCLI) useIPCOverTCP(_ *ishell.Context) {
+	if f.bridge.GetIPCOverTCP() {
+		f.Println("Local services already use TCP ports.")
+		return
+	}
+
+	f.Println("Any local process can connect to TCP ports, including processes of other users.")
+
+	if f.yesNoQuestion("Do you want local services to use TCP ports") {
+		if err := f.bridge.SetIPCOverTCP(true); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+
+		f.Println("Local services will use TCP ports after bridge is restarted.")
+	}
+}
+
+func (f *frontendCLI) enableSessionAuditLog(_ *ishell.Context) {
+	if f.bridge.GetSessionAuditLog() {
+		f.Println("IMAP sessions are already recorded in the audit log.")
+		return
+	}
+
+	if err := f.bridge.SetSessionAuditLog(true); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("New IMAP sessions will be recorded in the audit log.")
+}
+
+func (f *frontendCLI) disableSessionAuditLog(_ *ishell.Context) {
+	if !f.bridge.GetSessionAuditLog() {
+		f.Println("IMAP sessions are already not recorded.")
+		return
+	}
+
+	if err := f.bridge.SetSessionAuditLog(false); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("IMAP sessions will not be recorded anymore.")
+}
+
+func (f *frontendCLI) enableReauthOnDeauth(_ *ishell.Context) {
+	if f.bridge.GetReauthOnDeauth() {
+		f.Println("Accounts whose session is revoked are already asked to sign in again.")
+		return
+	}
+
+	if err := f.bridge.SetReauthOnDeauth(true); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Accounts whose session is revoked will be asked to sign in again.")
+}
+
+func (f *frontendCLI) disableReauthOnDeauth(_ *ishell.Context) {
+	if !f.bridge.GetReauthOnDeauth() {
+		f.Println("Accounts whose session is revoked are already only signed out.")
+		return
+	}
+
+	if err := f.bridge.SetReauthOnDeauth(false); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Accounts whose session is revoked will only be signed out.")
+}
+
+func (f *frontendCLI) enableTelemetry(_ *ishell.Context) {
+	if !f.bridge.GetTelemetryDisabled() {
+		f.Println("Usage diagnostics collection is enabled.")
+		return
+	}
+
+	f.Println("Usage diagnostics collection is disabled right now.")
+
+	if f.yesNoQuestion("Do you want to enable usage diagnostics collection") {
+		if err := f.bridge.SetTelemetryDisabled(false); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+	}
+}
+
+func (f *frontendCLI) disableTelemetry(_ *ishell.Context) {
+	if f.bridge.GetTelemetryDisabled() {
+		f.Println("Usage diagnostics collection is disabled.")
+		return
+	}
+
+	f.Println("Usage diagnostics collection is enabled right now.")
+
+	if f.yesNoQuestion("Do you want to disable usage diagnostics collection") {
+		if err := f.bridge.SetTelemetryDisabled(true); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+	}
+}
+
+func (f *frontendCLI) enableReporting(_ *ishell.Context) {
+	if !f.bridge.GetReportingDisabled() {
+		f.Println("Reporting is enabled.")
+		return
+	}
+
+	f.Println("Reporting is disabled right now.")
+
+	if f.yesNoQuestion("Do you want to allow crash reports, usage diagnostics and heartbeats") {
+		if err := f.bridge.SetReportingDisabled(false); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+
+		if f.bridge.GetReportingDisabled() {
+			f.Println("Reporting stays disabled because it is forced off by the environment.")
+		}
+	}
+}
+
+func (f *frontendCLI) disableReporting(_ *ishell.Context) {
+	if f.bridge.GetReportingDisabled() {
+		f.Println("Reporting is disabled.")
+		return
+	}
+
+	f.Println("Reporting is enabled right now.")
+
+	if f.yesNoQuestion("Do you want to disable crash reports, usage diagnostics and heartbeats") {
+		if err := f.bridge.SetReportingDisabled(true); err != nil {
+			f.printAndLogError(err)
+			return
+		}
+	}
+}
+
+func (f *frontendCLI) setGluonLocation(c *ishell.Context) {
+	if gluonDir := f.bridge.GetGluonCacheDir(); gluonDir != "" {
+		f.Println("The current message cache location is:", gluonDir)
+	}
+
+	if location := f.readStringInAttempts("Enter a new location for the message cache", c.ReadLine, f.isCacheLocationUsable); location != "" {
+		if err := f.bridge.SetGluonDir(context.Background(), location); err != nil {
 			f.printAndLogError(err)
 			return
 		}
 	}
 }
 
-func (f *frontendCLI) changeSMTPSecurity(_ *ishell.Context) {
+func (f *frontendCLI) changeBindAddress(c *ishell.Context) {
 	f.ShowPrompt(false)
 	defer f.ShowPrompt(true)
 
-	newSecurity := "SSL"
-	if f.bridge.GetSMTPSSL() {
-		newSecurity = "STARTTLS"
+	address := f.readStringInAttempts(fmt.Sprintf("Set the address to listen on (current %v, %v for this machine only)", f.bridge.GetBindAddress(), constants.Host), c.ReadLine, f.isBindAddressValid)
+	if address == "" {
+		f.printAndLogError(errors.New("failed to get new address"))
+		return
 	}
 
-	msg := fmt.Sprintf("Are you sure you want to change SMTP setting to %q", newSecurity)
+	acknowledgeRisk := false
+	if !net.ParseIP(address).IsLoopback() {
+		f.Println("Listening on", address, "lets other machines connect to bridge; IMAP and SMTP will only accept TLS connections")
+		f.Println("and the bridge certificate will be regenerated, so your email clients will have to trust it again.")
 
-	if f.yesNoQuestion(msg) {
-		if err := f.bridge.SetSMTPSSL(context.Background(), !f.bridge.GetSMTPSSL()); err != nil {
-			f.printAndLogError(err)
+		if acknowledgeRisk = f.yesNoQuestion("I understand the risk, listen on " + address); !acknowledgeRisk {
 			return
 		}
 	}
+
+	if err := f.bridge.SetBindAddress(context.Background(), address, acknowledgeRisk); err != nil {
+		f.printAndLogError(err)
+		return
+	}
 }
 
-func (f *frontendCLI) changeIMAPPort(c *ishell.Context) {
+func (f *frontendCLI) setCacheSizeLimit(c *ishell.Context) {
 	f.ShowPrompt(false)
 	defer f.ShowPrompt(true)
 
-	newIMAPPort := f.readStringInAttempts(fmt.Sprintf("Set IMAP port (current %v)", f.bridge.GetIMAPPort()), c.ReadLine, f.isPortFree)
-	if newIMAPPort == "" {
-		f.printAndLogError(errors.New("failed to get new port"))
+	f.Printf("The message cache currently uses %v MB", f.bridge.GetCacheSize()/(1024*1024))
+	if maxSize := f.bridge.GetMaxCacheSize(); maxSize != 0 {
+		f.Printf(" out of %v MB.\n", maxSize/(1024*1024))
+	} else {
+		f.Println(", without limit.")
+	}
+
+	limit := f.readStringInAttempts("Enter the new limit in MB (0 for no limit)", c.ReadLine, f.isCacheSizeLimitValid)
+	if limit == "" {
+		f.printAndLogError(errors.New("failed to get new limit"))
 		return
 	}
 
-	newIMAPPortInt, err := strconv.Atoi(newIMAPPort)
+	limitMB, err := strconv.ParseUint(limit, 10, 64)
 	if err != nil {
 		f.printAndLogError(err)
 		return
 	}
 
-	if err := f.bridge.SetIMAPPort(context.Background(), newIMAPPortInt); err != nil {
+	if err := f.bridge.SetMaxCacheSize(limitMB * 1024 * 1024); err != nil {
 		f.printAndLogError(err)
 		return
 	}
 }
 
-func (f *frontendCLI) changeSMTPPort(c *ishell.Context) {
+func (f *frontendCLI) setKeepRecentDays(c *ishell.Context) {
 	f.ShowPrompt(false)
 	defer f.ShowPrompt(true)
 
-	newSMTPPort := f.readStringInAttempts(fmt.Sprintf("Set SMTP port (current %v)", f.bridge.GetSMTPPort()), c.ReadLine, f.isPortFree)
-	if newSMTPPort == "" {
-		f.printAndLogError(errors.New("failed to get new port"))
+	if days := f.bridge.GetKeepRecentDays(); days != 0 {
+		f.Printf("The bodies of the messages of the last %v days are always kept downloaded.\n", days)
+	} else {
+		f.Println("The bodies of recent messages are evicted from the cache like any others.")
+	}
+
+	days := f.readStringInAttempts("Enter the number of days (0 for none)", c.ReadLine, f.isKeepRecentDaysValid)
+	if days == "" {
+		f.printAndLogError(errors.New("failed to get number of days"))
 		return
 	}
 
-	newSMTPPortInt, err := strconv.Atoi(newSMTPPort)
+	numDays, err := strconv.ParseUint(days, 10, 32)
 	if err != nil {
 		f.printAndLogError(err)
 		return
 	}
 
-	if err := f.bridge.SetSMTPPort(context.Background(), newSMTPPortInt); err != nil {
+	if err := f.bridge.SetKeepRecentDays(uint32(numDays)); err != nil {
 		f.printAndLogError(err)
 		return
 	}
+
+	if numDays != 0 && f.bridge.GetMaxCacheSize() == 0 {
+		f.Println("The cache is not limited, so all bodies are kept. Use " + bold("change cache-size") + " to let older ones be evicted.")
+	}
 }
 
-func (f *frontendCLI) allowProxy(_ *ishell.Context) {
-	if f.bridge.GetProxyAllowed() {
-		f.Println("Bridge is already set to use alternative routing to connect to Proton if it is being blocked.")
+func (f *frontendCLI) setMemoryLimit(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	usage := f.bridge.GetMemoryUsage()
+
+	f.Printf("Bridge currently uses %v MB out of %v MB to sync and build messages.\n", usage.Used/(1024*1024), usage.Limit/(1024*1024))
+
+	limit := f.readStringInAttempts("Enter the new limit in MB (0 for the default)", c.ReadLine, f.isCacheSizeLimitValid)
+	if limit == "" {
+		f.printAndLogError(errors.New("failed to get new limit"))
 		return
 	}
 
-	f.Println("Bridge is currently set to NOT use alternative routing to connect to Proton if it is being blocked.")
+	limitMB, err := strconv.ParseUint(limit, 10, 64)
+	if err != nil {
+		f.printAndLogError(err)
+		return
+	}
 
-	if f.yesNoQuestion("Are you sure you want to allow bridge to do this") {
-		if err := f.bridge.SetProxyAllowed(true); err != nil {
-			f.printAndLogError(err)
-			return
-		}
+	if err := f.bridge.SetMaxSyncMemory(limitMB * 1024 * 1024); err != nil {
+		f.printAndLogError(err)
+		return
 	}
+
+	f.Println("The limit is capped to half of the system memory and the batch sizes used by sync are updated on restart.")
 }
 
-func (f *frontendCLI) disallowProxy(_ *ishell.Context) {
-	if !f.bridge.GetProxyAllowed() {
-		f.Println("Bridge is already set to NOT use alternative routing to connect to Proton if it is being blocked.")
+func (f *frontendCLI) changeAutoLockTimeout(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	if timeout := f.bridge.GetAutoLockTimeout(); timeout != 0 {
+		f.Printf("Bridge currently locks itself after %v minutes of inactivity.\n", int(timeout.Minutes()))
+	} else {
+		f.Println("Bridge currently never locks itself.")
+	}
+
+	minutes := f.readStringInAttempts("Enter the new inactivity in minutes (0 to never lock)", c.ReadLine, f.isAutoLockTimeoutValid)
+	if minutes == "" {
+		f.printAndLogError(errors.New("failed to get new auto-lock timeout"))
 		return
 	}
 
-	f.Println("Bridge is currently set to use alternative routing to connect to Proton if it is being blocked.")
+	minutesInt, err := strconv.ParseUint(minutes, 10, 32)
+	if err != nil {
+		f.printAndLogError(err)
+		return
+	}
 
-	if f.yesNoQuestion("Are you sure you want to stop bridge from doing this") {
-		if err := f.bridge.SetProxyAllowed(false); err != nil {
-			f.printAndLogError(err)
-			return
-		}
+	if err := f.bridge.SetAutoLockTimeout(time.Duration(minutesInt) * time.Minute); err != nil {
+		f.printAndLogError(err)
+		return
 	}
 }
 
-func (f *frontendCLI) hideAllMail(_ *ishell.Context) {
-	if !f.bridge.GetShowAllMail() {
-		f.Println("All Mail folder is not listed in your local client.")
-		return
+func (f *frontendCLI) changeUnlockPassphrase(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	if f.bridge.HasUnlockPassphrase() {
+		f.Println("Removing the unlock passphrase also disables auto-lock.")
 	}
 
-	f.Println("All Mail folder is listed in your client right now.")
+	f.Print("New unlock passphrase, leave empty to remove it: ")
+	passphrase := c.ReadPassword()
 
-	if f.yesNoQuestion("Do you want to hide All Mail folder") {
-		if err := f.bridge.SetShowAllMail(false); err != nil {
-			f.printAndLogError(err)
+	if passphrase != "" {
+		f.Print("Repeat the unlock passphrase: ")
+
+		if c.ReadPassword() != passphrase {
+			f.Println("The passphrases don't match.")
 			return
 		}
 	}
-}
 
-func (f *frontendCLI) showAllMail(_ *ishell.Context) {
-	if f.bridge.GetShowAllMail() {
-		f.Println("All Mail folder is listed in your local client.")
+	if err := f.bridge.SetUnlockPassphrase([]byte(passphrase)); err != nil {
+		f.printAndLogError(err)
 		return
 	}
+}
 
-	f.Println("All Mail folder is not listed in your client right now.")
+func (f *frontendCLI) lockBridge(_ *ishell.Context) {
+	if f.bridge.IsLocked() {
+		f.Println("Bridge is already locked.")
+		return
+	}
 
-	if f.yesNoQuestion("Do you want to show All Mail folder") {
-		if err := f.bridge.SetShowAllMail(true); err != nil {
-			f.printAndLogError(err)
-			return
-		}
+	if err := f.bridge.Lock(context.Background()); err != nil {
+		f.printAndLogError("Cannot lock bridge: ", err)
+		return
 	}
 }
 
-func (f *frontendCLI) enableTelemetry(_ *ishell.Context) {
-	if !f.bridge.GetTelemetryDisabled() {
-		f.Println("Usage diagnostics collection is enabled.")
+func (f *frontendCLI) unlockBridge(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	if !f.bridge.IsLocked() {
+		f.Println("Bridge is not locked.")
 		return
 	}
 
-	f.Println("Usage diagnostics collection is disabled right now.")
+	f.Print("Unlock passphrase: ")
 
-	if f.yesNoQuestion("Do you want to enable usage diagnostics collection") {
-		if err := f.bridge.SetTelemetryDisabled(false); err != nil {
-			f.printAndLogError(err)
-			return
-		}
+	if err := f.bridge.Unlock([]byte(c.ReadPassword())); err != nil {
+		f.printAndLogError("Cannot unlock bridge: ", err)
+		return
 	}
 }
 
-func (f *frontendCLI) disableTelemetry(_ *ishell.Context) {
-	if f.bridge.GetTelemetryDisabled() {
-		f.Println("Usage diagnostics collection is disabled.")
+func (f *frontendCLI) rotateEncryptionKeys(_ *ishell.Context) {
+	f.Println("The vault and the message cache will be re-encrypted with new keys.")
+	f.Println("Accounts are not served while their message cache is being re-encrypted.")
+
+	if !f.yesNoQuestion("Are you sure you want to rotate the encryption keys") {
 		return
 	}
 
-	f.Println("Usage diagnostics collection is enabled right now.")
-
-	if f.yesNoQuestion("Do you want to disable usage diagnostics collection") {
-		if err := f.bridge.SetTelemetryDisabled(true); err != nil {
-			f.printAndLogError(err)
-			return
-		}
+	if err := f.bridge.RotateEncryptionKeys(); err != nil {
+		f.printAndLogError("Cannot rotate encryption keys: ", err)
+		return
 	}
+
+	f.Println("Encryption keys are being rotated.")
 }
 
-func (f *frontendCLI) setGluonLocation(c *ishell.Context) {
-	if gluonDir := f.bridge.GetGluonCacheDir(); gluonDir != "" {
-		f.Println("The current message cache location is:", gluonDir)
+func (f *frontendCLI) migrateToFileVault(_ *ishell.Context) {
+	if !f.bridge.IsCredentialStoreUnavailable() {
+		f.Println("The keychain is available, bridge doesn't run in degraded mode.")
+		return
 	}
 
-	if location := f.readStringInAttempts("Enter a new location for the message cache", c.ReadLine, f.isCacheLocationUsable); location != "" {
-		if err := f.bridge.SetGluonDir(context.Background(), location); err != nil {
-			f.printAndLogError(err)
-			return
-		}
+	f.Println("The vault will be kept in a file, unencrypted, rather than keyed in the keychain.")
+	f.Println("If the keychain can't be reached, the accounts it holds the vault key of must be added again.")
+
+	if !f.yesNoQuestion("Are you sure you want to migrate to the file-based vault") {
+		return
+	}
+
+	if err := f.bridge.MigrateToFileVault(context.Background()); err != nil {
+		f.printAndLogError("Cannot migrate to the file-based vault: ", err)
+		return
 	}
+
+	f.Println("The vault is now kept in a file.")
 }
 
 func (f *frontendCLI) tlsCertStatus(_ *ishell.Context) {
@@ -303,6 +1007,155 @@ func (f *frontendCLI) exportTLSCerts(c *ishell.Context) {
 	}
 }
 
+func (f *frontendCLI) showStatus(_ *ishell.Context) {
+	if f.bridge.HasAPIConnection() {
+		f.Println("Bridge is connected to Proton servers.")
+	} else {
+		f.Println("Bridge is NOT connected to Proton servers.")
+	}
+
+	if backoff := f.bridge.GetAPIBackoff(); backoff > 0 {
+		f.Println("Proton servers are rate-limiting Bridge; requests are paused for", backoff.Round(time.Second))
+	} else {
+		f.Println("Bridge is not being rate-limited.")
+	}
+
+	usage := f.bridge.GetMemoryUsage()
+
+	f.Printf("Memory used to sync and build messages: %v MB out of %v MB", usage.Used/(1024*1024), usage.Limit/(1024*1024))
+
+	if usage.Waiting > 0 {
+		f.Printf(", %v tasks waiting for memory.\n", usage.Waiting)
+	} else {
+		f.Println(".")
+	}
+
+	if queue := f.bridge.GetSyncQueue(); len(queue) > 0 {
+		usernames := make([]string, 0, len(queue))
+
+		for _, userID := range queue {
+			if info, err := f.bridge.GetUserInfo(userID); err == nil {
+				usernames = append(usernames, info.Username)
+			}
+		}
+
+		f.Println("Accounts waiting to sync:", strings.Join(usernames, ", "))
+	}
+
+	for _, userID := range f.bridge.GetUserIDs() {
+		info, err := f.bridge.GetUserInfo(userID)
+		if err != nil || info.State != bridge.Connected || info.MaxSpace == 0 {
+			continue
+		}
+
+		f.Printf(
+			"Storage of account %s: %v used out of %v (%v%%).\n",
+			info.Username, formatStorage(info.UsedSpace), formatStorage(info.MaxSpace), info.UsedSpace*100/info.MaxSpace,
+		)
+	}
+
+	if f.bridge.GetSessionAuditLog() {
+		f.printRecentIMAPSessions()
+	}
+}
+
+// printRecentIMAPSessions prints the sessions recorded by the audit log, most recent first.
+func (f *frontendCLI) printRecentIMAPSessions() {
+	sessions := f.bridge.GetRecentIMAPSessions()
+	if len(sessions) == 0 {
+		f.Println("No IMAP session was recorded since the audit log was enabled.")
+		return
+	}
+
+	f.Println(bold("Recent IMAP sessions:"))
+
+	for _, session := range sessions {
+		client := session.ClientName
+		if client == "" {
+			client = "unknown client"
+		} else if session.ClientVersion != "" {
+			client += " " + session.ClientVersion
+		}
+
+		address := session.Address
+		if address == "" {
+			address = "not authenticated"
+		}
+
+		ended := "open"
+		if !session.EndedAt.IsZero() {
+			ended = "ended " + session.EndedAt.Format(time.DateTime)
+		}
+
+		f.Printf(
+			"  #%v %s from %s as %s, started %s, %s: %v commands, %v received, %v sent",
+			session.ID, client, session.RemoteAddr, address, session.StartedAt.Format(time.DateTime), ended,
+			countCommands(session.Commands), formatStorage(uint64(session.BytesIn)), formatStorage(uint64(session.BytesOut)), //nolint:gosec // disable G115
+		)
+
+		if len(session.Mailboxes) > 0 {
+			f.Printf(", mailboxes %s", strings.Join(session.Mailboxes, ", "))
+		}
+
+		f.Println(".")
+	}
+}
+
+func countCommands(commands map[string]int) int {
+	var count int
+
+	for _, n := range commands {
+		count += n
+	}
+
+	return count
+}
+
+// formatStorage formats a storage size in bytes for display.
+func formatStorage(size uint64) string {
+	const unit = 1024
+
+	if size < unit {
+		return fmt.Sprintf("%v B", size)
+	}
+
+	div, exp := uint64(unit), 0
+
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+func (f *frontendCLI) collectDiagnostics(c *ishell.Context) {
+	if location := f.readStringInAttempts("Enter a folder in which to save the diagnostics bundle", c.ReadLine, f.isCacheLocationUsable); location != "" {
+		path, err := f.bridge.SaveDiagnostics(location)
+		if err != nil {
+			f.printAndLogError(err)
+			return
+		}
+
+		f.Println("Diagnostics bundle saved to", path)
+		f.Println("Email addresses and message IDs have been replaced by hashes; you can attach it to your support ticket.")
+	}
+}
+
+func (f *frontendCLI) checkConfiguration(_ *ishell.Context) {
+	f.Println("Checking the configuration, this may take a few seconds...")
+
+	report := f.bridge.CheckConfiguration(context.Background())
+
+	for _, check := range report.Checks {
+		f.Printf("[%7s] %v: %v\n", check.Status, check.Name, check.Details)
+	}
+
+	if report.Passed() {
+		f.Println("No problem was found.")
+	}
+}
+
 func (f *frontendCLI) importTLSCerts(c *ishell.Context) {
 	certPath := f.readStringInAttempts("Enter the path to the cert.pem file", c.ReadLine, f.isFile)
 	if certPath == "" {
@@ -321,7 +1174,35 @@ func (f *frontendCLI) importTLSCerts(c *ishell.Context) {
 		return
 	}
 
-	f.Println("TLS certificate imported. Restart Bridge to use it.")
+	f.Println("TLS certificate imported. It is now used by the IMAP and SMTP servers and reloaded when the files are renewed.")
+}
+
+func (f *frontendCLI) importTLSCertDir(c *ishell.Context) {
+	dir := f.readStringInAttempts("Enter the path to the directory containing the certificate and key (e.g. fullchain.pem and privkey.pem)", c.ReadLine, f.isCacheLocationUsable)
+	if dir == "" {
+		f.printAndLogError(errors.New("failed to get certificate directory"))
+		return
+	}
+
+	if err := f.bridge.SetBridgeTLSCertDir(dir); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("TLS certificate imported. It is now used by the IMAP and SMTP servers and reloaded when the files are renewed.")
+}
+
+func (f *frontendCLI) resetTLSCert(_ *ishell.Context) {
+	if !f.yesNoQuestion("Are you sure you want to stop using the imported TLS certificate") {
+		return
+	}
+
+	if err := f.bridge.ClearBridgeTLSCertPath(); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Bridge now uses its own self-signed TLS certificate.")
 }
 
 func (f *frontendCLI) isPortFree(port string) bool {
@@ -351,6 +1232,42 @@ func (f *frontendCLI) isCacheLocationUsable(location string) bool {
 	return stat.IsDir()
 }
 
+func (f *frontendCLI) isBindAddressValid(address string) bool {
+	if net.ParseIP(address) == nil {
+		f.Println("Input", address, "is not a valid IP address.")
+		return false
+	}
+
+	return true
+}
+
+func (f *frontendCLI) isAutoLockTimeoutValid(minutes string) bool {
+	if _, err := strconv.ParseUint(minutes, 10, 32); err != nil {
+		f.Println("Input", minutes, "is not a valid number of minutes.")
+		return false
+	}
+
+	return true
+}
+
+func (f *frontendCLI) isCacheSizeLimitValid(limit string) bool {
+	if _, err := strconv.ParseUint(limit, 10, 64); err != nil {
+		f.Println("Input", limit, "is not a valid size.")
+		return false
+	}
+
+	return true
+}
+
+func (f *frontendCLI) isKeepRecentDaysValid(days string) bool {
+	if _, err := strconv.ParseUint(days, 10, 32); err != nil {
+		f.Println("Input", days, "is not a valid number of days.")
+		return false
+	}
+
+	return true
+}
+
 func (f *frontendCLI) isFile(location string) bool {
 	stat, err := os.Stat(location)
 	if err != nil {
@@ -18,6 +18,8 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 	"github.com/abiosoft/ishell"
@@ -102,3 +104,31 @@ func (f *frontendCLI) selectStableChannel(_ *ishell.Context) {
 		}
 	}
 }
+
+func (f *frontendCLI) rollbackUpdate(_ *ishell.Context) {
+	f.Println("Bridge will go back to the previous version kept on disk after a restart.")
+	f.Println("The current version will not be installed automatically again.")
+
+	if !f.yesNoQuestion("Are you sure you want to roll back") {
+		return
+	}
+
+	if err := f.bridge.Rollback(); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Please restart Bridge to use the previous version.")
+}
+
+func (f *frontendCLI) installLocalUpdate(c *ishell.Context) {
+	if len(c.Args) != 1 {
+		f.Println("Please provide the directory holding the update files.")
+		return
+	}
+
+	// Once installed, the result is printed by the main event loop.
+	if err := f.bridge.InstallUpdateFromDir(context.Background(), c.Args[0]); err != nil {
+		f.printAndLogError(err)
+	}
+}
@@ -18,6 +18,7 @@
 package cli
 
 import (
+	"encoding/base64"
 	"strings"
 
 	"github.com/fatih/color"
@@ -33,6 +34,16 @@ func isNotEmpty(val string) bool {
 	return val != ""
 }
 
+func isBase64(val string) bool {
+	if val == "" {
+		return false
+	}
+
+	_, err := base64.StdEncoding.DecodeString(val)
+
+	return err == nil
+}
+
 func (f *frontendCLI) yesNoQuestion(question string) bool {
 	f.Print(question, "? yes/"+bold("no")+": ")
 	yes := "yes"
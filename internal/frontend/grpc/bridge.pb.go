@@ -104,6 +104,58 @@ func (LogLevel) EnumDescriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{0}
 }
 
+// **********************************************************
+// Connection doctor
+// **********************************************************
+type ConfigurationCheckStatus int32
+
+const (
+	ConfigurationCheckStatus_CHECK_PASSED  ConfigurationCheckStatus = 0
+	ConfigurationCheckStatus_CHECK_WARNING ConfigurationCheckStatus = 1
+	ConfigurationCheckStatus_CHECK_FAILED  ConfigurationCheckStatus = 2
+)
+
+// Enum value maps for ConfigurationCheckStatus.
+var (
+	ConfigurationCheckStatus_name = map[int32]string{
+		0: "CHECK_PASSED",
+		1: "CHECK_WARNING",
+		2: "CHECK_FAILED",
+	}
+	ConfigurationCheckStatus_value = map[string]int32{
+		"CHECK_PASSED":  0,
+		"CHECK_WARNING": 1,
+		"CHECK_FAILED":  2,
+	}
+)
+
+func (x ConfigurationCheckStatus) Enum() *ConfigurationCheckStatus {
+	p := new(ConfigurationCheckStatus)
+	*p = x
+	return p
+}
+
+func (x ConfigurationCheckStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigurationCheckStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bridge_proto_enumTypes[1].Descriptor()
+}
+
+func (ConfigurationCheckStatus) Type() protoreflect.EnumType {
+	return &file_bridge_proto_enumTypes[1]
+}
+
+func (x ConfigurationCheckStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigurationCheckStatus.Descriptor instead.
+func (ConfigurationCheckStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{1}
+}
+
 // **********************************************************
 // user related messages
 // **********************************************************
@@ -140,11 +192,11 @@ func (x UserState) String() string {
 }
 
 func (UserState) Descriptor() protoreflect.EnumDescriptor {
-	return file_bridge_proto_enumTypes[1].Descriptor()
+	return file_bridge_proto_enumTypes[2].Descriptor()
 }
 
 func (UserState) Type() protoreflect.EnumType {
-	return &file_bridge_proto_enumTypes[1]
+	return &file_bridge_proto_enumTypes[2]
 }
 
 func (x UserState) Number() protoreflect.EnumNumber {
@@ -153,7 +205,103 @@ func (x UserState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserState.Descriptor instead.
 func (UserState) EnumDescriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{1}
+	return file_bridge_proto_rawDescGZIP(), []int{2}
+}
+
+// How requests for a read receipt (Disposition-Notification-To) of received messages are handled.
+type ReadReceiptMode int32
+
+const (
+	ReadReceiptMode_READ_RECEIPT_IGNORE ReadReceiptMode = 0
+	ReadReceiptMode_READ_RECEIPT_PROMPT ReadReceiptMode = 1 // a UserReadReceiptRequestedEvent asks whether to send the receipt.
+	ReadReceiptMode_READ_RECEIPT_AUTO   ReadReceiptMode = 2
+)
+
+// Enum value maps for ReadReceiptMode.
+var (
+	ReadReceiptMode_name = map[int32]string{
+		0: "READ_RECEIPT_IGNORE",
+		1: "READ_RECEIPT_PROMPT",
+		2: "READ_RECEIPT_AUTO",
+	}
+	ReadReceiptMode_value = map[string]int32{
+		"READ_RECEIPT_IGNORE": 0,
+		"READ_RECEIPT_PROMPT": 1,
+		"READ_RECEIPT_AUTO":   2,
+	}
+)
+
+func (x ReadReceiptMode) Enum() *ReadReceiptMode {
+	p := new(ReadReceiptMode)
+	*p = x
+	return p
+}
+
+func (x ReadReceiptMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReadReceiptMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_bridge_proto_enumTypes[3].Descriptor()
+}
+
+func (ReadReceiptMode) Type() protoreflect.EnumType {
+	return &file_bridge_proto_enumTypes[3]
+}
+
+func (x ReadReceiptMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReadReceiptMode.Descriptor instead.
+func (ReadReceiptMode) EnumDescriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{3}
+}
+
+type ClientConfigFormat int32
+
+const (
+	ClientConfigFormat_CLIENT_CONFIG_AUTOCONFIG ClientConfigFormat = 0 // Thunderbird autoconfig XML.
+	ClientConfigFormat_CLIENT_CONFIG_PREFS_JS   ClientConfigFormat = 1 // Thunderbird prefs.js snippet.
+)
+
+// Enum value maps for ClientConfigFormat.
+var (
+	ClientConfigFormat_name = map[int32]string{
+		0: "CLIENT_CONFIG_AUTOCONFIG",
+		1: "CLIENT_CONFIG_PREFS_JS",
+	}
+	ClientConfigFormat_value = map[string]int32{
+		"CLIENT_CONFIG_AUTOCONFIG": 0,
+		"CLIENT_CONFIG_PREFS_JS":   1,
+	}
+)
+
+func (x ClientConfigFormat) Enum() *ClientConfigFormat {
+	p := new(ClientConfigFormat)
+	*p = x
+	return p
+}
+
+func (x ClientConfigFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClientConfigFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_bridge_proto_enumTypes[4].Descriptor()
+}
+
+func (ClientConfigFormat) Type() protoreflect.EnumType {
+	return &file_bridge_proto_enumTypes[4]
+}
+
+func (x ClientConfigFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClientConfigFormat.Descriptor instead.
+func (ClientConfigFormat) EnumDescriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{4}
 }
 
 type LoginErrorType int32
@@ -204,11 +352,11 @@ func (x LoginErrorType) String() string {
 }
 
 func (LoginErrorType) Descriptor() protoreflect.EnumDescriptor {
-	return file_bridge_proto_enumTypes[2].Descriptor()
+	return file_bridge_proto_enumTypes[5].Descriptor()
 }
 
 func (LoginErrorType) Type() protoreflect.EnumType {
-	return &file_bridge_proto_enumTypes[2]
+	return &file_bridge_proto_enumTypes[5]
 }
 
 func (x LoginErrorType) Number() protoreflect.EnumNumber {
@@ -217,7 +365,7 @@ func (x LoginErrorType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LoginErrorType.Descriptor instead.
 func (LoginErrorType) EnumDescriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{2}
+	return file_bridge_proto_rawDescGZIP(), []int{5}
 }
 
 type UpdateErrorType int32
@@ -253,11 +401,11 @@ func (x UpdateErrorType) String() string {
 }
 
 func (UpdateErrorType) Descriptor() protoreflect.EnumDescriptor {
-	return file_bridge_proto_enumTypes[3].Descriptor()
+	return file_bridge_proto_enumTypes[6].Descriptor()
 }
 
 func (UpdateErrorType) Type() protoreflect.EnumType {
-	return &file_bridge_proto_enumTypes[3]
+	return &file_bridge_proto_enumTypes[6]
 }
 
 func (x UpdateErrorType) Number() protoreflect.EnumNumber {
@@ -266,7 +414,7 @@ func (x UpdateErrorType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UpdateErrorType.Descriptor instead.
 func (UpdateErrorType) EnumDescriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{3}
+	return file_bridge_proto_rawDescGZIP(), []int{6}
 }
 
 type DiskCacheErrorType int32
@@ -296,11 +444,11 @@ func (x DiskCacheErrorType) String() string {
 }
 
 func (DiskCacheErrorType) Descriptor() protoreflect.EnumDescriptor {
-	return file_bridge_proto_enumTypes[4].Descriptor()
+	return file_bridge_proto_enumTypes[7].Descriptor()
 }
 
 func (DiskCacheErrorType) Type() protoreflect.EnumType {
-	return &file_bridge_proto_enumTypes[4]
+	return &file_bridge_proto_enumTypes[7]
 }
 
 func (x DiskCacheErrorType) Number() protoreflect.EnumNumber {
@@ -309,7 +457,7 @@ func (x DiskCacheErrorType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DiskCacheErrorType.Descriptor instead.
 func (DiskCacheErrorType) EnumDescriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{4}
+	return file_bridge_proto_rawDescGZIP(), []int{7}
 }
 
 type MailServerSettingsErrorType int32
@@ -354,11 +502,11 @@ func (x MailServerSettingsErrorType) String() string {
 }
 
 func (MailServerSettingsErrorType) Descriptor() protoreflect.EnumDescriptor {
-	return file_bridge_proto_enumTypes[5].Descriptor()
+	return file_bridge_proto_enumTypes[8].Descriptor()
 }
 
 func (MailServerSettingsErrorType) Type() protoreflect.EnumType {
-	return &file_bridge_proto_enumTypes[5]
+	return &file_bridge_proto_enumTypes[8]
 }
 
 func (x MailServerSettingsErrorType) Number() protoreflect.EnumNumber {
@@ -367,7 +515,7 @@ func (x MailServerSettingsErrorType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MailServerSettingsErrorType.Descriptor instead.
 func (MailServerSettingsErrorType) EnumDescriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{5}
+	return file_bridge_proto_rawDescGZIP(), []int{8}
 }
 
 // **********************************************************
@@ -406,11 +554,11 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_bridge_proto_enumTypes[6].Descriptor()
+	return file_bridge_proto_enumTypes[9].Descriptor()
 }
 
 func (ErrorCode) Type() protoreflect.EnumType {
-	return &file_bridge_proto_enumTypes[6]
+	return &file_bridge_proto_enumTypes[9]
 }
 
 func (x ErrorCode) Number() protoreflect.EnumNumber {
@@ -419,7 +567,7 @@ func (x ErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ErrorCode.Descriptor instead.
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{6}
+	return file_bridge_proto_rawDescGZIP(), []int{9}
 }
 
 type AddLogEntryRequest struct {
@@ -485,21 +633,17 @@ func (x *AddLogEntryRequest) GetMessage() string {
 	return ""
 }
 
-// **********************************************************
-//
-//	GuiReady
-//
-// **********************************************************
-type GuiReadyResponse struct {
+type SubsystemLogLevel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ShowSplashScreen bool `protobuf:"varint,1,opt,name=showSplashScreen,proto3" json:"showSplashScreen,omitempty"`
+	Subsystem string   `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"` // one of imap, smtp, sync, api, grpc
+	Level     LogLevel `protobuf:"varint,2,opt,name=level,proto3,enum=grpc.LogLevel" json:"level,omitempty"`
 }
 
-func (x *GuiReadyResponse) Reset() {
-	*x = GuiReadyResponse{}
+func (x *SubsystemLogLevel) Reset() {
+	*x = SubsystemLogLevel{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -507,13 +651,13 @@ func (x *GuiReadyResponse) Reset() {
 	}
 }
 
-func (x *GuiReadyResponse) String() string {
+func (x *SubsystemLogLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuiReadyResponse) ProtoMessage() {}
+func (*SubsystemLogLevel) ProtoMessage() {}
 
-func (x *GuiReadyResponse) ProtoReflect() protoreflect.Message {
+func (x *SubsystemLogLevel) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -525,39 +669,36 @@ func (x *GuiReadyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GuiReadyResponse.ProtoReflect.Descriptor instead.
-func (*GuiReadyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubsystemLogLevel.ProtoReflect.Descriptor instead.
+func (*SubsystemLogLevel) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GuiReadyResponse) GetShowSplashScreen() bool {
+func (x *SubsystemLogLevel) GetSubsystem() string {
 	if x != nil {
-		return x.ShowSplashScreen
+		return x.Subsystem
 	}
-	return false
+	return ""
 }
 
-// **********************************************************
-//
-//	Bug reporting related messages.
-//
-// **********************************************************
-type ReportBugRequest struct {
+func (x *SubsystemLogLevel) GetLevel() LogLevel {
+	if x != nil {
+		return x.Level
+	}
+	return LogLevel_LOG_PANIC
+}
+
+type LogLevelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OsType      string `protobuf:"bytes,1,opt,name=osType,proto3" json:"osType,omitempty"`
-	OsVersion   string `protobuf:"bytes,2,opt,name=osVersion,proto3" json:"osVersion,omitempty"`
-	Title       string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Address     string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	EmailClient string `protobuf:"bytes,6,opt,name=emailClient,proto3" json:"emailClient,omitempty"`
-	IncludeLogs bool   `protobuf:"varint,7,opt,name=includeLogs,proto3" json:"includeLogs,omitempty"`
+	DefaultLevel LogLevel             `protobuf:"varint,1,opt,name=defaultLevel,proto3,enum=grpc.LogLevel" json:"defaultLevel,omitempty"`
+	Subsystems   []*SubsystemLogLevel `protobuf:"bytes,2,rep,name=subsystems,proto3" json:"subsystems,omitempty"`
 }
 
-func (x *ReportBugRequest) Reset() {
-	*x = ReportBugRequest{}
+func (x *LogLevelsResponse) Reset() {
+	*x = LogLevelsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -565,13 +706,13 @@ func (x *ReportBugRequest) Reset() {
 	}
 }
 
-func (x *ReportBugRequest) String() string {
+func (x *LogLevelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportBugRequest) ProtoMessage() {}
+func (*LogLevelsResponse) ProtoMessage() {}
 
-func (x *ReportBugRequest) ProtoReflect() protoreflect.Message {
+func (x *LogLevelsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -583,72 +724,37 @@ func (x *ReportBugRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportBugRequest.ProtoReflect.Descriptor instead.
-func (*ReportBugRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogLevelsResponse.ProtoReflect.Descriptor instead.
+func (*LogLevelsResponse) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ReportBugRequest) GetOsType() string {
-	if x != nil {
-		return x.OsType
-	}
-	return ""
-}
-
-func (x *ReportBugRequest) GetOsVersion() string {
-	if x != nil {
-		return x.OsVersion
-	}
-	return ""
-}
-
-func (x *ReportBugRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *ReportBugRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *ReportBugRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *ReportBugRequest) GetEmailClient() string {
+func (x *LogLevelsResponse) GetDefaultLevel() LogLevel {
 	if x != nil {
-		return x.EmailClient
+		return x.DefaultLevel
 	}
-	return ""
+	return LogLevel_LOG_PANIC
 }
 
-func (x *ReportBugRequest) GetIncludeLogs() bool {
+func (x *LogLevelsResponse) GetSubsystems() []*SubsystemLogLevel {
 	if x != nil {
-		return x.IncludeLogs
+		return x.Subsystems
 	}
-	return false
+	return nil
 }
 
-type LoginRequest struct {
+type SetLogLevelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username     string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password     []byte `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	UseHvDetails *bool  `protobuf:"varint,3,opt,name=useHvDetails,proto3,oneof" json:"useHvDetails,omitempty"`
+	Subsystem      string   `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"` // empty to set the default level
+	Level          LogLevel `protobuf:"varint,2,opt,name=level,proto3,enum=grpc.LogLevel" json:"level,omitempty"`
+	ResetToDefault bool     `protobuf:"varint,3,opt,name=resetToDefault,proto3" json:"resetToDefault,omitempty"` // if true, the subsystem uses the default level again and level is ignored
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -656,13 +762,13 @@ func (x *LoginRequest) Reset() {
 	}
 }
 
-func (x *LoginRequest) String() string {
+func (x *SetLogLevelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*SetLogLevelRequest) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,42 +780,47 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LoginRequest) GetUsername() string {
+func (x *SetLogLevelRequest) GetSubsystem() string {
 	if x != nil {
-		return x.Username
+		return x.Subsystem
 	}
 	return ""
 }
 
-func (x *LoginRequest) GetPassword() []byte {
+func (x *SetLogLevelRequest) GetLevel() LogLevel {
 	if x != nil {
-		return x.Password
+		return x.Level
 	}
-	return nil
+	return LogLevel_LOG_PANIC
 }
 
-func (x *LoginRequest) GetUseHvDetails() bool {
-	if x != nil && x.UseHvDetails != nil {
-		return *x.UseHvDetails
+func (x *SetLogLevelRequest) GetResetToDefault() bool {
+	if x != nil {
+		return x.ResetToDefault
 	}
 	return false
 }
 
-type LoginAbortRequest struct {
+// **********************************************************
+//
+//	GuiReady
+//
+// **********************************************************
+type GuiReadyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	ShowSplashScreen bool `protobuf:"varint,1,opt,name=showSplashScreen,proto3" json:"showSplashScreen,omitempty"`
 }
 
-func (x *LoginAbortRequest) Reset() {
-	*x = LoginAbortRequest{}
+func (x *GuiReadyResponse) Reset() {
+	*x = GuiReadyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -717,13 +828,13 @@ func (x *LoginAbortRequest) Reset() {
 	}
 }
 
-func (x *LoginAbortRequest) String() string {
+func (x *GuiReadyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginAbortRequest) ProtoMessage() {}
+func (*GuiReadyResponse) ProtoMessage() {}
 
-func (x *LoginAbortRequest) ProtoReflect() protoreflect.Message {
+func (x *GuiReadyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -735,34 +846,34 @@ func (x *LoginAbortRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginAbortRequest.ProtoReflect.Descriptor instead.
-func (*LoginAbortRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GuiReadyResponse.ProtoReflect.Descriptor instead.
+func (*GuiReadyResponse) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *LoginAbortRequest) GetUsername() string {
+func (x *GuiReadyResponse) GetShowSplashScreen() bool {
 	if x != nil {
-		return x.Username
+		return x.ShowSplashScreen
 	}
-	return ""
+	return false
 }
 
 // **********************************************************
-// IMAP/SMTP Mail Server settings
-// **********************************************************
-type ImapSmtpSettings struct {
+//
+//	Service info
+//
+// **********************************************************
+type ServiceInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ImapPort      int32 `protobuf:"varint,1,opt,name=imapPort,proto3" json:"imapPort,omitempty"`
-	SmtpPort      int32 `protobuf:"varint,2,opt,name=smtpPort,proto3" json:"smtpPort,omitempty"`
-	UseSSLForImap bool  `protobuf:"varint,3,opt,name=useSSLForImap,proto3" json:"useSSLForImap,omitempty"`
-	UseSSLForSmtp bool  `protobuf:"varint,4,opt,name=useSSLForSmtp,proto3" json:"useSSLForSmtp,omitempty"`
+	ClientApiVersion int32  `protobuf:"varint,1,opt,name=clientApiVersion,proto3" json:"clientApiVersion,omitempty"` // the API version the frontend was built against; 0 means the first version.
+	ClientName       string `protobuf:"bytes,2,opt,name=clientName,proto3" json:"clientName,omitempty"`              // the name of the frontend, for logging purposes.
 }
 
-func (x *ImapSmtpSettings) Reset() {
-	*x = ImapSmtpSettings{}
+func (x *ServiceInfoRequest) Reset() {
+	*x = ServiceInfoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -770,13 +881,13 @@ func (x *ImapSmtpSettings) Reset() {
 	}
 }
 
-func (x *ImapSmtpSettings) String() string {
+func (x *ServiceInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImapSmtpSettings) ProtoMessage() {}
+func (*ServiceInfoRequest) ProtoMessage() {}
 
-func (x *ImapSmtpSettings) ProtoReflect() protoreflect.Message {
+func (x *ServiceInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -788,52 +899,37 @@ func (x *ImapSmtpSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImapSmtpSettings.ProtoReflect.Descriptor instead.
-func (*ImapSmtpSettings) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceInfoRequest.ProtoReflect.Descriptor instead.
+func (*ServiceInfoRequest) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ImapSmtpSettings) GetImapPort() int32 {
-	if x != nil {
-		return x.ImapPort
-	}
-	return 0
-}
-
-func (x *ImapSmtpSettings) GetSmtpPort() int32 {
+func (x *ServiceInfoRequest) GetClientApiVersion() int32 {
 	if x != nil {
-		return x.SmtpPort
+		return x.ClientApiVersion
 	}
 	return 0
 }
 
-func (x *ImapSmtpSettings) GetUseSSLForImap() bool {
-	if x != nil {
-		return x.UseSSLForImap
-	}
-	return false
-}
-
-func (x *ImapSmtpSettings) GetUseSSLForSmtp() bool {
+func (x *ServiceInfoRequest) GetClientName() string {
 	if x != nil {
-		return x.UseSSLForSmtp
+		return x.ClientName
 	}
-	return false
+	return ""
 }
 
-// **********************************************************
-// Keychain related message
-// **********************************************************
-type AvailableKeychainsResponse struct {
+type DeprecationHint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keychains []string `protobuf:"bytes,1,rep,name=keychains,proto3" json:"keychains,omitempty"`
+	Rpc         string `protobuf:"bytes,1,opt,name=rpc,proto3" json:"rpc,omitempty"`                 // the deprecated RPC.
+	Replacement string `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"` // the RPC to use instead, empty if none.
+	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *AvailableKeychainsResponse) Reset() {
-	*x = AvailableKeychainsResponse{}
+func (x *DeprecationHint) Reset() {
+	*x = DeprecationHint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -841,13 +937,13 @@ func (x *AvailableKeychainsResponse) Reset() {
 	}
 }
 
-func (x *AvailableKeychainsResponse) String() string {
+func (x *DeprecationHint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailableKeychainsResponse) ProtoMessage() {}
+func (*DeprecationHint) ProtoMessage() {}
 
-func (x *AvailableKeychainsResponse) ProtoReflect() protoreflect.Message {
+func (x *DeprecationHint) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -859,36 +955,46 @@ func (x *AvailableKeychainsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailableKeychainsResponse.ProtoReflect.Descriptor instead.
-func (*AvailableKeychainsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeprecationHint.ProtoReflect.Descriptor instead.
+func (*DeprecationHint) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AvailableKeychainsResponse) GetKeychains() []string {
+func (x *DeprecationHint) GetRpc() string {
 	if x != nil {
-		return x.Keychains
+		return x.Rpc
 	}
-	return nil
+	return ""
 }
 
-type User struct {
+func (x *DeprecationHint) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+func (x *DeprecationHint) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ServiceInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username   string    `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	AvatarText string    `protobuf:"bytes,3,opt,name=avatarText,proto3" json:"avatarText,omitempty"`
-	State      UserState `protobuf:"varint,4,opt,name=state,proto3,enum=grpc.UserState" json:"state,omitempty"`
-	SplitMode  bool      `protobuf:"varint,5,opt,name=splitMode,proto3" json:"splitMode,omitempty"`
-	UsedBytes  int64     `protobuf:"varint,6,opt,name=usedBytes,proto3" json:"usedBytes,omitempty"`
-	TotalBytes int64     `protobuf:"varint,7,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
-	Password   []byte    `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`
-	Addresses  []string  `protobuf:"bytes,9,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	ApiVersion          int32              `protobuf:"varint,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`                   // the API version implemented by bridge.
+	MinClientApiVersion int32              `protobuf:"varint,2,opt,name=minClientApiVersion,proto3" json:"minClientApiVersion,omitempty"` // the oldest frontend API version bridge still supports.
+	BridgeVersion       string             `protobuf:"bytes,3,opt,name=bridgeVersion,proto3" json:"bridgeVersion,omitempty"`
+	Features            []string           `protobuf:"bytes,4,rep,name=features,proto3" json:"features,omitempty"` // optional features supported by bridge, such as "fido2Login" or "webhooks".
+	Deprecations        []*DeprecationHint `protobuf:"bytes,5,rep,name=deprecations,proto3" json:"deprecations,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *ServiceInfo) Reset() {
+	*x = ServiceInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -896,13 +1002,13 @@ func (x *User) Reset() {
 	}
 }
 
-func (x *User) String() string {
+func (x *ServiceInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*ServiceInfo) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,85 +1020,67 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
+func (*ServiceInfo) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *User) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *User) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *User) GetAvatarText() string {
-	if x != nil {
-		return x.AvatarText
-	}
-	return ""
-}
-
-func (x *User) GetState() UserState {
-	if x != nil {
-		return x.State
-	}
-	return UserState_SIGNED_OUT
-}
-
-func (x *User) GetSplitMode() bool {
+func (x *ServiceInfo) GetApiVersion() int32 {
 	if x != nil {
-		return x.SplitMode
+		return x.ApiVersion
 	}
-	return false
+	return 0
 }
 
-func (x *User) GetUsedBytes() int64 {
+func (x *ServiceInfo) GetMinClientApiVersion() int32 {
 	if x != nil {
-		return x.UsedBytes
+		return x.MinClientApiVersion
 	}
 	return 0
 }
 
-func (x *User) GetTotalBytes() int64 {
+func (x *ServiceInfo) GetBridgeVersion() string {
 	if x != nil {
-		return x.TotalBytes
+		return x.BridgeVersion
 	}
-	return 0
+	return ""
 }
 
-func (x *User) GetPassword() []byte {
+func (x *ServiceInfo) GetFeatures() []string {
 	if x != nil {
-		return x.Password
+		return x.Features
 	}
 	return nil
 }
 
-func (x *User) GetAddresses() []string {
+func (x *ServiceInfo) GetDeprecations() []*DeprecationHint {
 	if x != nil {
-		return x.Addresses
+		return x.Deprecations
 	}
 	return nil
 }
 
-type UserSplitModeRequest struct {
+// **********************************************************
+//
+//	Bug reporting related messages.
+//
+// **********************************************************
+type ReportBugRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Active bool   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	OsType      string `protobuf:"bytes,1,opt,name=osType,proto3" json:"osType,omitempty"`
+	OsVersion   string `protobuf:"bytes,2,opt,name=osVersion,proto3" json:"osVersion,omitempty"`
+	Title       string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Address     string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	EmailClient string `protobuf:"bytes,6,opt,name=emailClient,proto3" json:"emailClient,omitempty"`
+	IncludeLogs bool   `protobuf:"varint,7,opt,name=includeLogs,proto3" json:"includeLogs,omitempty"`
 }
 
-func (x *UserSplitModeRequest) Reset() {
-	*x = UserSplitModeRequest{}
+func (x *ReportBugRequest) Reset() {
+	*x = ReportBugRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1000,13 +1088,13 @@ func (x *UserSplitModeRequest) Reset() {
 	}
 }
 
-func (x *UserSplitModeRequest) String() string {
+func (x *ReportBugRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSplitModeRequest) ProtoMessage() {}
+func (*ReportBugRequest) ProtoMessage() {}
 
-func (x *UserSplitModeRequest) ProtoReflect() protoreflect.Message {
+func (x *ReportBugRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1018,36 +1106,72 @@ func (x *UserSplitModeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSplitModeRequest.ProtoReflect.Descriptor instead.
-func (*UserSplitModeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReportBugRequest.ProtoReflect.Descriptor instead.
+func (*ReportBugRequest) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UserSplitModeRequest) GetUserID() string {
+func (x *ReportBugRequest) GetOsType() string {
 	if x != nil {
-		return x.UserID
+		return x.OsType
 	}
 	return ""
 }
 
-func (x *UserSplitModeRequest) GetActive() bool {
+func (x *ReportBugRequest) GetOsVersion() string {
 	if x != nil {
-		return x.Active
+		return x.OsVersion
+	}
+	return ""
+}
+
+func (x *ReportBugRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ReportBugRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ReportBugRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ReportBugRequest) GetEmailClient() string {
+	if x != nil {
+		return x.EmailClient
+	}
+	return ""
+}
+
+func (x *ReportBugRequest) GetIncludeLogs() bool {
+	if x != nil {
+		return x.IncludeLogs
 	}
 	return false
 }
 
-type UserBadEventFeedbackRequest struct {
+type LoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	DoResync bool   `protobuf:"varint,2,opt,name=doResync,proto3" json:"doResync,omitempty"`
+	Username     string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password     []byte `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	UseHvDetails *bool  `protobuf:"varint,3,opt,name=useHvDetails,proto3,oneof" json:"useHvDetails,omitempty"`
 }
 
-func (x *UserBadEventFeedbackRequest) Reset() {
-	*x = UserBadEventFeedbackRequest{}
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1055,13 +1179,13 @@ func (x *UserBadEventFeedbackRequest) Reset() {
 	}
 }
 
-func (x *UserBadEventFeedbackRequest) String() string {
+func (x *LoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserBadEventFeedbackRequest) ProtoMessage() {}
+func (*LoginRequest) ProtoMessage() {}
 
-func (x *UserBadEventFeedbackRequest) ProtoReflect() protoreflect.Message {
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1073,35 +1197,43 @@ func (x *UserBadEventFeedbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserBadEventFeedbackRequest.ProtoReflect.Descriptor instead.
-func (*UserBadEventFeedbackRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UserBadEventFeedbackRequest) GetUserID() string {
+func (x *LoginRequest) GetUsername() string {
 	if x != nil {
-		return x.UserID
+		return x.Username
 	}
 	return ""
 }
 
-func (x *UserBadEventFeedbackRequest) GetDoResync() bool {
+func (x *LoginRequest) GetPassword() []byte {
 	if x != nil {
-		return x.DoResync
+		return x.Password
+	}
+	return nil
+}
+
+func (x *LoginRequest) GetUseHvDetails() bool {
+	if x != nil && x.UseHvDetails != nil {
+		return *x.UseHvDetails
 	}
 	return false
 }
 
-type UserListResponse struct {
+type SubsystemTrace struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Subsystem string `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"` // unix timestamp, in seconds, at which the trace stops.
 }
 
-func (x *UserListResponse) Reset() {
-	*x = UserListResponse{}
+func (x *SubsystemTrace) Reset() {
+	*x = SubsystemTrace{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1109,13 +1241,13 @@ func (x *UserListResponse) Reset() {
 	}
 }
 
-func (x *UserListResponse) String() string {
+func (x *SubsystemTrace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserListResponse) ProtoMessage() {}
+func (*SubsystemTrace) ProtoMessage() {}
 
-func (x *UserListResponse) ProtoReflect() protoreflect.Message {
+func (x *SubsystemTrace) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1127,29 +1259,36 @@ func (x *UserListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserListResponse.ProtoReflect.Descriptor instead.
-func (*UserListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubsystemTrace.ProtoReflect.Descriptor instead.
+func (*SubsystemTrace) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UserListResponse) GetUsers() []*User {
+func (x *SubsystemTrace) GetSubsystem() string {
 	if x != nil {
-		return x.Users
+		return x.Subsystem
 	}
-	return nil
+	return ""
 }
 
-type ConfigureAppleMailRequest struct {
+func (x *SubsystemTrace) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type TracesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID  string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	TraceableSubsystems []string          `protobuf:"bytes,1,rep,name=traceableSubsystems,proto3" json:"traceableSubsystems,omitempty"` // one of imap, smtp (wire logs, including message data) or api (redacted requests).
+	Traces              []*SubsystemTrace `protobuf:"bytes,2,rep,name=traces,proto3" json:"traces,omitempty"`                           // the running traces.
 }
 
-func (x *ConfigureAppleMailRequest) Reset() {
-	*x = ConfigureAppleMailRequest{}
+func (x *TracesResponse) Reset() {
+	*x = TracesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1157,13 +1296,13 @@ func (x *ConfigureAppleMailRequest) Reset() {
 	}
 }
 
-func (x *ConfigureAppleMailRequest) String() string {
+func (x *TracesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigureAppleMailRequest) ProtoMessage() {}
+func (*TracesResponse) ProtoMessage() {}
 
-func (x *ConfigureAppleMailRequest) ProtoReflect() protoreflect.Message {
+func (x *TracesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1175,35 +1314,36 @@ func (x *ConfigureAppleMailRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigureAppleMailRequest.ProtoReflect.Descriptor instead.
-func (*ConfigureAppleMailRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TracesResponse.ProtoReflect.Descriptor instead.
+func (*TracesResponse) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ConfigureAppleMailRequest) GetUserID() string {
+func (x *TracesResponse) GetTraceableSubsystems() []string {
 	if x != nil {
-		return x.UserID
+		return x.TraceableSubsystems
 	}
-	return ""
+	return nil
 }
 
-func (x *ConfigureAppleMailRequest) GetAddress() string {
+func (x *TracesResponse) GetTraces() []*SubsystemTrace {
 	if x != nil {
-		return x.Address
+		return x.Traces
 	}
-	return ""
+	return nil
 }
 
-type EventStreamRequest struct {
+type StartTraceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientPlatform string `protobuf:"bytes,1,opt,name=ClientPlatform,proto3" json:"ClientPlatform,omitempty"`
+	Subsystem       string `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+	DurationMinutes int32  `protobuf:"varint,2,opt,name=durationMinutes,proto3" json:"durationMinutes,omitempty"` // 0 means the default duration.
 }
 
-func (x *EventStreamRequest) Reset() {
-	*x = EventStreamRequest{}
+func (x *StartTraceRequest) Reset() {
+	*x = StartTraceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1211,13 +1351,13 @@ func (x *EventStreamRequest) Reset() {
 	}
 }
 
-func (x *EventStreamRequest) String() string {
+func (x *StartTraceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventStreamRequest) ProtoMessage() {}
+func (*StartTraceRequest) ProtoMessage() {}
 
-func (x *EventStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *StartTraceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1229,39 +1369,46 @@ func (x *EventStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventStreamRequest.ProtoReflect.Descriptor instead.
-func (*EventStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartTraceRequest.ProtoReflect.Descriptor instead.
+func (*StartTraceRequest) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *EventStreamRequest) GetClientPlatform() string {
+func (x *StartTraceRequest) GetSubsystem() string {
 	if x != nil {
-		return x.ClientPlatform
+		return x.Subsystem
 	}
 	return ""
 }
 
-type StreamEvent struct {
+func (x *StartTraceRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+// IMAPSession is the audit record of an IMAP session. It never holds message content.
+type IMAPSession struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Event:
-	//
-	//	*StreamEvent_App
-	//	*StreamEvent_Login
-	//	*StreamEvent_Update
-	//	*StreamEvent_Cache
-	//	*StreamEvent_MailServerSettings
-	//	*StreamEvent_Keychain
-	//	*StreamEvent_Mail
-	//	*StreamEvent_User
-	//	*StreamEvent_GenericError
-	Event isStreamEvent_Event `protobuf_oneof:"event"`
-}
-
-func (x *StreamEvent) Reset() {
-	*x = StreamEvent{}
+	Id            int32            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RemoteAddress string           `protobuf:"bytes,2,opt,name=remoteAddress,proto3" json:"remoteAddress,omitempty"`
+	ClientName    string           `protobuf:"bytes,3,opt,name=clientName,proto3" json:"clientName,omitempty"`       // from the IMAP ID command.
+	ClientVersion string           `protobuf:"bytes,4,opt,name=clientVersion,proto3" json:"clientVersion,omitempty"` // from the IMAP ID command.
+	Address       string           `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`             // the authenticated address.
+	Mailboxes     []string         `protobuf:"bytes,6,rep,name=mailboxes,proto3" json:"mailboxes,omitempty"`
+	Commands      map[string]int32 `protobuf:"bytes,7,rep,name=commands,proto3" json:"commands,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // the number of times each command was executed, by command name.
+	BytesIn       int64            `protobuf:"varint,8,opt,name=bytesIn,proto3" json:"bytesIn,omitempty"`
+	BytesOut      int64            `protobuf:"varint,9,opt,name=bytesOut,proto3" json:"bytesOut,omitempty"`
+	StartedAt     int64            `protobuf:"varint,10,opt,name=startedAt,proto3" json:"startedAt,omitempty"` // unix timestamp, in seconds.
+	EndedAt       int64            `protobuf:"varint,11,opt,name=endedAt,proto3" json:"endedAt,omitempty"`     // unix timestamp, in seconds. 0 while the session is open.
+}
+
+func (x *IMAPSession) Reset() {
+	*x = IMAPSession{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1269,13 +1416,13 @@ func (x *StreamEvent) Reset() {
 	}
 }
 
-func (x *StreamEvent) String() string {
+func (x *IMAPSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamEvent) ProtoMessage() {}
+func (*IMAPSession) ProtoMessage() {}
 
-func (x *StreamEvent) ProtoReflect() protoreflect.Message {
+func (x *IMAPSession) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1287,184 +1434,163 @@ func (x *StreamEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamEvent.ProtoReflect.Descriptor instead.
-func (*StreamEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use IMAPSession.ProtoReflect.Descriptor instead.
+func (*IMAPSession) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{13}
 }
 
-func (m *StreamEvent) GetEvent() isStreamEvent_Event {
-	if m != nil {
-		return m.Event
+func (x *IMAPSession) GetId() int32 {
+	if x != nil {
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *StreamEvent) GetApp() *AppEvent {
-	if x, ok := x.GetEvent().(*StreamEvent_App); ok {
-		return x.App
+func (x *IMAPSession) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamEvent) GetLogin() *LoginEvent {
-	if x, ok := x.GetEvent().(*StreamEvent_Login); ok {
-		return x.Login
+func (x *IMAPSession) GetClientName() string {
+	if x != nil {
+		return x.ClientName
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamEvent) GetUpdate() *UpdateEvent {
-	if x, ok := x.GetEvent().(*StreamEvent_Update); ok {
-		return x.Update
+func (x *IMAPSession) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamEvent) GetCache() *DiskCacheEvent {
-	if x, ok := x.GetEvent().(*StreamEvent_Cache); ok {
-		return x.Cache
+func (x *IMAPSession) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamEvent) GetMailServerSettings() *MailServerSettingsEvent {
-	if x, ok := x.GetEvent().(*StreamEvent_MailServerSettings); ok {
-		return x.MailServerSettings
+func (x *IMAPSession) GetMailboxes() []string {
+	if x != nil {
+		return x.Mailboxes
 	}
 	return nil
 }
 
-func (x *StreamEvent) GetKeychain() *KeychainEvent {
-	if x, ok := x.GetEvent().(*StreamEvent_Keychain); ok {
-		return x.Keychain
+func (x *IMAPSession) GetCommands() map[string]int32 {
+	if x != nil {
+		return x.Commands
 	}
 	return nil
 }
 
-func (x *StreamEvent) GetMail() *MailEvent {
-	if x, ok := x.GetEvent().(*StreamEvent_Mail); ok {
-		return x.Mail
+func (x *IMAPSession) GetBytesIn() int64 {
+	if x != nil {
+		return x.BytesIn
 	}
-	return nil
+	return 0
 }
 
-func (x *StreamEvent) GetUser() *UserEvent {
-	if x, ok := x.GetEvent().(*StreamEvent_User); ok {
-		return x.User
+func (x *IMAPSession) GetBytesOut() int64 {
+	if x != nil {
+		return x.BytesOut
 	}
-	return nil
+	return 0
 }
 
-func (x *StreamEvent) GetGenericError() *GenericErrorEvent {
-	if x, ok := x.GetEvent().(*StreamEvent_GenericError); ok {
-		return x.GenericError
+func (x *IMAPSession) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
 	}
-	return nil
-}
-
-type isStreamEvent_Event interface {
-	isStreamEvent_Event()
+	return 0
 }
 
-type StreamEvent_App struct {
-	App *AppEvent `protobuf:"bytes,1,opt,name=app,proto3,oneof"`
+func (x *IMAPSession) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
 }
 
-type StreamEvent_Login struct {
-	Login *LoginEvent `protobuf:"bytes,2,opt,name=login,proto3,oneof"`
-}
+type IMAPSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type StreamEvent_Update struct {
-	Update *UpdateEvent `protobuf:"bytes,3,opt,name=update,proto3,oneof"`
+	Sessions []*IMAPSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"` // the open sessions, then the most recently ended ones.
 }
 
-type StreamEvent_Cache struct {
-	Cache *DiskCacheEvent `protobuf:"bytes,4,opt,name=cache,proto3,oneof"`
+func (x *IMAPSessionsResponse) Reset() {
+	*x = IMAPSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type StreamEvent_MailServerSettings struct {
-	MailServerSettings *MailServerSettingsEvent `protobuf:"bytes,5,opt,name=mailServerSettings,proto3,oneof"`
+func (x *IMAPSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type StreamEvent_Keychain struct {
-	Keychain *KeychainEvent `protobuf:"bytes,6,opt,name=keychain,proto3,oneof"`
-}
+func (*IMAPSessionsResponse) ProtoMessage() {}
 
-type StreamEvent_Mail struct {
-	Mail *MailEvent `protobuf:"bytes,7,opt,name=mail,proto3,oneof"`
+func (x *IMAPSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type StreamEvent_User struct {
-	User *UserEvent `protobuf:"bytes,8,opt,name=user,proto3,oneof"`
+// Deprecated: Use IMAPSessionsResponse.ProtoReflect.Descriptor instead.
+func (*IMAPSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{14}
 }
 
-type StreamEvent_GenericError struct {
-	GenericError *GenericErrorEvent `protobuf:"bytes,9,opt,name=genericError,proto3,oneof"`
+func (x *IMAPSessionsResponse) GetSessions() []*IMAPSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
 }
 
-func (*StreamEvent_App) isStreamEvent_Event() {}
-
-func (*StreamEvent_Login) isStreamEvent_Event() {}
-
-func (*StreamEvent_Update) isStreamEvent_Event() {}
-
-func (*StreamEvent_Cache) isStreamEvent_Event() {}
-
-func (*StreamEvent_MailServerSettings) isStreamEvent_Event() {}
-
-func (*StreamEvent_Keychain) isStreamEvent_Event() {}
-
-func (*StreamEvent_Mail) isStreamEvent_Event() {}
-
-func (*StreamEvent_User) isStreamEvent_Event() {}
-
-func (*StreamEvent_GenericError) isStreamEvent_Event() {}
-
-// **********************************************************
-// App related events
-// **********************************************************
-type AppEvent struct {
+type LoginFido2Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Event:
-	//
-	//	*AppEvent_InternetStatus
-	//	*AppEvent_ToggleAutostartFinished
-	//	*AppEvent_ResetFinished
-	//	*AppEvent_ReportBugFinished
-	//	*AppEvent_ReportBugSuccess
-	//	*AppEvent_ReportBugError
-	//	*AppEvent_ShowMainWindow
-	//	*AppEvent_ReportBugFallback
-	//	*AppEvent_CertificateInstallSuccess
-	//	*AppEvent_CertificateInstallCanceled
-	//	*AppEvent_CertificateInstallFailed
-	//	*AppEvent_KnowledgeBaseSuggestions
-	//	*AppEvent_RepairStarted
-	//	*AppEvent_AllUsersLoaded
-	//	*AppEvent_UserNotification
-	Event isAppEvent_Event `protobuf_oneof:"event"`
+	Username          string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	AuthenticatorData []byte `protobuf:"bytes,2,opt,name=authenticatorData,proto3" json:"authenticatorData,omitempty"`
+	Signature         []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	CredentialID      []byte `protobuf:"bytes,4,opt,name=credentialID,proto3" json:"credentialID,omitempty"`
 }
 
-func (x *AppEvent) Reset() {
-	*x = AppEvent{}
+func (x *LoginFido2Request) Reset() {
+	*x = LoginFido2Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[14]
+		mi := &file_bridge_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AppEvent) String() string {
+func (x *LoginFido2Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppEvent) ProtoMessage() {}
+func (*LoginFido2Request) ProtoMessage() {}
 
-func (x *AppEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[14]
+func (x *LoginFido2Request) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,242 +1601,230 @@ func (x *AppEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppEvent.ProtoReflect.Descriptor instead.
-func (*AppEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use LoginFido2Request.ProtoReflect.Descriptor instead.
+func (*LoginFido2Request) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{15}
 }
 
-func (m *AppEvent) GetEvent() isAppEvent_Event {
-	if m != nil {
-		return m.Event
+func (x *LoginFido2Request) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *AppEvent) GetInternetStatus() *InternetStatusEvent {
-	if x, ok := x.GetEvent().(*AppEvent_InternetStatus); ok {
-		return x.InternetStatus
+func (x *LoginFido2Request) GetAuthenticatorData() []byte {
+	if x != nil {
+		return x.AuthenticatorData
 	}
 	return nil
 }
 
-func (x *AppEvent) GetToggleAutostartFinished() *ToggleAutostartFinishedEvent {
-	if x, ok := x.GetEvent().(*AppEvent_ToggleAutostartFinished); ok {
-		return x.ToggleAutostartFinished
+func (x *LoginFido2Request) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
 	}
 	return nil
 }
 
-func (x *AppEvent) GetResetFinished() *ResetFinishedEvent {
-	if x, ok := x.GetEvent().(*AppEvent_ResetFinished); ok {
-		return x.ResetFinished
+func (x *LoginFido2Request) GetCredentialID() []byte {
+	if x != nil {
+		return x.CredentialID
 	}
 	return nil
 }
 
-func (x *AppEvent) GetReportBugFinished() *ReportBugFinishedEvent {
-	if x, ok := x.GetEvent().(*AppEvent_ReportBugFinished); ok {
-		return x.ReportBugFinished
-	}
-	return nil
+type LoginFido2LocalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *AppEvent) GetReportBugSuccess() *ReportBugSuccessEvent {
-	if x, ok := x.GetEvent().(*AppEvent_ReportBugSuccess); ok {
-		return x.ReportBugSuccess
+func (x *LoginFido2LocalRequest) Reset() {
+	*x = LoginFido2LocalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *AppEvent) GetReportBugError() *ReportBugErrorEvent {
-	if x, ok := x.GetEvent().(*AppEvent_ReportBugError); ok {
-		return x.ReportBugError
-	}
-	return nil
+func (x *LoginFido2LocalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AppEvent) GetShowMainWindow() *ShowMainWindowEvent {
-	if x, ok := x.GetEvent().(*AppEvent_ShowMainWindow); ok {
-		return x.ShowMainWindow
+func (*LoginFido2LocalRequest) ProtoMessage() {}
+
+func (x *LoginFido2LocalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AppEvent) GetReportBugFallback() *ReportBugFallbackEvent {
-	if x, ok := x.GetEvent().(*AppEvent_ReportBugFallback); ok {
-		return x.ReportBugFallback
-	}
-	return nil
+// Deprecated: Use LoginFido2LocalRequest.ProtoReflect.Descriptor instead.
+func (*LoginFido2LocalRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AppEvent) GetCertificateInstallSuccess() *CertificateInstallSuccessEvent {
-	if x, ok := x.GetEvent().(*AppEvent_CertificateInstallSuccess); ok {
-		return x.CertificateInstallSuccess
+func (x *LoginFido2LocalRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *AppEvent) GetCertificateInstallCanceled() *CertificateInstallCanceledEvent {
-	if x, ok := x.GetEvent().(*AppEvent_CertificateInstallCanceled); ok {
-		return x.CertificateInstallCanceled
-	}
-	return nil
-}
+type LoginAbortRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *AppEvent) GetCertificateInstallFailed() *CertificateInstallFailedEvent {
-	if x, ok := x.GetEvent().(*AppEvent_CertificateInstallFailed); ok {
-		return x.CertificateInstallFailed
-	}
-	return nil
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *AppEvent) GetKnowledgeBaseSuggestions() *KnowledgeBaseSuggestionsEvent {
-	if x, ok := x.GetEvent().(*AppEvent_KnowledgeBaseSuggestions); ok {
-		return x.KnowledgeBaseSuggestions
+func (x *LoginAbortRequest) Reset() {
+	*x = LoginAbortRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *AppEvent) GetRepairStarted() *RepairStartedEvent {
-	if x, ok := x.GetEvent().(*AppEvent_RepairStarted); ok {
-		return x.RepairStarted
-	}
-	return nil
+func (x *LoginAbortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AppEvent) GetAllUsersLoaded() *AllUsersLoadedEvent {
-	if x, ok := x.GetEvent().(*AppEvent_AllUsersLoaded); ok {
-		return x.AllUsersLoaded
-	}
-	return nil
-}
+func (*LoginAbortRequest) ProtoMessage() {}
 
-func (x *AppEvent) GetUserNotification() *UserNotificationEvent {
-	if x, ok := x.GetEvent().(*AppEvent_UserNotification); ok {
-		return x.UserNotification
+func (x *LoginAbortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
-}
-
-type isAppEvent_Event interface {
-	isAppEvent_Event()
-}
-
-type AppEvent_InternetStatus struct {
-	InternetStatus *InternetStatusEvent `protobuf:"bytes,1,opt,name=internetStatus,proto3,oneof"`
-}
-
-type AppEvent_ToggleAutostartFinished struct {
-	ToggleAutostartFinished *ToggleAutostartFinishedEvent `protobuf:"bytes,2,opt,name=toggleAutostartFinished,proto3,oneof"`
-}
-
-type AppEvent_ResetFinished struct {
-	ResetFinished *ResetFinishedEvent `protobuf:"bytes,3,opt,name=resetFinished,proto3,oneof"`
+	return mi.MessageOf(x)
 }
 
-type AppEvent_ReportBugFinished struct {
-	ReportBugFinished *ReportBugFinishedEvent `protobuf:"bytes,4,opt,name=reportBugFinished,proto3,oneof"`
+// Deprecated: Use LoginAbortRequest.ProtoReflect.Descriptor instead.
+func (*LoginAbortRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{17}
 }
 
-type AppEvent_ReportBugSuccess struct {
-	ReportBugSuccess *ReportBugSuccessEvent `protobuf:"bytes,5,opt,name=reportBugSuccess,proto3,oneof"`
+func (x *LoginAbortRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-type AppEvent_ReportBugError struct {
-	ReportBugError *ReportBugErrorEvent `protobuf:"bytes,6,opt,name=reportBugError,proto3,oneof"`
-}
+// **********************************************************
+// Memory budget shared by syncs and message building
+// **********************************************************
+type MemoryUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type AppEvent_ShowMainWindow struct {
-	ShowMainWindow *ShowMainWindowEvent `protobuf:"bytes,7,opt,name=showMainWindow,proto3,oneof"`
+	Used    uint64 `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`
+	Limit   uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Waiting int32  `protobuf:"varint,3,opt,name=waiting,proto3" json:"waiting,omitempty"` // number of tasks queued until enough of the budget is free.
 }
 
-type AppEvent_ReportBugFallback struct {
-	ReportBugFallback *ReportBugFallbackEvent `protobuf:"bytes,8,opt,name=reportBugFallback,proto3,oneof"`
+func (x *MemoryUsageResponse) Reset() {
+	*x = MemoryUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type AppEvent_CertificateInstallSuccess struct {
-	CertificateInstallSuccess *CertificateInstallSuccessEvent `protobuf:"bytes,9,opt,name=certificateInstallSuccess,proto3,oneof"`
+func (x *MemoryUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type AppEvent_CertificateInstallCanceled struct {
-	CertificateInstallCanceled *CertificateInstallCanceledEvent `protobuf:"bytes,10,opt,name=certificateInstallCanceled,proto3,oneof"`
-}
+func (*MemoryUsageResponse) ProtoMessage() {}
 
-type AppEvent_CertificateInstallFailed struct {
-	CertificateInstallFailed *CertificateInstallFailedEvent `protobuf:"bytes,11,opt,name=certificateInstallFailed,proto3,oneof"`
+func (x *MemoryUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type AppEvent_KnowledgeBaseSuggestions struct {
-	KnowledgeBaseSuggestions *KnowledgeBaseSuggestionsEvent `protobuf:"bytes,12,opt,name=knowledgeBaseSuggestions,proto3,oneof"`
+// Deprecated: Use MemoryUsageResponse.ProtoReflect.Descriptor instead.
+func (*MemoryUsageResponse) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{18}
 }
 
-type AppEvent_RepairStarted struct {
-	RepairStarted *RepairStartedEvent `protobuf:"bytes,13,opt,name=repairStarted,proto3,oneof"`
+func (x *MemoryUsageResponse) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
 }
 
-type AppEvent_AllUsersLoaded struct {
-	AllUsersLoaded *AllUsersLoadedEvent `protobuf:"bytes,14,opt,name=allUsersLoaded,proto3,oneof"`
+func (x *MemoryUsageResponse) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-type AppEvent_UserNotification struct {
-	UserNotification *UserNotificationEvent `protobuf:"bytes,15,opt,name=userNotification,proto3,oneof"`
+func (x *MemoryUsageResponse) GetWaiting() int32 {
+	if x != nil {
+		return x.Waiting
+	}
+	return 0
 }
 
-func (*AppEvent_InternetStatus) isAppEvent_Event() {}
-
-func (*AppEvent_ToggleAutostartFinished) isAppEvent_Event() {}
-
-func (*AppEvent_ResetFinished) isAppEvent_Event() {}
-
-func (*AppEvent_ReportBugFinished) isAppEvent_Event() {}
-
-func (*AppEvent_ReportBugSuccess) isAppEvent_Event() {}
-
-func (*AppEvent_ReportBugError) isAppEvent_Event() {}
-
-func (*AppEvent_ShowMainWindow) isAppEvent_Event() {}
-
-func (*AppEvent_ReportBugFallback) isAppEvent_Event() {}
-
-func (*AppEvent_CertificateInstallSuccess) isAppEvent_Event() {}
-
-func (*AppEvent_CertificateInstallCanceled) isAppEvent_Event() {}
-
-func (*AppEvent_CertificateInstallFailed) isAppEvent_Event() {}
-
-func (*AppEvent_KnowledgeBaseSuggestions) isAppEvent_Event() {}
-
-func (*AppEvent_RepairStarted) isAppEvent_Event() {}
-
-func (*AppEvent_AllUsersLoaded) isAppEvent_Event() {}
-
-func (*AppEvent_UserNotification) isAppEvent_Event() {}
-
-type InternetStatusEvent struct {
+// **********************************************************
+// IMAP/SMTP Mail Server settings
+// **********************************************************
+type ImapSmtpSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Connected bool `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
+	ImapPort      int32 `protobuf:"varint,1,opt,name=imapPort,proto3" json:"imapPort,omitempty"`
+	SmtpPort      int32 `protobuf:"varint,2,opt,name=smtpPort,proto3" json:"smtpPort,omitempty"`
+	UseSSLForImap bool  `protobuf:"varint,3,opt,name=useSSLForImap,proto3" json:"useSSLForImap,omitempty"`
+	UseSSLForSmtp bool  `protobuf:"varint,4,opt,name=useSSLForSmtp,proto3" json:"useSSLForSmtp,omitempty"`
 }
 
-func (x *InternetStatusEvent) Reset() {
-	*x = InternetStatusEvent{}
+func (x *ImapSmtpSettings) Reset() {
+	*x = ImapSmtpSettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[15]
+		mi := &file_bridge_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InternetStatusEvent) String() string {
+func (x *ImapSmtpSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternetStatusEvent) ProtoMessage() {}
+func (*ImapSmtpSettings) ProtoMessage() {}
 
-func (x *InternetStatusEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[15]
+func (x *ImapSmtpSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1721,41 +1835,66 @@ func (x *InternetStatusEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternetStatusEvent.ProtoReflect.Descriptor instead.
-func (*InternetStatusEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ImapSmtpSettings.ProtoReflect.Descriptor instead.
+func (*ImapSmtpSettings) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *InternetStatusEvent) GetConnected() bool {
+func (x *ImapSmtpSettings) GetImapPort() int32 {
 	if x != nil {
-		return x.Connected
+		return x.ImapPort
+	}
+	return 0
+}
+
+func (x *ImapSmtpSettings) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *ImapSmtpSettings) GetUseSSLForImap() bool {
+	if x != nil {
+		return x.UseSSLForImap
 	}
 	return false
 }
 
-type ToggleAutostartFinishedEvent struct {
+func (x *ImapSmtpSettings) GetUseSSLForSmtp() bool {
+	if x != nil {
+		return x.UseSSLForSmtp
+	}
+	return false
+}
+
+// Ports of the additional implicit TLS listeners; zero means the listener is disabled.
+type ImplicitTLSPortSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ImapPort int32 `protobuf:"varint,1,opt,name=imapPort,proto3" json:"imapPort,omitempty"`
+	SmtpPort int32 `protobuf:"varint,2,opt,name=smtpPort,proto3" json:"smtpPort,omitempty"`
 }
 
-func (x *ToggleAutostartFinishedEvent) Reset() {
-	*x = ToggleAutostartFinishedEvent{}
+func (x *ImplicitTLSPortSettings) Reset() {
+	*x = ImplicitTLSPortSettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[16]
+		mi := &file_bridge_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ToggleAutostartFinishedEvent) String() string {
+func (x *ImplicitTLSPortSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleAutostartFinishedEvent) ProtoMessage() {}
+func (*ImplicitTLSPortSettings) ProtoMessage() {}
 
-func (x *ToggleAutostartFinishedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[16]
+func (x *ImplicitTLSPortSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1766,34 +1905,52 @@ func (x *ToggleAutostartFinishedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleAutostartFinishedEvent.ProtoReflect.Descriptor instead.
-func (*ToggleAutostartFinishedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ImplicitTLSPortSettings.ProtoReflect.Descriptor instead.
+func (*ImplicitTLSPortSettings) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{20}
 }
 
-type ResetFinishedEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ImplicitTLSPortSettings) GetImapPort() int32 {
+	if x != nil {
+		return x.ImapPort
+	}
+	return 0
 }
 
-func (x *ResetFinishedEvent) Reset() {
-	*x = ResetFinishedEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[17]
+func (x *ImplicitTLSPortSettings) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+// Whether the IMAP and SMTP servers reject authentication over unencrypted connections.
+type SSLRequiredSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imap bool `protobuf:"varint,1,opt,name=imap,proto3" json:"imap,omitempty"`
+	Smtp bool `protobuf:"varint,2,opt,name=smtp,proto3" json:"smtp,omitempty"`
+}
+
+func (x *SSLRequiredSettings) Reset() {
+	*x = SSLRequiredSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResetFinishedEvent) String() string {
+func (x *SSLRequiredSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetFinishedEvent) ProtoMessage() {}
+func (*SSLRequiredSettings) ProtoMessage() {}
 
-func (x *ResetFinishedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[17]
+func (x *SSLRequiredSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1804,34 +1961,52 @@ func (x *ResetFinishedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetFinishedEvent.ProtoReflect.Descriptor instead.
-func (*ResetFinishedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SSLRequiredSettings.ProtoReflect.Descriptor instead.
+func (*SSLRequiredSettings) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{21}
 }
 
-type ReportBugFinishedEvent struct {
+func (x *SSLRequiredSettings) GetImap() bool {
+	if x != nil {
+		return x.Imap
+	}
+	return false
+}
+
+func (x *SSLRequiredSettings) GetSmtp() bool {
+	if x != nil {
+		return x.Smtp
+	}
+	return false
+}
+
+// Certificate and key files used by the IMAP and SMTP servers instead of the self-signed certificate.
+type TLSCertificateFiles struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	CertPath string `protobuf:"bytes,1,opt,name=certPath,proto3" json:"certPath,omitempty"`
+	KeyPath  string `protobuf:"bytes,2,opt,name=keyPath,proto3" json:"keyPath,omitempty"`
 }
 
-func (x *ReportBugFinishedEvent) Reset() {
-	*x = ReportBugFinishedEvent{}
+func (x *TLSCertificateFiles) Reset() {
+	*x = TLSCertificateFiles{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[18]
+		mi := &file_bridge_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReportBugFinishedEvent) String() string {
+func (x *TLSCertificateFiles) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportBugFinishedEvent) ProtoMessage() {}
+func (*TLSCertificateFiles) ProtoMessage() {}
 
-func (x *ReportBugFinishedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[18]
+func (x *TLSCertificateFiles) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1842,34 +2017,52 @@ func (x *ReportBugFinishedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportBugFinishedEvent.ProtoReflect.Descriptor instead.
-func (*ReportBugFinishedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use TLSCertificateFiles.ProtoReflect.Descriptor instead.
+func (*TLSCertificateFiles) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{22}
 }
 
-type ReportBugSuccessEvent struct {
+func (x *TLSCertificateFiles) GetCertPath() string {
+	if x != nil {
+		return x.CertPath
+	}
+	return ""
+}
+
+func (x *TLSCertificateFiles) GetKeyPath() string {
+	if x != nil {
+		return x.KeyPath
+	}
+	return ""
+}
+
+type ConfigurationCheck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name    string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // one of imap-port, smtp-port, imap-implicit-tls-port, smtp-implicit-tls-port, tls-certificate or api.
+	Status  ConfigurationCheckStatus `protobuf:"varint,2,opt,name=status,proto3,enum=grpc.ConfigurationCheckStatus" json:"status,omitempty"`
+	Details string                   `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"` // what was found, and how to fix it if the check didn't pass.
 }
 
-func (x *ReportBugSuccessEvent) Reset() {
-	*x = ReportBugSuccessEvent{}
+func (x *ConfigurationCheck) Reset() {
+	*x = ConfigurationCheck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[19]
+		mi := &file_bridge_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReportBugSuccessEvent) String() string {
+func (x *ConfigurationCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportBugSuccessEvent) ProtoMessage() {}
+func (*ConfigurationCheck) ProtoMessage() {}
 
-func (x *ReportBugSuccessEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[19]
+func (x *ConfigurationCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1880,34 +2073,57 @@ func (x *ReportBugSuccessEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportBugSuccessEvent.ProtoReflect.Descriptor instead.
-func (*ReportBugSuccessEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ConfigurationCheck.ProtoReflect.Descriptor instead.
+func (*ConfigurationCheck) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{23}
 }
 
-type ReportBugErrorEvent struct {
+func (x *ConfigurationCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigurationCheck) GetStatus() ConfigurationCheckStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ConfigurationCheckStatus_CHECK_PASSED
+}
+
+func (x *ConfigurationCheck) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type ConfigurationReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Checks []*ConfigurationCheck `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
 }
 
-func (x *ReportBugErrorEvent) Reset() {
-	*x = ReportBugErrorEvent{}
+func (x *ConfigurationReport) Reset() {
+	*x = ConfigurationReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[20]
+		mi := &file_bridge_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReportBugErrorEvent) String() string {
+func (x *ConfigurationReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportBugErrorEvent) ProtoMessage() {}
+func (*ConfigurationReport) ProtoMessage() {}
 
-func (x *ReportBugErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[20]
+func (x *ConfigurationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,34 +2134,45 @@ func (x *ReportBugErrorEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportBugErrorEvent.ProtoReflect.Descriptor instead.
-func (*ReportBugErrorEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ConfigurationReport.ProtoReflect.Descriptor instead.
+func (*ConfigurationReport) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{24}
 }
 
-type ShowMainWindowEvent struct {
+func (x *ConfigurationReport) GetChecks() []*ConfigurationCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+// Listening on a non-loopback address exposes bridge to other machines and must be acknowledged.
+type BindAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AcknowledgeRisk bool   `protobuf:"varint,2,opt,name=acknowledgeRisk,proto3" json:"acknowledgeRisk,omitempty"`
 }
 
-func (x *ShowMainWindowEvent) Reset() {
-	*x = ShowMainWindowEvent{}
+func (x *BindAddressRequest) Reset() {
+	*x = BindAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[21]
+		mi := &file_bridge_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShowMainWindowEvent) String() string {
+func (x *BindAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShowMainWindowEvent) ProtoMessage() {}
+func (*BindAddressRequest) ProtoMessage() {}
 
-func (x *ShowMainWindowEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[21]
+func (x *BindAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,34 +2183,53 @@ func (x *ShowMainWindowEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShowMainWindowEvent.ProtoReflect.Descriptor instead.
-func (*ShowMainWindowEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use BindAddressRequest.ProtoReflect.Descriptor instead.
+func (*BindAddressRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{25}
 }
 
-type ReportBugFallbackEvent struct {
+func (x *BindAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BindAddressRequest) GetAcknowledgeRisk() bool {
+	if x != nil {
+		return x.AcknowledgeRisk
+	}
+	return false
+}
+
+// **********************************************************
+// Keychain related message
+// **********************************************************
+type AvailableKeychainsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Keychains []string `protobuf:"bytes,1,rep,name=keychains,proto3" json:"keychains,omitempty"`
 }
 
-func (x *ReportBugFallbackEvent) Reset() {
-	*x = ReportBugFallbackEvent{}
+func (x *AvailableKeychainsResponse) Reset() {
+	*x = AvailableKeychainsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[22]
+		mi := &file_bridge_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReportBugFallbackEvent) String() string {
+func (x *AvailableKeychainsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportBugFallbackEvent) ProtoMessage() {}
+func (*AvailableKeychainsResponse) ProtoMessage() {}
 
-func (x *ReportBugFallbackEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[22]
+func (x *AvailableKeychainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1994,34 +2240,47 @@ func (x *ReportBugFallbackEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportBugFallbackEvent.ProtoReflect.Descriptor instead.
-func (*ReportBugFallbackEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use AvailableKeychainsResponse.ProtoReflect.Descriptor instead.
+func (*AvailableKeychainsResponse) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{26}
 }
 
-type CertificateInstallSuccessEvent struct {
+func (x *AvailableKeychainsResponse) GetKeychains() []string {
+	if x != nil {
+		return x.Keychains
+	}
+	return nil
+}
+
+// **********************************************************
+// Bridge events bus related messages
+// **********************************************************
+// Selects the events streamed by SubscribeBridgeEvents; an empty list streams every event.
+type BridgeEventFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"` // event type names, such as "UserLoggedIn", "SyncFinished" or "ConnStatusDown".
 }
 
-func (x *CertificateInstallSuccessEvent) Reset() {
-	*x = CertificateInstallSuccessEvent{}
+func (x *BridgeEventFilter) Reset() {
+	*x = BridgeEventFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[23]
+		mi := &file_bridge_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CertificateInstallSuccessEvent) String() string {
+func (x *BridgeEventFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CertificateInstallSuccessEvent) ProtoMessage() {}
+func (*BridgeEventFilter) ProtoMessage() {}
 
-func (x *CertificateInstallSuccessEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[23]
+func (x *BridgeEventFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2032,34 +2291,47 @@ func (x *CertificateInstallSuccessEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CertificateInstallSuccessEvent.ProtoReflect.Descriptor instead.
-func (*CertificateInstallSuccessEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use BridgeEventFilter.ProtoReflect.Descriptor instead.
+func (*BridgeEventFilter) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{27}
 }
 
-type CertificateInstallCanceledEvent struct {
+func (x *BridgeEventFilter) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// An event published by bridge.
+type BridgeEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`               // the event type name, such as "SyncFinished".
+	Timestamp   int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`    // unix time, in milliseconds, at which the event was published.
+	UserID      string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`           // the user the event relates to, empty if none.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"` // human readable summary of the event and its fields.
 }
 
-func (x *CertificateInstallCanceledEvent) Reset() {
-	*x = CertificateInstallCanceledEvent{}
+func (x *BridgeEvent) Reset() {
+	*x = BridgeEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[24]
+		mi := &file_bridge_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CertificateInstallCanceledEvent) String() string {
+func (x *BridgeEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CertificateInstallCanceledEvent) ProtoMessage() {}
+func (*BridgeEvent) ProtoMessage() {}
 
-func (x *CertificateInstallCanceledEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[24]
+func (x *BridgeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2070,34 +2342,69 @@ func (x *CertificateInstallCanceledEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CertificateInstallCanceledEvent.ProtoReflect.Descriptor instead.
-func (*CertificateInstallCanceledEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use BridgeEvent.ProtoReflect.Descriptor instead.
+func (*BridgeEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{28}
 }
 
-type CertificateInstallFailedEvent struct {
+func (x *BridgeEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BridgeEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BridgeEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BridgeEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// **********************************************************
+// Webhook related messages
+// **********************************************************
+type Webhook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Url    string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Events []string `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	Signed bool     `protobuf:"varint,3,opt,name=signed,proto3" json:"signed,omitempty"` // whether requests are signed with a secret.
 }
 
-func (x *CertificateInstallFailedEvent) Reset() {
-	*x = CertificateInstallFailedEvent{}
+func (x *Webhook) Reset() {
+	*x = Webhook{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[25]
+		mi := &file_bridge_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CertificateInstallFailedEvent) String() string {
+func (x *Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CertificateInstallFailedEvent) ProtoMessage() {}
+func (*Webhook) ProtoMessage() {}
 
-func (x *CertificateInstallFailedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[25]
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2108,34 +2415,58 @@ func (x *CertificateInstallFailedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CertificateInstallFailedEvent.ProtoReflect.Descriptor instead.
-func (*CertificateInstallFailedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{29}
 }
 
-type RepairStartedEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
 }
 
-func (x *RepairStartedEvent) Reset() {
-	*x = RepairStartedEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[26]
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetSigned() bool {
+	if x != nil {
+		return x.Signed
+	}
+	return false
+}
+
+type WebhooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks        []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	AvailableEvents []string   `protobuf:"bytes,2,rep,name=availableEvents,proto3" json:"availableEvents,omitempty"`
+}
+
+func (x *WebhooksResponse) Reset() {
+	*x = WebhooksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepairStartedEvent) String() string {
+func (x *WebhooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepairStartedEvent) ProtoMessage() {}
+func (*WebhooksResponse) ProtoMessage() {}
 
-func (x *RepairStartedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[26]
+func (x *WebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2146,34 +2477,52 @@ func (x *RepairStartedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepairStartedEvent.ProtoReflect.Descriptor instead.
-func (*RepairStartedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use WebhooksResponse.ProtoReflect.Descriptor instead.
+func (*WebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{30}
 }
 
-type AllUsersLoadedEvent struct {
+func (x *WebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *WebhooksResponse) GetAvailableEvents() []string {
+	if x != nil {
+		return x.AvailableEvents
+	}
+	return nil
+}
+
+type AddWebhookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Url    string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Secret string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // optional, requests are signed with it when set.
+	Events []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *AllUsersLoadedEvent) Reset() {
-	*x = AllUsersLoadedEvent{}
+func (x *AddWebhookRequest) Reset() {
+	*x = AddWebhookRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[27]
+		mi := &file_bridge_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AllUsersLoadedEvent) String() string {
+func (x *AddWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllUsersLoadedEvent) ProtoMessage() {}
+func (*AddWebhookRequest) ProtoMessage() {}
 
-func (x *AllUsersLoadedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[27]
+func (x *AddWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2184,37 +2533,71 @@ func (x *AllUsersLoadedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllUsersLoadedEvent.ProtoReflect.Descriptor instead.
-func (*AllUsersLoadedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use AddWebhookRequest.ProtoReflect.Descriptor instead.
+func (*AddWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{31}
 }
 
-type KnowledgeBaseSuggestion struct {
+func (x *AddWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *AddWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url   string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Id              string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username        string          `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	AvatarText      string          `protobuf:"bytes,3,opt,name=avatarText,proto3" json:"avatarText,omitempty"`
+	State           UserState       `protobuf:"varint,4,opt,name=state,proto3,enum=grpc.UserState" json:"state,omitempty"`
+	SplitMode       bool            `protobuf:"varint,5,opt,name=splitMode,proto3" json:"splitMode,omitempty"`
+	UsedBytes       int64           `protobuf:"varint,6,opt,name=usedBytes,proto3" json:"usedBytes,omitempty"`
+	TotalBytes      int64           `protobuf:"varint,7,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	Password        []byte          `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`
+	Addresses       []string        `protobuf:"bytes,9,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	HideAllMail     bool            `protobuf:"varint,10,opt,name=hideAllMail,proto3" json:"hideAllMail,omitempty"`
+	RepairMime      bool            `protobuf:"varint,11,opt,name=repairMime,proto3" json:"repairMime,omitempty"`
+	PendingReauth   bool            `protobuf:"varint,12,opt,name=pendingReauth,proto3" json:"pendingReauth,omitempty"` // the session was revoked; signing in again resumes from the local data.
+	ReadReceiptMode ReadReceiptMode `protobuf:"varint,13,opt,name=readReceiptMode,proto3,enum=grpc.ReadReceiptMode" json:"readReceiptMode,omitempty"`
+	SyncCutoff      int64           `protobuf:"varint,14,opt,name=syncCutoff,proto3" json:"syncCutoff,omitempty"` // messages older than this unix time are not synced. 0 means all messages are synced.
+	DataKept        bool            `protobuf:"varint,15,opt,name=dataKept,proto3" json:"dataKept,omitempty"`     // the user was removed with its local data kept; signing in again re-links it.
 }
 
-func (x *KnowledgeBaseSuggestion) Reset() {
-	*x = KnowledgeBaseSuggestion{}
+func (x *User) Reset() {
+	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[28]
+		mi := &file_bridge_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KnowledgeBaseSuggestion) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KnowledgeBaseSuggestion) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *KnowledgeBaseSuggestion) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[28]
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,50 +2608,142 @@ func (x *KnowledgeBaseSuggestion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KnowledgeBaseSuggestion.ProtoReflect.Descriptor instead.
-func (*KnowledgeBaseSuggestion) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *KnowledgeBaseSuggestion) GetUrl() string {
+func (x *User) GetId() string {
 	if x != nil {
-		return x.Url
+		return x.Id
 	}
 	return ""
 }
 
-func (x *KnowledgeBaseSuggestion) GetTitle() string {
+func (x *User) GetUsername() string {
 	if x != nil {
-		return x.Title
+		return x.Username
 	}
 	return ""
 }
 
-type KnowledgeBaseSuggestionsEvent struct {
+func (x *User) GetAvatarText() string {
+	if x != nil {
+		return x.AvatarText
+	}
+	return ""
+}
+
+func (x *User) GetState() UserState {
+	if x != nil {
+		return x.State
+	}
+	return UserState_SIGNED_OUT
+}
+
+func (x *User) GetSplitMode() bool {
+	if x != nil {
+		return x.SplitMode
+	}
+	return false
+}
+
+func (x *User) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *User) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *User) GetPassword() []byte {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *User) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *User) GetHideAllMail() bool {
+	if x != nil {
+		return x.HideAllMail
+	}
+	return false
+}
+
+func (x *User) GetRepairMime() bool {
+	if x != nil {
+		return x.RepairMime
+	}
+	return false
+}
+
+func (x *User) GetPendingReauth() bool {
+	if x != nil {
+		return x.PendingReauth
+	}
+	return false
+}
+
+func (x *User) GetReadReceiptMode() ReadReceiptMode {
+	if x != nil {
+		return x.ReadReceiptMode
+	}
+	return ReadReceiptMode_READ_RECEIPT_IGNORE
+}
+
+func (x *User) GetSyncCutoff() int64 {
+	if x != nil {
+		return x.SyncCutoff
+	}
+	return 0
+}
+
+func (x *User) GetDataKept() bool {
+	if x != nil {
+		return x.DataKept
+	}
+	return false
+}
+
+type UserSplitModeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Suggestions []*KnowledgeBaseSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Active bool   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
 }
 
-func (x *KnowledgeBaseSuggestionsEvent) Reset() {
-	*x = KnowledgeBaseSuggestionsEvent{}
+func (x *UserSplitModeRequest) Reset() {
+	*x = UserSplitModeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[29]
+		mi := &file_bridge_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KnowledgeBaseSuggestionsEvent) String() string {
+func (x *UserSplitModeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KnowledgeBaseSuggestionsEvent) ProtoMessage() {}
+func (*UserSplitModeRequest) ProtoMessage() {}
 
-func (x *KnowledgeBaseSuggestionsEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[29]
+func (x *UserSplitModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2279,54 +2754,51 @@ func (x *KnowledgeBaseSuggestionsEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KnowledgeBaseSuggestionsEvent.ProtoReflect.Descriptor instead.
-func (*KnowledgeBaseSuggestionsEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use UserSplitModeRequest.ProtoReflect.Descriptor instead.
+func (*UserSplitModeRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *KnowledgeBaseSuggestionsEvent) GetSuggestions() []*KnowledgeBaseSuggestion {
+func (x *UserSplitModeRequest) GetUserID() string {
 	if x != nil {
-		return x.Suggestions
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-// **********************************************************
-// Login related events
-// **********************************************************
-type LoginEvent struct {
+func (x *UserSplitModeRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type UserHideAllMailRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Event:
-	//
-	//	*LoginEvent_Error
-	//	*LoginEvent_TfaRequested
-	//	*LoginEvent_TwoPasswordRequested
-	//	*LoginEvent_Finished
-	//	*LoginEvent_AlreadyLoggedIn
-	//	*LoginEvent_HvRequested
-	Event isLoginEvent_Event `protobuf_oneof:"event"`
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Hide   bool   `protobuf:"varint,2,opt,name=hide,proto3" json:"hide,omitempty"`
 }
 
-func (x *LoginEvent) Reset() {
-	*x = LoginEvent{}
+func (x *UserHideAllMailRequest) Reset() {
+	*x = UserHideAllMailRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[30]
+		mi := &file_bridge_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginEvent) String() string {
+func (x *UserHideAllMailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginEvent) ProtoMessage() {}
+func (*UserHideAllMailRequest) ProtoMessage() {}
 
-func (x *LoginEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[30]
+func (x *UserHideAllMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2337,126 +2809,106 @@ func (x *LoginEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginEvent.ProtoReflect.Descriptor instead.
-func (*LoginEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use UserHideAllMailRequest.ProtoReflect.Descriptor instead.
+func (*UserHideAllMailRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{34}
 }
 
-func (m *LoginEvent) GetEvent() isLoginEvent_Event {
-	if m != nil {
-		return m.Event
+func (x *UserHideAllMailRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-func (x *LoginEvent) GetError() *LoginErrorEvent {
-	if x, ok := x.GetEvent().(*LoginEvent_Error); ok {
-		return x.Error
+func (x *UserHideAllMailRequest) GetHide() bool {
+	if x != nil {
+		return x.Hide
 	}
-	return nil
+	return false
 }
 
-func (x *LoginEvent) GetTfaRequested() *LoginTfaRequestedEvent {
-	if x, ok := x.GetEvent().(*LoginEvent_TfaRequested); ok {
-		return x.TfaRequested
-	}
-	return nil
-}
-
-func (x *LoginEvent) GetTwoPasswordRequested() *LoginTwoPasswordsRequestedEvent {
-	if x, ok := x.GetEvent().(*LoginEvent_TwoPasswordRequested); ok {
-		return x.TwoPasswordRequested
-	}
-	return nil
-}
-
-func (x *LoginEvent) GetFinished() *LoginFinishedEvent {
-	if x, ok := x.GetEvent().(*LoginEvent_Finished); ok {
-		return x.Finished
-	}
-	return nil
-}
+type UserRepairMimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *LoginEvent) GetAlreadyLoggedIn() *LoginFinishedEvent {
-	if x, ok := x.GetEvent().(*LoginEvent_AlreadyLoggedIn); ok {
-		return x.AlreadyLoggedIn
-	}
-	return nil
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Repair bool   `protobuf:"varint,2,opt,name=repair,proto3" json:"repair,omitempty"`
 }
 
-func (x *LoginEvent) GetHvRequested() *LoginHvRequestedEvent {
-	if x, ok := x.GetEvent().(*LoginEvent_HvRequested); ok {
-		return x.HvRequested
+func (x *UserRepairMimeRequest) Reset() {
+	*x = UserRepairMimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
-}
-
-type isLoginEvent_Event interface {
-	isLoginEvent_Event()
 }
 
-type LoginEvent_Error struct {
-	Error *LoginErrorEvent `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+func (x *UserRepairMimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type LoginEvent_TfaRequested struct {
-	TfaRequested *LoginTfaRequestedEvent `protobuf:"bytes,2,opt,name=tfaRequested,proto3,oneof"`
-}
+func (*UserRepairMimeRequest) ProtoMessage() {}
 
-type LoginEvent_TwoPasswordRequested struct {
-	TwoPasswordRequested *LoginTwoPasswordsRequestedEvent `protobuf:"bytes,3,opt,name=twoPasswordRequested,proto3,oneof"`
+func (x *UserRepairMimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type LoginEvent_Finished struct {
-	Finished *LoginFinishedEvent `protobuf:"bytes,4,opt,name=finished,proto3,oneof"`
+// Deprecated: Use UserRepairMimeRequest.ProtoReflect.Descriptor instead.
+func (*UserRepairMimeRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{35}
 }
 
-type LoginEvent_AlreadyLoggedIn struct {
-	AlreadyLoggedIn *LoginFinishedEvent `protobuf:"bytes,5,opt,name=alreadyLoggedIn,proto3,oneof"`
+func (x *UserRepairMimeRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
 }
 
-type LoginEvent_HvRequested struct {
-	HvRequested *LoginHvRequestedEvent `protobuf:"bytes,6,opt,name=hvRequested,proto3,oneof"`
+func (x *UserRepairMimeRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
 }
 
-func (*LoginEvent_Error) isLoginEvent_Event() {}
-
-func (*LoginEvent_TfaRequested) isLoginEvent_Event() {}
-
-func (*LoginEvent_TwoPasswordRequested) isLoginEvent_Event() {}
-
-func (*LoginEvent_Finished) isLoginEvent_Event() {}
-
-func (*LoginEvent_AlreadyLoggedIn) isLoginEvent_Event() {}
-
-func (*LoginEvent_HvRequested) isLoginEvent_Event() {}
-
-type LoginErrorEvent struct {
+type UserReadReceiptModeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    LoginErrorType `protobuf:"varint,1,opt,name=type,proto3,enum=grpc.LoginErrorType" json:"type,omitempty"`
-	Message string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UserID string          `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Mode   ReadReceiptMode `protobuf:"varint,2,opt,name=mode,proto3,enum=grpc.ReadReceiptMode" json:"mode,omitempty"`
 }
 
-func (x *LoginErrorEvent) Reset() {
-	*x = LoginErrorEvent{}
+func (x *UserReadReceiptModeRequest) Reset() {
+	*x = UserReadReceiptModeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[31]
+		mi := &file_bridge_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginErrorEvent) String() string {
+func (x *UserReadReceiptModeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginErrorEvent) ProtoMessage() {}
+func (*UserReadReceiptModeRequest) ProtoMessage() {}
 
-func (x *LoginErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[31]
+func (x *UserReadReceiptModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2467,50 +2919,51 @@ func (x *LoginErrorEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginErrorEvent.ProtoReflect.Descriptor instead.
-func (*LoginErrorEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use UserReadReceiptModeRequest.ProtoReflect.Descriptor instead.
+func (*UserReadReceiptModeRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *LoginErrorEvent) GetType() LoginErrorType {
+func (x *UserReadReceiptModeRequest) GetUserID() string {
 	if x != nil {
-		return x.Type
+		return x.UserID
 	}
-	return LoginErrorType_USERNAME_PASSWORD_ERROR
+	return ""
 }
 
-func (x *LoginErrorEvent) GetMessage() string {
+func (x *UserReadReceiptModeRequest) GetMode() ReadReceiptMode {
 	if x != nil {
-		return x.Message
+		return x.Mode
 	}
-	return ""
+	return ReadReceiptMode_READ_RECEIPT_IGNORE
 }
 
-type LoginTfaRequestedEvent struct {
+type UserReadReceiptRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	MessageID string `protobuf:"bytes,2,opt,name=messageID,proto3" json:"messageID,omitempty"`
 }
 
-func (x *LoginTfaRequestedEvent) Reset() {
-	*x = LoginTfaRequestedEvent{}
+func (x *UserReadReceiptRequest) Reset() {
+	*x = UserReadReceiptRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[32]
+		mi := &file_bridge_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginTfaRequestedEvent) String() string {
+func (x *UserReadReceiptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginTfaRequestedEvent) ProtoMessage() {}
+func (*UserReadReceiptRequest) ProtoMessage() {}
 
-func (x *LoginTfaRequestedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[32]
+func (x *UserReadReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2521,43 +2974,51 @@ func (x *LoginTfaRequestedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginTfaRequestedEvent.ProtoReflect.Descriptor instead.
-func (*LoginTfaRequestedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use UserReadReceiptRequest.ProtoReflect.Descriptor instead.
+func (*UserReadReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *LoginTfaRequestedEvent) GetUsername() string {
+func (x *UserReadReceiptRequest) GetUserID() string {
 	if x != nil {
-		return x.Username
+		return x.UserID
 	}
 	return ""
 }
 
-type LoginTwoPasswordsRequestedEvent struct {
+func (x *UserReadReceiptRequest) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+type UserSyncCutoffRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Cutoff int64  `protobuf:"varint,2,opt,name=cutoff,proto3" json:"cutoff,omitempty"` // unix time. 0 syncs all messages.
 }
 
-func (x *LoginTwoPasswordsRequestedEvent) Reset() {
-	*x = LoginTwoPasswordsRequestedEvent{}
+func (x *UserSyncCutoffRequest) Reset() {
+	*x = UserSyncCutoffRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[33]
+		mi := &file_bridge_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginTwoPasswordsRequestedEvent) String() string {
+func (x *UserSyncCutoffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginTwoPasswordsRequestedEvent) ProtoMessage() {}
+func (*UserSyncCutoffRequest) ProtoMessage() {}
 
-func (x *LoginTwoPasswordsRequestedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[33]
+func (x *UserSyncCutoffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,44 +3029,51 @@ func (x *LoginTwoPasswordsRequestedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginTwoPasswordsRequestedEvent.ProtoReflect.Descriptor instead.
-func (*LoginTwoPasswordsRequestedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use UserSyncCutoffRequest.ProtoReflect.Descriptor instead.
+func (*UserSyncCutoffRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *LoginTwoPasswordsRequestedEvent) GetUsername() string {
+func (x *UserSyncCutoffRequest) GetUserID() string {
 	if x != nil {
-		return x.Username
+		return x.UserID
 	}
 	return ""
 }
 
-type LoginFinishedEvent struct {
+func (x *UserSyncCutoffRequest) GetCutoff() int64 {
+	if x != nil {
+		return x.Cutoff
+	}
+	return 0
+}
+
+type UserBadEventFeedbackRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	WasSignedOut bool   `protobuf:"varint,2,opt,name=wasSignedOut,proto3" json:"wasSignedOut,omitempty"`
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	DoResync bool   `protobuf:"varint,2,opt,name=doResync,proto3" json:"doResync,omitempty"`
 }
 
-func (x *LoginFinishedEvent) Reset() {
-	*x = LoginFinishedEvent{}
+func (x *UserBadEventFeedbackRequest) Reset() {
+	*x = UserBadEventFeedbackRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[34]
+		mi := &file_bridge_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginFinishedEvent) String() string {
+func (x *UserBadEventFeedbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginFinishedEvent) ProtoMessage() {}
+func (*UserBadEventFeedbackRequest) ProtoMessage() {}
 
-func (x *LoginFinishedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[34]
+func (x *UserBadEventFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2616,50 +3084,50 @@ func (x *LoginFinishedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginFinishedEvent.ProtoReflect.Descriptor instead.
-func (*LoginFinishedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use UserBadEventFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*UserBadEventFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *LoginFinishedEvent) GetUserID() string {
+func (x *UserBadEventFeedbackRequest) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *LoginFinishedEvent) GetWasSignedOut() bool {
+func (x *UserBadEventFeedbackRequest) GetDoResync() bool {
 	if x != nil {
-		return x.WasSignedOut
+		return x.DoResync
 	}
 	return false
 }
 
-type LoginHvRequestedEvent struct {
+type UserListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HvUrl string `protobuf:"bytes,1,opt,name=hvUrl,proto3" json:"hvUrl,omitempty"`
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *LoginHvRequestedEvent) Reset() {
-	*x = LoginHvRequestedEvent{}
+func (x *UserListResponse) Reset() {
+	*x = UserListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[35]
+		mi := &file_bridge_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginHvRequestedEvent) String() string {
+func (x *UserListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginHvRequestedEvent) ProtoMessage() {}
+func (*UserListResponse) ProtoMessage() {}
 
-func (x *LoginHvRequestedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[35]
+func (x *UserListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2670,56 +3138,44 @@ func (x *LoginHvRequestedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginHvRequestedEvent.ProtoReflect.Descriptor instead.
-func (*LoginHvRequestedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use UserListResponse.ProtoReflect.Descriptor instead.
+func (*UserListResponse) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *LoginHvRequestedEvent) GetHvUrl() string {
+func (x *UserListResponse) GetUsers() []*User {
 	if x != nil {
-		return x.HvUrl
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-// **********************************************************
-// Update related events
-// **********************************************************
-type UpdateEvent struct {
+type ConfigureAppleMailRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Event:
-	//
-	//	*UpdateEvent_Error
-	//	*UpdateEvent_ManualReady
-	//	*UpdateEvent_ManualRestartNeeded
-	//	*UpdateEvent_Force
-	//	*UpdateEvent_SilentRestartNeeded
-	//	*UpdateEvent_IsLatestVersion
-	//	*UpdateEvent_CheckFinished
-	//	*UpdateEvent_VersionChanged
-	Event isUpdateEvent_Event `protobuf_oneof:"event"`
+	UserID  string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *UpdateEvent) Reset() {
-	*x = UpdateEvent{}
+func (x *ConfigureAppleMailRequest) Reset() {
+	*x = ConfigureAppleMailRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[36]
+		mi := &file_bridge_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateEvent) String() string {
+func (x *ConfigureAppleMailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEvent) ProtoMessage() {}
+func (*ConfigureAppleMailRequest) ProtoMessage() {}
 
-func (x *UpdateEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[36]
+func (x *ConfigureAppleMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2730,151 +3186,168 @@ func (x *UpdateEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEvent.ProtoReflect.Descriptor instead.
-func (*UpdateEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ConfigureAppleMailRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureAppleMailRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{41}
 }
 
-func (m *UpdateEvent) GetEvent() isUpdateEvent_Event {
-	if m != nil {
-		return m.Event
+func (x *ConfigureAppleMailRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateEvent) GetError() *UpdateErrorEvent {
-	if x, ok := x.GetEvent().(*UpdateEvent_Error); ok {
-		return x.Error
+func (x *ConfigureAppleMailRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateEvent) GetManualReady() *UpdateManualReadyEvent {
-	if x, ok := x.GetEvent().(*UpdateEvent_ManualReady); ok {
-		return x.ManualReady
-	}
-	return nil
+type ClientConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  string             `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Address string             `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"` // empty means the primary address.
+	Format  ClientConfigFormat `protobuf:"varint,3,opt,name=format,proto3,enum=grpc.ClientConfigFormat" json:"format,omitempty"`
 }
 
-func (x *UpdateEvent) GetManualRestartNeeded() *UpdateManualRestartNeededEvent {
-	if x, ok := x.GetEvent().(*UpdateEvent_ManualRestartNeeded); ok {
-		return x.ManualRestartNeeded
+func (x *ClientConfigRequest) Reset() {
+	*x = ClientConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *UpdateEvent) GetForce() *UpdateForceEvent {
-	if x, ok := x.GetEvent().(*UpdateEvent_Force); ok {
-		return x.Force
-	}
-	return nil
+func (x *ClientConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateEvent) GetSilentRestartNeeded() *UpdateSilentRestartNeeded {
-	if x, ok := x.GetEvent().(*UpdateEvent_SilentRestartNeeded); ok {
-		return x.SilentRestartNeeded
+func (*ClientConfigRequest) ProtoMessage() {}
+
+func (x *ClientConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateEvent) GetIsLatestVersion() *UpdateIsLatestVersion {
-	if x, ok := x.GetEvent().(*UpdateEvent_IsLatestVersion); ok {
-		return x.IsLatestVersion
-	}
-	return nil
+// Deprecated: Use ClientConfigRequest.ProtoReflect.Descriptor instead.
+func (*ClientConfigRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UpdateEvent) GetCheckFinished() *UpdateCheckFinished {
-	if x, ok := x.GetEvent().(*UpdateEvent_CheckFinished); ok {
-		return x.CheckFinished
+func (x *ClientConfigRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateEvent) GetVersionChanged() *UpdateVersionChanged {
-	if x, ok := x.GetEvent().(*UpdateEvent_VersionChanged); ok {
-		return x.VersionChanged
+func (x *ClientConfigRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type isUpdateEvent_Event interface {
-	isUpdateEvent_Event()
+func (x *ClientConfigRequest) GetFormat() ClientConfigFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ClientConfigFormat_CLIENT_CONFIG_AUTOCONFIG
 }
 
-type UpdateEvent_Error struct {
-	Error *UpdateErrorEvent `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
-}
+type AppPassword struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type UpdateEvent_ManualReady struct {
-	ManualReady *UpdateManualReadyEvent `protobuf:"bytes,2,opt,name=manualReady,proto3,oneof"`
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt int64  `protobuf:"varint,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // unix timestamp, in seconds.
 }
 
-type UpdateEvent_ManualRestartNeeded struct {
-	ManualRestartNeeded *UpdateManualRestartNeededEvent `protobuf:"bytes,3,opt,name=manualRestartNeeded,proto3,oneof"`
+func (x *AppPassword) Reset() {
+	*x = AppPassword{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type UpdateEvent_Force struct {
-	Force *UpdateForceEvent `protobuf:"bytes,4,opt,name=force,proto3,oneof"`
+func (x *AppPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type UpdateEvent_SilentRestartNeeded struct {
-	SilentRestartNeeded *UpdateSilentRestartNeeded `protobuf:"bytes,5,opt,name=silentRestartNeeded,proto3,oneof"`
-}
+func (*AppPassword) ProtoMessage() {}
 
-type UpdateEvent_IsLatestVersion struct {
-	IsLatestVersion *UpdateIsLatestVersion `protobuf:"bytes,6,opt,name=isLatestVersion,proto3,oneof"`
+func (x *AppPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type UpdateEvent_CheckFinished struct {
-	CheckFinished *UpdateCheckFinished `protobuf:"bytes,7,opt,name=checkFinished,proto3,oneof"`
+// Deprecated: Use AppPassword.ProtoReflect.Descriptor instead.
+func (*AppPassword) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{43}
 }
 
-type UpdateEvent_VersionChanged struct {
-	VersionChanged *UpdateVersionChanged `protobuf:"bytes,8,opt,name=versionChanged,proto3,oneof"`
+func (x *AppPassword) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*UpdateEvent_Error) isUpdateEvent_Event() {}
-
-func (*UpdateEvent_ManualReady) isUpdateEvent_Event() {}
-
-func (*UpdateEvent_ManualRestartNeeded) isUpdateEvent_Event() {}
-
-func (*UpdateEvent_Force) isUpdateEvent_Event() {}
-
-func (*UpdateEvent_SilentRestartNeeded) isUpdateEvent_Event() {}
-
-func (*UpdateEvent_IsLatestVersion) isUpdateEvent_Event() {}
-
-func (*UpdateEvent_CheckFinished) isUpdateEvent_Event() {}
-
-func (*UpdateEvent_VersionChanged) isUpdateEvent_Event() {}
+func (x *AppPassword) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
 
-type UpdateErrorEvent struct {
+type AppPasswordsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type UpdateErrorType `protobuf:"varint,1,opt,name=type,proto3,enum=grpc.UpdateErrorType" json:"type,omitempty"`
+	AppPasswords []*AppPassword `protobuf:"bytes,1,rep,name=appPasswords,proto3" json:"appPasswords,omitempty"`
 }
 
-func (x *UpdateErrorEvent) Reset() {
-	*x = UpdateErrorEvent{}
+func (x *AppPasswordsResponse) Reset() {
+	*x = AppPasswordsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[37]
+		mi := &file_bridge_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateErrorEvent) String() string {
+func (x *AppPasswordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateErrorEvent) ProtoMessage() {}
+func (*AppPasswordsResponse) ProtoMessage() {}
 
-func (x *UpdateErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[37]
+func (x *AppPasswordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2885,43 +3358,44 @@ func (x *UpdateErrorEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateErrorEvent.ProtoReflect.Descriptor instead.
-func (*UpdateErrorEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use AppPasswordsResponse.ProtoReflect.Descriptor instead.
+func (*AppPasswordsResponse) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UpdateErrorEvent) GetType() UpdateErrorType {
+func (x *AppPasswordsResponse) GetAppPasswords() []*AppPassword {
 	if x != nil {
-		return x.Type
+		return x.AppPasswords
 	}
-	return UpdateErrorType_UPDATE_MANUAL_ERROR
+	return nil
 }
 
-type UpdateManualReadyEvent struct {
+type AppPasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *UpdateManualReadyEvent) Reset() {
-	*x = UpdateManualReadyEvent{}
+func (x *AppPasswordRequest) Reset() {
+	*x = AppPasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[38]
+		mi := &file_bridge_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateManualReadyEvent) String() string {
+func (x *AppPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateManualReadyEvent) ProtoMessage() {}
+func (*AppPasswordRequest) ProtoMessage() {}
 
-func (x *UpdateManualReadyEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[38]
+func (x *AppPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2932,41 +3406,51 @@ func (x *UpdateManualReadyEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateManualReadyEvent.ProtoReflect.Descriptor instead.
-func (*UpdateManualReadyEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use AppPasswordRequest.ProtoReflect.Descriptor instead.
+func (*AppPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UpdateManualReadyEvent) GetVersion() string {
+func (x *AppPasswordRequest) GetUserID() string {
 	if x != nil {
-		return x.Version
+		return x.UserID
 	}
 	return ""
 }
 
-type UpdateManualRestartNeededEvent struct {
+func (x *AppPasswordRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AuthTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserID          string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ValiditySeconds int64  `protobuf:"varint,2,opt,name=validitySeconds,proto3" json:"validitySeconds,omitempty"` // 0 means the default validity.
 }
 
-func (x *UpdateManualRestartNeededEvent) Reset() {
-	*x = UpdateManualRestartNeededEvent{}
+func (x *AuthTokenRequest) Reset() {
+	*x = AuthTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[39]
+		mi := &file_bridge_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateManualRestartNeededEvent) String() string {
+func (x *AuthTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateManualRestartNeededEvent) ProtoMessage() {}
+func (*AuthTokenRequest) ProtoMessage() {}
 
-func (x *UpdateManualRestartNeededEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[39]
+func (x *AuthTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2977,36 +3461,52 @@ func (x *UpdateManualRestartNeededEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateManualRestartNeededEvent.ProtoReflect.Descriptor instead.
-func (*UpdateManualRestartNeededEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use AuthTokenRequest.ProtoReflect.Descriptor instead.
+func (*AuthTokenRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{46}
 }
 
-type UpdateForceEvent struct {
+func (x *AuthTokenRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AuthTokenRequest) GetValiditySeconds() int64 {
+	if x != nil {
+		return x.ValiditySeconds
+	}
+	return 0
+}
+
+type UserEventJournalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Search     string `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`          // only entries whose ID, subject or sender contain it are returned; empty means all.
+	MaxEntries int32  `protobuf:"varint,3,opt,name=maxEntries,proto3" json:"maxEntries,omitempty"` // only the most recent entries are returned; 0 means all.
 }
 
-func (x *UpdateForceEvent) Reset() {
-	*x = UpdateForceEvent{}
+func (x *UserEventJournalRequest) Reset() {
+	*x = UserEventJournalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[40]
+		mi := &file_bridge_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateForceEvent) String() string {
+func (x *UserEventJournalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateForceEvent) ProtoMessage() {}
+func (*UserEventJournalRequest) ProtoMessage() {}
 
-func (x *UpdateForceEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[40]
+func (x *UserEventJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3017,41 +3517,66 @@ func (x *UpdateForceEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateForceEvent.ProtoReflect.Descriptor instead.
-func (*UpdateForceEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use UserEventJournalRequest.ProtoReflect.Descriptor instead.
+func (*UserEventJournalRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UpdateForceEvent) GetVersion() string {
+func (x *UserEventJournalRequest) GetUserID() string {
 	if x != nil {
-		return x.Version
+		return x.UserID
 	}
 	return ""
 }
 
-type UpdateSilentRestartNeeded struct {
+func (x *UserEventJournalRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *UserEventJournalRequest) GetMaxEntries() int32 {
+	if x != nil {
+		return x.MaxEntries
+	}
+	return 0
+}
+
+type EventJournalEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Time          int64    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"` // unix timestamp, in seconds.
+	EventID       string   `protobuf:"bytes,2,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Kind          string   `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`     // message, label or refresh.
+	Action        string   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // created, updated or deleted.
+	Id            string   `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Subject       string   `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"` // the subject of a message or the name of a label.
+	Sender        string   `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+	Labels        []string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`
+	AddedLabels   []string `protobuf:"bytes,9,rep,name=addedLabels,proto3" json:"addedLabels,omitempty"`
+	RemovedLabels []string `protobuf:"bytes,10,rep,name=removedLabels,proto3" json:"removedLabels,omitempty"`
 }
 
-func (x *UpdateSilentRestartNeeded) Reset() {
-	*x = UpdateSilentRestartNeeded{}
+func (x *EventJournalEntry) Reset() {
+	*x = EventJournalEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[41]
+		mi := &file_bridge_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateSilentRestartNeeded) String() string {
+func (x *EventJournalEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSilentRestartNeeded) ProtoMessage() {}
+func (*EventJournalEntry) ProtoMessage() {}
 
-func (x *UpdateSilentRestartNeeded) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[41]
+func (x *EventJournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3062,72 +3587,106 @@ func (x *UpdateSilentRestartNeeded) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSilentRestartNeeded.ProtoReflect.Descriptor instead.
-func (*UpdateSilentRestartNeeded) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use EventJournalEntry.ProtoReflect.Descriptor instead.
+func (*EventJournalEntry) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{48}
 }
 
-type UpdateIsLatestVersion struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *EventJournalEntry) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
 }
 
-func (x *UpdateIsLatestVersion) Reset() {
-	*x = UpdateIsLatestVersion{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *EventJournalEntry) GetEventID() string {
+	if x != nil {
+		return x.EventID
 	}
+	return ""
 }
 
-func (x *UpdateIsLatestVersion) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventJournalEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
 }
 
-func (*UpdateIsLatestVersion) ProtoMessage() {}
+func (x *EventJournalEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
 
-func (x *UpdateIsLatestVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *EventJournalEntry) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateIsLatestVersion.ProtoReflect.Descriptor instead.
-func (*UpdateIsLatestVersion) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{42}
+func (x *EventJournalEntry) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
 }
 
-type UpdateCheckFinished struct {
+func (x *EventJournalEntry) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventJournalEntry) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *EventJournalEntry) GetAddedLabels() []string {
+	if x != nil {
+		return x.AddedLabels
+	}
+	return nil
+}
+
+func (x *EventJournalEntry) GetRemovedLabels() []string {
+	if x != nil {
+		return x.RemovedLabels
+	}
+	return nil
+}
+
+type UserEventJournalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Entries []*EventJournalEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // from the oldest to the most recent.
 }
 
-func (x *UpdateCheckFinished) Reset() {
-	*x = UpdateCheckFinished{}
+func (x *UserEventJournalResponse) Reset() {
+	*x = UserEventJournalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[43]
+		mi := &file_bridge_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateCheckFinished) String() string {
+func (x *UserEventJournalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCheckFinished) ProtoMessage() {}
+func (*UserEventJournalResponse) ProtoMessage() {}
 
-func (x *UpdateCheckFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[43]
+func (x *UserEventJournalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3138,34 +3697,43 @@ func (x *UpdateCheckFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCheckFinished.ProtoReflect.Descriptor instead.
-func (*UpdateCheckFinished) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use UserEventJournalResponse.ProtoReflect.Descriptor instead.
+func (*UserEventJournalResponse) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{49}
 }
 
-type UpdateVersionChanged struct {
+func (x *UserEventJournalResponse) GetEntries() []*EventJournalEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type EventStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ClientPlatform string `protobuf:"bytes,1,opt,name=ClientPlatform,proto3" json:"ClientPlatform,omitempty"`
 }
 
-func (x *UpdateVersionChanged) Reset() {
-	*x = UpdateVersionChanged{}
+func (x *EventStreamRequest) Reset() {
+	*x = EventStreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[44]
+		mi := &file_bridge_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateVersionChanged) String() string {
+func (x *EventStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVersionChanged) ProtoMessage() {}
+func (*EventStreamRequest) ProtoMessage() {}
 
-func (x *UpdateVersionChanged) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[44]
+func (x *EventStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3176,44 +3744,54 @@ func (x *UpdateVersionChanged) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVersionChanged.ProtoReflect.Descriptor instead.
-func (*UpdateVersionChanged) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use EventStreamRequest.ProtoReflect.Descriptor instead.
+func (*EventStreamRequest) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{50}
 }
 
-// **********************************************************
-// Cache on disk related events
-// **********************************************************
-type DiskCacheEvent struct {
+func (x *EventStreamRequest) GetClientPlatform() string {
+	if x != nil {
+		return x.ClientPlatform
+	}
+	return ""
+}
+
+type StreamEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Event:
 	//
-	//	*DiskCacheEvent_Error
-	//	*DiskCacheEvent_PathChanged
-	//	*DiskCacheEvent_PathChangeFinished
-	Event isDiskCacheEvent_Event `protobuf_oneof:"event"`
+	//	*StreamEvent_App
+	//	*StreamEvent_Login
+	//	*StreamEvent_Update
+	//	*StreamEvent_Cache
+	//	*StreamEvent_MailServerSettings
+	//	*StreamEvent_Keychain
+	//	*StreamEvent_Mail
+	//	*StreamEvent_User
+	//	*StreamEvent_GenericError
+	Event isStreamEvent_Event `protobuf_oneof:"event"`
 }
 
-func (x *DiskCacheEvent) Reset() {
-	*x = DiskCacheEvent{}
+func (x *StreamEvent) Reset() {
+	*x = StreamEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[45]
+		mi := &file_bridge_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DiskCacheEvent) String() string {
+func (x *StreamEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskCacheEvent) ProtoMessage() {}
+func (*StreamEvent) ProtoMessage() {}
 
-func (x *DiskCacheEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[45]
+func (x *StreamEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3224,133 +3802,189 @@ func (x *DiskCacheEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskCacheEvent.ProtoReflect.Descriptor instead.
-func (*DiskCacheEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use StreamEvent.ProtoReflect.Descriptor instead.
+func (*StreamEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{51}
 }
 
-func (m *DiskCacheEvent) GetEvent() isDiskCacheEvent_Event {
+func (m *StreamEvent) GetEvent() isStreamEvent_Event {
 	if m != nil {
 		return m.Event
 	}
 	return nil
 }
 
-func (x *DiskCacheEvent) GetError() *DiskCacheErrorEvent {
-	if x, ok := x.GetEvent().(*DiskCacheEvent_Error); ok {
-		return x.Error
+func (x *StreamEvent) GetApp() *AppEvent {
+	if x, ok := x.GetEvent().(*StreamEvent_App); ok {
+		return x.App
 	}
 	return nil
 }
 
-func (x *DiskCacheEvent) GetPathChanged() *DiskCachePathChangedEvent {
-	if x, ok := x.GetEvent().(*DiskCacheEvent_PathChanged); ok {
-		return x.PathChanged
+func (x *StreamEvent) GetLogin() *LoginEvent {
+	if x, ok := x.GetEvent().(*StreamEvent_Login); ok {
+		return x.Login
 	}
 	return nil
 }
 
-func (x *DiskCacheEvent) GetPathChangeFinished() *DiskCachePathChangeFinishedEvent {
-	if x, ok := x.GetEvent().(*DiskCacheEvent_PathChangeFinished); ok {
-		return x.PathChangeFinished
+func (x *StreamEvent) GetUpdate() *UpdateEvent {
+	if x, ok := x.GetEvent().(*StreamEvent_Update); ok {
+		return x.Update
 	}
 	return nil
 }
 
-type isDiskCacheEvent_Event interface {
-	isDiskCacheEvent_Event()
+func (x *StreamEvent) GetCache() *DiskCacheEvent {
+	if x, ok := x.GetEvent().(*StreamEvent_Cache); ok {
+		return x.Cache
+	}
+	return nil
 }
 
-type DiskCacheEvent_Error struct {
-	Error *DiskCacheErrorEvent `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+func (x *StreamEvent) GetMailServerSettings() *MailServerSettingsEvent {
+	if x, ok := x.GetEvent().(*StreamEvent_MailServerSettings); ok {
+		return x.MailServerSettings
+	}
+	return nil
 }
 
-type DiskCacheEvent_PathChanged struct {
-	PathChanged *DiskCachePathChangedEvent `protobuf:"bytes,2,opt,name=pathChanged,proto3,oneof"`
+func (x *StreamEvent) GetKeychain() *KeychainEvent {
+	if x, ok := x.GetEvent().(*StreamEvent_Keychain); ok {
+		return x.Keychain
+	}
+	return nil
 }
 
-type DiskCacheEvent_PathChangeFinished struct {
-	PathChangeFinished *DiskCachePathChangeFinishedEvent `protobuf:"bytes,3,opt,name=pathChangeFinished,proto3,oneof"`
+func (x *StreamEvent) GetMail() *MailEvent {
+	if x, ok := x.GetEvent().(*StreamEvent_Mail); ok {
+		return x.Mail
+	}
+	return nil
 }
 
-func (*DiskCacheEvent_Error) isDiskCacheEvent_Event() {}
+func (x *StreamEvent) GetUser() *UserEvent {
+	if x, ok := x.GetEvent().(*StreamEvent_User); ok {
+		return x.User
+	}
+	return nil
+}
 
-func (*DiskCacheEvent_PathChanged) isDiskCacheEvent_Event() {}
+func (x *StreamEvent) GetGenericError() *GenericErrorEvent {
+	if x, ok := x.GetEvent().(*StreamEvent_GenericError); ok {
+		return x.GenericError
+	}
+	return nil
+}
 
-func (*DiskCacheEvent_PathChangeFinished) isDiskCacheEvent_Event() {}
+type isStreamEvent_Event interface {
+	isStreamEvent_Event()
+}
 
-type DiskCacheErrorEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type StreamEvent_App struct {
+	App *AppEvent `protobuf:"bytes,1,opt,name=app,proto3,oneof"`
+}
 
-	Type DiskCacheErrorType `protobuf:"varint,1,opt,name=type,proto3,enum=grpc.DiskCacheErrorType" json:"type,omitempty"`
+type StreamEvent_Login struct {
+	Login *LoginEvent `protobuf:"bytes,2,opt,name=login,proto3,oneof"`
 }
 
-func (x *DiskCacheErrorEvent) Reset() {
-	*x = DiskCacheErrorEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[46]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type StreamEvent_Update struct {
+	Update *UpdateEvent `protobuf:"bytes,3,opt,name=update,proto3,oneof"`
 }
 
-func (x *DiskCacheErrorEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type StreamEvent_Cache struct {
+	Cache *DiskCacheEvent `protobuf:"bytes,4,opt,name=cache,proto3,oneof"`
 }
 
-func (*DiskCacheErrorEvent) ProtoMessage() {}
+type StreamEvent_MailServerSettings struct {
+	MailServerSettings *MailServerSettingsEvent `protobuf:"bytes,5,opt,name=mailServerSettings,proto3,oneof"`
+}
 
-func (x *DiskCacheErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[46]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type StreamEvent_Keychain struct {
+	Keychain *KeychainEvent `protobuf:"bytes,6,opt,name=keychain,proto3,oneof"`
 }
 
-// Deprecated: Use DiskCacheErrorEvent.ProtoReflect.Descriptor instead.
-func (*DiskCacheErrorEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{46}
+type StreamEvent_Mail struct {
+	Mail *MailEvent `protobuf:"bytes,7,opt,name=mail,proto3,oneof"`
 }
 
-func (x *DiskCacheErrorEvent) GetType() DiskCacheErrorType {
-	if x != nil {
-		return x.Type
-	}
-	return DiskCacheErrorType_CANT_MOVE_DISK_CACHE_ERROR
+type StreamEvent_User struct {
+	User *UserEvent `protobuf:"bytes,8,opt,name=user,proto3,oneof"`
 }
 
-type DiskCachePathChangedEvent struct {
+type StreamEvent_GenericError struct {
+	GenericError *GenericErrorEvent `protobuf:"bytes,9,opt,name=genericError,proto3,oneof"`
+}
+
+func (*StreamEvent_App) isStreamEvent_Event() {}
+
+func (*StreamEvent_Login) isStreamEvent_Event() {}
+
+func (*StreamEvent_Update) isStreamEvent_Event() {}
+
+func (*StreamEvent_Cache) isStreamEvent_Event() {}
+
+func (*StreamEvent_MailServerSettings) isStreamEvent_Event() {}
+
+func (*StreamEvent_Keychain) isStreamEvent_Event() {}
+
+func (*StreamEvent_Mail) isStreamEvent_Event() {}
+
+func (*StreamEvent_User) isStreamEvent_Event() {}
+
+func (*StreamEvent_GenericError) isStreamEvent_Event() {}
+
+// **********************************************************
+// App related events
+// **********************************************************
+type AppEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Types that are assignable to Event:
+	//
+	//	*AppEvent_InternetStatus
+	//	*AppEvent_ToggleAutostartFinished
+	//	*AppEvent_ResetFinished
+	//	*AppEvent_ReportBugFinished
+	//	*AppEvent_ReportBugSuccess
+	//	*AppEvent_ReportBugError
+	//	*AppEvent_ShowMainWindow
+	//	*AppEvent_ReportBugFallback
+	//	*AppEvent_CertificateInstallSuccess
+	//	*AppEvent_CertificateInstallCanceled
+	//	*AppEvent_CertificateInstallFailed
+	//	*AppEvent_KnowledgeBaseSuggestions
+	//	*AppEvent_RepairStarted
+	//	*AppEvent_AllUsersLoaded
+	//	*AppEvent_UserNotification
+	//	*AppEvent_BridgeLocked
+	//	*AppEvent_BridgeUnlocked
+	//	*AppEvent_ApiRateLimited
+	//	*AppEvent_KeyRotationStarted
+	//	*AppEvent_KeyRotationFinished
+	Event isAppEvent_Event `protobuf_oneof:"event"`
 }
 
-func (x *DiskCachePathChangedEvent) Reset() {
-	*x = DiskCachePathChangedEvent{}
+func (x *AppEvent) Reset() {
+	*x = AppEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[47]
+		mi := &file_bridge_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DiskCachePathChangedEvent) String() string {
+func (x *AppEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskCachePathChangedEvent) ProtoMessage() {}
+func (*AppEvent) ProtoMessage() {}
 
-func (x *DiskCachePathChangedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[47]
+func (x *AppEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3361,304 +3995,292 @@ func (x *DiskCachePathChangedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskCachePathChangedEvent.ProtoReflect.Descriptor instead.
-func (*DiskCachePathChangedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use AppEvent.ProtoReflect.Descriptor instead.
+func (*AppEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *DiskCachePathChangedEvent) GetPath() string {
-	if x != nil {
-		return x.Path
+func (m *AppEvent) GetEvent() isAppEvent_Event {
+	if m != nil {
+		return m.Event
 	}
-	return ""
+	return nil
 }
 
-type DiskCachePathChangeFinishedEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AppEvent) GetInternetStatus() *InternetStatusEvent {
+	if x, ok := x.GetEvent().(*AppEvent_InternetStatus); ok {
+		return x.InternetStatus
+	}
+	return nil
 }
 
-func (x *DiskCachePathChangeFinishedEvent) Reset() {
-	*x = DiskCachePathChangeFinishedEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[48]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AppEvent) GetToggleAutostartFinished() *ToggleAutostartFinishedEvent {
+	if x, ok := x.GetEvent().(*AppEvent_ToggleAutostartFinished); ok {
+		return x.ToggleAutostartFinished
 	}
+	return nil
 }
 
-func (x *DiskCachePathChangeFinishedEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AppEvent) GetResetFinished() *ResetFinishedEvent {
+	if x, ok := x.GetEvent().(*AppEvent_ResetFinished); ok {
+		return x.ResetFinished
+	}
+	return nil
 }
 
-func (*DiskCachePathChangeFinishedEvent) ProtoMessage() {}
-
-func (x *DiskCachePathChangeFinishedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[48]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AppEvent) GetReportBugFinished() *ReportBugFinishedEvent {
+	if x, ok := x.GetEvent().(*AppEvent_ReportBugFinished); ok {
+		return x.ReportBugFinished
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DiskCachePathChangeFinishedEvent.ProtoReflect.Descriptor instead.
-func (*DiskCachePathChangeFinishedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{48}
+func (x *AppEvent) GetReportBugSuccess() *ReportBugSuccessEvent {
+	if x, ok := x.GetEvent().(*AppEvent_ReportBugSuccess); ok {
+		return x.ReportBugSuccess
+	}
+	return nil
 }
 
-// **********************************************************
-// Mail server settings related events
-// **********************************************************
-type MailServerSettingsEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AppEvent) GetReportBugError() *ReportBugErrorEvent {
+	if x, ok := x.GetEvent().(*AppEvent_ReportBugError); ok {
+		return x.ReportBugError
+	}
+	return nil
+}
 
-	// Types that are assignable to Event:
-	//
-	//	*MailServerSettingsEvent_Error
-	//	*MailServerSettingsEvent_MailServerSettingsChanged
-	//	*MailServerSettingsEvent_ChangeMailServerSettingsFinished
-	Event isMailServerSettingsEvent_Event `protobuf_oneof:"event"`
+func (x *AppEvent) GetShowMainWindow() *ShowMainWindowEvent {
+	if x, ok := x.GetEvent().(*AppEvent_ShowMainWindow); ok {
+		return x.ShowMainWindow
+	}
+	return nil
 }
 
-func (x *MailServerSettingsEvent) Reset() {
-	*x = MailServerSettingsEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[49]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AppEvent) GetReportBugFallback() *ReportBugFallbackEvent {
+	if x, ok := x.GetEvent().(*AppEvent_ReportBugFallback); ok {
+		return x.ReportBugFallback
 	}
+	return nil
 }
 
-func (x *MailServerSettingsEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AppEvent) GetCertificateInstallSuccess() *CertificateInstallSuccessEvent {
+	if x, ok := x.GetEvent().(*AppEvent_CertificateInstallSuccess); ok {
+		return x.CertificateInstallSuccess
+	}
+	return nil
 }
 
-func (*MailServerSettingsEvent) ProtoMessage() {}
+func (x *AppEvent) GetCertificateInstallCanceled() *CertificateInstallCanceledEvent {
+	if x, ok := x.GetEvent().(*AppEvent_CertificateInstallCanceled); ok {
+		return x.CertificateInstallCanceled
+	}
+	return nil
+}
 
-func (x *MailServerSettingsEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[49]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AppEvent) GetCertificateInstallFailed() *CertificateInstallFailedEvent {
+	if x, ok := x.GetEvent().(*AppEvent_CertificateInstallFailed); ok {
+		return x.CertificateInstallFailed
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use MailServerSettingsEvent.ProtoReflect.Descriptor instead.
-func (*MailServerSettingsEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{49}
+func (x *AppEvent) GetKnowledgeBaseSuggestions() *KnowledgeBaseSuggestionsEvent {
+	if x, ok := x.GetEvent().(*AppEvent_KnowledgeBaseSuggestions); ok {
+		return x.KnowledgeBaseSuggestions
+	}
+	return nil
 }
 
-func (m *MailServerSettingsEvent) GetEvent() isMailServerSettingsEvent_Event {
-	if m != nil {
-		return m.Event
+func (x *AppEvent) GetRepairStarted() *RepairStartedEvent {
+	if x, ok := x.GetEvent().(*AppEvent_RepairStarted); ok {
+		return x.RepairStarted
 	}
 	return nil
 }
 
-func (x *MailServerSettingsEvent) GetError() *MailServerSettingsErrorEvent {
-	if x, ok := x.GetEvent().(*MailServerSettingsEvent_Error); ok {
-		return x.Error
+func (x *AppEvent) GetAllUsersLoaded() *AllUsersLoadedEvent {
+	if x, ok := x.GetEvent().(*AppEvent_AllUsersLoaded); ok {
+		return x.AllUsersLoaded
 	}
 	return nil
 }
 
-func (x *MailServerSettingsEvent) GetMailServerSettingsChanged() *MailServerSettingsChangedEvent {
-	if x, ok := x.GetEvent().(*MailServerSettingsEvent_MailServerSettingsChanged); ok {
-		return x.MailServerSettingsChanged
+func (x *AppEvent) GetUserNotification() *UserNotificationEvent {
+	if x, ok := x.GetEvent().(*AppEvent_UserNotification); ok {
+		return x.UserNotification
 	}
 	return nil
 }
 
-func (x *MailServerSettingsEvent) GetChangeMailServerSettingsFinished() *ChangeMailServerSettingsFinishedEvent {
-	if x, ok := x.GetEvent().(*MailServerSettingsEvent_ChangeMailServerSettingsFinished); ok {
-		return x.ChangeMailServerSettingsFinished
+func (x *AppEvent) GetBridgeLocked() *BridgeLockedEvent {
+	if x, ok := x.GetEvent().(*AppEvent_BridgeLocked); ok {
+		return x.BridgeLocked
 	}
 	return nil
 }
 
-type isMailServerSettingsEvent_Event interface {
-	isMailServerSettingsEvent_Event()
+func (x *AppEvent) GetBridgeUnlocked() *BridgeUnlockedEvent {
+	if x, ok := x.GetEvent().(*AppEvent_BridgeUnlocked); ok {
+		return x.BridgeUnlocked
+	}
+	return nil
 }
 
-type MailServerSettingsEvent_Error struct {
-	Error *MailServerSettingsErrorEvent `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+func (x *AppEvent) GetApiRateLimited() *APIRateLimitedEvent {
+	if x, ok := x.GetEvent().(*AppEvent_ApiRateLimited); ok {
+		return x.ApiRateLimited
+	}
+	return nil
 }
 
-type MailServerSettingsEvent_MailServerSettingsChanged struct {
-	MailServerSettingsChanged *MailServerSettingsChangedEvent `protobuf:"bytes,2,opt,name=mailServerSettingsChanged,proto3,oneof"`
+func (x *AppEvent) GetKeyRotationStarted() *KeyRotationStartedEvent {
+	if x, ok := x.GetEvent().(*AppEvent_KeyRotationStarted); ok {
+		return x.KeyRotationStarted
+	}
+	return nil
 }
 
-type MailServerSettingsEvent_ChangeMailServerSettingsFinished struct {
-	ChangeMailServerSettingsFinished *ChangeMailServerSettingsFinishedEvent `protobuf:"bytes,3,opt,name=changeMailServerSettingsFinished,proto3,oneof"`
+func (x *AppEvent) GetKeyRotationFinished() *KeyRotationFinishedEvent {
+	if x, ok := x.GetEvent().(*AppEvent_KeyRotationFinished); ok {
+		return x.KeyRotationFinished
+	}
+	return nil
 }
 
-func (*MailServerSettingsEvent_Error) isMailServerSettingsEvent_Event() {}
+type isAppEvent_Event interface {
+	isAppEvent_Event()
+}
 
-func (*MailServerSettingsEvent_MailServerSettingsChanged) isMailServerSettingsEvent_Event() {}
+type AppEvent_InternetStatus struct {
+	InternetStatus *InternetStatusEvent `protobuf:"bytes,1,opt,name=internetStatus,proto3,oneof"`
+}
 
-func (*MailServerSettingsEvent_ChangeMailServerSettingsFinished) isMailServerSettingsEvent_Event() {}
+type AppEvent_ToggleAutostartFinished struct {
+	ToggleAutostartFinished *ToggleAutostartFinishedEvent `protobuf:"bytes,2,opt,name=toggleAutostartFinished,proto3,oneof"`
+}
 
-type MailServerSettingsErrorEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type AppEvent_ResetFinished struct {
+	ResetFinished *ResetFinishedEvent `protobuf:"bytes,3,opt,name=resetFinished,proto3,oneof"`
+}
 
-	Type MailServerSettingsErrorType `protobuf:"varint,1,opt,name=type,proto3,enum=grpc.MailServerSettingsErrorType" json:"type,omitempty"`
+type AppEvent_ReportBugFinished struct {
+	ReportBugFinished *ReportBugFinishedEvent `protobuf:"bytes,4,opt,name=reportBugFinished,proto3,oneof"`
 }
 
-func (x *MailServerSettingsErrorEvent) Reset() {
-	*x = MailServerSettingsErrorEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[50]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type AppEvent_ReportBugSuccess struct {
+	ReportBugSuccess *ReportBugSuccessEvent `protobuf:"bytes,5,opt,name=reportBugSuccess,proto3,oneof"`
 }
 
-func (x *MailServerSettingsErrorEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type AppEvent_ReportBugError struct {
+	ReportBugError *ReportBugErrorEvent `protobuf:"bytes,6,opt,name=reportBugError,proto3,oneof"`
 }
 
-func (*MailServerSettingsErrorEvent) ProtoMessage() {}
-
-func (x *MailServerSettingsErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[50]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type AppEvent_ShowMainWindow struct {
+	ShowMainWindow *ShowMainWindowEvent `protobuf:"bytes,7,opt,name=showMainWindow,proto3,oneof"`
 }
 
-// Deprecated: Use MailServerSettingsErrorEvent.ProtoReflect.Descriptor instead.
-func (*MailServerSettingsErrorEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{50}
+type AppEvent_ReportBugFallback struct {
+	ReportBugFallback *ReportBugFallbackEvent `protobuf:"bytes,8,opt,name=reportBugFallback,proto3,oneof"`
 }
 
-func (x *MailServerSettingsErrorEvent) GetType() MailServerSettingsErrorType {
-	if x != nil {
-		return x.Type
-	}
-	return MailServerSettingsErrorType_IMAP_PORT_STARTUP_ERROR
+type AppEvent_CertificateInstallSuccess struct {
+	CertificateInstallSuccess *CertificateInstallSuccessEvent `protobuf:"bytes,9,opt,name=certificateInstallSuccess,proto3,oneof"`
 }
 
-type MailServerSettingsChangedEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type AppEvent_CertificateInstallCanceled struct {
+	CertificateInstallCanceled *CertificateInstallCanceledEvent `protobuf:"bytes,10,opt,name=certificateInstallCanceled,proto3,oneof"`
+}
 
-	Settings *ImapSmtpSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+type AppEvent_CertificateInstallFailed struct {
+	CertificateInstallFailed *CertificateInstallFailedEvent `protobuf:"bytes,11,opt,name=certificateInstallFailed,proto3,oneof"`
 }
 
-func (x *MailServerSettingsChangedEvent) Reset() {
-	*x = MailServerSettingsChangedEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[51]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type AppEvent_KnowledgeBaseSuggestions struct {
+	KnowledgeBaseSuggestions *KnowledgeBaseSuggestionsEvent `protobuf:"bytes,12,opt,name=knowledgeBaseSuggestions,proto3,oneof"`
 }
 
-func (x *MailServerSettingsChangedEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type AppEvent_RepairStarted struct {
+	RepairStarted *RepairStartedEvent `protobuf:"bytes,13,opt,name=repairStarted,proto3,oneof"`
 }
 
-func (*MailServerSettingsChangedEvent) ProtoMessage() {}
+type AppEvent_AllUsersLoaded struct {
+	AllUsersLoaded *AllUsersLoadedEvent `protobuf:"bytes,14,opt,name=allUsersLoaded,proto3,oneof"`
+}
 
-func (x *MailServerSettingsChangedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[51]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type AppEvent_UserNotification struct {
+	UserNotification *UserNotificationEvent `protobuf:"bytes,15,opt,name=userNotification,proto3,oneof"`
 }
 
-// Deprecated: Use MailServerSettingsChangedEvent.ProtoReflect.Descriptor instead.
-func (*MailServerSettingsChangedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{51}
+type AppEvent_BridgeLocked struct {
+	BridgeLocked *BridgeLockedEvent `protobuf:"bytes,16,opt,name=bridgeLocked,proto3,oneof"`
 }
 
-func (x *MailServerSettingsChangedEvent) GetSettings() *ImapSmtpSettings {
-	if x != nil {
-		return x.Settings
-	}
-	return nil
+type AppEvent_BridgeUnlocked struct {
+	BridgeUnlocked *BridgeUnlockedEvent `protobuf:"bytes,17,opt,name=bridgeUnlocked,proto3,oneof"`
 }
 
-type ChangeMailServerSettingsFinishedEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type AppEvent_ApiRateLimited struct {
+	ApiRateLimited *APIRateLimitedEvent `protobuf:"bytes,18,opt,name=apiRateLimited,proto3,oneof"`
 }
 
-func (x *ChangeMailServerSettingsFinishedEvent) Reset() {
-	*x = ChangeMailServerSettingsFinishedEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[52]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type AppEvent_KeyRotationStarted struct {
+	KeyRotationStarted *KeyRotationStartedEvent `protobuf:"bytes,19,opt,name=keyRotationStarted,proto3,oneof"`
 }
 
-func (x *ChangeMailServerSettingsFinishedEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type AppEvent_KeyRotationFinished struct {
+	KeyRotationFinished *KeyRotationFinishedEvent `protobuf:"bytes,20,opt,name=keyRotationFinished,proto3,oneof"`
 }
 
-func (*ChangeMailServerSettingsFinishedEvent) ProtoMessage() {}
+func (*AppEvent_InternetStatus) isAppEvent_Event() {}
 
-func (x *ChangeMailServerSettingsFinishedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[52]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
+func (*AppEvent_ToggleAutostartFinished) isAppEvent_Event() {}
 
-// Deprecated: Use ChangeMailServerSettingsFinishedEvent.ProtoReflect.Descriptor instead.
-func (*ChangeMailServerSettingsFinishedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{52}
-}
+func (*AppEvent_ResetFinished) isAppEvent_Event() {}
 
-// **********************************************************
-// keychain related events
-// **********************************************************
-type KeychainEvent struct {
+func (*AppEvent_ReportBugFinished) isAppEvent_Event() {}
+
+func (*AppEvent_ReportBugSuccess) isAppEvent_Event() {}
+
+func (*AppEvent_ReportBugError) isAppEvent_Event() {}
+
+func (*AppEvent_ShowMainWindow) isAppEvent_Event() {}
+
+func (*AppEvent_ReportBugFallback) isAppEvent_Event() {}
+
+func (*AppEvent_CertificateInstallSuccess) isAppEvent_Event() {}
+
+func (*AppEvent_CertificateInstallCanceled) isAppEvent_Event() {}
+
+func (*AppEvent_CertificateInstallFailed) isAppEvent_Event() {}
+
+func (*AppEvent_KnowledgeBaseSuggestions) isAppEvent_Event() {}
+
+func (*AppEvent_RepairStarted) isAppEvent_Event() {}
+
+func (*AppEvent_AllUsersLoaded) isAppEvent_Event() {}
+
+func (*AppEvent_UserNotification) isAppEvent_Event() {}
+
+func (*AppEvent_BridgeLocked) isAppEvent_Event() {}
+
+func (*AppEvent_BridgeUnlocked) isAppEvent_Event() {}
+
+func (*AppEvent_ApiRateLimited) isAppEvent_Event() {}
+
+func (*AppEvent_KeyRotationStarted) isAppEvent_Event() {}
+
+func (*AppEvent_KeyRotationFinished) isAppEvent_Event() {}
+
+type InternetStatusEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Event:
-	//
-	//	*KeychainEvent_ChangeKeychainFinished
-	//	*KeychainEvent_HasNoKeychain
-	//	*KeychainEvent_RebuildKeychain
-	Event isKeychainEvent_Event `protobuf_oneof:"event"`
+	Connected bool `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
 }
 
-func (x *KeychainEvent) Reset() {
-	*x = KeychainEvent{}
+func (x *InternetStatusEvent) Reset() {
+	*x = InternetStatusEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3666,13 +4288,13 @@ func (x *KeychainEvent) Reset() {
 	}
 }
 
-func (x *KeychainEvent) String() string {
+func (x *InternetStatusEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeychainEvent) ProtoMessage() {}
+func (*InternetStatusEvent) ProtoMessage() {}
 
-func (x *KeychainEvent) ProtoReflect() protoreflect.Message {
+func (x *InternetStatusEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3684,69 +4306,26 @@ func (x *KeychainEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeychainEvent.ProtoReflect.Descriptor instead.
-func (*KeychainEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use InternetStatusEvent.ProtoReflect.Descriptor instead.
+func (*InternetStatusEvent) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{53}
 }
 
-func (m *KeychainEvent) GetEvent() isKeychainEvent_Event {
-	if m != nil {
-		return m.Event
-	}
-	return nil
-}
-
-func (x *KeychainEvent) GetChangeKeychainFinished() *ChangeKeychainFinishedEvent {
-	if x, ok := x.GetEvent().(*KeychainEvent_ChangeKeychainFinished); ok {
-		return x.ChangeKeychainFinished
-	}
-	return nil
-}
-
-func (x *KeychainEvent) GetHasNoKeychain() *HasNoKeychainEvent {
-	if x, ok := x.GetEvent().(*KeychainEvent_HasNoKeychain); ok {
-		return x.HasNoKeychain
-	}
-	return nil
-}
-
-func (x *KeychainEvent) GetRebuildKeychain() *RebuildKeychainEvent {
-	if x, ok := x.GetEvent().(*KeychainEvent_RebuildKeychain); ok {
-		return x.RebuildKeychain
+func (x *InternetStatusEvent) GetConnected() bool {
+	if x != nil {
+		return x.Connected
 	}
-	return nil
-}
-
-type isKeychainEvent_Event interface {
-	isKeychainEvent_Event()
-}
-
-type KeychainEvent_ChangeKeychainFinished struct {
-	ChangeKeychainFinished *ChangeKeychainFinishedEvent `protobuf:"bytes,1,opt,name=changeKeychainFinished,proto3,oneof"`
-}
-
-type KeychainEvent_HasNoKeychain struct {
-	HasNoKeychain *HasNoKeychainEvent `protobuf:"bytes,2,opt,name=hasNoKeychain,proto3,oneof"`
-}
-
-type KeychainEvent_RebuildKeychain struct {
-	RebuildKeychain *RebuildKeychainEvent `protobuf:"bytes,3,opt,name=rebuildKeychain,proto3,oneof"`
+	return false
 }
 
-func (*KeychainEvent_ChangeKeychainFinished) isKeychainEvent_Event() {}
-
-func (*KeychainEvent_HasNoKeychain) isKeychainEvent_Event() {}
-
-func (*KeychainEvent_RebuildKeychain) isKeychainEvent_Event() {}
-
-type ChangeKeychainFinishedEvent struct {
+type ToggleAutostartFinishedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ChangeKeychainFinishedEvent) Reset() {
-	*x = ChangeKeychainFinishedEvent{}
+func (x *ToggleAutostartFinishedEvent) Reset() {
+	*x = ToggleAutostartFinishedEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3754,13 +4333,13 @@ func (x *ChangeKeychainFinishedEvent) Reset() {
 	}
 }
 
-func (x *ChangeKeychainFinishedEvent) String() string {
+func (x *ToggleAutostartFinishedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeKeychainFinishedEvent) ProtoMessage() {}
+func (*ToggleAutostartFinishedEvent) ProtoMessage() {}
 
-func (x *ChangeKeychainFinishedEvent) ProtoReflect() protoreflect.Message {
+func (x *ToggleAutostartFinishedEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3772,19 +4351,19 @@ func (x *ChangeKeychainFinishedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeKeychainFinishedEvent.ProtoReflect.Descriptor instead.
-func (*ChangeKeychainFinishedEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ToggleAutostartFinishedEvent.ProtoReflect.Descriptor instead.
+func (*ToggleAutostartFinishedEvent) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{54}
 }
 
-type HasNoKeychainEvent struct {
+type ResetFinishedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HasNoKeychainEvent) Reset() {
-	*x = HasNoKeychainEvent{}
+func (x *ResetFinishedEvent) Reset() {
+	*x = ResetFinishedEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3792,13 +4371,13 @@ func (x *HasNoKeychainEvent) Reset() {
 	}
 }
 
-func (x *HasNoKeychainEvent) String() string {
+func (x *ResetFinishedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HasNoKeychainEvent) ProtoMessage() {}
+func (*ResetFinishedEvent) ProtoMessage() {}
 
-func (x *HasNoKeychainEvent) ProtoReflect() protoreflect.Message {
+func (x *ResetFinishedEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3810,19 +4389,19 @@ func (x *HasNoKeychainEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HasNoKeychainEvent.ProtoReflect.Descriptor instead.
-func (*HasNoKeychainEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetFinishedEvent.ProtoReflect.Descriptor instead.
+func (*ResetFinishedEvent) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{55}
 }
 
-type RebuildKeychainEvent struct {
+type ReportBugFinishedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RebuildKeychainEvent) Reset() {
-	*x = RebuildKeychainEvent{}
+func (x *ReportBugFinishedEvent) Reset() {
+	*x = ReportBugFinishedEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3830,13 +4409,13 @@ func (x *RebuildKeychainEvent) Reset() {
 	}
 }
 
-func (x *RebuildKeychainEvent) String() string {
+func (x *ReportBugFinishedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RebuildKeychainEvent) ProtoMessage() {}
+func (*ReportBugFinishedEvent) ProtoMessage() {}
 
-func (x *RebuildKeychainEvent) ProtoReflect() protoreflect.Message {
+func (x *ReportBugFinishedEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3848,29 +4427,19 @@ func (x *RebuildKeychainEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RebuildKeychainEvent.ProtoReflect.Descriptor instead.
-func (*RebuildKeychainEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReportBugFinishedEvent.ProtoReflect.Descriptor instead.
+func (*ReportBugFinishedEvent) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{56}
 }
 
-// **********************************************************
-// Mail related events
-// **********************************************************
-type MailEvent struct {
+type ReportBugSuccessEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Event:
-	//
-	//	*MailEvent_AddressChanged
-	//	*MailEvent_AddressChangedLogout
-	//	*MailEvent_ApiCertIssue
-	Event isMailEvent_Event `protobuf_oneof:"event"`
 }
 
-func (x *MailEvent) Reset() {
-	*x = MailEvent{}
+func (x *ReportBugSuccessEvent) Reset() {
+	*x = ReportBugSuccessEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3878,13 +4447,13 @@ func (x *MailEvent) Reset() {
 	}
 }
 
-func (x *MailEvent) String() string {
+func (x *ReportBugSuccessEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MailEvent) ProtoMessage() {}
+func (*ReportBugSuccessEvent) ProtoMessage() {}
 
-func (x *MailEvent) ProtoReflect() protoreflect.Message {
+func (x *ReportBugSuccessEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3896,71 +4465,19 @@ func (x *MailEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MailEvent.ProtoReflect.Descriptor instead.
-func (*MailEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReportBugSuccessEvent.ProtoReflect.Descriptor instead.
+func (*ReportBugSuccessEvent) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{57}
 }
 
-func (m *MailEvent) GetEvent() isMailEvent_Event {
-	if m != nil {
-		return m.Event
-	}
-	return nil
-}
-
-func (x *MailEvent) GetAddressChanged() *AddressChangedEvent {
-	if x, ok := x.GetEvent().(*MailEvent_AddressChanged); ok {
-		return x.AddressChanged
-	}
-	return nil
-}
-
-func (x *MailEvent) GetAddressChangedLogout() *AddressChangedLogoutEvent {
-	if x, ok := x.GetEvent().(*MailEvent_AddressChangedLogout); ok {
-		return x.AddressChangedLogout
-	}
-	return nil
-}
-
-func (x *MailEvent) GetApiCertIssue() *ApiCertIssueEvent {
-	if x, ok := x.GetEvent().(*MailEvent_ApiCertIssue); ok {
-		return x.ApiCertIssue
-	}
-	return nil
-}
-
-type isMailEvent_Event interface {
-	isMailEvent_Event()
-}
-
-type MailEvent_AddressChanged struct {
-	AddressChanged *AddressChangedEvent `protobuf:"bytes,1,opt,name=addressChanged,proto3,oneof"`
-}
-
-type MailEvent_AddressChangedLogout struct {
-	AddressChangedLogout *AddressChangedLogoutEvent `protobuf:"bytes,2,opt,name=addressChangedLogout,proto3,oneof"`
-}
-
-type MailEvent_ApiCertIssue struct {
-	ApiCertIssue *ApiCertIssueEvent `protobuf:"bytes,3,opt,name=apiCertIssue,proto3,oneof"`
-}
-
-func (*MailEvent_AddressChanged) isMailEvent_Event() {}
-
-func (*MailEvent_AddressChangedLogout) isMailEvent_Event() {}
-
-func (*MailEvent_ApiCertIssue) isMailEvent_Event() {}
-
-type AddressChangedEvent struct {
+type ReportBugErrorEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AddressChangedEvent) Reset() {
-	*x = AddressChangedEvent{}
+func (x *ReportBugErrorEvent) Reset() {
+	*x = ReportBugErrorEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3968,13 +4485,13 @@ func (x *AddressChangedEvent) Reset() {
 	}
 }
 
-func (x *AddressChangedEvent) String() string {
+func (x *ReportBugErrorEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressChangedEvent) ProtoMessage() {}
+func (*ReportBugErrorEvent) ProtoMessage() {}
 
-func (x *AddressChangedEvent) ProtoReflect() protoreflect.Message {
+func (x *ReportBugErrorEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3986,28 +4503,19 @@ func (x *AddressChangedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddressChangedEvent.ProtoReflect.Descriptor instead.
-func (*AddressChangedEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReportBugErrorEvent.ProtoReflect.Descriptor instead.
+func (*ReportBugErrorEvent) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *AddressChangedEvent) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-type AddressChangedLogoutEvent struct {
+type ShowMainWindowEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AddressChangedLogoutEvent) Reset() {
-	*x = AddressChangedLogoutEvent{}
+func (x *ShowMainWindowEvent) Reset() {
+	*x = ShowMainWindowEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4015,13 +4523,13 @@ func (x *AddressChangedLogoutEvent) Reset() {
 	}
 }
 
-func (x *AddressChangedLogoutEvent) String() string {
+func (x *ShowMainWindowEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressChangedLogoutEvent) ProtoMessage() {}
+func (*ShowMainWindowEvent) ProtoMessage() {}
 
-func (x *AddressChangedLogoutEvent) ProtoReflect() protoreflect.Message {
+func (x *ShowMainWindowEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4033,26 +4541,19 @@ func (x *AddressChangedLogoutEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddressChangedLogoutEvent.ProtoReflect.Descriptor instead.
-func (*AddressChangedLogoutEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShowMainWindowEvent.ProtoReflect.Descriptor instead.
+func (*ShowMainWindowEvent) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *AddressChangedLogoutEvent) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-type ApiCertIssueEvent struct {
+type ReportBugFallbackEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ApiCertIssueEvent) Reset() {
-	*x = ApiCertIssueEvent{}
+func (x *ReportBugFallbackEvent) Reset() {
+	*x = ReportBugFallbackEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4060,13 +4561,13 @@ func (x *ApiCertIssueEvent) Reset() {
 	}
 }
 
-func (x *ApiCertIssueEvent) String() string {
+func (x *ReportBugFallbackEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiCertIssueEvent) ProtoMessage() {}
+func (*ReportBugFallbackEvent) ProtoMessage() {}
 
-func (x *ApiCertIssueEvent) ProtoReflect() protoreflect.Message {
+func (x *ReportBugFallbackEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4078,32 +4579,19 @@ func (x *ApiCertIssueEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiCertIssueEvent.ProtoReflect.Descriptor instead.
-func (*ApiCertIssueEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReportBugFallbackEvent.ProtoReflect.Descriptor instead.
+func (*ReportBugFallbackEvent) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{60}
 }
 
-type UserEvent struct {
+type CertificateInstallSuccessEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Event:
-	//
-	//	*UserEvent_ToggleSplitModeFinished
-	//	*UserEvent_UserDisconnected
-	//	*UserEvent_UserChanged
-	//	*UserEvent_UserBadEvent
-	//	*UserEvent_UsedBytesChangedEvent
-	//	*UserEvent_ImapLoginFailedEvent
-	//	*UserEvent_SyncStartedEvent
-	//	*UserEvent_SyncFinishedEvent
-	//	*UserEvent_SyncProgressEvent
-	Event isUserEvent_Event `protobuf_oneof:"event"`
 }
 
-func (x *UserEvent) Reset() {
-	*x = UserEvent{}
+func (x *CertificateInstallSuccessEvent) Reset() {
+	*x = CertificateInstallSuccessEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bridge_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4111,13 +4599,13 @@ func (x *UserEvent) Reset() {
 	}
 }
 
-func (x *UserEvent) String() string {
+func (x *CertificateInstallSuccessEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserEvent) ProtoMessage() {}
+func (*CertificateInstallSuccessEvent) ProtoMessage() {}
 
-func (x *UserEvent) ProtoReflect() protoreflect.Message {
+func (x *CertificateInstallSuccessEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_bridge_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4129,164 +4617,188 @@ func (x *UserEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
-func (*UserEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use CertificateInstallSuccessEvent.ProtoReflect.Descriptor instead.
+func (*CertificateInstallSuccessEvent) Descriptor() ([]byte, []int) {
 	return file_bridge_proto_rawDescGZIP(), []int{61}
 }
 
-func (m *UserEvent) GetEvent() isUserEvent_Event {
-	if m != nil {
-		return m.Event
-	}
-	return nil
+type CertificateInstallCanceledEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserEvent) GetToggleSplitModeFinished() *ToggleSplitModeFinishedEvent {
-	if x, ok := x.GetEvent().(*UserEvent_ToggleSplitModeFinished); ok {
-		return x.ToggleSplitModeFinished
+func (x *CertificateInstallCanceledEvent) Reset() {
+	*x = CertificateInstallCanceledEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *UserEvent) GetUserDisconnected() *UserDisconnectedEvent {
-	if x, ok := x.GetEvent().(*UserEvent_UserDisconnected); ok {
-		return x.UserDisconnected
-	}
-	return nil
+func (x *CertificateInstallCanceledEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserEvent) GetUserChanged() *UserChangedEvent {
-	if x, ok := x.GetEvent().(*UserEvent_UserChanged); ok {
-		return x.UserChanged
+func (*CertificateInstallCanceledEvent) ProtoMessage() {}
+
+func (x *CertificateInstallCanceledEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UserEvent) GetUserBadEvent() *UserBadEvent {
-	if x, ok := x.GetEvent().(*UserEvent_UserBadEvent); ok {
-		return x.UserBadEvent
-	}
-	return nil
+// Deprecated: Use CertificateInstallCanceledEvent.ProtoReflect.Descriptor instead.
+func (*CertificateInstallCanceledEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UserEvent) GetUsedBytesChangedEvent() *UsedBytesChangedEvent {
-	if x, ok := x.GetEvent().(*UserEvent_UsedBytesChangedEvent); ok {
-		return x.UsedBytesChangedEvent
-	}
-	return nil
+type CertificateInstallFailedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserEvent) GetImapLoginFailedEvent() *ImapLoginFailedEvent {
-	if x, ok := x.GetEvent().(*UserEvent_ImapLoginFailedEvent); ok {
-		return x.ImapLoginFailedEvent
+func (x *CertificateInstallFailedEvent) Reset() {
+	*x = CertificateInstallFailedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *UserEvent) GetSyncStartedEvent() *SyncStartedEvent {
-	if x, ok := x.GetEvent().(*UserEvent_SyncStartedEvent); ok {
-		return x.SyncStartedEvent
-	}
-	return nil
+func (x *CertificateInstallFailedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserEvent) GetSyncFinishedEvent() *SyncFinishedEvent {
-	if x, ok := x.GetEvent().(*UserEvent_SyncFinishedEvent); ok {
-		return x.SyncFinishedEvent
-	}
-	return nil
-}
+func (*CertificateInstallFailedEvent) ProtoMessage() {}
 
-func (x *UserEvent) GetSyncProgressEvent() *SyncProgressEvent {
-	if x, ok := x.GetEvent().(*UserEvent_SyncProgressEvent); ok {
-		return x.SyncProgressEvent
+func (x *CertificateInstallFailedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type isUserEvent_Event interface {
-	isUserEvent_Event()
+// Deprecated: Use CertificateInstallFailedEvent.ProtoReflect.Descriptor instead.
+func (*CertificateInstallFailedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{63}
 }
 
-type UserEvent_ToggleSplitModeFinished struct {
-	ToggleSplitModeFinished *ToggleSplitModeFinishedEvent `protobuf:"bytes,1,opt,name=toggleSplitModeFinished,proto3,oneof"`
+type RepairStartedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-type UserEvent_UserDisconnected struct {
-	UserDisconnected *UserDisconnectedEvent `protobuf:"bytes,2,opt,name=userDisconnected,proto3,oneof"`
+func (x *RepairStartedEvent) Reset() {
+	*x = RepairStartedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type UserEvent_UserChanged struct {
-	UserChanged *UserChangedEvent `protobuf:"bytes,3,opt,name=userChanged,proto3,oneof"`
+func (x *RepairStartedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type UserEvent_UserBadEvent struct {
-	UserBadEvent *UserBadEvent `protobuf:"bytes,4,opt,name=userBadEvent,proto3,oneof"`
-}
+func (*RepairStartedEvent) ProtoMessage() {}
 
-type UserEvent_UsedBytesChangedEvent struct {
-	UsedBytesChangedEvent *UsedBytesChangedEvent `protobuf:"bytes,5,opt,name=usedBytesChangedEvent,proto3,oneof"`
+func (x *RepairStartedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type UserEvent_ImapLoginFailedEvent struct {
-	ImapLoginFailedEvent *ImapLoginFailedEvent `protobuf:"bytes,6,opt,name=imapLoginFailedEvent,proto3,oneof"`
+// Deprecated: Use RepairStartedEvent.ProtoReflect.Descriptor instead.
+func (*RepairStartedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{64}
 }
 
-type UserEvent_SyncStartedEvent struct {
-	SyncStartedEvent *SyncStartedEvent `protobuf:"bytes,7,opt,name=syncStartedEvent,proto3,oneof"`
+type AllUsersLoadedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-type UserEvent_SyncFinishedEvent struct {
-	SyncFinishedEvent *SyncFinishedEvent `protobuf:"bytes,8,opt,name=syncFinishedEvent,proto3,oneof"`
+func (x *AllUsersLoadedEvent) Reset() {
+	*x = AllUsersLoadedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type UserEvent_SyncProgressEvent struct {
-	SyncProgressEvent *SyncProgressEvent `protobuf:"bytes,9,opt,name=syncProgressEvent,proto3,oneof"`
+func (x *AllUsersLoadedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserEvent_ToggleSplitModeFinished) isUserEvent_Event() {}
-
-func (*UserEvent_UserDisconnected) isUserEvent_Event() {}
-
-func (*UserEvent_UserChanged) isUserEvent_Event() {}
-
-func (*UserEvent_UserBadEvent) isUserEvent_Event() {}
-
-func (*UserEvent_UsedBytesChangedEvent) isUserEvent_Event() {}
-
-func (*UserEvent_ImapLoginFailedEvent) isUserEvent_Event() {}
-
-func (*UserEvent_SyncStartedEvent) isUserEvent_Event() {}
+func (*AllUsersLoadedEvent) ProtoMessage() {}
 
-func (*UserEvent_SyncFinishedEvent) isUserEvent_Event() {}
+func (x *AllUsersLoadedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*UserEvent_SyncProgressEvent) isUserEvent_Event() {}
+// Deprecated: Use AllUsersLoadedEvent.ProtoReflect.Descriptor instead.
+func (*AllUsersLoadedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{65}
+}
 
-type ToggleSplitModeFinishedEvent struct {
+type BridgeLockedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	AutoLocked bool `protobuf:"varint,1,opt,name=autoLocked,proto3" json:"autoLocked,omitempty"`
 }
 
-func (x *ToggleSplitModeFinishedEvent) Reset() {
-	*x = ToggleSplitModeFinishedEvent{}
+func (x *BridgeLockedEvent) Reset() {
+	*x = BridgeLockedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[62]
+		mi := &file_bridge_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ToggleSplitModeFinishedEvent) String() string {
+func (x *BridgeLockedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleSplitModeFinishedEvent) ProtoMessage() {}
+func (*BridgeLockedEvent) ProtoMessage() {}
 
-func (x *ToggleSplitModeFinishedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[62]
+func (x *BridgeLockedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4297,43 +4809,41 @@ func (x *ToggleSplitModeFinishedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleSplitModeFinishedEvent.ProtoReflect.Descriptor instead.
-func (*ToggleSplitModeFinishedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use BridgeLockedEvent.ProtoReflect.Descriptor instead.
+func (*BridgeLockedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ToggleSplitModeFinishedEvent) GetUserID() string {
+func (x *BridgeLockedEvent) GetAutoLocked() bool {
 	if x != nil {
-		return x.UserID
+		return x.AutoLocked
 	}
-	return ""
+	return false
 }
 
-type UserDisconnectedEvent struct {
+type BridgeUnlockedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *UserDisconnectedEvent) Reset() {
-	*x = UserDisconnectedEvent{}
+func (x *BridgeUnlockedEvent) Reset() {
+	*x = BridgeUnlockedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[63]
+		mi := &file_bridge_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserDisconnectedEvent) String() string {
+func (x *BridgeUnlockedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserDisconnectedEvent) ProtoMessage() {}
+func (*BridgeUnlockedEvent) ProtoMessage() {}
 
-func (x *UserDisconnectedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[63]
+func (x *BridgeUnlockedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4344,43 +4854,34 @@ func (x *UserDisconnectedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserDisconnectedEvent.ProtoReflect.Descriptor instead.
-func (*UserDisconnectedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *UserDisconnectedEvent) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+// Deprecated: Use BridgeUnlockedEvent.ProtoReflect.Descriptor instead.
+func (*BridgeUnlockedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{67}
 }
 
-type UserChangedEvent struct {
+type KeyRotationStartedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *UserChangedEvent) Reset() {
-	*x = UserChangedEvent{}
+func (x *KeyRotationStartedEvent) Reset() {
+	*x = KeyRotationStartedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[64]
+		mi := &file_bridge_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserChangedEvent) String() string {
+func (x *KeyRotationStartedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserChangedEvent) ProtoMessage() {}
+func (*KeyRotationStartedEvent) ProtoMessage() {}
 
-func (x *UserChangedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[64]
+func (x *KeyRotationStartedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4391,44 +4892,36 @@ func (x *UserChangedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserChangedEvent.ProtoReflect.Descriptor instead.
-func (*UserChangedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{64}
-}
-
-func (x *UserChangedEvent) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
+// Deprecated: Use KeyRotationStartedEvent.ProtoReflect.Descriptor instead.
+func (*KeyRotationStartedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{68}
 }
 
-type UserBadEvent struct {
+type KeyRotationFinishedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // empty if the keys were rotated.
 }
 
-func (x *UserBadEvent) Reset() {
-	*x = UserBadEvent{}
+func (x *KeyRotationFinishedEvent) Reset() {
+	*x = KeyRotationFinishedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[65]
+		mi := &file_bridge_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserBadEvent) String() string {
+func (x *KeyRotationFinishedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserBadEvent) ProtoMessage() {}
+func (*KeyRotationFinishedEvent) ProtoMessage() {}
 
-func (x *UserBadEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[65]
+func (x *KeyRotationFinishedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4439,51 +4932,43 @@ func (x *UserBadEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserBadEvent.ProtoReflect.Descriptor instead.
-func (*UserBadEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{65}
-}
-
-func (x *UserBadEvent) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
+// Deprecated: Use KeyRotationFinishedEvent.ProtoReflect.Descriptor instead.
+func (*KeyRotationFinishedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UserBadEvent) GetErrorMessage() string {
+func (x *KeyRotationFinishedEvent) GetError() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Error
 	}
 	return ""
 }
 
-type UsedBytesChangedEvent struct {
+type APIRateLimitedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	UsedBytes int64  `protobuf:"varint,2,opt,name=usedBytes,proto3" json:"usedBytes,omitempty"`
+	RetryAfterSeconds int64 `protobuf:"varint,1,opt,name=retryAfterSeconds,proto3" json:"retryAfterSeconds,omitempty"` // how long API requests are held back for.
 }
 
-func (x *UsedBytesChangedEvent) Reset() {
-	*x = UsedBytesChangedEvent{}
+func (x *APIRateLimitedEvent) Reset() {
+	*x = APIRateLimitedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[66]
+		mi := &file_bridge_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UsedBytesChangedEvent) String() string {
+func (x *APIRateLimitedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsedBytesChangedEvent) ProtoMessage() {}
+func (*APIRateLimitedEvent) ProtoMessage() {}
 
-func (x *UsedBytesChangedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[66]
+func (x *APIRateLimitedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4494,50 +4979,44 @@ func (x *UsedBytesChangedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsedBytesChangedEvent.ProtoReflect.Descriptor instead.
-func (*UsedBytesChangedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *UsedBytesChangedEvent) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
+// Deprecated: Use APIRateLimitedEvent.ProtoReflect.Descriptor instead.
+func (*APIRateLimitedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *UsedBytesChangedEvent) GetUsedBytes() int64 {
+func (x *APIRateLimitedEvent) GetRetryAfterSeconds() int64 {
 	if x != nil {
-		return x.UsedBytes
+		return x.RetryAfterSeconds
 	}
 	return 0
 }
 
-type ImapLoginFailedEvent struct {
+type KnowledgeBaseSuggestion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Url   string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 }
 
-func (x *ImapLoginFailedEvent) Reset() {
-	*x = ImapLoginFailedEvent{}
+func (x *KnowledgeBaseSuggestion) Reset() {
+	*x = KnowledgeBaseSuggestion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[67]
+		mi := &file_bridge_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImapLoginFailedEvent) String() string {
+func (x *KnowledgeBaseSuggestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImapLoginFailedEvent) ProtoMessage() {}
+func (*KnowledgeBaseSuggestion) ProtoMessage() {}
 
-func (x *ImapLoginFailedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[67]
+func (x *KnowledgeBaseSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4548,43 +5027,50 @@ func (x *ImapLoginFailedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImapLoginFailedEvent.ProtoReflect.Descriptor instead.
-func (*ImapLoginFailedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use KnowledgeBaseSuggestion.ProtoReflect.Descriptor instead.
+func (*KnowledgeBaseSuggestion) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ImapLoginFailedEvent) GetUsername() string {
+func (x *KnowledgeBaseSuggestion) GetUrl() string {
 	if x != nil {
-		return x.Username
+		return x.Url
 	}
 	return ""
 }
 
-type SyncStartedEvent struct {
+func (x *KnowledgeBaseSuggestion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type KnowledgeBaseSuggestionsEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Suggestions []*KnowledgeBaseSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
 }
 
-func (x *SyncStartedEvent) Reset() {
-	*x = SyncStartedEvent{}
+func (x *KnowledgeBaseSuggestionsEvent) Reset() {
+	*x = KnowledgeBaseSuggestionsEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[68]
+		mi := &file_bridge_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncStartedEvent) String() string {
+func (x *KnowledgeBaseSuggestionsEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncStartedEvent) ProtoMessage() {}
+func (*KnowledgeBaseSuggestionsEvent) ProtoMessage() {}
 
-func (x *SyncStartedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[68]
+func (x *KnowledgeBaseSuggestionsEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4595,43 +5081,55 @@ func (x *SyncStartedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncStartedEvent.ProtoReflect.Descriptor instead.
-func (*SyncStartedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use KnowledgeBaseSuggestionsEvent.ProtoReflect.Descriptor instead.
+func (*KnowledgeBaseSuggestionsEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *SyncStartedEvent) GetUserID() string {
+func (x *KnowledgeBaseSuggestionsEvent) GetSuggestions() []*KnowledgeBaseSuggestion {
 	if x != nil {
-		return x.UserID
+		return x.Suggestions
 	}
-	return ""
+	return nil
 }
 
-type SyncFinishedEvent struct {
+// **********************************************************
+// Login related events
+// **********************************************************
+type LoginEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// Types that are assignable to Event:
+	//
+	//	*LoginEvent_Error
+	//	*LoginEvent_TfaRequested
+	//	*LoginEvent_TwoPasswordRequested
+	//	*LoginEvent_Finished
+	//	*LoginEvent_AlreadyLoggedIn
+	//	*LoginEvent_HvRequested
+	//	*LoginEvent_Fido2Requested
+	Event isLoginEvent_Event `protobuf_oneof:"event"`
 }
 
-func (x *SyncFinishedEvent) Reset() {
-	*x = SyncFinishedEvent{}
+func (x *LoginEvent) Reset() {
+	*x = LoginEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[69]
+		mi := &file_bridge_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncFinishedEvent) String() string {
+func (x *LoginEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncFinishedEvent) ProtoMessage() {}
+func (*LoginEvent) ProtoMessage() {}
 
-func (x *SyncFinishedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[69]
+func (x *LoginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4642,46 +5140,139 @@ func (x *SyncFinishedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncFinishedEvent.ProtoReflect.Descriptor instead.
-func (*SyncFinishedEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use LoginEvent.ProtoReflect.Descriptor instead.
+func (*LoginEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *SyncFinishedEvent) GetUserID() string {
-	if x != nil {
-		return x.UserID
+func (m *LoginEvent) GetEvent() isLoginEvent_Event {
+	if m != nil {
+		return m.Event
 	}
-	return ""
+	return nil
 }
 
-type SyncProgressEvent struct {
+func (x *LoginEvent) GetError() *LoginErrorEvent {
+	if x, ok := x.GetEvent().(*LoginEvent_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetTfaRequested() *LoginTfaRequestedEvent {
+	if x, ok := x.GetEvent().(*LoginEvent_TfaRequested); ok {
+		return x.TfaRequested
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetTwoPasswordRequested() *LoginTwoPasswordsRequestedEvent {
+	if x, ok := x.GetEvent().(*LoginEvent_TwoPasswordRequested); ok {
+		return x.TwoPasswordRequested
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetFinished() *LoginFinishedEvent {
+	if x, ok := x.GetEvent().(*LoginEvent_Finished); ok {
+		return x.Finished
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetAlreadyLoggedIn() *LoginFinishedEvent {
+	if x, ok := x.GetEvent().(*LoginEvent_AlreadyLoggedIn); ok {
+		return x.AlreadyLoggedIn
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetHvRequested() *LoginHvRequestedEvent {
+	if x, ok := x.GetEvent().(*LoginEvent_HvRequested); ok {
+		return x.HvRequested
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetFido2Requested() *LoginFido2RequestedEvent {
+	if x, ok := x.GetEvent().(*LoginEvent_Fido2Requested); ok {
+		return x.Fido2Requested
+	}
+	return nil
+}
+
+type isLoginEvent_Event interface {
+	isLoginEvent_Event()
+}
+
+type LoginEvent_Error struct {
+	Error *LoginErrorEvent `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type LoginEvent_TfaRequested struct {
+	TfaRequested *LoginTfaRequestedEvent `protobuf:"bytes,2,opt,name=tfaRequested,proto3,oneof"`
+}
+
+type LoginEvent_TwoPasswordRequested struct {
+	TwoPasswordRequested *LoginTwoPasswordsRequestedEvent `protobuf:"bytes,3,opt,name=twoPasswordRequested,proto3,oneof"`
+}
+
+type LoginEvent_Finished struct {
+	Finished *LoginFinishedEvent `protobuf:"bytes,4,opt,name=finished,proto3,oneof"`
+}
+
+type LoginEvent_AlreadyLoggedIn struct {
+	AlreadyLoggedIn *LoginFinishedEvent `protobuf:"bytes,5,opt,name=alreadyLoggedIn,proto3,oneof"`
+}
+
+type LoginEvent_HvRequested struct {
+	HvRequested *LoginHvRequestedEvent `protobuf:"bytes,6,opt,name=hvRequested,proto3,oneof"`
+}
+
+type LoginEvent_Fido2Requested struct {
+	Fido2Requested *LoginFido2RequestedEvent `protobuf:"bytes,7,opt,name=fido2Requested,proto3,oneof"`
+}
+
+func (*LoginEvent_Error) isLoginEvent_Event() {}
+
+func (*LoginEvent_TfaRequested) isLoginEvent_Event() {}
+
+func (*LoginEvent_TwoPasswordRequested) isLoginEvent_Event() {}
+
+func (*LoginEvent_Finished) isLoginEvent_Event() {}
+
+func (*LoginEvent_AlreadyLoggedIn) isLoginEvent_Event() {}
+
+func (*LoginEvent_HvRequested) isLoginEvent_Event() {}
+
+func (*LoginEvent_Fido2Requested) isLoginEvent_Event() {}
+
+type LoginErrorEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID      string  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Progress    float64 `protobuf:"fixed64,2,opt,name=progress,proto3" json:"progress,omitempty"`
-	ElapsedMs   int64   `protobuf:"varint,3,opt,name=elapsedMs,proto3" json:"elapsedMs,omitempty"`
-	RemainingMs int64   `protobuf:"varint,4,opt,name=remainingMs,proto3" json:"remainingMs,omitempty"`
+	Type    LoginErrorType `protobuf:"varint,1,opt,name=type,proto3,enum=grpc.LoginErrorType" json:"type,omitempty"`
+	Message string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *SyncProgressEvent) Reset() {
-	*x = SyncProgressEvent{}
+func (x *LoginErrorEvent) Reset() {
+	*x = LoginErrorEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[70]
+		mi := &file_bridge_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncProgressEvent) String() string {
+func (x *LoginErrorEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncProgressEvent) ProtoMessage() {}
+func (*LoginErrorEvent) ProtoMessage() {}
 
-func (x *SyncProgressEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[70]
+func (x *LoginErrorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4692,67 +5283,50 @@ func (x *SyncProgressEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncProgressEvent.ProtoReflect.Descriptor instead.
-func (*SyncProgressEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{70}
-}
-
-func (x *SyncProgressEvent) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *SyncProgressEvent) GetProgress() float64 {
-	if x != nil {
-		return x.Progress
-	}
-	return 0
+// Deprecated: Use LoginErrorEvent.ProtoReflect.Descriptor instead.
+func (*LoginErrorEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *SyncProgressEvent) GetElapsedMs() int64 {
+func (x *LoginErrorEvent) GetType() LoginErrorType {
 	if x != nil {
-		return x.ElapsedMs
+		return x.Type
 	}
-	return 0
+	return LoginErrorType_USERNAME_PASSWORD_ERROR
 }
 
-func (x *SyncProgressEvent) GetRemainingMs() int64 {
+func (x *LoginErrorEvent) GetMessage() string {
 	if x != nil {
-		return x.RemainingMs
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type UserNotificationEvent struct {
+type LoginTfaRequestedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title    string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Subtitle string `protobuf:"bytes,2,opt,name=subtitle,proto3" json:"subtitle,omitempty"`
-	Body     string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	UserID   string `protobuf:"bytes,4,opt,name=userID,proto3" json:"userID,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *UserNotificationEvent) Reset() {
-	*x = UserNotificationEvent{}
+func (x *LoginTfaRequestedEvent) Reset() {
+	*x = LoginTfaRequestedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[71]
+		mi := &file_bridge_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserNotificationEvent) String() string {
+func (x *LoginTfaRequestedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserNotificationEvent) ProtoMessage() {}
+func (*LoginTfaRequestedEvent) ProtoMessage() {}
 
-func (x *UserNotificationEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[71]
+func (x *LoginTfaRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4763,64 +5337,224 @@ func (x *UserNotificationEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserNotificationEvent.ProtoReflect.Descriptor instead.
-func (*UserNotificationEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use LoginTfaRequestedEvent.ProtoReflect.Descriptor instead.
+func (*LoginTfaRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *UserNotificationEvent) GetTitle() string {
+func (x *LoginTfaRequestedEvent) GetUsername() string {
 	if x != nil {
-		return x.Title
+		return x.Username
 	}
 	return ""
 }
 
-func (x *UserNotificationEvent) GetSubtitle() string {
+type LoginFido2RequestedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username       string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	RelyingPartyID string   `protobuf:"bytes,2,opt,name=relyingPartyID,proto3" json:"relyingPartyID,omitempty"`
+	ClientDataHash []byte   `protobuf:"bytes,3,opt,name=clientDataHash,proto3" json:"clientDataHash,omitempty"`
+	CredentialIDs  [][]byte `protobuf:"bytes,4,rep,name=credentialIDs,proto3" json:"credentialIDs,omitempty"`
+	TotpAvailable  bool     `protobuf:"varint,5,opt,name=totpAvailable,proto3" json:"totpAvailable,omitempty"` // The user may use a TOTP code through Login2FA instead.
+}
+
+func (x *LoginFido2RequestedEvent) Reset() {
+	*x = LoginFido2RequestedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginFido2RequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginFido2RequestedEvent) ProtoMessage() {}
+
+func (x *LoginFido2RequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginFido2RequestedEvent.ProtoReflect.Descriptor instead.
+func (*LoginFido2RequestedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *LoginFido2RequestedEvent) GetUsername() string {
 	if x != nil {
-		return x.Subtitle
+		return x.Username
 	}
 	return ""
 }
 
-func (x *UserNotificationEvent) GetBody() string {
+func (x *LoginFido2RequestedEvent) GetRelyingPartyID() string {
 	if x != nil {
-		return x.Body
+		return x.RelyingPartyID
 	}
 	return ""
 }
 
-func (x *UserNotificationEvent) GetUserID() string {
+func (x *LoginFido2RequestedEvent) GetClientDataHash() []byte {
+	if x != nil {
+		return x.ClientDataHash
+	}
+	return nil
+}
+
+func (x *LoginFido2RequestedEvent) GetCredentialIDs() [][]byte {
+	if x != nil {
+		return x.CredentialIDs
+	}
+	return nil
+}
+
+func (x *LoginFido2RequestedEvent) GetTotpAvailable() bool {
+	if x != nil {
+		return x.TotpAvailable
+	}
+	return false
+}
+
+type LoginTwoPasswordsRequestedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *LoginTwoPasswordsRequestedEvent) Reset() {
+	*x = LoginTwoPasswordsRequestedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginTwoPasswordsRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginTwoPasswordsRequestedEvent) ProtoMessage() {}
+
+func (x *LoginTwoPasswordsRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginTwoPasswordsRequestedEvent.ProtoReflect.Descriptor instead.
+func (*LoginTwoPasswordsRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *LoginTwoPasswordsRequestedEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type LoginFinishedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	WasSignedOut bool   `protobuf:"varint,2,opt,name=wasSignedOut,proto3" json:"wasSignedOut,omitempty"`
+}
+
+func (x *LoginFinishedEvent) Reset() {
+	*x = LoginFinishedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginFinishedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginFinishedEvent) ProtoMessage() {}
+
+func (x *LoginFinishedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginFinishedEvent.ProtoReflect.Descriptor instead.
+func (*LoginFinishedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *LoginFinishedEvent) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-type GenericErrorEvent struct {
+func (x *LoginFinishedEvent) GetWasSignedOut() bool {
+	if x != nil {
+		return x.WasSignedOut
+	}
+	return false
+}
+
+type LoginHvRequestedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=grpc.ErrorCode" json:"code,omitempty"`
+	HvUrl string `protobuf:"bytes,1,opt,name=hvUrl,proto3" json:"hvUrl,omitempty"`
 }
 
-func (x *GenericErrorEvent) Reset() {
-	*x = GenericErrorEvent{}
+func (x *LoginHvRequestedEvent) Reset() {
+	*x = LoginHvRequestedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[72]
+		mi := &file_bridge_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenericErrorEvent) String() string {
+func (x *LoginHvRequestedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenericErrorEvent) ProtoMessage() {}
+func (*LoginHvRequestedEvent) ProtoMessage() {}
 
-func (x *GenericErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[72]
+func (x *LoginHvRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4831,1181 +5565,5131 @@ func (x *GenericErrorEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenericErrorEvent.ProtoReflect.Descriptor instead.
-func (*GenericErrorEvent) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use LoginHvRequestedEvent.ProtoReflect.Descriptor instead.
+func (*LoginHvRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GenericErrorEvent) GetCode() ErrorCode {
+func (x *LoginHvRequestedEvent) GetHvUrl() string {
 	if x != nil {
-		return x.Code
+		return x.HvUrl
 	}
-	return ErrorCode_UNKNOWN_ERROR
+	return ""
 }
 
-var File_bridge_proto protoreflect.FileDescriptor
+// **********************************************************
+// Update related events
+// **********************************************************
+type UpdateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_bridge_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04,
-	0x67, 0x72, 0x70, 0x63, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x6e, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x3e, 0x0a, 0x10, 0x47, 0x75, 0x69, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x77, 0x53, 0x70, 0x6c,
-	0x61, 0x73, 0x68, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x10, 0x73, 0x68, 0x6f, 0x77, 0x53, 0x70, 0x6c, 0x61, 0x73, 0x68, 0x53, 0x63, 0x72, 0x65, 0x65,
-	0x6e, 0x22, 0xde, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x73, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f,
-	0x67, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x0c, 0x75,
-	0x73, 0x65, 0x48, 0x76, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x48, 0x76, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x75, 0x73, 0x65, 0x48, 0x76, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x2f, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x62,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x49, 0x6d, 0x61, 0x70, 0x53,
-	0x6d, 0x74, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69,
-	0x6d, 0x61, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69,
-	0x6d, 0x61, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6d, 0x74, 0x70, 0x50,
-	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x6d, 0x74, 0x70, 0x50,
-	0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x53, 0x53, 0x4c, 0x46, 0x6f, 0x72,
-	0x49, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x53,
-	0x53, 0x4c, 0x46, 0x6f, 0x72, 0x49, 0x6d, 0x61, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73, 0x65,
-	0x53, 0x53, 0x4c, 0x46, 0x6f, 0x72, 0x53, 0x6d, 0x74, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x75, 0x73, 0x65, 0x53, 0x53, 0x4c, 0x46, 0x6f, 0x72, 0x53, 0x6d, 0x74, 0x70, 0x22,
-	0x3a, 0x0a, 0x1a, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x04,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x54, 0x65, 0x78, 0x74,
-	0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70, 0x6c, 0x69, 0x74,
-	0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x70, 0x6c, 0x69,
-	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x46, 0x0a,
-	0x14, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x6f, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x64, 0x6f, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x34, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x4d,
-	0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65,
-	0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3c, 0x0a,
-	0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0xd0, 0x03, 0x0a, 0x0b,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x61,
-	0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x70, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12,
-	0x28, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x48, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73,
-	0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x63,
-	0x61, 0x63, 0x68, 0x65, 0x12, 0x4f, 0x0a, 0x12, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
-	0x00, 0x52, 0x12, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4b,
-	0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08,
-	0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61,
-	0x69, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x25, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69,
-	0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xd2,
-	0x09, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x5e, 0x0a, 0x17, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x41,
-	0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x17, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x41,
-	0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x12, 0x4c, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46,
-	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x12, 0x49, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x42, 0x75, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x42, 0x75, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x43, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x69, 0x6e, 0x57,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4c, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42,
-	0x75, 0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75,
-	0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x19, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x19,
-	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x67, 0x0a, 0x1a, 0x63, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x65, 0x64, 0x12, 0x61, 0x0a, 0x18, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x46, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x18, 0x63, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x46,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x61, 0x0a, 0x18, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67, 0x67,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x18,
-	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x61,
-	0x69, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x70,
-	0x61, 0x69, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c,
-	0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
-	0x0e, 0x61, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x12,
-	0x49, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x13, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x54, 0x6f, 0x67, 0x67,
-	0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65,
-	0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x18,
-	0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x42, 0x75, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x68, 0x6f, 0x77,
-	0x4d, 0x61, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22,
-	0x18, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46, 0x61, 0x6c, 0x6c,
-	0x62, 0x61, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x43, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x43,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x1f,
-	0x0a, 0x1d, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22,
-	0x14, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x41, 0x0a, 0x17,
-	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22,
-	0x60, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65,
-	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x6e, 0x6f,
-	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0xa4, 0x03, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x42, 0x0a, 0x0c, 0x74, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x54, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x12, 0x5b, 0x0a, 0x14, 0x74, 0x77, 0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x77,
-	0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x14, 0x74, 0x77, 0x6f, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
-	0x12, 0x36, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46,
-	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08,
-	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x0f, 0x61, 0x6c, 0x72, 0x65,
-	0x61, 0x64, 0x79, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x49, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x61,
-	0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x3f,
-	0x0a, 0x0b, 0x68, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x48, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x48, 0x00, 0x52, 0x0b, 0x68, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42,
-	0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x34, 0x0a, 0x16, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x1f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x77,
-	0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x61, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f,
-	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x61, 0x73, 0x53, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x22, 0x2d, 0x0a, 0x15, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48,
-	0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x68, 0x76, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x68, 0x76, 0x55, 0x72, 0x6c, 0x22, 0xb9, 0x04, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52,
-	0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x65,
-	0x61, 0x64, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x6e, 0x75,
-	0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x58, 0x0a, 0x13, 0x6d, 0x61, 0x6e, 0x75, 0x61,
-	0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e,
-	0x65, 0x65, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x61,
-	0x6e, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65,
-	0x64, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f,
-	0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x12, 0x53, 0x0a, 0x13, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6c, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x48,
-	0x00, 0x52, 0x13, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x0f, 0x69, 0x73, 0x4c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x73, 0x4c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f,
-	0x69, 0x73, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x41, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x12, 0x44, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x22, 0x3d, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0x32, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c,
-	0x52, 0x65, 0x61, 0x64, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
-	0x6e, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65,
-	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69,
-	0x6c, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65,
-	0x64, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x73, 0x4c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0xeb, 0x01, 0x0a, 0x0e, 0x44, 0x69,
-	0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x43, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x64, 0x12, 0x58, 0x0a, 0x12, 0x70, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x12, 0x70, 0x61, 0x74, 0x68,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x07,
-	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x6b, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2f, 0x0a, 0x19,
-	0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x22, 0x0a,
-	0x20, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x22, 0xbf, 0x02, 0x0a, 0x17, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x64, 0x0a, 0x19, 0x6d, 0x61, 0x69,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x48, 0x00, 0x52, 0x19, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
-	0x79, 0x0a, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x1c, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x21, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x54, 0x0a, 0x1e, 0x4d, 0x61,
-	0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x08,
-	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x61, 0x70, 0x53, 0x6d, 0x74, 0x70, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x22, 0x27, 0x0a, 0x25, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x69, 0x6e, 0x69,
-	0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x0d, 0x4b, 0x65,
-	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x16, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x16, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x4e,
-	0x6f, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x61, 0x73, 0x4e, 0x6f, 0x4b, 0x65, 0x79, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x68, 0x61, 0x73,
-	0x4e, 0x6f, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x46, 0x0a, 0x0f, 0x72, 0x65,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
-	0x00, 0x52, 0x0f, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x48, 0x61,
-	0x73, 0x4e, 0x6f, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xef, 0x01, 0x0a, 0x09, 0x4d, 0x61, 0x69,
-	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x55, 0x0a, 0x14, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x4c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x4c,
-	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x14, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x6f,
-	0x75, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x70, 0x69, 0x43, 0x65, 0x72, 0x74, 0x49, 0x73, 0x73,
-	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x70, 0x69, 0x43, 0x65, 0x72, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x70, 0x69, 0x43, 0x65, 0x72, 0x74, 0x49, 0x73, 0x73, 0x75,
-	0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x35, 0x0a, 0x19, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x4c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x70, 0x69, 0x43, 0x65, 0x72, 0x74, 0x49, 0x73, 0x73,
-	0x75, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xb4, 0x05, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x17, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53,
-	0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x17, 0x74, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x44, 0x69, 0x73,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10,
-	0x75, 0x73, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x12, 0x3a, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
-	0x0b, 0x75, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x0c,
-	0x75, 0x73, 0x65, 0x72, 0x42, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61,
-	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x42, 0x61,
-	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x15, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65,
-	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x48, 0x00, 0x52, 0x15, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x14, 0x69,
-	0x6d, 0x61, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x49, 0x6d, 0x61, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x14, 0x69, 0x6d, 0x61, 0x70, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a,
-	0x10, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
-	0x00, 0x52, 0x10, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x73, 0x79, 0x6e, 0x63, 0x46,
-	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x11,
-	0x73, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x48, 0x00, 0x52, 0x11, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x36,
-	0x0a, 0x1c, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64,
-	0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x33, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x10, 0x55,
-	0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4a, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x42,
-	0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x4d, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x22, 0x32, 0x0a, 0x14, 0x49, 0x6d, 0x61, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x10, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x22, 0x2b, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
-	0x87, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6c, 0x61,
-	0x70, 0x73, 0x65, 0x64, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6c,
-	0x61, 0x70, 0x73, 0x65, 0x64, 0x4d, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x61, 0x69,
-	0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65,
-	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x22, 0x75, 0x0a, 0x15, 0x55, 0x73, 0x65,
-	0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x22, 0x38, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x2a, 0x71, 0x0a, 0x08, 0x4c, 0x6f,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x4f, 0x47, 0x5f, 0x50, 0x41,
-	0x4e, 0x49, 0x43, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x4f, 0x47, 0x5f, 0x46, 0x41, 0x54,
-	0x41, 0x4c, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x4f, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x47, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10,
-	0x03, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x47, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x04, 0x12,
-	0x0d, 0x0a, 0x09, 0x4c, 0x4f, 0x47, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x05, 0x12, 0x0d,
-	0x0a, 0x09, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x06, 0x2a, 0x36, 0x0a,
-	0x09, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x49,
-	0x47, 0x4e, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f,
-	0x43, 0x4b, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
-	0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xb0, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x53, 0x45, 0x52,
-	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x55, 0x53,
-	0x45, 0x52, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x46,
-	0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x46, 0x41,
-	0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x57, 0x4f, 0x5f,
-	0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0x05, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x57, 0x4f, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52,
-	0x44, 0x53, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x56,
-	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x07, 0x2a, 0x5b, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x55,
-	0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46,
-	0x4f, 0x52, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x49, 0x4c, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0x02, 0x2a, 0x34, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x43,
-	0x41, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x41,
-	0x43, 0x48, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x2a, 0xdd, 0x01, 0x0a, 0x1b,
-	0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x49,
-	0x4d, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x55, 0x50,
-	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4d, 0x54, 0x50,
-	0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x55, 0x50, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4d, 0x41, 0x50, 0x5f, 0x50, 0x4f,
-	0x52, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4d, 0x54, 0x50, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43,
-	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x25, 0x0a,
-	0x21, 0x49, 0x4d, 0x41, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x4d, 0x54, 0x50, 0x5f, 0x43, 0x4f, 0x4e,
-	0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x48, 0x41,
-	0x4e, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x2a, 0x53, 0x0a, 0x09, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x54,
-	0x4c, 0x53, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x45,
-	0x59, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02,
-	0x32, 0xbd, 0x21, 0x0a, 0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x0b, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x67,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64,
-	0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x08, 0x47, 0x75, 0x69, 0x52, 0x65,
-	0x61, 0x64, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x75, 0x69, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x51, 0x75, 0x69, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x07, 0x52,
-	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0d, 0x53, 0x68, 0x6f, 0x77, 0x4f, 0x6e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x53,
-	0x65, 0x74, 0x49, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x6e, 0x12,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x4f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x49,
-	0x73, 0x42, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x43, 0x0a, 0x0d, 0x49, 0x73, 0x42, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x49, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x49, 0x73, 0x41, 0x6c,
-	0x6c, 0x4d, 0x61, 0x69, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x46, 0x0a, 0x10, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6c, 0x56, 0x69, 0x73,
-	0x69, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4c, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x49,
-	0x73, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x13, 0x49, 0x73, 0x54, 0x65, 0x6c, 0x65,
-	0x6d, 0x65, 0x74, 0x72, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x3c, 0x0a, 0x04, 0x47, 0x6f, 0x4f, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x3e, 0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x3f, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x40, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4e, 0x0a, 0x16, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x79, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a,
-	0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0f, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x3b, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x12, 0x16, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0d,
-	0x46, 0x6f, 0x72, 0x63, 0x65, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x12, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x57,
-	0x0a, 0x1f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x12, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x08,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x32, 0x46, 0x41, 0x12, 0x12, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x32, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x12, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x62, 0x6f, 0x72,
-	0x74, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x62,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x4c, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x49, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x6d,
-	0x61, 0x74, 0x69, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x12, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x49, 0x0a, 0x13, 0x49, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x44,
-	0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0f,
-	0x53, 0x65, 0x74, 0x49, 0x73, 0x44, 0x6f, 0x48, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0c, 0x49, 0x73, 0x44, 0x6f, 0x48, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
-	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x44, 0x0a, 0x12, 0x4d, 0x61, 0x69, 0x6c, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x61,
-	0x70, 0x53, 0x6d, 0x74, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x47, 0x0a,
-	0x15, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d,
-	0x61, 0x70, 0x53, 0x6d, 0x74, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x49, 0x73, 0x50, 0x6f,
-	0x72, 0x74, 0x46, 0x72, 0x65, 0x65, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x4e, 0x0a, 0x12, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65,
-	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4a, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0f, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x0a, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x55, 0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x6f, 0x75,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x51, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x70, 0x70, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x4d,
-	0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x4f, 0x0a, 0x19, 0x49, 0x73, 0x54, 0x4c, 0x53, 0x43, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x54, 0x4c,
-	0x53, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x15,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x4c, 0x53, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0e, 0x52,
-	0x75, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0f,
-	0x53, 0x74, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x3f, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x4d, 0x61, 0x69, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e,
-	0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// Types that are assignable to Event:
+	//
+	//	*UpdateEvent_Error
+	//	*UpdateEvent_ManualReady
+	//	*UpdateEvent_ManualRestartNeeded
+	//	*UpdateEvent_Force
+	//	*UpdateEvent_SilentRestartNeeded
+	//	*UpdateEvent_IsLatestVersion
+	//	*UpdateEvent_CheckFinished
+	//	*UpdateEvent_VersionChanged
+	Event isUpdateEvent_Event `protobuf_oneof:"event"`
 }
 
-var (
-	file_bridge_proto_rawDescOnce sync.Once
-	file_bridge_proto_rawDescData = file_bridge_proto_rawDesc
-)
+func (x *UpdateEvent) Reset() {
+	*x = UpdateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_bridge_proto_rawDescGZIP() []byte {
-	file_bridge_proto_rawDescOnce.Do(func() {
-		file_bridge_proto_rawDescData = protoimpl.X.CompressGZIP(file_bridge_proto_rawDescData)
-	})
-	return file_bridge_proto_rawDescData
+func (x *UpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_bridge_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_bridge_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
-var file_bridge_proto_goTypes = []interface{}{
-	(LogLevel)(0),                                 // 0: grpc.LogLevel
-	(UserState)(0),                                // 1: grpc.UserState
-	(LoginErrorType)(0),                           // 2: grpc.LoginErrorType
-	(UpdateErrorType)(0),                          // 3: grpc.UpdateErrorType
-	(DiskCacheErrorType)(0),                       // 4: grpc.DiskCacheErrorType
-	(MailServerSettingsErrorType)(0),              // 5: grpc.MailServerSettingsErrorType
-	(ErrorCode)(0),                                // 6: grpc.ErrorCode
-	(*AddLogEntryRequest)(nil),                    // 7: grpc.AddLogEntryRequest
-	(*GuiReadyResponse)(nil),                      // 8: grpc.GuiReadyResponse
-	(*ReportBugRequest)(nil),                      // 9: grpc.ReportBugRequest
-	(*LoginRequest)(nil),                          // 10: grpc.LoginRequest
-	(*LoginAbortRequest)(nil),                     // 11: grpc.LoginAbortRequest
-	(*ImapSmtpSettings)(nil),                      // 12: grpc.ImapSmtpSettings
-	(*AvailableKeychainsResponse)(nil),            // 13: grpc.AvailableKeychainsResponse
-	(*User)(nil),                                  // 14: grpc.User
-	(*UserSplitModeRequest)(nil),                  // 15: grpc.UserSplitModeRequest
-	(*UserBadEventFeedbackRequest)(nil),           // 16: grpc.UserBadEventFeedbackRequest
-	(*UserListResponse)(nil),                      // 17: grpc.UserListResponse
-	(*ConfigureAppleMailRequest)(nil),             // 18: grpc.ConfigureAppleMailRequest
-	(*EventStreamRequest)(nil),                    // 19: grpc.EventStreamRequest
-	(*StreamEvent)(nil),                           // 20: grpc.StreamEvent
-	(*AppEvent)(nil),                              // 21: grpc.AppEvent
-	(*InternetStatusEvent)(nil),                   // 22: grpc.InternetStatusEvent
-	(*ToggleAutostartFinishedEvent)(nil),          // 23: grpc.ToggleAutostartFinishedEvent
-	(*ResetFinishedEvent)(nil),                    // 24: grpc.ResetFinishedEvent
-	(*ReportBugFinishedEvent)(nil),                // 25: grpc.ReportBugFinishedEvent
-	(*ReportBugSuccessEvent)(nil),                 // 26: grpc.ReportBugSuccessEvent
-	(*ReportBugErrorEvent)(nil),                   // 27: grpc.ReportBugErrorEvent
-	(*ShowMainWindowEvent)(nil),                   // 28: grpc.ShowMainWindowEvent
-	(*ReportBugFallbackEvent)(nil),                // 29: grpc.ReportBugFallbackEvent
-	(*CertificateInstallSuccessEvent)(nil),        // 30: grpc.CertificateInstallSuccessEvent
-	(*CertificateInstallCanceledEvent)(nil),       // 31: grpc.CertificateInstallCanceledEvent
-	(*CertificateInstallFailedEvent)(nil),         // 32: grpc.CertificateInstallFailedEvent
-	(*RepairStartedEvent)(nil),                    // 33: grpc.RepairStartedEvent
-	(*AllUsersLoadedEvent)(nil),                   // 34: grpc.AllUsersLoadedEvent
-	(*KnowledgeBaseSuggestion)(nil),               // 35: grpc.KnowledgeBaseSuggestion
-	(*KnowledgeBaseSuggestionsEvent)(nil),         // 36: grpc.KnowledgeBaseSuggestionsEvent
-	(*LoginEvent)(nil),                            // 37: grpc.LoginEvent
-	(*LoginErrorEvent)(nil),                       // 38: grpc.LoginErrorEvent
-	(*LoginTfaRequestedEvent)(nil),                // 39: grpc.LoginTfaRequestedEvent
-	(*LoginTwoPasswordsRequestedEvent)(nil),       // 40: grpc.LoginTwoPasswordsRequestedEvent
-	(*LoginFinishedEvent)(nil),                    // 41: grpc.LoginFinishedEvent
-	(*LoginHvRequestedEvent)(nil),                 // 42: grpc.LoginHvRequestedEvent
-	(*UpdateEvent)(nil),                           // 43: grpc.UpdateEvent
-	(*UpdateErrorEvent)(nil),                      // 44: grpc.UpdateErrorEvent
-	(*UpdateManualReadyEvent)(nil),                // 45: grpc.UpdateManualReadyEvent
-	(*UpdateManualRestartNeededEvent)(nil),        // 46: grpc.UpdateManualRestartNeededEvent
-	(*UpdateForceEvent)(nil),                      // 47: grpc.UpdateForceEvent
-	(*UpdateSilentRestartNeeded)(nil),             // 48: grpc.UpdateSilentRestartNeeded
-	(*UpdateIsLatestVersion)(nil),                 // 49: grpc.UpdateIsLatestVersion
-	(*UpdateCheckFinished)(nil),                   // 50: grpc.UpdateCheckFinished
-	(*UpdateVersionChanged)(nil),                  // 51: grpc.UpdateVersionChanged
-	(*DiskCacheEvent)(nil),                        // 52: grpc.DiskCacheEvent
-	(*DiskCacheErrorEvent)(nil),                   // 53: grpc.DiskCacheErrorEvent
-	(*DiskCachePathChangedEvent)(nil),             // 54: grpc.DiskCachePathChangedEvent
-	(*DiskCachePathChangeFinishedEvent)(nil),      // 55: grpc.DiskCachePathChangeFinishedEvent
-	(*MailServerSettingsEvent)(nil),               // 56: grpc.MailServerSettingsEvent
-	(*MailServerSettingsErrorEvent)(nil),          // 57: grpc.MailServerSettingsErrorEvent
-	(*MailServerSettingsChangedEvent)(nil),        // 58: grpc.MailServerSettingsChangedEvent
-	(*ChangeMailServerSettingsFinishedEvent)(nil), // 59: grpc.ChangeMailServerSettingsFinishedEvent
-	(*KeychainEvent)(nil),                         // 60: grpc.KeychainEvent
-	(*ChangeKeychainFinishedEvent)(nil),           // 61: grpc.ChangeKeychainFinishedEvent
-	(*HasNoKeychainEvent)(nil),                    // 62: grpc.HasNoKeychainEvent
-	(*RebuildKeychainEvent)(nil),                  // 63: grpc.RebuildKeychainEvent
-	(*MailEvent)(nil),                             // 64: grpc.MailEvent
-	(*AddressChangedEvent)(nil),                   // 65: grpc.AddressChangedEvent
-	(*AddressChangedLogoutEvent)(nil),             // 66: grpc.AddressChangedLogoutEvent
-	(*ApiCertIssueEvent)(nil),                     // 67: grpc.ApiCertIssueEvent
-	(*UserEvent)(nil),                             // 68: grpc.UserEvent
-	(*ToggleSplitModeFinishedEvent)(nil),          // 69: grpc.ToggleSplitModeFinishedEvent
-	(*UserDisconnectedEvent)(nil),                 // 70: grpc.UserDisconnectedEvent
-	(*UserChangedEvent)(nil),                      // 71: grpc.UserChangedEvent
-	(*UserBadEvent)(nil),                          // 72: grpc.UserBadEvent
-	(*UsedBytesChangedEvent)(nil),                 // 73: grpc.UsedBytesChangedEvent
-	(*ImapLoginFailedEvent)(nil),                  // 74: grpc.ImapLoginFailedEvent
-	(*SyncStartedEvent)(nil),                      // 75: grpc.SyncStartedEvent
-	(*SyncFinishedEvent)(nil),                     // 76: grpc.SyncFinishedEvent
-	(*SyncProgressEvent)(nil),                     // 77: grpc.SyncProgressEvent
-	(*UserNotificationEvent)(nil),                 // 78: grpc.UserNotificationEvent
-	(*GenericErrorEvent)(nil),                     // 79: grpc.GenericErrorEvent
-	(*wrapperspb.StringValue)(nil),                // 80: google.protobuf.StringValue
-	(*emptypb.Empty)(nil),                         // 81: google.protobuf.Empty
-	(*wrapperspb.BoolValue)(nil),                  // 82: google.protobuf.BoolValue
-	(*wrapperspb.Int32Value)(nil),                 // 83: google.protobuf.Int32Value
+func (*UpdateEvent) ProtoMessage() {}
+
+func (x *UpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_bridge_proto_depIdxs = []int32{
-	0,   // 0: grpc.AddLogEntryRequest.level:type_name -> grpc.LogLevel
-	1,   // 1: grpc.User.state:type_name -> grpc.UserState
-	14,  // 2: grpc.UserListResponse.users:type_name -> grpc.User
-	21,  // 3: grpc.StreamEvent.app:type_name -> grpc.AppEvent
-	37,  // 4: grpc.StreamEvent.login:type_name -> grpc.LoginEvent
-	43,  // 5: grpc.StreamEvent.update:type_name -> grpc.UpdateEvent
-	52,  // 6: grpc.StreamEvent.cache:type_name -> grpc.DiskCacheEvent
-	56,  // 7: grpc.StreamEvent.mailServerSettings:type_name -> grpc.MailServerSettingsEvent
-	60,  // 8: grpc.StreamEvent.keychain:type_name -> grpc.KeychainEvent
-	64,  // 9: grpc.StreamEvent.mail:type_name -> grpc.MailEvent
-	68,  // 10: grpc.StreamEvent.user:type_name -> grpc.UserEvent
-	79,  // 11: grpc.StreamEvent.genericError:type_name -> grpc.GenericErrorEvent
-	22,  // 12: grpc.AppEvent.internetStatus:type_name -> grpc.InternetStatusEvent
-	23,  // 13: grpc.AppEvent.toggleAutostartFinished:type_name -> grpc.ToggleAutostartFinishedEvent
-	24,  // 14: grpc.AppEvent.resetFinished:type_name -> grpc.ResetFinishedEvent
-	25,  // 15: grpc.AppEvent.reportBugFinished:type_name -> grpc.ReportBugFinishedEvent
-	26,  // 16: grpc.AppEvent.reportBugSuccess:type_name -> grpc.ReportBugSuccessEvent
-	27,  // 17: grpc.AppEvent.reportBugError:type_name -> grpc.ReportBugErrorEvent
-	28,  // 18: grpc.AppEvent.showMainWindow:type_name -> grpc.ShowMainWindowEvent
-	29,  // 19: grpc.AppEvent.reportBugFallback:type_name -> grpc.ReportBugFallbackEvent
-	30,  // 20: grpc.AppEvent.certificateInstallSuccess:type_name -> grpc.CertificateInstallSuccessEvent
-	31,  // 21: grpc.AppEvent.certificateInstallCanceled:type_name -> grpc.CertificateInstallCanceledEvent
-	32,  // 22: grpc.AppEvent.certificateInstallFailed:type_name -> grpc.CertificateInstallFailedEvent
-	36,  // 23: grpc.AppEvent.knowledgeBaseSuggestions:type_name -> grpc.KnowledgeBaseSuggestionsEvent
-	33,  // 24: grpc.AppEvent.repairStarted:type_name -> grpc.RepairStartedEvent
-	34,  // 25: grpc.AppEvent.allUsersLoaded:type_name -> grpc.AllUsersLoadedEvent
-	78,  // 26: grpc.AppEvent.userNotification:type_name -> grpc.UserNotificationEvent
-	35,  // 27: grpc.KnowledgeBaseSuggestionsEvent.suggestions:type_name -> grpc.KnowledgeBaseSuggestion
-	38,  // 28: grpc.LoginEvent.error:type_name -> grpc.LoginErrorEvent
-	39,  // 29: grpc.LoginEvent.tfaRequested:type_name -> grpc.LoginTfaRequestedEvent
-	40,  // 30: grpc.LoginEvent.twoPasswordRequested:type_name -> grpc.LoginTwoPasswordsRequestedEvent
-	41,  // 31: grpc.LoginEvent.finished:type_name -> grpc.LoginFinishedEvent
-	41,  // 32: grpc.LoginEvent.alreadyLoggedIn:type_name -> grpc.LoginFinishedEvent
-	42,  // 33: grpc.LoginEvent.hvRequested:type_name -> grpc.LoginHvRequestedEvent
-	2,   // 34: grpc.LoginErrorEvent.type:type_name -> grpc.LoginErrorType
-	44,  // 35: grpc.UpdateEvent.error:type_name -> grpc.UpdateErrorEvent
-	45,  // 36: grpc.UpdateEvent.manualReady:type_name -> grpc.UpdateManualReadyEvent
-	46,  // 37: grpc.UpdateEvent.manualRestartNeeded:type_name -> grpc.UpdateManualRestartNeededEvent
-	47,  // 38: grpc.UpdateEvent.force:type_name -> grpc.UpdateForceEvent
-	48,  // 39: grpc.UpdateEvent.silentRestartNeeded:type_name -> grpc.UpdateSilentRestartNeeded
-	49,  // 40: grpc.UpdateEvent.isLatestVersion:type_name -> grpc.UpdateIsLatestVersion
-	50,  // 41: grpc.UpdateEvent.checkFinished:type_name -> grpc.UpdateCheckFinished
-	51,  // 42: grpc.UpdateEvent.versionChanged:type_name -> grpc.UpdateVersionChanged
-	3,   // 43: grpc.UpdateErrorEvent.type:type_name -> grpc.UpdateErrorType
-	53,  // 44: grpc.DiskCacheEvent.error:type_name -> grpc.DiskCacheErrorEvent
-	54,  // 45: grpc.DiskCacheEvent.pathChanged:type_name -> grpc.DiskCachePathChangedEvent
-	55,  // 46: grpc.DiskCacheEvent.pathChangeFinished:type_name -> grpc.DiskCachePathChangeFinishedEvent
-	4,   // 47: grpc.DiskCacheErrorEvent.type:type_name -> grpc.DiskCacheErrorType
-	57,  // 48: grpc.MailServerSettingsEvent.error:type_name -> grpc.MailServerSettingsErrorEvent
-	58,  // 49: grpc.MailServerSettingsEvent.mailServerSettingsChanged:type_name -> grpc.MailServerSettingsChangedEvent
-	59,  // 50: grpc.MailServerSettingsEvent.changeMailServerSettingsFinished:type_name -> grpc.ChangeMailServerSettingsFinishedEvent
-	5,   // 51: grpc.MailServerSettingsErrorEvent.type:type_name -> grpc.MailServerSettingsErrorType
-	12,  // 52: grpc.MailServerSettingsChangedEvent.settings:type_name -> grpc.ImapSmtpSettings
-	61,  // 53: grpc.KeychainEvent.changeKeychainFinished:type_name -> grpc.ChangeKeychainFinishedEvent
-	62,  // 54: grpc.KeychainEvent.hasNoKeychain:type_name -> grpc.HasNoKeychainEvent
-	63,  // 55: grpc.KeychainEvent.rebuildKeychain:type_name -> grpc.RebuildKeychainEvent
-	65,  // 56: grpc.MailEvent.addressChanged:type_name -> grpc.AddressChangedEvent
-	66,  // 57: grpc.MailEvent.addressChangedLogout:type_name -> grpc.AddressChangedLogoutEvent
-	67,  // 58: grpc.MailEvent.apiCertIssue:type_name -> grpc.ApiCertIssueEvent
-	69,  // 59: grpc.UserEvent.toggleSplitModeFinished:type_name -> grpc.ToggleSplitModeFinishedEvent
-	70,  // 60: grpc.UserEvent.userDisconnected:type_name -> grpc.UserDisconnectedEvent
-	71,  // 61: grpc.UserEvent.userChanged:type_name -> grpc.UserChangedEvent
-	72,  // 62: grpc.UserEvent.userBadEvent:type_name -> grpc.UserBadEvent
-	73,  // 63: grpc.UserEvent.usedBytesChangedEvent:type_name -> grpc.UsedBytesChangedEvent
-	74,  // 64: grpc.UserEvent.imapLoginFailedEvent:type_name -> grpc.ImapLoginFailedEvent
-	75,  // 65: grpc.UserEvent.syncStartedEvent:type_name -> grpc.SyncStartedEvent
-	76,  // 66: grpc.UserEvent.syncFinishedEvent:type_name -> grpc.SyncFinishedEvent
-	77,  // 67: grpc.UserEvent.syncProgressEvent:type_name -> grpc.SyncProgressEvent
-	6,   // 68: grpc.GenericErrorEvent.code:type_name -> grpc.ErrorCode
-	80,  // 69: grpc.Bridge.CheckTokens:input_type -> google.protobuf.StringValue
-	7,   // 70: grpc.Bridge.AddLogEntry:input_type -> grpc.AddLogEntryRequest
-	81,  // 71: grpc.Bridge.GuiReady:input_type -> google.protobuf.Empty
-	81,  // 72: grpc.Bridge.Quit:input_type -> google.protobuf.Empty
-	81,  // 73: grpc.Bridge.Restart:input_type -> google.protobuf.Empty
-	81,  // 74: grpc.Bridge.ShowOnStartup:input_type -> google.protobuf.Empty
-	82,  // 75: grpc.Bridge.SetIsAutostartOn:input_type -> google.protobuf.BoolValue
-	81,  // 76: grpc.Bridge.IsAutostartOn:input_type -> google.protobuf.Empty
-	82,  // 77: grpc.Bridge.SetIsBetaEnabled:input_type -> google.protobuf.BoolValue
-	81,  // 78: grpc.Bridge.IsBetaEnabled:input_type -> google.protobuf.Empty
-	82,  // 79: grpc.Bridge.SetIsAllMailVisible:input_type -> google.protobuf.BoolValue
-	81,  // 80: grpc.Bridge.IsAllMailVisible:input_type -> google.protobuf.Empty
-	82,  // 81: grpc.Bridge.SetIsTelemetryDisabled:input_type -> google.protobuf.BoolValue
-	81,  // 82: grpc.Bridge.IsTelemetryDisabled:input_type -> google.protobuf.Empty
-	81,  // 83: grpc.Bridge.GoOs:input_type -> google.protobuf.Empty
-	81,  // 84: grpc.Bridge.TriggerReset:input_type -> google.protobuf.Empty
-	81,  // 85: grpc.Bridge.Version:input_type -> google.protobuf.Empty
-	81,  // 86: grpc.Bridge.LogsPath:input_type -> google.protobuf.Empty
-	81,  // 87: grpc.Bridge.LicensePath:input_type -> google.protobuf.Empty
-	81,  // 88: grpc.Bridge.ReleaseNotesPageLink:input_type -> google.protobuf.Empty
-	81,  // 89: grpc.Bridge.DependencyLicensesLink:input_type -> google.protobuf.Empty
-	81,  // 90: grpc.Bridge.LandingPageLink:input_type -> google.protobuf.Empty
-	80,  // 91: grpc.Bridge.SetColorSchemeName:input_type -> google.protobuf.StringValue
-	81,  // 92: grpc.Bridge.ColorSchemeName:input_type -> google.protobuf.Empty
-	81,  // 93: grpc.Bridge.CurrentEmailClient:input_type -> google.protobuf.Empty
-	9,   // 94: grpc.Bridge.ReportBug:input_type -> grpc.ReportBugRequest
-	80,  // 95: grpc.Bridge.ForceLauncher:input_type -> google.protobuf.StringValue
-	80,  // 96: grpc.Bridge.SetMainExecutable:input_type -> google.protobuf.StringValue
-	80,  // 97: grpc.Bridge.RequestKnowledgeBaseSuggestions:input_type -> google.protobuf.StringValue
-	10,  // 98: grpc.Bridge.Login:input_type -> grpc.LoginRequest
-	10,  // 99: grpc.Bridge.Login2FA:input_type -> grpc.LoginRequest
-	10,  // 100: grpc.Bridge.Login2Passwords:input_type -> grpc.LoginRequest
-	11,  // 101: grpc.Bridge.LoginAbort:input_type -> grpc.LoginAbortRequest
-	81,  // 102: grpc.Bridge.CheckUpdate:input_type -> google.protobuf.Empty
-	81,  // 103: grpc.Bridge.InstallUpdate:input_type -> google.protobuf.Empty
-	82,  // 104: grpc.Bridge.SetIsAutomaticUpdateOn:input_type -> google.protobuf.BoolValue
-	81,  // 105: grpc.Bridge.IsAutomaticUpdateOn:input_type -> google.protobuf.Empty
-	81,  // 106: grpc.Bridge.DiskCachePath:input_type -> google.protobuf.Empty
-	80,  // 107: grpc.Bridge.SetDiskCachePath:input_type -> google.protobuf.StringValue
-	82,  // 108: grpc.Bridge.SetIsDoHEnabled:input_type -> google.protobuf.BoolValue
-	81,  // 109: grpc.Bridge.IsDoHEnabled:input_type -> google.protobuf.Empty
-	81,  // 110: grpc.Bridge.MailServerSettings:input_type -> google.protobuf.Empty
-	12,  // 111: grpc.Bridge.SetMailServerSettings:input_type -> grpc.ImapSmtpSettings
-	81,  // 112: grpc.Bridge.Hostname:input_type -> google.protobuf.Empty
-	83,  // 113: grpc.Bridge.IsPortFree:input_type -> google.protobuf.Int32Value
-	81,  // 114: grpc.Bridge.AvailableKeychains:input_type -> google.protobuf.Empty
-	80,  // 115: grpc.Bridge.SetCurrentKeychain:input_type -> google.protobuf.StringValue
-	81,  // 116: grpc.Bridge.CurrentKeychain:input_type -> google.protobuf.Empty
-	81,  // 117: grpc.Bridge.GetUserList:input_type -> google.protobuf.Empty
-	80,  // 118: grpc.Bridge.GetUser:input_type -> google.protobuf.StringValue
-	15,  // 119: grpc.Bridge.SetUserSplitMode:input_type -> grpc.UserSplitModeRequest
-	16,  // 120: grpc.Bridge.SendBadEventUserFeedback:input_type -> grpc.UserBadEventFeedbackRequest
-	80,  // 121: grpc.Bridge.LogoutUser:input_type -> google.protobuf.StringValue
-	80,  // 122: grpc.Bridge.RemoveUser:input_type -> google.protobuf.StringValue
-	18,  // 123: grpc.Bridge.ConfigureUserAppleMail:input_type -> grpc.ConfigureAppleMailRequest
-	81,  // 124: grpc.Bridge.IsTLSCertificateInstalled:input_type -> google.protobuf.Empty
-	81,  // 125: grpc.Bridge.InstallTLSCertificate:input_type -> google.protobuf.Empty
-	80,  // 126: grpc.Bridge.ExportTLSCertificates:input_type -> google.protobuf.StringValue
-	19,  // 127: grpc.Bridge.RunEventStream:input_type -> grpc.EventStreamRequest
-	81,  // 128: grpc.Bridge.StopEventStream:input_type -> google.protobuf.Empty
-	81,  // 129: grpc.Bridge.TriggerRepair:input_type -> google.protobuf.Empty
-	80,  // 130: grpc.Bridge.CheckTokens:output_type -> google.protobuf.StringValue
-	81,  // 131: grpc.Bridge.AddLogEntry:output_type -> google.protobuf.Empty
-	8,   // 132: grpc.Bridge.GuiReady:output_type -> grpc.GuiReadyResponse
-	81,  // 133: grpc.Bridge.Quit:output_type -> google.protobuf.Empty
-	81,  // 134: grpc.Bridge.Restart:output_type -> google.protobuf.Empty
-	82,  // 135: grpc.Bridge.ShowOnStartup:output_type -> google.protobuf.BoolValue
-	81,  // 136: grpc.Bridge.SetIsAutostartOn:output_type -> google.protobuf.Empty
-	82,  // 137: grpc.Bridge.IsAutostartOn:output_type -> google.protobuf.BoolValue
-	81,  // 138: grpc.Bridge.SetIsBetaEnabled:output_type -> google.protobuf.Empty
-	82,  // 139: grpc.Bridge.IsBetaEnabled:output_type -> google.protobuf.BoolValue
-	81,  // 140: grpc.Bridge.SetIsAllMailVisible:output_type -> google.protobuf.Empty
-	82,  // 141: grpc.Bridge.IsAllMailVisible:output_type -> google.protobuf.BoolValue
-	81,  // 142: grpc.Bridge.SetIsTelemetryDisabled:output_type -> google.protobuf.Empty
-	82,  // 143: grpc.Bridge.IsTelemetryDisabled:output_type -> google.protobuf.BoolValue
-	80,  // 144: grpc.Bridge.GoOs:output_type -> google.protobuf.StringValue
-	81,  // 145: grpc.Bridge.TriggerReset:output_type -> google.protobuf.Empty
-	80,  // 146: grpc.Bridge.Version:output_type -> google.protobuf.StringValue
-	80,  // 147: grpc.Bridge.LogsPath:output_type -> google.protobuf.StringValue
-	80,  // 148: grpc.Bridge.LicensePath:output_type -> google.protobuf.StringValue
-	80,  // 149: grpc.Bridge.ReleaseNotesPageLink:output_type -> google.protobuf.StringValue
-	80,  // 150: grpc.Bridge.DependencyLicensesLink:output_type -> google.protobuf.StringValue
-	80,  // 151: grpc.Bridge.LandingPageLink:output_type -> google.protobuf.StringValue
-	81,  // 152: grpc.Bridge.SetColorSchemeName:output_type -> google.protobuf.Empty
-	80,  // 153: grpc.Bridge.ColorSchemeName:output_type -> google.protobuf.StringValue
-	80,  // 154: grpc.Bridge.CurrentEmailClient:output_type -> google.protobuf.StringValue
-	81,  // 155: grpc.Bridge.ReportBug:output_type -> google.protobuf.Empty
-	81,  // 156: grpc.Bridge.ForceLauncher:output_type -> google.protobuf.Empty
-	81,  // 157: grpc.Bridge.SetMainExecutable:output_type -> google.protobuf.Empty
-	81,  // 158: grpc.Bridge.RequestKnowledgeBaseSuggestions:output_type -> google.protobuf.Empty
-	81,  // 159: grpc.Bridge.Login:output_type -> google.protobuf.Empty
-	81,  // 160: grpc.Bridge.Login2FA:output_type -> google.protobuf.Empty
-	81,  // 161: grpc.Bridge.Login2Passwords:output_type -> google.protobuf.Empty
-	81,  // 162: grpc.Bridge.LoginAbort:output_type -> google.protobuf.Empty
-	81,  // 163: grpc.Bridge.CheckUpdate:output_type -> google.protobuf.Empty
-	81,  // 164: grpc.Bridge.InstallUpdate:output_type -> google.protobuf.Empty
-	81,  // 165: grpc.Bridge.SetIsAutomaticUpdateOn:output_type -> google.protobuf.Empty
-	82,  // 166: grpc.Bridge.IsAutomaticUpdateOn:output_type -> google.protobuf.BoolValue
-	80,  // 167: grpc.Bridge.DiskCachePath:output_type -> google.protobuf.StringValue
-	81,  // 168: grpc.Bridge.SetDiskCachePath:output_type -> google.protobuf.Empty
-	81,  // 169: grpc.Bridge.SetIsDoHEnabled:output_type -> google.protobuf.Empty
-	82,  // 170: grpc.Bridge.IsDoHEnabled:output_type -> google.protobuf.BoolValue
-	12,  // 171: grpc.Bridge.MailServerSettings:output_type -> grpc.ImapSmtpSettings
-	81,  // 172: grpc.Bridge.SetMailServerSettings:output_type -> google.protobuf.Empty
-	80,  // 173: grpc.Bridge.Hostname:output_type -> google.protobuf.StringValue
-	82,  // 174: grpc.Bridge.IsPortFree:output_type -> google.protobuf.BoolValue
-	13,  // 175: grpc.Bridge.AvailableKeychains:output_type -> grpc.AvailableKeychainsResponse
-	81,  // 176: grpc.Bridge.SetCurrentKeychain:output_type -> google.protobuf.Empty
-	80,  // 177: grpc.Bridge.CurrentKeychain:output_type -> google.protobuf.StringValue
-	17,  // 178: grpc.Bridge.GetUserList:output_type -> grpc.UserListResponse
-	14,  // 179: grpc.Bridge.GetUser:output_type -> grpc.User
-	81,  // 180: grpc.Bridge.SetUserSplitMode:output_type -> google.protobuf.Empty
-	81,  // 181: grpc.Bridge.SendBadEventUserFeedback:output_type -> google.protobuf.Empty
-	81,  // 182: grpc.Bridge.LogoutUser:output_type -> google.protobuf.Empty
-	81,  // 183: grpc.Bridge.RemoveUser:output_type -> google.protobuf.Empty
-	81,  // 184: grpc.Bridge.ConfigureUserAppleMail:output_type -> google.protobuf.Empty
-	82,  // 185: grpc.Bridge.IsTLSCertificateInstalled:output_type -> google.protobuf.BoolValue
-	81,  // 186: grpc.Bridge.InstallTLSCertificate:output_type -> google.protobuf.Empty
-	81,  // 187: grpc.Bridge.ExportTLSCertificates:output_type -> google.protobuf.Empty
-	20,  // 188: grpc.Bridge.RunEventStream:output_type -> grpc.StreamEvent
-	81,  // 189: grpc.Bridge.StopEventStream:output_type -> google.protobuf.Empty
-	81,  // 190: grpc.Bridge.TriggerRepair:output_type -> google.protobuf.Empty
-	130, // [130:191] is the sub-list for method output_type
-	69,  // [69:130] is the sub-list for method input_type
-	69,  // [69:69] is the sub-list for extension type_name
-	69,  // [69:69] is the sub-list for extension extendee
-	0,   // [0:69] is the sub-list for field type_name
+
+// Deprecated: Use UpdateEvent.ProtoReflect.Descriptor instead.
+func (*UpdateEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{80}
 }
 
-func init() { file_bridge_proto_init() }
-func file_bridge_proto_init() {
-	if File_bridge_proto != nil {
-		return
+func (m *UpdateEvent) GetEvent() isUpdateEvent_Event {
+	if m != nil {
+		return m.Event
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_bridge_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLogEntryRequest); i {
+	return nil
+}
+
+func (x *UpdateEvent) GetError() *UpdateErrorEvent {
+	if x, ok := x.GetEvent().(*UpdateEvent_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *UpdateEvent) GetManualReady() *UpdateManualReadyEvent {
+	if x, ok := x.GetEvent().(*UpdateEvent_ManualReady); ok {
+		return x.ManualReady
+	}
+	return nil
+}
+
+func (x *UpdateEvent) GetManualRestartNeeded() *UpdateManualRestartNeededEvent {
+	if x, ok := x.GetEvent().(*UpdateEvent_ManualRestartNeeded); ok {
+		return x.ManualRestartNeeded
+	}
+	return nil
+}
+
+func (x *UpdateEvent) GetForce() *UpdateForceEvent {
+	if x, ok := x.GetEvent().(*UpdateEvent_Force); ok {
+		return x.Force
+	}
+	return nil
+}
+
+func (x *UpdateEvent) GetSilentRestartNeeded() *UpdateSilentRestartNeeded {
+	if x, ok := x.GetEvent().(*UpdateEvent_SilentRestartNeeded); ok {
+		return x.SilentRestartNeeded
+	}
+	return nil
+}
+
+func (x *UpdateEvent) GetIsLatestVersion() *UpdateIsLatestVersion {
+	if x, ok := x.GetEvent().(*UpdateEvent_IsLatestVersion); ok {
+		return x.IsLatestVersion
+	}
+	return nil
+}
+
+func (x *UpdateEvent) GetCheckFinished() *UpdateCheckFinished {
+	if x, ok := x.GetEvent().(*UpdateEvent_CheckFinished); ok {
+		return x.CheckFinished
+	}
+	return nil
+}
+
+func (x *UpdateEvent) GetVersionChanged() *UpdateVersionChanged {
+	if x, ok := x.GetEvent().(*UpdateEvent_VersionChanged); ok {
+		return x.VersionChanged
+	}
+	return nil
+}
+
+type isUpdateEvent_Event interface {
+	isUpdateEvent_Event()
+}
+
+type UpdateEvent_Error struct {
+	Error *UpdateErrorEvent `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type UpdateEvent_ManualReady struct {
+	ManualReady *UpdateManualReadyEvent `protobuf:"bytes,2,opt,name=manualReady,proto3,oneof"`
+}
+
+type UpdateEvent_ManualRestartNeeded struct {
+	ManualRestartNeeded *UpdateManualRestartNeededEvent `protobuf:"bytes,3,opt,name=manualRestartNeeded,proto3,oneof"`
+}
+
+type UpdateEvent_Force struct {
+	Force *UpdateForceEvent `protobuf:"bytes,4,opt,name=force,proto3,oneof"`
+}
+
+type UpdateEvent_SilentRestartNeeded struct {
+	SilentRestartNeeded *UpdateSilentRestartNeeded `protobuf:"bytes,5,opt,name=silentRestartNeeded,proto3,oneof"`
+}
+
+type UpdateEvent_IsLatestVersion struct {
+	IsLatestVersion *UpdateIsLatestVersion `protobuf:"bytes,6,opt,name=isLatestVersion,proto3,oneof"`
+}
+
+type UpdateEvent_CheckFinished struct {
+	CheckFinished *UpdateCheckFinished `protobuf:"bytes,7,opt,name=checkFinished,proto3,oneof"`
+}
+
+type UpdateEvent_VersionChanged struct {
+	VersionChanged *UpdateVersionChanged `protobuf:"bytes,8,opt,name=versionChanged,proto3,oneof"`
+}
+
+func (*UpdateEvent_Error) isUpdateEvent_Event() {}
+
+func (*UpdateEvent_ManualReady) isUpdateEvent_Event() {}
+
+func (*UpdateEvent_ManualRestartNeeded) isUpdateEvent_Event() {}
+
+func (*UpdateEvent_Force) isUpdateEvent_Event() {}
+
+func (*UpdateEvent_SilentRestartNeeded) isUpdateEvent_Event() {}
+
+func (*UpdateEvent_IsLatestVersion) isUpdateEvent_Event() {}
+
+func (*UpdateEvent_CheckFinished) isUpdateEvent_Event() {}
+
+func (*UpdateEvent_VersionChanged) isUpdateEvent_Event() {}
+
+type UpdateErrorEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type UpdateErrorType `protobuf:"varint,1,opt,name=type,proto3,enum=grpc.UpdateErrorType" json:"type,omitempty"`
+}
+
+func (x *UpdateErrorEvent) Reset() {
+	*x = UpdateErrorEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateErrorEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateErrorEvent) ProtoMessage() {}
+
+func (x *UpdateErrorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateErrorEvent.ProtoReflect.Descriptor instead.
+func (*UpdateErrorEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UpdateErrorEvent) GetType() UpdateErrorType {
+	if x != nil {
+		return x.Type
+	}
+	return UpdateErrorType_UPDATE_MANUAL_ERROR
+}
+
+type UpdateManualReadyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateManualReadyEvent) Reset() {
+	*x = UpdateManualReadyEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateManualReadyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManualReadyEvent) ProtoMessage() {}
+
+func (x *UpdateManualReadyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManualReadyEvent.ProtoReflect.Descriptor instead.
+func (*UpdateManualReadyEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateManualReadyEvent) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type UpdateManualRestartNeededEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateManualRestartNeededEvent) Reset() {
+	*x = UpdateManualRestartNeededEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateManualRestartNeededEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManualRestartNeededEvent) ProtoMessage() {}
+
+func (x *UpdateManualRestartNeededEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManualRestartNeededEvent.ProtoReflect.Descriptor instead.
+func (*UpdateManualRestartNeededEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{83}
+}
+
+type UpdateForceEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateForceEvent) Reset() {
+	*x = UpdateForceEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateForceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateForceEvent) ProtoMessage() {}
+
+func (x *UpdateForceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateForceEvent.ProtoReflect.Descriptor instead.
+func (*UpdateForceEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateForceEvent) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type UpdateSilentRestartNeeded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateSilentRestartNeeded) Reset() {
+	*x = UpdateSilentRestartNeeded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSilentRestartNeeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSilentRestartNeeded) ProtoMessage() {}
+
+func (x *UpdateSilentRestartNeeded) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSilentRestartNeeded.ProtoReflect.Descriptor instead.
+func (*UpdateSilentRestartNeeded) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{85}
+}
+
+type UpdateIsLatestVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateIsLatestVersion) Reset() {
+	*x = UpdateIsLatestVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateIsLatestVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIsLatestVersion) ProtoMessage() {}
+
+func (x *UpdateIsLatestVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIsLatestVersion.ProtoReflect.Descriptor instead.
+func (*UpdateIsLatestVersion) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{86}
+}
+
+type UpdateCheckFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateCheckFinished) Reset() {
+	*x = UpdateCheckFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCheckFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCheckFinished) ProtoMessage() {}
+
+func (x *UpdateCheckFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCheckFinished.ProtoReflect.Descriptor instead.
+func (*UpdateCheckFinished) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{87}
+}
+
+type UpdateVersionChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateVersionChanged) Reset() {
+	*x = UpdateVersionChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVersionChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVersionChanged) ProtoMessage() {}
+
+func (x *UpdateVersionChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVersionChanged.ProtoReflect.Descriptor instead.
+func (*UpdateVersionChanged) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{88}
+}
+
+// **********************************************************
+// Cache on disk related events
+// **********************************************************
+type DiskCacheEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*DiskCacheEvent_Error
+	//	*DiskCacheEvent_PathChanged
+	//	*DiskCacheEvent_PathChangeFinished
+	Event isDiskCacheEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *DiskCacheEvent) Reset() {
+	*x = DiskCacheEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskCacheEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskCacheEvent) ProtoMessage() {}
+
+func (x *DiskCacheEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskCacheEvent.ProtoReflect.Descriptor instead.
+func (*DiskCacheEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{89}
+}
+
+func (m *DiskCacheEvent) GetEvent() isDiskCacheEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *DiskCacheEvent) GetError() *DiskCacheErrorEvent {
+	if x, ok := x.GetEvent().(*DiskCacheEvent_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *DiskCacheEvent) GetPathChanged() *DiskCachePathChangedEvent {
+	if x, ok := x.GetEvent().(*DiskCacheEvent_PathChanged); ok {
+		return x.PathChanged
+	}
+	return nil
+}
+
+func (x *DiskCacheEvent) GetPathChangeFinished() *DiskCachePathChangeFinishedEvent {
+	if x, ok := x.GetEvent().(*DiskCacheEvent_PathChangeFinished); ok {
+		return x.PathChangeFinished
+	}
+	return nil
+}
+
+type isDiskCacheEvent_Event interface {
+	isDiskCacheEvent_Event()
+}
+
+type DiskCacheEvent_Error struct {
+	Error *DiskCacheErrorEvent `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type DiskCacheEvent_PathChanged struct {
+	PathChanged *DiskCachePathChangedEvent `protobuf:"bytes,2,opt,name=pathChanged,proto3,oneof"`
+}
+
+type DiskCacheEvent_PathChangeFinished struct {
+	PathChangeFinished *DiskCachePathChangeFinishedEvent `protobuf:"bytes,3,opt,name=pathChangeFinished,proto3,oneof"`
+}
+
+func (*DiskCacheEvent_Error) isDiskCacheEvent_Event() {}
+
+func (*DiskCacheEvent_PathChanged) isDiskCacheEvent_Event() {}
+
+func (*DiskCacheEvent_PathChangeFinished) isDiskCacheEvent_Event() {}
+
+type DiskCacheErrorEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type DiskCacheErrorType `protobuf:"varint,1,opt,name=type,proto3,enum=grpc.DiskCacheErrorType" json:"type,omitempty"`
+}
+
+func (x *DiskCacheErrorEvent) Reset() {
+	*x = DiskCacheErrorEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskCacheErrorEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskCacheErrorEvent) ProtoMessage() {}
+
+func (x *DiskCacheErrorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskCacheErrorEvent.ProtoReflect.Descriptor instead.
+func (*DiskCacheErrorEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DiskCacheErrorEvent) GetType() DiskCacheErrorType {
+	if x != nil {
+		return x.Type
+	}
+	return DiskCacheErrorType_CANT_MOVE_DISK_CACHE_ERROR
+}
+
+type DiskCachePathChangedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *DiskCachePathChangedEvent) Reset() {
+	*x = DiskCachePathChangedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskCachePathChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskCachePathChangedEvent) ProtoMessage() {}
+
+func (x *DiskCachePathChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskCachePathChangedEvent.ProtoReflect.Descriptor instead.
+func (*DiskCachePathChangedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DiskCachePathChangedEvent) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type DiskCachePathChangeFinishedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DiskCachePathChangeFinishedEvent) Reset() {
+	*x = DiskCachePathChangeFinishedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskCachePathChangeFinishedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskCachePathChangeFinishedEvent) ProtoMessage() {}
+
+func (x *DiskCachePathChangeFinishedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskCachePathChangeFinishedEvent.ProtoReflect.Descriptor instead.
+func (*DiskCachePathChangeFinishedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{92}
+}
+
+// **********************************************************
+// Mail server settings related events
+// **********************************************************
+type MailServerSettingsEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*MailServerSettingsEvent_Error
+	//	*MailServerSettingsEvent_MailServerSettingsChanged
+	//	*MailServerSettingsEvent_ChangeMailServerSettingsFinished
+	Event isMailServerSettingsEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *MailServerSettingsEvent) Reset() {
+	*x = MailServerSettingsEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailServerSettingsEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailServerSettingsEvent) ProtoMessage() {}
+
+func (x *MailServerSettingsEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailServerSettingsEvent.ProtoReflect.Descriptor instead.
+func (*MailServerSettingsEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{93}
+}
+
+func (m *MailServerSettingsEvent) GetEvent() isMailServerSettingsEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *MailServerSettingsEvent) GetError() *MailServerSettingsErrorEvent {
+	if x, ok := x.GetEvent().(*MailServerSettingsEvent_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *MailServerSettingsEvent) GetMailServerSettingsChanged() *MailServerSettingsChangedEvent {
+	if x, ok := x.GetEvent().(*MailServerSettingsEvent_MailServerSettingsChanged); ok {
+		return x.MailServerSettingsChanged
+	}
+	return nil
+}
+
+func (x *MailServerSettingsEvent) GetChangeMailServerSettingsFinished() *ChangeMailServerSettingsFinishedEvent {
+	if x, ok := x.GetEvent().(*MailServerSettingsEvent_ChangeMailServerSettingsFinished); ok {
+		return x.ChangeMailServerSettingsFinished
+	}
+	return nil
+}
+
+type isMailServerSettingsEvent_Event interface {
+	isMailServerSettingsEvent_Event()
+}
+
+type MailServerSettingsEvent_Error struct {
+	Error *MailServerSettingsErrorEvent `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type MailServerSettingsEvent_MailServerSettingsChanged struct {
+	MailServerSettingsChanged *MailServerSettingsChangedEvent `protobuf:"bytes,2,opt,name=mailServerSettingsChanged,proto3,oneof"`
+}
+
+type MailServerSettingsEvent_ChangeMailServerSettingsFinished struct {
+	ChangeMailServerSettingsFinished *ChangeMailServerSettingsFinishedEvent `protobuf:"bytes,3,opt,name=changeMailServerSettingsFinished,proto3,oneof"`
+}
+
+func (*MailServerSettingsEvent_Error) isMailServerSettingsEvent_Event() {}
+
+func (*MailServerSettingsEvent_MailServerSettingsChanged) isMailServerSettingsEvent_Event() {}
+
+func (*MailServerSettingsEvent_ChangeMailServerSettingsFinished) isMailServerSettingsEvent_Event() {}
+
+type MailServerSettingsErrorEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type MailServerSettingsErrorType `protobuf:"varint,1,opt,name=type,proto3,enum=grpc.MailServerSettingsErrorType" json:"type,omitempty"`
+}
+
+func (x *MailServerSettingsErrorEvent) Reset() {
+	*x = MailServerSettingsErrorEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailServerSettingsErrorEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailServerSettingsErrorEvent) ProtoMessage() {}
+
+func (x *MailServerSettingsErrorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailServerSettingsErrorEvent.ProtoReflect.Descriptor instead.
+func (*MailServerSettingsErrorEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *MailServerSettingsErrorEvent) GetType() MailServerSettingsErrorType {
+	if x != nil {
+		return x.Type
+	}
+	return MailServerSettingsErrorType_IMAP_PORT_STARTUP_ERROR
+}
+
+type MailServerSettingsChangedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *ImapSmtpSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *MailServerSettingsChangedEvent) Reset() {
+	*x = MailServerSettingsChangedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailServerSettingsChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailServerSettingsChangedEvent) ProtoMessage() {}
+
+func (x *MailServerSettingsChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailServerSettingsChangedEvent.ProtoReflect.Descriptor instead.
+func (*MailServerSettingsChangedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *MailServerSettingsChangedEvent) GetSettings() *ImapSmtpSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ChangeMailServerSettingsFinishedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChangeMailServerSettingsFinishedEvent) Reset() {
+	*x = ChangeMailServerSettingsFinishedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeMailServerSettingsFinishedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeMailServerSettingsFinishedEvent) ProtoMessage() {}
+
+func (x *ChangeMailServerSettingsFinishedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeMailServerSettingsFinishedEvent.ProtoReflect.Descriptor instead.
+func (*ChangeMailServerSettingsFinishedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{96}
+}
+
+// **********************************************************
+// keychain related events
+// **********************************************************
+type KeychainEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*KeychainEvent_ChangeKeychainFinished
+	//	*KeychainEvent_HasNoKeychain
+	//	*KeychainEvent_RebuildKeychain
+	//	*KeychainEvent_CredentialStoreUnavailable
+	//	*KeychainEvent_CredentialStoreAvailable
+	Event isKeychainEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *KeychainEvent) Reset() {
+	*x = KeychainEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeychainEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeychainEvent) ProtoMessage() {}
+
+func (x *KeychainEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeychainEvent.ProtoReflect.Descriptor instead.
+func (*KeychainEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{97}
+}
+
+func (m *KeychainEvent) GetEvent() isKeychainEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *KeychainEvent) GetChangeKeychainFinished() *ChangeKeychainFinishedEvent {
+	if x, ok := x.GetEvent().(*KeychainEvent_ChangeKeychainFinished); ok {
+		return x.ChangeKeychainFinished
+	}
+	return nil
+}
+
+func (x *KeychainEvent) GetHasNoKeychain() *HasNoKeychainEvent {
+	if x, ok := x.GetEvent().(*KeychainEvent_HasNoKeychain); ok {
+		return x.HasNoKeychain
+	}
+	return nil
+}
+
+func (x *KeychainEvent) GetRebuildKeychain() *RebuildKeychainEvent {
+	if x, ok := x.GetEvent().(*KeychainEvent_RebuildKeychain); ok {
+		return x.RebuildKeychain
+	}
+	return nil
+}
+
+func (x *KeychainEvent) GetCredentialStoreUnavailable() *CredentialStoreUnavailableEvent {
+	if x, ok := x.GetEvent().(*KeychainEvent_CredentialStoreUnavailable); ok {
+		return x.CredentialStoreUnavailable
+	}
+	return nil
+}
+
+func (x *KeychainEvent) GetCredentialStoreAvailable() *CredentialStoreAvailableEvent {
+	if x, ok := x.GetEvent().(*KeychainEvent_CredentialStoreAvailable); ok {
+		return x.CredentialStoreAvailable
+	}
+	return nil
+}
+
+type isKeychainEvent_Event interface {
+	isKeychainEvent_Event()
+}
+
+type KeychainEvent_ChangeKeychainFinished struct {
+	ChangeKeychainFinished *ChangeKeychainFinishedEvent `protobuf:"bytes,1,opt,name=changeKeychainFinished,proto3,oneof"`
+}
+
+type KeychainEvent_HasNoKeychain struct {
+	HasNoKeychain *HasNoKeychainEvent `protobuf:"bytes,2,opt,name=hasNoKeychain,proto3,oneof"`
+}
+
+type KeychainEvent_RebuildKeychain struct {
+	RebuildKeychain *RebuildKeychainEvent `protobuf:"bytes,3,opt,name=rebuildKeychain,proto3,oneof"`
+}
+
+type KeychainEvent_CredentialStoreUnavailable struct {
+	CredentialStoreUnavailable *CredentialStoreUnavailableEvent `protobuf:"bytes,4,opt,name=credentialStoreUnavailable,proto3,oneof"`
+}
+
+type KeychainEvent_CredentialStoreAvailable struct {
+	CredentialStoreAvailable *CredentialStoreAvailableEvent `protobuf:"bytes,5,opt,name=credentialStoreAvailable,proto3,oneof"`
+}
+
+func (*KeychainEvent_ChangeKeychainFinished) isKeychainEvent_Event() {}
+
+func (*KeychainEvent_HasNoKeychain) isKeychainEvent_Event() {}
+
+func (*KeychainEvent_RebuildKeychain) isKeychainEvent_Event() {}
+
+func (*KeychainEvent_CredentialStoreUnavailable) isKeychainEvent_Event() {}
+
+func (*KeychainEvent_CredentialStoreAvailable) isKeychainEvent_Event() {}
+
+type ChangeKeychainFinishedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChangeKeychainFinishedEvent) Reset() {
+	*x = ChangeKeychainFinishedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeKeychainFinishedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeKeychainFinishedEvent) ProtoMessage() {}
+
+func (x *ChangeKeychainFinishedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeKeychainFinishedEvent.ProtoReflect.Descriptor instead.
+func (*ChangeKeychainFinishedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{98}
+}
+
+type HasNoKeychainEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HasNoKeychainEvent) Reset() {
+	*x = HasNoKeychainEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasNoKeychainEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasNoKeychainEvent) ProtoMessage() {}
+
+func (x *HasNoKeychainEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasNoKeychainEvent.ProtoReflect.Descriptor instead.
+func (*HasNoKeychainEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{99}
+}
+
+type RebuildKeychainEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RebuildKeychainEvent) Reset() {
+	*x = RebuildKeychainEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebuildKeychainEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildKeychainEvent) ProtoMessage() {}
+
+func (x *RebuildKeychainEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildKeychainEvent.ProtoReflect.Descriptor instead.
+func (*RebuildKeychainEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{100}
+}
+
+type CredentialStoreUnavailableEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CredentialStoreUnavailableEvent) Reset() {
+	*x = CredentialStoreUnavailableEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CredentialStoreUnavailableEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialStoreUnavailableEvent) ProtoMessage() {}
+
+func (x *CredentialStoreUnavailableEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialStoreUnavailableEvent.ProtoReflect.Descriptor instead.
+func (*CredentialStoreUnavailableEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CredentialStoreUnavailableEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CredentialStoreAvailableEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CredentialStoreAvailableEvent) Reset() {
+	*x = CredentialStoreAvailableEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CredentialStoreAvailableEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialStoreAvailableEvent) ProtoMessage() {}
+
+func (x *CredentialStoreAvailableEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialStoreAvailableEvent.ProtoReflect.Descriptor instead.
+func (*CredentialStoreAvailableEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{102}
+}
+
+// **********************************************************
+// Mail related events
+// **********************************************************
+type MailEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*MailEvent_AddressChanged
+	//	*MailEvent_AddressChangedLogout
+	//	*MailEvent_ApiCertIssue
+	//	*MailEvent_MessageTooLarge
+	Event isMailEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *MailEvent) Reset() {
+	*x = MailEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailEvent) ProtoMessage() {}
+
+func (x *MailEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailEvent.ProtoReflect.Descriptor instead.
+func (*MailEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{103}
+}
+
+func (m *MailEvent) GetEvent() isMailEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *MailEvent) GetAddressChanged() *AddressChangedEvent {
+	if x, ok := x.GetEvent().(*MailEvent_AddressChanged); ok {
+		return x.AddressChanged
+	}
+	return nil
+}
+
+func (x *MailEvent) GetAddressChangedLogout() *AddressChangedLogoutEvent {
+	if x, ok := x.GetEvent().(*MailEvent_AddressChangedLogout); ok {
+		return x.AddressChangedLogout
+	}
+	return nil
+}
+
+func (x *MailEvent) GetApiCertIssue() *ApiCertIssueEvent {
+	if x, ok := x.GetEvent().(*MailEvent_ApiCertIssue); ok {
+		return x.ApiCertIssue
+	}
+	return nil
+}
+
+func (x *MailEvent) GetMessageTooLarge() *MessageTooLargeEvent {
+	if x, ok := x.GetEvent().(*MailEvent_MessageTooLarge); ok {
+		return x.MessageTooLarge
+	}
+	return nil
+}
+
+type isMailEvent_Event interface {
+	isMailEvent_Event()
+}
+
+type MailEvent_AddressChanged struct {
+	AddressChanged *AddressChangedEvent `protobuf:"bytes,1,opt,name=addressChanged,proto3,oneof"`
+}
+
+type MailEvent_AddressChangedLogout struct {
+	AddressChangedLogout *AddressChangedLogoutEvent `protobuf:"bytes,2,opt,name=addressChangedLogout,proto3,oneof"`
+}
+
+type MailEvent_ApiCertIssue struct {
+	ApiCertIssue *ApiCertIssueEvent `protobuf:"bytes,3,opt,name=apiCertIssue,proto3,oneof"`
+}
+
+type MailEvent_MessageTooLarge struct {
+	MessageTooLarge *MessageTooLargeEvent `protobuf:"bytes,4,opt,name=messageTooLarge,proto3,oneof"`
+}
+
+func (*MailEvent_AddressChanged) isMailEvent_Event() {}
+
+func (*MailEvent_AddressChangedLogout) isMailEvent_Event() {}
+
+func (*MailEvent_ApiCertIssue) isMailEvent_Event() {}
+
+func (*MailEvent_MessageTooLarge) isMailEvent_Event() {}
+
+type AddressChangedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AddressChangedEvent) Reset() {
+	*x = AddressChangedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressChangedEvent) ProtoMessage() {}
+
+func (x *AddressChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressChangedEvent.ProtoReflect.Descriptor instead.
+func (*AddressChangedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AddressChangedEvent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AddressChangedLogoutEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AddressChangedLogoutEvent) Reset() {
+	*x = AddressChangedLogoutEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressChangedLogoutEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressChangedLogoutEvent) ProtoMessage() {}
+
+func (x *AddressChangedLogoutEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressChangedLogoutEvent.ProtoReflect.Descriptor instead.
+func (*AddressChangedLogoutEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AddressChangedLogoutEvent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ApiCertIssueEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApiCertIssueEvent) Reset() {
+	*x = ApiCertIssueEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiCertIssueEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiCertIssueEvent) ProtoMessage() {}
+
+func (x *ApiCertIssueEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiCertIssueEvent.ProtoReflect.Descriptor instead.
+func (*ApiCertIssueEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{106}
+}
+
+type MessageTooLargeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Size   int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Limit  int64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *MessageTooLargeEvent) Reset() {
+	*x = MessageTooLargeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageTooLargeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageTooLargeEvent) ProtoMessage() {}
+
+func (x *MessageTooLargeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageTooLargeEvent.ProtoReflect.Descriptor instead.
+func (*MessageTooLargeEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *MessageTooLargeEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MessageTooLargeEvent) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MessageTooLargeEvent) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type UserEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*UserEvent_ToggleSplitModeFinished
+	//	*UserEvent_UserDisconnected
+	//	*UserEvent_UserChanged
+	//	*UserEvent_UserBadEvent
+	//	*UserEvent_UsedBytesChangedEvent
+	//	*UserEvent_ImapLoginFailedEvent
+	//	*UserEvent_SyncStartedEvent
+	//	*UserEvent_SyncFinishedEvent
+	//	*UserEvent_SyncProgressEvent
+	//	*UserEvent_StorageAlmostFullEvent
+	//	*UserEvent_ReauthRequiredEvent
+	//	*UserEvent_ReadReceiptRequestedEvent
+	Event isUserEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *UserEvent) Reset() {
+	*x = UserEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEvent) ProtoMessage() {}
+
+func (x *UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{108}
+}
+
+func (m *UserEvent) GetEvent() isUserEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *UserEvent) GetToggleSplitModeFinished() *ToggleSplitModeFinishedEvent {
+	if x, ok := x.GetEvent().(*UserEvent_ToggleSplitModeFinished); ok {
+		return x.ToggleSplitModeFinished
+	}
+	return nil
+}
+
+func (x *UserEvent) GetUserDisconnected() *UserDisconnectedEvent {
+	if x, ok := x.GetEvent().(*UserEvent_UserDisconnected); ok {
+		return x.UserDisconnected
+	}
+	return nil
+}
+
+func (x *UserEvent) GetUserChanged() *UserChangedEvent {
+	if x, ok := x.GetEvent().(*UserEvent_UserChanged); ok {
+		return x.UserChanged
+	}
+	return nil
+}
+
+func (x *UserEvent) GetUserBadEvent() *UserBadEvent {
+	if x, ok := x.GetEvent().(*UserEvent_UserBadEvent); ok {
+		return x.UserBadEvent
+	}
+	return nil
+}
+
+func (x *UserEvent) GetUsedBytesChangedEvent() *UsedBytesChangedEvent {
+	if x, ok := x.GetEvent().(*UserEvent_UsedBytesChangedEvent); ok {
+		return x.UsedBytesChangedEvent
+	}
+	return nil
+}
+
+func (x *UserEvent) GetImapLoginFailedEvent() *ImapLoginFailedEvent {
+	if x, ok := x.GetEvent().(*UserEvent_ImapLoginFailedEvent); ok {
+		return x.ImapLoginFailedEvent
+	}
+	return nil
+}
+
+func (x *UserEvent) GetSyncStartedEvent() *SyncStartedEvent {
+	if x, ok := x.GetEvent().(*UserEvent_SyncStartedEvent); ok {
+		return x.SyncStartedEvent
+	}
+	return nil
+}
+
+func (x *UserEvent) GetSyncFinishedEvent() *SyncFinishedEvent {
+	if x, ok := x.GetEvent().(*UserEvent_SyncFinishedEvent); ok {
+		return x.SyncFinishedEvent
+	}
+	return nil
+}
+
+func (x *UserEvent) GetSyncProgressEvent() *SyncProgressEvent {
+	if x, ok := x.GetEvent().(*UserEvent_SyncProgressEvent); ok {
+		return x.SyncProgressEvent
+	}
+	return nil
+}
+
+func (x *UserEvent) GetStorageAlmostFullEvent() *UserStorageAlmostFullEvent {
+	if x, ok := x.GetEvent().(*UserEvent_StorageAlmostFullEvent); ok {
+		return x.StorageAlmostFullEvent
+	}
+	return nil
+}
+
+func (x *UserEvent) GetReauthRequiredEvent() *UserReauthRequiredEvent {
+	if x, ok := x.GetEvent().(*UserEvent_ReauthRequiredEvent); ok {
+		return x.ReauthRequiredEvent
+	}
+	return nil
+}
+
+func (x *UserEvent) GetReadReceiptRequestedEvent() *UserReadReceiptRequestedEvent {
+	if x, ok := x.GetEvent().(*UserEvent_ReadReceiptRequestedEvent); ok {
+		return x.ReadReceiptRequestedEvent
+	}
+	return nil
+}
+
+type isUserEvent_Event interface {
+	isUserEvent_Event()
+}
+
+type UserEvent_ToggleSplitModeFinished struct {
+	ToggleSplitModeFinished *ToggleSplitModeFinishedEvent `protobuf:"bytes,1,opt,name=toggleSplitModeFinished,proto3,oneof"`
+}
+
+type UserEvent_UserDisconnected struct {
+	UserDisconnected *UserDisconnectedEvent `protobuf:"bytes,2,opt,name=userDisconnected,proto3,oneof"`
+}
+
+type UserEvent_UserChanged struct {
+	UserChanged *UserChangedEvent `protobuf:"bytes,3,opt,name=userChanged,proto3,oneof"`
+}
+
+type UserEvent_UserBadEvent struct {
+	UserBadEvent *UserBadEvent `protobuf:"bytes,4,opt,name=userBadEvent,proto3,oneof"`
+}
+
+type UserEvent_UsedBytesChangedEvent struct {
+	UsedBytesChangedEvent *UsedBytesChangedEvent `protobuf:"bytes,5,opt,name=usedBytesChangedEvent,proto3,oneof"`
+}
+
+type UserEvent_ImapLoginFailedEvent struct {
+	ImapLoginFailedEvent *ImapLoginFailedEvent `protobuf:"bytes,6,opt,name=imapLoginFailedEvent,proto3,oneof"`
+}
+
+type UserEvent_SyncStartedEvent struct {
+	SyncStartedEvent *SyncStartedEvent `protobuf:"bytes,7,opt,name=syncStartedEvent,proto3,oneof"`
+}
+
+type UserEvent_SyncFinishedEvent struct {
+	SyncFinishedEvent *SyncFinishedEvent `protobuf:"bytes,8,opt,name=syncFinishedEvent,proto3,oneof"`
+}
+
+type UserEvent_SyncProgressEvent struct {
+	SyncProgressEvent *SyncProgressEvent `protobuf:"bytes,9,opt,name=syncProgressEvent,proto3,oneof"`
+}
+
+type UserEvent_StorageAlmostFullEvent struct {
+	StorageAlmostFullEvent *UserStorageAlmostFullEvent `protobuf:"bytes,10,opt,name=storageAlmostFullEvent,proto3,oneof"`
+}
+
+type UserEvent_ReauthRequiredEvent struct {
+	ReauthRequiredEvent *UserReauthRequiredEvent `protobuf:"bytes,11,opt,name=reauthRequiredEvent,proto3,oneof"`
+}
+
+type UserEvent_ReadReceiptRequestedEvent struct {
+	ReadReceiptRequestedEvent *UserReadReceiptRequestedEvent `protobuf:"bytes,12,opt,name=readReceiptRequestedEvent,proto3,oneof"`
+}
+
+func (*UserEvent_ToggleSplitModeFinished) isUserEvent_Event() {}
+
+func (*UserEvent_UserDisconnected) isUserEvent_Event() {}
+
+func (*UserEvent_UserChanged) isUserEvent_Event() {}
+
+func (*UserEvent_UserBadEvent) isUserEvent_Event() {}
+
+func (*UserEvent_UsedBytesChangedEvent) isUserEvent_Event() {}
+
+func (*UserEvent_ImapLoginFailedEvent) isUserEvent_Event() {}
+
+func (*UserEvent_SyncStartedEvent) isUserEvent_Event() {}
+
+func (*UserEvent_SyncFinishedEvent) isUserEvent_Event() {}
+
+func (*UserEvent_SyncProgressEvent) isUserEvent_Event() {}
+
+func (*UserEvent_StorageAlmostFullEvent) isUserEvent_Event() {}
+
+func (*UserEvent_ReauthRequiredEvent) isUserEvent_Event() {}
+
+func (*UserEvent_ReadReceiptRequestedEvent) isUserEvent_Event() {}
+
+type ToggleSplitModeFinishedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ToggleSplitModeFinishedEvent) Reset() {
+	*x = ToggleSplitModeFinishedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleSplitModeFinishedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleSplitModeFinishedEvent) ProtoMessage() {}
+
+func (x *ToggleSplitModeFinishedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleSplitModeFinishedEvent.ProtoReflect.Descriptor instead.
+func (*ToggleSplitModeFinishedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ToggleSplitModeFinishedEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type UserDisconnectedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *UserDisconnectedEvent) Reset() {
+	*x = UserDisconnectedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDisconnectedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDisconnectedEvent) ProtoMessage() {}
+
+func (x *UserDisconnectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDisconnectedEvent.ProtoReflect.Descriptor instead.
+func (*UserDisconnectedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UserDisconnectedEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UserChangedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *UserChangedEvent) Reset() {
+	*x = UserChangedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserChangedEvent) ProtoMessage() {}
+
+func (x *UserChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserChangedEvent.ProtoReflect.Descriptor instead.
+func (*UserChangedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UserChangedEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type UserBadEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *UserBadEvent) Reset() {
+	*x = UserBadEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserBadEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBadEvent) ProtoMessage() {}
+
+func (x *UserBadEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBadEvent.ProtoReflect.Descriptor instead.
+func (*UserBadEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *UserBadEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserBadEvent) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type UsedBytesChangedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	UsedBytes int64  `protobuf:"varint,2,opt,name=usedBytes,proto3" json:"usedBytes,omitempty"`
+}
+
+func (x *UsedBytesChangedEvent) Reset() {
+	*x = UsedBytesChangedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsedBytesChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsedBytesChangedEvent) ProtoMessage() {}
+
+func (x *UsedBytesChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsedBytesChangedEvent.ProtoReflect.Descriptor instead.
+func (*UsedBytesChangedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *UsedBytesChangedEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UsedBytesChangedEvent) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+type UserStorageAlmostFullEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	UsedBytes  int64  `protobuf:"varint,2,opt,name=usedBytes,proto3" json:"usedBytes,omitempty"`
+	TotalBytes int64  `protobuf:"varint,3,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+}
+
+func (x *UserStorageAlmostFullEvent) Reset() {
+	*x = UserStorageAlmostFullEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserStorageAlmostFullEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStorageAlmostFullEvent) ProtoMessage() {}
+
+func (x *UserStorageAlmostFullEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStorageAlmostFullEvent.ProtoReflect.Descriptor instead.
+func (*UserStorageAlmostFullEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UserStorageAlmostFullEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserStorageAlmostFullEvent) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *UserStorageAlmostFullEvent) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+type UserReauthRequiredEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *UserReauthRequiredEvent) Reset() {
+	*x = UserReauthRequiredEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserReauthRequiredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserReauthRequiredEvent) ProtoMessage() {}
+
+func (x *UserReauthRequiredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserReauthRequiredEvent.ProtoReflect.Descriptor instead.
+func (*UserReauthRequiredEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *UserReauthRequiredEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserReauthRequiredEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UserReadReceiptRequestedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	MessageID string `protobuf:"bytes,2,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Subject   string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+}
+
+func (x *UserReadReceiptRequestedEvent) Reset() {
+	*x = UserReadReceiptRequestedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserReadReceiptRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserReadReceiptRequestedEvent) ProtoMessage() {}
+
+func (x *UserReadReceiptRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserReadReceiptRequestedEvent.ProtoReflect.Descriptor instead.
+func (*UserReadReceiptRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *UserReadReceiptRequestedEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserReadReceiptRequestedEvent) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+func (x *UserReadReceiptRequestedEvent) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *UserReadReceiptRequestedEvent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type ImapLoginFailedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *ImapLoginFailedEvent) Reset() {
+	*x = ImapLoginFailedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImapLoginFailedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImapLoginFailedEvent) ProtoMessage() {}
+
+func (x *ImapLoginFailedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImapLoginFailedEvent.ProtoReflect.Descriptor instead.
+func (*ImapLoginFailedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ImapLoginFailedEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SyncStartedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *SyncStartedEvent) Reset() {
+	*x = SyncStartedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncStartedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStartedEvent) ProtoMessage() {}
+
+func (x *SyncStartedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStartedEvent.ProtoReflect.Descriptor instead.
+func (*SyncStartedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *SyncStartedEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type SyncFinishedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *SyncFinishedEvent) Reset() {
+	*x = SyncFinishedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncFinishedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFinishedEvent) ProtoMessage() {}
+
+func (x *SyncFinishedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFinishedEvent.ProtoReflect.Descriptor instead.
+func (*SyncFinishedEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SyncFinishedEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type SyncProgressEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID      string  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Progress    float64 `protobuf:"fixed64,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	ElapsedMs   int64   `protobuf:"varint,3,opt,name=elapsedMs,proto3" json:"elapsedMs,omitempty"`
+	RemainingMs int64   `protobuf:"varint,4,opt,name=remainingMs,proto3" json:"remainingMs,omitempty"`
+}
+
+func (x *SyncProgressEvent) Reset() {
+	*x = SyncProgressEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncProgressEvent) ProtoMessage() {}
+
+func (x *SyncProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncProgressEvent.ProtoReflect.Descriptor instead.
+func (*SyncProgressEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *SyncProgressEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SyncProgressEvent) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *SyncProgressEvent) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+func (x *SyncProgressEvent) GetRemainingMs() int64 {
+	if x != nil {
+		return x.RemainingMs
+	}
+	return 0
+}
+
+type UserNotificationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title    string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Subtitle string `protobuf:"bytes,2,opt,name=subtitle,proto3" json:"subtitle,omitempty"`
+	Body     string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	UserID   string `protobuf:"bytes,4,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *UserNotificationEvent) Reset() {
+	*x = UserNotificationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserNotificationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserNotificationEvent) ProtoMessage() {}
+
+func (x *UserNotificationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserNotificationEvent.ProtoReflect.Descriptor instead.
+func (*UserNotificationEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *UserNotificationEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UserNotificationEvent) GetSubtitle() string {
+	if x != nil {
+		return x.Subtitle
+	}
+	return ""
+}
+
+func (x *UserNotificationEvent) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *UserNotificationEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GenericErrorEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=grpc.ErrorCode" json:"code,omitempty"`
+}
+
+func (x *GenericErrorEvent) Reset() {
+	*x = GenericErrorEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenericErrorEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenericErrorEvent) ProtoMessage() {}
+
+func (x *GenericErrorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenericErrorEvent.ProtoReflect.Descriptor instead.
+func (*GenericErrorEvent) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GenericErrorEvent) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_UNKNOWN_ERROR
+}
+
+var File_bridge_proto protoreflect.FileDescriptor
+
+var file_bridge_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04,
+	0x67, 0x72, 0x70, 0x63, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x6e, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x57, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x80, 0x01, 0x0a, 0x11, 0x4c,
+	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x80, 0x01,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x54, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x22, 0x3e, 0x0a, 0x10, 0x47, 0x75, 0x69, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x77, 0x53, 0x70, 0x6c, 0x61,
+	0x73, 0x68, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x73, 0x68, 0x6f, 0x77, 0x53, 0x70, 0x6c, 0x61, 0x73, 0x68, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x22, 0x60, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x70, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x72, 0x70, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0xdc, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x30, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x70,
+	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13,
+	0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x6e, 0x74, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xde, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x67,
+	0x73, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x0c, 0x75, 0x73,
+	0x65, 0x48, 0x76, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x48, 0x76, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x75, 0x73, 0x65, 0x48, 0x76, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x22, 0x4c, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x70, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x63, 0x65, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x13, 0x74, 0x72, 0x61, 0x63, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x06, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75,
+	0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x22, 0xa9, 0x03, 0x0a, 0x0b, 0x49, 0x4d, 0x41, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x69, 0x6c, 0x62,
+	0x6f, 0x78, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x69, 0x6c,
+	0x62, 0x6f, 0x78, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x4d, 0x41, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x45, 0x0a,
+	0x14, 0x49, 0x4d, 0x41, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x4d, 0x41, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x69,
+	0x64, 0x6f, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x16, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46,
+	0x69, 0x64, 0x6f, 0x32, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x11,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x59, 0x0a,
+	0x13, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x49, 0x6d, 0x61,
+	0x70, 0x53, 0x6d, 0x74, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6d, 0x61, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x69, 0x6d, 0x61, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6d, 0x74,
+	0x70, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x6d, 0x74,
+	0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x53, 0x53, 0x4c, 0x46,
+	0x6f, 0x72, 0x49, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x73,
+	0x65, 0x53, 0x53, 0x4c, 0x46, 0x6f, 0x72, 0x49, 0x6d, 0x61, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x75,
+	0x73, 0x65, 0x53, 0x53, 0x4c, 0x46, 0x6f, 0x72, 0x53, 0x6d, 0x74, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x53, 0x53, 0x4c, 0x46, 0x6f, 0x72, 0x53, 0x6d, 0x74,
+	0x70, 0x22, 0x51, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x54, 0x4c, 0x53,
+	0x50, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6d, 0x61, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x69, 0x6d, 0x61, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6d, 0x74, 0x70,
+	0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x6d, 0x74, 0x70,
+	0x50, 0x6f, 0x72, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x53, 0x53, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x6d, 0x61, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6d, 0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73,
+	0x6d, 0x74, 0x70, 0x22, 0x4b, 0x0a, 0x13, 0x54, 0x4c, 0x53, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x65,
+	0x72, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x65,
+	0x72, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68,
+	0x22, 0x7a, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x47, 0x0a, 0x13,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x58, 0x0a, 0x12, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x52, 0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x69, 0x73, 0x6b, 0x22,
+	0x3a, 0x0a, 0x1a, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x29, 0x0a, 0x11, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x0b, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x4b, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x22, 0x67,
+	0x0a, 0x10, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xf4,
+	0x03, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x54, 0x65, 0x78,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x54,
+	0x65, 0x78, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65,
+	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x68, 0x69, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6c, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x69, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x4d, 0x61,
+	0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x4d, 0x69, 0x6d, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x4d, 0x69,
+	0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61,
+	0x75, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x61, 0x75, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x79, 0x6e,
+	0x63, 0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73,
+	0x79, 0x6e, 0x63, 0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x4b, 0x65, 0x70, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x4b, 0x65, 0x70, 0x74, 0x22, 0x46, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x44, 0x0a,
+	0x16, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68,
+	0x69, 0x64, 0x65, 0x22, 0x47, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x4d, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x22, 0x5f, 0x0a, 0x1a,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x4e, 0x0a,
+	0x16, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x22, 0x47, 0x0a,
+	0x15, 0x55, 0x73, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x63, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x34, 0x0a, 0x10, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22,
+	0x4d, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x41, 0x70, 0x70, 0x6c,
+	0x65, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x79,
+	0x0a, 0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3f, 0x0a, 0x0b, 0x41, 0x70, 0x70,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4d, 0x0a, 0x14, 0x41, 0x70,
+	0x70, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x70, 0x70, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x0c, 0x61, 0x70, 0x70,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x40, 0x0a, 0x12, 0x41, 0x70, 0x70,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x69, 0x0a, 0x17, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x8f, 0x02, 0x0a,
+	0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x4d,
+	0x0a, 0x18, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3c, 0x0a,
+	0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0xd0, 0x03, 0x0a, 0x0b,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x61,
+	0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x70, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12,
+	0x28, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x12, 0x4f, 0x0a, 0x12, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x12, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4b,
+	0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08,
+	0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61,
+	0x69, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x25, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69,
+	0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xc0,
+	0x0c, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x5e, 0x0a, 0x17, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x41,
+	0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x17, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x41,
+	0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x12, 0x4c, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x12, 0x49, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x42, 0x75, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x42, 0x75, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x43, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x69, 0x6e, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4c, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42,
+	0x75, 0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75,
+	0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x19, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x19,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x67, 0x0a, 0x1a, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x65, 0x64, 0x12, 0x61, 0x0a, 0x18, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x18, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x61, 0x0a, 0x18, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x18,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x0e, 0x61, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x12,
+	0x49, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x55,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x43,
+	0x0a, 0x0e, 0x61, 0x70, 0x69, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x50,
+	0x49, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x70, 0x69, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x12, 0x6b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x12, 0x6b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x13, 0x6b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x13, 0x6b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x33, 0x0a, 0x13, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x41, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x18, 0x0a, 0x16,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x75, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x15, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x68, 0x6f, 0x77, 0x4d, 0x61,
+	0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x18, 0x0a,
+	0x16, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x1f, 0x0a, 0x1d,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x14, 0x0a,
+	0x12, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x11, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22,
+	0x15, 0x0a, 0x13, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x30, 0x0a, 0x18, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x43, 0x0a, 0x13, 0x41, 0x50, 0x49, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x60, 0x0a, 0x1d, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0b,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xee, 0x03,
+	0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x42, 0x0a, 0x0c, 0x74,
+	0x66, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x66,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x0c, 0x74, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12,
+	0x5b, 0x0a, 0x14, 0x74, 0x77, 0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x77, 0x6f, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x14, 0x74, 0x77, 0x6f, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x08,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x0f, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x4c,
+	0x6f, 0x67, 0x67, 0x65, 0x64, 0x49, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x6c, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x3f, 0x0a, 0x0b, 0x68, 0x76,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48, 0x76, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b,
+	0x68, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0e, 0x66,
+	0x69, 0x64, 0x6f, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x46, 0x69, 0x64, 0x6f, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x69, 0x64, 0x6f, 0x32, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x55,
+	0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x34, 0x0a, 0x16, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x66,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x18,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x69, 0x64, 0x6f, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
+	0x6c, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f,
+	0x74, 0x70, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x70, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x22, 0x3d, 0x0a, 0x1f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x77, 0x6f, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x50, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x77, 0x61, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x61, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x22, 0x2d, 0x0a, 0x15, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48, 0x76, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x76,
+	0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x76, 0x55, 0x72, 0x6c,
+	0x22, 0xb9, 0x04, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x2e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x40, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x12, 0x58, 0x0a, 0x13, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e,
+	0x75, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x13,
+	0x73, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x13, 0x73, 0x69,
+	0x6c, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x12, 0x47, 0x0a, 0x0f, 0x69, 0x73, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x73, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x69, 0x73, 0x4c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x44, 0x0a,
+	0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x10,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x32, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x20, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x2c, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x22, 0x17, 0x0a, 0x15,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x73, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x22, 0xeb, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69,
+	0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x0b, 0x70, 0x61,
+	0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
+	0x58, 0x0a, 0x12, 0x70, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x12, 0x70, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x43, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2f, 0x0a, 0x19, 0x44, 0x69, 0x73, 0x6b, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x22, 0x0a, 0x20, 0x44, 0x69, 0x73, 0x6b,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xbf, 0x02, 0x0a,
+	0x17, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x64, 0x0a, 0x19, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x19, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x79, 0x0a, 0x20, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x55,
+	0x0a, 0x1c, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x35,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x54, 0x0a, 0x1e, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x6d, 0x61, 0x70, 0x53, 0x6d, 0x74, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x27, 0x0a, 0x25, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0xcb, 0x03, 0x0a, 0x0d, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x16, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x16, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x4e, 0x6f, 0x4b, 0x65, 0x79, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x48, 0x61, 0x73, 0x4e, 0x6f, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x4e, 0x6f, 0x4b, 0x65, 0x79,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x46, 0x0a, 0x0f, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4b, 0x65, 0x79,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x67, 0x0a,
+	0x1a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x61, 0x0a, 0x18, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x18, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x14, 0x0a, 0x12, 0x48, 0x61, 0x73, 0x4e, 0x6f, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x37, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x1f, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xb7, 0x02, 0x0a, 0x09, 0x4d, 0x61,
+	0x69, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x55, 0x0a, 0x14,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x14, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x4c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x70, 0x69, 0x43, 0x65, 0x72, 0x74, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x70, 0x69, 0x43, 0x65, 0x72, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x70, 0x69, 0x43, 0x65, 0x72, 0x74, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6f,
+	0x4c, 0x61, 0x72, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6f, 0x4c, 0x61, 0x72,
+	0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6f, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x35, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x41,
+	0x70, 0x69, 0x43, 0x65, 0x72, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0x58, 0x0a, 0x14, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6f, 0x4c, 0x61,
+	0x72, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xc8, 0x07, 0x0a, 0x09, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x17, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x17, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
+	0x38, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x42, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x42, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65,
+	0x72, 0x42, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x15, 0x75, 0x73, 0x65,
+	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x15, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x50,
+	0x0a, 0x14, 0x69, 0x6d, 0x61, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x61, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x14, 0x69, 0x6d, 0x61, 0x70,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x44, 0x0a, 0x10, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x73, 0x79,
+	0x6e, 0x63, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x47, 0x0a, 0x11, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x16, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x41, 0x6c, 0x6d, 0x6f, 0x73, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x6d, 0x6f, 0x73,
+	0x74, 0x46, 0x75, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x16, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x6d, 0x6f, 0x73, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x13, 0x72, 0x65, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x13, 0x72, 0x65, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x63, 0x0a, 0x19, 0x72, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x19, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x1c, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x33, 0x0a,
+	0x15, 0x55, 0x73, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4a,
+	0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4d, 0x0a, 0x15, 0x55, 0x73,
+	0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x1a, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x6d, 0x6f, 0x73, 0x74, 0x46, 0x75,
+	0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x4d, 0x0a,
+	0x17, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a,
+	0x1d, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x32, 0x0a, 0x14,
+	0x49, 0x6d, 0x61, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x2a, 0x0a, 0x10, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x11,
+	0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x53, 0x79,
+	0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4d,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x4d, 0x73, 0x22, 0x75, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x38, 0x0a, 0x11, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x69, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x2a, 0x71, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x4f, 0x47, 0x5f, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x4c, 0x4f, 0x47, 0x5f, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x4c, 0x4f, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x0c, 0x0a,
+	0x08, 0x4c, 0x4f, 0x47, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x4c,
+	0x4f, 0x47, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x4f, 0x47,
+	0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x4f, 0x47, 0x5f,
+	0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x06, 0x2a, 0x51, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x50, 0x41, 0x53,
+	0x53, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x57,
+	0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x36, 0x0a, 0x09, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x49, 0x47, 0x4e, 0x45,
+	0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x43, 0x4b, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x2a, 0x5a, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45,
+	0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x50,
+	0x52, 0x4f, 0x4d, 0x50, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x41, 0x44, 0x5f,
+	0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x02, 0x2a, 0x4e,
+	0x0a, 0x12, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x53, 0x5f, 0x4a, 0x53, 0x10, 0x01, 0x2a, 0xb0,
+	0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x50, 0x41,
+	0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x46, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x46, 0x41, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x10,
+	0x04, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x57, 0x4f, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52,
+	0x44, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x57,
+	0x4f, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x53, 0x5f, 0x41, 0x42, 0x4f, 0x52,
+	0x54, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x56, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x07, 0x2a, 0x5b, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4d,
+	0x41, 0x4e, 0x55, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x16, 0x0a,
+	0x12, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x53, 0x49, 0x4c, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x2a, 0x34,
+	0x0a, 0x12, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x41, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x56,
+	0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x00, 0x2a, 0xdd, 0x01, 0x0a, 0x1b, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4d, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x55, 0x50, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4d, 0x54, 0x50, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x55, 0x50, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x1a,
+	0x0a, 0x16, 0x49, 0x4d, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4d,
+	0x54, 0x50, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x4d, 0x41, 0x50, 0x5f, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x25, 0x0a,
+	0x21, 0x53, 0x4d, 0x54, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x05, 0x2a, 0x53, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4c, 0x53, 0x5f, 0x43, 0x45, 0x52, 0x54,
+	0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x32, 0x80, 0x47, 0x0a, 0x06, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f,
+	0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3c, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36,
+	0x0a, 0x06, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x49,
+	0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x18, 0x49,
+	0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x52,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x49, 0x4d, 0x41, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x4d, 0x41, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x47, 0x75, 0x69, 0x52, 0x65, 0x61, 0x64,
+	0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x75, 0x69, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x04, 0x51, 0x75, 0x69, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x07, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0d, 0x53, 0x68, 0x6f, 0x77, 0x4f, 0x6e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x75, 0x70, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x53, 0x65, 0x74,
+	0x49, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x6e, 0x12, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x4f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x49, 0x73, 0x42,
+	0x65, 0x74, 0x61, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43,
+	0x0a, 0x0d, 0x49, 0x73, 0x42, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x49, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x4d,
+	0x61, 0x69, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46,
+	0x0a, 0x10, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x49, 0x73, 0x49,
+	0x50, 0x43, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x43, 0x50, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a,
+	0x0c, 0x49, 0x73, 0x49, 0x50, 0x43, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x43, 0x50, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x50, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x49, 0x73, 0x52, 0x65, 0x61, 0x75, 0x74, 0x68,
+	0x4f, 0x6e, 0x44, 0x65, 0x61, 0x75, 0x74, 0x68, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x17, 0x49, 0x73, 0x52, 0x65, 0x61, 0x75, 0x74, 0x68, 0x4f,
+	0x6e, 0x44, 0x65, 0x61, 0x75, 0x74, 0x68, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x4c, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x49, 0x73, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x49, 0x0a, 0x13, 0x49, 0x73, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4c, 0x0a, 0x16, 0x53,
+	0x65, 0x74, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x13, 0x49, 0x73, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x47, 0x6f, 0x4f, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3f, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4e, 0x0a, 0x16, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x4c, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x4a, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a,
+	0x0f, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67, 0x12,
+	0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x50, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x47, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x45, 0x0a, 0x0d, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x49, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x1f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x08, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x32, 0x46, 0x41, 0x12, 0x12, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x69, 0x64, 0x6f, 0x32,
+	0x12, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x69, 0x64,
+	0x6f, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x47, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x69, 0x64, 0x6f, 0x32, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x46, 0x69, 0x64, 0x6f, 0x32, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0f, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x32, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x12, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0a, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x16, 0x53, 0x65,
+	0x74, 0x49, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x6e, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x13, 0x49, 0x73, 0x41, 0x75,
+	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x53, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4b,
+	0x0a, 0x13, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4d, 0x61,
+	0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0d, 0x44,
+	0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x4f, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b,
+	0x65, 0x65, 0x70, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x79, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x52, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0d, 0x4d, 0x61, 0x78, 0x53, 0x79,
+	0x6e, 0x63, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x48,
+	0x0a, 0x10, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x53, 0x65,
+	0x74, 0x49, 0x73, 0x44, 0x6f, 0x48, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x42, 0x0a, 0x0c, 0x49, 0x73, 0x44, 0x6f, 0x48, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x44, 0x0a, 0x12, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x61, 0x70, 0x53,
+	0x6d, 0x74, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x47, 0x0a, 0x15, 0x53,
+	0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x61, 0x70,
+	0x53, 0x6d, 0x74, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x54, 0x4c, 0x53, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x54, 0x4c, 0x53, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x4c, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x54, 0x4c,
+	0x53, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x54, 0x4c, 0x53, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a,
+	0x0b, 0x53, 0x53, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x53, 0x4c, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x43, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x53, 0x53, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x53, 0x4c, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x53,
+	0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x45, 0x0a, 0x0a, 0x49, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x65, 0x65, 0x12, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4e, 0x0a, 0x12, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x47, 0x0a, 0x0f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x52, 0x0a, 0x1c, 0x49,
+	0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x44, 0x0a, 0x12, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x6f, 0x4c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x49, 0x0a,
+	0x12, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x13, 0x48, 0x61, 0x73, 0x55,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3e, 0x0a, 0x08, 0x49, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x36, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x14, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x0a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x46, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x12, 0x53, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6c,
+	0x12, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x64, 0x65,
+	0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x4d, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x4d, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x52, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1c, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x48, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63,
+	0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x12, 0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x55, 0x0a, 0x18, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x42, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x12, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x69, 0x7a, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x65, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x51, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x41, 0x70, 0x70,
+	0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x4d, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x4c, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x41, 0x70, 0x70, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x70, 0x70, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4e, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x70, 0x70,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x70, 0x70, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x49, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x70, 0x70,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x70, 0x70, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1d, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x19, 0x49, 0x73, 0x54,
+	0x4c, 0x53, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x15, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x54, 0x4c, 0x53, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x4c, 0x53,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x48, 0x0a, 0x13, 0x54, 0x4c, 0x53, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x4c, 0x53, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x16,
+	0x53, 0x65, 0x74, 0x54, 0x4c, 0x53, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x4c,
+	0x53, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x14, 0x53, 0x65, 0x74,
+	0x54, 0x4c, 0x53, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x69,
+	0x72, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x54, 0x4c, 0x53, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a,
+	0x0a, 0x08, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x41, 0x64,
+	0x64, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x64, 0x64, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0d, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x3f, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
+	0x01, 0x12, 0x41, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x0d, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x36, 0x5a, 0x34,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x6e, 0x4d, 0x61, 0x69, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2d, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bridge_proto_rawDescOnce sync.Once
+	file_bridge_proto_rawDescData = file_bridge_proto_rawDesc
+)
+
+func file_bridge_proto_rawDescGZIP() []byte {
+	file_bridge_proto_rawDescOnce.Do(func() {
+		file_bridge_proto_rawDescData = protoimpl.X.CompressGZIP(file_bridge_proto_rawDescData)
+	})
+	return file_bridge_proto_rawDescData
+}
+
+var file_bridge_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_bridge_proto_msgTypes = make([]protoimpl.MessageInfo, 124)
+var file_bridge_proto_goTypes = []interface{}{
+	(LogLevel)(0),                                 // 0: grpc.LogLevel
+	(ConfigurationCheckStatus)(0),                 // 1: grpc.ConfigurationCheckStatus
+	(UserState)(0),                                // 2: grpc.UserState
+	(ReadReceiptMode)(0),                          // 3: grpc.ReadReceiptMode
+	(ClientConfigFormat)(0),                       // 4: grpc.ClientConfigFormat
+	(LoginErrorType)(0),                           // 5: grpc.LoginErrorType
+	(UpdateErrorType)(0),                          // 6: grpc.UpdateErrorType
+	(DiskCacheErrorType)(0),                       // 7: grpc.DiskCacheErrorType
+	(MailServerSettingsErrorType)(0),              // 8: grpc.MailServerSettingsErrorType
+	(ErrorCode)(0),                                // 9: grpc.ErrorCode
+	(*AddLogEntryRequest)(nil),                    // 10: grpc.AddLogEntryRequest
+	(*SubsystemLogLevel)(nil),                     // 11: grpc.SubsystemLogLevel
+	(*LogLevelsResponse)(nil),                     // 12: grpc.LogLevelsResponse
+	(*SetLogLevelRequest)(nil),                    // 13: grpc.SetLogLevelRequest
+	(*GuiReadyResponse)(nil),                      // 14: grpc.GuiReadyResponse
+	(*ServiceInfoRequest)(nil),                    // 15: grpc.ServiceInfoRequest
+	(*DeprecationHint)(nil),                       // 16: grpc.DeprecationHint
+	(*ServiceInfo)(nil),                           // 17: grpc.ServiceInfo
+	(*ReportBugRequest)(nil),                      // 18: grpc.ReportBugRequest
+	(*LoginRequest)(nil),                          // 19: grpc.LoginRequest
+	(*SubsystemTrace)(nil),                        // 20: grpc.SubsystemTrace
+	(*TracesResponse)(nil),                        // 21: grpc.TracesResponse
+	(*StartTraceRequest)(nil),                     // 22: grpc.StartTraceRequest
+	(*IMAPSession)(nil),                           // 23: grpc.IMAPSession
+	(*IMAPSessionsResponse)(nil),                  // 24: grpc.IMAPSessionsResponse
+	(*LoginFido2Request)(nil),                     // 25: grpc.LoginFido2Request
+	(*LoginFido2LocalRequest)(nil),                // 26: grpc.LoginFido2LocalRequest
+	(*LoginAbortRequest)(nil),                     // 27: grpc.LoginAbortRequest
+	(*MemoryUsageResponse)(nil),                   // 28: grpc.MemoryUsageResponse
+	(*ImapSmtpSettings)(nil),                      // 29: grpc.ImapSmtpSettings
+	(*ImplicitTLSPortSettings)(nil),               // 30: grpc.ImplicitTLSPortSettings
+	(*SSLRequiredSettings)(nil),                   // 31: grpc.SSLRequiredSettings
+	(*TLSCertificateFiles)(nil),                   // 32: grpc.TLSCertificateFiles
+	(*ConfigurationCheck)(nil),                    // 33: grpc.ConfigurationCheck
+	(*ConfigurationReport)(nil),                   // 34: grpc.ConfigurationReport
+	(*BindAddressRequest)(nil),                    // 35: grpc.BindAddressRequest
+	(*AvailableKeychainsResponse)(nil),            // 36: grpc.AvailableKeychainsResponse
+	(*BridgeEventFilter)(nil),                     // 37: grpc.BridgeEventFilter
+	(*BridgeEvent)(nil),                           // 38: grpc.BridgeEvent
+	(*Webhook)(nil),                               // 39: grpc.Webhook
+	(*WebhooksResponse)(nil),                      // 40: grpc.WebhooksResponse
+	(*AddWebhookRequest)(nil),                     // 41: grpc.AddWebhookRequest
+	(*User)(nil),                                  // 42: grpc.User
+	(*UserSplitModeRequest)(nil),                  // 43: grpc.UserSplitModeRequest
+	(*UserHideAllMailRequest)(nil),                // 44: grpc.UserHideAllMailRequest
+	(*UserRepairMimeRequest)(nil),                 // 45: grpc.UserRepairMimeRequest
+	(*UserReadReceiptModeRequest)(nil),            // 46: grpc.UserReadReceiptModeRequest
+	(*UserReadReceiptRequest)(nil),                // 47: grpc.UserReadReceiptRequest
+	(*UserSyncCutoffRequest)(nil),                 // 48: grpc.UserSyncCutoffRequest
+	(*UserBadEventFeedbackRequest)(nil),           // 49: grpc.UserBadEventFeedbackRequest
+	(*UserListResponse)(nil),                      // 50: grpc.UserListResponse
+	(*ConfigureAppleMailRequest)(nil),             // 51: grpc.ConfigureAppleMailRequest
+	(*ClientConfigRequest)(nil),                   // 52: grpc.ClientConfigRequest
+	(*AppPassword)(nil),                           // 53: grpc.AppPassword
+	(*AppPasswordsResponse)(nil),                  // 54: grpc.AppPasswordsResponse
+	(*AppPasswordRequest)(nil),                    // 55: grpc.AppPasswordRequest
+	(*AuthTokenRequest)(nil),                      // 56: grpc.AuthTokenRequest
+	(*UserEventJournalRequest)(nil),               // 57: grpc.UserEventJournalRequest
+	(*EventJournalEntry)(nil),                     // 58: grpc.EventJournalEntry
+	(*UserEventJournalResponse)(nil),              // 59: grpc.UserEventJournalResponse
+	(*EventStreamRequest)(nil),                    // 60: grpc.EventStreamRequest
+	(*StreamEvent)(nil),                           // 61: grpc.StreamEvent
+	(*AppEvent)(nil),                              // 62: grpc.AppEvent
+	(*InternetStatusEvent)(nil),                   // 63: grpc.InternetStatusEvent
+	(*ToggleAutostartFinishedEvent)(nil),          // 64: grpc.ToggleAutostartFinishedEvent
+	(*ResetFinishedEvent)(nil),                    // 65: grpc.ResetFinishedEvent
+	(*ReportBugFinishedEvent)(nil),                // 66: grpc.ReportBugFinishedEvent
+	(*ReportBugSuccessEvent)(nil),                 // 67: grpc.ReportBugSuccessEvent
+	(*ReportBugErrorEvent)(nil),                   // 68: grpc.ReportBugErrorEvent
+	(*ShowMainWindowEvent)(nil),                   // 69: grpc.ShowMainWindowEvent
+	(*ReportBugFallbackEvent)(nil),                // 70: grpc.ReportBugFallbackEvent
+	(*CertificateInstallSuccessEvent)(nil),        // 71: grpc.CertificateInstallSuccessEvent
+	(*CertificateInstallCanceledEvent)(nil),       // 72: grpc.CertificateInstallCanceledEvent
+	(*CertificateInstallFailedEvent)(nil),         // 73: grpc.CertificateInstallFailedEvent
+	(*RepairStartedEvent)(nil),                    // 74: grpc.RepairStartedEvent
+	(*AllUsersLoadedEvent)(nil),                   // 75: grpc.AllUsersLoadedEvent
+	(*BridgeLockedEvent)(nil),                     // 76: grpc.BridgeLockedEvent
+	(*BridgeUnlockedEvent)(nil),                   // 77: grpc.BridgeUnlockedEvent
+	(*KeyRotationStartedEvent)(nil),               // 78: grpc.KeyRotationStartedEvent
+	(*KeyRotationFinishedEvent)(nil),              // 79: grpc.KeyRotationFinishedEvent
+	(*APIRateLimitedEvent)(nil),                   // 80: grpc.APIRateLimitedEvent
+	(*KnowledgeBaseSuggestion)(nil),               // 81: grpc.KnowledgeBaseSuggestion
+	(*KnowledgeBaseSuggestionsEvent)(nil),         // 82: grpc.KnowledgeBaseSuggestionsEvent
+	(*LoginEvent)(nil),                            // 83: grpc.LoginEvent
+	(*LoginErrorEvent)(nil),                       // 84: grpc.LoginErrorEvent
+	(*LoginTfaRequestedEvent)(nil),                // 85: grpc.LoginTfaRequestedEvent
+	(*LoginFido2RequestedEvent)(nil),              // 86: grpc.LoginFido2RequestedEvent
+	(*LoginTwoPasswordsRequestedEvent)(nil),       // 87: grpc.LoginTwoPasswordsRequestedEvent
+	(*LoginFinishedEvent)(nil),                    // 88: grpc.LoginFinishedEvent
+	(*LoginHvRequestedEvent)(nil),                 // 89: grpc.LoginHvRequestedEvent
+	(*UpdateEvent)(nil),                           // 90: grpc.UpdateEvent
+	(*UpdateErrorEvent)(nil),                      // 91: grpc.UpdateErrorEvent
+	(*UpdateManualReadyEvent)(nil),                // 92: grpc.UpdateManualReadyEvent
+	(*UpdateManualRestartNeededEvent)(nil),        // 93: grpc.UpdateManualRestartNeededEvent
+	(*UpdateForceEvent)(nil),                      // 94: grpc.UpdateForceEvent
+	(*UpdateSilentRestartNeeded)(nil),             // 95: grpc.UpdateSilentRestartNeeded
+	(*UpdateIsLatestVersion)(nil),                 // 96: grpc.UpdateIsLatestVersion
+	(*UpdateCheckFinished)(nil),                   // 97: grpc.UpdateCheckFinished
+	(*UpdateVersionChanged)(nil),                  // 98: grpc.UpdateVersionChanged
+	(*DiskCacheEvent)(nil),                        // 99: grpc.DiskCacheEvent
+	(*DiskCacheErrorEvent)(nil),                   // 100: grpc.DiskCacheErrorEvent
+	(*DiskCachePathChangedEvent)(nil),             // 101: grpc.DiskCachePathChangedEvent
+	(*DiskCachePathChangeFinishedEvent)(nil),      // 102: grpc.DiskCachePathChangeFinishedEvent
+	(*MailServerSettingsEvent)(nil),               // 103: grpc.MailServerSettingsEvent
+	(*MailServerSettingsErrorEvent)(nil),          // 104: grpc.MailServerSettingsErrorEvent
+	(*MailServerSettingsChangedEvent)(nil),        // 105: grpc.MailServerSettingsChangedEvent
+	(*ChangeMailServerSettingsFinishedEvent)(nil), // 106: grpc.ChangeMailServerSettingsFinishedEvent
+	(*KeychainEvent)(nil),                         // 107: grpc.KeychainEvent
+	(*ChangeKeychainFinishedEvent)(nil),           // 108: grpc.ChangeKeychainFinishedEvent
+	(*HasNoKeychainEvent)(nil),                    // 109: grpc.HasNoKeychainEvent
+	(*RebuildKeychainEvent)(nil),                  // 110: grpc.RebuildKeychainEvent
+	(*CredentialStoreUnavailableEvent)(nil),       // 111: grpc.CredentialStoreUnavailableEvent
+	(*CredentialStoreAvailableEvent)(nil),         // 112: grpc.CredentialStoreAvailableEvent
+	(*MailEvent)(nil),                             // 113: grpc.MailEvent
+	(*AddressChangedEvent)(nil),                   // 114: grpc.AddressChangedEvent
+	(*AddressChangedLogoutEvent)(nil),             // 115: grpc.AddressChangedLogoutEvent
+	(*ApiCertIssueEvent)(nil),                     // 116: grpc.ApiCertIssueEvent
+	(*MessageTooLargeEvent)(nil),                  // 117: grpc.MessageTooLargeEvent
+	(*UserEvent)(nil),                             // 118: grpc.UserEvent
+	(*ToggleSplitModeFinishedEvent)(nil),          // 119: grpc.ToggleSplitModeFinishedEvent
+	(*UserDisconnectedEvent)(nil),                 // 120: grpc.UserDisconnectedEvent
+	(*UserChangedEvent)(nil),                      // 121: grpc.UserChangedEvent
+	(*UserBadEvent)(nil),                          // 122: grpc.UserBadEvent
+	(*UsedBytesChangedEvent)(nil),                 // 123: grpc.UsedBytesChangedEvent
+	(*UserStorageAlmostFullEvent)(nil),            // 124: grpc.UserStorageAlmostFullEvent
+	(*UserReauthRequiredEvent)(nil),               // 125: grpc.UserReauthRequiredEvent
+	(*UserReadReceiptRequestedEvent)(nil),         // 126: grpc.UserReadReceiptRequestedEvent
+	(*ImapLoginFailedEvent)(nil),                  // 127: grpc.ImapLoginFailedEvent
+	(*SyncStartedEvent)(nil),                      // 128: grpc.SyncStartedEvent
+	(*SyncFinishedEvent)(nil),                     // 129: grpc.SyncFinishedEvent
+	(*SyncProgressEvent)(nil),                     // 130: grpc.SyncProgressEvent
+	(*UserNotificationEvent)(nil),                 // 131: grpc.UserNotificationEvent
+	(*GenericErrorEvent)(nil),                     // 132: grpc.GenericErrorEvent
+	nil,                                           // 133: grpc.IMAPSession.CommandsEntry
+	(*wrapperspb.StringValue)(nil),                // 134: google.protobuf.StringValue
+	(*emptypb.Empty)(nil),                         // 135: google.protobuf.Empty
+	(*wrapperspb.BoolValue)(nil),                  // 136: google.protobuf.BoolValue
+	(*wrapperspb.UInt64Value)(nil),                // 137: google.protobuf.UInt64Value
+	(*wrapperspb.UInt32Value)(nil),                // 138: google.protobuf.UInt32Value
+	(*wrapperspb.Int32Value)(nil),                 // 139: google.protobuf.Int32Value
+	(*wrapperspb.BytesValue)(nil),                 // 140: google.protobuf.BytesValue
+}
+var file_bridge_proto_depIdxs = []int32{
+	0,   // 0: grpc.AddLogEntryRequest.level:type_name -> grpc.LogLevel
+	0,   // 1: grpc.SubsystemLogLevel.level:type_name -> grpc.LogLevel
+	0,   // 2: grpc.LogLevelsResponse.defaultLevel:type_name -> grpc.LogLevel
+	11,  // 3: grpc.LogLevelsResponse.subsystems:type_name -> grpc.SubsystemLogLevel
+	0,   // 4: grpc.SetLogLevelRequest.level:type_name -> grpc.LogLevel
+	16,  // 5: grpc.ServiceInfo.deprecations:type_name -> grpc.DeprecationHint
+	20,  // 6: grpc.TracesResponse.traces:type_name -> grpc.SubsystemTrace
+	133, // 7: grpc.IMAPSession.commands:type_name -> grpc.IMAPSession.CommandsEntry
+	23,  // 8: grpc.IMAPSessionsResponse.sessions:type_name -> grpc.IMAPSession
+	1,   // 9: grpc.ConfigurationCheck.status:type_name -> grpc.ConfigurationCheckStatus
+	33,  // 10: grpc.ConfigurationReport.checks:type_name -> grpc.ConfigurationCheck
+	39,  // 11: grpc.WebhooksResponse.webhooks:type_name -> grpc.Webhook
+	2,   // 12: grpc.User.state:type_name -> grpc.UserState
+	3,   // 13: grpc.User.readReceiptMode:type_name -> grpc.ReadReceiptMode
+	3,   // 14: grpc.UserReadReceiptModeRequest.mode:type_name -> grpc.ReadReceiptMode
+	42,  // 15: grpc.UserListResponse.users:type_name -> grpc.User
+	4,   // 16: grpc.ClientConfigRequest.format:type_name -> grpc.ClientConfigFormat
+	53,  // 17: grpc.AppPasswordsResponse.appPasswords:type_name -> grpc.AppPassword
+	58,  // 18: grpc.UserEventJournalResponse.entries:type_name -> grpc.EventJournalEntry
+	62,  // 19: grpc.StreamEvent.app:type_name -> grpc.AppEvent
+	83,  // 20: grpc.StreamEvent.login:type_name -> grpc.LoginEvent
+	90,  // 21: grpc.StreamEvent.update:type_name -> grpc.UpdateEvent
+	99,  // 22: grpc.StreamEvent.cache:type_name -> grpc.DiskCacheEvent
+	103, // 23: grpc.StreamEvent.mailServerSettings:type_name -> grpc.MailServerSettingsEvent
+	107, // 24: grpc.StreamEvent.keychain:type_name -> grpc.KeychainEvent
+	113, // 25: grpc.StreamEvent.mail:type_name -> grpc.MailEvent
+	118, // 26: grpc.StreamEvent.user:type_name -> grpc.UserEvent
+	132, // 27: grpc.StreamEvent.genericError:type_name -> grpc.GenericErrorEvent
+	63,  // 28: grpc.AppEvent.internetStatus:type_name -> grpc.InternetStatusEvent
+	64,  // 29: grpc.AppEvent.toggleAutostartFinished:type_name -> grpc.ToggleAutostartFinishedEvent
+	65,  // 30: grpc.AppEvent.resetFinished:type_name -> grpc.ResetFinishedEvent
+	66,  // 31: grpc.AppEvent.reportBugFinished:type_name -> grpc.ReportBugFinishedEvent
+	67,  // 32: grpc.AppEvent.reportBugSuccess:type_name -> grpc.ReportBugSuccessEvent
+	68,  // 33: grpc.AppEvent.reportBugError:type_name -> grpc.ReportBugErrorEvent
+	69,  // 34: grpc.AppEvent.showMainWindow:type_name -> grpc.ShowMainWindowEvent
+	70,  // 35: grpc.AppEvent.reportBugFallback:type_name -> grpc.ReportBugFallbackEvent
+	71,  // 36: grpc.AppEvent.certificateInstallSuccess:type_name -> grpc.CertificateInstallSuccessEvent
+	72,  // 37: grpc.AppEvent.certificateInstallCanceled:type_name -> grpc.CertificateInstallCanceledEvent
+	73,  // 38: grpc.AppEvent.certificateInstallFailed:type_name -> grpc.CertificateInstallFailedEvent
+	82,  // 39: grpc.AppEvent.knowledgeBaseSuggestions:type_name -> grpc.KnowledgeBaseSuggestionsEvent
+	74,  // 40: grpc.AppEvent.repairStarted:type_name -> grpc.RepairStartedEvent
+	75,  // 41: grpc.AppEvent.allUsersLoaded:type_name -> grpc.AllUsersLoadedEvent
+	131, // 42: grpc.AppEvent.userNotification:type_name -> grpc.UserNotificationEvent
+	76,  // 43: grpc.AppEvent.bridgeLocked:type_name -> grpc.BridgeLockedEvent
+	77,  // 44: grpc.AppEvent.bridgeUnlocked:type_name -> grpc.BridgeUnlockedEvent
+	80,  // 45: grpc.AppEvent.apiRateLimited:type_name -> grpc.APIRateLimitedEvent
+	78,  // 46: grpc.AppEvent.keyRotationStarted:type_name -> grpc.KeyRotationStartedEvent
+	79,  // 47: grpc.AppEvent.keyRotationFinished:type_name -> grpc.KeyRotationFinishedEvent
+	81,  // 48: grpc.KnowledgeBaseSuggestionsEvent.suggestions:type_name -> grpc.KnowledgeBaseSuggestion
+	84,  // 49: grpc.LoginEvent.error:type_name -> grpc.LoginErrorEvent
+	85,  // 50: grpc.LoginEvent.tfaRequested:type_name -> grpc.LoginTfaRequestedEvent
+	87,  // 51: grpc.LoginEvent.twoPasswordRequested:type_name -> grpc.LoginTwoPasswordsRequestedEvent
+	88,  // 52: grpc.LoginEvent.finished:type_name -> grpc.LoginFinishedEvent
+	88,  // 53: grpc.LoginEvent.alreadyLoggedIn:type_name -> grpc.LoginFinishedEvent
+	89,  // 54: grpc.LoginEvent.hvRequested:type_name -> grpc.LoginHvRequestedEvent
+	86,  // 55: grpc.LoginEvent.fido2Requested:type_name -> grpc.LoginFido2RequestedEvent
+	5,   // 56: grpc.LoginErrorEvent.type:type_name -> grpc.LoginErrorType
+	91,  // 57: grpc.UpdateEvent.error:type_name -> grpc.UpdateErrorEvent
+	92,  // 58: grpc.UpdateEvent.manualReady:type_name -> grpc.UpdateManualReadyEvent
+	93,  // 59: grpc.UpdateEvent.manualRestartNeeded:type_name -> grpc.UpdateManualRestartNeededEvent
+	94,  // 60: grpc.UpdateEvent.force:type_name -> grpc.UpdateForceEvent
+	95,  // 61: grpc.UpdateEvent.silentRestartNeeded:type_name -> grpc.UpdateSilentRestartNeeded
+	96,  // 62: grpc.UpdateEvent.isLatestVersion:type_name -> grpc.UpdateIsLatestVersion
+	97,  // 63: grpc.UpdateEvent.checkFinished:type_name -> grpc.UpdateCheckFinished
+	98,  // 64: grpc.UpdateEvent.versionChanged:type_name -> grpc.UpdateVersionChanged
+	6,   // 65: grpc.UpdateErrorEvent.type:type_name -> grpc.UpdateErrorType
+	100, // 66: grpc.DiskCacheEvent.error:type_name -> grpc.DiskCacheErrorEvent
+	101, // 67: grpc.DiskCacheEvent.pathChanged:type_name -> grpc.DiskCachePathChangedEvent
+	102, // 68: grpc.DiskCacheEvent.pathChangeFinished:type_name -> grpc.DiskCachePathChangeFinishedEvent
+	7,   // 69: grpc.DiskCacheErrorEvent.type:type_name -> grpc.DiskCacheErrorType
+	104, // 70: grpc.MailServerSettingsEvent.error:type_name -> grpc.MailServerSettingsErrorEvent
+	105, // 71: grpc.MailServerSettingsEvent.mailServerSettingsChanged:type_name -> grpc.MailServerSettingsChangedEvent
+	106, // 72: grpc.MailServerSettingsEvent.changeMailServerSettingsFinished:type_name -> grpc.ChangeMailServerSettingsFinishedEvent
+	8,   // 73: grpc.MailServerSettingsErrorEvent.type:type_name -> grpc.MailServerSettingsErrorType
+	29,  // 74: grpc.MailServerSettingsChangedEvent.settings:type_name -> grpc.ImapSmtpSettings
+	108, // 75: grpc.KeychainEvent.changeKeychainFinished:type_name -> grpc.ChangeKeychainFinishedEvent
+	109, // 76: grpc.KeychainEvent.hasNoKeychain:type_name -> grpc.HasNoKeychainEvent
+	110, // 77: grpc.KeychainEvent.rebuildKeychain:type_name -> grpc.RebuildKeychainEvent
+	111, // 78: grpc.KeychainEvent.credentialStoreUnavailable:type_name -> grpc.CredentialStoreUnavailableEvent
+	112, // 79: grpc.KeychainEvent.credentialStoreAvailable:type_name -> grpc.CredentialStoreAvailableEvent
+	114, // 80: grpc.MailEvent.addressChanged:type_name -> grpc.AddressChangedEvent
+	115, // 81: grpc.MailEvent.addressChangedLogout:type_name -> grpc.AddressChangedLogoutEvent
+	116, // 82: grpc.MailEvent.apiCertIssue:type_name -> grpc.ApiCertIssueEvent
+	117, // 83: grpc.MailEvent.messageTooLarge:type_name -> grpc.MessageTooLargeEvent
+	119, // 84: grpc.UserEvent.toggleSplitModeFinished:type_name -> grpc.ToggleSplitModeFinishedEvent
+	120, // 85: grpc.UserEvent.userDisconnected:type_name -> grpc.UserDisconnectedEvent
+	121, // 86: grpc.UserEvent.userChanged:type_name -> grpc.UserChangedEvent
+	122, // 87: grpc.UserEvent.userBadEvent:type_name -> grpc.UserBadEvent
+	123, // 88: grpc.UserEvent.usedBytesChangedEvent:type_name -> grpc.UsedBytesChangedEvent
+	127, // 89: grpc.UserEvent.imapLoginFailedEvent:type_name -> grpc.ImapLoginFailedEvent
+	128, // 90: grpc.UserEvent.syncStartedEvent:type_name -> grpc.SyncStartedEvent
+	129, // 91: grpc.UserEvent.syncFinishedEvent:type_name -> grpc.SyncFinishedEvent
+	130, // 92: grpc.UserEvent.syncProgressEvent:type_name -> grpc.SyncProgressEvent
+	124, // 93: grpc.UserEvent.storageAlmostFullEvent:type_name -> grpc.UserStorageAlmostFullEvent
+	125, // 94: grpc.UserEvent.reauthRequiredEvent:type_name -> grpc.UserReauthRequiredEvent
+	126, // 95: grpc.UserEvent.readReceiptRequestedEvent:type_name -> grpc.UserReadReceiptRequestedEvent
+	9,   // 96: grpc.GenericErrorEvent.code:type_name -> grpc.ErrorCode
+	15,  // 97: grpc.Bridge.GetServiceInfo:input_type -> grpc.ServiceInfoRequest
+	134, // 98: grpc.Bridge.CheckTokens:input_type -> google.protobuf.StringValue
+	10,  // 99: grpc.Bridge.AddLogEntry:input_type -> grpc.AddLogEntryRequest
+	135, // 100: grpc.Bridge.LogLevels:input_type -> google.protobuf.Empty
+	13,  // 101: grpc.Bridge.SetLogLevel:input_type -> grpc.SetLogLevelRequest
+	135, // 102: grpc.Bridge.Traces:input_type -> google.protobuf.Empty
+	22,  // 103: grpc.Bridge.StartTrace:input_type -> grpc.StartTraceRequest
+	134, // 104: grpc.Bridge.StopTrace:input_type -> google.protobuf.StringValue
+	136, // 105: grpc.Bridge.SetIsSessionAuditLogEnabled:input_type -> google.protobuf.BoolValue
+	135, // 106: grpc.Bridge.IsSessionAuditLogEnabled:input_type -> google.protobuf.Empty
+	135, // 107: grpc.Bridge.RecentIMAPSessions:input_type -> google.protobuf.Empty
+	135, // 108: grpc.Bridge.GuiReady:input_type -> google.protobuf.Empty
+	135, // 109: grpc.Bridge.Quit:input_type -> google.protobuf.Empty
+	135, // 110: grpc.Bridge.Restart:input_type -> google.protobuf.Empty
+	135, // 111: grpc.Bridge.ShowOnStartup:input_type -> google.protobuf.Empty
+	136, // 112: grpc.Bridge.SetIsAutostartOn:input_type -> google.protobuf.BoolValue
+	135, // 113: grpc.Bridge.IsAutostartOn:input_type -> google.protobuf.Empty
+	136, // 114: grpc.Bridge.SetIsBetaEnabled:input_type -> google.protobuf.BoolValue
+	135, // 115: grpc.Bridge.IsBetaEnabled:input_type -> google.protobuf.Empty
+	136, // 116: grpc.Bridge.SetIsAllMailVisible:input_type -> google.protobuf.BoolValue
+	135, // 117: grpc.Bridge.IsAllMailVisible:input_type -> google.protobuf.Empty
+	136, // 118: grpc.Bridge.SetIsIPCOverTCP:input_type -> google.protobuf.BoolValue
+	135, // 119: grpc.Bridge.IsIPCOverTCP:input_type -> google.protobuf.Empty
+	136, // 120: grpc.Bridge.SetIsReauthOnDeauthEnabled:input_type -> google.protobuf.BoolValue
+	135, // 121: grpc.Bridge.IsReauthOnDeauthEnabled:input_type -> google.protobuf.Empty
+	136, // 122: grpc.Bridge.SetIsTelemetryDisabled:input_type -> google.protobuf.BoolValue
+	135, // 123: grpc.Bridge.IsTelemetryDisabled:input_type -> google.protobuf.Empty
+	136, // 124: grpc.Bridge.SetIsReportingDisabled:input_type -> google.protobuf.BoolValue
+	135, // 125: grpc.Bridge.IsReportingDisabled:input_type -> google.protobuf.Empty
+	135, // 126: grpc.Bridge.GoOs:input_type -> google.protobuf.Empty
+	135, // 127: grpc.Bridge.TriggerReset:input_type -> google.protobuf.Empty
+	135, // 128: grpc.Bridge.Version:input_type -> google.protobuf.Empty
+	135, // 129: grpc.Bridge.LogsPath:input_type -> google.protobuf.Empty
+	135, // 130: grpc.Bridge.LicensePath:input_type -> google.protobuf.Empty
+	135, // 131: grpc.Bridge.ReleaseNotesPageLink:input_type -> google.protobuf.Empty
+	135, // 132: grpc.Bridge.DependencyLicensesLink:input_type -> google.protobuf.Empty
+	135, // 133: grpc.Bridge.LandingPageLink:input_type -> google.protobuf.Empty
+	134, // 134: grpc.Bridge.SetColorSchemeName:input_type -> google.protobuf.StringValue
+	135, // 135: grpc.Bridge.ColorSchemeName:input_type -> google.protobuf.Empty
+	135, // 136: grpc.Bridge.CurrentEmailClient:input_type -> google.protobuf.Empty
+	18,  // 137: grpc.Bridge.ReportBug:input_type -> grpc.ReportBugRequest
+	134, // 138: grpc.Bridge.CollectDiagnostics:input_type -> google.protobuf.StringValue
+	135, // 139: grpc.Bridge.CheckConfiguration:input_type -> google.protobuf.Empty
+	134, // 140: grpc.Bridge.ForceLauncher:input_type -> google.protobuf.StringValue
+	134, // 141: grpc.Bridge.SetMainExecutable:input_type -> google.protobuf.StringValue
+	134, // 142: grpc.Bridge.RequestKnowledgeBaseSuggestions:input_type -> google.protobuf.StringValue
+	19,  // 143: grpc.Bridge.Login:input_type -> grpc.LoginRequest
+	19,  // 144: grpc.Bridge.Login2FA:input_type -> grpc.LoginRequest
+	25,  // 145: grpc.Bridge.LoginFido2:input_type -> grpc.LoginFido2Request
+	26,  // 146: grpc.Bridge.LoginFido2Local:input_type -> grpc.LoginFido2LocalRequest
+	19,  // 147: grpc.Bridge.Login2Passwords:input_type -> grpc.LoginRequest
+	27,  // 148: grpc.Bridge.LoginAbort:input_type -> grpc.LoginAbortRequest
+	135, // 149: grpc.Bridge.CheckUpdate:input_type -> google.protobuf.Empty
+	135, // 150: grpc.Bridge.InstallUpdate:input_type -> google.protobuf.Empty
+	135, // 151: grpc.Bridge.RollbackUpdate:input_type -> google.protobuf.Empty
+	136, // 152: grpc.Bridge.SetIsAutomaticUpdateOn:input_type -> google.protobuf.BoolValue
+	135, // 153: grpc.Bridge.IsAutomaticUpdateOn:input_type -> google.protobuf.Empty
+	135, // 154: grpc.Bridge.DiskCachePath:input_type -> google.protobuf.Empty
+	134, // 155: grpc.Bridge.SetDiskCachePath:input_type -> google.protobuf.StringValue
+	135, // 156: grpc.Bridge.DiskCacheMaxSize:input_type -> google.protobuf.Empty
+	137, // 157: grpc.Bridge.SetDiskCacheMaxSize:input_type -> google.protobuf.UInt64Value
+	135, // 158: grpc.Bridge.DiskCacheSize:input_type -> google.protobuf.Empty
+	135, // 159: grpc.Bridge.DiskCacheKeepRecentDays:input_type -> google.protobuf.Empty
+	138, // 160: grpc.Bridge.SetDiskCacheKeepRecentDays:input_type -> google.protobuf.UInt32Value
+	135, // 161: grpc.Bridge.MaxSyncMemory:input_type -> google.protobuf.Empty
+	137, // 162: grpc.Bridge.SetMaxSyncMemory:input_type -> google.protobuf.UInt64Value
+	135, // 163: grpc.Bridge.MemoryUsage:input_type -> google.protobuf.Empty
+	136, // 164: grpc.Bridge.SetIsDoHEnabled:input_type -> google.protobuf.BoolValue
+	135, // 165: grpc.Bridge.IsDoHEnabled:input_type -> google.protobuf.Empty
+	135, // 166: grpc.Bridge.MailServerSettings:input_type -> google.protobuf.Empty
+	29,  // 167: grpc.Bridge.SetMailServerSettings:input_type -> grpc.ImapSmtpSettings
+	135, // 168: grpc.Bridge.ImplicitTLSPorts:input_type -> google.protobuf.Empty
+	30,  // 169: grpc.Bridge.SetImplicitTLSPorts:input_type -> grpc.ImplicitTLSPortSettings
+	135, // 170: grpc.Bridge.SSLRequired:input_type -> google.protobuf.Empty
+	31,  // 171: grpc.Bridge.SetSSLRequired:input_type -> grpc.SSLRequiredSettings
+	135, // 172: grpc.Bridge.Hostname:input_type -> google.protobuf.Empty
+	135, // 173: grpc.Bridge.BindAddress:input_type -> google.protobuf.Empty
+	35,  // 174: grpc.Bridge.SetBindAddress:input_type -> grpc.BindAddressRequest
+	139, // 175: grpc.Bridge.IsPortFree:input_type -> google.protobuf.Int32Value
+	135, // 176: grpc.Bridge.AvailableKeychains:input_type -> google.protobuf.Empty
+	134, // 177: grpc.Bridge.SetCurrentKeychain:input_type -> google.protobuf.StringValue
+	135, // 178: grpc.Bridge.CurrentKeychain:input_type -> google.protobuf.Empty
+	135, // 179: grpc.Bridge.IsCredentialStoreUnavailable:input_type -> google.protobuf.Empty
+	135, // 180: grpc.Bridge.MigrateToFileVault:input_type -> google.protobuf.Empty
+	135, // 181: grpc.Bridge.AutoLockTimeout:input_type -> google.protobuf.Empty
+	139, // 182: grpc.Bridge.SetAutoLockTimeout:input_type -> google.protobuf.Int32Value
+	135, // 183: grpc.Bridge.HasUnlockPassphrase:input_type -> google.protobuf.Empty
+	140, // 184: grpc.Bridge.SetUnlockPassphrase:input_type -> google.protobuf.BytesValue
+	135, // 185: grpc.Bridge.IsLocked:input_type -> google.protobuf.Empty
+	135, // 186: grpc.Bridge.Lock:input_type -> google.protobuf.Empty
+	140, // 187: grpc.Bridge.Unlock:input_type -> google.protobuf.BytesValue
+	135, // 188: grpc.Bridge.RotateEncryptionKeys:input_type -> google.protobuf.Empty
+	135, // 189: grpc.Bridge.GetUserList:input_type -> google.protobuf.Empty
+	134, // 190: grpc.Bridge.GetUser:input_type -> google.protobuf.StringValue
+	43,  // 191: grpc.Bridge.SetUserSplitMode:input_type -> grpc.UserSplitModeRequest
+	44,  // 192: grpc.Bridge.SetUserHideAllMail:input_type -> grpc.UserHideAllMailRequest
+	45,  // 193: grpc.Bridge.SetUserRepairMime:input_type -> grpc.UserRepairMimeRequest
+	46,  // 194: grpc.Bridge.SetUserReadReceiptMode:input_type -> grpc.UserReadReceiptModeRequest
+	47,  // 195: grpc.Bridge.SendUserReadReceipt:input_type -> grpc.UserReadReceiptRequest
+	48,  // 196: grpc.Bridge.SetUserSyncCutoff:input_type -> grpc.UserSyncCutoffRequest
+	49,  // 197: grpc.Bridge.SendBadEventUserFeedback:input_type -> grpc.UserBadEventFeedbackRequest
+	134, // 198: grpc.Bridge.LogoutUser:input_type -> google.protobuf.StringValue
+	134, // 199: grpc.Bridge.PrioritizeUserSync:input_type -> google.protobuf.StringValue
+	134, // 200: grpc.Bridge.RemoveUser:input_type -> google.protobuf.StringValue
+	134, // 201: grpc.Bridge.RemoveUserKeepData:input_type -> google.protobuf.StringValue
+	51,  // 202: grpc.Bridge.ConfigureUserAppleMail:input_type -> grpc.ConfigureAppleMailRequest
+	52,  // 203: grpc.Bridge.UserClientConfig:input_type -> grpc.ClientConfigRequest
+	135, // 204: grpc.Bridge.StartAutoconfigServer:input_type -> google.protobuf.Empty
+	134, // 205: grpc.Bridge.UserAppPasswords:input_type -> google.protobuf.StringValue
+	55,  // 206: grpc.Bridge.CreateUserAppPassword:input_type -> grpc.AppPasswordRequest
+	55,  // 207: grpc.Bridge.RevokeUserAppPassword:input_type -> grpc.AppPasswordRequest
+	56,  // 208: grpc.Bridge.CreateUserAuthToken:input_type -> grpc.AuthTokenRequest
+	57,  // 209: grpc.Bridge.UserEventJournal:input_type -> grpc.UserEventJournalRequest
+	135, // 210: grpc.Bridge.IsTLSCertificateInstalled:input_type -> google.protobuf.Empty
+	135, // 211: grpc.Bridge.InstallTLSCertificate:input_type -> google.protobuf.Empty
+	134, // 212: grpc.Bridge.ExportTLSCertificates:input_type -> google.protobuf.StringValue
+	135, // 213: grpc.Bridge.TLSCertificatePaths:input_type -> google.protobuf.Empty
+	32,  // 214: grpc.Bridge.SetTLSCertificatePaths:input_type -> grpc.TLSCertificateFiles
+	134, // 215: grpc.Bridge.SetTLSCertificateDir:input_type -> google.protobuf.StringValue
+	135, // 216: grpc.Bridge.ResetTLSCertificate:input_type -> google.protobuf.Empty
+	135, // 217: grpc.Bridge.Webhooks:input_type -> google.protobuf.Empty
+	41,  // 218: grpc.Bridge.AddWebhook:input_type -> grpc.AddWebhookRequest
+	134, // 219: grpc.Bridge.RemoveWebhook:input_type -> google.protobuf.StringValue
+	60,  // 220: grpc.Bridge.RunEventStream:input_type -> grpc.EventStreamRequest
+	135, // 221: grpc.Bridge.StopEventStream:input_type -> google.protobuf.Empty
+	37,  // 222: grpc.Bridge.SubscribeBridgeEvents:input_type -> grpc.BridgeEventFilter
+	135, // 223: grpc.Bridge.TriggerRepair:input_type -> google.protobuf.Empty
+	17,  // 224: grpc.Bridge.GetServiceInfo:output_type -> grpc.ServiceInfo
+	134, // 225: grpc.Bridge.CheckTokens:output_type -> google.protobuf.StringValue
+	135, // 226: grpc.Bridge.AddLogEntry:output_type -> google.protobuf.Empty
+	12,  // 227: grpc.Bridge.LogLevels:output_type -> grpc.LogLevelsResponse
+	135, // 228: grpc.Bridge.SetLogLevel:output_type -> google.protobuf.Empty
+	21,  // 229: grpc.Bridge.Traces:output_type -> grpc.TracesResponse
+	135, // 230: grpc.Bridge.StartTrace:output_type -> google.protobuf.Empty
+	135, // 231: grpc.Bridge.StopTrace:output_type -> google.protobuf.Empty
+	135, // 232: grpc.Bridge.SetIsSessionAuditLogEnabled:output_type -> google.protobuf.Empty
+	136, // 233: grpc.Bridge.IsSessionAuditLogEnabled:output_type -> google.protobuf.BoolValue
+	24,  // 234: grpc.Bridge.RecentIMAPSessions:output_type -> grpc.IMAPSessionsResponse
+	14,  // 235: grpc.Bridge.GuiReady:output_type -> grpc.GuiReadyResponse
+	135, // 236: grpc.Bridge.Quit:output_type -> google.protobuf.Empty
+	135, // 237: grpc.Bridge.Restart:output_type -> google.protobuf.Empty
+	136, // 238: grpc.Bridge.ShowOnStartup:output_type -> google.protobuf.BoolValue
+	135, // 239: grpc.Bridge.SetIsAutostartOn:output_type -> google.protobuf.Empty
+	136, // 240: grpc.Bridge.IsAutostartOn:output_type -> google.protobuf.BoolValue
+	135, // 241: grpc.Bridge.SetIsBetaEnabled:output_type -> google.protobuf.Empty
+	136, // 242: grpc.Bridge.IsBetaEnabled:output_type -> google.protobuf.BoolValue
+	135, // 243: grpc.Bridge.SetIsAllMailVisible:output_type -> google.protobuf.Empty
+	136, // 244: grpc.Bridge.IsAllMailVisible:output_type -> google.protobuf.BoolValue
+	135, // 245: grpc.Bridge.SetIsIPCOverTCP:output_type -> google.protobuf.Empty
+	136, // 246: grpc.Bridge.IsIPCOverTCP:output_type -> google.protobuf.BoolValue
+	135, // 247: grpc.Bridge.SetIsReauthOnDeauthEnabled:output_type -> google.protobuf.Empty
+	136, // 248: grpc.Bridge.IsReauthOnDeauthEnabled:output_type -> google.protobuf.BoolValue
+	135, // 249: grpc.Bridge.SetIsTelemetryDisabled:output_type -> google.protobuf.Empty
+	136, // 250: grpc.Bridge.IsTelemetryDisabled:output_type -> google.protobuf.BoolValue
+	135, // 251: grpc.Bridge.SetIsReportingDisabled:output_type -> google.protobuf.Empty
+	136, // 252: grpc.Bridge.IsReportingDisabled:output_type -> google.protobuf.BoolValue
+	134, // 253: grpc.Bridge.GoOs:output_type -> google.protobuf.StringValue
+	135, // 254: grpc.Bridge.TriggerReset:output_type -> google.protobuf.Empty
+	134, // 255: grpc.Bridge.Version:output_type -> google.protobuf.StringValue
+	134, // 256: grpc.Bridge.LogsPath:output_type -> google.protobuf.StringValue
+	134, // 257: grpc.Bridge.LicensePath:output_type -> google.protobuf.StringValue
+	134, // 258: grpc.Bridge.ReleaseNotesPageLink:output_type -> google.protobuf.StringValue
+	134, // 259: grpc.Bridge.DependencyLicensesLink:output_type -> google.protobuf.StringValue
+	134, // 260: grpc.Bridge.LandingPageLink:output_type -> google.protobuf.StringValue
+	135, // 261: grpc.Bridge.SetColorSchemeName:output_type -> google.protobuf.Empty
+	134, // 262: grpc.Bridge.ColorSchemeName:output_type -> google.protobuf.StringValue
+	134, // 263: grpc.Bridge.CurrentEmailClient:output_type -> google.protobuf.StringValue
+	135, // 264: grpc.Bridge.ReportBug:output_type -> google.protobuf.Empty
+	134, // 265: grpc.Bridge.CollectDiagnostics:output_type -> google.protobuf.StringValue
+	34,  // 266: grpc.Bridge.CheckConfiguration:output_type -> grpc.ConfigurationReport
+	135, // 267: grpc.Bridge.ForceLauncher:output_type -> google.protobuf.Empty
+	135, // 268: grpc.Bridge.SetMainExecutable:output_type -> google.protobuf.Empty
+	135, // 269: grpc.Bridge.RequestKnowledgeBaseSuggestions:output_type -> google.protobuf.Empty
+	135, // 270: grpc.Bridge.Login:output_type -> google.protobuf.Empty
+	135, // 271: grpc.Bridge.Login2FA:output_type -> google.protobuf.Empty
+	135, // 272: grpc.Bridge.LoginFido2:output_type -> google.protobuf.Empty
+	135, // 273: grpc.Bridge.LoginFido2Local:output_type -> google.protobuf.Empty
+	135, // 274: grpc.Bridge.Login2Passwords:output_type -> google.protobuf.Empty
+	135, // 275: grpc.Bridge.LoginAbort:output_type -> google.protobuf.Empty
+	135, // 276: grpc.Bridge.CheckUpdate:output_type -> google.protobuf.Empty
+	135, // 277: grpc.Bridge.InstallUpdate:output_type -> google.protobuf.Empty
+	135, // 278: grpc.Bridge.RollbackUpdate:output_type -> google.protobuf.Empty
+	135, // 279: grpc.Bridge.SetIsAutomaticUpdateOn:output_type -> google.protobuf.Empty
+	136, // 280: grpc.Bridge.IsAutomaticUpdateOn:output_type -> google.protobuf.BoolValue
+	134, // 281: grpc.Bridge.DiskCachePath:output_type -> google.protobuf.StringValue
+	135, // 282: grpc.Bridge.SetDiskCachePath:output_type -> google.protobuf.Empty
+	137, // 283: grpc.Bridge.DiskCacheMaxSize:output_type -> google.protobuf.UInt64Value
+	135, // 284: grpc.Bridge.SetDiskCacheMaxSize:output_type -> google.protobuf.Empty
+	137, // 285: grpc.Bridge.DiskCacheSize:output_type -> google.protobuf.UInt64Value
+	138, // 286: grpc.Bridge.DiskCacheKeepRecentDays:output_type -> google.protobuf.UInt32Value
+	135, // 287: grpc.Bridge.SetDiskCacheKeepRecentDays:output_type -> google.protobuf.Empty
+	137, // 288: grpc.Bridge.MaxSyncMemory:output_type -> google.protobuf.UInt64Value
+	135, // 289: grpc.Bridge.SetMaxSyncMemory:output_type -> google.protobuf.Empty
+	28,  // 290: grpc.Bridge.MemoryUsage:output_type -> grpc.MemoryUsageResponse
+	135, // 291: grpc.Bridge.SetIsDoHEnabled:output_type -> google.protobuf.Empty
+	136, // 292: grpc.Bridge.IsDoHEnabled:output_type -> google.protobuf.BoolValue
+	29,  // 293: grpc.Bridge.MailServerSettings:output_type -> grpc.ImapSmtpSettings
+	135, // 294: grpc.Bridge.SetMailServerSettings:output_type -> google.protobuf.Empty
+	30,  // 295: grpc.Bridge.ImplicitTLSPorts:output_type -> grpc.ImplicitTLSPortSettings
+	135, // 296: grpc.Bridge.SetImplicitTLSPorts:output_type -> google.protobuf.Empty
+	31,  // 297: grpc.Bridge.SSLRequired:output_type -> grpc.SSLRequiredSettings
+	135, // 298: grpc.Bridge.SetSSLRequired:output_type -> google.protobuf.Empty
+	134, // 299: grpc.Bridge.Hostname:output_type -> google.protobuf.StringValue
+	134, // 300: grpc.Bridge.BindAddress:output_type -> google.protobuf.StringValue
+	135, // 301: grpc.Bridge.SetBindAddress:output_type -> google.protobuf.Empty
+	136, // 302: grpc.Bridge.IsPortFree:output_type -> google.protobuf.BoolValue
+	36,  // 303: grpc.Bridge.AvailableKeychains:output_type -> grpc.AvailableKeychainsResponse
+	135, // 304: grpc.Bridge.SetCurrentKeychain:output_type -> google.protobuf.Empty
+	134, // 305: grpc.Bridge.CurrentKeychain:output_type -> google.protobuf.StringValue
+	136, // 306: grpc.Bridge.IsCredentialStoreUnavailable:output_type -> google.protobuf.BoolValue
+	135, // 307: grpc.Bridge.MigrateToFileVault:output_type -> google.protobuf.Empty
+	139, // 308: grpc.Bridge.AutoLockTimeout:output_type -> google.protobuf.Int32Value
+	135, // 309: grpc.Bridge.SetAutoLockTimeout:output_type -> google.protobuf.Empty
+	136, // 310: grpc.Bridge.HasUnlockPassphrase:output_type -> google.protobuf.BoolValue
+	135, // 311: grpc.Bridge.SetUnlockPassphrase:output_type -> google.protobuf.Empty
+	136, // 312: grpc.Bridge.IsLocked:output_type -> google.protobuf.BoolValue
+	135, // 313: grpc.Bridge.Lock:output_type -> google.protobuf.Empty
+	135, // 314: grpc.Bridge.Unlock:output_type -> google.protobuf.Empty
+	135, // 315: grpc.Bridge.RotateEncryptionKeys:output_type -> google.protobuf.Empty
+	50,  // 316: grpc.Bridge.GetUserList:output_type -> grpc.UserListResponse
+	42,  // 317: grpc.Bridge.GetUser:output_type -> grpc.User
+	135, // 318: grpc.Bridge.SetUserSplitMode:output_type -> google.protobuf.Empty
+	135, // 319: grpc.Bridge.SetUserHideAllMail:output_type -> google.protobuf.Empty
+	135, // 320: grpc.Bridge.SetUserRepairMime:output_type -> google.protobuf.Empty
+	135, // 321: grpc.Bridge.SetUserReadReceiptMode:output_type -> google.protobuf.Empty
+	135, // 322: grpc.Bridge.SendUserReadReceipt:output_type -> google.protobuf.Empty
+	135, // 323: grpc.Bridge.SetUserSyncCutoff:output_type -> google.protobuf.Empty
+	135, // 324: grpc.Bridge.SendBadEventUserFeedback:output_type -> google.protobuf.Empty
+	135, // 325: grpc.Bridge.LogoutUser:output_type -> google.protobuf.Empty
+	135, // 326: grpc.Bridge.PrioritizeUserSync:output_type -> google.protobuf.Empty
+	135, // 327: grpc.Bridge.RemoveUser:output_type -> google.protobuf.Empty
+	135, // 328: grpc.Bridge.RemoveUserKeepData:output_type -> google.protobuf.Empty
+	135, // 329: grpc.Bridge.ConfigureUserAppleMail:output_type -> google.protobuf.Empty
+	134, // 330: grpc.Bridge.UserClientConfig:output_type -> google.protobuf.StringValue
+	134, // 331: grpc.Bridge.StartAutoconfigServer:output_type -> google.protobuf.StringValue
+	54,  // 332: grpc.Bridge.UserAppPasswords:output_type -> grpc.AppPasswordsResponse
+	140, // 333: grpc.Bridge.CreateUserAppPassword:output_type -> google.protobuf.BytesValue
+	135, // 334: grpc.Bridge.RevokeUserAppPassword:output_type -> google.protobuf.Empty
+	140, // 335: grpc.Bridge.CreateUserAuthToken:output_type -> google.protobuf.BytesValue
+	59,  // 336: grpc.Bridge.UserEventJournal:output_type -> grpc.UserEventJournalResponse
+	136, // 337: grpc.Bridge.IsTLSCertificateInstalled:output_type -> google.protobuf.BoolValue
+	135, // 338: grpc.Bridge.InstallTLSCertificate:output_type -> google.protobuf.Empty
+	135, // 339: grpc.Bridge.ExportTLSCertificates:output_type -> google.protobuf.Empty
+	32,  // 340: grpc.Bridge.TLSCertificatePaths:output_type -> grpc.TLSCertificateFiles
+	135, // 341: grpc.Bridge.SetTLSCertificatePaths:output_type -> google.protobuf.Empty
+	135, // 342: grpc.Bridge.SetTLSCertificateDir:output_type -> google.protobuf.Empty
+	135, // 343: grpc.Bridge.ResetTLSCertificate:output_type -> google.protobuf.Empty
+	40,  // 344: grpc.Bridge.Webhooks:output_type -> grpc.WebhooksResponse
+	135, // 345: grpc.Bridge.AddWebhook:output_type -> google.protobuf.Empty
+	135, // 346: grpc.Bridge.RemoveWebhook:output_type -> google.protobuf.Empty
+	61,  // 347: grpc.Bridge.RunEventStream:output_type -> grpc.StreamEvent
+	135, // 348: grpc.Bridge.StopEventStream:output_type -> google.protobuf.Empty
+	38,  // 349: grpc.Bridge.SubscribeBridgeEvents:output_type -> grpc.BridgeEvent
+	135, // 350: grpc.Bridge.TriggerRepair:output_type -> google.protobuf.Empty
+	224, // [224:351] is the sub-list for method output_type
+	97,  // [97:224] is the sub-list for method input_type
+	97,  // [97:97] is the sub-list for extension type_name
+	97,  // [97:97] is the sub-list for extension extendee
+	0,   // [0:97] is the sub-list for field type_name
+}
+
+func init() { file_bridge_proto_init() }
+func file_bridge_proto_init() {
+	if File_bridge_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_bridge_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLogEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubsystemLogLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLevelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuiReadyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeprecationHint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportBugRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubsystemTrace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTraceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IMAPSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IMAPSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginFido2Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginFido2LocalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginAbortRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImapSmtpSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImplicitTLSPortSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSLRequiredSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLSCertificateFiles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigurationCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigurationReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvailableKeychainsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeEventFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhooksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSplitModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserHideAllMailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRepairMimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserReadReceiptModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserReadReceiptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6016,8 +10700,8 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GuiReadyResponse); i {
+		file_bridge_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSyncCutoffRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6028,8 +10712,8 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportBugRequest); i {
+		file_bridge_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserBadEventFeedbackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6040,8 +10724,8 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginRequest); i {
+		file_bridge_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6052,8 +10736,8 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginAbortRequest); i {
+		file_bridge_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigureAppleMailRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6064,8 +10748,8 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImapSmtpSettings); i {
+		file_bridge_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6076,8 +10760,8 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AvailableKeychainsResponse); i {
+		file_bridge_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppPassword); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6088,8 +10772,8 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_bridge_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppPasswordsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6100,8 +10784,8 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSplitModeRequest); i {
+		file_bridge_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppPasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6112,8 +10796,8 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserBadEventFeedbackRequest); i {
+		file_bridge_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6124,8 +10808,8 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserListResponse); i {
+		file_bridge_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserEventJournalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6136,8 +10820,8 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigureAppleMailRequest); i {
+		file_bridge_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventJournalEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6148,7 +10832,19 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserEventJournalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventStreamRequest); i {
 			case 0:
 				return &v.state
@@ -6160,7 +10856,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamEvent); i {
 			case 0:
 				return &v.state
@@ -6172,7 +10868,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AppEvent); i {
 			case 0:
 				return &v.state
@@ -6184,7 +10880,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InternetStatusEvent); i {
 			case 0:
 				return &v.state
@@ -6196,7 +10892,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ToggleAutostartFinishedEvent); i {
 			case 0:
 				return &v.state
@@ -6208,7 +10904,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResetFinishedEvent); i {
 			case 0:
 				return &v.state
@@ -6220,7 +10916,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportBugFinishedEvent); i {
 			case 0:
 				return &v.state
@@ -6232,7 +10928,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportBugSuccessEvent); i {
 			case 0:
 				return &v.state
@@ -6244,7 +10940,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportBugErrorEvent); i {
 			case 0:
 				return &v.state
@@ -6256,7 +10952,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ShowMainWindowEvent); i {
 			case 0:
 				return &v.state
@@ -6268,7 +10964,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportBugFallbackEvent); i {
 			case 0:
 				return &v.state
@@ -6280,7 +10976,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CertificateInstallSuccessEvent); i {
 			case 0:
 				return &v.state
@@ -6292,7 +10988,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CertificateInstallCanceledEvent); i {
 			case 0:
 				return &v.state
@@ -6304,7 +11000,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CertificateInstallFailedEvent); i {
 			case 0:
 				return &v.state
@@ -6316,7 +11012,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepairStartedEvent); i {
 			case 0:
 				return &v.state
@@ -6328,7 +11024,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AllUsersLoadedEvent); i {
 			case 0:
 				return &v.state
@@ -6340,7 +11036,67 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeLockedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeUnlockedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRotationStartedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRotationFinishedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIRateLimitedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KnowledgeBaseSuggestion); i {
 			case 0:
 				return &v.state
@@ -6352,7 +11108,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KnowledgeBaseSuggestionsEvent); i {
 			case 0:
 				return &v.state
@@ -6364,7 +11120,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoginEvent); i {
 			case 0:
 				return &v.state
@@ -6376,7 +11132,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoginErrorEvent); i {
 			case 0:
 				return &v.state
@@ -6388,7 +11144,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoginTfaRequestedEvent); i {
 			case 0:
 				return &v.state
@@ -6400,7 +11156,19 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginFido2RequestedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoginTwoPasswordsRequestedEvent); i {
 			case 0:
 				return &v.state
@@ -6412,7 +11180,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoginFinishedEvent); i {
 			case 0:
 				return &v.state
@@ -6424,7 +11192,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoginHvRequestedEvent); i {
 			case 0:
 				return &v.state
@@ -6436,7 +11204,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateEvent); i {
 			case 0:
 				return &v.state
@@ -6448,7 +11216,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateErrorEvent); i {
 			case 0:
 				return &v.state
@@ -6460,7 +11228,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateManualReadyEvent); i {
 			case 0:
 				return &v.state
@@ -6472,7 +11240,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateManualRestartNeededEvent); i {
 			case 0:
 				return &v.state
@@ -6484,7 +11252,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateForceEvent); i {
 			case 0:
 				return &v.state
@@ -6496,7 +11264,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSilentRestartNeeded); i {
 			case 0:
 				return &v.state
@@ -6508,7 +11276,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateIsLatestVersion); i {
 			case 0:
 				return &v.state
@@ -6520,7 +11288,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateCheckFinished); i {
 			case 0:
 				return &v.state
@@ -6532,7 +11300,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateVersionChanged); i {
 			case 0:
 				return &v.state
@@ -6544,7 +11312,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DiskCacheEvent); i {
 			case 0:
 				return &v.state
@@ -6556,7 +11324,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DiskCacheErrorEvent); i {
 			case 0:
 				return &v.state
@@ -6568,7 +11336,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DiskCachePathChangedEvent); i {
 			case 0:
 				return &v.state
@@ -6580,7 +11348,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DiskCachePathChangeFinishedEvent); i {
 			case 0:
 				return &v.state
@@ -6592,7 +11360,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MailServerSettingsEvent); i {
 			case 0:
 				return &v.state
@@ -6604,7 +11372,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MailServerSettingsErrorEvent); i {
 			case 0:
 				return &v.state
@@ -6616,7 +11384,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MailServerSettingsChangedEvent); i {
 			case 0:
 				return &v.state
@@ -6628,7 +11396,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChangeMailServerSettingsFinishedEvent); i {
 			case 0:
 				return &v.state
@@ -6640,7 +11408,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KeychainEvent); i {
 			case 0:
 				return &v.state
@@ -6652,7 +11420,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChangeKeychainFinishedEvent); i {
 			case 0:
 				return &v.state
@@ -6664,7 +11432,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HasNoKeychainEvent); i {
 			case 0:
 				return &v.state
@@ -6676,7 +11444,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RebuildKeychainEvent); i {
 			case 0:
 				return &v.state
@@ -6688,7 +11456,31 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CredentialStoreUnavailableEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CredentialStoreAvailableEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MailEvent); i {
 			case 0:
 				return &v.state
@@ -6700,7 +11492,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddressChangedEvent); i {
 			case 0:
 				return &v.state
@@ -6712,7 +11504,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddressChangedLogoutEvent); i {
 			case 0:
 				return &v.state
@@ -6724,7 +11516,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApiCertIssueEvent); i {
 			case 0:
 				return &v.state
@@ -6736,7 +11528,19 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageTooLargeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserEvent); i {
 			case 0:
 				return &v.state
@@ -6748,7 +11552,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ToggleSplitModeFinishedEvent); i {
 			case 0:
 				return &v.state
@@ -6760,7 +11564,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserDisconnectedEvent); i {
 			case 0:
 				return &v.state
@@ -6772,7 +11576,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserChangedEvent); i {
 			case 0:
 				return &v.state
@@ -6784,7 +11588,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserBadEvent); i {
 			case 0:
 				return &v.state
@@ -6796,7 +11600,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UsedBytesChangedEvent); i {
 			case 0:
 				return &v.state
@@ -6808,7 +11612,43 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserStorageAlmostFullEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserReauthRequiredEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserReadReceiptRequestedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImapLoginFailedEvent); i {
 			case 0:
 				return &v.state
@@ -6820,7 +11660,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SyncStartedEvent); i {
 			case 0:
 				return &v.state
@@ -6832,7 +11672,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SyncFinishedEvent); i {
 			case 0:
 				return &v.state
@@ -6844,7 +11684,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SyncProgressEvent); i {
 			case 0:
 				return &v.state
@@ -6856,7 +11696,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserNotificationEvent); i {
 			case 0:
 				return &v.state
@@ -6868,7 +11708,7 @@ func file_bridge_proto_init() {
 				return nil
 			}
 		}
-		file_bridge_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_bridge_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenericErrorEvent); i {
 			case 0:
 				return &v.state
@@ -6881,8 +11721,8 @@ func file_bridge_proto_init() {
 			}
 		}
 	}
-	file_bridge_proto_msgTypes[3].OneofWrappers = []interface{}{}
-	file_bridge_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_bridge_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_bridge_proto_msgTypes[51].OneofWrappers = []interface{}{
 		(*StreamEvent_App)(nil),
 		(*StreamEvent_Login)(nil),
 		(*StreamEvent_Update)(nil),
@@ -6893,7 +11733,7 @@ func file_bridge_proto_init() {
 		(*StreamEvent_User)(nil),
 		(*StreamEvent_GenericError)(nil),
 	}
-	file_bridge_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_bridge_proto_msgTypes[52].OneofWrappers = []interface{}{
 		(*AppEvent_InternetStatus)(nil),
 		(*AppEvent_ToggleAutostartFinished)(nil),
 		(*AppEvent_ResetFinished)(nil),
@@ -6909,16 +11749,22 @@ func file_bridge_proto_init() {
 		(*AppEvent_RepairStarted)(nil),
 		(*AppEvent_AllUsersLoaded)(nil),
 		(*AppEvent_UserNotification)(nil),
+		(*AppEvent_BridgeLocked)(nil),
+		(*AppEvent_BridgeUnlocked)(nil),
+		(*AppEvent_ApiRateLimited)(nil),
+		(*AppEvent_KeyRotationStarted)(nil),
+		(*AppEvent_KeyRotationFinished)(nil),
 	}
-	file_bridge_proto_msgTypes[30].OneofWrappers = []interface{}{
+	file_bridge_proto_msgTypes[73].OneofWrappers = []interface{}{
 		(*LoginEvent_Error)(nil),
 		(*LoginEvent_TfaRequested)(nil),
 		(*LoginEvent_TwoPasswordRequested)(nil),
 		(*LoginEvent_Finished)(nil),
 		(*LoginEvent_AlreadyLoggedIn)(nil),
 		(*LoginEvent_HvRequested)(nil),
+		(*LoginEvent_Fido2Requested)(nil),
 	}
-	file_bridge_proto_msgTypes[36].OneofWrappers = []interface{}{
+	file_bridge_proto_msgTypes[80].OneofWrappers = []interface{}{
 		(*UpdateEvent_Error)(nil),
 		(*UpdateEvent_ManualReady)(nil),
 		(*UpdateEvent_ManualRestartNeeded)(nil),
@@ -6928,27 +11774,30 @@ func file_bridge_proto_init() {
 		(*UpdateEvent_CheckFinished)(nil),
 		(*UpdateEvent_VersionChanged)(nil),
 	}
-	file_bridge_proto_msgTypes[45].OneofWrappers = []interface{}{
+	file_bridge_proto_msgTypes[89].OneofWrappers = []interface{}{
 		(*DiskCacheEvent_Error)(nil),
 		(*DiskCacheEvent_PathChanged)(nil),
 		(*DiskCacheEvent_PathChangeFinished)(nil),
 	}
-	file_bridge_proto_msgTypes[49].OneofWrappers = []interface{}{
+	file_bridge_proto_msgTypes[93].OneofWrappers = []interface{}{
 		(*MailServerSettingsEvent_Error)(nil),
 		(*MailServerSettingsEvent_MailServerSettingsChanged)(nil),
 		(*MailServerSettingsEvent_ChangeMailServerSettingsFinished)(nil),
 	}
-	file_bridge_proto_msgTypes[53].OneofWrappers = []interface{}{
+	file_bridge_proto_msgTypes[97].OneofWrappers = []interface{}{
 		(*KeychainEvent_ChangeKeychainFinished)(nil),
 		(*KeychainEvent_HasNoKeychain)(nil),
 		(*KeychainEvent_RebuildKeychain)(nil),
+		(*KeychainEvent_CredentialStoreUnavailable)(nil),
+		(*KeychainEvent_CredentialStoreAvailable)(nil),
 	}
-	file_bridge_proto_msgTypes[57].OneofWrappers = []interface{}{
+	file_bridge_proto_msgTypes[103].OneofWrappers = []interface{}{
 		(*MailEvent_AddressChanged)(nil),
 		(*MailEvent_AddressChangedLogout)(nil),
 		(*MailEvent_ApiCertIssue)(nil),
+		(*MailEvent_MessageTooLarge)(nil),
 	}
-	file_bridge_proto_msgTypes[61].OneofWrappers = []interface{}{
+	file_bridge_proto_msgTypes[108].OneofWrappers = []interface{}{
 		(*UserEvent_ToggleSplitModeFinished)(nil),
 		(*UserEvent_UserDisconnected)(nil),
 		(*UserEvent_UserChanged)(nil),
@@ -6958,14 +11807,17 @@ func file_bridge_proto_init() {
 		(*UserEvent_SyncStartedEvent)(nil),
 		(*UserEvent_SyncFinishedEvent)(nil),
 		(*UserEvent_SyncProgressEvent)(nil),
+		(*UserEvent_StorageAlmostFullEvent)(nil),
+		(*UserEvent_ReauthRequiredEvent)(nil),
+		(*UserEvent_ReadReceiptRequestedEvent)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bridge_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   73,
+			NumEnums:      10,
+			NumMessages:   124,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
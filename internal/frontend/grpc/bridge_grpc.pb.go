@@ -38,8 +38,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
+	Bridge_GetServiceInfo_FullMethodName                  = "/grpc.Bridge/GetServiceInfo"
 	Bridge_CheckTokens_FullMethodName                     = "/grpc.Bridge/CheckTokens"
 	Bridge_AddLogEntry_FullMethodName                     = "/grpc.Bridge/AddLogEntry"
+	Bridge_LogLevels_FullMethodName                       = "/grpc.Bridge/LogLevels"
+	Bridge_SetLogLevel_FullMethodName                     = "/grpc.Bridge/SetLogLevel"
+	Bridge_Traces_FullMethodName                          = "/grpc.Bridge/Traces"
+	Bridge_StartTrace_FullMethodName                      = "/grpc.Bridge/StartTrace"
+	Bridge_StopTrace_FullMethodName                       = "/grpc.Bridge/StopTrace"
+	Bridge_SetIsSessionAuditLogEnabled_FullMethodName     = "/grpc.Bridge/SetIsSessionAuditLogEnabled"
+	Bridge_IsSessionAuditLogEnabled_FullMethodName        = "/grpc.Bridge/IsSessionAuditLogEnabled"
+	Bridge_RecentIMAPSessions_FullMethodName              = "/grpc.Bridge/RecentIMAPSessions"
 	Bridge_GuiReady_FullMethodName                        = "/grpc.Bridge/GuiReady"
 	Bridge_Quit_FullMethodName                            = "/grpc.Bridge/Quit"
 	Bridge_Restart_FullMethodName                         = "/grpc.Bridge/Restart"
@@ -50,8 +59,14 @@ const (
 	Bridge_IsBetaEnabled_FullMethodName                   = "/grpc.Bridge/IsBetaEnabled"
 	Bridge_SetIsAllMailVisible_FullMethodName             = "/grpc.Bridge/SetIsAllMailVisible"
 	Bridge_IsAllMailVisible_FullMethodName                = "/grpc.Bridge/IsAllMailVisible"
+	Bridge_SetIsIPCOverTCP_FullMethodName                 = "/grpc.Bridge/SetIsIPCOverTCP"
+	Bridge_IsIPCOverTCP_FullMethodName                    = "/grpc.Bridge/IsIPCOverTCP"
+	Bridge_SetIsReauthOnDeauthEnabled_FullMethodName      = "/grpc.Bridge/SetIsReauthOnDeauthEnabled"
+	Bridge_IsReauthOnDeauthEnabled_FullMethodName         = "/grpc.Bridge/IsReauthOnDeauthEnabled"
 	Bridge_SetIsTelemetryDisabled_FullMethodName          = "/grpc.Bridge/SetIsTelemetryDisabled"
 	Bridge_IsTelemetryDisabled_FullMethodName             = "/grpc.Bridge/IsTelemetryDisabled"
+	Bridge_SetIsReportingDisabled_FullMethodName          = "/grpc.Bridge/SetIsReportingDisabled"
+	Bridge_IsReportingDisabled_FullMethodName             = "/grpc.Bridge/IsReportingDisabled"
 	Bridge_GoOs_FullMethodName                            = "/grpc.Bridge/GoOs"
 	Bridge_TriggerReset_FullMethodName                    = "/grpc.Bridge/TriggerReset"
 	Bridge_Version_FullMethodName                         = "/grpc.Bridge/Version"
@@ -64,40 +79,91 @@ const (
 	Bridge_ColorSchemeName_FullMethodName                 = "/grpc.Bridge/ColorSchemeName"
 	Bridge_CurrentEmailClient_FullMethodName              = "/grpc.Bridge/CurrentEmailClient"
 	Bridge_ReportBug_FullMethodName                       = "/grpc.Bridge/ReportBug"
+	Bridge_CollectDiagnostics_FullMethodName              = "/grpc.Bridge/CollectDiagnostics"
+	Bridge_CheckConfiguration_FullMethodName              = "/grpc.Bridge/CheckConfiguration"
 	Bridge_ForceLauncher_FullMethodName                   = "/grpc.Bridge/ForceLauncher"
 	Bridge_SetMainExecutable_FullMethodName               = "/grpc.Bridge/SetMainExecutable"
 	Bridge_RequestKnowledgeBaseSuggestions_FullMethodName = "/grpc.Bridge/RequestKnowledgeBaseSuggestions"
 	Bridge_Login_FullMethodName                           = "/grpc.Bridge/Login"
 	Bridge_Login2FA_FullMethodName                        = "/grpc.Bridge/Login2FA"
+	Bridge_LoginFido2_FullMethodName                      = "/grpc.Bridge/LoginFido2"
+	Bridge_LoginFido2Local_FullMethodName                 = "/grpc.Bridge/LoginFido2Local"
 	Bridge_Login2Passwords_FullMethodName                 = "/grpc.Bridge/Login2Passwords"
 	Bridge_LoginAbort_FullMethodName                      = "/grpc.Bridge/LoginAbort"
 	Bridge_CheckUpdate_FullMethodName                     = "/grpc.Bridge/CheckUpdate"
 	Bridge_InstallUpdate_FullMethodName                   = "/grpc.Bridge/InstallUpdate"
+	Bridge_RollbackUpdate_FullMethodName                  = "/grpc.Bridge/RollbackUpdate"
 	Bridge_SetIsAutomaticUpdateOn_FullMethodName          = "/grpc.Bridge/SetIsAutomaticUpdateOn"
 	Bridge_IsAutomaticUpdateOn_FullMethodName             = "/grpc.Bridge/IsAutomaticUpdateOn"
 	Bridge_DiskCachePath_FullMethodName                   = "/grpc.Bridge/DiskCachePath"
 	Bridge_SetDiskCachePath_FullMethodName                = "/grpc.Bridge/SetDiskCachePath"
+	Bridge_DiskCacheMaxSize_FullMethodName                = "/grpc.Bridge/DiskCacheMaxSize"
+	Bridge_SetDiskCacheMaxSize_FullMethodName             = "/grpc.Bridge/SetDiskCacheMaxSize"
+	Bridge_DiskCacheSize_FullMethodName                   = "/grpc.Bridge/DiskCacheSize"
+	Bridge_DiskCacheKeepRecentDays_FullMethodName         = "/grpc.Bridge/DiskCacheKeepRecentDays"
+	Bridge_SetDiskCacheKeepRecentDays_FullMethodName      = "/grpc.Bridge/SetDiskCacheKeepRecentDays"
+	Bridge_MaxSyncMemory_FullMethodName                   = "/grpc.Bridge/MaxSyncMemory"
+	Bridge_SetMaxSyncMemory_FullMethodName                = "/grpc.Bridge/SetMaxSyncMemory"
+	Bridge_MemoryUsage_FullMethodName                     = "/grpc.Bridge/MemoryUsage"
 	Bridge_SetIsDoHEnabled_FullMethodName                 = "/grpc.Bridge/SetIsDoHEnabled"
 	Bridge_IsDoHEnabled_FullMethodName                    = "/grpc.Bridge/IsDoHEnabled"
 	Bridge_MailServerSettings_FullMethodName              = "/grpc.Bridge/MailServerSettings"
 	Bridge_SetMailServerSettings_FullMethodName           = "/grpc.Bridge/SetMailServerSettings"
+	Bridge_ImplicitTLSPorts_FullMethodName                = "/grpc.Bridge/ImplicitTLSPorts"
+	Bridge_SetImplicitTLSPorts_FullMethodName             = "/grpc.Bridge/SetImplicitTLSPorts"
+	Bridge_SSLRequired_FullMethodName                     = "/grpc.Bridge/SSLRequired"
+	Bridge_SetSSLRequired_FullMethodName                  = "/grpc.Bridge/SetSSLRequired"
 	Bridge_Hostname_FullMethodName                        = "/grpc.Bridge/Hostname"
+	Bridge_BindAddress_FullMethodName                     = "/grpc.Bridge/BindAddress"
+	Bridge_SetBindAddress_FullMethodName                  = "/grpc.Bridge/SetBindAddress"
 	Bridge_IsPortFree_FullMethodName                      = "/grpc.Bridge/IsPortFree"
 	Bridge_AvailableKeychains_FullMethodName              = "/grpc.Bridge/AvailableKeychains"
 	Bridge_SetCurrentKeychain_FullMethodName              = "/grpc.Bridge/SetCurrentKeychain"
 	Bridge_CurrentKeychain_FullMethodName                 = "/grpc.Bridge/CurrentKeychain"
+	Bridge_IsCredentialStoreUnavailable_FullMethodName    = "/grpc.Bridge/IsCredentialStoreUnavailable"
+	Bridge_MigrateToFileVault_FullMethodName              = "/grpc.Bridge/MigrateToFileVault"
+	Bridge_AutoLockTimeout_FullMethodName                 = "/grpc.Bridge/AutoLockTimeout"
+	Bridge_SetAutoLockTimeout_FullMethodName              = "/grpc.Bridge/SetAutoLockTimeout"
+	Bridge_HasUnlockPassphrase_FullMethodName             = "/grpc.Bridge/HasUnlockPassphrase"
+	Bridge_SetUnlockPassphrase_FullMethodName             = "/grpc.Bridge/SetUnlockPassphrase"
+	Bridge_IsLocked_FullMethodName                        = "/grpc.Bridge/IsLocked"
+	Bridge_Lock_FullMethodName                            = "/grpc.Bridge/Lock"
+	Bridge_Unlock_FullMethodName                          = "/grpc.Bridge/Unlock"
+	Bridge_RotateEncryptionKeys_FullMethodName            = "/grpc.Bridge/RotateEncryptionKeys"
 	Bridge_GetUserList_FullMethodName                     = "/grpc.Bridge/GetUserList"
 	Bridge_GetUser_FullMethodName                         = "/grpc.Bridge/GetUser"
 	Bridge_SetUserSplitMode_FullMethodName                = "/grpc.Bridge/SetUserSplitMode"
+	Bridge_SetUserHideAllMail_FullMethodName              = "/grpc.Bridge/SetUserHideAllMail"
+	Bridge_SetUserRepairMime_FullMethodName               = "/grpc.Bridge/SetUserRepairMime"
+	Bridge_SetUserReadReceiptMode_FullMethodName          = "/grpc.Bridge/SetUserReadReceiptMode"
+	Bridge_SendUserReadReceipt_FullMethodName             = "/grpc.Bridge/SendUserReadReceipt"
+	Bridge_SetUserSyncCutoff_FullMethodName               = "/grpc.Bridge/SetUserSyncCutoff"
 	Bridge_SendBadEventUserFeedback_FullMethodName        = "/grpc.Bridge/SendBadEventUserFeedback"
 	Bridge_LogoutUser_FullMethodName                      = "/grpc.Bridge/LogoutUser"
+	Bridge_PrioritizeUserSync_FullMethodName              = "/grpc.Bridge/PrioritizeUserSync"
 	Bridge_RemoveUser_FullMethodName                      = "/grpc.Bridge/RemoveUser"
+	Bridge_RemoveUserKeepData_FullMethodName              = "/grpc.Bridge/RemoveUserKeepData"
 	Bridge_ConfigureUserAppleMail_FullMethodName          = "/grpc.Bridge/ConfigureUserAppleMail"
+	Bridge_UserClientConfig_FullMethodName                = "/grpc.Bridge/UserClientConfig"
+	Bridge_StartAutoconfigServer_FullMethodName           = "/grpc.Bridge/StartAutoconfigServer"
+	Bridge_UserAppPasswords_FullMethodName                = "/grpc.Bridge/UserAppPasswords"
+	Bridge_CreateUserAppPassword_FullMethodName           = "/grpc.Bridge/CreateUserAppPassword"
+	Bridge_RevokeUserAppPassword_FullMethodName           = "/grpc.Bridge/RevokeUserAppPassword"
+	Bridge_CreateUserAuthToken_FullMethodName             = "/grpc.Bridge/CreateUserAuthToken"
+	Bridge_UserEventJournal_FullMethodName                = "/grpc.Bridge/UserEventJournal"
 	Bridge_IsTLSCertificateInstalled_FullMethodName       = "/grpc.Bridge/IsTLSCertificateInstalled"
 	Bridge_InstallTLSCertificate_FullMethodName           = "/grpc.Bridge/InstallTLSCertificate"
 	Bridge_ExportTLSCertificates_FullMethodName           = "/grpc.Bridge/ExportTLSCertificates"
+	Bridge_TLSCertificatePaths_FullMethodName             = "/grpc.Bridge/TLSCertificatePaths"
+	Bridge_SetTLSCertificatePaths_FullMethodName          = "/grpc.Bridge/SetTLSCertificatePaths"
+	Bridge_SetTLSCertificateDir_FullMethodName            = "/grpc.Bridge/SetTLSCertificateDir"
+	Bridge_ResetTLSCertificate_FullMethodName             = "/grpc.Bridge/ResetTLSCertificate"
+	Bridge_Webhooks_FullMethodName                        = "/grpc.Bridge/Webhooks"
+	Bridge_AddWebhook_FullMethodName                      = "/grpc.Bridge/AddWebhook"
+	Bridge_RemoveWebhook_FullMethodName                   = "/grpc.Bridge/RemoveWebhook"
 	Bridge_RunEventStream_FullMethodName                  = "/grpc.Bridge/RunEventStream"
 	Bridge_StopEventStream_FullMethodName                 = "/grpc.Bridge/StopEventStream"
+	Bridge_SubscribeBridgeEvents_FullMethodName           = "/grpc.Bridge/SubscribeBridgeEvents"
 	Bridge_TriggerRepair_FullMethodName                   = "/grpc.Bridge/TriggerRepair"
 )
 
@@ -106,8 +172,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BridgeClient interface {
 	// App related calls
+	GetServiceInfo(ctx context.Context, in *ServiceInfoRequest, opts ...grpc.CallOption) (*ServiceInfo, error)
 	CheckTokens(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	AddLogEntry(ctx context.Context, in *AddLogEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LogLevels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LogLevelsResponse, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Traces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TracesResponse, error)
+	StartTrace(ctx context.Context, in *StartTraceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StopTrace(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetIsSessionAuditLogEnabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IsSessionAuditLogEnabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	RecentIMAPSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*IMAPSessionsResponse, error)
 	GuiReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GuiReadyResponse, error)
 	Quit(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Restart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -118,8 +193,14 @@ type BridgeClient interface {
 	IsBetaEnabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	SetIsAllMailVisible(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	IsAllMailVisible(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	SetIsIPCOverTCP(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IsIPCOverTCP(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	SetIsReauthOnDeauthEnabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IsReauthOnDeauthEnabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	SetIsTelemetryDisabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	IsTelemetryDisabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	SetIsReportingDisabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IsReportingDisabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	GoOs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	TriggerReset(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
@@ -132,48 +213,103 @@ type BridgeClient interface {
 	ColorSchemeName(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	CurrentEmailClient(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	ReportBug(ctx context.Context, in *ReportBugRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CollectDiagnostics(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	CheckConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConfigurationReport, error)
 	ForceLauncher(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetMainExecutable(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RequestKnowledgeBaseSuggestions(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// login
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Login2FA(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LoginFido2(ctx context.Context, in *LoginFido2Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LoginFido2Local(ctx context.Context, in *LoginFido2LocalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Login2Passwords(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	LoginAbort(ctx context.Context, in *LoginAbortRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// update
 	CheckUpdate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	InstallUpdate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RollbackUpdate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetIsAutomaticUpdateOn(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	IsAutomaticUpdateOn(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	// cache
 	DiskCachePath(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	SetDiskCachePath(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DiskCacheMaxSize(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.UInt64Value, error)
+	SetDiskCacheMaxSize(ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DiskCacheSize(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.UInt64Value, error)
+	DiskCacheKeepRecentDays(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.UInt32Value, error)
+	SetDiskCacheKeepRecentDays(ctx context.Context, in *wrapperspb.UInt32Value, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// memory
+	MaxSyncMemory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.UInt64Value, error)
+	SetMaxSyncMemory(ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MemoryUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MemoryUsageResponse, error)
 	// mail
 	SetIsDoHEnabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	IsDoHEnabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	MailServerSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImapSmtpSettings, error)
 	SetMailServerSettings(ctx context.Context, in *ImapSmtpSettings, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ImplicitTLSPorts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImplicitTLSPortSettings, error)
+	SetImplicitTLSPorts(ctx context.Context, in *ImplicitTLSPortSettings, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SSLRequired(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SSLRequiredSettings, error)
+	SetSSLRequired(ctx context.Context, in *SSLRequiredSettings, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Hostname(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	BindAddress(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	SetBindAddress(ctx context.Context, in *BindAddressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	IsPortFree(ctx context.Context, in *wrapperspb.Int32Value, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	// keychain
 	AvailableKeychains(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AvailableKeychainsResponse, error)
 	SetCurrentKeychain(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CurrentKeychain(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	IsCredentialStoreUnavailable(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	MigrateToFileVault(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// lock
+	AutoLockTimeout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.Int32Value, error)
+	SetAutoLockTimeout(ctx context.Context, in *wrapperspb.Int32Value, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	HasUnlockPassphrase(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	SetUnlockPassphrase(ctx context.Context, in *wrapperspb.BytesValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IsLocked(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	Lock(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Unlock(ctx context.Context, in *wrapperspb.BytesValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RotateEncryptionKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// User & user list
 	GetUserList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserListResponse, error)
 	GetUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*User, error)
 	SetUserSplitMode(ctx context.Context, in *UserSplitModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserHideAllMail(ctx context.Context, in *UserHideAllMailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserRepairMime(ctx context.Context, in *UserRepairMimeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserReadReceiptMode(ctx context.Context, in *UserReadReceiptModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SendUserReadReceipt(ctx context.Context, in *UserReadReceiptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserSyncCutoff(ctx context.Context, in *UserSyncCutoffRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SendBadEventUserFeedback(ctx context.Context, in *UserBadEventFeedbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	LogoutUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PrioritizeUserSync(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveUserKeepData(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ConfigureUserAppleMail(ctx context.Context, in *ConfigureAppleMailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UserClientConfig(ctx context.Context, in *ClientConfigRequest, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	StartAutoconfigServer(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	UserAppPasswords(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*AppPasswordsResponse, error)
+	CreateUserAppPassword(ctx context.Context, in *AppPasswordRequest, opts ...grpc.CallOption) (*wrapperspb.BytesValue, error)
+	RevokeUserAppPassword(ctx context.Context, in *AppPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateUserAuthToken(ctx context.Context, in *AuthTokenRequest, opts ...grpc.CallOption) (*wrapperspb.BytesValue, error)
+	UserEventJournal(ctx context.Context, in *UserEventJournalRequest, opts ...grpc.CallOption) (*UserEventJournalResponse, error)
 	// TLS certificate related calls
 	IsTLSCertificateInstalled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	InstallTLSCertificate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ExportTLSCertificates(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TLSCertificatePaths(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TLSCertificateFiles, error)
+	SetTLSCertificatePaths(ctx context.Context, in *TLSCertificateFiles, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetTLSCertificateDir(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResetTLSCertificate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Webhooks
+	Webhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WebhooksResponse, error)
+	AddWebhook(ctx context.Context, in *AddWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveWebhook(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Server -> Client event stream
 	RunEventStream(ctx context.Context, in *EventStreamRequest, opts ...grpc.CallOption) (Bridge_RunEventStreamClient, error)
 	StopEventStream(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Bridge events bus, for third-party integrations. Unlike RunEventStream, any number of clients can subscribe at once.
+	SubscribeBridgeEvents(ctx context.Context, in *BridgeEventFilter, opts ...grpc.CallOption) (Bridge_SubscribeBridgeEventsClient, error)
 	// Repair
 	TriggerRepair(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -186,6 +322,15 @@ func NewBridgeClient(cc grpc.ClientConnInterface) BridgeClient {
 	return &bridgeClient{cc}
 }
 
+func (c *bridgeClient) GetServiceInfo(ctx context.Context, in *ServiceInfoRequest, opts ...grpc.CallOption) (*ServiceInfo, error) {
+	out := new(ServiceInfo)
+	err := c.cc.Invoke(ctx, Bridge_GetServiceInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) CheckTokens(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
 	out := new(wrapperspb.StringValue)
 	err := c.cc.Invoke(ctx, Bridge_CheckTokens_FullMethodName, in, out, opts...)
@@ -204,6 +349,78 @@ func (c *bridgeClient) AddLogEntry(ctx context.Context, in *AddLogEntryRequest,
 	return out, nil
 }
 
+func (c *bridgeClient) LogLevels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LogLevelsResponse, error) {
+	out := new(LogLevelsResponse)
+	err := c.cc.Invoke(ctx, Bridge_LogLevels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) Traces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TracesResponse, error) {
+	out := new(TracesResponse)
+	err := c.cc.Invoke(ctx, Bridge_Traces_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) StartTrace(ctx context.Context, in *StartTraceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_StartTrace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) StopTrace(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_StopTrace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetIsSessionAuditLogEnabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetIsSessionAuditLogEnabled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) IsSessionAuditLogEnabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_IsSessionAuditLogEnabled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) RecentIMAPSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*IMAPSessionsResponse, error) {
+	out := new(IMAPSessionsResponse)
+	err := c.cc.Invoke(ctx, Bridge_RecentIMAPSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) GuiReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GuiReadyResponse, error) {
 	out := new(GuiReadyResponse)
 	err := c.cc.Invoke(ctx, Bridge_GuiReady_FullMethodName, in, out, opts...)
@@ -294,6 +511,42 @@ func (c *bridgeClient) IsAllMailVisible(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
+func (c *bridgeClient) SetIsIPCOverTCP(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetIsIPCOverTCP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) IsIPCOverTCP(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_IsIPCOverTCP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetIsReauthOnDeauthEnabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetIsReauthOnDeauthEnabled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) IsReauthOnDeauthEnabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_IsReauthOnDeauthEnabled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) SetIsTelemetryDisabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Bridge_SetIsTelemetryDisabled_FullMethodName, in, out, opts...)
@@ -312,6 +565,24 @@ func (c *bridgeClient) IsTelemetryDisabled(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
+func (c *bridgeClient) SetIsReportingDisabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetIsReportingDisabled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) IsReportingDisabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_IsReportingDisabled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) GoOs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
 	out := new(wrapperspb.StringValue)
 	err := c.cc.Invoke(ctx, Bridge_GoOs_FullMethodName, in, out, opts...)
@@ -420,6 +691,24 @@ func (c *bridgeClient) ReportBug(ctx context.Context, in *ReportBugRequest, opts
 	return out, nil
 }
 
+func (c *bridgeClient) CollectDiagnostics(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, Bridge_CollectDiagnostics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) CheckConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConfigurationReport, error) {
+	out := new(ConfigurationReport)
+	err := c.cc.Invoke(ctx, Bridge_CheckConfiguration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) ForceLauncher(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Bridge_ForceLauncher_FullMethodName, in, out, opts...)
@@ -465,6 +754,24 @@ func (c *bridgeClient) Login2FA(ctx context.Context, in *LoginRequest, opts ...g
 	return out, nil
 }
 
+func (c *bridgeClient) LoginFido2(ctx context.Context, in *LoginFido2Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_LoginFido2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) LoginFido2Local(ctx context.Context, in *LoginFido2LocalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_LoginFido2Local_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) Login2Passwords(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Bridge_Login2Passwords_FullMethodName, in, out, opts...)
@@ -501,6 +808,15 @@ func (c *bridgeClient) InstallUpdate(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *bridgeClient) RollbackUpdate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_RollbackUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) SetIsAutomaticUpdateOn(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Bridge_SetIsAutomaticUpdateOn_FullMethodName, in, out, opts...)
@@ -537,1545 +853,3420 @@ func (c *bridgeClient) SetDiskCachePath(ctx context.Context, in *wrapperspb.Stri
 	return out, nil
 }
 
-func (c *bridgeClient) SetIsDoHEnabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_SetIsDoHEnabled_FullMethodName, in, out, opts...)
+func (c *bridgeClient) DiskCacheMaxSize(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.UInt64Value, error) {
+	out := new(wrapperspb.UInt64Value)
+	err := c.cc.Invoke(ctx, Bridge_DiskCacheMaxSize_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) IsDoHEnabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
-	out := new(wrapperspb.BoolValue)
-	err := c.cc.Invoke(ctx, Bridge_IsDoHEnabled_FullMethodName, in, out, opts...)
+func (c *bridgeClient) SetDiskCacheMaxSize(ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetDiskCacheMaxSize_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) MailServerSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImapSmtpSettings, error) {
-	out := new(ImapSmtpSettings)
-	err := c.cc.Invoke(ctx, Bridge_MailServerSettings_FullMethodName, in, out, opts...)
+func (c *bridgeClient) DiskCacheSize(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.UInt64Value, error) {
+	out := new(wrapperspb.UInt64Value)
+	err := c.cc.Invoke(ctx, Bridge_DiskCacheSize_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) SetMailServerSettings(ctx context.Context, in *ImapSmtpSettings, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_SetMailServerSettings_FullMethodName, in, out, opts...)
+func (c *bridgeClient) DiskCacheKeepRecentDays(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.UInt32Value, error) {
+	out := new(wrapperspb.UInt32Value)
+	err := c.cc.Invoke(ctx, Bridge_DiskCacheKeepRecentDays_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) Hostname(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
-	out := new(wrapperspb.StringValue)
-	err := c.cc.Invoke(ctx, Bridge_Hostname_FullMethodName, in, out, opts...)
+func (c *bridgeClient) SetDiskCacheKeepRecentDays(ctx context.Context, in *wrapperspb.UInt32Value, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetDiskCacheKeepRecentDays_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) IsPortFree(ctx context.Context, in *wrapperspb.Int32Value, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
-	out := new(wrapperspb.BoolValue)
-	err := c.cc.Invoke(ctx, Bridge_IsPortFree_FullMethodName, in, out, opts...)
+func (c *bridgeClient) MaxSyncMemory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.UInt64Value, error) {
+	out := new(wrapperspb.UInt64Value)
+	err := c.cc.Invoke(ctx, Bridge_MaxSyncMemory_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) AvailableKeychains(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AvailableKeychainsResponse, error) {
-	out := new(AvailableKeychainsResponse)
-	err := c.cc.Invoke(ctx, Bridge_AvailableKeychains_FullMethodName, in, out, opts...)
+func (c *bridgeClient) SetMaxSyncMemory(ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetMaxSyncMemory_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) SetCurrentKeychain(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_SetCurrentKeychain_FullMethodName, in, out, opts...)
+func (c *bridgeClient) MemoryUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MemoryUsageResponse, error) {
+	out := new(MemoryUsageResponse)
+	err := c.cc.Invoke(ctx, Bridge_MemoryUsage_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) CurrentKeychain(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
-	out := new(wrapperspb.StringValue)
-	err := c.cc.Invoke(ctx, Bridge_CurrentKeychain_FullMethodName, in, out, opts...)
+func (c *bridgeClient) SetIsDoHEnabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetIsDoHEnabled_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) GetUserList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserListResponse, error) {
-	out := new(UserListResponse)
-	err := c.cc.Invoke(ctx, Bridge_GetUserList_FullMethodName, in, out, opts...)
+func (c *bridgeClient) IsDoHEnabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_IsDoHEnabled_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) GetUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, Bridge_GetUser_FullMethodName, in, out, opts...)
+func (c *bridgeClient) MailServerSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImapSmtpSettings, error) {
+	out := new(ImapSmtpSettings)
+	err := c.cc.Invoke(ctx, Bridge_MailServerSettings_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) SetUserSplitMode(ctx context.Context, in *UserSplitModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bridgeClient) SetMailServerSettings(ctx context.Context, in *ImapSmtpSettings, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_SetUserSplitMode_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Bridge_SetMailServerSettings_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) SendBadEventUserFeedback(ctx context.Context, in *UserBadEventFeedbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_SendBadEventUserFeedback_FullMethodName, in, out, opts...)
+func (c *bridgeClient) ImplicitTLSPorts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImplicitTLSPortSettings, error) {
+	out := new(ImplicitTLSPortSettings)
+	err := c.cc.Invoke(ctx, Bridge_ImplicitTLSPorts_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) LogoutUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bridgeClient) SetImplicitTLSPorts(ctx context.Context, in *ImplicitTLSPortSettings, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_LogoutUser_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Bridge_SetImplicitTLSPorts_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) RemoveUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_RemoveUser_FullMethodName, in, out, opts...)
+func (c *bridgeClient) SSLRequired(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SSLRequiredSettings, error) {
+	out := new(SSLRequiredSettings)
+	err := c.cc.Invoke(ctx, Bridge_SSLRequired_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) ConfigureUserAppleMail(ctx context.Context, in *ConfigureAppleMailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bridgeClient) SetSSLRequired(ctx context.Context, in *SSLRequiredSettings, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_ConfigureUserAppleMail_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Bridge_SetSSLRequired_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) IsTLSCertificateInstalled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
-	out := new(wrapperspb.BoolValue)
-	err := c.cc.Invoke(ctx, Bridge_IsTLSCertificateInstalled_FullMethodName, in, out, opts...)
+func (c *bridgeClient) Hostname(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, Bridge_Hostname_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) InstallTLSCertificate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_InstallTLSCertificate_FullMethodName, in, out, opts...)
+func (c *bridgeClient) BindAddress(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, Bridge_BindAddress_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) ExportTLSCertificates(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bridgeClient) SetBindAddress(ctx context.Context, in *BindAddressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_ExportTLSCertificates_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Bridge_SetBindAddress_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) RunEventStream(ctx context.Context, in *EventStreamRequest, opts ...grpc.CallOption) (Bridge_RunEventStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Bridge_ServiceDesc.Streams[0], Bridge_RunEventStream_FullMethodName, opts...)
+func (c *bridgeClient) IsPortFree(ctx context.Context, in *wrapperspb.Int32Value, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_IsPortFree_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &bridgeRunEventStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *bridgeClient) AvailableKeychains(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AvailableKeychainsResponse, error) {
+	out := new(AvailableKeychainsResponse)
+	err := c.cc.Invoke(ctx, Bridge_AvailableKeychains_FullMethodName, in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type Bridge_RunEventStreamClient interface {
-	Recv() (*StreamEvent, error)
-	grpc.ClientStream
+func (c *bridgeClient) SetCurrentKeychain(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetCurrentKeychain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type bridgeRunEventStreamClient struct {
-	grpc.ClientStream
+func (c *bridgeClient) CurrentKeychain(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, Bridge_CurrentKeychain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *bridgeRunEventStreamClient) Recv() (*StreamEvent, error) {
-	m := new(StreamEvent)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *bridgeClient) IsCredentialStoreUnavailable(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_IsCredentialStoreUnavailable_FullMethodName, in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *bridgeClient) StopEventStream(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bridgeClient) MigrateToFileVault(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_StopEventStream_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Bridge_MigrateToFileVault_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bridgeClient) TriggerRepair(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Bridge_TriggerRepair_FullMethodName, in, out, opts...)
+func (c *bridgeClient) AutoLockTimeout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.Int32Value, error) {
+	out := new(wrapperspb.Int32Value)
+	err := c.cc.Invoke(ctx, Bridge_AutoLockTimeout_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// BridgeServer is the server API for Bridge service.
-// All implementations must embed UnimplementedBridgeServer
-// for forward compatibility
-type BridgeServer interface {
-	// App related calls
-	CheckTokens(context.Context, *wrapperspb.StringValue) (*wrapperspb.StringValue, error)
-	AddLogEntry(context.Context, *AddLogEntryRequest) (*emptypb.Empty, error)
-	GuiReady(context.Context, *emptypb.Empty) (*GuiReadyResponse, error)
-	Quit(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	Restart(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	ShowOnStartup(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
-	SetIsAutostartOn(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
-	IsAutostartOn(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
-	SetIsBetaEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
-	IsBetaEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
-	SetIsAllMailVisible(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
-	IsAllMailVisible(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
-	SetIsTelemetryDisabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
-	IsTelemetryDisabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
-	GoOs(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	TriggerReset(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	Version(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	LogsPath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	LicensePath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	ReleaseNotesPageLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	DependencyLicensesLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	LandingPageLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	SetColorSchemeName(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
-	ColorSchemeName(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	CurrentEmailClient(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	ReportBug(context.Context, *ReportBugRequest) (*emptypb.Empty, error)
-	ForceLauncher(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
-	SetMainExecutable(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
-	RequestKnowledgeBaseSuggestions(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
-	// login
-	Login(context.Context, *LoginRequest) (*emptypb.Empty, error)
-	Login2FA(context.Context, *LoginRequest) (*emptypb.Empty, error)
-	Login2Passwords(context.Context, *LoginRequest) (*emptypb.Empty, error)
-	LoginAbort(context.Context, *LoginAbortRequest) (*emptypb.Empty, error)
-	// update
-	CheckUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	InstallUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	SetIsAutomaticUpdateOn(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
-	IsAutomaticUpdateOn(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
-	// cache
-	DiskCachePath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	SetDiskCachePath(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
-	// mail
-	SetIsDoHEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
-	IsDoHEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
-	MailServerSettings(context.Context, *emptypb.Empty) (*ImapSmtpSettings, error)
-	SetMailServerSettings(context.Context, *ImapSmtpSettings) (*emptypb.Empty, error)
-	Hostname(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	IsPortFree(context.Context, *wrapperspb.Int32Value) (*wrapperspb.BoolValue, error)
-	// keychain
-	AvailableKeychains(context.Context, *emptypb.Empty) (*AvailableKeychainsResponse, error)
-	SetCurrentKeychain(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
-	CurrentKeychain(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	// User & user list
-	GetUserList(context.Context, *emptypb.Empty) (*UserListResponse, error)
-	GetUser(context.Context, *wrapperspb.StringValue) (*User, error)
-	SetUserSplitMode(context.Context, *UserSplitModeRequest) (*emptypb.Empty, error)
-	SendBadEventUserFeedback(context.Context, *UserBadEventFeedbackRequest) (*emptypb.Empty, error)
-	LogoutUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
-	RemoveUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
-	ConfigureUserAppleMail(context.Context, *ConfigureAppleMailRequest) (*emptypb.Empty, error)
-	// TLS certificate related calls
-	IsTLSCertificateInstalled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
-	InstallTLSCertificate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	ExportTLSCertificates(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
-	// Server -> Client event stream
-	RunEventStream(*EventStreamRequest, Bridge_RunEventStreamServer) error
-	StopEventStream(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	// Repair
-	TriggerRepair(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	mustEmbedUnimplementedBridgeServer()
+func (c *bridgeClient) SetAutoLockTimeout(ctx context.Context, in *wrapperspb.Int32Value, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetAutoLockTimeout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedBridgeServer must be embedded to have forward compatible implementations.
-type UnimplementedBridgeServer struct {
+func (c *bridgeClient) HasUnlockPassphrase(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_HasUnlockPassphrase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedBridgeServer) CheckTokens(context.Context, *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckTokens not implemented")
-}
-func (UnimplementedBridgeServer) AddLogEntry(context.Context, *AddLogEntryRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLogEntry not implemented")
+func (c *bridgeClient) SetUnlockPassphrase(ctx context.Context, in *wrapperspb.BytesValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetUnlockPassphrase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) GuiReady(context.Context, *emptypb.Empty) (*GuiReadyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GuiReady not implemented")
+
+func (c *bridgeClient) IsLocked(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_IsLocked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) Quit(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Quit not implemented")
+
+func (c *bridgeClient) Lock(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_Lock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) Restart(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Restart not implemented")
+
+func (c *bridgeClient) Unlock(ctx context.Context, in *wrapperspb.BytesValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_Unlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) ShowOnStartup(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowOnStartup not implemented")
+
+func (c *bridgeClient) RotateEncryptionKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_RotateEncryptionKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) SetIsAutostartOn(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetIsAutostartOn not implemented")
+
+func (c *bridgeClient) GetUserList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserListResponse, error) {
+	out := new(UserListResponse)
+	err := c.cc.Invoke(ctx, Bridge_GetUserList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) IsAutostartOn(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsAutostartOn not implemented")
+
+func (c *bridgeClient) GetUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, Bridge_GetUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) SetIsBetaEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetIsBetaEnabled not implemented")
+
+func (c *bridgeClient) SetUserSplitMode(ctx context.Context, in *UserSplitModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetUserSplitMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) IsBetaEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsBetaEnabled not implemented")
+
+func (c *bridgeClient) SetUserHideAllMail(ctx context.Context, in *UserHideAllMailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetUserHideAllMail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) SetIsAllMailVisible(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetIsAllMailVisible not implemented")
+
+func (c *bridgeClient) SetUserRepairMime(ctx context.Context, in *UserRepairMimeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetUserRepairMime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) IsAllMailVisible(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsAllMailVisible not implemented")
+
+func (c *bridgeClient) SetUserReadReceiptMode(ctx context.Context, in *UserReadReceiptModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetUserReadReceiptMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) SetIsTelemetryDisabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetIsTelemetryDisabled not implemented")
+
+func (c *bridgeClient) SendUserReadReceipt(ctx context.Context, in *UserReadReceiptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SendUserReadReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) IsTelemetryDisabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsTelemetryDisabled not implemented")
+
+func (c *bridgeClient) SetUserSyncCutoff(ctx context.Context, in *UserSyncCutoffRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetUserSyncCutoff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) GoOs(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GoOs not implemented")
+
+func (c *bridgeClient) SendBadEventUserFeedback(ctx context.Context, in *UserBadEventFeedbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SendBadEventUserFeedback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) TriggerReset(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TriggerReset not implemented")
+
+func (c *bridgeClient) LogoutUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_LogoutUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) Version(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+
+func (c *bridgeClient) PrioritizeUserSync(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_PrioritizeUserSync_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) LogsPath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LogsPath not implemented")
+
+func (c *bridgeClient) RemoveUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_RemoveUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) LicensePath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LicensePath not implemented")
+
+func (c *bridgeClient) RemoveUserKeepData(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_RemoveUserKeepData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) ReleaseNotesPageLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNotesPageLink not implemented")
+
+func (c *bridgeClient) ConfigureUserAppleMail(ctx context.Context, in *ConfigureAppleMailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_ConfigureUserAppleMail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) DependencyLicensesLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DependencyLicensesLink not implemented")
-}
-func (UnimplementedBridgeServer) LandingPageLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LandingPageLink not implemented")
+
+func (c *bridgeClient) UserClientConfig(ctx context.Context, in *ClientConfigRequest, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, Bridge_UserClientConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) SetColorSchemeName(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetColorSchemeName not implemented")
+
+func (c *bridgeClient) StartAutoconfigServer(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, Bridge_StartAutoconfigServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) ColorSchemeName(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ColorSchemeName not implemented")
+
+func (c *bridgeClient) UserAppPasswords(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*AppPasswordsResponse, error) {
+	out := new(AppPasswordsResponse)
+	err := c.cc.Invoke(ctx, Bridge_UserAppPasswords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) CurrentEmailClient(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentEmailClient not implemented")
+
+func (c *bridgeClient) CreateUserAppPassword(ctx context.Context, in *AppPasswordRequest, opts ...grpc.CallOption) (*wrapperspb.BytesValue, error) {
+	out := new(wrapperspb.BytesValue)
+	err := c.cc.Invoke(ctx, Bridge_CreateUserAppPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) ReportBug(context.Context, *ReportBugRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportBug not implemented")
+
+func (c *bridgeClient) RevokeUserAppPassword(ctx context.Context, in *AppPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_RevokeUserAppPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) ForceLauncher(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ForceLauncher not implemented")
+
+func (c *bridgeClient) CreateUserAuthToken(ctx context.Context, in *AuthTokenRequest, opts ...grpc.CallOption) (*wrapperspb.BytesValue, error) {
+	out := new(wrapperspb.BytesValue)
+	err := c.cc.Invoke(ctx, Bridge_CreateUserAuthToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) SetMainExecutable(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetMainExecutable not implemented")
+
+func (c *bridgeClient) UserEventJournal(ctx context.Context, in *UserEventJournalRequest, opts ...grpc.CallOption) (*UserEventJournalResponse, error) {
+	out := new(UserEventJournalResponse)
+	err := c.cc.Invoke(ctx, Bridge_UserEventJournal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) RequestKnowledgeBaseSuggestions(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestKnowledgeBaseSuggestions not implemented")
+
+func (c *bridgeClient) IsTLSCertificateInstalled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_IsTLSCertificateInstalled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) Login(context.Context, *LoginRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func (c *bridgeClient) InstallTLSCertificate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_InstallTLSCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) Login2FA(context.Context, *LoginRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login2FA not implemented")
+
+func (c *bridgeClient) ExportTLSCertificates(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_ExportTLSCertificates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) Login2Passwords(context.Context, *LoginRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login2Passwords not implemented")
+
+func (c *bridgeClient) TLSCertificatePaths(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TLSCertificateFiles, error) {
+	out := new(TLSCertificateFiles)
+	err := c.cc.Invoke(ctx, Bridge_TLSCertificatePaths_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) LoginAbort(context.Context, *LoginAbortRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginAbort not implemented")
+
+func (c *bridgeClient) SetTLSCertificatePaths(ctx context.Context, in *TLSCertificateFiles, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetTLSCertificatePaths_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) CheckUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckUpdate not implemented")
+
+func (c *bridgeClient) SetTLSCertificateDir(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetTLSCertificateDir_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) InstallUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstallUpdate not implemented")
+
+func (c *bridgeClient) ResetTLSCertificate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_ResetTLSCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) SetIsAutomaticUpdateOn(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetIsAutomaticUpdateOn not implemented")
+
+func (c *bridgeClient) Webhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WebhooksResponse, error) {
+	out := new(WebhooksResponse)
+	err := c.cc.Invoke(ctx, Bridge_Webhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) IsAutomaticUpdateOn(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsAutomaticUpdateOn not implemented")
+
+func (c *bridgeClient) AddWebhook(ctx context.Context, in *AddWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_AddWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) DiskCachePath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DiskCachePath not implemented")
+
+func (c *bridgeClient) RemoveWebhook(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_RemoveWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) SetDiskCachePath(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDiskCachePath not implemented")
+
+func (c *bridgeClient) RunEventStream(ctx context.Context, in *EventStreamRequest, opts ...grpc.CallOption) (Bridge_RunEventStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bridge_ServiceDesc.Streams[0], Bridge_RunEventStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bridgeRunEventStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedBridgeServer) SetIsDoHEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetIsDoHEnabled not implemented")
+
+type Bridge_RunEventStreamClient interface {
+	Recv() (*StreamEvent, error)
+	grpc.ClientStream
 }
-func (UnimplementedBridgeServer) IsDoHEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsDoHEnabled not implemented")
+
+type bridgeRunEventStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedBridgeServer) MailServerSettings(context.Context, *emptypb.Empty) (*ImapSmtpSettings, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MailServerSettings not implemented")
+
+func (x *bridgeRunEventStreamClient) Recv() (*StreamEvent, error) {
+	m := new(StreamEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedBridgeServer) SetMailServerSettings(context.Context, *ImapSmtpSettings) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetMailServerSettings not implemented")
+
+func (c *bridgeClient) StopEventStream(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_StopEventStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) Hostname(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Hostname not implemented")
+
+func (c *bridgeClient) SubscribeBridgeEvents(ctx context.Context, in *BridgeEventFilter, opts ...grpc.CallOption) (Bridge_SubscribeBridgeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bridge_ServiceDesc.Streams[1], Bridge_SubscribeBridgeEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bridgeSubscribeBridgeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedBridgeServer) IsPortFree(context.Context, *wrapperspb.Int32Value) (*wrapperspb.BoolValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsPortFree not implemented")
+
+type Bridge_SubscribeBridgeEventsClient interface {
+	Recv() (*BridgeEvent, error)
+	grpc.ClientStream
 }
-func (UnimplementedBridgeServer) AvailableKeychains(context.Context, *emptypb.Empty) (*AvailableKeychainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AvailableKeychains not implemented")
+
+type bridgeSubscribeBridgeEventsClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedBridgeServer) SetCurrentKeychain(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetCurrentKeychain not implemented")
+
+func (x *bridgeSubscribeBridgeEventsClient) Recv() (*BridgeEvent, error) {
+	m := new(BridgeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedBridgeServer) CurrentKeychain(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentKeychain not implemented")
+
+func (c *bridgeClient) TriggerRepair(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_TriggerRepair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBridgeServer) GetUserList(context.Context, *emptypb.Empty) (*UserListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserList not implemented")
+
+// BridgeServer is the server API for Bridge service.
+// All implementations must embed UnimplementedBridgeServer
+// for forward compatibility
+type BridgeServer interface {
+	// App related calls
+	GetServiceInfo(context.Context, *ServiceInfoRequest) (*ServiceInfo, error)
+	CheckTokens(context.Context, *wrapperspb.StringValue) (*wrapperspb.StringValue, error)
+	AddLogEntry(context.Context, *AddLogEntryRequest) (*emptypb.Empty, error)
+	LogLevels(context.Context, *emptypb.Empty) (*LogLevelsResponse, error)
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*emptypb.Empty, error)
+	Traces(context.Context, *emptypb.Empty) (*TracesResponse, error)
+	StartTrace(context.Context, *StartTraceRequest) (*emptypb.Empty, error)
+	StopTrace(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	SetIsSessionAuditLogEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	IsSessionAuditLogEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	RecentIMAPSessions(context.Context, *emptypb.Empty) (*IMAPSessionsResponse, error)
+	GuiReady(context.Context, *emptypb.Empty) (*GuiReadyResponse, error)
+	Quit(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	Restart(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	ShowOnStartup(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	SetIsAutostartOn(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	IsAutostartOn(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	SetIsBetaEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	IsBetaEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	SetIsAllMailVisible(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	IsAllMailVisible(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	SetIsIPCOverTCP(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	IsIPCOverTCP(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	SetIsReauthOnDeauthEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	IsReauthOnDeauthEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	SetIsTelemetryDisabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	IsTelemetryDisabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	SetIsReportingDisabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	IsReportingDisabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	GoOs(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	TriggerReset(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	Version(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	LogsPath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	LicensePath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	ReleaseNotesPageLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	DependencyLicensesLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	LandingPageLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	SetColorSchemeName(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	ColorSchemeName(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	CurrentEmailClient(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	ReportBug(context.Context, *ReportBugRequest) (*emptypb.Empty, error)
+	CollectDiagnostics(context.Context, *wrapperspb.StringValue) (*wrapperspb.StringValue, error)
+	CheckConfiguration(context.Context, *emptypb.Empty) (*ConfigurationReport, error)
+	ForceLauncher(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	SetMainExecutable(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	RequestKnowledgeBaseSuggestions(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	// login
+	Login(context.Context, *LoginRequest) (*emptypb.Empty, error)
+	Login2FA(context.Context, *LoginRequest) (*emptypb.Empty, error)
+	LoginFido2(context.Context, *LoginFido2Request) (*emptypb.Empty, error)
+	LoginFido2Local(context.Context, *LoginFido2LocalRequest) (*emptypb.Empty, error)
+	Login2Passwords(context.Context, *LoginRequest) (*emptypb.Empty, error)
+	LoginAbort(context.Context, *LoginAbortRequest) (*emptypb.Empty, error)
+	// update
+	CheckUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	InstallUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	RollbackUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	SetIsAutomaticUpdateOn(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	IsAutomaticUpdateOn(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	// cache
+	DiskCachePath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	SetDiskCachePath(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	DiskCacheMaxSize(context.Context, *emptypb.Empty) (*wrapperspb.UInt64Value, error)
+	SetDiskCacheMaxSize(context.Context, *wrapperspb.UInt64Value) (*emptypb.Empty, error)
+	DiskCacheSize(context.Context, *emptypb.Empty) (*wrapperspb.UInt64Value, error)
+	DiskCacheKeepRecentDays(context.Context, *emptypb.Empty) (*wrapperspb.UInt32Value, error)
+	SetDiskCacheKeepRecentDays(context.Context, *wrapperspb.UInt32Value) (*emptypb.Empty, error)
+	// memory
+	MaxSyncMemory(context.Context, *emptypb.Empty) (*wrapperspb.UInt64Value, error)
+	SetMaxSyncMemory(context.Context, *wrapperspb.UInt64Value) (*emptypb.Empty, error)
+	MemoryUsage(context.Context, *emptypb.Empty) (*MemoryUsageResponse, error)
+	// mail
+	SetIsDoHEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	IsDoHEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	MailServerSettings(context.Context, *emptypb.Empty) (*ImapSmtpSettings, error)
+	SetMailServerSettings(context.Context, *ImapSmtpSettings) (*emptypb.Empty, error)
+	ImplicitTLSPorts(context.Context, *emptypb.Empty) (*ImplicitTLSPortSettings, error)
+	SetImplicitTLSPorts(context.Context, *ImplicitTLSPortSettings) (*emptypb.Empty, error)
+	SSLRequired(context.Context, *emptypb.Empty) (*SSLRequiredSettings, error)
+	SetSSLRequired(context.Context, *SSLRequiredSettings) (*emptypb.Empty, error)
+	Hostname(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	BindAddress(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	SetBindAddress(context.Context, *BindAddressRequest) (*emptypb.Empty, error)
+	IsPortFree(context.Context, *wrapperspb.Int32Value) (*wrapperspb.BoolValue, error)
+	// keychain
+	AvailableKeychains(context.Context, *emptypb.Empty) (*AvailableKeychainsResponse, error)
+	SetCurrentKeychain(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	CurrentKeychain(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	IsCredentialStoreUnavailable(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	MigrateToFileVault(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// lock
+	AutoLockTimeout(context.Context, *emptypb.Empty) (*wrapperspb.Int32Value, error)
+	SetAutoLockTimeout(context.Context, *wrapperspb.Int32Value) (*emptypb.Empty, error)
+	HasUnlockPassphrase(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	SetUnlockPassphrase(context.Context, *wrapperspb.BytesValue) (*emptypb.Empty, error)
+	IsLocked(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	Lock(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	Unlock(context.Context, *wrapperspb.BytesValue) (*emptypb.Empty, error)
+	RotateEncryptionKeys(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// User & user list
+	GetUserList(context.Context, *emptypb.Empty) (*UserListResponse, error)
+	GetUser(context.Context, *wrapperspb.StringValue) (*User, error)
+	SetUserSplitMode(context.Context, *UserSplitModeRequest) (*emptypb.Empty, error)
+	SetUserHideAllMail(context.Context, *UserHideAllMailRequest) (*emptypb.Empty, error)
+	SetUserRepairMime(context.Context, *UserRepairMimeRequest) (*emptypb.Empty, error)
+	SetUserReadReceiptMode(context.Context, *UserReadReceiptModeRequest) (*emptypb.Empty, error)
+	SendUserReadReceipt(context.Context, *UserReadReceiptRequest) (*emptypb.Empty, error)
+	SetUserSyncCutoff(context.Context, *UserSyncCutoffRequest) (*emptypb.Empty, error)
+	SendBadEventUserFeedback(context.Context, *UserBadEventFeedbackRequest) (*emptypb.Empty, error)
+	LogoutUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	PrioritizeUserSync(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	RemoveUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	RemoveUserKeepData(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	ConfigureUserAppleMail(context.Context, *ConfigureAppleMailRequest) (*emptypb.Empty, error)
+	UserClientConfig(context.Context, *ClientConfigRequest) (*wrapperspb.StringValue, error)
+	StartAutoconfigServer(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	UserAppPasswords(context.Context, *wrapperspb.StringValue) (*AppPasswordsResponse, error)
+	CreateUserAppPassword(context.Context, *AppPasswordRequest) (*wrapperspb.BytesValue, error)
+	RevokeUserAppPassword(context.Context, *AppPasswordRequest) (*emptypb.Empty, error)
+	CreateUserAuthToken(context.Context, *AuthTokenRequest) (*wrapperspb.BytesValue, error)
+	UserEventJournal(context.Context, *UserEventJournalRequest) (*UserEventJournalResponse, error)
+	// TLS certificate related calls
+	IsTLSCertificateInstalled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	InstallTLSCertificate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	ExportTLSCertificates(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	TLSCertificatePaths(context.Context, *emptypb.Empty) (*TLSCertificateFiles, error)
+	SetTLSCertificatePaths(context.Context, *TLSCertificateFiles) (*emptypb.Empty, error)
+	SetTLSCertificateDir(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	ResetTLSCertificate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Webhooks
+	Webhooks(context.Context, *emptypb.Empty) (*WebhooksResponse, error)
+	AddWebhook(context.Context, *AddWebhookRequest) (*emptypb.Empty, error)
+	RemoveWebhook(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	// Server -> Client event stream
+	RunEventStream(*EventStreamRequest, Bridge_RunEventStreamServer) error
+	StopEventStream(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Bridge events bus, for third-party integrations. Unlike RunEventStream, any number of clients can subscribe at once.
+	SubscribeBridgeEvents(*BridgeEventFilter, Bridge_SubscribeBridgeEventsServer) error
+	// Repair
+	TriggerRepair(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedBridgeServer()
+}
+
+// UnimplementedBridgeServer must be embedded to have forward compatible implementations.
+type UnimplementedBridgeServer struct {
+}
+
+func (UnimplementedBridgeServer) GetServiceInfo(context.Context, *ServiceInfoRequest) (*ServiceInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceInfo not implemented")
+}
+func (UnimplementedBridgeServer) CheckTokens(context.Context, *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTokens not implemented")
+}
+func (UnimplementedBridgeServer) AddLogEntry(context.Context, *AddLogEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLogEntry not implemented")
+}
+func (UnimplementedBridgeServer) LogLevels(context.Context, *emptypb.Empty) (*LogLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogLevels not implemented")
+}
+func (UnimplementedBridgeServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedBridgeServer) Traces(context.Context, *emptypb.Empty) (*TracesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Traces not implemented")
+}
+func (UnimplementedBridgeServer) StartTrace(context.Context, *StartTraceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTrace not implemented")
+}
+func (UnimplementedBridgeServer) StopTrace(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopTrace not implemented")
+}
+func (UnimplementedBridgeServer) SetIsSessionAuditLogEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsSessionAuditLogEnabled not implemented")
+}
+func (UnimplementedBridgeServer) IsSessionAuditLogEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsSessionAuditLogEnabled not implemented")
+}
+func (UnimplementedBridgeServer) RecentIMAPSessions(context.Context, *emptypb.Empty) (*IMAPSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecentIMAPSessions not implemented")
+}
+func (UnimplementedBridgeServer) GuiReady(context.Context, *emptypb.Empty) (*GuiReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuiReady not implemented")
+}
+func (UnimplementedBridgeServer) Quit(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quit not implemented")
+}
+func (UnimplementedBridgeServer) Restart(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restart not implemented")
+}
+func (UnimplementedBridgeServer) ShowOnStartup(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowOnStartup not implemented")
+}
+func (UnimplementedBridgeServer) SetIsAutostartOn(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsAutostartOn not implemented")
+}
+func (UnimplementedBridgeServer) IsAutostartOn(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAutostartOn not implemented")
+}
+func (UnimplementedBridgeServer) SetIsBetaEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsBetaEnabled not implemented")
+}
+func (UnimplementedBridgeServer) IsBetaEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBetaEnabled not implemented")
+}
+func (UnimplementedBridgeServer) SetIsAllMailVisible(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsAllMailVisible not implemented")
+}
+func (UnimplementedBridgeServer) IsAllMailVisible(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAllMailVisible not implemented")
+}
+func (UnimplementedBridgeServer) SetIsIPCOverTCP(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsIPCOverTCP not implemented")
+}
+func (UnimplementedBridgeServer) IsIPCOverTCP(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsIPCOverTCP not implemented")
+}
+func (UnimplementedBridgeServer) SetIsReauthOnDeauthEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsReauthOnDeauthEnabled not implemented")
+}
+func (UnimplementedBridgeServer) IsReauthOnDeauthEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsReauthOnDeauthEnabled not implemented")
+}
+func (UnimplementedBridgeServer) SetIsTelemetryDisabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsTelemetryDisabled not implemented")
+}
+func (UnimplementedBridgeServer) IsTelemetryDisabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsTelemetryDisabled not implemented")
+}
+func (UnimplementedBridgeServer) SetIsReportingDisabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsReportingDisabled not implemented")
+}
+func (UnimplementedBridgeServer) IsReportingDisabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsReportingDisabled not implemented")
+}
+func (UnimplementedBridgeServer) GoOs(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoOs not implemented")
+}
+func (UnimplementedBridgeServer) TriggerReset(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerReset not implemented")
+}
+func (UnimplementedBridgeServer) Version(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+func (UnimplementedBridgeServer) LogsPath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogsPath not implemented")
+}
+func (UnimplementedBridgeServer) LicensePath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LicensePath not implemented")
+}
+func (UnimplementedBridgeServer) ReleaseNotesPageLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNotesPageLink not implemented")
+}
+func (UnimplementedBridgeServer) DependencyLicensesLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DependencyLicensesLink not implemented")
+}
+func (UnimplementedBridgeServer) LandingPageLink(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LandingPageLink not implemented")
+}
+func (UnimplementedBridgeServer) SetColorSchemeName(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetColorSchemeName not implemented")
+}
+func (UnimplementedBridgeServer) ColorSchemeName(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ColorSchemeName not implemented")
+}
+func (UnimplementedBridgeServer) CurrentEmailClient(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEmailClient not implemented")
+}
+func (UnimplementedBridgeServer) ReportBug(context.Context, *ReportBugRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportBug not implemented")
+}
+func (UnimplementedBridgeServer) CollectDiagnostics(context.Context, *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectDiagnostics not implemented")
+}
+func (UnimplementedBridgeServer) CheckConfiguration(context.Context, *emptypb.Empty) (*ConfigurationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConfiguration not implemented")
+}
+func (UnimplementedBridgeServer) ForceLauncher(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceLauncher not implemented")
+}
+func (UnimplementedBridgeServer) SetMainExecutable(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMainExecutable not implemented")
+}
+func (UnimplementedBridgeServer) RequestKnowledgeBaseSuggestions(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestKnowledgeBaseSuggestions not implemented")
+}
+func (UnimplementedBridgeServer) Login(context.Context, *LoginRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedBridgeServer) Login2FA(context.Context, *LoginRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login2FA not implemented")
+}
+func (UnimplementedBridgeServer) LoginFido2(context.Context, *LoginFido2Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginFido2 not implemented")
+}
+func (UnimplementedBridgeServer) LoginFido2Local(context.Context, *LoginFido2LocalRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginFido2Local not implemented")
+}
+func (UnimplementedBridgeServer) Login2Passwords(context.Context, *LoginRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login2Passwords not implemented")
+}
+func (UnimplementedBridgeServer) LoginAbort(context.Context, *LoginAbortRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginAbort not implemented")
+}
+func (UnimplementedBridgeServer) CheckUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUpdate not implemented")
+}
+func (UnimplementedBridgeServer) InstallUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallUpdate not implemented")
+}
+func (UnimplementedBridgeServer) RollbackUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackUpdate not implemented")
+}
+func (UnimplementedBridgeServer) SetIsAutomaticUpdateOn(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsAutomaticUpdateOn not implemented")
+}
+func (UnimplementedBridgeServer) IsAutomaticUpdateOn(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAutomaticUpdateOn not implemented")
+}
+func (UnimplementedBridgeServer) DiskCachePath(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskCachePath not implemented")
+}
+func (UnimplementedBridgeServer) SetDiskCachePath(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiskCachePath not implemented")
+}
+func (UnimplementedBridgeServer) DiskCacheMaxSize(context.Context, *emptypb.Empty) (*wrapperspb.UInt64Value, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskCacheMaxSize not implemented")
+}
+func (UnimplementedBridgeServer) SetDiskCacheMaxSize(context.Context, *wrapperspb.UInt64Value) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiskCacheMaxSize not implemented")
+}
+func (UnimplementedBridgeServer) DiskCacheSize(context.Context, *emptypb.Empty) (*wrapperspb.UInt64Value, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskCacheSize not implemented")
+}
+func (UnimplementedBridgeServer) DiskCacheKeepRecentDays(context.Context, *emptypb.Empty) (*wrapperspb.UInt32Value, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskCacheKeepRecentDays not implemented")
+}
+func (UnimplementedBridgeServer) SetDiskCacheKeepRecentDays(context.Context, *wrapperspb.UInt32Value) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiskCacheKeepRecentDays not implemented")
+}
+func (UnimplementedBridgeServer) MaxSyncMemory(context.Context, *emptypb.Empty) (*wrapperspb.UInt64Value, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxSyncMemory not implemented")
+}
+func (UnimplementedBridgeServer) SetMaxSyncMemory(context.Context, *wrapperspb.UInt64Value) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxSyncMemory not implemented")
+}
+func (UnimplementedBridgeServer) MemoryUsage(context.Context, *emptypb.Empty) (*MemoryUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemoryUsage not implemented")
+}
+func (UnimplementedBridgeServer) SetIsDoHEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsDoHEnabled not implemented")
+}
+func (UnimplementedBridgeServer) IsDoHEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsDoHEnabled not implemented")
+}
+func (UnimplementedBridgeServer) MailServerSettings(context.Context, *emptypb.Empty) (*ImapSmtpSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MailServerSettings not implemented")
+}
+func (UnimplementedBridgeServer) SetMailServerSettings(context.Context, *ImapSmtpSettings) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMailServerSettings not implemented")
+}
+func (UnimplementedBridgeServer) ImplicitTLSPorts(context.Context, *emptypb.Empty) (*ImplicitTLSPortSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImplicitTLSPorts not implemented")
+}
+func (UnimplementedBridgeServer) SetImplicitTLSPorts(context.Context, *ImplicitTLSPortSettings) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetImplicitTLSPorts not implemented")
+}
+func (UnimplementedBridgeServer) SSLRequired(context.Context, *emptypb.Empty) (*SSLRequiredSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SSLRequired not implemented")
+}
+func (UnimplementedBridgeServer) SetSSLRequired(context.Context, *SSLRequiredSettings) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSSLRequired not implemented")
+}
+func (UnimplementedBridgeServer) Hostname(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hostname not implemented")
+}
+func (UnimplementedBridgeServer) BindAddress(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindAddress not implemented")
+}
+func (UnimplementedBridgeServer) SetBindAddress(context.Context, *BindAddressRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBindAddress not implemented")
+}
+func (UnimplementedBridgeServer) IsPortFree(context.Context, *wrapperspb.Int32Value) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPortFree not implemented")
+}
+func (UnimplementedBridgeServer) AvailableKeychains(context.Context, *emptypb.Empty) (*AvailableKeychainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvailableKeychains not implemented")
+}
+func (UnimplementedBridgeServer) SetCurrentKeychain(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCurrentKeychain not implemented")
+}
+func (UnimplementedBridgeServer) CurrentKeychain(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentKeychain not implemented")
+}
+func (UnimplementedBridgeServer) IsCredentialStoreUnavailable(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsCredentialStoreUnavailable not implemented")
+}
+func (UnimplementedBridgeServer) MigrateToFileVault(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateToFileVault not implemented")
+}
+func (UnimplementedBridgeServer) AutoLockTimeout(context.Context, *emptypb.Empty) (*wrapperspb.Int32Value, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoLockTimeout not implemented")
+}
+func (UnimplementedBridgeServer) SetAutoLockTimeout(context.Context, *wrapperspb.Int32Value) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoLockTimeout not implemented")
+}
+func (UnimplementedBridgeServer) HasUnlockPassphrase(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasUnlockPassphrase not implemented")
+}
+func (UnimplementedBridgeServer) SetUnlockPassphrase(context.Context, *wrapperspb.BytesValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnlockPassphrase not implemented")
+}
+func (UnimplementedBridgeServer) IsLocked(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsLocked not implemented")
+}
+func (UnimplementedBridgeServer) Lock(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (UnimplementedBridgeServer) Unlock(context.Context, *wrapperspb.BytesValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedBridgeServer) RotateEncryptionKeys(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateEncryptionKeys not implemented")
+}
+func (UnimplementedBridgeServer) GetUserList(context.Context, *emptypb.Empty) (*UserListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserList not implemented")
+}
+func (UnimplementedBridgeServer) GetUser(context.Context, *wrapperspb.StringValue) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedBridgeServer) SetUserSplitMode(context.Context, *UserSplitModeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserSplitMode not implemented")
+}
+func (UnimplementedBridgeServer) SetUserHideAllMail(context.Context, *UserHideAllMailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserHideAllMail not implemented")
+}
+func (UnimplementedBridgeServer) SetUserRepairMime(context.Context, *UserRepairMimeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRepairMime not implemented")
+}
+func (UnimplementedBridgeServer) SetUserReadReceiptMode(context.Context, *UserReadReceiptModeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserReadReceiptMode not implemented")
+}
+func (UnimplementedBridgeServer) SendUserReadReceipt(context.Context, *UserReadReceiptRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendUserReadReceipt not implemented")
+}
+func (UnimplementedBridgeServer) SetUserSyncCutoff(context.Context, *UserSyncCutoffRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserSyncCutoff not implemented")
+}
+func (UnimplementedBridgeServer) SendBadEventUserFeedback(context.Context, *UserBadEventFeedbackRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendBadEventUserFeedback not implemented")
+}
+func (UnimplementedBridgeServer) LogoutUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutUser not implemented")
+}
+func (UnimplementedBridgeServer) PrioritizeUserSync(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrioritizeUserSync not implemented")
+}
+func (UnimplementedBridgeServer) RemoveUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUser not implemented")
+}
+func (UnimplementedBridgeServer) RemoveUserKeepData(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserKeepData not implemented")
+}
+func (UnimplementedBridgeServer) ConfigureUserAppleMail(context.Context, *ConfigureAppleMailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureUserAppleMail not implemented")
+}
+func (UnimplementedBridgeServer) UserClientConfig(context.Context, *ClientConfigRequest) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserClientConfig not implemented")
+}
+func (UnimplementedBridgeServer) StartAutoconfigServer(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartAutoconfigServer not implemented")
+}
+func (UnimplementedBridgeServer) UserAppPasswords(context.Context, *wrapperspb.StringValue) (*AppPasswordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserAppPasswords not implemented")
+}
+func (UnimplementedBridgeServer) CreateUserAppPassword(context.Context, *AppPasswordRequest) (*wrapperspb.BytesValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserAppPassword not implemented")
+}
+func (UnimplementedBridgeServer) RevokeUserAppPassword(context.Context, *AppPasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserAppPassword not implemented")
+}
+func (UnimplementedBridgeServer) CreateUserAuthToken(context.Context, *AuthTokenRequest) (*wrapperspb.BytesValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserAuthToken not implemented")
+}
+func (UnimplementedBridgeServer) UserEventJournal(context.Context, *UserEventJournalRequest) (*UserEventJournalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserEventJournal not implemented")
+}
+func (UnimplementedBridgeServer) IsTLSCertificateInstalled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsTLSCertificateInstalled not implemented")
+}
+func (UnimplementedBridgeServer) InstallTLSCertificate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallTLSCertificate not implemented")
+}
+func (UnimplementedBridgeServer) ExportTLSCertificates(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTLSCertificates not implemented")
+}
+func (UnimplementedBridgeServer) TLSCertificatePaths(context.Context, *emptypb.Empty) (*TLSCertificateFiles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TLSCertificatePaths not implemented")
+}
+func (UnimplementedBridgeServer) SetTLSCertificatePaths(context.Context, *TLSCertificateFiles) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTLSCertificatePaths not implemented")
+}
+func (UnimplementedBridgeServer) SetTLSCertificateDir(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTLSCertificateDir not implemented")
+}
+func (UnimplementedBridgeServer) ResetTLSCertificate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTLSCertificate not implemented")
+}
+func (UnimplementedBridgeServer) Webhooks(context.Context, *emptypb.Empty) (*WebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Webhooks not implemented")
+}
+func (UnimplementedBridgeServer) AddWebhook(context.Context, *AddWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWebhook not implemented")
+}
+func (UnimplementedBridgeServer) RemoveWebhook(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebhook not implemented")
+}
+func (UnimplementedBridgeServer) RunEventStream(*EventStreamRequest, Bridge_RunEventStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method RunEventStream not implemented")
+}
+func (UnimplementedBridgeServer) StopEventStream(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopEventStream not implemented")
+}
+func (UnimplementedBridgeServer) SubscribeBridgeEvents(*BridgeEventFilter, Bridge_SubscribeBridgeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBridgeEvents not implemented")
+}
+func (UnimplementedBridgeServer) TriggerRepair(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerRepair not implemented")
+}
+func (UnimplementedBridgeServer) mustEmbedUnimplementedBridgeServer() {}
+
+// UnsafeBridgeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BridgeServer will
+// result in compilation errors.
+type UnsafeBridgeServer interface {
+	mustEmbedUnimplementedBridgeServer()
+}
+
+func RegisterBridgeServer(s grpc.ServiceRegistrar, srv BridgeServer) {
+	s.RegisterService(&Bridge_ServiceDesc, srv)
+}
+
+func _Bridge_GetServiceInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GetServiceInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_GetServiceInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GetServiceInfo(ctx, req.(*ServiceInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_CheckTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).CheckTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_CheckTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).CheckTokens(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_AddLogEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLogEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).AddLogEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_AddLogEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).AddLogEntry(ctx, req.(*AddLogEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_LogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).LogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_LogLevels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).LogLevels(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_Traces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).Traces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_Traces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).Traces(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_StartTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).StartTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_StartTrace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).StartTrace(ctx, req.(*StartTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_StopTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).StopTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_StopTrace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).StopTrace(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetIsSessionAuditLogEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetIsSessionAuditLogEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetIsSessionAuditLogEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetIsSessionAuditLogEnabled(ctx, req.(*wrapperspb.BoolValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_IsSessionAuditLogEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).IsSessionAuditLogEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_IsSessionAuditLogEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).IsSessionAuditLogEnabled(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_RecentIMAPSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).RecentIMAPSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_RecentIMAPSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).RecentIMAPSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_GuiReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GuiReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_GuiReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GuiReady(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_Quit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).Quit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_Quit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).Quit(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).Restart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_Restart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).Restart(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_ShowOnStartup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).ShowOnStartup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_ShowOnStartup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).ShowOnStartup(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetIsAutostartOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetIsAutostartOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetIsAutostartOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetIsAutostartOn(ctx, req.(*wrapperspb.BoolValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_IsAutostartOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).IsAutostartOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_IsAutostartOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).IsAutostartOn(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetIsBetaEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetIsBetaEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetIsBetaEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetIsBetaEnabled(ctx, req.(*wrapperspb.BoolValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_IsBetaEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).IsBetaEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_IsBetaEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).IsBetaEnabled(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetIsAllMailVisible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetIsAllMailVisible(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetIsAllMailVisible_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetIsAllMailVisible(ctx, req.(*wrapperspb.BoolValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_IsAllMailVisible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).IsAllMailVisible(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_IsAllMailVisible_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).IsAllMailVisible(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetIsIPCOverTCP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetIsIPCOverTCP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetIsIPCOverTCP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetIsIPCOverTCP(ctx, req.(*wrapperspb.BoolValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_IsIPCOverTCP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).IsIPCOverTCP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_IsIPCOverTCP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).IsIPCOverTCP(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetIsReauthOnDeauthEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetIsReauthOnDeauthEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetIsReauthOnDeauthEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetIsReauthOnDeauthEnabled(ctx, req.(*wrapperspb.BoolValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_IsReauthOnDeauthEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).IsReauthOnDeauthEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_IsReauthOnDeauthEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).IsReauthOnDeauthEnabled(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetIsTelemetryDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetIsTelemetryDisabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetIsTelemetryDisabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetIsTelemetryDisabled(ctx, req.(*wrapperspb.BoolValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_IsTelemetryDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).IsTelemetryDisabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_IsTelemetryDisabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).IsTelemetryDisabled(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetIsReportingDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetIsReportingDisabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetIsReportingDisabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetIsReportingDisabled(ctx, req.(*wrapperspb.BoolValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_IsReportingDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).IsReportingDisabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_IsReportingDisabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).IsReportingDisabled(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_GoOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GoOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_GoOs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GoOs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_TriggerReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).TriggerReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_TriggerReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).TriggerReset(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_Version_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).Version(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_LogsPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).LogsPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_LogsPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).LogsPath(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_LicensePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).LicensePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_LicensePath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).LicensePath(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_ReleaseNotesPageLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).ReleaseNotesPageLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_ReleaseNotesPageLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).ReleaseNotesPageLink(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_DependencyLicensesLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).DependencyLicensesLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_DependencyLicensesLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).DependencyLicensesLink(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_LandingPageLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).LandingPageLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_LandingPageLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).LandingPageLink(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetColorSchemeName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetColorSchemeName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetColorSchemeName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetColorSchemeName(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_ColorSchemeName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).ColorSchemeName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_ColorSchemeName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).ColorSchemeName(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_CurrentEmailClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).CurrentEmailClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_CurrentEmailClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).CurrentEmailClient(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_ReportBug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportBugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).ReportBug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_ReportBug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).ReportBug(ctx, req.(*ReportBugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_CollectDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).CollectDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_CollectDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).CollectDiagnostics(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_CheckConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).CheckConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_CheckConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).CheckConfiguration(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_ForceLauncher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).ForceLauncher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_ForceLauncher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).ForceLauncher(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetMainExecutable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetMainExecutable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetMainExecutable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetMainExecutable(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_RequestKnowledgeBaseSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).RequestKnowledgeBaseSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_RequestKnowledgeBaseSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).RequestKnowledgeBaseSuggestions(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_Login2FA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).Login2FA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_Login2FA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).Login2FA(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_LoginFido2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginFido2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).LoginFido2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_LoginFido2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).LoginFido2(ctx, req.(*LoginFido2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_LoginFido2Local_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginFido2LocalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).LoginFido2Local(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_LoginFido2Local_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).LoginFido2Local(ctx, req.(*LoginFido2LocalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_Login2Passwords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).Login2Passwords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_Login2Passwords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).Login2Passwords(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) GetUser(context.Context, *wrapperspb.StringValue) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+
+func _Bridge_LoginAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginAbortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).LoginAbort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_LoginAbort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).LoginAbort(ctx, req.(*LoginAbortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) SetUserSplitMode(context.Context, *UserSplitModeRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserSplitMode not implemented")
+
+func _Bridge_CheckUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).CheckUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_CheckUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).CheckUpdate(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) SendBadEventUserFeedback(context.Context, *UserBadEventFeedbackRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendBadEventUserFeedback not implemented")
+
+func _Bridge_InstallUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).InstallUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_InstallUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).InstallUpdate(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) LogoutUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LogoutUser not implemented")
+
+func _Bridge_RollbackUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).RollbackUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_RollbackUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).RollbackUpdate(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) RemoveUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveUser not implemented")
+
+func _Bridge_SetIsAutomaticUpdateOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetIsAutomaticUpdateOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetIsAutomaticUpdateOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetIsAutomaticUpdateOn(ctx, req.(*wrapperspb.BoolValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) ConfigureUserAppleMail(context.Context, *ConfigureAppleMailRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfigureUserAppleMail not implemented")
+
+func _Bridge_IsAutomaticUpdateOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).IsAutomaticUpdateOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_IsAutomaticUpdateOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).IsAutomaticUpdateOn(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) IsTLSCertificateInstalled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsTLSCertificateInstalled not implemented")
+
+func _Bridge_DiskCachePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).DiskCachePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_DiskCachePath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).DiskCachePath(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) InstallTLSCertificate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstallTLSCertificate not implemented")
+
+func _Bridge_SetDiskCachePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetDiskCachePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetDiskCachePath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetDiskCachePath(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) ExportTLSCertificates(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExportTLSCertificates not implemented")
+
+func _Bridge_DiskCacheMaxSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).DiskCacheMaxSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_DiskCacheMaxSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).DiskCacheMaxSize(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) RunEventStream(*EventStreamRequest, Bridge_RunEventStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method RunEventStream not implemented")
+
+func _Bridge_SetDiskCacheMaxSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.UInt64Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetDiskCacheMaxSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetDiskCacheMaxSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetDiskCacheMaxSize(ctx, req.(*wrapperspb.UInt64Value))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) StopEventStream(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopEventStream not implemented")
+
+func _Bridge_DiskCacheSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).DiskCacheSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_DiskCacheSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).DiskCacheSize(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) TriggerRepair(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TriggerRepair not implemented")
+
+func _Bridge_DiskCacheKeepRecentDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).DiskCacheKeepRecentDays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_DiskCacheKeepRecentDays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).DiskCacheKeepRecentDays(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBridgeServer) mustEmbedUnimplementedBridgeServer() {}
 
-// UnsafeBridgeServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BridgeServer will
-// result in compilation errors.
-type UnsafeBridgeServer interface {
-	mustEmbedUnimplementedBridgeServer()
+func _Bridge_SetDiskCacheKeepRecentDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.UInt32Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetDiskCacheKeepRecentDays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetDiskCacheKeepRecentDays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetDiskCacheKeepRecentDays(ctx, req.(*wrapperspb.UInt32Value))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBridgeServer(s grpc.ServiceRegistrar, srv BridgeServer) {
-	s.RegisterService(&Bridge_ServiceDesc, srv)
+func _Bridge_MaxSyncMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).MaxSyncMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_MaxSyncMemory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).MaxSyncMemory(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_CheckTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.StringValue)
+func _Bridge_SetMaxSyncMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.UInt64Value)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).CheckTokens(ctx, in)
+		return srv.(BridgeServer).SetMaxSyncMemory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_CheckTokens_FullMethodName,
+		FullMethod: Bridge_SetMaxSyncMemory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).CheckTokens(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).SetMaxSyncMemory(ctx, req.(*wrapperspb.UInt64Value))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_AddLogEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddLogEntryRequest)
+func _Bridge_MemoryUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).AddLogEntry(ctx, in)
+		return srv.(BridgeServer).MemoryUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_AddLogEntry_FullMethodName,
+		FullMethod: Bridge_MemoryUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).AddLogEntry(ctx, req.(*AddLogEntryRequest))
+		return srv.(BridgeServer).MemoryUsage(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_GuiReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_SetIsDoHEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).GuiReady(ctx, in)
+		return srv.(BridgeServer).SetIsDoHEnabled(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_GuiReady_FullMethodName,
+		FullMethod: Bridge_SetIsDoHEnabled_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).GuiReady(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).SetIsDoHEnabled(ctx, req.(*wrapperspb.BoolValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_Quit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_IsDoHEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).Quit(ctx, in)
+		return srv.(BridgeServer).IsDoHEnabled(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_Quit_FullMethodName,
+		FullMethod: Bridge_IsDoHEnabled_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).Quit(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).IsDoHEnabled(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_MailServerSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).Restart(ctx, in)
+		return srv.(BridgeServer).MailServerSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_Restart_FullMethodName,
+		FullMethod: Bridge_MailServerSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).Restart(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).MailServerSettings(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_ShowOnStartup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_SetMailServerSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImapSmtpSettings)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).ShowOnStartup(ctx, in)
+		return srv.(BridgeServer).SetMailServerSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_ShowOnStartup_FullMethodName,
+		FullMethod: Bridge_SetMailServerSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).ShowOnStartup(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).SetMailServerSettings(ctx, req.(*ImapSmtpSettings))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetIsAutostartOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.BoolValue)
+func _Bridge_ImplicitTLSPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetIsAutostartOn(ctx, in)
+		return srv.(BridgeServer).ImplicitTLSPorts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetIsAutostartOn_FullMethodName,
+		FullMethod: Bridge_ImplicitTLSPorts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetIsAutostartOn(ctx, req.(*wrapperspb.BoolValue))
+		return srv.(BridgeServer).ImplicitTLSPorts(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_IsAutostartOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_SetImplicitTLSPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImplicitTLSPortSettings)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).IsAutostartOn(ctx, in)
+		return srv.(BridgeServer).SetImplicitTLSPorts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_IsAutostartOn_FullMethodName,
+		FullMethod: Bridge_SetImplicitTLSPorts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).IsAutostartOn(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).SetImplicitTLSPorts(ctx, req.(*ImplicitTLSPortSettings))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetIsBetaEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.BoolValue)
+func _Bridge_SSLRequired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetIsBetaEnabled(ctx, in)
+		return srv.(BridgeServer).SSLRequired(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetIsBetaEnabled_FullMethodName,
+		FullMethod: Bridge_SSLRequired_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetIsBetaEnabled(ctx, req.(*wrapperspb.BoolValue))
+		return srv.(BridgeServer).SSLRequired(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_IsBetaEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_SetSSLRequired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSLRequiredSettings)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).IsBetaEnabled(ctx, in)
+		return srv.(BridgeServer).SetSSLRequired(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_IsBetaEnabled_FullMethodName,
+		FullMethod: Bridge_SetSSLRequired_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).IsBetaEnabled(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).SetSSLRequired(ctx, req.(*SSLRequiredSettings))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetIsAllMailVisible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.BoolValue)
+func _Bridge_Hostname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetIsAllMailVisible(ctx, in)
+		return srv.(BridgeServer).Hostname(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetIsAllMailVisible_FullMethodName,
+		FullMethod: Bridge_Hostname_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetIsAllMailVisible(ctx, req.(*wrapperspb.BoolValue))
+		return srv.(BridgeServer).Hostname(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_IsAllMailVisible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_BindAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).IsAllMailVisible(ctx, in)
+		return srv.(BridgeServer).BindAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_IsAllMailVisible_FullMethodName,
+		FullMethod: Bridge_BindAddress_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).IsAllMailVisible(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).BindAddress(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetIsTelemetryDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.BoolValue)
+func _Bridge_SetBindAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetIsTelemetryDisabled(ctx, in)
+		return srv.(BridgeServer).SetBindAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetIsTelemetryDisabled_FullMethodName,
+		FullMethod: Bridge_SetBindAddress_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetIsTelemetryDisabled(ctx, req.(*wrapperspb.BoolValue))
+		return srv.(BridgeServer).SetBindAddress(ctx, req.(*BindAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_IsTelemetryDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_IsPortFree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.Int32Value)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).IsTelemetryDisabled(ctx, in)
+		return srv.(BridgeServer).IsPortFree(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_IsTelemetryDisabled_FullMethodName,
+		FullMethod: Bridge_IsPortFree_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).IsTelemetryDisabled(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).IsPortFree(ctx, req.(*wrapperspb.Int32Value))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_GoOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_AvailableKeychains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).GoOs(ctx, in)
+		return srv.(BridgeServer).AvailableKeychains(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_GoOs_FullMethodName,
+		FullMethod: Bridge_AvailableKeychains_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).GoOs(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).AvailableKeychains(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_TriggerReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_SetCurrentKeychain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).TriggerReset(ctx, in)
+		return srv.(BridgeServer).SetCurrentKeychain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_TriggerReset_FullMethodName,
+		FullMethod: Bridge_SetCurrentKeychain_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).TriggerReset(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).SetCurrentKeychain(ctx, req.(*wrapperspb.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_CurrentKeychain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).Version(ctx, in)
+		return srv.(BridgeServer).CurrentKeychain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_Version_FullMethodName,
+		FullMethod: Bridge_CurrentKeychain_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).Version(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).CurrentKeychain(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_LogsPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_IsCredentialStoreUnavailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).LogsPath(ctx, in)
+		return srv.(BridgeServer).IsCredentialStoreUnavailable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_LogsPath_FullMethodName,
+		FullMethod: Bridge_IsCredentialStoreUnavailable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).LogsPath(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).IsCredentialStoreUnavailable(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_LicensePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_MigrateToFileVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).LicensePath(ctx, in)
+		return srv.(BridgeServer).MigrateToFileVault(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_LicensePath_FullMethodName,
+		FullMethod: Bridge_MigrateToFileVault_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).LicensePath(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).MigrateToFileVault(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_ReleaseNotesPageLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_AutoLockTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).ReleaseNotesPageLink(ctx, in)
+		return srv.(BridgeServer).AutoLockTimeout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_ReleaseNotesPageLink_FullMethodName,
+		FullMethod: Bridge_AutoLockTimeout_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).ReleaseNotesPageLink(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).AutoLockTimeout(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_DependencyLicensesLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_SetAutoLockTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.Int32Value)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).DependencyLicensesLink(ctx, in)
+		return srv.(BridgeServer).SetAutoLockTimeout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_DependencyLicensesLink_FullMethodName,
+		FullMethod: Bridge_SetAutoLockTimeout_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).DependencyLicensesLink(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).SetAutoLockTimeout(ctx, req.(*wrapperspb.Int32Value))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_LandingPageLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_HasUnlockPassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).LandingPageLink(ctx, in)
+		return srv.(BridgeServer).HasUnlockPassphrase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_LandingPageLink_FullMethodName,
+		FullMethod: Bridge_HasUnlockPassphrase_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).LandingPageLink(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).HasUnlockPassphrase(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetColorSchemeName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.StringValue)
+func _Bridge_SetUnlockPassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BytesValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetColorSchemeName(ctx, in)
+		return srv.(BridgeServer).SetUnlockPassphrase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetColorSchemeName_FullMethodName,
+		FullMethod: Bridge_SetUnlockPassphrase_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetColorSchemeName(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).SetUnlockPassphrase(ctx, req.(*wrapperspb.BytesValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_ColorSchemeName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_IsLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).ColorSchemeName(ctx, in)
+		return srv.(BridgeServer).IsLocked(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_ColorSchemeName_FullMethodName,
+		FullMethod: Bridge_IsLocked_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).ColorSchemeName(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).IsLocked(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_CurrentEmailClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).CurrentEmailClient(ctx, in)
+		return srv.(BridgeServer).Lock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_CurrentEmailClient_FullMethodName,
+		FullMethod: Bridge_Lock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).CurrentEmailClient(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).Lock(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_ReportBug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReportBugRequest)
+func _Bridge_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BytesValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).ReportBug(ctx, in)
+		return srv.(BridgeServer).Unlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_ReportBug_FullMethodName,
+		FullMethod: Bridge_Unlock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).ReportBug(ctx, req.(*ReportBugRequest))
+		return srv.(BridgeServer).Unlock(ctx, req.(*wrapperspb.BytesValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_ForceLauncher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.StringValue)
+func _Bridge_RotateEncryptionKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).ForceLauncher(ctx, in)
+		return srv.(BridgeServer).RotateEncryptionKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_ForceLauncher_FullMethodName,
+		FullMethod: Bridge_RotateEncryptionKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).ForceLauncher(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).RotateEncryptionKeys(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetMainExecutable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.StringValue)
+func _Bridge_GetUserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetMainExecutable(ctx, in)
+		return srv.(BridgeServer).GetUserList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetMainExecutable_FullMethodName,
+		FullMethod: Bridge_GetUserList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetMainExecutable(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).GetUserList(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_RequestKnowledgeBaseSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).RequestKnowledgeBaseSuggestions(ctx, in)
+		return srv.(BridgeServer).GetUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_RequestKnowledgeBaseSuggestions_FullMethodName,
+		FullMethod: Bridge_GetUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).RequestKnowledgeBaseSuggestions(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).GetUser(ctx, req.(*wrapperspb.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _Bridge_SetUserSplitMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSplitModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).Login(ctx, in)
+		return srv.(BridgeServer).SetUserSplitMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_Login_FullMethodName,
+		FullMethod: Bridge_SetUserSplitMode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).Login(ctx, req.(*LoginRequest))
+		return srv.(BridgeServer).SetUserSplitMode(ctx, req.(*UserSplitModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_Login2FA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _Bridge_SetUserHideAllMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserHideAllMailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).Login2FA(ctx, in)
+		return srv.(BridgeServer).SetUserHideAllMail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_Login2FA_FullMethodName,
+		FullMethod: Bridge_SetUserHideAllMail_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).Login2FA(ctx, req.(*LoginRequest))
+		return srv.(BridgeServer).SetUserHideAllMail(ctx, req.(*UserHideAllMailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_Login2Passwords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _Bridge_SetUserRepairMime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRepairMimeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).Login2Passwords(ctx, in)
+		return srv.(BridgeServer).SetUserRepairMime(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_Login2Passwords_FullMethodName,
+		FullMethod: Bridge_SetUserRepairMime_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).Login2Passwords(ctx, req.(*LoginRequest))
+		return srv.(BridgeServer).SetUserRepairMime(ctx, req.(*UserRepairMimeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_LoginAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginAbortRequest)
+func _Bridge_SetUserReadReceiptMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserReadReceiptModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).LoginAbort(ctx, in)
+		return srv.(BridgeServer).SetUserReadReceiptMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_LoginAbort_FullMethodName,
+		FullMethod: Bridge_SetUserReadReceiptMode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).LoginAbort(ctx, req.(*LoginAbortRequest))
+		return srv.(BridgeServer).SetUserReadReceiptMode(ctx, req.(*UserReadReceiptModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_CheckUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_SendUserReadReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserReadReceiptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).CheckUpdate(ctx, in)
+		return srv.(BridgeServer).SendUserReadReceipt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_CheckUpdate_FullMethodName,
+		FullMethod: Bridge_SendUserReadReceipt_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).CheckUpdate(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).SendUserReadReceipt(ctx, req.(*UserReadReceiptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_InstallUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_SetUserSyncCutoff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSyncCutoffRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).InstallUpdate(ctx, in)
+		return srv.(BridgeServer).SetUserSyncCutoff(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_InstallUpdate_FullMethodName,
+		FullMethod: Bridge_SetUserSyncCutoff_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).InstallUpdate(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).SetUserSyncCutoff(ctx, req.(*UserSyncCutoffRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetIsAutomaticUpdateOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.BoolValue)
+func _Bridge_SendBadEventUserFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserBadEventFeedbackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetIsAutomaticUpdateOn(ctx, in)
+		return srv.(BridgeServer).SendBadEventUserFeedback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetIsAutomaticUpdateOn_FullMethodName,
+		FullMethod: Bridge_SendBadEventUserFeedback_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetIsAutomaticUpdateOn(ctx, req.(*wrapperspb.BoolValue))
+		return srv.(BridgeServer).SendBadEventUserFeedback(ctx, req.(*UserBadEventFeedbackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_IsAutomaticUpdateOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_LogoutUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).IsAutomaticUpdateOn(ctx, in)
+		return srv.(BridgeServer).LogoutUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_IsAutomaticUpdateOn_FullMethodName,
+		FullMethod: Bridge_LogoutUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).IsAutomaticUpdateOn(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).LogoutUser(ctx, req.(*wrapperspb.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_DiskCachePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_PrioritizeUserSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).DiskCachePath(ctx, in)
+		return srv.(BridgeServer).PrioritizeUserSync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_DiskCachePath_FullMethodName,
+		FullMethod: Bridge_PrioritizeUserSync_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).DiskCachePath(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).PrioritizeUserSync(ctx, req.(*wrapperspb.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetDiskCachePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_RemoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetDiskCachePath(ctx, in)
+		return srv.(BridgeServer).RemoveUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetDiskCachePath_FullMethodName,
+		FullMethod: Bridge_RemoveUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetDiskCachePath(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).RemoveUser(ctx, req.(*wrapperspb.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetIsDoHEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.BoolValue)
+func _Bridge_RemoveUserKeepData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetIsDoHEnabled(ctx, in)
+		return srv.(BridgeServer).RemoveUserKeepData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetIsDoHEnabled_FullMethodName,
+		FullMethod: Bridge_RemoveUserKeepData_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetIsDoHEnabled(ctx, req.(*wrapperspb.BoolValue))
+		return srv.(BridgeServer).RemoveUserKeepData(ctx, req.(*wrapperspb.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_IsDoHEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_ConfigureUserAppleMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureAppleMailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).IsDoHEnabled(ctx, in)
+		return srv.(BridgeServer).ConfigureUserAppleMail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_IsDoHEnabled_FullMethodName,
+		FullMethod: Bridge_ConfigureUserAppleMail_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).IsDoHEnabled(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).ConfigureUserAppleMail(ctx, req.(*ConfigureAppleMailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_MailServerSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_UserClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).MailServerSettings(ctx, in)
+		return srv.(BridgeServer).UserClientConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_MailServerSettings_FullMethodName,
+		FullMethod: Bridge_UserClientConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).MailServerSettings(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).UserClientConfig(ctx, req.(*ClientConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetMailServerSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImapSmtpSettings)
+func _Bridge_StartAutoconfigServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetMailServerSettings(ctx, in)
+		return srv.(BridgeServer).StartAutoconfigServer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetMailServerSettings_FullMethodName,
+		FullMethod: Bridge_StartAutoconfigServer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetMailServerSettings(ctx, req.(*ImapSmtpSettings))
+		return srv.(BridgeServer).StartAutoconfigServer(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_Hostname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_UserAppPasswords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).Hostname(ctx, in)
+		return srv.(BridgeServer).UserAppPasswords(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_Hostname_FullMethodName,
+		FullMethod: Bridge_UserAppPasswords_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).Hostname(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).UserAppPasswords(ctx, req.(*wrapperspb.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_IsPortFree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.Int32Value)
+func _Bridge_CreateUserAppPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppPasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).IsPortFree(ctx, in)
+		return srv.(BridgeServer).CreateUserAppPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_IsPortFree_FullMethodName,
+		FullMethod: Bridge_CreateUserAppPassword_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).IsPortFree(ctx, req.(*wrapperspb.Int32Value))
+		return srv.(BridgeServer).CreateUserAppPassword(ctx, req.(*AppPasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_AvailableKeychains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_RevokeUserAppPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppPasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).AvailableKeychains(ctx, in)
+		return srv.(BridgeServer).RevokeUserAppPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_AvailableKeychains_FullMethodName,
+		FullMethod: Bridge_RevokeUserAppPassword_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).AvailableKeychains(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).RevokeUserAppPassword(ctx, req.(*AppPasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetCurrentKeychain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.StringValue)
+func _Bridge_CreateUserAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetCurrentKeychain(ctx, in)
+		return srv.(BridgeServer).CreateUserAuthToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetCurrentKeychain_FullMethodName,
+		FullMethod: Bridge_CreateUserAuthToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetCurrentKeychain(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).CreateUserAuthToken(ctx, req.(*AuthTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_CurrentKeychain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_UserEventJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserEventJournalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).CurrentKeychain(ctx, in)
+		return srv.(BridgeServer).UserEventJournal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_CurrentKeychain_FullMethodName,
+		FullMethod: Bridge_UserEventJournal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).CurrentKeychain(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).UserEventJournal(ctx, req.(*UserEventJournalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_GetUserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_IsTLSCertificateInstalled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).GetUserList(ctx, in)
+		return srv.(BridgeServer).IsTLSCertificateInstalled(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_GetUserList_FullMethodName,
+		FullMethod: Bridge_IsTLSCertificateInstalled_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).GetUserList(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).IsTLSCertificateInstalled(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.StringValue)
+func _Bridge_InstallTLSCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).GetUser(ctx, in)
+		return srv.(BridgeServer).InstallTLSCertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_GetUser_FullMethodName,
+		FullMethod: Bridge_InstallTLSCertificate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).GetUser(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).InstallTLSCertificate(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetUserSplitMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserSplitModeRequest)
+func _Bridge_ExportTLSCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetUserSplitMode(ctx, in)
+		return srv.(BridgeServer).ExportTLSCertificates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetUserSplitMode_FullMethodName,
+		FullMethod: Bridge_ExportTLSCertificates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetUserSplitMode(ctx, req.(*UserSplitModeRequest))
+		return srv.(BridgeServer).ExportTLSCertificates(ctx, req.(*wrapperspb.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SendBadEventUserFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserBadEventFeedbackRequest)
+func _Bridge_TLSCertificatePaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SendBadEventUserFeedback(ctx, in)
+		return srv.(BridgeServer).TLSCertificatePaths(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SendBadEventUserFeedback_FullMethodName,
+		FullMethod: Bridge_TLSCertificatePaths_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SendBadEventUserFeedback(ctx, req.(*UserBadEventFeedbackRequest))
+		return srv.(BridgeServer).TLSCertificatePaths(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_LogoutUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.StringValue)
+func _Bridge_SetTLSCertificatePaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSCertificateFiles)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).LogoutUser(ctx, in)
+		return srv.(BridgeServer).SetTLSCertificatePaths(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_LogoutUser_FullMethodName,
+		FullMethod: Bridge_SetTLSCertificatePaths_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).LogoutUser(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).SetTLSCertificatePaths(ctx, req.(*TLSCertificateFiles))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_RemoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_SetTLSCertificateDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).RemoveUser(ctx, in)
+		return srv.(BridgeServer).SetTLSCertificateDir(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_RemoveUser_FullMethodName,
+		FullMethod: Bridge_SetTLSCertificateDir_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).RemoveUser(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).SetTLSCertificateDir(ctx, req.(*wrapperspb.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_ConfigureUserAppleMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigureAppleMailRequest)
+func _Bridge_ResetTLSCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).ConfigureUserAppleMail(ctx, in)
+		return srv.(BridgeServer).ResetTLSCertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_ConfigureUserAppleMail_FullMethodName,
+		FullMethod: Bridge_ResetTLSCertificate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).ConfigureUserAppleMail(ctx, req.(*ConfigureAppleMailRequest))
+		return srv.(BridgeServer).ResetTLSCertificate(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_IsTLSCertificateInstalled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_Webhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).IsTLSCertificateInstalled(ctx, in)
+		return srv.(BridgeServer).Webhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_IsTLSCertificateInstalled_FullMethodName,
+		FullMethod: Bridge_Webhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).IsTLSCertificateInstalled(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).Webhooks(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_InstallTLSCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Bridge_AddWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).InstallTLSCertificate(ctx, in)
+		return srv.(BridgeServer).AddWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_InstallTLSCertificate_FullMethodName,
+		FullMethod: Bridge_AddWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).InstallTLSCertificate(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).AddWebhook(ctx, req.(*AddWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_ExportTLSCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_RemoveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).ExportTLSCertificates(ctx, in)
+		return srv.(BridgeServer).RemoveWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_ExportTLSCertificates_FullMethodName,
+		FullMethod: Bridge_RemoveWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).ExportTLSCertificates(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).RemoveWebhook(ctx, req.(*wrapperspb.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2119,6 +4310,27 @@ func _Bridge_StopEventStream_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bridge_SubscribeBridgeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BridgeEventFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BridgeServer).SubscribeBridgeEvents(m, &bridgeSubscribeBridgeEventsServer{stream})
+}
+
+type Bridge_SubscribeBridgeEventsServer interface {
+	Send(*BridgeEvent) error
+	grpc.ServerStream
+}
+
+type bridgeSubscribeBridgeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bridgeSubscribeBridgeEventsServer) Send(m *BridgeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Bridge_TriggerRepair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -2144,6 +4356,10 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "grpc.Bridge",
 	HandlerType: (*BridgeServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServiceInfo",
+			Handler:    _Bridge_GetServiceInfo_Handler,
+		},
 		{
 			MethodName: "CheckTokens",
 			Handler:    _Bridge_CheckTokens_Handler,
@@ -2152,6 +4368,38 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddLogEntry",
 			Handler:    _Bridge_AddLogEntry_Handler,
 		},
+		{
+			MethodName: "LogLevels",
+			Handler:    _Bridge_LogLevels_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Bridge_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "Traces",
+			Handler:    _Bridge_Traces_Handler,
+		},
+		{
+			MethodName: "StartTrace",
+			Handler:    _Bridge_StartTrace_Handler,
+		},
+		{
+			MethodName: "StopTrace",
+			Handler:    _Bridge_StopTrace_Handler,
+		},
+		{
+			MethodName: "SetIsSessionAuditLogEnabled",
+			Handler:    _Bridge_SetIsSessionAuditLogEnabled_Handler,
+		},
+		{
+			MethodName: "IsSessionAuditLogEnabled",
+			Handler:    _Bridge_IsSessionAuditLogEnabled_Handler,
+		},
+		{
+			MethodName: "RecentIMAPSessions",
+			Handler:    _Bridge_RecentIMAPSessions_Handler,
+		},
 		{
 			MethodName: "GuiReady",
 			Handler:    _Bridge_GuiReady_Handler,
@@ -2192,6 +4440,22 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsAllMailVisible",
 			Handler:    _Bridge_IsAllMailVisible_Handler,
 		},
+		{
+			MethodName: "SetIsIPCOverTCP",
+			Handler:    _Bridge_SetIsIPCOverTCP_Handler,
+		},
+		{
+			MethodName: "IsIPCOverTCP",
+			Handler:    _Bridge_IsIPCOverTCP_Handler,
+		},
+		{
+			MethodName: "SetIsReauthOnDeauthEnabled",
+			Handler:    _Bridge_SetIsReauthOnDeauthEnabled_Handler,
+		},
+		{
+			MethodName: "IsReauthOnDeauthEnabled",
+			Handler:    _Bridge_IsReauthOnDeauthEnabled_Handler,
+		},
 		{
 			MethodName: "SetIsTelemetryDisabled",
 			Handler:    _Bridge_SetIsTelemetryDisabled_Handler,
@@ -2200,6 +4464,14 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsTelemetryDisabled",
 			Handler:    _Bridge_IsTelemetryDisabled_Handler,
 		},
+		{
+			MethodName: "SetIsReportingDisabled",
+			Handler:    _Bridge_SetIsReportingDisabled_Handler,
+		},
+		{
+			MethodName: "IsReportingDisabled",
+			Handler:    _Bridge_IsReportingDisabled_Handler,
+		},
 		{
 			MethodName: "GoOs",
 			Handler:    _Bridge_GoOs_Handler,
@@ -2248,6 +4520,14 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportBug",
 			Handler:    _Bridge_ReportBug_Handler,
 		},
+		{
+			MethodName: "CollectDiagnostics",
+			Handler:    _Bridge_CollectDiagnostics_Handler,
+		},
+		{
+			MethodName: "CheckConfiguration",
+			Handler:    _Bridge_CheckConfiguration_Handler,
+		},
 		{
 			MethodName: "ForceLauncher",
 			Handler:    _Bridge_ForceLauncher_Handler,
@@ -2268,6 +4548,14 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login2FA",
 			Handler:    _Bridge_Login2FA_Handler,
 		},
+		{
+			MethodName: "LoginFido2",
+			Handler:    _Bridge_LoginFido2_Handler,
+		},
+		{
+			MethodName: "LoginFido2Local",
+			Handler:    _Bridge_LoginFido2Local_Handler,
+		},
 		{
 			MethodName: "Login2Passwords",
 			Handler:    _Bridge_Login2Passwords_Handler,
@@ -2284,6 +4572,10 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InstallUpdate",
 			Handler:    _Bridge_InstallUpdate_Handler,
 		},
+		{
+			MethodName: "RollbackUpdate",
+			Handler:    _Bridge_RollbackUpdate_Handler,
+		},
 		{
 			MethodName: "SetIsAutomaticUpdateOn",
 			Handler:    _Bridge_SetIsAutomaticUpdateOn_Handler,
@@ -2300,6 +4592,38 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetDiskCachePath",
 			Handler:    _Bridge_SetDiskCachePath_Handler,
 		},
+		{
+			MethodName: "DiskCacheMaxSize",
+			Handler:    _Bridge_DiskCacheMaxSize_Handler,
+		},
+		{
+			MethodName: "SetDiskCacheMaxSize",
+			Handler:    _Bridge_SetDiskCacheMaxSize_Handler,
+		},
+		{
+			MethodName: "DiskCacheSize",
+			Handler:    _Bridge_DiskCacheSize_Handler,
+		},
+		{
+			MethodName: "DiskCacheKeepRecentDays",
+			Handler:    _Bridge_DiskCacheKeepRecentDays_Handler,
+		},
+		{
+			MethodName: "SetDiskCacheKeepRecentDays",
+			Handler:    _Bridge_SetDiskCacheKeepRecentDays_Handler,
+		},
+		{
+			MethodName: "MaxSyncMemory",
+			Handler:    _Bridge_MaxSyncMemory_Handler,
+		},
+		{
+			MethodName: "SetMaxSyncMemory",
+			Handler:    _Bridge_SetMaxSyncMemory_Handler,
+		},
+		{
+			MethodName: "MemoryUsage",
+			Handler:    _Bridge_MemoryUsage_Handler,
+		},
 		{
 			MethodName: "SetIsDoHEnabled",
 			Handler:    _Bridge_SetIsDoHEnabled_Handler,
@@ -2316,10 +4640,34 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetMailServerSettings",
 			Handler:    _Bridge_SetMailServerSettings_Handler,
 		},
+		{
+			MethodName: "ImplicitTLSPorts",
+			Handler:    _Bridge_ImplicitTLSPorts_Handler,
+		},
+		{
+			MethodName: "SetImplicitTLSPorts",
+			Handler:    _Bridge_SetImplicitTLSPorts_Handler,
+		},
+		{
+			MethodName: "SSLRequired",
+			Handler:    _Bridge_SSLRequired_Handler,
+		},
+		{
+			MethodName: "SetSSLRequired",
+			Handler:    _Bridge_SetSSLRequired_Handler,
+		},
 		{
 			MethodName: "Hostname",
 			Handler:    _Bridge_Hostname_Handler,
 		},
+		{
+			MethodName: "BindAddress",
+			Handler:    _Bridge_BindAddress_Handler,
+		},
+		{
+			MethodName: "SetBindAddress",
+			Handler:    _Bridge_SetBindAddress_Handler,
+		},
 		{
 			MethodName: "IsPortFree",
 			Handler:    _Bridge_IsPortFree_Handler,
@@ -2336,6 +4684,46 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentKeychain",
 			Handler:    _Bridge_CurrentKeychain_Handler,
 		},
+		{
+			MethodName: "IsCredentialStoreUnavailable",
+			Handler:    _Bridge_IsCredentialStoreUnavailable_Handler,
+		},
+		{
+			MethodName: "MigrateToFileVault",
+			Handler:    _Bridge_MigrateToFileVault_Handler,
+		},
+		{
+			MethodName: "AutoLockTimeout",
+			Handler:    _Bridge_AutoLockTimeout_Handler,
+		},
+		{
+			MethodName: "SetAutoLockTimeout",
+			Handler:    _Bridge_SetAutoLockTimeout_Handler,
+		},
+		{
+			MethodName: "HasUnlockPassphrase",
+			Handler:    _Bridge_HasUnlockPassphrase_Handler,
+		},
+		{
+			MethodName: "SetUnlockPassphrase",
+			Handler:    _Bridge_SetUnlockPassphrase_Handler,
+		},
+		{
+			MethodName: "IsLocked",
+			Handler:    _Bridge_IsLocked_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _Bridge_Lock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _Bridge_Unlock_Handler,
+		},
+		{
+			MethodName: "RotateEncryptionKeys",
+			Handler:    _Bridge_RotateEncryptionKeys_Handler,
+		},
 		{
 			MethodName: "GetUserList",
 			Handler:    _Bridge_GetUserList_Handler,
@@ -2348,6 +4736,26 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetUserSplitMode",
 			Handler:    _Bridge_SetUserSplitMode_Handler,
 		},
+		{
+			MethodName: "SetUserHideAllMail",
+			Handler:    _Bridge_SetUserHideAllMail_Handler,
+		},
+		{
+			MethodName: "SetUserRepairMime",
+			Handler:    _Bridge_SetUserRepairMime_Handler,
+		},
+		{
+			MethodName: "SetUserReadReceiptMode",
+			Handler:    _Bridge_SetUserReadReceiptMode_Handler,
+		},
+		{
+			MethodName: "SendUserReadReceipt",
+			Handler:    _Bridge_SendUserReadReceipt_Handler,
+		},
+		{
+			MethodName: "SetUserSyncCutoff",
+			Handler:    _Bridge_SetUserSyncCutoff_Handler,
+		},
 		{
 			MethodName: "SendBadEventUserFeedback",
 			Handler:    _Bridge_SendBadEventUserFeedback_Handler,
@@ -2356,14 +4764,50 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LogoutUser",
 			Handler:    _Bridge_LogoutUser_Handler,
 		},
+		{
+			MethodName: "PrioritizeUserSync",
+			Handler:    _Bridge_PrioritizeUserSync_Handler,
+		},
 		{
 			MethodName: "RemoveUser",
 			Handler:    _Bridge_RemoveUser_Handler,
 		},
+		{
+			MethodName: "RemoveUserKeepData",
+			Handler:    _Bridge_RemoveUserKeepData_Handler,
+		},
 		{
 			MethodName: "ConfigureUserAppleMail",
 			Handler:    _Bridge_ConfigureUserAppleMail_Handler,
 		},
+		{
+			MethodName: "UserClientConfig",
+			Handler:    _Bridge_UserClientConfig_Handler,
+		},
+		{
+			MethodName: "StartAutoconfigServer",
+			Handler:    _Bridge_StartAutoconfigServer_Handler,
+		},
+		{
+			MethodName: "UserAppPasswords",
+			Handler:    _Bridge_UserAppPasswords_Handler,
+		},
+		{
+			MethodName: "CreateUserAppPassword",
+			Handler:    _Bridge_CreateUserAppPassword_Handler,
+		},
+		{
+			MethodName: "RevokeUserAppPassword",
+			Handler:    _Bridge_RevokeUserAppPassword_Handler,
+		},
+		{
+			MethodName: "CreateUserAuthToken",
+			Handler:    _Bridge_CreateUserAuthToken_Handler,
+		},
+		{
+			MethodName: "UserEventJournal",
+			Handler:    _Bridge_UserEventJournal_Handler,
+		},
 		{
 			MethodName: "IsTLSCertificateInstalled",
 			Handler:    _Bridge_IsTLSCertificateInstalled_Handler,
@@ -2376,6 +4820,34 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportTLSCertificates",
 			Handler:    _Bridge_ExportTLSCertificates_Handler,
 		},
+		{
+			MethodName: "TLSCertificatePaths",
+			Handler:    _Bridge_TLSCertificatePaths_Handler,
+		},
+		{
+			MethodName: "SetTLSCertificatePaths",
+			Handler:    _Bridge_SetTLSCertificatePaths_Handler,
+		},
+		{
+			MethodName: "SetTLSCertificateDir",
+			Handler:    _Bridge_SetTLSCertificateDir_Handler,
+		},
+		{
+			MethodName: "ResetTLSCertificate",
+			Handler:    _Bridge_ResetTLSCertificate_Handler,
+		},
+		{
+			MethodName: "Webhooks",
+			Handler:    _Bridge_Webhooks_Handler,
+		},
+		{
+			MethodName: "AddWebhook",
+			Handler:    _Bridge_AddWebhook_Handler,
+		},
+		{
+			MethodName: "RemoveWebhook",
+			Handler:    _Bridge_RemoveWebhook_Handler,
+		},
 		{
 			MethodName: "StopEventStream",
 			Handler:    _Bridge_StopEventStream_Handler,
@@ -2391,6 +4863,11 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Bridge_RunEventStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeBridgeEvents",
+			Handler:       _Bridge_SubscribeBridgeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "bridge.proto",
 }
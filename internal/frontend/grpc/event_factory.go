@@ -18,7 +18,10 @@
 package grpc
 
 import (
+	"time"
+
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/fido"
 	"github.com/ProtonMail/proton-bridge/v3/internal/kb"
 	"github.com/bradenaw/juniper/xslices"
 )
@@ -89,6 +92,16 @@ func NewLoginTfaRequestedEvent(username string) *StreamEvent {
 	return loginEvent(&LoginEvent{Event: &LoginEvent_TfaRequested{TfaRequested: &LoginTfaRequestedEvent{Username: username}}})
 }
 
+func NewLoginFido2RequestedEvent(username string, challenge *fido.Challenge, totpAvailable bool) *StreamEvent {
+	return loginEvent(&LoginEvent{Event: &LoginEvent_Fido2Requested{Fido2Requested: &LoginFido2RequestedEvent{
+		Username:       username,
+		RelyingPartyID: challenge.RelyingPartyID,
+		ClientDataHash: challenge.ClientDataHash(),
+		CredentialIDs:  challenge.CredentialIDs,
+		TotpAvailable:  totpAvailable,
+	}}})
+}
+
 func NewLoginTwoPasswordsRequestedEvent(username string) *StreamEvent {
 	return loginEvent(&LoginEvent{Event: &LoginEvent_TwoPasswordRequested{TwoPasswordRequested: &LoginTwoPasswordsRequestedEvent{Username: username}}})
 }
@@ -185,6 +198,14 @@ func NewKeychainRebuildKeychainEvent() *StreamEvent {
 	return keychainEvent(&KeychainEvent{Event: &KeychainEvent_RebuildKeychain{RebuildKeychain: &RebuildKeychainEvent{}}})
 }
 
+func NewKeychainCredentialStoreUnavailableEvent(err error) *StreamEvent {
+	return keychainEvent(&KeychainEvent{Event: &KeychainEvent_CredentialStoreUnavailable{CredentialStoreUnavailable: &CredentialStoreUnavailableEvent{Error: err.Error()}}})
+}
+
+func NewKeychainCredentialStoreAvailableEvent() *StreamEvent {
+	return keychainEvent(&KeychainEvent{Event: &KeychainEvent_CredentialStoreAvailable{CredentialStoreAvailable: &CredentialStoreAvailableEvent{}}})
+}
+
 func NewMailAddressChangeEvent(email string) *StreamEvent {
 	return mailEvent(&MailEvent{Event: &MailEvent_AddressChanged{AddressChanged: &AddressChangedEvent{Address: email}}})
 }
@@ -197,6 +218,10 @@ func NewMailApiCertIssue() *StreamEvent { //nolint:revive,stylecheck
 	return mailEvent(&MailEvent{Event: &MailEvent_ApiCertIssue{ApiCertIssue: &ApiCertIssueEvent{}}})
 }
 
+func NewMailMessageTooLargeEvent(userID string, size, limit int64) *StreamEvent {
+	return mailEvent(&MailEvent{Event: &MailEvent_MessageTooLarge{MessageTooLarge: &MessageTooLargeEvent{UserID: userID, Size: size, Limit: limit}}})
+}
+
 func NewUserToggleSplitModeFinishedEvent(userID string) *StreamEvent {
 	return userEvent(&UserEvent{Event: &UserEvent_ToggleSplitModeFinished{ToggleSplitModeFinished: &ToggleSplitModeFinishedEvent{UserID: userID}}})
 }
@@ -220,6 +245,30 @@ func NewUsedBytesChangedEvent(userID string, usedBytes uint64) *StreamEvent {
 	}}})
 }
 
+func NewUserStorageAlmostFullEvent(userID string, usedBytes, totalBytes uint64) *StreamEvent {
+	return userEvent(&UserEvent{Event: &UserEvent_StorageAlmostFullEvent{StorageAlmostFullEvent: &UserStorageAlmostFullEvent{
+		UserID:     userID,
+		UsedBytes:  int64(usedBytes),  //nolint:gosec // disable G115
+		TotalBytes: int64(totalBytes), //nolint:gosec // disable G115
+	}}})
+}
+
+func NewUserReauthRequiredEvent(userID, username string) *StreamEvent {
+	return userEvent(&UserEvent{Event: &UserEvent_ReauthRequiredEvent{ReauthRequiredEvent: &UserReauthRequiredEvent{
+		UserID:   userID,
+		Username: username,
+	}}})
+}
+
+func NewUserReadReceiptRequestedEvent(userID, messageID, recipient, subject string) *StreamEvent {
+	return userEvent(&UserEvent{Event: &UserEvent_ReadReceiptRequestedEvent{ReadReceiptRequestedEvent: &UserReadReceiptRequestedEvent{
+		UserID:    userID,
+		MessageID: messageID,
+		Recipient: recipient,
+		Subject:   subject,
+	}}})
+}
+
 func newIMAPLoginFailedEvent(username string) *StreamEvent {
 	return userEvent(&UserEvent{Event: &UserEvent_ImapLoginFailedEvent{ImapLoginFailedEvent: &ImapLoginFailedEvent{Username: username}}})
 }
@@ -253,6 +302,32 @@ func NewAllUsersLoadedEvent() *StreamEvent {
 	return appEvent(&AppEvent{Event: &AppEvent_AllUsersLoaded{AllUsersLoaded: &AllUsersLoadedEvent{}}})
 }
 
+func NewBridgeLockedEvent(autoLocked bool) *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_BridgeLocked{BridgeLocked: &BridgeLockedEvent{AutoLocked: autoLocked}}})
+}
+
+func NewBridgeUnlockedEvent() *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_BridgeUnlocked{BridgeUnlocked: &BridgeUnlockedEvent{}}})
+}
+
+func NewKeyRotationStartedEvent() *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_KeyRotationStarted{KeyRotationStarted: &KeyRotationStartedEvent{}}})
+}
+
+func NewKeyRotationFinishedEvent(err error) *StreamEvent {
+	var errStr string
+
+	if err != nil {
+		errStr = err.Error()
+	}
+
+	return appEvent(&AppEvent{Event: &AppEvent_KeyRotationFinished{KeyRotationFinished: &KeyRotationFinishedEvent{Error: errStr}}})
+}
+
+func NewAPIRateLimitedEvent(retryAfter time.Duration) *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_ApiRateLimited{ApiRateLimited: &APIRateLimitedEvent{RetryAfterSeconds: int64(retryAfter.Seconds())}}})
+}
+
 func NewUserNotificationEvent(event events.UserNotification) *StreamEvent {
 	return appEvent(&AppEvent{Event: &AppEvent_UserNotification{
 		UserNotification: &UserNotificationEvent{
@@ -24,13 +24,9 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
-	"io/fs"
-	"math/rand"
 	"net"
-	"os"
-	"path/filepath"
-	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ProtonMail/gluon/async"
@@ -38,6 +34,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/fido"
 	"github.com/ProtonMail/proton-bridge/v3/internal/hv"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
 	"github.com/ProtonMail/proton-bridge/v3/internal/service"
@@ -45,13 +42,9 @@ import (
 	"github.com/bradenaw/juniper/xslices"
 	"github.com/elastic/go-sysinfo"
 	sysinfotypes "github.com/elastic/go-sysinfo/types"
-	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/metadata"
-	status "google.golang.org/grpc/status"
 )
 
 const (
@@ -66,6 +59,8 @@ type Service struct { // nolint:structcheck
 
 	grpcServer         *grpc.Server //  the gGRPC server
 	listener           net.Listener
+	tokens             *tokenStore
+	clientAPIVersion   atomic.Int32
 	eventStreamCh      chan *StreamEvent
 	eventStreamChMutex sync.RWMutex
 	eventStreamDoneCh  chan struct{}
@@ -86,6 +81,7 @@ type Service struct { // nolint:structcheck
 
 	authClient              *proton.Client
 	auth                    proton.Auth
+	fidoChallenge           *fido.Challenge
 	password                []byte
 	twoPasswordAttemptCount int
 
@@ -118,35 +114,19 @@ func NewService(
 	}
 
 	config := service.Config{
-		Cert:  string(certPEM),
-		Token: uuid.NewString(),
+		Cert: string(certPEM),
 	}
 
-	var listener net.Listener
-	if useFileSocket() {
-		var err error
-		if config.FileSocketPath, err = computeFileSocketPath(); err != nil {
-			logrus.WithError(err).WithError(err).Panic("Could not create gRPC file socket")
-		}
+	listener, err := service.Listen("grpc", useTCP(bridge), &config)
+	if err != nil {
+		logrus.WithError(err).Panic("Could not create gRPC listener")
+	}
 
-		listener, err = net.Listen("unix", config.FileSocketPath)
-		if err != nil {
-			logrus.WithError(err).Panic("Could not create gRPC file socket listener")
-		}
-	} else {
-		var err error
-		listener, err = net.Listen("tcp", "127.0.0.1:0") // Port should be provided by the OS.
-		if err != nil {
-			logrus.WithError(err).Panic("Could not create gRPC listener")
-		}
+	tokens := newTokenStore(time.Now, func(token string) {
+		updateServerConfigFile(locations, config, token)
+	})
 
-		// retrieve the port assigned by the system, so that we can put it in the config file.
-		address, ok := listener.Addr().(*net.TCPAddr)
-		if !ok {
-			return nil, fmt.Errorf("could not retrieve gRPC service listener address")
-		}
-		config.Port = address.Port
-	}
+	config.Token = tokens.pendingToken()
 
 	if path, err := service.SaveGRPCServerConfigFile(locations, &config, serverConfigFileName); err != nil {
 		logrus.WithError(err).WithField("path", path).Panic("Could not write gRPC service config file")
@@ -157,10 +137,11 @@ func NewService(
 	s := &Service{
 		grpcServer: grpc.NewServer(
 			grpc.Creds(credentials.NewTLS(tlsConfig)),
-			grpc.UnaryInterceptor(newUnaryTokenValidator(config.Token)),
-			grpc.StreamInterceptor(newStreamTokenValidator(config.Token)),
+			grpc.ChainUnaryInterceptor(newUnaryTokenValidator(tokens), newUnaryActivityNotifier(bridge)),
+			grpc.StreamInterceptor(newStreamTokenValidator(tokens)),
 		),
 		listener: listener,
+		tokens:   tokens,
 
 		panicHandler: panicHandler,
 		restarter:    restarter,
@@ -225,11 +206,16 @@ func (s *Service) Loop() error {
 		s.watchEvents()
 	}()
 
-	s.log.WithField("useFileSocket", useFileSocket()).Info("Starting gRPC server")
+	s.log.WithField("address", s.listener.Addr()).Info("Starting gRPC server")
 
 	doneCh := make(chan struct{})
 	defer close(doneCh)
 
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+		s.rotateServerToken(doneCh)
+	}()
+
 	go func() {
 		defer async.HandlePanic(s.panicHandler)
 
@@ -278,6 +264,9 @@ func (s *Service) watchEvents() {
 		case events.ConnStatusDown:
 			_ = s.SendEvent(NewInternetStatusEvent(false))
 
+		case events.APIRateLimited:
+			_ = s.SendEvent(NewAPIRateLimitedEvent(event.RetryAfter))
+
 		case events.IMAPServerError:
 			_ = s.SendEvent(NewMailServerSettingsErrorEvent(MailServerSettingsErrorType_IMAP_PORT_STARTUP_ERROR))
 
@@ -323,9 +312,15 @@ func (s *Service) watchEvents() {
 		case events.UsedSpaceChanged:
 			_ = s.SendEvent(NewUsedBytesChangedEvent(event.UserID, event.UsedSpace))
 
+		case events.UserStorageAlmostFull:
+			_ = s.SendEvent(NewUserStorageAlmostFullEvent(event.UserID, event.UsedSpace, event.MaxSpace))
+
 		case events.IMAPLoginFailed:
 			_ = s.SendEvent(newIMAPLoginFailedEvent(event.Username))
 
+		case events.MessageTooLarge:
+			_ = s.SendEvent(NewMailMessageTooLargeEvent(event.UserID, event.Size, event.Limit))
+
 		case events.UserDeauth:
 			// This is the event the GUI cares about.
 			_ = s.SendEvent(NewUserChangedEvent(event.UserID))
@@ -335,6 +330,12 @@ func (s *Service) watchEvents() {
 				_ = s.SendEvent(NewUserDisconnectedEvent(user.Username))
 			}
 
+		case events.UserReauthRequired:
+			_ = s.SendEvent(NewUserReauthRequiredEvent(event.UserID, event.Username))
+
+		case events.UserReadReceiptRequested:
+			_ = s.SendEvent(NewUserReadReceiptRequestedEvent(event.UserID, event.MessageID, event.Recipient, event.Subject))
+
 		case events.UserBadEvent:
 			_ = s.SendEvent(NewUserBadEvent(event.UserID, event.Error.Error()))
 
@@ -405,6 +406,24 @@ func (s *Service) watchEvents() {
 		case events.AllUsersLoaded:
 			_ = s.SendEvent(NewAllUsersLoadedEvent())
 
+		case events.BridgeLocked:
+			_ = s.SendEvent(NewBridgeLockedEvent(event.AutoLocked))
+
+		case events.BridgeUnlocked:
+			_ = s.SendEvent(NewBridgeUnlockedEvent())
+
+		case events.KeyRotationStarted:
+			_ = s.SendEvent(NewKeyRotationStartedEvent())
+
+		case events.CredentialStoreUnavailable:
+			_ = s.SendEvent(NewKeychainCredentialStoreUnavailableEvent(event.Error))
+
+		case events.CredentialStoreAvailable:
+			_ = s.SendEvent(NewKeychainCredentialStoreAvailableEvent())
+
+		case events.KeyRotationFinished:
+			_ = s.SendEvent(NewKeyRotationFinishedEvent(event.Error))
+
 		case events.UserNotification:
 			_ = s.SendEvent(NewUserNotificationEvent(event))
 		}
@@ -418,6 +437,7 @@ func (s *Service) loginAbort() {
 func (s *Service) loginClean() {
 	s.auth = proton.Auth{}
 	s.authClient = nil
+	s.fidoChallenge = nil
 	for i := range s.password {
 		s.password[i] = '\x00'
 	}
@@ -559,33 +579,39 @@ func newTLSConfig() (*tls.Config, []byte, error) {
 	}, certPEM, nil
 }
 
-// validateServerToken verify that the server token provided by the client is valid.
-func validateServerToken(ctx context.Context, wantToken string) error {
-	values, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return status.Error(codes.Unauthenticated, "missing server token")
-	}
+// updateServerConfigFile writes the new server token to the config file.
+func updateServerConfigFile(locations service.Locator, config service.Config, token string) {
+	config.Token = token
 
-	token := values.Get(serverTokenMetadataKey)
-	if len(token) == 0 {
-		return status.Error(codes.Unauthenticated, "missing server token")
+	if path, err := service.SaveGRPCServerConfigFile(locations, &config, serverConfigFileName); err != nil {
+		logrus.WithError(err).WithField("path", path).Error("Could not write gRPC service config file")
 	}
+}
 
-	if len(token) > 1 {
-		return status.Error(codes.Unauthenticated, "more than one server token was provided")
-	}
+// rotateServerToken replaces the unused server token at regular intervals and expires the tokens of gone frontends.
+// It stops when the service quits or stops serving.
+func (s *Service) rotateServerToken(doneCh <-chan struct{}) {
+	ticker := time.NewTicker(serverTokenValidity / 10)
+	defer ticker.Stop()
 
-	if token[0] != wantToken {
-		return status.Error(codes.Unauthenticated, "invalid server token")
-	}
+	for {
+		select {
+		case <-s.quitCh:
+			return
 
-	return nil
+		case <-doneCh:
+			return
+
+		case <-ticker.C:
+			s.tokens.rotate()
+		}
+	}
 }
 
 // newUnaryTokenValidator checks the server token for every unary gRPC call.
-func newUnaryTokenValidator(wantToken string) grpc.UnaryServerInterceptor {
-	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if err := validateServerToken(ctx, wantToken); err != nil {
+func newUnaryTokenValidator(tokens *tokenStore) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, err := tokens.validate(ctx, info.FullMethod); err != nil {
 			return nil, err
 		}
 
@@ -593,13 +619,26 @@ func newUnaryTokenValidator(wantToken string) grpc.UnaryServerInterceptor {
 	}
 }
 
+// newUnaryActivityNotifier returns an interceptor telling bridge it is in use, which postpones auto-lock.
+func newUnaryActivityNotifier(bridge *bridge.Bridge) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		bridge.NotifyActivity()
+
+		return handler(ctx, req)
+	}
+}
+
 // newStreamTokenValidator checks the server token for every gRPC stream request.
-func newStreamTokenValidator(wantToken string) grpc.StreamServerInterceptor {
-	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		if err := validateServerToken(stream.Context(), wantToken); err != nil {
+func newStreamTokenValidator(tokens *tokenStore) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		token, err := tokens.validate(stream.Context(), info.FullMethod)
+		if err != nil {
 			return err
 		}
 
+		tokens.openStream(token)
+		defer tokens.closeStream(token)
+
 		return handler(srv, stream)
 	}
 }
@@ -655,28 +694,7 @@ func (s *Service) handleHvRequest(err error) {
 	_ = s.SendEvent(NewLoginHvRequestedEvent(hvChallengeURL))
 }
 
-// computeFileSocketPath Return an available path for a socket file in the temp folder.
-func computeFileSocketPath() (string, error) {
-	tempPath := os.TempDir()
-	for i := 0; i < 1000; i++ {
-		path := filepath.Join(tempPath, fmt.Sprintf("bridge%04d", rand.Intn(10000))) // nolint:gosec
-		if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
-			return path, nil
-		}
-
-		if err := os.Remove(path); err != nil {
-			logrus.WithField("path", path).WithError(err).Warning("Could not remove existing socket file")
-			continue
-		}
-
-		return path, nil
-	}
-
-	return "", errors.New("unable to find a suitable file socket in user config folder")
-}
-
-// useFileSocket return true iff file socket should be used for the gRPC service.
-func useFileSocket() bool {
-	//goland:noinspection GoBoolExpressions
-	return runtime.GOOS != "windows"
+// useTCP returns true iff the gRPC service should listen on a TCP port rather than a Unix domain socket.
+func useTCP(bridge *bridge.Bridge) bool {
+	return bridge.GetIPCOverTCP()
 }
@@ -25,6 +25,8 @@ import (
 
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -79,3 +81,52 @@ func (s *Service) ExportTLSCertificates(_ context.Context, folderPath *wrappersp
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *Service) TLSCertificatePaths(context.Context, *emptypb.Empty) (*TLSCertificateFiles, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("TLSCertificatePaths")
+
+	certPath, keyPath := s.bridge.GetBridgeTLSCertPath()
+
+	return &TLSCertificateFiles{CertPath: certPath, KeyPath: keyPath}, nil
+}
+
+func (s *Service) SetTLSCertificatePaths(_ context.Context, paths *TLSCertificateFiles) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("certPath", paths.CertPath).WithField("keyPath", paths.KeyPath).Info("SetTLSCertificatePaths")
+
+	if err := s.bridge.SetBridgeTLSCertPath(paths.CertPath, paths.KeyPath); err != nil {
+		s.log.WithError(err).Error("Failed to set TLS certificate")
+		return nil, status.Errorf(codes.InvalidArgument, "failed to set TLS certificate: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) SetTLSCertificateDir(_ context.Context, dir *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("dir", dir.Value).Info("SetTLSCertificateDir")
+
+	if err := s.bridge.SetBridgeTLSCertDir(dir.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set TLS certificate")
+		return nil, status.Errorf(codes.InvalidArgument, "failed to set TLS certificate: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) ResetTLSCertificate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Info("ResetTLSCertificate")
+
+	if err := s.bridge.ClearBridgeTLSCertPath(); err != nil {
+		s.log.WithError(err).Error("Failed to reset TLS certificate")
+		return nil, status.Errorf(codes.Internal, "failed to reset TLS certificate: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/ProtonMail/gluon/async"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+const (
+	// apiVersion is the version of the gRPC API implemented by bridge.
+	// It must be increased each time RPCs or stream events are added, and the new events registered in eventAPIVersion.
+	apiVersion = 13
+
+	// minClientAPIVersion is the oldest frontend API version bridge still supports.
+	minClientAPIVersion = 1
+
+	// legacyClientAPIVersion is assumed for frontends that don't call GetServiceInfo, such as older bridge GUIs.
+	legacyClientAPIVersion = 1
+)
+
+// serviceFeatures lists the optional features frontends can rely on instead of guessing from the bridge version.
+var serviceFeatures = []string{ //nolint:gochecknoglobals
+	"appPasswords",
+	"authTokens",
+	"autoLock",
+	"bindAddress",
+	"bridgeEvents",
+	"clientConfig",
+	"configurationCheck",
+	"credentialStoreDegradedMode",
+	"diagnostics",
+	"diskCacheLimit",
+	"eventJournal",
+	"fido2Login",
+	"fido2LocalKey",
+	"implicitTLS",
+	"ipcOverTCP",
+	"keepDataOnRemove",
+	"keepRecentMail",
+	"keyRotation",
+	"logLevels",
+	"readReceipts",
+	"reauthOnDeauth",
+	"sessionAuditLog",
+	"sslRequired",
+	"syncCutoff",
+	"syncMemoryLimit",
+	"tlsCertificateFiles",
+	"traces",
+	"webhooks",
+}
+
+// serviceDeprecations lists the RPCs frontends should stop using.
+var serviceDeprecations = []*DeprecationHint{ //nolint:gochecknoglobals
+	{Rpc: "ColorSchemeName", Reason: "the color scheme should be managed by the frontend"},
+	{Rpc: "SetColorSchemeName", Reason: "the color scheme should be managed by the frontend"},
+}
+
+// GetServiceInfo returns the API version and features of the service, and records the API version of the frontend so
+// that bridge doesn't send it stream events it doesn't know about.
+func (s *Service) GetServiceInfo(_ context.Context, request *ServiceInfoRequest) (*ServiceInfo, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("clientName", request.ClientName).WithField("clientAPIVersion", request.ClientApiVersion).Info("GetServiceInfo")
+
+	clientAPIVersion := request.ClientApiVersion
+	if clientAPIVersion == 0 {
+		clientAPIVersion = legacyClientAPIVersion
+	}
+
+	if clientAPIVersion < minClientAPIVersion {
+		return nil, status.Errorf(codes.FailedPrecondition, "frontend API version %v is not supported anymore, the oldest supported version is %v", clientAPIVersion, minClientAPIVersion)
+	}
+
+	s.clientAPIVersion.Store(clientAPIVersion)
+
+	return &ServiceInfo{
+		ApiVersion:          apiVersion,
+		MinClientApiVersion: minClientAPIVersion,
+		BridgeVersion:       s.bridge.GetCurrentVersion().Original(),
+		Features:            serviceFeatures,
+		Deprecations:        serviceDeprecations,
+	}, nil
+}
+
+// getClientAPIVersion returns the API version of the frontend.
+func (s *Service) getClientAPIVersion() int32 {
+	if version := s.clientAPIVersion.Load(); version != 0 {
+		return version
+	}
+
+	return legacyClientAPIVersion
+}
+
+// isBlockingEvent returns whether a flow waits for the frontend to act on the given stream event: the login waiting for
+// the security key, the account waiting for the user to sign in again and the message waiting for its read receipt.
+// Such events are sent to all frontends, as an older one logging an unknown event is better than a flow that hangs.
+func isBlockingEvent(event *StreamEvent) bool {
+	switch event := event.Event.(type) {
+	case *StreamEvent_Login:
+		_, ok := event.Login.Event.(*LoginEvent_Fido2Requested)
+		return ok
+
+	case *StreamEvent_User:
+		switch event.User.Event.(type) {
+		case *UserEvent_ReauthRequiredEvent,
+			*UserEvent_ReadReceiptRequestedEvent:
+			return true
+		}
+	}
+
+	return false
+}
+
+// eventAPIVersion returns the API version in which the given stream event was introduced.
+func eventAPIVersion(event *StreamEvent) int32 {
+	switch event := event.Event.(type) {
+	case *StreamEvent_App:
+		switch event.App.Event.(type) {
+		case *AppEvent_BridgeLocked,
+			*AppEvent_BridgeUnlocked,
+			*AppEvent_ApiRateLimited,
+			*AppEvent_KeyRotationStarted,
+			*AppEvent_KeyRotationFinished:
+			return 2
+		}
+
+	case *StreamEvent_Keychain:
+		switch event.Keychain.Event.(type) {
+		case *KeychainEvent_CredentialStoreUnavailable,
+			*KeychainEvent_CredentialStoreAvailable:
+			return 9
+		}
+
+	case *StreamEvent_Login:
+		if _, ok := event.Login.Event.(*LoginEvent_Fido2Requested); ok {
+			return 2
+		}
+
+	case *StreamEvent_Mail:
+		if _, ok := event.Mail.Event.(*MailEvent_MessageTooLarge); ok {
+			return 2
+		}
+
+	case *StreamEvent_User:
+		switch event.User.Event.(type) {
+		case *UserEvent_StorageAlmostFullEvent:
+			return 2
+		case *UserEvent_ReauthRequiredEvent:
+			return 6
+		case *UserEvent_ReadReceiptRequestedEvent:
+			return 7
+		}
+	}
+
+	return 1
+}
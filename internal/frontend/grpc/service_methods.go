@@ -22,6 +22,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"runtime"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gluon/async"
@@ -29,13 +30,17 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/fido"
 	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/theme"
 	"github.com/ProtonMail/proton-bridge/v3/internal/hv"
 	"github.com/ProtonMail/proton-bridge/v3/internal/kb"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
 	"github.com/ProtonMail/proton-bridge/v3/internal/service"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
+	"github.com/bradenaw/juniper/xslices"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -92,6 +97,121 @@ func (s *Service) AddLogEntry(_ context.Context, request *AddLogEntryRequest) (*
 	return &emptypb.Empty{}, nil
 }
 
+// LogLevels implement the LogLevels gRPC service call.
+func (s *Service) LogLevels(_ context.Context, _ *emptypb.Empty) (*LogLevelsResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("LogLevels")
+
+	levels := logging.GetSubsystemLevels()
+
+	res := &LogLevelsResponse{DefaultLevel: grpcLevelFromLogrusLevel(logging.GetLevel())}
+
+	for _, subsystem := range logging.Subsystems {
+		res.Subsystems = append(res.Subsystems, &SubsystemLogLevel{
+			Subsystem: string(subsystem),
+			Level:     grpcLevelFromLogrusLevel(levels[subsystem]),
+		})
+	}
+
+	return res, nil
+}
+
+// SetLogLevel implement the SetLogLevel gRPC service call.
+func (s *Service) SetLogLevel(_ context.Context, request *SetLogLevelRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("subsystem", request.Subsystem).WithField("level", request.Level).WithField("reset", request.ResetToDefault).Debug("SetLogLevel")
+
+	var err error
+
+	switch {
+	case request.Subsystem == "":
+		logging.SetLevel(logrusLevelFromGrpcLevel(request.Level))
+
+	case request.ResetToDefault:
+		err = logging.ResetSubsystemLevel(logging.Subsystem(request.Subsystem))
+
+	default:
+		err = logging.SetSubsystemLevel(logging.Subsystem(request.Subsystem), logrusLevelFromGrpcLevel(request.Level))
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Traces implement the Traces gRPC service call.
+func (s *Service) Traces(_ context.Context, _ *emptypb.Empty) (*TracesResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("Traces")
+
+	res := &TracesResponse{}
+
+	for _, subsystem := range logging.TraceableSubsystems {
+		res.TraceableSubsystems = append(res.TraceableSubsystems, string(subsystem))
+	}
+
+	for subsystem, expiresAt := range logging.GetTraces() {
+		res.Traces = append(res.Traces, &SubsystemTrace{
+			Subsystem: string(subsystem),
+			ExpiresAt: expiresAt.Unix(),
+		})
+	}
+
+	return res, nil
+}
+
+func (s *Service) SetIsSessionAuditLogEnabled(_ context.Context, isEnabled *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("isEnabled", isEnabled.Value).Debug("SetIsSessionAuditLogEnabled")
+
+	if err := s.bridge.SetSessionAuditLog(isEnabled.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set session audit log")
+		return nil, status.Errorf(codes.Internal, "failed to set session audit log: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) IsSessionAuditLogEnabled(_ context.Context, _ *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("IsSessionAuditLogEnabled")
+
+	return wrapperspb.Bool(s.bridge.GetSessionAuditLog()), nil
+}
+
+func (s *Service) RecentIMAPSessions(_ context.Context, _ *emptypb.Empty) (*IMAPSessionsResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("RecentIMAPSessions")
+
+	return &IMAPSessionsResponse{Sessions: xslices.Map(s.bridge.GetRecentIMAPSessions(), grpcIMAPSessionFromBridge)}, nil
+}
+
+// StartTrace implement the StartTrace gRPC service call.
+func (s *Service) StartTrace(_ context.Context, request *StartTraceRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("subsystem", request.Subsystem).WithField("durationMinutes", request.DurationMinutes).Debug("StartTrace")
+
+	if err := logging.StartTrace(logging.Subsystem(request.Subsystem), time.Duration(request.DurationMinutes)*time.Minute); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// StopTrace implement the StopTrace gRPC service call.
+func (s *Service) StopTrace(_ context.Context, subsystem *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("subsystem", subsystem.Value).Debug("StopTrace")
+
+	if err := logging.StopTrace(logging.Subsystem(subsystem.Value)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 // GuiReady implement the GuiReady gRPC service call.
 func (s *Service) GuiReady(_ context.Context, _ *emptypb.Empty) (*GuiReadyResponse, error) {
 	defer async.HandlePanic(s.panicHandler)
@@ -223,6 +343,44 @@ func (s *Service) IsAllMailVisible(_ context.Context, _ *emptypb.Empty) (*wrappe
 	return wrapperspb.Bool(s.bridge.GetShowAllMail()), nil
 }
 
+func (s *Service) SetIsIPCOverTCP(_ context.Context, isOverTCP *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("isOverTCP", isOverTCP.Value).Debug("SetIsIPCOverTCP")
+
+	if err := s.bridge.SetIPCOverTCP(isOverTCP.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set IPC over TCP")
+		return nil, status.Errorf(codes.Internal, "failed to set IPC over TCP: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) IsIPCOverTCP(_ context.Context, _ *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("IsIPCOverTCP")
+
+	return wrapperspb.Bool(s.bridge.GetIPCOverTCP()), nil
+}
+
+func (s *Service) SetIsReauthOnDeauthEnabled(_ context.Context, isEnabled *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("isEnabled", isEnabled.Value).Debug("SetIsReauthOnDeauthEnabled")
+
+	if err := s.bridge.SetReauthOnDeauth(isEnabled.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set re-auth on deauth")
+		return nil, status.Errorf(codes.Internal, "failed to set re-auth on deauth: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) IsReauthOnDeauthEnabled(_ context.Context, _ *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("IsReauthOnDeauthEnabled")
+
+	return wrapperspb.Bool(s.bridge.GetReauthOnDeauth()), nil
+}
+
 func (s *Service) SetIsTelemetryDisabled(_ context.Context, isDisabled *wrapperspb.BoolValue) (*emptypb.Empty, error) {
 	defer async.HandlePanic(s.panicHandler)
 	s.log.WithField("isEnabled", isDisabled.Value).Debug("SetIsTelemetryDisabled")
@@ -242,6 +400,25 @@ func (s *Service) IsTelemetryDisabled(_ context.Context, _ *emptypb.Empty) (*wra
 	return wrapperspb.Bool(s.bridge.GetTelemetryDisabled()), nil
 }
 
+func (s *Service) SetIsReportingDisabled(_ context.Context, isDisabled *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("isDisabled", isDisabled.Value).Debug("SetIsReportingDisabled")
+
+	if err := s.bridge.SetReportingDisabled(isDisabled.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set reporting status")
+		return nil, status.Errorf(codes.Internal, "failed to set reporting status: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) IsReportingDisabled(_ context.Context, _ *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("IsReportingDisabled")
+
+	return wrapperspb.Bool(s.bridge.GetReportingDisabled()), nil
+}
+
 func (s *Service) GoOs(_ context.Context, _ *emptypb.Empty) (*wrapperspb.StringValue, error) {
 	defer async.HandlePanic(s.panicHandler)
 	s.log.Debug("GoOs") // TO-DO We can probably get rid of this and use QSysInfo::product name
@@ -391,6 +568,40 @@ func (s *Service) ReportBug(_ context.Context, report *ReportBugRequest) (*empty
 	return &emptypb.Empty{}, nil
 }
 
+// CollectDiagnostics writes a sanitized diagnostics bundle into the given folder and returns the path of the created file.
+func (s *Service) CollectDiagnostics(_ context.Context, folderPath *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("folderPath", folderPath.Value).Debug("CollectDiagnostics")
+
+	path, err := s.bridge.SaveDiagnostics(folderPath.Value)
+	if err != nil {
+		s.log.WithError(err).Error("Failed to collect diagnostics")
+		return nil, status.Errorf(codes.Internal, "failed to collect diagnostics: %v", err)
+	}
+
+	return wrapperspb.String(path), nil
+}
+
+// CheckConfiguration runs the connection doctor and returns its report.
+func (s *Service) CheckConfiguration(ctx context.Context, _ *emptypb.Empty) (*ConfigurationReport, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("CheckConfiguration")
+
+	report := s.bridge.CheckConfiguration(ctx)
+
+	res := &ConfigurationReport{}
+
+	for _, check := range report.Checks {
+		res.Checks = append(res.Checks, &ConfigurationCheck{
+			Name:    check.Name,
+			Status:  grpcCheckStatusFromCheckStatus(check.Status),
+			Details: check.Details,
+		})
+	}
+
+	return res, nil
+}
+
 func (s *Service) ForceLauncher(_ context.Context, launcher *wrapperspb.StringValue) (*emptypb.Empty, error) {
 	defer async.HandlePanic(s.panicHandler)
 
@@ -485,8 +696,26 @@ func (s *Service) Login(_ context.Context, login *LoginRequest) (*emptypb.Empty,
 		s.authClient = client
 		s.auth = auth
 
+		if fido.IsEnabled(auth) {
+			if challenge, err := fido.NewChallenge(auth); err != nil {
+				s.log.WithError(err).Warn("Cannot create security key challenge")
+			} else {
+				s.fidoChallenge = challenge
+			}
+		}
+
+		hasTOTP := auth.TwoFA.Enabled&proton.HasTOTP != 0
+
 		switch {
-		case auth.TwoFA.Enabled&proton.HasTOTP != 0:
+		case s.fidoChallenge != nil:
+			// Frontends that don't handle security keys can still complete the login with a TOTP code.
+			if hasTOTP {
+				_ = s.SendEvent(NewLoginTfaRequestedEvent(login.Username))
+			}
+
+			_ = s.SendEvent(NewLoginFido2RequestedEvent(login.Username, s.fidoChallenge, hasTOTP))
+
+		case hasTOTP:
 			_ = s.SendEvent(NewLoginTfaRequestedEvent(login.Username))
 
 		case auth.PasswordMode == proton.TwoPasswordMode:
@@ -545,6 +774,88 @@ func (s *Service) Login2FA(_ context.Context, login *LoginRequest) (*emptypb.Emp
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) LoginFido2(_ context.Context, login *LoginFido2Request) (*emptypb.Empty, error) {
+	s.log.WithField("username", login.Username).Debug("LoginFido2")
+
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+
+		if !s.checkFido2Login() {
+			return
+		}
+
+		s.finishFido2Login(login.Username, fido.Assertion{
+			AuthenticatorData: login.AuthenticatorData,
+			Signature:         login.Signature,
+			CredentialID:      login.CredentialID,
+		})
+	}()
+
+	return &emptypb.Empty{}, nil
+}
+
+// LoginFido2Local completes the login with a security key connected to this machine, which the user has to touch.
+func (s *Service) LoginFido2Local(_ context.Context, login *LoginFido2LocalRequest) (*emptypb.Empty, error) {
+	s.log.WithField("username", login.Username).Debug("LoginFido2Local")
+
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+
+		if !s.checkFido2Login() {
+			return
+		}
+
+		assertion, err := fido.Assert(context.Background(), s.fidoChallenge)
+		if err != nil {
+			// The user may plug in a key and try again, or use another second factor.
+			s.log.WithError(err).Warn("Login FIDO2: cannot use local security key")
+			_ = s.SendEvent(NewLoginError(LoginErrorType_TFA_ERROR, err.Error()))
+			return
+		}
+
+		s.finishFido2Login(login.Username, assertion)
+	}()
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkFido2Login returns whether the login is waiting for a security key, aborting it otherwise.
+func (s *Service) checkFido2Login() bool {
+	if s.auth.UID == "" || s.authClient == nil || s.fidoChallenge == nil {
+		s.log.Errorf("Login FIDO2: authentication incomplete %s %p", s.auth.UID, s.authClient)
+		_ = s.SendEvent(NewLoginError(LoginErrorType_TFA_ABORT, "Missing authentication, try again."))
+		s.loginClean()
+		return false
+	}
+
+	return true
+}
+
+// finishFido2Login sends the security key assertion and moves on to the next step of the login.
+func (s *Service) finishFido2Login(username string, assertion fido.Assertion) {
+	req := s.fidoChallenge.Auth2FAReq(assertion)
+
+	if err := s.authClient.Auth2FA(context.Background(), req); err != nil {
+		if apiErr := new(proton.APIError); errors.As(err, &apiErr) && apiErr.Code == proton.PasswordWrong {
+			s.log.Warn("Login FIDO2: retry security key")
+			_ = s.SendEvent(NewLoginError(LoginErrorType_TFA_ERROR, ""))
+		} else {
+			s.log.WithError(err).Warn("Login FIDO2: failed")
+			_ = s.SendEvent(NewLoginError(LoginErrorType_TFA_ABORT, err.Error()))
+			s.loginClean()
+		}
+
+		return
+	}
+
+	if s.auth.PasswordMode == proton.TwoPasswordMode {
+		_ = s.SendEvent(NewLoginTwoPasswordsRequestedEvent(username))
+		return
+	}
+
+	s.finishLogin()
+}
+
 func (s *Service) Login2Passwords(_ context.Context, login *LoginRequest) (*emptypb.Empty, error) {
 	s.log.WithField("username", login.Username).Debug("Login2Passwords")
 
@@ -624,6 +935,18 @@ func (s *Service) InstallUpdate(_ context.Context, _ *emptypb.Empty) (*emptypb.E
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) RollbackUpdate(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("RollbackUpdate")
+
+	if err := s.bridge.Rollback(); err != nil {
+		s.log.WithError(err).Error("Failed to roll back update")
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to roll back update: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Service) SetIsAutomaticUpdateOn(_ context.Context, isOn *wrapperspb.BoolValue) (*emptypb.Empty, error) {
 	defer async.HandlePanic(s.panicHandler)
 
@@ -688,6 +1011,91 @@ func (s *Service) SetDiskCachePath(_ context.Context, newPath *wrapperspb.String
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) DiskCacheMaxSize(_ context.Context, _ *emptypb.Empty) (*wrapperspb.UInt64Value, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("DiskCacheMaxSize")
+
+	return wrapperspb.UInt64(s.bridge.GetMaxCacheSize()), nil
+}
+
+func (s *Service) SetDiskCacheMaxSize(_ context.Context, maxSize *wrapperspb.UInt64Value) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("maxSize", maxSize.Value).Debug("SetDiskCacheMaxSize")
+
+	if err := s.bridge.SetMaxCacheSize(maxSize.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set the disk cache size limit")
+		return nil, status.Errorf(codes.Internal, "failed to set the disk cache size limit: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) DiskCacheKeepRecentDays(_ context.Context, _ *emptypb.Empty) (*wrapperspb.UInt32Value, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("DiskCacheKeepRecentDays")
+
+	return wrapperspb.UInt32(s.bridge.GetKeepRecentDays()), nil
+}
+
+func (s *Service) SetDiskCacheKeepRecentDays(_ context.Context, days *wrapperspb.UInt32Value) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("days", days.Value).Debug("SetDiskCacheKeepRecentDays")
+
+	if err := s.bridge.SetKeepRecentDays(days.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set the days of recent messages kept in the disk cache")
+		return nil, status.Errorf(codes.Internal, "failed to set the days of recent messages kept in the disk cache: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) DiskCacheSize(_ context.Context, _ *emptypb.Empty) (*wrapperspb.UInt64Value, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("DiskCacheSize")
+
+	return wrapperspb.UInt64(s.bridge.GetCacheSize()), nil
+}
+
+func (s *Service) MaxSyncMemory(_ context.Context, _ *emptypb.Empty) (*wrapperspb.UInt64Value, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("MaxSyncMemory")
+
+	return wrapperspb.UInt64(s.bridge.GetMaxSyncMemory()), nil
+}
+
+func (s *Service) SetMaxSyncMemory(_ context.Context, maxMemory *wrapperspb.UInt64Value) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("maxMemory", maxMemory.Value).Debug("SetMaxSyncMemory")
+
+	if err := s.bridge.SetMaxSyncMemory(maxMemory.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set the memory budget")
+		return nil, status.Errorf(codes.Internal, "failed to set the memory budget: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) MemoryUsage(_ context.Context, _ *emptypb.Empty) (*MemoryUsageResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("MemoryUsage")
+
+	usage := s.bridge.GetMemoryUsage()
+
+	return &MemoryUsageResponse{
+		Used:    usage.Used,
+		Limit:   usage.Limit,
+		Waiting: int32(usage.Waiting), //nolint:gosec // disable G115
+	}, nil
+}
+
 func (s *Service) SetIsDoHEnabled(_ context.Context, isEnabled *wrapperspb.BoolValue) (*emptypb.Empty, error) {
 	defer async.HandlePanic(s.panicHandler)
 
@@ -774,6 +1182,74 @@ func (s *Service) SetMailServerSettings(_ context.Context, settings *ImapSmtpSet
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) ImplicitTLSPorts(_ context.Context, _ *emptypb.Empty) (*ImplicitTLSPortSettings, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("ImplicitTLSPorts")
+
+	return &ImplicitTLSPortSettings{
+		ImapPort: int32(s.bridge.GetIMAPImplicitTLSPort()), //nolint:gosec // disable G115
+		SmtpPort: int32(s.bridge.GetSMTPImplicitTLSPort()), //nolint:gosec // disable G115
+	}, nil
+}
+
+func (s *Service) SetImplicitTLSPorts(ctx context.Context, settings *ImplicitTLSPortSettings) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("ImapPort", settings.ImapPort).WithField("SmtpPort", settings.SmtpPort).Debug("SetImplicitTLSPorts")
+
+	if err := s.bridge.SetIMAPImplicitTLSPort(ctx, int(settings.ImapPort)); err != nil {
+		s.log.WithError(err).Error("Failed to set implicit TLS IMAP port")
+		return nil, implicitTLSPortError(err)
+	}
+
+	if err := s.bridge.SetSMTPImplicitTLSPort(ctx, int(settings.SmtpPort)); err != nil {
+		s.log.WithError(err).Error("Failed to set implicit TLS SMTP port")
+		return nil, implicitTLSPortError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) SSLRequired(_ context.Context, _ *emptypb.Empty) (*SSLRequiredSettings, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("SSLRequired")
+
+	return &SSLRequiredSettings{
+		Imap: s.bridge.GetIMAPSSLRequired(),
+		Smtp: s.bridge.GetSMTPSSLRequired(),
+	}, nil
+}
+
+func (s *Service) SetSSLRequired(ctx context.Context, settings *SSLRequiredSettings) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("IMAP", settings.Imap).WithField("SMTP", settings.Smtp).Debug("SetSSLRequired")
+
+	if err := s.bridge.SetIMAPSSLRequired(ctx, settings.Imap); err != nil {
+		s.log.WithError(err).Error("Failed to set IMAP SSL requirement")
+		return nil, status.Errorf(codes.Internal, "failed to set IMAP SSL requirement: %v", err)
+	}
+
+	if err := s.bridge.SetSMTPSSLRequired(ctx, settings.Smtp); err != nil {
+		s.log.WithError(err).Error("Failed to set SMTP SSL requirement")
+		return nil, status.Errorf(codes.Internal, "failed to set SMTP SSL requirement: %v", err)
+	}
+
+	_ = s.SendEvent(NewMailServerSettingsChangedEvent(s.getMailServerSettings()))
+
+	return &emptypb.Empty{}, nil
+}
+
+func implicitTLSPortError(err error) error {
+	if errors.Is(err, bridge.ErrInvalidImplicitTLSPort) {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return status.Errorf(codes.Internal, "failed to set implicit TLS port: %v", err)
+}
+
 func (s *Service) Hostname(_ context.Context, _ *emptypb.Empty) (*wrapperspb.StringValue, error) {
 	defer async.HandlePanic(s.panicHandler)
 
@@ -782,6 +1258,32 @@ func (s *Service) Hostname(_ context.Context, _ *emptypb.Empty) (*wrapperspb.Str
 	return wrapperspb.String(constants.Host), nil
 }
 
+func (s *Service) BindAddress(_ context.Context, _ *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("BindAddress")
+
+	return wrapperspb.String(s.bridge.GetBindAddress()), nil
+}
+
+func (s *Service) SetBindAddress(ctx context.Context, req *BindAddressRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("address", req.Address).WithField("acknowledgeRisk", req.AcknowledgeRisk).Debug("SetBindAddress")
+
+	if err := s.bridge.SetBindAddress(ctx, req.Address, req.AcknowledgeRisk); err != nil {
+		s.log.WithError(err).Error("Failed to set bind address")
+
+		if errors.Is(err, bridge.ErrInvalidBindAddress) || errors.Is(err, bridge.ErrBindAddressNotAcknowledged) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to set bind address: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set bind address: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Service) IsPortFree(_ context.Context, port *wrapperspb.Int32Value) (*wrapperspb.BoolValue, error) {
 	defer async.HandlePanic(s.panicHandler)
 
@@ -838,6 +1340,197 @@ func (s *Service) CurrentKeychain(_ context.Context, _ *emptypb.Empty) (*wrapper
 	return wrapperspb.String(helper), nil
 }
 
+func (s *Service) IsCredentialStoreUnavailable(_ context.Context, _ *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("IsCredentialStoreUnavailable")
+
+	return wrapperspb.Bool(s.bridge.IsCredentialStoreUnavailable()), nil
+}
+
+func (s *Service) MigrateToFileVault(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("MigrateToFileVault")
+
+	if err := s.bridge.MigrateToFileVault(ctx); err != nil {
+		s.log.WithError(err).Error("Failed to migrate to the file-based vault")
+
+		if errors.Is(err, bridge.ErrBridgeLocked) || errors.Is(err, bridge.ErrKeyRotationInProgress) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to migrate to the file-based vault: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) AutoLockTimeout(_ context.Context, _ *emptypb.Empty) (*wrapperspb.Int32Value, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("AutoLockTimeout")
+
+	return wrapperspb.Int32(int32(s.bridge.GetAutoLockTimeout() / time.Minute)), nil
+}
+
+func (s *Service) SetAutoLockTimeout(_ context.Context, minutes *wrapperspb.Int32Value) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("minutes", minutes.Value).Debug("SetAutoLockTimeout")
+
+	if err := s.bridge.SetAutoLockTimeout(time.Duration(minutes.Value) * time.Minute); err != nil {
+		s.log.WithError(err).Error("Failed to set auto-lock timeout")
+
+		if errors.Is(err, bridge.ErrInvalidAutoLockTimeout) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		if errors.Is(err, bridge.ErrNoUnlockPassphrase) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set auto-lock timeout: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) HasUnlockPassphrase(_ context.Context, _ *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("HasUnlockPassphrase")
+
+	return wrapperspb.Bool(s.bridge.HasUnlockPassphrase()), nil
+}
+
+func (s *Service) SetUnlockPassphrase(_ context.Context, passphrase *wrapperspb.BytesValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("remove", len(passphrase.Value) == 0).Debug("SetUnlockPassphrase")
+
+	if err := s.bridge.SetUnlockPassphrase(passphrase.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set unlock passphrase")
+
+		if errors.Is(err, bridge.ErrBridgeLocked) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set unlock passphrase: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) IsLocked(_ context.Context, _ *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("IsLocked")
+
+	return wrapperspb.Bool(s.bridge.IsLocked()), nil
+}
+
+func (s *Service) Lock(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("Lock")
+
+	if err := s.bridge.Lock(ctx); err != nil {
+		s.log.WithError(err).Warn("Failed to lock bridge")
+
+		if errors.Is(err, bridge.ErrNoUnlockPassphrase) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to lock bridge: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) Unlock(_ context.Context, passphrase *wrapperspb.BytesValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("Unlock")
+
+	if err := s.bridge.Unlock(passphrase.Value); err != nil {
+		s.log.WithError(err).Warn("Failed to unlock bridge")
+
+		if errors.Is(err, bridge.ErrInvalidUnlockPassphrase) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to unlock bridge: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) RotateEncryptionKeys(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("RotateEncryptionKeys")
+
+	if err := s.bridge.RotateEncryptionKeys(); err != nil {
+		if errors.Is(err, bridge.ErrBridgeLocked) || errors.Is(err, bridge.ErrKeyRotationInProgress) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to rotate encryption keys: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) Webhooks(_ context.Context, _ *emptypb.Empty) (*WebhooksResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("Webhooks")
+
+	return &WebhooksResponse{
+		Webhooks: xslices.Map(s.bridge.GetWebhooks(), func(webhook vault.Webhook) *Webhook {
+			return &Webhook{Url: webhook.URL, Events: webhook.Events, Signed: webhook.Secret != ""}
+		}),
+		AvailableEvents: bridge.WebhookEvents,
+	}, nil
+}
+
+func (s *Service) AddWebhook(_ context.Context, req *AddWebhookRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("events", req.Events).Debug("AddWebhook")
+
+	if err := s.bridge.AddWebhook(req.Url, req.Secret, req.Events...); err != nil {
+		s.log.WithError(err).Error("Failed to add webhook")
+
+		if errors.Is(err, bridge.ErrInvalidWebhook) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to add webhook: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) RemoveWebhook(_ context.Context, url *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.Debug("RemoveWebhook")
+
+	if err := s.bridge.RemoveWebhook(url.Value); err != nil {
+		s.log.WithError(err).Error("Failed to remove webhook")
+
+		if errors.Is(err, bridge.ErrNoSuchWebhook) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to remove webhook: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Service) TriggerRepair(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
 	s.log.Debug("TriggerRepair")
 	go func() {
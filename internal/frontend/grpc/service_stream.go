@@ -19,6 +19,8 @@ package grpc
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/proton-bridge/v3/internal/kb"
@@ -69,6 +71,11 @@ func (s *Service) RunEventStream(request *EventStreamRequest, server Bridge_RunE
 			return nil
 
 		case event := <-s.eventStreamCh:
+			if eventAPIVersion(event) > s.getClientAPIVersion() && !isBlockingEvent(event) {
+				s.log.WithField("event", event).Debug("Not sending event unknown to the frontend")
+				continue
+			}
+
 			s.log.WithField("event", event).Debug("Sending event")
 			if err := server.Send(event); err != nil {
 				s.log.Debug("Stop Event stream")
@@ -82,6 +89,39 @@ func (s *Service) RunEventStream(request *EventStreamRequest, server Bridge_RunE
 	}
 }
 
+// SubscribeBridgeEvents streams the events published by bridge, optionally only those of the given types.
+func (s *Service) SubscribeBridgeEvents(filter *BridgeEventFilter, server Bridge_SubscribeBridgeEventsServer) error {
+	defer async.HandlePanic(s.panicHandler)
+
+	s.log.WithField("types", filter.Types).Debug("SubscribeBridgeEvents")
+
+	eventCh, done := s.bridge.GetEvents()
+	defer done()
+
+	for {
+		select {
+		case <-server.Context().Done():
+			s.log.Debug("Client closed the bridge events stream")
+			return nil
+
+		case event, ok := <-eventCh:
+			if !ok {
+				return nil
+			}
+
+			bridgeEvent := newBridgeEvent(event, time.Now())
+
+			if len(filter.Types) > 0 && !slices.Contains(filter.Types, bridgeEvent.Type) {
+				continue
+			}
+
+			if err := server.Send(bridgeEvent); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // StopEventStream stops the event stream.
 func (s *Service) StopEventStream(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, s.stopEventStream()
@@ -19,9 +19,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clientconfig"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/eventjournal"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -99,6 +105,92 @@ func (s *Service) SetUserSplitMode(_ context.Context, splitMode *UserSplitModeRe
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) SetUserHideAllMail(_ context.Context, req *UserHideAllMailRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("Hide", req.Hide).Debug("SetUserHideAllMail")
+
+	if err := s.bridge.SetUserHideAllMail(req.UserID, req.Hide); err != nil {
+		if errors.Is(err, bridge.ErrNoSuchUser) {
+			return nil, status.Errorf(codes.NotFound, "user not found %v", req.UserID)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set hide all mail: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) SetUserRepairMime(_ context.Context, req *UserRepairMimeRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("Repair", req.Repair).Debug("SetUserRepairMime")
+
+	if err := s.bridge.SetUserRepairMIME(req.UserID, req.Repair); err != nil {
+		if errors.Is(err, bridge.ErrNoSuchUser) {
+			return nil, status.Errorf(codes.NotFound, "user not found %v", req.UserID)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set repair MIME: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) SetUserReadReceiptMode(_ context.Context, req *UserReadReceiptModeRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("Mode", req.Mode).Debug("SetUserReadReceiptMode")
+
+	mode, ok := readReceiptModeFromGrpc(req.Mode)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown read receipt mode %v", req.Mode)
+	}
+
+	if err := s.bridge.SetUserReadReceiptMode(req.UserID, mode); err != nil {
+		if errors.Is(err, bridge.ErrNoSuchUser) {
+			return nil, status.Errorf(codes.NotFound, "user not found %v", req.UserID)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set read receipt mode: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) SendUserReadReceipt(ctx context.Context, req *UserReadReceiptRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("MessageID", req.MessageID).Debug("SendUserReadReceipt")
+
+	if err := s.bridge.SendUserReadReceipt(ctx, req.UserID, req.MessageID); err != nil {
+		switch {
+		case errors.Is(err, bridge.ErrNoSuchUser):
+			return nil, status.Errorf(codes.NotFound, "user not found %v", req.UserID)
+		case errors.Is(err, bridge.ErrNoReadReceiptRequested):
+			return nil, status.Errorf(codes.FailedPrecondition, "no read receipt requested: %v", err)
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to send read receipt: %v", err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) SetUserSyncCutoff(ctx context.Context, req *UserSyncCutoffRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("Cutoff", req.Cutoff).Debug("SetUserSyncCutoff")
+
+	if err := s.bridge.SetUserSyncCutoff(ctx, req.UserID, syncCutoffFromGrpc(req.Cutoff)); err != nil {
+		switch {
+		case errors.Is(err, bridge.ErrNoSuchUser):
+			return nil, status.Errorf(codes.NotFound, "user not found %v", req.UserID)
+		case errors.Is(err, bridge.ErrInvalidSyncCutoff):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sync cutoff: %v", err)
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to set sync cutoff: %v", err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Service) SendBadEventUserFeedback(_ context.Context, feedback *UserBadEventFeedbackRequest) (*emptypb.Empty, error) {
 	defer async.HandlePanic(s.panicHandler)
 	l := s.log.WithField("UserID", feedback.UserID).WithField("doResync", feedback.DoResync)
@@ -137,6 +229,17 @@ func (s *Service) LogoutUser(_ context.Context, userID *wrapperspb.StringValue)
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) PrioritizeUserSync(_ context.Context, userID *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", userID.Value).Debug("PrioritizeUserSync")
+
+	if err := s.bridge.PrioritizeSync(userID.Value); err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", userID.Value)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Service) RemoveUser(_ context.Context, userID *wrapperspb.StringValue) (*emptypb.Empty, error) {
 	defer async.HandlePanic(s.panicHandler)
 	s.log.WithField("UserID", userID.Value).Debug("RemoveUser")
@@ -153,6 +256,21 @@ func (s *Service) RemoveUser(_ context.Context, userID *wrapperspb.StringValue)
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) RemoveUserKeepData(_ context.Context, userID *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", userID.Value).Debug("RemoveUserKeepData")
+
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+
+		if err := s.bridge.RemoveUserKeepData(context.Background(), userID.Value); err != nil {
+			s.log.WithError(err).Error("Failed to remove user")
+		}
+	}()
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Service) ConfigureUserAppleMail(ctx context.Context, request *ConfigureAppleMailRequest) (*emptypb.Empty, error) {
 	defer async.HandlePanic(s.panicHandler)
 	s.log.WithField("UserID", request.UserID).WithField("Address", request.Address).Debug("ConfigureUserAppleMail")
@@ -171,3 +289,150 @@ func (s *Service) ConfigureUserAppleMail(ctx context.Context, request *Configure
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *Service) UserClientConfig(_ context.Context, request *ClientConfigRequest) (*wrapperspb.StringValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", request.UserID).WithField("Address", request.Address).WithField("Format", request.Format).Debug("UserClientConfig")
+
+	var format clientconfig.Format
+
+	switch request.Format {
+	case ClientConfigFormat_CLIENT_CONFIG_AUTOCONFIG:
+		format = clientconfig.FormatAutoconfig
+
+	case ClientConfigFormat_CLIENT_CONFIG_PREFS_JS:
+		format = clientconfig.FormatPrefsJS
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown client config format: %v", request.Format)
+	}
+
+	config, err := s.bridge.GetClientConfig(request.UserID, request.Address, format)
+	if err != nil {
+		if errors.Is(err, bridge.ErrNoSuchUser) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to generate client config: %v", err)
+	}
+
+	return wrapperspb.String(string(config)), nil
+}
+
+func (s *Service) StartAutoconfigServer(_ context.Context, _ *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("StartAutoconfigServer")
+
+	url, err := s.bridge.StartAutoconfigServer()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start autoconfig server: %v", err)
+	}
+
+	return wrapperspb.String(url), nil
+}
+
+func (s *Service) UserAppPasswords(_ context.Context, userID *wrapperspb.StringValue) (*AppPasswordsResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", userID.Value).Debug("UserAppPasswords")
+
+	appPasses, err := s.bridge.GetAppPasswords(userID.Value)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", userID.Value)
+	}
+
+	return &AppPasswordsResponse{
+		AppPasswords: xslices.Map(appPasses, func(appPass bridge.AppPasswordInfo) *AppPassword {
+			return &AppPassword{Name: appPass.Name, CreatedAt: appPass.CreatedAt.Unix()}
+		}),
+	}, nil
+}
+
+func (s *Service) CreateUserAppPassword(_ context.Context, request *AppPasswordRequest) (*wrapperspb.BytesValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", request.UserID).WithField("Name", request.Name).Debug("CreateUserAppPassword")
+
+	appPass, err := s.bridge.CreateAppPassword(request.UserID, request.Name)
+	if err != nil {
+		return nil, appPasswordError(err)
+	}
+
+	return wrapperspb.Bytes(appPass), nil
+}
+
+func (s *Service) RevokeUserAppPassword(_ context.Context, request *AppPasswordRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", request.UserID).WithField("Name", request.Name).Debug("RevokeUserAppPassword")
+
+	if err := s.bridge.RevokeAppPassword(request.UserID, request.Name); err != nil {
+		return nil, appPasswordError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) CreateUserAuthToken(_ context.Context, request *AuthTokenRequest) (*wrapperspb.BytesValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", request.UserID).WithField("ValiditySeconds", request.ValiditySeconds).Debug("CreateUserAuthToken")
+
+	token, err := s.bridge.CreateAuthToken(request.UserID, time.Duration(request.ValiditySeconds)*time.Second)
+	if err != nil {
+		return nil, appPasswordError(err)
+	}
+
+	return wrapperspb.Bytes(token), nil
+}
+
+func (s *Service) UserEventJournal(_ context.Context, request *UserEventJournalRequest) (*UserEventJournalResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", request.UserID).WithField("MaxEntries", request.MaxEntries).Debug("UserEventJournal")
+
+	entries, err := s.bridge.GetUserEventJournal(request.UserID)
+	if err != nil {
+		if errors.Is(err, bridge.ErrNoSuchUser) {
+			return nil, status.Errorf(codes.NotFound, "user not found %v", request.UserID)
+		}
+
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	if request.Search != "" {
+		entries = eventjournal.Search(entries, request.Search)
+	}
+
+	if request.MaxEntries > 0 && len(entries) > int(request.MaxEntries) {
+		entries = entries[len(entries)-int(request.MaxEntries):]
+	}
+
+	return &UserEventJournalResponse{
+		Entries: xslices.Map(entries, func(entry eventjournal.Entry) *EventJournalEntry {
+			return &EventJournalEntry{
+				Time:          entry.Time.Unix(),
+				EventID:       entry.EventID,
+				Kind:          string(entry.Kind),
+				Action:        string(entry.Action),
+				Id:            entry.ID,
+				Subject:       entry.Subject,
+				Sender:        entry.Sender,
+				Labels:        entry.Labels,
+				AddedLabels:   entry.Added,
+				RemovedLabels: entry.Removed,
+			}
+		}),
+	}, nil
+}
+
+func appPasswordError(err error) error {
+	switch {
+	case errors.Is(err, bridge.ErrNoSuchUser), errors.Is(err, bridge.ErrNoSuchAppPassword):
+		return status.Errorf(codes.NotFound, "%v", err)
+
+	case errors.Is(err, bridge.ErrInvalidAppPassword), errors.Is(err, bridge.ErrInvalidAuthTokenValidity):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+
+	case errors.Is(err, bridge.ErrAppPasswordExists):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	status "google.golang.org/grpc/status"
+)
+
+// serverTokenValidity is how long the server token written to the config file can be used to connect a new frontend
+// before it is replaced by a new one.
+const serverTokenValidity = 5 * time.Minute
+
+// frontendSessionTTL is how long the token claimed by a frontend stays valid once the frontend stopped using it.
+const frontendSessionTTL = time.Hour
+
+// frontendSession describes a frontend that connected to the service.
+type frontendSession struct {
+	peer        string
+	userAgent   string
+	connectedAt time.Time
+
+	// lastSeen is when the frontend last used its token, and streams the number of its streams still open.
+	lastSeen time.Time
+	streams  int
+}
+
+// tokenStore manages the server tokens.
+// The pending token is the one written to the server config file. It can only be used once: the first frontend using it
+// claims it, and it then stays valid for that frontend only. A new pending token is then written to the config file for
+// the next frontend, e.g. a restarted one. A pending token that isn't claimed in time is rotated, and the token of a
+// frontend expires once the frontend stopped using it for long enough.
+type tokenStore struct {
+	pending  string
+	issuedAt time.Time
+	sessions map[string]frontendSession
+	lock     sync.Mutex
+
+	now             func() time.Time
+	onPendingChange func(token string)
+	log             *logrus.Entry
+}
+
+// newTokenStore returns a token store with a new pending token.
+// onPendingChange is called with the new pending token each time it changes.
+func newTokenStore(now func() time.Time, onPendingChange func(token string)) *tokenStore {
+	return &tokenStore{
+		pending:         uuid.NewString(),
+		issuedAt:        now(),
+		sessions:        make(map[string]frontendSession),
+		now:             now,
+		onPendingChange: onPendingChange,
+		log:             logrus.WithField("pkg", "grpc/audit"),
+	}
+}
+
+// pendingToken returns the token a new frontend can use to connect, if any.
+func (store *tokenStore) pendingToken() string {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	return store.pending
+}
+
+// rotate replaces the pending token if it has expired, and forgets the tokens of the frontends which stopped using them.
+func (store *tokenStore) rotate() {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	for token, session := range store.sessions {
+		if store.isExpiredUnsafe(session) {
+			store.log.WithField("userAgent", session.userAgent).Info("Frontend session expired")
+			delete(store.sessions, token)
+		}
+	}
+
+	if store.now().Sub(store.issuedAt) < serverTokenValidity {
+		return
+	}
+
+	store.log.Info("Rotating unused server token")
+
+	store.renewPendingUnsafe()
+}
+
+// validate checks that the server token provided by the client is valid, claiming the pending token if needed.
+// It returns the token of the client.
+func (store *tokenStore) validate(ctx context.Context, method string) (string, error) {
+	token, err := getServerToken(ctx)
+	if err != nil {
+		store.reject(ctx, method, err)
+		return "", err
+	}
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if session, ok := store.sessions[token]; ok {
+		if store.isExpiredUnsafe(session) {
+			delete(store.sessions, token)
+
+			err := status.Error(codes.Unauthenticated, "expired server token")
+			store.reject(ctx, method, err)
+
+			return "", err
+		}
+
+		session.lastSeen = store.now()
+		store.sessions[token] = session
+
+		return token, nil
+	}
+
+	if token != store.pending {
+		err := status.Error(codes.Unauthenticated, "invalid server token")
+		store.reject(ctx, method, err)
+
+		return "", err
+	}
+
+	if store.now().Sub(store.issuedAt) >= serverTokenValidity {
+		err := status.Error(codes.Unauthenticated, "expired server token")
+		store.reject(ctx, method, err)
+
+		return "", err
+	}
+
+	session := frontendSession{
+		peer:        getPeer(ctx),
+		userAgent:   getUserAgent(ctx),
+		connectedAt: store.now(),
+		lastSeen:    store.now(),
+	}
+
+	store.log.WithFields(logrus.Fields{
+		"peer":      session.peer,
+		"userAgent": session.userAgent,
+		"method":    method,
+	}).Info("Frontend connected")
+
+	store.sessions[token] = session
+	store.renewPendingUnsafe()
+
+	return token, nil
+}
+
+// openStream records that the frontend with the given token opened a stream; its token doesn't expire while it is open.
+func (store *tokenStore) openStream(token string) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if session, ok := store.sessions[token]; ok {
+		session.streams++
+		store.sessions[token] = session
+	}
+}
+
+// closeStream records that the frontend with the given token closed a stream.
+func (store *tokenStore) closeStream(token string) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if session, ok := store.sessions[token]; ok {
+		session.streams--
+		session.lastSeen = store.now()
+		store.sessions[token] = session
+	}
+}
+
+func (store *tokenStore) isExpiredUnsafe(session frontendSession) bool {
+	return session.streams == 0 && store.now().Sub(session.lastSeen) >= frontendSessionTTL
+}
+
+func (store *tokenStore) renewPendingUnsafe() {
+	store.pending = uuid.NewString()
+	store.issuedAt = store.now()
+	store.onPendingChange(store.pending)
+}
+
+func (store *tokenStore) reject(ctx context.Context, method string, err error) {
+	store.log.WithFields(logrus.Fields{
+		"peer":      getPeer(ctx),
+		"userAgent": getUserAgent(ctx),
+		"method":    method,
+	}).WithError(err).Warn("Rejected gRPC call from frontend")
+}
+
+// getServerToken returns the server token provided by the client.
+func getServerToken(ctx context.Context) (string, error) {
+	values, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing server token")
+	}
+
+	token := values.Get(serverTokenMetadataKey)
+	if len(token) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing server token")
+	}
+
+	if len(token) > 1 {
+		return "", status.Error(codes.Unauthenticated, "more than one server token was provided")
+	}
+
+	return token[0], nil
+}
+
+func getPeer(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+
+	return ""
+}
+
+func getUserAgent(ctx context.Context) string {
+	if values, ok := metadata.FromIncomingContext(ctx); ok {
+		if userAgent := values.Get("user-agent"); len(userAgent) > 0 {
+			return userAgent[0]
+		}
+	}
+
+	return ""
+}
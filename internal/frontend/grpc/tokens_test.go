@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func Test_TokenStore(t *testing.T) {
+	now := time.Now()
+
+	var pending []string
+
+	store := newTokenStore(func() time.Time { return now }, func(token string) { pending = append(pending, token) })
+
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(serverTokenMetadataKey, token))
+	}
+
+	validate := func(token string) error {
+		_, err := store.validate(withToken(token), "method")
+		return err
+	}
+
+	// A missing or unknown token is rejected.
+	_, err := store.validate(context.Background(), "method")
+	require.Error(t, err)
+	require.Error(t, validate("invalid"))
+
+	// An unused token is rotated once it has expired.
+	token := store.pendingToken()
+	store.rotate()
+	require.Equal(t, token, store.pendingToken())
+
+	now = now.Add(serverTokenValidity)
+	require.Error(t, validate(token))
+
+	store.rotate()
+	require.NotEqual(t, token, store.pendingToken())
+	require.Equal(t, []string{store.pendingToken()}, pending)
+	require.Error(t, validate(token))
+
+	// The first frontend using the pending token claims it, and a new one is issued for the next frontend.
+	token = store.pendingToken()
+	require.NoError(t, validate(token))
+	require.NotEmpty(t, store.pendingToken())
+	require.NotEqual(t, token, store.pendingToken())
+	require.Equal(t, store.pendingToken(), pending[len(pending)-1])
+
+	// A restarted frontend can claim the new token; the claimed one stays valid while in use.
+	restarted := store.pendingToken()
+	require.NoError(t, validate(restarted))
+
+	now = now.Add(frontendSessionTTL / 2)
+	require.NoError(t, validate(token))
+
+	// The token of a frontend doesn't expire while one of its streams is open.
+	store.openStream(restarted)
+
+	now = now.Add(frontendSessionTTL)
+	store.rotate()
+	require.NoError(t, validate(restarted))
+
+	// Otherwise it expires once the frontend stopped using it.
+	require.Error(t, validate(token))
+
+	store.closeStream(restarted)
+
+	now = now.Add(frontendSessionTTL)
+	store.rotate()
+	require.Error(t, validate(restarted))
+}
@@ -18,10 +18,14 @@
 package grpc
 
 import (
+	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/sirupsen/logrus"
 )
@@ -66,15 +70,90 @@ func getInitials(fullName string) string {
 // grpcUserFromInfo converts a bridge user to a gRPC user.
 func grpcUserFromInfo(user bridge.UserInfo) *User {
 	return &User{
-		Id:         user.UserID,
-		Username:   user.Username,
-		AvatarText: getInitials(user.Username),
-		State:      userStateToGrpc(user.State),
-		SplitMode:  user.AddressMode == vault.SplitMode,
-		UsedBytes:  int64(user.UsedSpace), //nolint:gosec // disable G115
-		TotalBytes: int64(user.MaxSpace),  //nolint:gosec // disable G115
-		Password:   user.BridgePass,
-		Addresses:  user.Addresses,
+		Id:          user.UserID,
+		Username:    user.Username,
+		AvatarText:  getInitials(user.Username),
+		State:       userStateToGrpc(user.State),
+		SplitMode:   user.AddressMode == vault.SplitMode,
+		UsedBytes:   int64(user.UsedSpace), //nolint:gosec // disable G115
+		TotalBytes:  int64(user.MaxSpace),  //nolint:gosec // disable G115
+		Password:    user.BridgePass,
+		Addresses:   user.Addresses,
+		HideAllMail: user.HideAllMail,
+		RepairMime:  user.RepairMIME,
+
+		PendingReauth:   user.PendingReauth,
+		ReadReceiptMode: readReceiptModeToGrpc(user.ReadReceiptMode),
+		SyncCutoff:      syncCutoffToGrpc(user.SyncCutoff),
+		DataKept:        user.DataKept,
+	}
+}
+
+func grpcIMAPSessionFromBridge(session bridge.IMAPSession) *IMAPSession {
+	commands := make(map[string]int32, len(session.Commands))
+
+	for name, count := range session.Commands {
+		commands[name] = int32(count) //nolint:gosec // disable G115
+	}
+
+	var endedAt int64
+
+	if !session.EndedAt.IsZero() {
+		endedAt = session.EndedAt.Unix()
+	}
+
+	return &IMAPSession{
+		Id:            int32(session.ID), //nolint:gosec // disable G115
+		RemoteAddress: session.RemoteAddr,
+		ClientName:    session.ClientName,
+		ClientVersion: session.ClientVersion,
+		Address:       session.Address,
+		Mailboxes:     session.Mailboxes,
+		Commands:      commands,
+		BytesIn:       session.BytesIn,
+		BytesOut:      session.BytesOut,
+		StartedAt:     session.StartedAt.Unix(),
+		EndedAt:       endedAt,
+	}
+}
+
+func syncCutoffToGrpc(cutoff time.Time) int64 {
+	if cutoff.IsZero() {
+		return 0
+	}
+
+	return cutoff.Unix()
+}
+
+func syncCutoffFromGrpc(cutoff int64) time.Time {
+	if cutoff <= 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(cutoff, 0)
+}
+
+func readReceiptModeToGrpc(mode vault.ReadReceiptMode) ReadReceiptMode {
+	switch mode {
+	case vault.ReadReceiptPrompt:
+		return ReadReceiptMode_READ_RECEIPT_PROMPT
+	case vault.ReadReceiptAuto:
+		return ReadReceiptMode_READ_RECEIPT_AUTO
+	default:
+		return ReadReceiptMode_READ_RECEIPT_IGNORE
+	}
+}
+
+func readReceiptModeFromGrpc(mode ReadReceiptMode) (vault.ReadReceiptMode, bool) {
+	switch mode {
+	case ReadReceiptMode_READ_RECEIPT_IGNORE:
+		return vault.ReadReceiptIgnore, true
+	case ReadReceiptMode_READ_RECEIPT_PROMPT:
+		return vault.ReadReceiptPrompt, true
+	case ReadReceiptMode_READ_RECEIPT_AUTO:
+		return vault.ReadReceiptAuto, true
+	default:
+		return vault.ReadReceiptIgnore, false
 	}
 }
 
@@ -112,3 +191,57 @@ func logrusLevelFromGrpcLevel(level LogLevel) logrus.Level {
 		return logrus.ErrorLevel
 	}
 }
+
+// grpcLevelFromLogrusLevel converts a logrus log level to a gRPC log level.
+func grpcLevelFromLogrusLevel(level logrus.Level) LogLevel {
+	switch level {
+	case logrus.PanicLevel:
+		return LogLevel_LOG_PANIC
+	case logrus.FatalLevel:
+		return LogLevel_LOG_FATAL
+	case logrus.ErrorLevel:
+		return LogLevel_LOG_ERROR
+	case logrus.WarnLevel:
+		return LogLevel_LOG_WARN
+	case logrus.InfoLevel:
+		return LogLevel_LOG_INFO
+	case logrus.DebugLevel:
+		return LogLevel_LOG_DEBUG
+	case logrus.TraceLevel:
+		return LogLevel_LOG_TRACE
+	default:
+		return LogLevel_LOG_ERROR
+	}
+}
+
+// grpcCheckStatusFromCheckStatus converts the status of a configuration check to its gRPC counterpart.
+func grpcCheckStatusFromCheckStatus(status bridge.CheckStatus) ConfigurationCheckStatus {
+	switch status {
+	case bridge.CheckPassed:
+		return ConfigurationCheckStatus_CHECK_PASSED
+	case bridge.CheckWarning:
+		return ConfigurationCheckStatus_CHECK_WARNING
+	default:
+		return ConfigurationCheckStatus_CHECK_FAILED
+	}
+}
+
+// newBridgeEvent converts a bridge event for the SubscribeBridgeEvents stream.
+func newBridgeEvent(event events.Event, publishedAt time.Time) *BridgeEvent {
+	value := reflect.Indirect(reflect.ValueOf(event))
+
+	var userID string
+
+	if value.Kind() == reflect.Struct {
+		if field := value.FieldByName("UserID"); field.IsValid() && field.Kind() == reflect.String {
+			userID = field.String()
+		}
+	}
+
+	return &BridgeEvent{
+		Type:        value.Type().Name(),
+		Timestamp:   publishedAt.UnixMilli(),
+		UserID:      userID,
+		Description: fmt.Sprint(event),
+	}
+}
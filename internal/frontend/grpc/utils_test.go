@@ -18,8 +18,12 @@
 package grpc
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/fido"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,3 +47,40 @@ func Test_GetInitials(t *testing.T) {
 	require.Equal(t, "JM", getInitials("Jean Michel"))
 	require.Equal(t, "GC", getInitials("George Michael Carrie"))
 }
+
+func Test_NewBridgeEvent(t *testing.T) {
+	now := time.Now()
+
+	event := newBridgeEvent(events.SyncFinished{UserID: "userID"}, now)
+	require.Equal(t, "SyncFinished", event.Type)
+	require.Equal(t, now.UnixMilli(), event.Timestamp)
+	require.Equal(t, "userID", event.UserID)
+	require.Equal(t, "SyncFinished: UserID: userID", event.Description)
+
+	event = newBridgeEvent(events.ConnStatusDown{}, now)
+	require.Equal(t, "ConnStatusDown", event.Type)
+	require.Empty(t, event.UserID)
+}
+
+func Test_EventAPIVersion(t *testing.T) {
+	require.Equal(t, int32(1), eventAPIVersion(NewInternetStatusEvent(true)))
+	require.Equal(t, int32(1), eventAPIVersion(NewLoginTfaRequestedEvent("username")))
+	require.Equal(t, int32(2), eventAPIVersion(NewBridgeLockedEvent(true)))
+	require.Equal(t, int32(2), eventAPIVersion(NewUserStorageAlmostFullEvent("userID", 90, 100)))
+	require.Equal(t, int32(6), eventAPIVersion(NewUserReauthRequiredEvent("userID", "username")))
+	require.Equal(t, int32(7), eventAPIVersion(NewUserReadReceiptRequestedEvent("userID", "messageID", "sender@pm.me", "subject")))
+	require.Equal(t, int32(9), eventAPIVersion(NewKeychainCredentialStoreUnavailableEvent(errors.New("no keychain"))))
+
+	// Events can't be newer than the API.
+	require.LessOrEqual(t, eventAPIVersion(NewBridgeLockedEvent(false)), int32(apiVersion))
+}
+
+func Test_IsBlockingEvent(t *testing.T) {
+	// Flows wait for these, so they are sent whatever the API version of the frontend.
+	require.True(t, isBlockingEvent(NewLoginFido2RequestedEvent("username", &fido.Challenge{}, true)))
+	require.True(t, isBlockingEvent(NewUserReauthRequiredEvent("userID", "username")))
+	require.True(t, isBlockingEvent(NewUserReadReceiptRequestedEvent("userID", "messageID", "sender@pm.me", "subject")))
+
+	require.False(t, isBlockingEvent(NewBridgeLockedEvent(true)))
+	require.False(t, isBlockingEvent(NewUserStorageAlmostFullEvent("userID", 90, 100)))
+}
@@ -46,13 +46,23 @@ type Locations struct {
 	// userCache is the path to the user cache directory, for storing non-essential data.
 	userCache string
 
+	// gluonCachePath overrides the default location of the gluon message cache, once moved by the user.
+	gluonCachePath string
+
+	// ephemeralPath, when set, holds the gluon store, the gluon database and the IMAP sync state instead of the
+	// persistent locations, so that nothing but the vault survives the ephemeral directory being wiped.
+	ephemeralPath string
+
+	// portableDir, when set, is the single directory holding all files because bridge runs in portable mode.
+	portableDir string
+
 	configName    string
 	configGuiName string
 }
 
 // New returns a new locations object.
 func New(provider Provider, configName string) *Locations {
-	return &Locations{
+	l := &Locations{
 		userConfig: provider.UserConfig(),
 		userData:   provider.UserData(),
 		userCache:  provider.UserCache(),
@@ -60,6 +70,17 @@ func New(provider Provider, configName string) *Locations {
 		configName:    configName,
 		configGuiName: configName + "-gui",
 	}
+
+	if portable, ok := provider.(*PortableProvider); ok {
+		l.portableDir = portable.Root()
+	}
+
+	return l
+}
+
+// PortableDir returns the directory holding all files if bridge runs in portable mode, or an empty string otherwise.
+func (l *Locations) PortableDir() string {
+	return l.portableDir
 }
 
 // GetLockFile returns the path to the bridge lock file (e.g. ~/.cache/<company>/<app>/<app>.lock).
@@ -148,6 +169,27 @@ func (l *Locations) ProvideGluonCachePath() (string, error) {
 	return l.getGluonCachePath(), nil
 }
 
+// SetEphemeralPath makes the gluon store, the gluon database and the IMAP sync state live in the given directory,
+// typically a tmpfs mount. An empty path restores the persistent locations.
+func (l *Locations) SetEphemeralPath(path string) {
+	l.ephemeralPath = path
+}
+
+// IsEphemeral returns whether the gluon store, the gluon database and the IMAP sync state live in an ephemeral directory.
+func (l *Locations) IsEphemeral() bool {
+	return l.ephemeralPath != ""
+}
+
+// SetGluonCachePath records the location the gluon message cache was moved to.
+// An empty path restores the default location.
+func (l *Locations) SetGluonCachePath(path string) {
+	if path == filepath.Join(l.userData, "gluon") {
+		path = ""
+	}
+
+	l.gluonCachePath = path
+}
+
 // ProvideGluonDataPath returns a location for gluon data.
 // It creates it if it doesn't already exist.
 func (l *Locations) ProvideGluonDataPath() (string, error) {
@@ -196,6 +238,16 @@ func (l *Locations) ProvideIMAPSyncConfigPath() (string, error) {
 	return l.getIMAPSyncConfigPath(), nil
 }
 
+// ProvideEventJournalPath returns a location for the journals of the processed events of each user
+// (e.g. ~/.local/share/<company>/<app>/journal). It creates it if it doesn't already exist.
+func (l *Locations) ProvideEventJournalPath() (string, error) {
+	if err := os.MkdirAll(l.getEventJournalPath(), 0o700); err != nil {
+		return "", err
+	}
+
+	return l.getEventJournalPath(), nil
+}
+
 // ProvideUnleashCachePath returns a location for the unleash cache data (e.g. ~/.cache/protonmail/bridge-v3).
 // It creates it if it doesn't already exist.
 func (l *Locations) ProvideUnleashCachePath() (string, error) {
@@ -207,10 +259,22 @@ func (l *Locations) ProvideUnleashCachePath() (string, error) {
 }
 
 func (l *Locations) getGluonCachePath() string {
+	if l.ephemeralPath != "" {
+		return filepath.Join(l.ephemeralPath, "gluon")
+	}
+
+	if l.gluonCachePath != "" {
+		return l.gluonCachePath
+	}
+
 	return filepath.Join(l.userData, "gluon")
 }
 
 func (l *Locations) getGluonDataPath() string {
+	if l.ephemeralPath != "" {
+		return filepath.Join(l.ephemeralPath, "gluon")
+	}
+
 	return filepath.Join(l.userData, "gluon")
 }
 
@@ -223,9 +287,21 @@ func (l *Locations) getSettingsPath() string {
 }
 
 func (l *Locations) getIMAPSyncConfigPath() string {
+	if l.ephemeralPath != "" {
+		return filepath.Join(l.ephemeralPath, "imap-sync")
+	}
+
 	return filepath.Join(l.userConfig, "imap-sync")
 }
 
+func (l *Locations) getEventJournalPath() string {
+	if l.ephemeralPath != "" {
+		return filepath.Join(l.ephemeralPath, "journal")
+	}
+
+	return filepath.Join(l.userData, "journal")
+}
+
 func (l *Locations) getLogsPath() string {
 	return filepath.Join(l.userData, "logs")
 }
@@ -244,12 +320,19 @@ func (l *Locations) getNotificationsCachePath() string {
 
 func (l *Locations) getUnleashCachePath() string { return filepath.Join(l.userCache, "unleash_cache") }
 
-// Clear removes everything except the lock and update files.
+// Clear removes everything except the lock and update files, including a relocated or ephemeral message cache.
 func (l *Locations) Clear(except ...string) error {
+	targets := []string{l.userConfig, l.userData, l.userCache}
+	if l.gluonCachePath != "" {
+		targets = append(targets, l.gluonCachePath)
+	}
+
+	if l.ephemeralPath != "" {
+		targets = append(targets, l.ephemeralPath)
+	}
+
 	return files.Remove(
-		l.userConfig,
-		l.userData,
-		l.userCache,
+		targets...,
 	).Except(
 		append(except, l.GetGuiLockFile(), l.getUpdatesPath())...,
 	).Do()
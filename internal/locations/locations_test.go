@@ -20,6 +20,7 @@ package locations
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -63,6 +64,64 @@ func TestClearUpdateFiles(t *testing.T) {
 	assert.NoDirExists(t, l.getUpdatesPath())
 }
 
+func TestRelocatedGluonCachePath(t *testing.T) {
+	l := newTestLocations(t)
+
+	defaultPath, err := l.ProvideGluonCachePath()
+	require.NoError(t, err)
+
+	// Move the cache elsewhere.
+	newPath := filepath.Join(t.TempDir(), "gluon")
+	l.SetGluonCachePath(newPath)
+
+	path, err := l.ProvideGluonCachePath()
+	require.NoError(t, err)
+	assert.Equal(t, newPath, path)
+
+	// Clearing removes the relocated cache as well.
+	createFilesInDir(t, newPath, "foo")
+	assert.NoError(t, l.Clear())
+	assert.NoDirExists(t, newPath)
+
+	// Restore the default location.
+	l.SetGluonCachePath("")
+
+	path, err = l.ProvideGluonCachePath()
+	require.NoError(t, err)
+	assert.Equal(t, defaultPath, path)
+}
+
+func TestEphemeralPaths(t *testing.T) {
+	l := newTestLocations(t)
+	require.False(t, l.IsEphemeral())
+
+	ephemeralDir := t.TempDir()
+	l.SetEphemeralPath(ephemeralDir)
+	require.True(t, l.IsEphemeral())
+
+	// The gluon store, database and sync state all live in the ephemeral directory.
+	for _, provide := range []func() (string, error){
+		l.ProvideGluonCachePath,
+		l.ProvideGluonDataPath,
+		l.ProvideIMAPSyncConfigPath,
+		l.ProvideEventJournalPath,
+	} {
+		path, err := provide()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(path, ephemeralDir))
+	}
+
+	// A relocated message cache is ignored while ephemeral.
+	l.SetGluonCachePath(filepath.Join(t.TempDir(), "gluon"))
+
+	path, err := l.ProvideGluonCachePath()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(ephemeralDir, "gluon"), path)
+
+	// Settings stay in their persistent location.
+	assert.False(t, strings.HasPrefix(l.getSettingsPath(), ephemeralDir))
+}
+
 func TestRemoveOldGoIMAPCacheFolders(t *testing.T) {
 	l := newTestLocations(t)
 
@@ -86,6 +145,99 @@ func TestRemoveOldGoIMAPCacheFolders(t *testing.T) {
 	assert.NoDirExists(t, l.getGoIMAPCachePath())
 }
 
+func TestPortableLocations(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "portable")
+
+	provider, err := NewPortableProvider(dir)
+	require.NoError(t, err)
+
+	l := New(provider, "configName")
+	assert.Equal(t, dir, l.PortableDir())
+
+	// Everything lives in the portable directory.
+	for _, provide := range []func() (string, error){
+		l.ProvideSettingsPath,
+		l.ProvideGluonCachePath,
+		l.ProvideLogsPath,
+		l.ProvideUpdatesPath,
+		l.ProvideIMAPSyncConfigPath,
+		l.ProvideUnleashCachePath,
+	} {
+		path, err := provide()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(path, dir))
+	}
+
+	assert.True(t, strings.HasPrefix(l.GetLockFile(), dir))
+
+	// Cleaning the go-imap cache leaves the lock files alone.
+	createFilesInDir(t, filepath.Dir(l.GetLockFile()), filepath.Base(l.GetLockFile()))
+	require.NoError(t, l.CleanGoIMAPCache())
+	assert.FileExists(t, l.GetLockFile())
+
+	// The standard locations are not portable.
+	assert.Empty(t, newTestLocations(t).PortableDir())
+}
+
+func TestPortableDirFromEnv(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Setenv(PortableDirEnvVar, dir)
+
+	portableDir, ok := LookupPortableDir()
+	require.True(t, ok)
+	assert.Equal(t, dir, portableDir)
+}
+
+func TestMigrateLocationsToPortable(t *testing.T) {
+	from := newFakeAppDirs(t)
+
+	createFilesInDir(t, from.configDir, "vault.enc", "imap-sync/user.json")
+	createFilesInDir(t, from.dataDir, "gluon/backend/db/user.db", "logs/bridge.log")
+	createFilesInDir(t, from.cacheDir, "unleash_cache/cache.json")
+
+	dir := filepath.Join(t.TempDir(), "portable")
+	require.True(t, newPortableProvider(dir).isNew())
+
+	to, err := migrateToPortable(from, dir)
+	require.NoError(t, err)
+	require.False(t, to.isNew())
+
+	assert.FileExists(t, filepath.Join(dir, "vault.enc"))
+	assert.FileExists(t, filepath.Join(dir, "imap-sync", "user.json"))
+	assert.FileExists(t, filepath.Join(dir, "gluon", "backend", "db", "user.db"))
+	assert.FileExists(t, filepath.Join(dir, "logs", "bridge.log"))
+	assert.FileExists(t, filepath.Join(to.UserCache(), "unleash_cache", "cache.json"))
+
+	// The standard locations are left untouched.
+	assert.FileExists(t, filepath.Join(from.configDir, "vault.enc"))
+	assert.FileExists(t, filepath.Join(from.dataDir, "logs", "bridge.log"))
+}
+
+func TestMigrateLocationsToPortable_Failure(t *testing.T) {
+	from := newFakeAppDirs(t)
+
+	createFilesInDir(t, from.configDir, "vault.enc")
+	createFilesInDir(t, from.cacheDir, "unleash_cache/cache.json")
+
+	// The vault can't be copied over a directory of the same name.
+	dir := filepath.Join(t.TempDir(), "portable")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "vault.enc"), 0o700))
+
+	_, err := migrateToPortable(from, dir)
+	require.Error(t, err)
+
+	// The portable directory is still new, so the migration is tried again on the next start.
+	require.True(t, newPortableProvider(dir).isNew())
+	require.NoError(t, os.Remove(filepath.Join(dir, "vault.enc")))
+
+	to, err := migrateToPortable(from, dir)
+	require.NoError(t, err)
+	require.False(t, to.isNew())
+	assert.FileExists(t, filepath.Join(dir, "vault.enc"))
+	assert.FileExists(t, filepath.Join(to.UserCache(), "unleash_cache", "cache.json"))
+}
+
 func newFakeAppDirs(t *testing.T) *fakeAppDirs {
 	return &fakeAppDirs{
 		configDir: t.TempDir(),
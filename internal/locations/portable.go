@@ -0,0 +1,194 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package locations
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/files"
+	"github.com/sirupsen/logrus"
+)
+
+// PortableDirEnvVar is the environment variable holding the directory in which bridge keeps all its files when it
+// runs in portable mode. The launcher sets it for bridge, which may be started from the updates directory.
+const PortableDirEnvVar = "BRIDGE_PORTABLE_DIR"
+
+const (
+	// portableMarkerName is the name of the file which, when found next to the executable, turns on portable mode.
+	portableMarkerName = "portable"
+
+	// portableDataDirName is the name of the directory next to the executable used in portable mode
+	// when no directory is given through PortableDirEnvVar.
+	portableDataDirName = "bridge-data"
+)
+
+// LookupPortableDir returns the directory in which bridge keeps all its files when it runs in portable mode,
+// and whether it does. The directory is taken from PortableDirEnvVar or, if a portable marker file lies next
+// to the executable (e.g. on a USB stick), is a directory next to the executable.
+func LookupPortableDir() (string, bool) {
+	if dir := os.Getenv(PortableDirEnvVar); dir != "" {
+		if abs, err := filepath.Abs(dir); err == nil {
+			return abs, true
+		}
+
+		return dir, true
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return "", false
+	}
+
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+
+	if _, err := os.Stat(filepath.Join(filepath.Dir(exe), portableMarkerName)); err != nil {
+		return "", false
+	}
+
+	return filepath.Join(filepath.Dir(exe), portableDataDirName), true
+}
+
+// NewProvider returns a locations provider using the portable directory if bridge runs in portable mode,
+// and the system-default storage locations otherwise.
+// On the first start in portable mode, the files found in the system-default locations are copied over.
+func NewProvider(name string) (Provider, error) {
+	dir, ok := LookupPortableDir()
+	if !ok {
+		return NewDefaultProvider(name)
+	}
+
+	return newPortableProviderWithMigration(name, dir)
+}
+
+// PortableProvider is a locations provider keeping everything in a single directory.
+// Like on macOS and Windows, config and data share the same directory; non-essential data lives in a subdirectory.
+type PortableProvider struct {
+	root, cache string
+}
+
+// NewPortableProvider returns a locations provider keeping config, data and cache in the given directory.
+func NewPortableProvider(dir string) (*PortableProvider, error) {
+	provider := newPortableProvider(dir)
+
+	if err := os.MkdirAll(provider.cache, 0o700); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+func newPortableProvider(dir string) *PortableProvider {
+	return &PortableProvider{
+		root: dir,
+		// Not "cache", which is the legacy go-imap cache directory within the config directory.
+		cache: filepath.Join(dir, "runtime"),
+	}
+}
+
+// Root returns the directory holding everything.
+func (p *PortableProvider) Root() string {
+	return p.root
+}
+
+// UserConfig returns the directory used to store user-specific configuration.
+func (p *PortableProvider) UserConfig() string {
+	return p.root
+}
+
+// UserData returns the directory used to store user-specific data.
+func (p *PortableProvider) UserData() string {
+	return p.root
+}
+
+// UserCache returns the directory used to store user-specific non-essential data.
+func (p *PortableProvider) UserCache() string {
+	return p.cache
+}
+
+// isNew returns whether the provider's directory was never used by bridge yet.
+func (p *PortableProvider) isNew() bool {
+	return !files.Exists(p.cache)
+}
+
+func newPortableProviderWithMigration(name, dir string) (*PortableProvider, error) {
+	if !newPortableProvider(dir).isNew() {
+		return NewPortableProvider(dir)
+	}
+
+	from, err := newDefaultProvider(name)
+	if err != nil {
+		logrus.WithError(err).Warn("Could not get the standard locations, nothing is migrated to the portable directory")
+		return NewPortableProvider(dir)
+	}
+
+	return migrateToPortable(from, dir)
+}
+
+// migrateToPortable copies the files of the from locations to the new portable directory.
+// The cache directory, which marks the portable directory as used, is only created once they all are;
+// if the migration fails, it is tried again on the next start.
+func migrateToPortable(from Provider, dir string) (*PortableProvider, error) {
+	provider := newPortableProvider(dir)
+
+	if err := migrateLocations(from, provider); err != nil {
+		if err := os.RemoveAll(provider.cache); err != nil {
+			logrus.WithError(err).Error("Failed to remove the portable cache directory")
+		}
+
+		return nil, fmt.Errorf("failed to migrate the standard locations to the portable directory: %w", err)
+	}
+
+	if err := os.MkdirAll(provider.cache, 0o700); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// migrateLocations copies the files of the from locations to the to locations.
+// The from locations are left untouched so that bridge can still be run in non-portable mode.
+func migrateLocations(from, to Provider) error {
+	copied := make(map[[2]string]struct{})
+
+	for _, dirs := range [][2]string{
+		{from.UserConfig(), to.UserConfig()},
+		{from.UserData(), to.UserData()},
+		{from.UserCache(), to.UserCache()},
+	} {
+		src, dst := dirs[0], dirs[1]
+
+		// On macOS and Windows, the standard config and data directories are the same.
+		if _, ok := copied[dirs]; ok || !files.Exists(src) {
+			continue
+		}
+
+		copied[dirs] = struct{}{}
+
+		logrus.WithField("from", src).WithField("to", dst).Info("Migrating files to the portable directory")
+
+		if err := files.CopyDir(src, dst); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
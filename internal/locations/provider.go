@@ -37,40 +37,48 @@ type DefaultProvider struct {
 }
 
 func NewDefaultProvider(name string) (*DefaultProvider, error) {
-	config, err := os.UserConfigDir()
+	provider, err := newDefaultProvider(name)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := userDataDir()
-	if err != nil {
+	if err := os.MkdirAll(provider.config, 0o700); err != nil {
 		return nil, err
 	}
 
-	cache, err := os.UserCacheDir()
-	if err != nil {
+	if err := os.MkdirAll(provider.data, 0o700); err != nil {
 		return nil, err
 	}
 
-	provider := &DefaultProvider{
-		config: filepath.Join(config, name),
-		data:   filepath.Join(data, name),
-		cache:  filepath.Join(cache, name),
+	if err := os.MkdirAll(provider.cache, 0o700); err != nil {
+		return nil, err
 	}
 
-	if err := os.MkdirAll(provider.config, 0o700); err != nil {
+	return provider, nil
+}
+
+// newDefaultProvider returns the system-default storage locations without creating them.
+func newDefaultProvider(name string) (*DefaultProvider, error) {
+	config, err := os.UserConfigDir()
+	if err != nil {
 		return nil, err
 	}
 
-	if err := os.MkdirAll(provider.data, 0o700); err != nil {
+	data, err := userDataDir()
+	if err != nil {
 		return nil, err
 	}
 
-	if err := os.MkdirAll(provider.cache, 0o700); err != nil {
+	cache, err := os.UserCacheDir()
+	if err != nil {
 		return nil, err
 	}
 
-	return provider, nil
+	return &DefaultProvider{
+		config: filepath.Join(config, name),
+		data:   filepath.Join(data, name),
+		cache:  filepath.Join(cache, name),
+	}, nil
 }
 
 // UserConfig returns a directory that can be used to store user-specific configuration.
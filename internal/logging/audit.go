@@ -0,0 +1,56 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"errors"
+	"sync"
+)
+
+var ErrAuditLogUnavailable = errors.New("the audit log is unavailable until logging is initialized")
+
+// auditTarget is where the audit log files are written: next to the log files of the current session,
+// with the same rotation options.
+var auditTarget struct { //nolint:gochecknoglobals
+	lock      sync.Mutex
+	logsPath  string
+	sessionID SessionID
+	options   RotationOptions
+}
+
+func setAuditTarget(logsPath string, sessionID SessionID, options RotationOptions) {
+	auditTarget.lock.Lock()
+	defer auditTarget.lock.Unlock()
+
+	auditTarget.logsPath = logsPath
+	auditTarget.sessionID = sessionID
+	auditTarget.options = options
+}
+
+// NewAuditLog creates the rotator writing the audit log files of the current session.
+// As files are numbered from the start, it must be created at most once per session.
+func NewAuditLog() (*Rotator, error) {
+	auditTarget.lock.Lock()
+	defer auditTarget.lock.Unlock()
+
+	if auditTarget.logsPath == "" {
+		return nil, ErrAuditLogUnavailable
+	}
+
+	return NewDefaultRotatorWithOptions(auditTarget.logsPath, auditTarget.sessionID, AuditShortAppName, auditTarget.options)
+}
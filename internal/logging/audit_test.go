@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogging_AuditLog(t *testing.T) {
+	setAuditTarget("", "", RotationOptions{})
+
+	_, err := NewAuditLog()
+	require.ErrorIs(t, err, ErrAuditLogUnavailable)
+
+	dir := t.TempDir()
+	sessionID := createDummySession(t, dir, 1000, 0, 0, 500)
+
+	setAuditTarget(dir, sessionID, RotationOptions{MaxFileSize: 10, MaxTotalSize: NoPruning})
+
+	auditLog, err := NewAuditLog()
+	require.NoError(t, err)
+
+	// The audit log rotates like the other logs of the session.
+	_, err = auditLog.Write([]byte("0123456789"))
+	require.NoError(t, err)
+	_, err = auditLog.Write([]byte("01234"))
+	require.NoError(t, err)
+	require.NoError(t, auditLog.Close())
+
+	checkFolderContent(t, dir, []fileInfo{
+		{filename: string(sessionID) + "_bri_000" + logFileSuffix, size: 500},
+		{filename: string(sessionID) + "_aud_000" + logFileSuffix, size: 10},
+		{filename: string(sessionID) + "_aud_001" + logFileSuffix, size: 5},
+	}...)
+
+	// The audit log is never part of bug reports.
+	paths, err := getOrderedLogFileListForBugReport(dir, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, string(sessionID)+"_bri_000"+logFileSuffix)}, paths)
+
+	// The audit log is pruned along with its session.
+	old := time.Now().Add(-40 * 24 * time.Hour)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	for _, entry := range entries {
+		require.NoError(t, os.Chtimes(filepath.Join(dir, entry.Name()), old, old))
+	}
+
+	failureCount, err := pruneOldLogs(dir, createDummySession(t, dir, 1000, 0, 0, 500), 30*24*time.Hour)
+	require.NoError(t, err)
+	require.Zero(t, failureCount)
+
+	entries, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
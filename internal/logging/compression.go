@@ -20,12 +20,17 @@ package logging
 import (
 	"archive/zip"
 	"bytes"
+	"compress/gzip"
 	"errors"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
+// compressedLogExt is the extension appended to the name of compressed log files.
+const compressedLogExt = ".gz"
+
 var (
 	errNoInputFile      = errors.New("no file was provided to put in the archive")
 	errCannotFitAnyFile = errors.New("no file can fit in the archive")
@@ -168,3 +173,105 @@ func copyZipContent(zipReader *zip.Reader, zipWriter *zip.Writer) error {
 
 	return nil
 }
+
+// compressLogFile replaces the log file at path with a gzip-compressed copy named after it.
+func compressLogFile(path string) error {
+	src, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = src.Close() }()
+
+	tmpPath := path + compressedLogExt + ".tmp"
+
+	dst, err := os.Create(filepath.Clean(tmpPath))
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(dst)
+	gz.Name = filepath.Base(path)
+
+	_, err = io.Copy(gz, src)
+	if closeErr := gz.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	if err := os.Rename(tmpPath, path+compressedLogExt); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	_ = src.Close()
+
+	return os.Remove(path)
+}
+
+// compressPreviousSessionsLogs compresses the log files written uncompressed by previous sessions,
+// e.g. by versions of bridge that did not compress rotated log files.
+func compressPreviousSessionsLogs(logsPath string, currentSessionID SessionID) (failureCount int, err error) {
+	sessionInfoList, err := buildSessionInfoList(logsPath)
+	if err != nil {
+		return 0, err
+	}
+
+	for sessionID, session := range sessionInfoList {
+		if sessionID == currentSessionID {
+			continue
+		}
+
+		for _, log := range session.allLogs() {
+			if strings.HasSuffix(log.filename, compressedLogExt) {
+				continue
+			}
+
+			if err := compressLogFile(filepath.Join(logsPath, log.filename)); err != nil {
+				failureCount++
+			}
+		}
+	}
+
+	return failureCount, nil
+}
+
+// openLogFile opens the log file at path for reading, transparently decompressing it if needed.
+func openLogFile(path string) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasSuffix(path, compressedLogExt) {
+		return f, nil
+	}
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &gzipLogReader{Reader: gz, file: f}, nil
+}
+
+type gzipLogReader struct {
+	*gzip.Reader
+	file *os.File
+}
+
+func (r *gzipLogReader) Close() error {
+	err := r.Reader.Close()
+	if closeErr := r.file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
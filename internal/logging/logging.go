@@ -21,10 +21,12 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/bradenaw/juniper/xslices"
@@ -41,14 +43,46 @@ const (
 	// fallback is sending the report via email, which has a limit of 10mb
 	// total or 7MB per file.
 	DefaultMaxLogFileSize = 5 * 1024 * 1024
+
+	// DefaultMaxLogAge is the age above which the logs of previous sessions are deleted.
+	DefaultMaxLogAge = 30 * 24 * time.Hour
 )
 
+// RotationOptions controls when log files are rotated and how long the rotated ones are kept.
+type RotationOptions struct {
+	// MaxFileSize is the size above which the log file is rotated.
+	MaxFileSize int64
+
+	// MaxTotalSize is the disk budget of all log files, past which the oldest are deleted. NoPruning disables it.
+	MaxTotalSize int64
+
+	// MaxAge is the age above which the log files of previous sessions are deleted. Zero disables it.
+	MaxAge time.Duration
+
+	// Compress enables the gzip compression of rotated log files.
+	Compress bool
+}
+
+// DefaultRotationOptions returns the rotation options used unless configured otherwise.
+func DefaultRotationOptions() RotationOptions {
+	return RotationOptions{
+		MaxFileSize:  DefaultMaxLogFileSize,
+		MaxTotalSize: DefaultPruningSize,
+		MaxAge:       DefaultMaxLogAge,
+		Compress:     true,
+	}
+}
+
 type AppName string
 
 const (
 	BridgeShortAppName   AppName = "bri"
 	LauncherShortAppName AppName = "lau"
 	GUIShortAppName      AppName = "gui"
+
+	// AuditShortAppName names the files of the IMAP session audit log. They are rotated and pruned along with
+	// the other log files of their session, but never included in bug reports.
+	AuditShortAppName AppName = "aud"
 )
 
 type coloredStdOutHook struct {
@@ -75,6 +109,10 @@ func (cs *coloredStdOutHook) Levels() []logrus.Level {
 }
 
 func (cs *coloredStdOutHook) Fire(entry *logrus.Entry) error {
+	if !isEnabled(entry) {
+		return nil
+	}
+
 	bytes, err := cs.formatter.Format(entry)
 	if err != nil {
 		return err
@@ -87,26 +125,63 @@ func (cs *coloredStdOutHook) Fire(entry *logrus.Entry) error {
 	return nil
 }
 
-// Init Initialize logging. Log files are rotated when their size exceeds rotationSize. if pruningSize >= 0, pruning occurs using
-// the default pruning algorithm.
-func Init(logsPath string, sessionID SessionID, appName AppName, rotationSize, pruningSize int64, level string) (io.Closer, error) {
-	logrus.SetFormatter(&logrus.TextFormatter{
-		DisableColors:    true,
-		ForceQuote:       true,
-		FullTimestamp:    true,
-		QuoteEmptyFields: true,
-		TimestampFormat:  "2006-01-02 15:04:05.000",
-	})
+// Format is the format of the log entries.
+type Format string
+
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+)
+
+var ErrUnknownFormat = errors.New("unknown log format")
+
+// ParseFormat parses a log format; an empty string means the text format.
+func ParseFormat(format string) (Format, error) {
+	switch Format(strings.ToLower(format)) {
+	case "", FormatText:
+		return FormatText, nil
+
+	case FormatJSON:
+		return FormatJSON, nil
+
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+// SetFormat sets the format of the log entries.
+func SetFormat(format Format) {
+	if format == FormatJSON {
+		logrus.SetFormatter(&levelFilter{Formatter: &logrus.JSONFormatter{
+			TimestampFormat: "2006-01-02T15:04:05.000Z07:00",
+		}})
+	} else {
+		logrus.SetFormatter(&levelFilter{Formatter: &logrus.TextFormatter{
+			DisableColors:    true,
+			ForceQuote:       true,
+			FullTimestamp:    true,
+			QuoteEmptyFields: true,
+			TimestampFormat:  "2006-01-02 15:04:05.000",
+		}})
+	}
+}
+
+// Init Initialize logging. Log files are rotated, compressed and pruned according to the rotation options.
+// The level may set the level of some subsystems, e.g. "info,imap=debug".
+func Init(logsPath string, sessionID SessionID, appName AppName, options RotationOptions, level string) (io.Closer, error) {
+	SetFormat(FormatText)
 
 	logrus.AddHook(newColoredStdOutHook())
 
-	rotator, err := NewDefaultRotator(logsPath, sessionID, appName, rotationSize, pruningSize)
+	rotator, err := NewDefaultRotatorWithOptions(logsPath, sessionID, appName, options)
 	if err != nil {
 		return nil, err
 	}
 
 	logrus.SetOutput(rotator)
 
+	setAuditTarget(logsPath, sessionID, options)
+
 	return rotator, setLevel(level)
 }
 
@@ -181,33 +256,29 @@ func getOrderedLogFileListForBugReport(logsPath string, maxSessionCount int) ([]
 // higher will not set writing to file again if it was previously cancelled by
 // Debug or Trace.
 func setLevel(level string) error {
-	if level == "" {
-		level = "debug"
-	}
-
-	logLevel, err := logrus.ParseLevel(level)
+	logLevel, overrides, err := ParseLevels(level)
 	if err != nil {
 		return err
 	}
 
-	logrus.SetLevel(logLevel)
+	setLevels(logLevel, overrides)
 
 	// The hook to print panic, fatal and error to stderr is always
 	// added. We want to avoid log duplicates by replacing all hooks.
-	if logrus.GetLevel() == logrus.TraceLevel {
+	if logLevel == logrus.TraceLevel {
 		_ = logrus.StandardLogger().ReplaceHooks(logrus.LevelHooks{})
 		logrus.SetOutput(os.Stderr)
-		logrus.SetFormatter(&logrus.TextFormatter{
+		logrus.SetFormatter(&levelFilter{Formatter: &logrus.TextFormatter{
 			FullTimestamp:   true,
 			TimestampFormat: time.StampMilli,
-		})
+		}})
 	}
 
 	return nil
 }
 
 func getLogSessionID(filename string) (SessionID, error) {
-	re := regexp.MustCompile(`^(?P<sessionID>\d{8}_\d{9})_.*\.log$`)
+	re := regexp.MustCompile(`^(?P<sessionID>\d{8}_\d{9})_.*\.log(?:\.gz)?$`)
 
 	match := re.FindStringSubmatch(filename)
 
@@ -250,7 +321,7 @@ func MatchLauncherLogName(filename string) bool {
 }
 
 func matchLogName(logName string, appName AppName) bool {
-	return regexp.MustCompile(`^\d{8}_\d{9}_\Q` + string(appName) + `\E_\d{3}_.*\.log$`).MatchString(logName)
+	return regexp.MustCompile(`^\d{8}_\d{9}_\Q` + string(appName) + `\E_\d{3}_.*\.log(?:\.gz)?$`).MatchString(logName)
 }
 
 type logKey string
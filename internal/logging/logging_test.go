@@ -97,7 +97,7 @@ func TestLogging_GetOrderedLogFileListForBugReport(t *testing.T) {
 
 func TestLogging_Close(t *testing.T) {
 	d := t.TempDir()
-	closer, err := Init(d, NewSessionID(), constants.AppName, 1, DefaultPruningSize, "debug")
+	closer, err := Init(d, NewSessionID(), constants.AppName, RotationOptions{MaxFileSize: 1, MaxTotalSize: DefaultPruningSize}, "debug")
 	require.NoError(t, err)
 	logrus.Debug("Test") // because we set max log file size to 1, this will force a rotation of the log file.
 	require.NotNil(t, closer)
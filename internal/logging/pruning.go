@@ -22,6 +22,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/bradenaw/juniper/xslices"
 	"golang.org/x/exp/maps"
@@ -38,6 +39,7 @@ type Pruner func() (failureCount int, err error)
 type logFileInfo struct {
 	filename string
 	size     int64
+	modTime  time.Time
 }
 
 type sessionInfo struct {
@@ -46,11 +48,19 @@ type sessionInfo struct {
 	launcherLogs []logFileInfo
 	guiLogs      []logFileInfo
 	bridgeLogs   []logFileInfo
+	auditLogs    []logFileInfo
 }
 
-func defaultPruner(logsDir string, currentSessionID SessionID, pruningSize int64) func() (failureCount int, err error) {
+func defaultPruner(logsDir string, currentSessionID SessionID, pruningSize int64, maxAge time.Duration) func() (failureCount int, err error) {
 	return func() (int, error) {
-		return pruneLogs(logsDir, currentSessionID, pruningSize)
+		failureCount, err := pruneOldLogs(logsDir, currentSessionID, maxAge)
+		if err != nil || pruningSize < 0 {
+			return failureCount, err
+		}
+
+		sizeFailureCount, err := pruneLogs(logsDir, currentSessionID, pruningSize)
+
+		return failureCount + sizeFailureCount, err
 	}
 }
 
@@ -108,13 +118,38 @@ func pruneLogs(logDir string, currentSessionID SessionID, pruningSize int64) (fa
 	return failureCount, nil
 }
 
+// pruneOldLogs deletes the sessions, other than the current one, whose last log file was written more than maxAge ago.
+// A zero maxAge keeps all sessions regardless of their age.
+func pruneOldLogs(logDir string, currentSessionID SessionID, maxAge time.Duration) (failureCount int, err error) {
+	if maxAge <= 0 {
+		return 0, nil
+	}
+
+	sessionInfoList, err := buildSessionInfoList(logDir)
+	if err != nil {
+		return 0, err
+	}
+
+	for sessionID, sessionInfo := range sessionInfoList {
+		if sessionID == currentSessionID {
+			continue
+		}
+
+		if time.Since(sessionInfo.lastModTime()) > maxAge {
+			failureCount += sessionInfo.deleteFiles()
+		}
+	}
+
+	return failureCount, nil
+}
+
 func newSessionInfo(dir string, sessionID SessionID) (*sessionInfo, error) {
-	paths, err := filepath.Glob(filepath.Join(dir, string(sessionID)+"_*.log"))
+	paths, err := filepath.Glob(filepath.Join(dir, string(sessionID)+"_*.log*"))
 	if err != nil {
 		return nil, err
 	}
 
-	rx := regexp.MustCompile(`^\Q` + string(sessionID) + `\E_([^_]*)_\d+_.*\.log$`)
+	rx := regexp.MustCompile(`^\Q` + string(sessionID) + `\E_([^_]*)_\d+_.*\.log(?:\.gz)?$`)
 
 	result := sessionInfo{sessionID: sessionID, dir: dir}
 	for _, path := range paths {
@@ -132,6 +167,7 @@ func newSessionInfo(dir string, sessionID SessionID) (*sessionInfo, error) {
 		fileInfo := logFileInfo{
 			filename: filename,
 			size:     stats.Size(),
+			modTime:  stats.ModTime(),
 		}
 
 		switch AppName(match[1]) {
@@ -141,6 +177,8 @@ func newSessionInfo(dir string, sessionID SessionID) (*sessionInfo, error) {
 			result.guiLogs = append(result.guiLogs, fileInfo)
 		case BridgeShortAppName:
 			result.bridgeLogs = append(result.bridgeLogs, fileInfo)
+		case AuditShortAppName:
+			result.auditLogs = append(result.auditLogs, fileInfo)
 		}
 	}
 
@@ -148,6 +186,7 @@ func newSessionInfo(dir string, sessionID SessionID) (*sessionInfo, error) {
 	slices.SortFunc(result.launcherLogs, lessFunc)
 	slices.SortFunc(result.guiLogs, lessFunc)
 	slices.SortFunc(result.bridgeLogs, lessFunc)
+	slices.SortFunc(result.auditLogs, lessFunc)
 
 	return &result, nil
 }
@@ -157,16 +196,34 @@ func (s *sessionInfo) size() int64 {
 	size := xslices.Reduce(s.launcherLogs, 0, summer)
 	size = xslices.Reduce(s.guiLogs, size, summer)
 	size = xslices.Reduce(s.bridgeLogs, size, summer)
+	size = xslices.Reduce(s.auditLogs, size, summer)
 	return size
 }
 
-func (s *sessionInfo) deleteFiles() (failureCount int) {
+func (s *sessionInfo) allLogs() []logFileInfo {
 	var allLogs []logFileInfo
 	allLogs = append(allLogs, s.launcherLogs...)
 	allLogs = append(allLogs, s.guiLogs...)
 	allLogs = append(allLogs, s.bridgeLogs...)
+	allLogs = append(allLogs, s.auditLogs...)
+
+	return allLogs
+}
 
-	for _, log := range allLogs {
+func (s *sessionInfo) lastModTime() time.Time {
+	var lastModTime time.Time
+
+	for _, log := range s.allLogs() {
+		if log.modTime.After(lastModTime) {
+			lastModTime = log.modTime
+		}
+	}
+
+	return lastModTime
+}
+
+func (s *sessionInfo) deleteFiles() (failureCount int) {
+	for _, log := range s.allLogs() {
 		if err := os.Remove(filepath.Join(s.dir, log.filename)); err != nil {
 			failureCount++
 		}
@@ -210,7 +267,7 @@ func buildSessionInfoList(dir string) (map[SessionID]*sessionInfo, error) {
 		if entry.IsDir() {
 			continue
 		}
-		rx := regexp.MustCompile(`^(\d{8}_\d{9})_.*\.log$`)
+		rx := regexp.MustCompile(`^(\d{8}_\d{9})_.*\.log(?:\.gz)?$`)
 		match := rx.FindStringSubmatch(entry.Name())
 		if match == nil || len(match) < 2 {
 			continue
@@ -182,6 +182,44 @@ func TestLogging_PruningBigCurrentSession(t *testing.T) {
 	checkFolderContent(t, dir, minimalFiles...)
 }
 
+func TestLogging_PruningOldSessions(t *testing.T) {
+	dir := t.TempDir()
+	const maxLogFileSize = 1000
+	oldSessionID := createDummySession(t, dir, maxLogFileSize, 500, 500, 1500)
+	recentSessionID := createDummySession(t, dir, maxLogFileSize, 500, 500, 500)
+	currentSessionID := createDummySession(t, dir, maxLogFileSize, 500, 500, 500)
+
+	// Age the old and the current sessions.
+	old := time.Now().Add(-40 * 24 * time.Hour)
+	for _, sessionID := range []SessionID{oldSessionID, currentSessionID} {
+		paths, err := filepath.Glob(filepath.Join(dir, string(sessionID)+"_*.log"))
+		require.NoError(t, err)
+
+		for _, path := range paths {
+			require.NoError(t, os.Chtimes(path, old, old))
+		}
+	}
+
+	// A zero max age keeps everything.
+	failureCount, err := pruneOldLogs(dir, currentSessionID, 0)
+	require.NoError(t, err)
+	require.Zero(t, failureCount)
+
+	failureCount, err = pruneOldLogs(dir, currentSessionID, 30*24*time.Hour)
+	require.NoError(t, err)
+	require.Zero(t, failureCount)
+
+	// Only the old session is deleted; the current one is kept regardless of its age.
+	checkFolderContent(t, dir, []fileInfo{
+		{filename: string(recentSessionID) + "_lau_000" + logFileSuffix, size: 500},
+		{filename: string(recentSessionID) + "_gui_000" + logFileSuffix, size: 500},
+		{filename: string(recentSessionID) + "_bri_000" + logFileSuffix, size: 500},
+		{filename: string(currentSessionID) + "_lau_000" + logFileSuffix, size: 500},
+		{filename: string(currentSessionID) + "_gui_000" + logFileSuffix, size: 500},
+		{filename: string(currentSessionID) + "_bri_000" + logFileSuffix, size: 500},
+	}...)
+}
+
 func createDummySession(t *testing.T, dir string, maxLogFileSize int64, launcherLogSize, guiLogSize, bridgeLogSize int64) SessionID {
 	time.Sleep(2 * time.Millisecond) // ensure our sessionID is unused.
 	sessionID := NewSessionID()
@@ -0,0 +1,104 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	emailRegexp = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(\.[A-Za-z0-9\-]+)+`) //nolint:gochecknoglobals
+
+	// apiIDRegexp matches the base64url-encoded IDs of API resources (messages, users, addresses...).
+	apiIDRegexp = regexp.MustCompile(`[A-Za-z0-9_\-]{86}==`) //nolint:gochecknoglobals
+)
+
+// Redact replaces email addresses and API IDs (including message IDs) found in text by a short hash of them.
+// The same value is always replaced by the same hash, so occurrences can still be correlated.
+// Unlike Sensitive, it does not depend on the build tags.
+func Redact(text string) string {
+	text = emailRegexp.ReplaceAllStringFunc(text, func(email string) string {
+		return RedactValue("email", email)
+	})
+
+	return apiIDRegexp.ReplaceAllStringFunc(text, func(id string) string {
+		return RedactValue("id", id)
+	})
+}
+
+// RedactValue returns the placeholder used by Redact for a value of the given kind.
+func RedactValue(kind, value string) string {
+	sum := sha256.Sum256([]byte(value))
+
+	return fmt.Sprintf("<%s:%s>", kind, hex.EncodeToString(sum[:])[0:8])
+}
+
+// WriteRedactedLogs adds the redacted, uncompressed logs of the last maxSessionCount sessions to the archive, in the dir folder.
+// Files are added in the same order of priority as for bug reports, skipping those that would exceed maxSize in total.
+func WriteRedactedLogs(zw *zip.Writer, dir, logsPath string, maxSessionCount int, maxSize int64) error {
+	paths, err := getOrderedLogFileListForBugReport(logsPath, maxSessionCount)
+	if err != nil {
+		return err
+	}
+
+	var size int64
+
+	for _, filePath := range paths {
+		b, err := readLogFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		redacted := Redact(string(b))
+
+		if size+int64(len(redacted)) > maxSize {
+			continue
+		}
+
+		w, err := zw.Create(path.Join(dir, strings.TrimSuffix(filepath.Base(filePath), compressedLogExt)))
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.Write([]byte(redacted)); err != nil {
+			return err
+		}
+
+		size += int64(len(redacted))
+	}
+
+	return nil
+}
+
+func readLogFile(path string) ([]byte, error) {
+	r, err := openLogFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = r.Close() }()
+
+	return io.ReadAll(r)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedact(t *testing.T) {
+	messageID := strings.Repeat("aB3_-", 17) + "x=="
+
+	text := "Sending message " + messageID + " from john.doe+tag@proton.me to <jane@mail.example.com>"
+	redacted := Redact(text)
+
+	require.NotContains(t, redacted, "john.doe")
+	require.NotContains(t, redacted, "jane@")
+	require.NotContains(t, redacted, messageID)
+
+	require.Equal(t,
+		"Sending message "+RedactValue("id", messageID)+
+			" from "+RedactValue("email", "john.doe+tag@proton.me")+
+			" to <"+RedactValue("email", "jane@mail.example.com")+">",
+		redacted,
+	)
+}
+
+func TestRedact_Stable(t *testing.T) {
+	require.Equal(t, Redact("user@example.com"), Redact("user@example.com"))
+	require.NotEqual(t, Redact("user@example.com"), Redact("other@example.com"))
+}
+
+func TestRedact_NothingToRedact(t *testing.T) {
+	text := "time=2024-01-01 level=info msg=\"IMAP server listening on 127.0.0.1:1143\""
+
+	require.Equal(t, text, Redact(text))
+}
+
+func TestRedact_WriteRedactedLogs(t *testing.T) {
+	dir := t.TempDir()
+
+	filename := string(NewSessionID()) + "_bri_000_v" + constants.Version + "_" + constants.Tag + ".log"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, filename), []byte("Login of user@example.com succeeded\n"), 0o600))
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	require.NoError(t, WriteRedactedLogs(zw, "logs", dir, 1, 1024))
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 1)
+	require.Equal(t, "logs/"+filename, zr.File[0].Name)
+
+	f, err := zr.File[0].Open()
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	b, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.Equal(t, "Login of "+RedactValue("email", "user@example.com")+" succeeded\n", string(b))
+}
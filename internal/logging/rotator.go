@@ -24,11 +24,13 @@ import (
 	"path/filepath"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/sirupsen/logrus"
 )
 
 type Rotator struct {
 	getFile     FileProvider
 	prune       Pruner
+	compress    Compressor
 	wc          io.WriteCloser
 	size        int64
 	maxFileSize int64
@@ -37,11 +39,22 @@ type Rotator struct {
 
 type FileProvider func(index int) (io.WriteCloser, error)
 
+// Compressor compresses the log file with the given index once it has been rotated.
+type Compressor func(index int) error
+
+func logFileName(sessionID SessionID, appName AppName, index int) string {
+	return fmt.Sprintf("%v_%v_%03d_v%v_%v.log", sessionID, appName, index, constants.Version, constants.Tag)
+}
+
 func defaultFileProvider(logsPath string, sessionID SessionID, appName AppName) FileProvider {
 	return func(index int) (io.WriteCloser, error) {
-		return os.Create(filepath.Join(logsPath, //nolint:gosec // G304
-			fmt.Sprintf("%v_%v_%03d_v%v_%v.log", sessionID, appName, index, constants.Version, constants.Tag),
-		))
+		return os.Create(filepath.Join(logsPath, logFileName(sessionID, appName, index))) //nolint:gosec // G304
+	}
+}
+
+func defaultCompressor(logsPath string, sessionID SessionID, appName AppName) Compressor {
+	return func(index int) error {
+		return compressLogFile(filepath.Join(logsPath, logFileName(sessionID, appName, index)))
 	}
 }
 
@@ -60,14 +73,40 @@ func NewRotator(maxFileSize int64, getFile FileProvider, prune Pruner) (*Rotator
 }
 
 func NewDefaultRotator(logsPath string, sessionID SessionID, appName AppName, maxLogFileSize, pruningSize int64) (*Rotator, error) {
+	return NewDefaultRotatorWithOptions(logsPath, sessionID, appName, RotationOptions{
+		MaxFileSize:  maxLogFileSize,
+		MaxTotalSize: pruningSize,
+	})
+}
+
+// NewDefaultRotatorWithOptions creates a rotator writing the log files of the given session in logsPath.
+// When compression is enabled, the uncompressed log files of the previous sessions are compressed first.
+func NewDefaultRotatorWithOptions(logsPath string, sessionID SessionID, appName AppName, options RotationOptions) (*Rotator, error) {
 	var pruner Pruner
-	if pruningSize < 0 {
+	if options.MaxTotalSize < 0 && options.MaxAge <= 0 {
 		pruner = nullPruner
 	} else {
-		pruner = defaultPruner(logsPath, sessionID, pruningSize)
+		pruner = defaultPruner(logsPath, sessionID, options.MaxTotalSize, options.MaxAge)
+	}
+
+	if options.Compress {
+		if failureCount, err := compressPreviousSessionsLogs(logsPath, sessionID); err != nil {
+			return nil, err
+		} else if failureCount > 0 {
+			logrus.WithField("failureCount", failureCount).Warn("Failed to compress some log files of previous sessions")
+		}
+	}
+
+	r, err := NewRotator(options.MaxFileSize, defaultFileProvider(logsPath, sessionID, appName), pruner)
+	if err != nil {
+		return nil, err
 	}
 
-	return NewRotator(maxLogFileSize, defaultFileProvider(logsPath, sessionID, appName), pruner)
+	if options.Compress {
+		r.compress = defaultCompressor(logsPath, sessionID, appName)
+	}
+
+	return r, nil
 }
 
 func (r *Rotator) Write(p []byte) (int, error) {
@@ -97,6 +136,11 @@ func (r *Rotator) Close() error {
 func (r *Rotator) rotate() error {
 	if r.wc != nil {
 		_ = r.wc.Close()
+
+		// If compression fails, the log file is simply kept as is.
+		if r.compress != nil {
+			_ = r.compress(r.nextIndex - 1)
+		}
 	}
 
 	if _, err := r.prune(); err != nil {
@@ -24,6 +24,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -154,6 +155,55 @@ func TestLogging_DefaultRotatorWithPruning(t *testing.T) {
 	}...)
 }
 
+func TestLogging_DefaultRotatorWithCompression(t *testing.T) {
+	tenBytes := []byte("0123456789")
+	tmpDir := t.TempDir()
+
+	// A log file left uncompressed by a previous session.
+	prevSessionID := NewSessionID()
+	prevPath := filepath.Join(tmpDir, logFileName(prevSessionID, "bri", 0))
+	require.NoError(t, os.WriteFile(prevPath, tenBytes, 0o600))
+
+	time.Sleep(2 * time.Millisecond) // ensure our sessionID is unused.
+	sessionID := NewSessionID()
+	basePath := filepath.Join(tmpDir, string(sessionID))
+
+	r, err := NewDefaultRotatorWithOptions(tmpDir, sessionID, "bri", RotationOptions{
+		MaxFileSize:  10,
+		MaxTotalSize: NoPruning,
+		Compress:     true,
+	})
+	require.NoError(t, err)
+
+	// The log file of the previous session got compressed.
+	require.NoFileExists(t, prevPath)
+	require.FileExists(t, prevPath+compressedLogExt)
+
+	for i := 0; i < 3; i++ {
+		_, err = r.Write(tenBytes)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, r.Close())
+
+	// The two rotated files are compressed, the current one is not.
+	require.Equal(t, 2, countFilesMatching(basePath+"_bri_*.log.gz"))
+	require.Equal(t, 1, countFilesMatching(basePath+"_bri_*.log"))
+	require.Equal(t, 1, countFilesMatching(basePath+"_bri_002_*.log"))
+
+	for _, path := range []string{prevPath, filepath.Join(tmpDir, logFileName(sessionID, "bri", 0))} {
+		b, err := readLogFile(path + compressedLogExt)
+		require.NoError(t, err)
+		require.Equal(t, tenBytes, b)
+	}
+
+	// Compressed files still belong to their session.
+	sessions, err := buildSessionInfoList(tmpDir)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Len(t, sessions[sessionID].bridgeLogs, 3)
+}
+
 func BenchmarkRotate(b *testing.B) {
 	benchRotate(b, DefaultMaxLogFileSize, getTestFile(b, b.TempDir(), DefaultMaxLogFileSize-1))
 }
@@ -0,0 +1,281 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Subsystem is a component of the application whose log level can be set on its own.
+type Subsystem string
+
+const (
+	SubsystemIMAP Subsystem = "imap"
+	SubsystemSMTP Subsystem = "smtp"
+	SubsystemSync Subsystem = "sync"
+	SubsystemAPI  Subsystem = "api"
+	SubsystemGRPC Subsystem = "grpc"
+)
+
+// Subsystems lists the subsystems whose log level can be set.
+var Subsystems = []Subsystem{SubsystemIMAP, SubsystemSMTP, SubsystemSync, SubsystemAPI, SubsystemGRPC} //nolint:gochecknoglobals
+
+var ErrUnknownSubsystem = errors.New("unknown log subsystem")
+
+// levels holds the default log level, the levels of the subsystems that override it and the running traces.
+var levels = struct { //nolint:gochecknoglobals
+	lock      sync.RWMutex
+	base      logrus.Level
+	overrides map[Subsystem]logrus.Level
+	traces    map[Subsystem]*trace
+}{
+	base:      logrus.InfoLevel,
+	overrides: make(map[Subsystem]logrus.Level),
+	traces:    make(map[Subsystem]*trace),
+}
+
+// ParseLevels parses a level specification such as "info" or "info,imap=debug,smtp=trace".
+// The first level without a subsystem is the default one.
+func ParseLevels(spec string) (logrus.Level, map[Subsystem]logrus.Level, error) {
+	base, overrides := logrus.DebugLevel, make(map[Subsystem]logrus.Level)
+
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			level, err := logrus.ParseLevel(part)
+			if err != nil {
+				return 0, nil, err
+			}
+
+			base = level
+
+			continue
+		}
+
+		subsystem, err := parseSubsystem(name)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		level, err := logrus.ParseLevel(value)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		overrides[subsystem] = level
+	}
+
+	return base, overrides, nil
+}
+
+// GetLevel returns the default log level.
+func GetLevel() logrus.Level {
+	levels.lock.RLock()
+	defer levels.lock.RUnlock()
+
+	return levels.base
+}
+
+// SetLevel sets the default log level, used by the subsystems whose level was not set on its own.
+func SetLevel(level logrus.Level) {
+	levels.lock.Lock()
+	defer levels.lock.Unlock()
+
+	levels.base = level
+
+	applyLevelsUnsafe()
+}
+
+// GetSubsystemLevels returns the log level of each subsystem.
+func GetSubsystemLevels() map[Subsystem]logrus.Level {
+	levels.lock.RLock()
+	defer levels.lock.RUnlock()
+
+	res := make(map[Subsystem]logrus.Level, len(Subsystems))
+
+	for _, subsystem := range Subsystems {
+		res[subsystem] = levelForUnsafe(subsystem)
+	}
+
+	return res
+}
+
+// SetSubsystemLevel sets the log level of the given subsystem.
+func SetSubsystemLevel(subsystem Subsystem, level logrus.Level) error {
+	if _, err := parseSubsystem(string(subsystem)); err != nil {
+		return err
+	}
+
+	levels.lock.Lock()
+	defer levels.lock.Unlock()
+
+	levels.overrides[subsystem] = level
+
+	applyLevelsUnsafe()
+
+	return nil
+}
+
+// ResetSubsystemLevel makes the given subsystem use the default log level again.
+func ResetSubsystemLevel(subsystem Subsystem) error {
+	if _, err := parseSubsystem(string(subsystem)); err != nil {
+		return err
+	}
+
+	levels.lock.Lock()
+	defer levels.lock.Unlock()
+
+	delete(levels.overrides, subsystem)
+
+	applyLevelsUnsafe()
+
+	return nil
+}
+
+// setLevels replaces the default log level and all subsystem levels.
+func setLevels(base logrus.Level, overrides map[Subsystem]logrus.Level) {
+	levels.lock.Lock()
+	defer levels.lock.Unlock()
+
+	levels.base, levels.overrides = base, overrides
+
+	applyLevelsUnsafe()
+}
+
+// applyLevelsUnsafe sets the logrus level to the most verbose of the levels, so that no entry is dropped
+// before being filtered by subsystem. The caller must hold the lock.
+func applyLevelsUnsafe() {
+	level := levels.base
+
+	for _, override := range levels.overrides {
+		level = max(level, override)
+	}
+
+	if len(levels.traces) > 0 {
+		level = logrus.TraceLevel
+	}
+
+	logrus.SetLevel(level)
+}
+
+func levelForUnsafe(subsystem Subsystem) logrus.Level {
+	if _, ok := levels.traces[subsystem]; ok {
+		return logrus.TraceLevel
+	}
+
+	if level, ok := levels.overrides[subsystem]; ok {
+		return level
+	}
+
+	return levels.base
+}
+
+// isEnabled returns whether the given entry should be logged, according to the level of its subsystem.
+func isEnabled(entry *logrus.Entry) bool {
+	levels.lock.RLock()
+	defer levels.lock.RUnlock()
+
+	if len(levels.overrides) == 0 && len(levels.traces) == 0 {
+		return entry.Level <= levels.base
+	}
+
+	subsystem, ok := subsystemOf(entry.Data)
+	if !ok {
+		return entry.Level <= levels.base
+	}
+
+	return entry.Level <= levelForUnsafe(subsystem)
+}
+
+// subsystemOf guesses the subsystem an entry belongs to from the fields set by the packages of the application.
+func subsystemOf(fields logrus.Fields) (Subsystem, bool) {
+	if _, ok := fields["sync-stage"]; ok {
+		return SubsystemSync, true
+	}
+
+	if service, ok := fields["service"].(string); ok {
+		switch service {
+		case "imap":
+			return SubsystemIMAP, true
+
+		case "smtp":
+			return SubsystemSMTP, true
+
+		case "sync":
+			return SubsystemSync, true
+		}
+	}
+
+	pkg, ok := fields["pkg"].(string)
+	if !ok {
+		return "", false
+	}
+
+	switch {
+	case pkg == "grpc":
+		return SubsystemGRPC, true
+
+	case strings.HasPrefix(pkg, "gpa/"):
+		return SubsystemAPI, true
+
+	case strings.HasPrefix(pkg, "gluon"), strings.Contains(strings.ToLower(pkg), "imap"):
+		return SubsystemIMAP, true
+
+	case strings.Contains(strings.ToLower(pkg), "smtp"):
+		return SubsystemSMTP, true
+
+	case strings.Contains(pkg, "sync"):
+		return SubsystemSync, true
+
+	default:
+		return "", false
+	}
+}
+
+func parseSubsystem(name string) (Subsystem, error) {
+	for _, subsystem := range Subsystems {
+		if strings.EqualFold(name, string(subsystem)) {
+			return subsystem, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnknownSubsystem, name)
+}
+
+// levelFilter drops the entries whose subsystem is not logged at their level.
+type levelFilter struct {
+	logrus.Formatter
+}
+
+func (f *levelFilter) Format(entry *logrus.Entry) ([]byte, error) {
+	if !isEnabled(entry) {
+		return nil, nil
+	}
+
+	return f.Formatter.Format(entry)
+}
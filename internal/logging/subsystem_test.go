@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLevels(t *testing.T) {
+	base, overrides, err := ParseLevels("")
+	require.NoError(t, err)
+	require.Equal(t, logrus.DebugLevel, base)
+	require.Empty(t, overrides)
+
+	base, overrides, err = ParseLevels("warn, IMAP=trace,smtp=debug")
+	require.NoError(t, err)
+	require.Equal(t, logrus.WarnLevel, base)
+	require.Equal(t, map[Subsystem]logrus.Level{SubsystemIMAP: logrus.TraceLevel, SubsystemSMTP: logrus.DebugLevel}, overrides)
+
+	_, _, err = ParseLevels("info,gluon=trace")
+	require.ErrorIs(t, err, ErrUnknownSubsystem)
+
+	_, _, err = ParseLevels("info,imap=loud")
+	require.Error(t, err)
+}
+
+func TestSubsystemLevels(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := logrus.StandardLogger()
+	prevOut, prevFormatter, prevLevel := logger.Out, logger.Formatter, logger.GetLevel()
+
+	defer func() {
+		logger.SetOutput(prevOut)
+		logger.SetFormatter(prevFormatter)
+		setLevels(prevLevel, make(map[Subsystem]logrus.Level))
+	}()
+
+	logrus.SetOutput(&buf)
+	SetFormat(FormatJSON)
+	setLevels(logrus.InfoLevel, make(map[Subsystem]logrus.Level))
+
+	require.NoError(t, SetSubsystemLevel(SubsystemIMAP, logrus.TraceLevel))
+	require.NoError(t, SetSubsystemLevel(SubsystemAPI, logrus.ErrorLevel))
+	require.Equal(t, logrus.TraceLevel, logrus.GetLevel())
+
+	logrus.WithField("pkg", "gluon/imap").Trace("imap trace")
+	logrus.WithField("service", "imap").Debug("imap debug")
+	logrus.WithField("pkg", "server/smtp").Debug("smtp debug")
+	logrus.WithField("sync-stage", "apply").Info("sync info")
+	logrus.WithField("pkg", "gpa/client").Warn("api warn")
+	logrus.WithField("pkg", "bridge").Debug("bridge debug")
+	logrus.WithField("pkg", "bridge").Info("bridge info")
+
+	var messages []string
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]any
+
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		messages = append(messages, entry["msg"].(string)) //nolint:forcetypeassert
+	}
+
+	require.Equal(t, []string{"imap trace", "imap debug", "sync info", "bridge info"}, messages)
+
+	levels := GetSubsystemLevels()
+	require.Equal(t, logrus.TraceLevel, levels[SubsystemIMAP])
+	require.Equal(t, logrus.InfoLevel, levels[SubsystemSMTP])
+	require.Equal(t, logrus.ErrorLevel, levels[SubsystemAPI])
+
+	require.NoError(t, ResetSubsystemLevel(SubsystemIMAP))
+	require.NoError(t, ResetSubsystemLevel(SubsystemAPI))
+	require.Equal(t, logrus.InfoLevel, logrus.GetLevel())
+
+	require.ErrorIs(t, SetSubsystemLevel("gluon", logrus.TraceLevel), ErrUnknownSubsystem)
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("")
+	require.NoError(t, err)
+	require.Equal(t, FormatText, format)
+
+	format, err = ParseFormat("JSON")
+	require.NoError(t, err)
+	require.Equal(t, FormatJSON, format)
+
+	_, err = ParseFormat("xml")
+	require.ErrorIs(t, err, ErrUnknownFormat)
+}
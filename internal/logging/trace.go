@@ -0,0 +1,328 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// DefaultTraceDuration is how long a trace runs when no duration is given.
+	DefaultTraceDuration = 15 * time.Minute
+
+	// MaxTraceDuration is the longest a trace can run before it is stopped automatically.
+	MaxTraceDuration = 2 * time.Hour
+)
+
+var (
+	ErrNotTraceable         = errors.New("subsystem can't be traced")
+	ErrInvalidTraceDuration = errors.New("invalid trace duration")
+)
+
+// TraceableSubsystems lists the subsystems whose wire-level activity can be traced:
+// the IMAP and SMTP sessions, and the API requests (with redacted URLs).
+var TraceableSubsystems = []Subsystem{SubsystemIMAP, SubsystemSMTP, SubsystemAPI} //nolint:gochecknoglobals
+
+// trace is a running trace of a subsystem.
+type trace struct {
+	expiresAt time.Time
+	timer     *time.Timer
+}
+
+// traceWatchers are called whenever a trace starts or stops.
+var traceWatchers = struct { //nolint:gochecknoglobals
+	lock sync.Mutex
+	next int
+	fns  map[int]func(Subsystem, bool)
+}{
+	fns: make(map[int]func(Subsystem, bool)),
+}
+
+// StartTrace logs everything the given subsystem does, including its wire-level activity, for the given duration.
+// The trace stops automatically afterwards; starting a running trace again extends it.
+func StartTrace(subsystem Subsystem, duration time.Duration) error {
+	if duration == 0 {
+		duration = DefaultTraceDuration
+	}
+
+	if duration < 0 || duration > MaxTraceDuration {
+		return fmt.Errorf("%w: %v (must be at most %v)", ErrInvalidTraceDuration, duration, MaxTraceDuration)
+	}
+
+	subsystem, err := parseTraceableSubsystem(subsystem)
+	if err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().Add(duration)
+
+	started := func() bool {
+		levels.lock.Lock()
+		defer levels.lock.Unlock()
+
+		running, ok := levels.traces[subsystem]
+		if ok {
+			running.timer.Stop()
+		}
+
+		t := &trace{expiresAt: expiresAt}
+		t.timer = time.AfterFunc(duration, func() { stopTrace(subsystem, t) })
+
+		levels.traces[subsystem] = t
+
+		applyLevelsUnsafe()
+
+		return !ok
+	}()
+
+	entry := logrus.WithField("subsystem", subsystem).WithField("expiresAt", expiresAt.Format(time.RFC3339))
+
+	if subsystem == SubsystemAPI {
+		entry.Warning("Trace started")
+	} else {
+		entry.Warning("Trace started, THIS LOG WILL CONTAIN **DECRYPTED** MESSAGE DATA")
+	}
+
+	if started {
+		notifyTraceWatchers(subsystem, true)
+	}
+
+	return nil
+}
+
+// StopTrace stops the trace of the given subsystem, if it is running.
+func StopTrace(subsystem Subsystem) error {
+	subsystem, err := parseTraceableSubsystem(subsystem)
+	if err != nil {
+		return err
+	}
+
+	levels.lock.RLock()
+	t, ok := levels.traces[subsystem]
+	levels.lock.RUnlock()
+
+	if ok {
+		t.timer.Stop()
+		stopTrace(subsystem, t)
+	}
+
+	return nil
+}
+
+// GetTraces returns the time at which each running trace stops.
+func GetTraces() map[Subsystem]time.Time {
+	levels.lock.RLock()
+	defer levels.lock.RUnlock()
+
+	res := make(map[Subsystem]time.Time, len(levels.traces))
+
+	for subsystem, t := range levels.traces {
+		res[subsystem] = t.expiresAt
+	}
+
+	return res
+}
+
+// IsTracing returns whether the given subsystem is being traced.
+func IsTracing(subsystem Subsystem) bool {
+	levels.lock.RLock()
+	defer levels.lock.RUnlock()
+
+	_, ok := levels.traces[subsystem]
+
+	return ok
+}
+
+// WatchTraces calls fn whenever a trace of a subsystem starts or stops, until the returned function is called.
+// A trace which is extended doesn't start again.
+func WatchTraces(fn func(subsystem Subsystem, running bool)) func() {
+	traceWatchers.lock.Lock()
+	defer traceWatchers.lock.Unlock()
+
+	id := traceWatchers.next
+	traceWatchers.next++
+
+	traceWatchers.fns[id] = fn
+
+	return func() {
+		traceWatchers.lock.Lock()
+		defer traceWatchers.lock.Unlock()
+
+		delete(traceWatchers.fns, id)
+	}
+}
+
+func notifyTraceWatchers(subsystem Subsystem, running bool) {
+	traceWatchers.lock.Lock()
+	defer traceWatchers.lock.Unlock()
+
+	for _, fn := range traceWatchers.fns {
+		fn(subsystem, running)
+	}
+}
+
+// NewTraceWriter returns a writer that only forwards data to w while the given subsystem is being traced.
+// The credentials sent by IMAP and SMTP clients are redacted: the arguments of LOGIN, AUTHENTICATE and AUTH commands and
+// the responses to authentication challenges.
+// A writer is shared by all the sessions of a server and their lines interleave, so each line is redacted on its own.
+func NewTraceWriter(subsystem Subsystem, w io.Writer) io.Writer {
+	tw := &traceWriter{subsystem: subsystem, w: w}
+
+	switch subsystem { //nolint:exhaustive
+	case SubsystemIMAP:
+		tw.redact = redactIMAPLine
+
+	case SubsystemSMTP:
+		tw.redact = redactSMTPLine
+	}
+
+	return tw
+}
+
+type traceWriter struct {
+	subsystem Subsystem
+	w         io.Writer
+
+	// redact returns the line, without its line ending, with the credentials it holds redacted.
+	redact func(line []byte) []byte
+
+	// lock protects the writes, as a writer is shared by all sessions.
+	lock sync.Mutex
+}
+
+func (tw *traceWriter) Write(p []byte) (int, error) {
+	if !IsTracing(tw.subsystem) {
+		return len(p), nil
+	}
+
+	tw.lock.Lock()
+	defer tw.lock.Unlock()
+
+	if tw.redact == nil {
+		return tw.w.Write(p)
+	}
+
+	var buf bytes.Buffer
+
+	for _, line := range bytes.SplitAfter(p, []byte("\n")) {
+		content := bytes.TrimRight(line, "\r\n")
+
+		buf.Write(tw.redact(content))
+		buf.Write(line[len(content):])
+	}
+
+	if _, err := tw.w.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// redactedCredentials replaces the credentials redacted from traces.
+const redactedCredentials = "<redacted>"
+
+var (
+	// imapAuthCommand matches the IMAP commands logged by gluon which carry credentials, up to their first argument.
+	imapAuthCommand = regexp.MustCompile(`(?i)^(C\[\d+\]: \S+ (?:LOGIN|AUTHENTICATE \S+)) .*$`)
+
+	// imapContinuation matches the lines logged by gluon which hold a single token sent by the client, e.g. a response to
+	// an authentication challenge, since commands hold at least a tag and a name.
+	imapContinuation = regexp.MustCompile(`^(C\[\d+\]: )(\S+)$`)
+
+	// smtpAuthCommand matches the SMTP AUTH command, up to the name of its mechanism.
+	smtpAuthCommand = regexp.MustCompile(`(?i)^(AUTH \S+) .*$`)
+
+	// smtpContinuation matches the lines which hold a single base64 token, e.g. a response to an authentication
+	// challenge. Which session sent them is unknown, so message data that looks the same is redacted too.
+	smtpContinuation = regexp.MustCompile(`^(?:[A-Za-z0-9+/]+=*|=|\*)$`)
+
+	// smtpBareCommand matches the SMTP commands which have no arguments.
+	smtpBareCommand = regexp.MustCompile(`(?i)^(?:DATA|QUIT|RSET|NOOP|STARTTLS|HELP|EHLO|HELO)$`)
+)
+
+func redactIMAPLine(line []byte) []byte {
+	if imapAuthCommand.Match(line) {
+		return imapAuthCommand.ReplaceAll(line, []byte("$1 "+redactedCredentials))
+	}
+
+	// IDLE is ended with a single DONE.
+	if match := imapContinuation.FindSubmatch(line); match != nil && !strings.EqualFold(string(match[2]), "DONE") {
+		return []byte(string(match[1]) + redactedCredentials)
+	}
+
+	return line
+}
+
+func redactSMTPLine(line []byte) []byte {
+	switch {
+	case smtpAuthCommand.Match(line):
+		return smtpAuthCommand.ReplaceAll(line, []byte("$1 "+redactedCredentials))
+
+	case smtpContinuation.Match(line) && !smtpBareCommand.Match(line):
+		return []byte(redactedCredentials)
+	}
+
+	return line
+}
+
+// stopTrace removes the given trace, unless it was replaced in the meantime.
+func stopTrace(subsystem Subsystem, t *trace) {
+	stopped := func() bool {
+		levels.lock.Lock()
+		defer levels.lock.Unlock()
+
+		if levels.traces[subsystem] != t {
+			return false
+		}
+
+		delete(levels.traces, subsystem)
+
+		applyLevelsUnsafe()
+
+		return true
+	}()
+
+	if stopped {
+		logrus.WithField("subsystem", subsystem).Warning("Trace stopped")
+
+		notifyTraceWatchers(subsystem, false)
+	}
+}
+
+func parseTraceableSubsystem(subsystem Subsystem) (Subsystem, error) {
+	subsystem, err := parseSubsystem(string(subsystem))
+	if err != nil {
+		return "", err
+	}
+
+	if !slices.Contains(TraceableSubsystems, subsystem) {
+		return "", fmt.Errorf("%w: %q", ErrNotTraceable, subsystem)
+	}
+
+	return subsystem, nil
+}
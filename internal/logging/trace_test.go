@@ -0,0 +1,153 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrace(t *testing.T) {
+	prevLevel := logrus.GetLevel()
+
+	defer setLevels(prevLevel, make(map[Subsystem]logrus.Level))
+
+	setLevels(logrus.InfoLevel, make(map[Subsystem]logrus.Level))
+
+	var buf bytes.Buffer
+
+	writer := NewTraceWriter(SubsystemIMAP, &buf)
+
+	// Nothing is written while the subsystem isn't traced.
+	_, err := writer.Write([]byte("before"))
+	require.NoError(t, err)
+	require.Empty(t, buf.String())
+
+	require.NoError(t, StartTrace("IMAP", 100*time.Millisecond))
+	require.True(t, IsTracing(SubsystemIMAP))
+	require.Contains(t, GetTraces(), SubsystemIMAP)
+	require.Equal(t, logrus.TraceLevel, GetSubsystemLevels()[SubsystemIMAP])
+	require.Equal(t, logrus.InfoLevel, GetSubsystemLevels()[SubsystemSMTP])
+	require.Equal(t, logrus.TraceLevel, logrus.GetLevel())
+
+	_, err = writer.Write([]byte("during"))
+	require.NoError(t, err)
+	require.Equal(t, "during", buf.String())
+
+	// The trace stops on its own.
+	require.Eventually(t, func() bool { return !IsTracing(SubsystemIMAP) }, time.Second, 10*time.Millisecond)
+	require.Equal(t, logrus.InfoLevel, GetSubsystemLevels()[SubsystemIMAP])
+	require.Equal(t, logrus.InfoLevel, logrus.GetLevel())
+
+	// A trace can also be stopped before it expires.
+	require.NoError(t, StartTrace(SubsystemAPI, 0))
+	require.Equal(t, DefaultTraceDuration, time.Until(GetTraces()[SubsystemAPI]).Round(time.Minute))
+	require.NoError(t, StopTrace(SubsystemAPI))
+	require.False(t, IsTracing(SubsystemAPI))
+
+	require.ErrorIs(t, StartTrace(SubsystemSync, time.Minute), ErrNotTraceable)
+	require.ErrorIs(t, StartTrace("gluon", time.Minute), ErrUnknownSubsystem)
+	require.ErrorIs(t, StartTrace(SubsystemSMTP, 3*time.Hour), ErrInvalidTraceDuration)
+}
+
+func TestTraceWriter_Redact(t *testing.T) {
+	prevLevel := logrus.GetLevel()
+
+	defer setLevels(prevLevel, make(map[Subsystem]logrus.Level))
+
+	var watched []Subsystem
+
+	unwatch := WatchTraces(func(subsystem Subsystem, running bool) {
+		if running {
+			watched = append(watched, subsystem)
+		}
+	})
+	defer unwatch()
+
+	require.NoError(t, StartTrace(SubsystemIMAP, time.Minute))
+	require.NoError(t, StartTrace(SubsystemSMTP, time.Minute))
+	require.NoError(t, StartTrace(SubsystemSMTP, time.Minute))
+	require.Equal(t, []Subsystem{SubsystemIMAP, SubsystemSMTP}, watched)
+
+	defer func() {
+		require.NoError(t, StopTrace(SubsystemIMAP))
+		require.NoError(t, StopTrace(SubsystemSMTP))
+	}()
+
+	var imapBuf, smtpBuf bytes.Buffer
+
+	imapWriter := NewTraceWriter(SubsystemIMAP, &imapBuf)
+
+	for _, line := range []string{
+		"C[1]: a1 LOGIN user@pm.me secret\n",
+		"C[2]: a2 authenticate PLAIN AHVzZXIAc2VjcmV0\n",
+		"C[3]: a3 AUTHENTICATE PLAIN\n",
+		"S[3]: +\n",
+		"C[3]: AHVzZXIAc2VjcmV0\n",
+		"C[3]: a4 IDLE\n",
+		"C[3]: DONE\n",
+	} {
+		_, err := imapWriter.Write([]byte(line))
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, ""+
+		"C[1]: a1 LOGIN <redacted>\n"+
+		"C[2]: a2 authenticate PLAIN <redacted>\n"+
+		"C[3]: a3 AUTHENTICATE PLAIN\n"+
+		"S[3]: +\n"+
+		"C[3]: <redacted>\n"+
+		"C[3]: a4 IDLE\n"+
+		"C[3]: DONE\n",
+		imapBuf.String(),
+	)
+
+	smtpWriter := NewTraceWriter(SubsystemSMTP, &smtpBuf)
+
+	_, err := smtpWriter.Write([]byte("AUTH PLAIN AHVzZXIAc2VjcmV0\r\n235 2.0.0 Authentication succeeded\r\nAUTH LOGIN\r\n334 VXNlcm5hbWU6\r\n"))
+	require.NoError(t, err)
+
+	_, err = smtpWriter.Write([]byte("dXNlcg==\r\n334 UGFzc3dvcmQ6\r\nc2VjcmV0\r\nMAIL FROM:<user@pm.me>\r\n"))
+	require.NoError(t, err)
+
+	// The lines of another session come in between a challenge and its response.
+	_, err = smtpWriter.Write([]byte("AUTH LOGIN\r\n334 VXNlcm5hbWU6\r\nDATA\r\nSubject: hello\r\n.\r\ndXNlcg==\r\n"))
+	require.NoError(t, err)
+
+	require.Equal(t, ""+
+		"AUTH PLAIN <redacted>\r\n"+
+		"235 2.0.0 Authentication succeeded\r\n"+
+		"AUTH LOGIN\r\n"+
+		"334 VXNlcm5hbWU6\r\n"+
+		"<redacted>\r\n"+
+		"334 UGFzc3dvcmQ6\r\n"+
+		"<redacted>\r\n"+
+		"MAIL FROM:<user@pm.me>\r\n"+
+		"AUTH LOGIN\r\n"+
+		"334 VXNlcm5hbWU6\r\n"+
+		"DATA\r\n"+
+		"Subject: hello\r\n"+
+		".\r\n"+
+		"<redacted>\r\n",
+		smtpBuf.String(),
+	)
+}
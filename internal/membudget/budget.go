@@ -0,0 +1,174 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package membudget implements a process-wide memory budget that memory hungry work reserves from before running.
+package membudget
+
+import (
+	"context"
+	"sync"
+)
+
+// Budget tracks how many bytes are reserved by in-flight work and blocks new reservations that would exceed its limit.
+// A nil Budget imposes no limit.
+type Budget struct {
+	lock    sync.Mutex
+	cond    chan struct{}
+	limit   uint64
+	used    uint64
+	waiting int
+}
+
+// New returns a budget allowing at most limit bytes to be reserved at once, zero meaning unlimited.
+func New(limit uint64) *Budget {
+	return &Budget{
+		cond:  make(chan struct{}),
+		limit: limit,
+	}
+}
+
+// Acquire reserves n bytes, blocking until enough of the budget is free or the context is cancelled.
+// A reservation larger than the whole budget is let through once nothing else is reserved, so that it cannot stall forever.
+func (b *Budget) Acquire(ctx context.Context, n uint64) (*Reservation, error) {
+	if b == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	b.lock.Lock()
+
+	if b.fits(n) {
+		b.used += n
+		b.lock.Unlock()
+
+		return &Reservation{budget: b, size: n}, nil
+	}
+
+	b.waiting++
+	defer func() {
+		b.lock.Lock()
+		b.waiting--
+		b.lock.Unlock()
+	}()
+
+	for {
+		cond := b.cond
+		b.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+
+		case <-cond:
+		}
+
+		b.lock.Lock()
+
+		if b.fits(n) {
+			b.used += n
+			b.lock.Unlock()
+
+			return &Reservation{budget: b, size: n}, nil
+		}
+	}
+}
+
+// Used returns the number of bytes currently reserved.
+func (b *Budget) Used() uint64 {
+	if b == nil {
+		return 0
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.used
+}
+
+// Limit returns the size of the budget, zero meaning unlimited.
+func (b *Budget) Limit() uint64 {
+	if b == nil {
+		return 0
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.limit
+}
+
+// SetLimit changes the size of the budget. Existing reservations are kept even if they now exceed it.
+func (b *Budget) SetLimit(limit uint64) {
+	if b == nil {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.limit = limit
+
+	b.broadcast()
+}
+
+// Waiting returns the number of callers currently blocked in Acquire.
+func (b *Budget) Waiting() int {
+	if b == nil {
+		return 0
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.waiting
+}
+
+func (b *Budget) fits(n uint64) bool {
+	return b.limit == 0 || b.used == 0 || b.used+n <= b.limit
+}
+
+func (b *Budget) release(n uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.used -= n
+
+	b.broadcast()
+}
+
+// broadcast wakes up every blocked Acquire call. It must be called with the lock held.
+func (b *Budget) broadcast() {
+	close(b.cond)
+	b.cond = make(chan struct{})
+}
+
+// Reservation is a part of the budget held by a caller of Acquire.
+type Reservation struct {
+	budget *Budget
+	size   uint64
+	once   sync.Once
+}
+
+// Release returns the reserved bytes to the budget. It is safe to call more than once and on a nil reservation.
+func (r *Reservation) Release() {
+	if r == nil {
+		return
+	}
+
+	r.once.Do(func() {
+		r.budget.release(r.size)
+	})
+}
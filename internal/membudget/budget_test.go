@@ -0,0 +1,125 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package membudget_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/membudget"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudget_AcquireRelease(t *testing.T) {
+	budget := membudget.New(100)
+
+	r1, err := budget.Acquire(context.Background(), 60)
+	require.NoError(t, err)
+	require.Equal(t, uint64(60), budget.Used())
+
+	acquired := make(chan *membudget.Reservation)
+
+	go func() {
+		r2, err := budget.Acquire(context.Background(), 60)
+		require.NoError(t, err)
+		acquired <- r2
+	}()
+
+	// The second reservation does not fit and must wait for the first one to be released.
+	require.Eventually(t, func() bool { return budget.Waiting() == 1 }, time.Second, 10*time.Millisecond)
+
+	select {
+	case <-acquired:
+		t.Fatal("reservation should not fit in the budget")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	r1.Release()
+	r1.Release()
+
+	r2 := <-acquired
+	require.Equal(t, uint64(60), budget.Used())
+	require.Zero(t, budget.Waiting())
+
+	r2.Release()
+	require.Zero(t, budget.Used())
+}
+
+func TestBudget_OversizedReservation(t *testing.T) {
+	budget := membudget.New(100)
+
+	// A reservation larger than the budget goes through when nothing else is reserved.
+	r, err := budget.Acquire(context.Background(), 500)
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), budget.Used())
+
+	r.Release()
+	require.Zero(t, budget.Used())
+}
+
+func TestBudget_Cancel(t *testing.T) {
+	budget := membudget.New(100)
+
+	r, err := budget.Acquire(context.Background(), 100)
+	require.NoError(t, err)
+	defer r.Release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = budget.Acquire(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Zero(t, budget.Waiting())
+	require.Equal(t, uint64(100), budget.Used())
+}
+
+func TestBudget_SetLimit(t *testing.T) {
+	budget := membudget.New(100)
+
+	r1, err := budget.Acquire(context.Background(), 80)
+	require.NoError(t, err)
+	defer r1.Release()
+
+	acquired := make(chan struct{})
+
+	go func() {
+		r2, err := budget.Acquire(context.Background(), 80)
+		require.NoError(t, err)
+		defer r2.Release()
+		close(acquired)
+	}()
+
+	require.Eventually(t, func() bool { return budget.Waiting() == 1 }, time.Second, 10*time.Millisecond)
+
+	// Raising the limit lets the blocked reservation through.
+	budget.SetLimit(200)
+
+	<-acquired
+}
+
+func TestBudget_Nil(t *testing.T) {
+	var budget *membudget.Budget
+
+	r, err := budget.Acquire(context.Background(), 100)
+	require.NoError(t, err)
+	r.Release()
+
+	require.Zero(t, budget.Used())
+	require.Zero(t, budget.Limit())
+}
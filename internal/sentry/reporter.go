@@ -20,8 +20,10 @@ package sentry
 import (
 	"errors"
 	"fmt"
+	"io/fs"
 	"log"
 	"os"
+	"path/filepath"
 	"runtime"
 	"time"
 
@@ -39,7 +41,23 @@ const hostNotDetectedField = "not-detected"
 
 var skippedFunctions = []string{} //nolint:gochecknoglobals
 
+// EnvDisableReporting keeps the sentry client from being created at all when set to a non-empty value.
+const EnvDisableReporting = "BRIDGE_DISABLE_REPORTING"
+
+// The sentry client is only created with SetEnabled, once the reporting kill switch is known to be off.
 func init() { //nolint:gochecknoinits
+	sentry.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetFingerprint([]string{"{{ default }}"})
+		scope.SetUser(sentry.User{ID: GetProtectedHostname()})
+	})
+
+	sentry.Logger = log.New(
+		logrus.WithField("pkg", "sentry-go").WriterLevel(logrus.WarnLevel),
+		"", 0,
+	)
+}
+
+func newClientOptions() sentry.ClientOptions {
 	sentrySyncTransport := sentry.NewHTTPSyncTransport()
 	sentrySyncTransport.Timeout = time.Second * 3
 	appVersion := constants.Version
@@ -48,7 +66,7 @@ func init() { //nolint:gochecknoinits
 		appVersion = version.Original()
 	}
 
-	options := sentry.ClientOptions{
+	return sentry.ClientOptions{
 		Dsn:            constants.DSNSentry,
 		Release:        constants.AppVersion(appVersion),
 		BeforeSend:     EnhanceSentryEvent,
@@ -57,20 +75,61 @@ func init() { //nolint:gochecknoinits
 		Environment:    constants.BuildEnv,
 		MaxBreadcrumbs: 50,
 	}
+}
+
+// SetEnabled creates or drops the sentry client.
+// While disabled there is no client, and so no transport, which could send anything.
+func SetEnabled(enabled bool) error {
+	hub := sentry.CurrentHub()
 
-	if err := sentry.Init(options); err != nil {
-		logrus.WithError(err).Error("Failed to initialize sentry options")
+	if !enabled {
+		hub.BindClient(nil)
+		return nil
 	}
 
-	sentry.ConfigureScope(func(scope *sentry.Scope) {
-		scope.SetFingerprint([]string{"{{ default }}"})
-		scope.SetUser(sentry.User{ID: GetProtectedHostname()})
-	})
+	if hub.Client() != nil {
+		return nil
+	}
 
-	sentry.Logger = log.New(
-		logrus.WithField("pkg", "sentry-go").WriterLevel(logrus.WarnLevel),
-		"", 0,
-	)
+	client, err := sentry.NewClient(newClientOptions())
+	if err != nil {
+		return err
+	}
+
+	hub.BindClient(client)
+
+	return nil
+}
+
+// IsEnabled returns whether a sentry client exists.
+func IsEnabled() bool {
+	return sentry.CurrentHub().Client() != nil
+}
+
+// disabledMarkerName is the file whose presence in the settings dir tells processes that can't read the vault,
+// such as the launcher, that reporting is disabled.
+const disabledMarkerName = "reporting_disabled"
+
+// SaveDisabledMarker creates or removes the marker telling that reporting is disabled in the given settings dir.
+func SaveDisabledMarker(settingsDir string, disabled bool) error {
+	path := filepath.Join(settingsDir, disabledMarkerName)
+
+	if !disabled {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	}
+
+	return os.WriteFile(path, nil, 0o600)
+}
+
+// IsDisabledByMarker returns whether the marker telling that reporting is disabled exists in the given settings dir.
+func IsDisabledByMarker(settingsDir string) bool {
+	_, err := os.Stat(filepath.Join(settingsDir, disabledMarkerName))
+
+	return err == nil
 }
 
 type hostInfoData struct {
@@ -187,6 +246,11 @@ func (r *Reporter) scopedReport(context map[string]interface{}, doReport func())
 		return nil
 	}
 
+	if !IsEnabled() {
+		logrus.Debug("Crash reporting is disabled, not sending report")
+		return nil
+	}
+
 	tags := map[string]string{
 		"OS":          runtime.GOOS,
 		"Client":      r.appName,
@@ -64,3 +64,35 @@ func TestFilterOutPanicHandlers(t *testing.T) {
 	gotFrames := filterOutPanicHandlers(frames)
 	r.Equal(t, frames[:5], gotFrames)
 }
+
+func TestSetEnabled(t *testing.T) {
+	// No client exists until reporting is enabled.
+	r.False(t, IsEnabled())
+
+	r.NoError(t, SetEnabled(false))
+	r.False(t, IsEnabled())
+	r.Nil(t, sentry.CurrentHub().Client())
+
+	// Nothing is sent and no error is returned while disabled.
+	r.NoError(t, NewReporter("test", nil).scopedReport(nil, func() {
+		r.FailNow(t, "report must not be built while disabled")
+	}))
+
+	r.NoError(t, SetEnabled(true))
+	r.True(t, IsEnabled())
+}
+
+func TestDisabledMarker(t *testing.T) {
+	dir := t.TempDir()
+
+	r.False(t, IsDisabledByMarker(dir))
+
+	r.NoError(t, SaveDisabledMarker(dir, true))
+	r.True(t, IsDisabledByMarker(dir))
+
+	// Saving it again is harmless either way.
+	r.NoError(t, SaveDisabledMarker(dir, true))
+	r.NoError(t, SaveDisabledMarker(dir, false))
+	r.False(t, IsDisabledByMarker(dir))
+	r.NoError(t, SaveDisabledMarker(dir, false))
+}
@@ -19,6 +19,8 @@ package service
 
 import (
 	"encoding/json"
+	"errors"
+	"io/fs"
 	"os"
 	"path/filepath"
 )
@@ -44,7 +46,13 @@ func (s *Config) save(path string) error {
 }
 
 func (s *Config) _save(path string) error {
-	f, err := os.Create(path) //nolint:errcheck,gosec
+	// The file holds the token giving full control over bridge, so only the current user may read it.
+	// The permissions are only set when a file is created, so a file left over by a previous run is removed first.
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o600) //nolint:errcheck,gosec
 	if err != nil {
 		return err
 	}
@@ -18,7 +18,9 @@
 package service
 
 import (
+	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -45,6 +47,17 @@ func TestConfig(t *testing.T) {
 	tempFilePath := filepath.Join(tempDir, tempFileName)
 	require.NoError(t, conf1.save(tempFilePath))
 
+	// The config holds a token, so only the current user can read it, even if the files were left readable by a previous run.
+	if runtime.GOOS != "windows" {
+		require.NoError(t, os.Chmod(tempFilePath, 0o644))
+		require.NoError(t, os.WriteFile(tempFilePath+"_", nil, 0o644))
+		require.NoError(t, conf1.save(tempFilePath))
+
+		info, err := os.Stat(tempFilePath)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
 	conf2 := Config{}
 	require.NoError(t, conf2.Load(tempFilePath))
 	require.Equal(t, conf1, conf2)
@@ -0,0 +1,109 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Host is the address local services listen on when they use a TCP port.
+const Host = "127.0.0.1"
+
+// Listen returns a listener for a local service and records in the config how clients reach it.
+// Unless overTCP is set, it listens on a Unix domain socket only the current user can access, which Windows supports too.
+// It falls back to a localhost TCP port if that fails.
+func Listen(name string, overTCP bool, config *Config) (net.Listener, error) {
+	if !overTCP {
+		listener, path, err := listenSocket(name)
+		if err == nil {
+			config.FileSocketPath = path
+
+			return listener, nil
+		}
+
+		logrus.WithField("service", name).WithError(err).Warn("Could not listen on a socket, falling back to a TCP port")
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(Host, "0")) // Port should be provided by the OS.
+	if err != nil {
+		return nil, err
+	}
+
+	// retrieve the port assigned by the system, so that we can put it in the config file.
+	address, ok := listener.Addr().(*net.TCPAddr)
+	if !ok {
+		_ = listener.Close()
+		return nil, fmt.Errorf("could not retrieve %v listener address", name)
+	}
+
+	config.FileSocketPath = ""
+	config.Port = address.Port
+
+	return listener, nil
+}
+
+// Dial connects to the local service described by the config.
+func Dial(ctx context.Context, config *Config) (net.Conn, error) {
+	if config.FileSocketPath != "" {
+		return dialSocket(ctx, config.FileSocketPath)
+	}
+
+	return (&net.Dialer{}).DialContext(ctx, "tcp", net.JoinHostPort(Host, fmt.Sprint(config.Port)))
+}
+
+// listenSocket listens on a Unix domain socket in a new directory only the current user can access.
+// The socket lives in the temporary directory because socket paths are limited to around a hundred characters.
+func listenSocket(name string) (net.Listener, string, error) {
+	dir, err := os.MkdirTemp("", "bridge-"+name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	path := filepath.Join(dir, name+".sock")
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, "", err
+	}
+
+	return &socketListener{Listener: listener, dir: dir}, path, nil
+}
+
+func dialSocket(ctx context.Context, path string) (net.Conn, error) {
+	return (&net.Dialer{}).DialContext(ctx, "unix", path)
+}
+
+// socketListener removes the directory of the socket when closed.
+type socketListener struct {
+	net.Listener
+
+	dir string
+}
+
+func (l *socketListener) Close() error {
+	defer func() { _ = os.RemoveAll(l.dir) }()
+
+	return l.Listener.Close()
+}
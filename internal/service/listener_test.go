@@ -0,0 +1,67 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListen(t *testing.T) {
+	for _, overTCP := range []bool{false, true} {
+		config := Config{}
+
+		listener, err := Listen("test", overTCP, &config)
+		require.NoError(t, err)
+
+		if overTCP {
+			require.Empty(t, config.FileSocketPath)
+			require.NotZero(t, config.Port)
+		} else {
+			require.NotEmpty(t, config.FileSocketPath)
+		}
+
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			_, _ = conn.Write([]byte("hello"))
+			_ = conn.Close()
+		}()
+
+		// A client reaches the service using the config only.
+		conn, err := Dial(context.Background(), &config)
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(conn)
+		require.NoError(t, err)
+		require.Equal(t, "hello", string(data))
+		require.NoError(t, conn.Close())
+
+		// Once the listener is closed, the service can't be reached anymore.
+		require.NoError(t, listener.Close())
+
+		_, err = Dial(context.Background(), &config)
+		require.Error(t, err)
+	}
+}
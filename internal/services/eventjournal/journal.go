@@ -0,0 +1,258 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package eventjournal keeps a concise per-user journal of the Proton events processed by bridge, so that users and
+// support can find out what happened to a message (e.g. moved to trash from another client) without debug logs.
+package eventjournal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+)
+
+// MaxEntries is the number of most recent entries kept in a user's journal.
+const MaxEntries = 2000
+
+// Kind is the kind of item a journal entry is about.
+type Kind string
+
+const (
+	KindMessage Kind = "message"
+	KindLabel   Kind = "label"
+	KindRefresh Kind = "refresh"
+)
+
+// Action is what happened to the item of a journal entry.
+type Action string
+
+const (
+	ActionCreated Action = "created"
+	ActionUpdated Action = "updated"
+	ActionDeleted Action = "deleted"
+)
+
+// Entry describes the change brought by a processed event to a message or a label.
+type Entry struct {
+	Time    time.Time `json:"time"`
+	EventID string    `json:"eventID"`
+
+	Kind   Kind   `json:"kind"`
+	Action Action `json:"action,omitempty"`
+	ID     string `json:"id,omitempty"`
+
+	// Subject is the subject of a message, or the name of a label.
+	Subject string `json:"subject,omitempty"`
+	Sender  string `json:"sender,omitempty"`
+
+	// Labels are the names of the labels and folders a message is in after the event.
+	Labels []string `json:"labels,omitempty"`
+
+	// Added and Removed are the names of the labels and folders a message was added to and removed from by the event,
+	// when the labels it was in before are known.
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// Journal is the persisted list of the most recent entries of a user.
+// The file is written as JSON lines so that new entries are appended; it is compacted once it grows too large.
+type Journal struct {
+	path string
+
+	entries     []Entry
+	fileEntries int
+	lock        sync.Mutex
+
+	log *logrus.Entry
+}
+
+// GetJournalPath returns the path of the journal of the given user in the given directory.
+func GetJournalPath(dir, userID string) string {
+	return filepath.Join(dir, userID+".journal")
+}
+
+// DeleteJournal removes the journal of the given user.
+func DeleteJournal(dir, userID string) error {
+	if err := os.Remove(GetJournalPath(dir, userID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// Open loads the journal of the given user, creating an empty one if it doesn't exist or can't be read.
+func Open(dir, userID string) *Journal {
+	journal := &Journal{
+		path: GetJournalPath(dir, userID),
+		log:  logrus.WithField("pkg", "eventjournal").WithField("userID", userID),
+	}
+
+	if err := journal.read(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		journal.log.WithError(err).Warn("Failed to read event journal, starting a new one")
+	}
+
+	return journal
+}
+
+// Entries returns the entries of the journal, from the oldest to the most recent.
+func (j *Journal) Entries() []Entry {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	return slices.Clone(j.entries)
+}
+
+// Append adds the given entries to the journal, dropping the oldest ones past MaxEntries.
+func (j *Journal) Append(entries ...Entry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	j.entries = append(j.entries, entries...)
+
+	if len(j.entries) > MaxEntries {
+		j.entries = slices.Clone(j.entries[len(j.entries)-MaxEntries:])
+	}
+
+	var err error
+
+	if j.fileEntries+len(entries) > 2*MaxEntries {
+		err = j.compact()
+	} else {
+		err = j.write(entries)
+	}
+
+	if err != nil {
+		j.log.WithError(err).Error("Failed to write event journal")
+	}
+}
+
+// last returns the most recent entry about the given item, if any.
+func (j *Journal) last(kind Kind, id string) (Entry, bool) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	for idx := len(j.entries) - 1; idx >= 0; idx-- {
+		if j.entries[idx].Kind == kind && j.entries[idx].ID == id {
+			return j.entries[idx], true
+		}
+	}
+
+	return Entry{}, false
+}
+
+func (j *Journal) read() error {
+	file, err := os.Open(j.path)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		var entry Entry
+
+		// A partially written last line is skipped.
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+
+		j.entries = append(j.entries, entry)
+		j.fileEntries++
+	}
+
+	if len(j.entries) > MaxEntries {
+		j.entries = j.entries[len(j.entries)-MaxEntries:]
+	}
+
+	return scanner.Err()
+}
+
+func (j *Journal) write(entries []Entry) error {
+	if err := os.MkdirAll(filepath.Dir(j.path), 0o700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	encoder := json.NewEncoder(file)
+
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return fmt.Errorf("failed to encode entry: %w", err)
+		}
+
+		j.fileEntries++
+	}
+
+	return nil
+}
+
+// compact rewrites the journal file with the entries kept in memory.
+func (j *Journal) compact() error {
+	tmpPath := j.path + ".tmp"
+
+	if err := os.Remove(tmpPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	tmp := &Journal{path: tmpPath}
+
+	if err := tmp.write(j.entries); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		return err
+	}
+
+	j.fileEntries = tmp.fileEntries
+
+	return nil
+}
+
+// Search returns the entries whose ID, subject or sender contain the given text, ignoring case.
+func Search(entries []Entry, text string) []Entry {
+	text = strings.ToLower(text)
+
+	return xslices.Filter(entries, func(entry Entry) bool {
+		return strings.Contains(strings.ToLower(entry.ID), text) ||
+			strings.Contains(strings.ToLower(entry.Subject), text) ||
+			strings.Contains(strings.ToLower(entry.Sender), text)
+	})
+}
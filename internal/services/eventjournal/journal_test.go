@@ -0,0 +1,150 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package eventjournal
+
+import (
+	"fmt"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal_AppendAndReopen(t *testing.T) {
+	dir := t.TempDir()
+
+	journal := Open(dir, "userID")
+	require.Empty(t, journal.Entries())
+
+	journal.Append(
+		Entry{EventID: "event1", Kind: KindMessage, Action: ActionCreated, ID: "msg1", Subject: "Hello"},
+		Entry{EventID: "event2", Kind: KindMessage, Action: ActionDeleted, ID: "msg1", Subject: "Hello"},
+	)
+
+	// The entries are read back from disk.
+	entries := Open(dir, "userID").Entries()
+	require.Len(t, entries, 2)
+	require.Equal(t, "event1", entries[0].EventID)
+	require.Equal(t, ActionDeleted, entries[1].Action)
+
+	require.NoError(t, DeleteJournal(dir, "userID"))
+	require.Empty(t, Open(dir, "userID").Entries())
+}
+
+func TestJournal_KeepsMostRecentEntries(t *testing.T) {
+	dir := t.TempDir()
+
+	journal := Open(dir, "userID")
+
+	for i := 0; i < 3*MaxEntries; i++ {
+		journal.Append(Entry{EventID: fmt.Sprintf("event%v", i), Kind: KindMessage, ID: "msg"})
+	}
+
+	entries := journal.Entries()
+	require.Len(t, entries, MaxEntries)
+	require.Equal(t, fmt.Sprintf("event%v", 3*MaxEntries-1), entries[MaxEntries-1].EventID)
+
+	// The file was compacted and holds the same entries.
+	reopened := Open(dir, "userID")
+	require.Equal(t, entries, reopened.Entries())
+	require.LessOrEqual(t, reopened.fileEntries, 2*MaxEntries)
+}
+
+func TestJournal_Search(t *testing.T) {
+	entries := []Entry{
+		{ID: "msg1", Subject: "Invoice for October", Sender: "billing@example.com"},
+		{ID: "msg2", Subject: "Lunch?", Sender: "friend@example.com"},
+	}
+
+	require.Len(t, Search(entries, "invoice"), 1)
+	require.Len(t, Search(entries, "FRIEND@"), 1)
+	require.Len(t, Search(entries, "msg"), 2)
+	require.Empty(t, Search(entries, "nothing"))
+}
+
+func TestService_NewEntries(t *testing.T) {
+	service := NewService("userID", nil, Open(t.TempDir(), "userID"), []proton.Label{
+		{ID: proton.InboxLabel, Name: "Inbox"},
+		{ID: proton.TrashLabel, Name: "Trash"},
+	})
+
+	now := time.Now()
+
+	record := func(event proton.Event) []Entry {
+		entries := service.newEntries(event, now)
+		service.journal.Append(entries...)
+
+		return entries
+	}
+
+	// A message is created in the inbox.
+	entries := record(proton.Event{EventID: "event1", Messages: []proton.MessageEvent{{
+		EventItem: proton.EventItem{ID: "msg1", Action: proton.EventCreate},
+		Message: proton.MessageMetadata{
+			ID:       "msg1",
+			Subject:  "Hello",
+			Sender:   &mail.Address{Address: "sender@example.com"},
+			LabelIDs: []string{proton.InboxLabel, proton.AllMailLabel},
+		},
+	}}})
+	require.Equal(t, []Entry{{
+		Time:    now,
+		EventID: "event1",
+		Kind:    KindMessage,
+		Action:  ActionCreated,
+		ID:      "msg1",
+		Subject: "Hello",
+		Sender:  "sender@example.com",
+		Labels:  []string{"Inbox"},
+	}}, entries)
+
+	// A new label is created and the message is moved to the trash and labeled in the same event.
+	entries = record(proton.Event{
+		EventID: "event2",
+		Labels: []proton.LabelEvent{{
+			EventItem: proton.EventItem{ID: "label1", Action: proton.EventCreate},
+			Label:     proton.Label{ID: "label1", Name: "Work"},
+		}},
+		Messages: []proton.MessageEvent{{
+			EventItem: proton.EventItem{ID: "msg1", Action: proton.EventUpdate},
+			Message: proton.MessageMetadata{
+				ID:       "msg1",
+				Subject:  "Hello",
+				LabelIDs: []string{proton.TrashLabel, "label1", proton.AllMailLabel},
+			},
+		}},
+	})
+	require.Len(t, entries, 2)
+	require.Equal(t, KindLabel, entries[0].Kind)
+	require.Equal(t, "Work", entries[0].Subject)
+	require.Equal(t, []string{"Trash", "Work"}, entries[1].Added)
+	require.Equal(t, []string{"Inbox"}, entries[1].Removed)
+
+	// The message is deleted; its subject is taken from the journal.
+	entries = record(proton.Event{EventID: "event3", Messages: []proton.MessageEvent{{
+		EventItem: proton.EventItem{ID: "msg1", Action: proton.EventDelete},
+	}}})
+	require.Equal(t, ActionDeleted, entries[0].Action)
+	require.Equal(t, "Hello", entries[0].Subject)
+
+	// A refresh is recorded as such.
+	entries = record(proton.Event{EventID: "event4", Refresh: proton.RefreshMail})
+	require.Equal(t, []Entry{{Time: now, EventID: "event4", Kind: KindRefresh}}, entries)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package eventjournal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/orderedtasks"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/userevents"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+)
+
+// hiddenLabelIDs are the labels every message of a kind is in; they are left out of the journal as they carry no information.
+var hiddenLabelIDs = []string{ //nolint:gochecknoglobals
+	proton.AllMailLabel,
+	proton.AllDraftsLabel,
+	proton.AllSentLabel,
+	proton.AllScheduledLabel,
+}
+
+// Service records the message and label events of a user in its journal.
+type Service struct {
+	userID string
+
+	log *logrus.Entry
+
+	eventService userevents.Subscribable
+	subscription *userevents.EventChanneledSubscriber
+
+	journal *Journal
+
+	// labelNames maps label IDs to names; it is only accessed from the event loop.
+	labelNames map[string]string
+}
+
+func NewService(userID string, service userevents.Subscribable, journal *Journal, labels []proton.Label) *Service {
+	labelNames := make(map[string]string, len(labels))
+
+	for _, label := range labels {
+		labelNames[label.ID] = label.Name
+	}
+
+	return &Service{
+		userID: userID,
+
+		log: logrus.WithFields(logrus.Fields{
+			"user":    userID,
+			"service": "event-journal",
+		}),
+
+		eventService: service,
+		subscription: userevents.NewEventSubscriber(fmt.Sprintf("event-journal-%v", userID)),
+
+		journal: journal,
+
+		labelNames: labelNames,
+	}
+}
+
+func (s *Service) Start(ctx context.Context, group *orderedtasks.OrderedCancelGroup) {
+	group.Go(ctx, s.userID, "event-journal-service", s.run)
+}
+
+// Entries returns the entries of the user's journal, from the oldest to the most recent.
+func (s *Service) Entries() []Entry {
+	return s.journal.Entries()
+}
+
+func (s *Service) run(ctx context.Context) {
+	s.log.Info("Starting service main loop")
+	defer s.log.Info("Exiting service main loop")
+
+	s.eventService.Subscribe(s.subscription)
+	defer s.eventService.Unsubscribe(s.subscription)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-s.subscription.OnEventCh():
+			if !ok {
+				continue
+			}
+
+			e.Consume(func(event proton.Event) error {
+				s.journal.Append(s.newEntries(event, time.Now())...)
+				return nil
+			})
+		}
+	}
+}
+
+// newEntries returns the journal entries describing the given event.
+func (s *Service) newEntries(event proton.Event, now time.Time) []Entry {
+	if event.Refresh&proton.RefreshMail != 0 {
+		return []Entry{{Time: now, EventID: event.EventID, Kind: KindRefresh}}
+	}
+
+	var entries []Entry
+
+	// Labels come first so that messages moved to a new label get its name.
+	for _, labelEvent := range event.Labels {
+		entries = append(entries, s.newLabelEntry(event.EventID, labelEvent, now))
+	}
+
+	for _, messageEvent := range event.Messages {
+		entries = append(entries, s.newMessageEntry(event.EventID, messageEvent, now))
+	}
+
+	return entries
+}
+
+func (s *Service) newLabelEntry(eventID string, event proton.LabelEvent, now time.Time) Entry {
+	entry := Entry{
+		Time:    now,
+		EventID: eventID,
+		Kind:    KindLabel,
+		Action:  getAction(event.Action),
+		ID:      event.ID,
+	}
+
+	if event.Action == proton.EventDelete {
+		entry.Subject = s.labelNames[event.ID]
+		delete(s.labelNames, event.ID)
+	} else {
+		entry.Subject = event.Label.Name
+		s.labelNames[event.ID] = event.Label.Name
+	}
+
+	return entry
+}
+
+func (s *Service) newMessageEntry(eventID string, event proton.MessageEvent, now time.Time) Entry {
+	entry := Entry{
+		Time:    now,
+		EventID: eventID,
+		Kind:    KindMessage,
+		Action:  getAction(event.Action),
+		ID:      event.ID,
+	}
+
+	previous, hasPrevious := s.journal.last(KindMessage, event.ID)
+
+	if event.Action == proton.EventDelete {
+		entry.Subject = previous.Subject
+		entry.Sender = previous.Sender
+
+		return entry
+	}
+
+	entry.Subject = event.Message.Subject
+
+	if event.Message.Sender != nil {
+		entry.Sender = event.Message.Sender.Address
+	}
+
+	entry.Labels = s.getLabelNames(event.Message.LabelIDs)
+
+	if hasPrevious && previous.Action != ActionDeleted {
+		entry.Added = xslices.Filter(entry.Labels, func(name string) bool { return !slices.Contains(previous.Labels, name) })
+		entry.Removed = xslices.Filter(previous.Labels, func(name string) bool { return !slices.Contains(entry.Labels, name) })
+	}
+
+	return entry
+}
+
+func (s *Service) getLabelNames(labelIDs []string) []string {
+	var names []string
+
+	for _, labelID := range labelIDs {
+		if slices.Contains(hiddenLabelIDs, labelID) {
+			continue
+		}
+
+		if name, ok := s.labelNames[labelID]; ok {
+			names = append(names, name)
+		} else {
+			names = append(names, labelID)
+		}
+	}
+
+	return names
+}
+
+func getAction(action proton.EventAction) Action {
+	switch action {
+	case proton.EventCreate:
+		return ActionCreated
+	case proton.EventDelete:
+		return ActionDeleted
+	default:
+		return ActionUpdated
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/ProtonMail/gluon/rfc822"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/membudget"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/sendrecorder"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
@@ -67,6 +68,13 @@ type Connector struct {
 
 	sharedCache *SharedCache
 	syncState   *SyncState
+	memBudget   *membudget.Budget
+	jobOpts     *messageJobOptions
+
+	readReceipts *readReceiptIndex
+	readHandler  ReadHandler
+
+	olderMail olderMailSearcher
 }
 
 var errNoSenderAddressMatch = errors.New("no matching sender found in address list")
@@ -82,6 +90,11 @@ func NewConnector(
 	reporter reporter.Reporter,
 	showAllMail bool,
 	syncState *SyncState,
+	memBudget *membudget.Budget,
+	jobOpts *messageJobOptions,
+	readReceipts *readReceiptIndex,
+	readHandler ReadHandler,
+	olderMail olderMailSearcher,
 ) *Connector {
 	userID := identityState.UserID()
 
@@ -115,6 +128,13 @@ func NewConnector(
 
 		sharedCache: NewSharedCached(),
 		syncState:   syncState,
+		memBudget:   memBudget,
+		jobOpts:     jobOpts,
+
+		readReceipts: readReceipts,
+		readHandler:  readHandler,
+
+		olderMail: olderMail,
 	}
 }
 
@@ -202,9 +222,18 @@ func (s *Connector) GetMessageLiteral(ctx context.Context, id imap.MessageID) ([
 		return nil, err
 	}
 
+	// Building holds a decrypted copy of the message next to the downloaded one; wait for running syncs to make room.
+	reservation, err := s.memBudget.Acquire(ctx, uint64(msg.Size)) //nolint:gosec // disable G115
+	if err != nil {
+		return nil, err
+	}
+	defer reservation.Release()
+
+	s.readReceipts.record(msg.Message)
+
 	var literal []byte
 	err = s.identityState.WithAddrKR(msg.AddressID, func(_, addrKR *crypto.KeyRing) error {
-		l, buildErr := message.DecryptAndBuildRFC822(addrKR, msg.Message, msg.AttData, defaultMessageJobOpts())
+		l, buildErr := message.DecryptAndBuildRFC822(addrKR, msg.Message, msg.AttData, s.jobOpts.get())
 		if buildErr != nil {
 			return buildErr
 		}
@@ -267,6 +296,16 @@ func (s *Connector) CreateMessage(ctx context.Context, _ connector.IMAPStateWrit
 		return imap.Message{}, nil, connector.ErrOperationNotAllowed
 	}
 
+	// Reject messages the API would refuse before uploading anything. Like when sending, the attachments are measured
+	// decoded; messages that can't be parsed are left for the import to reject.
+	if limit, ok := s.identityState.AppendLimit(); ok {
+		if msg, err := message.Parse(bytes.NewReader(literal)); err == nil && msg.AttachmentSize() > int64(limit) { //nolint:gosec // disable G115
+			s.log.WithField("size", msg.AttachmentSize()).WithField("limit", limit).Warn("Message exceeds the append limit")
+
+			return imap.Message{}, nil, fmt.Errorf("message attachments of %v bytes exceed the limit of %v bytes: %w", msg.AttachmentSize(), limit, connector.ErrMessageSizeExceedsLimits)
+		}
+	}
+
 	toList, err := getLiteralToList(literal)
 	if err != nil {
 		return imap.Message{}, nil, fmt.Errorf("failed to retrieve addresses from literal:%w", err)
@@ -294,7 +333,7 @@ func (s *Connector) CreateMessage(ctx context.Context, _ connector.IMAPStateWrit
 		if err := s.identityState.WithAddrKR(full.AddressID, func(_, addrKR *crypto.KeyRing) error {
 			var err error
 
-			if literal, err = message.DecryptAndBuildRFC822(addrKR, full.Message, full.AttData, defaultMessageJobOpts()); err != nil {
+			if literal, err = message.DecryptAndBuildRFC822(addrKR, full.Message, full.AttData, s.jobOpts.get()); err != nil {
 				return err
 			}
 
@@ -488,12 +527,38 @@ func (s *Connector) MoveMessages(ctx context.Context, _ connector.IMAPStateWrite
 
 func (s *Connector) MarkMessagesSeen(ctx context.Context, _ connector.IMAPStateWrite, messageIDs []imap.MessageID, seen bool) error {
 	if seen {
-		return s.client.MarkMessagesRead(ctx, usertypes.MapTo[imap.MessageID, string](messageIDs)...)
+		ids := usertypes.MapTo[imap.MessageID, string](messageIDs)
+
+		if err := s.client.MarkMessagesRead(ctx, ids...); err != nil {
+			return err
+		}
+
+		if s.readHandler != nil {
+			s.notifyMessagesRead(ids)
+		}
+
+		return nil
 	}
 
 	return s.client.MarkMessagesUnread(ctx, usertypes.MapTo[imap.MessageID, string](messageIDs)...)
 }
 
+// notifyMessagesRead passes the messages that may request a read receipt to the read handler.
+// Gluon only marks messages that aren't \Seen yet, so each message is passed once.
+func (s *Connector) notifyMessagesRead(messageIDs []string) {
+	messages := make([]ReadMessage, 0, len(messageIDs))
+
+	for _, messageID := range messageIDs {
+		if msg, none := s.readReceipts.lookup(messageID); !none {
+			messages = append(messages, msg)
+		}
+	}
+
+	if len(messages) > 0 {
+		s.readHandler.OnMessagesRead(messages)
+	}
+}
+
 func (s *Connector) MarkMessagesFlagged(ctx context.Context, _ connector.IMAPStateWrite, messageIDs []imap.MessageID, flagged bool) error {
 	if flagged {
 		return s.client.LabelMessages(ctx, usertypes.MapTo[imap.MessageID, string](messageIDs), proton.StarredLabel)
@@ -737,7 +802,7 @@ func (s *Connector) importMessage(
 			return fmt.Errorf("failed to fetch message: %w", err)
 		}
 
-		if literal, err = message.DecryptAndBuildRFC822(primaryKey, full.Message, full.AttData, defaultMessageJobOpts()); err != nil {
+		if literal, err = message.DecryptAndBuildRFC822(primaryKey, full.Message, full.AttData, s.jobOpts.get()); err != nil {
 			return fmt.Errorf("failed to build message: %w", err)
 		}
 
@@ -942,3 +1007,22 @@ func (s *Connector) getSenderProtonAddress(p *parser.Parser) (proton.Address, er
 
 	return addressList[index], nil
 }
+
+// HasAddress returns whether IMAP clients log in to this connector with the given email address.
+func (s *Connector) HasAddress(email string) bool {
+	for _, addr := range s.identityState.GetAddresses() {
+		if !equalAddresses(addr.Email, email) {
+			continue
+		}
+
+		return s.addressMode != usertypes.AddressModeSplit || addr.ID == s.addrID
+	}
+
+	return false
+}
+
+// SearchOlderMail adds the messages older than the sync cutoff whose subject contains the given text to the mailboxes,
+// so that a search of the client can find them. It returns how many messages were added.
+func (s *Connector) SearchOlderMail(ctx context.Context, subject string) (int, error) {
+	return s.olderMail.SearchOlderMail(ctx, s.addrID, subject)
+}
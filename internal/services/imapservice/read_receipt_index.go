@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/ProtonMail/go-proton-api"
+)
+
+// readReceiptIndexSize is the number of recently built messages whose read receipt request is remembered.
+const readReceiptIndexSize = 10000
+
+// ReadMessage is a message an IMAP client marked as read.
+type ReadMessage struct {
+	ID string
+
+	// Message holds the metadata and header of the message if it is known to request a read receipt.
+	// It is nil if the message was not built recently, in which case the handler has to look it up.
+	Message *proton.Message
+}
+
+// readReceiptIndex remembers, for the messages bridge built recently, whether they request a read receipt.
+// This spares looking up every message an IMAP client marks as read.
+type readReceiptIndex struct {
+	lock    sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type readReceiptEntry struct {
+	messageID string
+
+	// msg is the message without its body, or nil if it doesn't request a read receipt.
+	msg *proton.Message
+}
+
+func newReadReceiptIndex() *readReceiptIndex {
+	return &readReceiptIndex{
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// record remembers whether the given message requests a read receipt.
+func (idx *readReceiptIndex) record(msg proton.Message) {
+	entry := readReceiptEntry{messageID: msg.ID}
+
+	if requestsReadReceipt(msg) {
+		msg.Body = ""
+		msg.Attachments = nil
+
+		entry.msg = &msg
+	}
+
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+
+	if elem, ok := idx.entries[msg.ID]; ok {
+		elem.Value = entry
+		idx.order.MoveToFront(elem)
+
+		return
+	}
+
+	idx.entries[msg.ID] = idx.order.PushFront(entry)
+
+	if idx.order.Len() > readReceiptIndexSize {
+		oldest := idx.order.Back()
+		idx.order.Remove(oldest)
+		delete(idx.entries, oldest.Value.(readReceiptEntry).messageID) //nolint:forcetypeassert
+	}
+}
+
+// lookup returns the given message as a ReadMessage and whether it is known to not request a read receipt.
+func (idx *readReceiptIndex) lookup(messageID string) (ReadMessage, bool) {
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+
+	elem, ok := idx.entries[messageID]
+	if !ok {
+		return ReadMessage{ID: messageID}, false
+	}
+
+	entry := elem.Value.(readReceiptEntry) //nolint:forcetypeassert
+
+	return ReadMessage{ID: messageID, Message: entry.msg}, entry.msg == nil
+}
+
+// requestsReadReceipt returns whether the given message was received and has a Disposition-Notification-To header.
+func requestsReadReceipt(msg proton.Message) bool {
+	if msg.Flags&proton.MessageFlagReceived == 0 {
+		return false
+	}
+
+	header, err := rfc822.NewHeader([]byte(msg.Header))
+	if err != nil {
+		// Let the read handler look at it.
+		return true
+	}
+
+	return header.Has("Disposition-Notification-To")
+}
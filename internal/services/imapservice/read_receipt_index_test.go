@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadReceiptIndex(t *testing.T) {
+	idx := newReadReceiptIndex()
+
+	idx.record(proton.Message{
+		MessageMetadata: proton.MessageMetadata{ID: "requested", Flags: proton.MessageFlagReceived},
+		Header:          "Disposition-Notification-To: <alice@example.com>\r\n\r\n",
+		Body:            "body",
+	})
+
+	idx.record(proton.Message{
+		MessageMetadata: proton.MessageMetadata{ID: "sent", Flags: proton.MessageFlagSent},
+		Header:          "Disposition-Notification-To: <alice@example.com>\r\n\r\n",
+	})
+
+	idx.record(proton.Message{
+		MessageMetadata: proton.MessageMetadata{ID: "plain", Flags: proton.MessageFlagReceived},
+		Header:          "Subject: hello\r\n\r\n",
+	})
+
+	// A received message with the header is passed on, without its body.
+	msg, none := idx.lookup("requested")
+	require.False(t, none)
+	require.NotNil(t, msg.Message)
+	require.Empty(t, msg.Message.Body)
+
+	// Sent messages and messages without the header are known to not request a read receipt.
+	_, none = idx.lookup("sent")
+	require.True(t, none)
+
+	_, none = idx.lookup("plain")
+	require.True(t, none)
+
+	// Unknown messages have to be looked up.
+	msg, none = idx.lookup("unknown")
+	require.False(t, none)
+	require.Nil(t, msg.Message)
+
+	// The oldest messages are forgotten.
+	for i := 0; i < readReceiptIndexSize; i++ {
+		idx.record(proton.Message{MessageMetadata: proton.MessageMetadata{ID: fmt.Sprintf("msg-%v", i)}})
+	}
+
+	msg, none = idx.lookup("requested")
+	require.False(t, none)
+	require.Nil(t, msg.Message)
+}
@@ -26,10 +26,13 @@ import (
 	"time"
 
 	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/gluon/imap"
 	"github.com/ProtonMail/gluon/reporter"
 	"github.com/ProtonMail/gluon/watcher"
 	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/membudget"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/observability"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/orderedtasks"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/sendrecorder"
@@ -38,6 +41,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/useridentity"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/cpc"
+	"github.com/bradenaw/juniper/xslices"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 )
@@ -55,6 +59,17 @@ type GluonIDProvider interface {
 	GluonKey() []byte
 }
 
+// ReadHandler is notified of the messages IMAP clients mark as read, and of deleted messages so it can forget them.
+type ReadHandler interface {
+	OnMessagesRead(messages []ReadMessage)
+	OnMessageDeleted(messageID string)
+}
+
+// olderMailSearcher adds the messages older than the sync cutoff that match a search to the IMAP databases.
+type olderMailSearcher interface {
+	SearchOlderMail(ctx context.Context, addrID, subject string) (int, error)
+}
+
 type Service struct {
 	log *logrus.Entry
 	cpc *cpc.CPC
@@ -70,6 +85,7 @@ type Service struct {
 	eventProvider   EventProvider
 	serverManager   IMAPServerManager
 	eventPublisher  events.EventPublisher
+	readHandler     ReadHandler
 
 	panicHandler async.PanicHandler
 	sendRecorder *sendrecorder.SendRecorder
@@ -78,9 +94,21 @@ type Service struct {
 	eventSubscription events.Subscription
 	eventWatcher      *watcher.Watcher[events.Event]
 	connectors        map[string]*Connector
-	maxSyncMemory     uint64
+	memBudget         *membudget.Budget
+	jobOpts           *messageJobOptions
+	readReceipts      *readReceiptIndex
 	showAllMail       bool
 
+	// syncCutoff is the time (unix) before which messages are not synced; zero means all messages are.
+	syncCutoff int64
+
+	// cutoffRemovals is set once messages were removed because of the sync cutoff. Gluon only deletes them for good
+	// when the IMAP user is loaded again, so until then they can't be synced again incrementally.
+	cutoffRemovals bool
+
+	// olderMessageIDs are the messages older than the sync cutoff that were added because a client searched for them.
+	olderMessageIDs map[string]struct{}
+
 	syncHandler        *syncservice.Handler
 	syncUpdateApplier  *SyncUpdateApplier
 	syncMessageBuilder *SyncMessageBuilder
@@ -92,6 +120,9 @@ type Service struct {
 	isSyncing          atomic.Bool
 
 	observabilitySender observability.Sender
+
+	// clock times the retries of failed syncs.
+	clock clock.Clock
 }
 
 func NewService(
@@ -101,6 +132,7 @@ func NewService(
 	eventProvider EventProvider,
 	serverManager IMAPServerManager,
 	eventPublisher events.EventPublisher,
+	readHandler ReadHandler,
 	bridgePassProvider useridentity.BridgePassProvider,
 	keyPassProvider useridentity.KeyPassProvider,
 	panicHandler async.PanicHandler,
@@ -109,9 +141,13 @@ func NewService(
 	addressMode usertypes.AddressMode,
 	subscription events.Subscription,
 	syncConfigDir string,
-	maxSyncMemory uint64,
+	memBudget *membudget.Budget,
+	repairMIME bool,
+	spillDir func() string,
 	showAllMail bool,
+	syncCutoff int64,
 	observabilitySender observability.Sender,
+	clock clock.Clock,
 ) *Service {
 	subscriberName := fmt.Sprintf("imap-%v", identityState.User.ID)
 
@@ -122,7 +158,9 @@ func NewService(
 	rwIdentity := newRWIdentity(identityState, bridgePassProvider, keyPassProvider)
 
 	syncUpdateApplier := NewSyncUpdateApplier()
-	syncMessageBuilder := NewSyncMessageBuilder(rwIdentity)
+	jobOpts := newMessageJobOptions(repairMIME, spillDir)
+	readReceipts := newReadReceiptIndex()
+	syncMessageBuilder := NewSyncMessageBuilder(rwIdentity, jobOpts, readReceipts)
 	syncReporter := newSyncReporter(identityState.User.ID, eventPublisher, time.Second)
 
 	return &Service{
@@ -137,6 +175,7 @@ func NewService(
 		serverManager:   serverManager,
 		eventProvider:   eventProvider,
 		eventPublisher:  eventPublisher,
+		readHandler:     readHandler,
 
 		subscription: userevents.NewEventSubscriber(subscriberName),
 
@@ -144,19 +183,25 @@ func NewService(
 		sendRecorder: sendRecorder,
 		reporter:     reporter,
 
-		connectors:    make(map[string]*Connector),
-		maxSyncMemory: maxSyncMemory,
+		connectors:   make(map[string]*Connector),
+		memBudget:    memBudget,
+		jobOpts:      jobOpts,
+		readReceipts: readReceipts,
 
 		eventWatcher:      subscription.Add(events.IMAPServerCreated{}, events.ConnStatusUp{}, events.ConnStatusDown{}),
 		eventSubscription: subscription,
 		showAllMail:       showAllMail,
 
+		syncCutoff: syncCutoff,
+
 		syncUpdateApplier:  syncUpdateApplier,
 		syncMessageBuilder: syncMessageBuilder,
 		syncReporter:       syncReporter,
 		syncConfigPath:     GetSyncConfigPath(syncConfigDir, identityState.User.ID),
 
 		observabilitySender: observabilitySender,
+
+		clock: clock,
 	}
 }
 
@@ -176,7 +221,8 @@ func (s *Service) Start(
 		s.syncStateProvider = syncStateProvider
 	}
 
-	s.syncHandler = syncservice.NewHandler(syncRegulator, s.client, s.identityState.UserID(), s.syncStateProvider, s.log, s.panicHandler)
+	s.syncHandler = syncservice.NewHandler(syncRegulator, s.client, s.identityState.UserID(), s.syncStateProvider, s.log, s.panicHandler, s.clock)
+	s.syncHandler.SetCutoff(s.syncCutoff)
 
 	// Get user labels
 	apiLabels, err := s.client.GetLabels(ctx, proton.LabelTypeSystem, proton.LabelTypeFolder, proton.LabelTypeLabel)
@@ -245,6 +291,28 @@ func (s *Service) ShowAllMail(ctx context.Context, v bool) error {
 	return err
 }
 
+// SetRepairMIME sets whether malformed MIME is repaired when building messages.
+// It applies to messages built from now on; messages already cached by gluon are only rebuilt on resync.
+func (s *Service) SetRepairMIME(v bool) {
+	s.jobOpts.repairMIME.Store(v)
+}
+
+// SetSyncCutoff sets the time (unix) before which messages are not synced; zero means all messages are.
+// The IMAP databases are adjusted incrementally: narrowing the sync window removes the messages it no longer covers,
+// widening it syncs the messages it now covers.
+func (s *Service) SetSyncCutoff(ctx context.Context, cutoff int64) error {
+	_, err := s.cpc.Send(ctx, &setSyncCutoffReq{cutoff: cutoff})
+
+	return err
+}
+
+// SearchOlderMail searches the server for the messages older than the sync cutoff whose subject contains the given
+// text, and adds them to the IMAP databases so that clients can find them. In split mode, only the messages of the
+// given address are searched. It returns how many messages were added.
+func (s *Service) SearchOlderMail(ctx context.Context, addrID, subject string) (int, error) {
+	return cpc.SendTyped[int](ctx, s.cpc, &searchOlderMailReq{addrID: addrID, subject: subject})
+}
+
 func (s *Service) GetLabels(ctx context.Context) (map[string]proton.Label, error) {
 	return cpc.SendTyped[map[string]proton.Label](ctx, s.cpc, &getLabelsReq{})
 }
@@ -304,6 +372,16 @@ func (s *Service) HandleUserEvent(_ context.Context, user *proton.User) error {
 	})
 }
 
+func (s *Service) HandleUsedSpaceEvent(_ context.Context, newSpace int64) error {
+	s.log.Debug("handling used space event")
+
+	return s.identityState.Write(func(identity *useridentity.State) error {
+		identity.OnUserSpaceChanged(uint64(newSpace)) //nolint:gosec // disable G115
+
+		return nil
+	})
+}
+
 func (s *Service) run(ctx context.Context) { //nolint gocyclo
 	s.log.Info("Starting IMAP Service")
 	defer s.log.Info("Exiting IMAP Service")
@@ -315,11 +393,12 @@ func (s *Service) run(ctx context.Context) { //nolint gocyclo
 	s.startSyncing()
 
 	eventHandler := userevents.EventHandler{
-		UserHandler:    s,
-		AddressHandler: s,
-		RefreshHandler: s,
-		LabelHandler:   s,
-		MessageHandler: s,
+		UserHandler:      s,
+		AddressHandler:   s,
+		RefreshHandler:   s,
+		LabelHandler:     s,
+		MessageHandler:   s,
+		UsedSpaceHandler: s,
 	}
 
 	syncEventHandler := s.newSyncEventHandler()
@@ -378,6 +457,16 @@ func (s *Service) run(ctx context.Context) { //nolint gocyclo
 				req.Reply(ctx, nil, nil)
 				s.setShowAllMail(r.v)
 
+			case *setSyncCutoffReq:
+				s.log.Debug("Set sync cutoff request")
+				err := s.setSyncCutoff(ctx, r.cutoff)
+				req.Reply(ctx, nil, err)
+
+			case *searchOlderMailReq:
+				s.log.Debug("Search older mail request")
+				added, err := s.searchOlderMail(ctx, r.addrID, r.subject)
+				req.Reply(ctx, added, err)
+
 			case *getSyncFailedMessagesReq:
 				s.log.Debug("Get sync failed messages Request")
 				status, err := s.syncStateProvider.GetSyncStatus(ctx)
@@ -518,6 +607,11 @@ func (s *Service) buildConnectors() (map[string]*Connector, error) {
 			s.reporter,
 			s.showAllMail,
 			s.syncStateProvider,
+			s.memBudget,
+			s.jobOpts,
+			s.readReceipts,
+			s.readHandler,
+			s,
 		)
 
 		return connectors, nil
@@ -535,6 +629,11 @@ func (s *Service) buildConnectors() (map[string]*Connector, error) {
 			s.reporter,
 			s.showAllMail,
 			s.syncStateProvider,
+			s.memBudget,
+			s.jobOpts,
+			s.readReceipts,
+			s.readHandler,
+			s,
 		)
 	}
 
@@ -591,6 +690,10 @@ func (s *Service) setAddressMode(ctx context.Context, mode usertypes.AddressMode
 		return nil
 	}
 
+	s.cancelSync()
+
+	reusedAddrID, canReuse := s.getReusableAddressID(ctx)
+
 	s.addressMode = mode
 	if mode == usertypes.AddressModeSplit {
 		s.log.Info("Setting Split Address Mode")
@@ -598,7 +701,17 @@ func (s *Service) setAddressMode(ctx context.Context, mode usertypes.AddressMode
 		s.log.Info("Setting Combined Address Mode")
 	}
 
-	s.cancelSync()
+	if canReuse {
+		if err := s.remapAddressMode(ctx, reusedAddrID); err != nil {
+			return fmt.Errorf("failed to remap address mode: %w", err)
+		}
+
+		s.startSyncing()
+
+		return nil
+	}
+
+	s.log.Info("Sync is not complete, resyncing all addresses")
 
 	if err := s.removeConnectorsFromServer(ctx, s.connectors, true); err != nil {
 		return err
@@ -621,6 +734,159 @@ func (s *Service) setAddressMode(ctx context.Context, mode usertypes.AddressMode
 	return nil
 }
 
+// getReusableAddressID returns the address whose IMAP database is shared by both address modes. In combined mode,
+// all messages are stored in the database of the primary address, which is also the database of that address
+// in split mode. The database can only be reused if it was fully synced.
+func (s *Service) getReusableAddressID(ctx context.Context) (string, bool) {
+	status, err := s.syncStateProvider.GetSyncStatus(ctx)
+	if err != nil {
+		s.log.WithError(err).Error("Failed to get sync status")
+		return "", false
+	}
+
+	if !status.IsComplete() {
+		return "", false
+	}
+
+	if s.addressMode == usertypes.AddressModeCombined {
+		// The connector keeps its address if the primary address changed since it was created.
+		for _, c := range s.connectors {
+			if _, ok := s.identityState.GetAddress(c.addrID); ok {
+				return c.addrID, true
+			}
+		}
+
+		return "", false
+	}
+
+	addr, err := s.identityState.GetPrimaryAddress()
+	if err != nil {
+		s.log.WithError(err).Error("Failed to get primary address")
+		return "", false
+	}
+
+	if _, ok := s.connectors[addr.ID]; !ok {
+		return "", false
+	}
+
+	return addr.ID, true
+}
+
+// remapAddressMode switches the address mode while keeping the IMAP database of the given address. Only the
+// databases of the other addresses are rebuilt; the messages already present in the kept database are not
+// downloaded again. Clients of the kept address are disconnected while the connector is replaced, but the
+// mailboxes and UIDs they already know about remain valid.
+func (s *Service) remapAddressMode(ctx context.Context, reusedAddrID string) error {
+	s.log.WithField("addrID", reusedAddrID).Info("Remapping address mode, reusing existing IMAP database")
+
+	reused, others := s.splitConnectors(reusedAddrID)
+
+	if err := s.removeConnectorsFromServer(ctx, others, true); err != nil {
+		return err
+	}
+
+	if err := s.removeConnectorsFromServer(ctx, reused, false); err != nil {
+		return err
+	}
+
+	if err := s.rebuildConnectors(); err != nil {
+		return fmt.Errorf("failed to rebuild connectors: %w", err)
+	}
+
+	reused, others = s.splitConnectors(reusedAddrID)
+
+	// The kept database must be loaded first, as adding new IMAP users resets the sync status.
+	if err := s.addConnectorsToServer(ctx, reused); err != nil {
+		return err
+	}
+
+	if err := s.addConnectorsToServer(ctx, others); err != nil {
+		return err
+	}
+
+	// Labels are synced here so that the new databases are never re-created if the sync gets interrupted.
+	updates, err := syncLabels(ctx, s.labels.GetLabelMap(), maps.Values(s.connectors))
+	if err != nil {
+		return fmt.Errorf("failed to sync labels: %w", err)
+	}
+
+	if err := waitOnIMAPUpdates(ctx, updates); err != nil {
+		return fmt.Errorf("failed to sync labels: %w", err)
+	}
+
+	if s.addressMode == usertypes.AddressModeSplit {
+		for _, c := range reused {
+			if err := s.removeOtherAddressMessages(ctx, c); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := s.syncStateProvider.ClearSyncStatusReusingAddress(ctx, reusedAddrID); err != nil {
+		return fmt.Errorf("failed to clear sync status:%w", err)
+	}
+
+	return nil
+}
+
+// splitConnectors separates the connector of the given address from the other connectors.
+func (s *Service) splitConnectors(addrID string) (map[string]*Connector, map[string]*Connector) {
+	match := make(map[string]*Connector)
+	others := make(map[string]*Connector)
+
+	for id, c := range s.connectors {
+		if c.addrID == addrID {
+			match[id] = c
+		} else {
+			others[id] = c
+		}
+	}
+
+	return match, others
+}
+
+// removeOtherAddressMessages removes the messages which do not belong to the connector's address from its
+// database. This is required when a database created in combined mode is reused in split mode.
+func (s *Service) removeOtherAddressMessages(ctx context.Context, c *Connector) error {
+	const pageSize = 150
+
+	for _, addr := range s.identityState.GetAddresses() {
+		if addr.ID == c.addrID {
+			continue
+		}
+
+		for page := 0; ; page++ {
+			metadata, err := s.client.GetMessageMetadataPage(ctx, page, pageSize, proton.MessageFilter{
+				AddressID: addr.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get message metadata: %w", err)
+			}
+
+			if len(metadata) == 0 {
+				break
+			}
+
+			updates := xslices.Map(metadata, func(m proton.MessageMetadata) imap.Update {
+				update := imap.NewMessagesDeleted(imap.MessageID(m.ID))
+				c.publishUpdate(ctx, update)
+
+				return update
+			})
+
+			if err := waitOnIMAPUpdates(ctx, updates); err != nil {
+				return fmt.Errorf("failed to remove messages of other addresses: %w", err)
+			}
+
+			if len(metadata) < pageSize {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) setShowAllMail(v bool) {
 	if s.showAllMail == v {
 		return
@@ -655,6 +921,13 @@ type onLogoutReq struct{}
 
 type showAllMailReq struct{ v bool }
 
+type setSyncCutoffReq struct{ cutoff int64 }
+
+type searchOlderMailReq struct {
+	addrID  string
+	subject string
+}
+
 type onDeleteReq struct{}
 
 type setAddressModeReq struct {
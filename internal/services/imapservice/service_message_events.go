@@ -28,6 +28,7 @@ import (
 	"github.com/ProtonMail/gluon/imap"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	obsMetrics "github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice/observabilitymetrics/evtloopmsgevents"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/observability"
@@ -134,6 +135,11 @@ func onMessageCreated(
 		"date":      message.Time,
 	}).Info("Handling message created event")
 
+	if s.isBeforeSyncCutoff(message) {
+		s.log.WithField("messageID", message.ID).Info("Message is older than the sync cutoff, not creating it")
+		return nil, nil
+	}
+
 	full, err := s.client.GetFullMessage(ctx, message.ID, usertypes.NewProtonAPIScheduler(s.panicHandler), proton.NewDefaultAttachmentAllocator())
 	if err != nil {
 		// If the message is not found, it means that it has been deleted before we could fetch it.
@@ -150,7 +156,8 @@ func onMessageCreated(
 	apiLabels := s.labels.GetLabelMap()
 
 	if err := s.identityState.WithAddrKR(message.AddressID, func(_, addrKR *crypto.KeyRing) error {
-		res := buildRFC822(apiLabels, full, addrKR, new(bytes.Buffer))
+		res := buildRFC822(apiLabels, full, addrKR, s.jobOpts.get(), new(bytes.Buffer))
+		s.readReceipts.record(full.Message)
 
 		if res.err != nil {
 			s.log.WithError(err).Error("Failed to build RFC822 message")
@@ -186,6 +193,13 @@ func onMessageCreated(
 		return nil, nil
 	}
 
+	if message.Flags&proton.MessageFlagReceived != 0 {
+		s.eventPublisher.PublishEvent(ctx, events.MessageReceived{
+			UserID:    s.identityState.UserID(),
+			MessageID: message.ID,
+		})
+	}
+
 	return []imap.Update{update}, nil
 }
 
@@ -212,7 +226,8 @@ func onMessageUpdateDraftOrSent(ctx context.Context, s *Service, event proton.Me
 	apiLabels := s.labels.GetLabelMap()
 
 	if err := s.identityState.WithAddrKR(event.Message.AddressID, func(_, addrKR *crypto.KeyRing) error {
-		res := buildRFC822(apiLabels, full, addrKR, new(bytes.Buffer))
+		res := buildRFC822(apiLabels, full, addrKR, s.jobOpts.get(), new(bytes.Buffer))
+		s.readReceipts.record(full.Message)
 
 		if res.err != nil {
 			logrus.WithError(err).Error("Failed to build RFC822 message")
@@ -287,6 +302,10 @@ func onMessageUpdate(ctx context.Context, s *Service, message proton.MessageMeta
 func onMessageDeleted(ctx context.Context, s *Service, event proton.MessageEvent) []imap.Update {
 	s.log.WithField("messageID", event.ID).Info("Handling message deleted event")
 
+	if s.readHandler != nil {
+		s.readHandler.OnMessageDeleted(event.ID)
+	}
+
 	updates := make([]imap.Update, 0, len(s.connectors))
 
 	for _, updateCh := range maps.Values(s.connectors) {
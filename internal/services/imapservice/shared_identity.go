@@ -33,6 +33,7 @@ type sharedIdentity interface {
 	GetAddresses() []proton.Address
 	WithAddrKR(addrID string, fn func(userKR, addrKR *crypto.KeyRing) error) error
 	CheckAuth(email string, password []byte) (string, error)
+	AppendLimit() (uint64, bool)
 }
 
 type rwIdentity struct {
@@ -109,6 +110,13 @@ func (r *rwIdentity) CheckAuth(email string, password []byte) (string, error) {
 	return r.identity.CheckAuth(email, password, r.bridgePassProvider)
 }
 
+func (r *rwIdentity) AppendLimit() (uint64, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.identity.AppendLimit()
+}
+
 func (r *rwIdentity) Write(f func(identity *useridentity.State) error) error {
 	r.lock.Lock()
 	defer r.lock.Unlock()
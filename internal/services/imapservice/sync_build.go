@@ -20,6 +20,7 @@ package imapservice
 import (
 	"bytes"
 	"html/template"
+	"sync/atomic"
 	"time"
 
 	"github.com/ProtonMail/gluon/imap"
@@ -50,7 +51,42 @@ func defaultMessageJobOpts() message.JobOptions {
 	}
 }
 
-func buildRFC822(apiLabels map[string]proton.Label, full proton.FullMessage, addrKR *crypto.KeyRing, buffer *bytes.Buffer) *buildRes {
+// messageJobOptions holds the per-user message building settings.
+// It is shared by the service, its connectors and the sync message builder so that changes apply immediately.
+type messageJobOptions struct {
+	repairMIME atomic.Bool
+
+	// spillDir returns the current cache directory, which can move while bridge runs.
+	spillDir func() string
+}
+
+func newMessageJobOptions(repairMIME bool, spillDir func() string) *messageJobOptions {
+	opts := &messageJobOptions{spillDir: spillDir}
+
+	opts.repairMIME.Store(repairMIME)
+
+	return opts
+}
+
+func (o *messageJobOptions) get() message.JobOptions {
+	opts := defaultMessageJobOpts()
+
+	opts.RepairMIME = o.repairMIME.Load()
+
+	if o.spillDir != nil {
+		opts.SpillDir = o.spillDir()
+	}
+
+	return opts
+}
+
+func buildRFC822(
+	apiLabels map[string]proton.Label,
+	full proton.FullMessage,
+	addrKR *crypto.KeyRing,
+	opts message.JobOptions,
+	buffer *bytes.Buffer,
+) *buildRes {
 	var (
 		update *imap.MessageCreated
 		err    error
@@ -58,7 +94,7 @@ func buildRFC822(apiLabels map[string]proton.Label, full proton.FullMessage, add
 
 	buffer.Grow(full.Size)
 
-	if buildErr := message.DecryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, defaultMessageJobOpts(), buffer); buildErr != nil {
+	if buildErr := message.DecryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, opts, buffer); buildErr != nil {
 		update = newMessageCreatedFailedUpdate(apiLabels, full.MessageMetadata, buildErr)
 		err = buildErr
 	} else if created, parseErr := newMessageCreatedUpdate(apiLabels, full.MessageMetadata, buffer.Bytes()); parseErr != nil {
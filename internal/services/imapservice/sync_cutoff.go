@@ -0,0 +1,239 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
+	"github.com/sirupsen/logrus"
+)
+
+// setSyncCutoff changes the time (unix) before which messages are not synced and adjusts the IMAP databases.
+// The sync is restarted afterwards so that it continues with the new cutoff.
+func (s *Service) setSyncCutoff(ctx context.Context, cutoff int64) error {
+	if cutoff == s.syncCutoff {
+		return nil
+	}
+
+	oldCutoff := s.syncCutoff
+
+	s.log.WithFields(logrus.Fields{
+		"oldCutoff": oldCutoff,
+		"cutoff":    cutoff,
+	}).Info("Changing sync cutoff")
+
+	s.cancelSync()
+	defer s.startSyncing()
+
+	s.syncCutoff = cutoff
+	s.syncHandler.SetCutoff(cutoff)
+
+	if cutoff != 0 && (oldCutoff == 0 || cutoff > oldCutoff) {
+		return s.removeMessagesBefore(ctx, cutoff, oldCutoff)
+	}
+
+	// The removed messages are still known to gluon, which would fail to delete them once synced again.
+	// The messages added by searches would be created twice.
+	if s.cutoffRemovals || len(s.olderMessageIDs) != 0 {
+		return s.resyncAll(ctx)
+	}
+
+	status, err := s.syncStateProvider.GetSyncStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sync status: %w", err)
+	}
+
+	// An ongoing sync simply continues past the old cutoff.
+	if !status.IsComplete() {
+		return nil
+	}
+
+	if err := s.syncStateProvider.ClearSyncStatusSyncedSince(ctx, oldCutoff); err != nil {
+		return fmt.Errorf("failed to clear sync status: %w", err)
+	}
+
+	return nil
+}
+
+// removeMessagesBefore removes the messages older than the cutoff from the IMAP databases. The messages older than
+// the old cutoff were never synced and are not looked at; zero means all messages may have been.
+func (s *Service) removeMessagesBefore(ctx context.Context, cutoff, oldCutoff int64) error {
+	const pageSize = 150
+
+	var lastMessageID string
+
+	for {
+		metadata, err := s.client.GetMessageMetadataPage(ctx, 0, pageSize, proton.MessageFilter{
+			EndID: lastMessageID,
+			Desc:  true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get message metadata: %w", err)
+		}
+
+		// The message a page ends at is listed again at the start of the next page.
+		if len(metadata) != 0 && metadata[0].ID == lastMessageID {
+			metadata = metadata[1:]
+		}
+
+		if len(metadata) == 0 {
+			return nil
+		}
+
+		lastMessageID = metadata[len(metadata)-1].ID
+
+		var (
+			messageIDs []imap.MessageID
+			done       bool
+		)
+
+		for _, message := range metadata {
+			if oldCutoff != 0 && message.Time < oldCutoff {
+				done = true
+				break
+			}
+
+			if message.Time < cutoff {
+				messageIDs = append(messageIDs, imap.MessageID(message.ID))
+			}
+		}
+
+		if len(messageIDs) != 0 {
+			updates := make([]imap.Update, 0, len(s.connectors)*len(messageIDs))
+
+			for _, c := range s.connectors {
+				for _, messageID := range messageIDs {
+					update := imap.NewMessagesDeleted(messageID)
+					c.publishUpdate(ctx, update)
+					updates = append(updates, update)
+				}
+			}
+
+			s.cutoffRemovals = true
+
+			if err := waitOnIMAPUpdates(ctx, updates); err != nil {
+				return fmt.Errorf("failed to remove messages older than the sync cutoff: %w", err)
+			}
+		}
+
+		if done {
+			return nil
+		}
+	}
+}
+
+// resyncAll recreates the IMAP databases and syncs them from scratch.
+func (s *Service) resyncAll(ctx context.Context) error {
+	if err := s.removeConnectorsFromServer(ctx, s.connectors, true); err != nil {
+		return err
+	}
+
+	if err := s.rebuildConnectors(); err != nil {
+		return fmt.Errorf("failed to rebuild connectors: %w", err)
+	}
+
+	if err := s.syncStateProvider.ClearSyncStatus(ctx); err != nil {
+		return fmt.Errorf("failed to clear sync status: %w", err)
+	}
+
+	if err := s.addConnectorsToServer(ctx, s.connectors); err != nil {
+		return err
+	}
+
+	s.cutoffRemovals = false
+	s.olderMessageIDs = nil
+
+	return nil
+}
+
+// isBeforeSyncCutoff returns whether the message is older than the sync cutoff, and thus must not be synced.
+// Messages a client searched for are kept up to date like the others.
+func (s *Service) isBeforeSyncCutoff(message proton.MessageMetadata) bool {
+	if _, ok := s.olderMessageIDs[message.ID]; ok {
+		return false
+	}
+
+	return s.syncCutoff != 0 && message.Time < s.syncCutoff
+}
+
+// searchOlderMail adds the messages older than the sync cutoff whose subject contains the given text to the IMAP
+// databases. It's the server-side search path of the sync window: gluon only searches the messages it has, so the
+// matching older messages are fetched before the search of the client reaches it. It returns how many were added.
+func (s *Service) searchOlderMail(ctx context.Context, addrID, subject string) (int, error) {
+	const (
+		pageSize   = 150
+		maxResults = 100
+	)
+
+	if s.syncCutoff == 0 || subject == "" {
+		return 0, nil
+	}
+
+	filter := proton.MessageFilter{Subject: subject, Desc: true}
+
+	if s.addressMode == usertypes.AddressModeSplit {
+		filter.AddressID = addrID
+	}
+
+	var added int
+
+	for page := 0; added < maxResults; page++ {
+		metadata, err := s.client.GetMessageMetadataPage(ctx, page, pageSize, filter)
+		if err != nil {
+			return added, fmt.Errorf("failed to search messages: %w", err)
+		}
+
+		for _, message := range metadata {
+			if !s.isBeforeSyncCutoff(message) || added == maxResults {
+				continue
+			}
+
+			if s.olderMessageIDs == nil {
+				s.olderMessageIDs = make(map[string]struct{})
+			}
+
+			s.olderMessageIDs[message.ID] = struct{}{}
+
+			updates, err := onMessageCreated(logging.WithLogrusField(ctx, "action", "search older message"), s, message, false)
+			if err != nil {
+				return added, fmt.Errorf("failed to add older message: %w", err)
+			}
+
+			if err := waitOnIMAPUpdates(ctx, updates); err != nil {
+				return added, fmt.Errorf("failed to add older message: %w", err)
+			}
+
+			added++
+		}
+
+		if len(metadata) < pageSize {
+			break
+		}
+	}
+
+	if added != 0 {
+		s.log.WithField("count", added).Info("Added messages older than the sync cutoff found by a search")
+	}
+
+	return added, nil
+}
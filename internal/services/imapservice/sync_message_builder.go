@@ -27,11 +27,14 @@ import (
 )
 
 type SyncMessageBuilder struct {
-	state *rwIdentity
+	state   *rwIdentity
+	jobOpts *messageJobOptions
+
+	readReceipts *readReceiptIndex
 }
 
-func NewSyncMessageBuilder(rw *rwIdentity) *SyncMessageBuilder {
-	return &SyncMessageBuilder{state: rw}
+func NewSyncMessageBuilder(rw *rwIdentity, jobOpts *messageJobOptions, readReceipts *readReceiptIndex) *SyncMessageBuilder {
+	return &SyncMessageBuilder{state: rw, jobOpts: jobOpts, readReceipts: readReceipts}
 }
 
 func (s SyncMessageBuilder) WithKeys(f func(*crypto.KeyRing, map[string]*crypto.KeyRing) error) error {
@@ -46,10 +49,12 @@ func (s SyncMessageBuilder) BuildMessage(
 ) (syncservice.BuildResult, error) {
 	buffer.Grow(full.Size)
 
-	if err := message.DecryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, defaultMessageJobOpts(), buffer); err != nil {
+	if err := message.DecryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, s.jobOpts.get(), buffer); err != nil {
 		return syncservice.BuildResult{}, err
 	}
 
+	s.readReceipts.record(full.Message)
+
 	update, err := newMessageCreatedUpdate(apiLabels, full.MessageMetadata, buffer.Bytes())
 	if err != nil {
 		return syncservice.BuildResult{}, err
@@ -117,6 +117,46 @@ func (s *SyncState) ClearSyncStatus(_ context.Context) error {
 	return nil
 }
 
+// ClearSyncStatusReusingAddress resets the sync status, except for the labels which are considered synced. The
+// messages of the given address are already present in the IMAP database and will not be downloaded again.
+func (s *SyncState) ClearSyncStatusReusingAddress(_ context.Context, addrID string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	oldStatus := s.status
+
+	s.status = syncservice.DefaultStatus()
+	s.status.HasLabels = true
+	s.status.ReusedAddressID = addrID
+
+	if err := s.storeUnsafe(); err != nil {
+		s.status = oldStatus
+		return err
+	}
+
+	return nil
+}
+
+// ClearSyncStatusSyncedSince resets the sync status, except for the labels which are considered synced. The messages
+// from the given time (unix) on are already present in the IMAP database and will not be downloaded again.
+func (s *SyncState) ClearSyncStatusSyncedSince(_ context.Context, syncedSince int64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	oldStatus := s.status
+
+	s.status = syncservice.DefaultStatus()
+	s.status.HasLabels = true
+	s.status.SyncedSince = syncedSince
+
+	if err := s.storeUnsafe(); err != nil {
+		s.status = oldStatus
+		return err
+	}
+
+	return nil
+}
+
 func (s *SyncState) SetHasLabels(_ context.Context, b bool) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -66,6 +66,54 @@ func TestMigrateSyncSettings_DoesNotExist(t *testing.T) {
 	require.True(t, status.HasMessages)
 }
 
+func TestSyncState_ClearSyncStatusReusingAddress(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := GetSyncConfigPath(tmpDir, "test")
+
+	_, err := generateTestState(testFile)
+	require.NoError(t, err)
+
+	state, err := NewSyncState(testFile)
+	require.NoError(t, err)
+	require.NoError(t, state.ClearSyncStatusReusingAddress(context.Background(), "addr"))
+
+	// The status must survive a reload.
+	state, err = NewSyncState(testFile)
+	require.NoError(t, err)
+	status, err := state.GetSyncStatus(context.Background())
+	require.NoError(t, err)
+	require.True(t, status.HasLabels)
+	require.False(t, status.HasMessages)
+	require.False(t, status.HasMessageCount)
+	require.Zero(t, status.NumSyncedMessages)
+	require.Empty(t, status.FailedMessages)
+	require.Equal(t, "addr", status.ReusedAddressID)
+}
+
+func TestSyncState_ClearSyncStatusSyncedSince(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := GetSyncConfigPath(tmpDir, "test")
+
+	_, err := generateTestState(testFile)
+	require.NoError(t, err)
+
+	state, err := NewSyncState(testFile)
+	require.NoError(t, err)
+	require.NoError(t, state.ClearSyncStatusSyncedSince(context.Background(), 1000))
+
+	// The status must survive a reload.
+	state, err = NewSyncState(testFile)
+	require.NoError(t, err)
+	status, err := state.GetSyncStatus(context.Background())
+	require.NoError(t, err)
+	require.True(t, status.HasLabels)
+	require.False(t, status.HasMessages)
+	require.False(t, status.HasMessageCount)
+	require.Zero(t, status.NumSyncedMessages)
+	require.Empty(t, status.FailedMessages)
+	require.Equal(t, int64(1000), status.SyncedSince)
+}
+
 func generateTestState(path string) (syncservice.Status, error) {
 	status := syncservice.DefaultStatus()
 
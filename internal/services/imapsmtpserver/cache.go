@@ -0,0 +1,753 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bytes"
+	"container/heap"
+	"container/list"
+	"context"
+	"io"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/ProtonMail/gluon/store"
+)
+
+// maxDateHeaderSize is how much of a body is looked at to find its Date header when it is cached.
+const maxDateHeaderSize = 64 * 1024
+
+// gluonIDHeader is the header in which gluon records the internal ID of the bodies it fetched through the connector.
+// Recovered messages, which the server refused, are stored as the client sent them, without it.
+const gluonIDHeader = "X-Pm-Gluon-Id"
+
+// remoteIDHeader is the header in which bridge records the ID of the message on the server.
+const remoteIDHeader = "X-Pm-Internal-Id"
+
+// bodyFetcher downloads the literal of a message from the server; the gluon connectors implement it.
+type bodyFetcher interface {
+	GetMessageLiteral(ctx context.Context, id imap.MessageID) ([]byte, error)
+}
+
+// cacheLimiter keeps track of the message bodies cached by all gluon stores and evicts the least recently used ones
+// once their total size exceeds the limit. Headers and envelopes live in the gluon database and are never evicted;
+// gluon fetches an evicted body again through the connector the next time a client requests it.
+// The bodies of messages more recent than the keep window are never evicted: as sync and new messages download all
+// bodies, recent mail stays fully available offline while older bodies are only kept as long as they fit. Those that
+// were evicted before they entered the window, e.g. because it grew, are downloaded again when the stores are refreshed.
+// The bodies of recovered messages are never evicted either, as they only exist in the cache.
+type cacheLimiter struct {
+	lock sync.Mutex
+
+	limit uint64
+	size  uint64
+	keep  time.Duration
+
+	// lru holds the bodies that can be evicted, most recently used first, and recent those of the messages in the
+	// keep window, oldest first. Pinned bodies and those cached on a previous run that were not inspected yet are in
+	// neither, so eviction never has to skip over them.
+	lru    *list.List
+	recent recentHeap
+
+	// last is the most recently used body, which is never evicted.
+	last *cacheEntry
+
+	index map[cacheKey]*cacheEntry
+
+	// stores holds the stores by the ID of their gluon user.
+	stores map[string]*limitedStore
+}
+
+type cacheKey struct {
+	store *limitedStore
+	id    imap.InternalMessageID
+}
+
+type cacheEntry struct {
+	key  cacheKey
+	size uint64
+
+	// date is the date of the message; it is zero if the body has no valid Date header.
+	// pinned is whether the body can't be fetched again and must never be evicted.
+	// Both are only known once inspected is true.
+	date      time.Time
+	pinned    bool
+	inspected bool
+
+	// elem is the element of the entry in the LRU list and heapIdx its index in the recent heap, -1 if it's not there.
+	elem    *list.Element
+	heapIdx int
+}
+
+// newCacheLimiter creates a cache limiter with the given limit in bytes, zero meaning unlimited,
+// never evicting the bodies of messages more recent than the given keep window, zero meaning none.
+func newCacheLimiter(limit uint64, keep time.Duration) *cacheLimiter {
+	return &cacheLimiter{
+		limit:  limit,
+		keep:   keep,
+		lru:    list.New(),
+		index:  make(map[cacheKey]*cacheEntry),
+		stores: make(map[string]*limitedStore),
+	}
+}
+
+// setLimit changes the limit and evicts the bodies that no longer fit.
+func (l *cacheLimiter) setLimit(limit uint64) {
+	l.lock.Lock()
+
+	l.limit = limit
+
+	victims := l.evict()
+
+	l.lock.Unlock()
+
+	evictBodies(victims)
+}
+
+// setKeep changes the window of recent messages whose bodies are never evicted and evicts the bodies that no longer fit.
+// It returns whether the window grew, in which case evicted bodies may have to be downloaded again.
+func (l *cacheLimiter) setKeep(keep time.Duration) bool {
+	l.lock.Lock()
+
+	grew := keep != 0 && (l.keep == 0 || keep > l.keep)
+
+	l.keep = keep
+
+	// Bodies that entered the window can no longer be evicted; those that left it are aged out by evict.
+	if grew {
+		for elem := l.lru.Front(); elem != nil; {
+			next, entry := elem.Next(), elem.Value.(*cacheEntry) //nolint:forcetypeassert
+
+			if isRecent(entry.date, l.keep) {
+				l.lru.Remove(elem)
+				entry.elem = nil
+
+				heap.Push(&l.recent, entry)
+			}
+
+			elem = next
+		}
+	}
+
+	victims := l.evict()
+
+	l.lock.Unlock()
+
+	evictBodies(victims)
+
+	return grew
+}
+
+// setFetcher sets how the evicted bodies of the store of the given gluon user are downloaded again when it's refreshed.
+func (l *cacheLimiter) setFetcher(gluonID string, fetcher bodyFetcher) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if s, ok := l.stores[gluonID]; ok {
+		s.fetcher = fetcher
+	}
+}
+
+// refresh refreshes the store of the given gluon user; see limitedStore.refresh.
+func (l *cacheLimiter) refresh(ctx context.Context, gluonID string) {
+	l.lock.Lock()
+	s, ok := l.stores[gluonID]
+	l.lock.Unlock()
+
+	if ok {
+		s.refresh(ctx)
+	}
+}
+
+// refreshAll refreshes all the stores; see limitedStore.refresh.
+func (l *cacheLimiter) refreshAll(ctx context.Context) {
+	l.lock.Lock()
+
+	stores := make([]*limitedStore, 0, len(l.stores))
+
+	for _, s := range l.stores {
+		stores = append(stores, s)
+	}
+
+	l.lock.Unlock()
+
+	for _, s := range stores {
+		s.refresh(ctx)
+	}
+}
+
+// getSize returns the total size of the cached bodies.
+func (l *cacheLimiter) getSize() uint64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.size
+}
+
+// add records a cached body as the most recently used one and returns the bodies that no longer fit.
+func (l *cacheLimiter) add(s *limitedStore, id imap.InternalMessageID, size uint64, rec cacheRecord) []*cacheEntry {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.last = l.put(cacheKey{store: s, id: id}, size, &rec, true)
+
+	return l.evict()
+}
+
+// touch marks a cached body as the most recently used one. Bodies the limiter didn't inspect yet are inspected from
+// the given literal, in which case their record is returned to be written to the journal.
+func (l *cacheLimiter) touch(s *limitedStore, id imap.InternalMessageID, size uint64, literal []byte) (cacheRecord, bool, []*cacheEntry) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if entry, ok := l.index[cacheKey{store: s, id: id}]; ok && entry.inspected {
+		if entry.elem != nil {
+			l.lru.MoveToFront(entry.elem)
+		}
+
+		l.last = entry
+
+		return cacheRecord{}, false, nil
+	}
+
+	// The body was cached on a previous run and wasn't inspected yet, or before the limiter knew about it.
+	rec := inspectBody(id, literal)
+
+	l.last = l.put(cacheKey{store: s, id: id}, size, &rec, true)
+
+	return rec, true, l.evict()
+}
+
+// inspected records what was found when inspecting a body cached on a previous run and returns the bodies that no
+// longer fit. Inspected bodies are considered the least recently used ones.
+func (l *cacheLimiter) inspected(s *limitedStore, id imap.InternalMessageID, rec cacheRecord) []*cacheEntry {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry, ok := l.index[cacheKey{store: s, id: id}]
+	if !ok || entry.inspected {
+		return nil
+	}
+
+	entry.setRecord(rec)
+
+	l.classify(entry, false)
+
+	return l.evict()
+}
+
+// remove forgets about the given bodies of a store.
+func (l *cacheLimiter) remove(s *limitedStore, ids ...imap.InternalMessageID) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	for _, id := range ids {
+		if entry, ok := l.index[cacheKey{store: s, id: id}]; ok {
+			l.drop(entry)
+		}
+	}
+}
+
+// register makes the limiter account for the bodies a store found on disk, listed from the most recently written one;
+// those with a record are known, the others are inspected when the store is refreshed. It returns the bodies that
+// no longer fit.
+func (l *cacheLimiter) register(s *limitedStore, bodies []cachedBody) []*cacheEntry {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.stores[filepath.Base(s.dir)] = s
+
+	for i, body := range bodies {
+		var rec *cacheRecord
+
+		if r, ok := s.journal.get(body.id); ok && !r.Evicted {
+			rec = &r
+		}
+
+		entry := l.put(cacheKey{store: s, id: body.id}, body.size, rec, false)
+
+		// Until a body is used, the one written last is considered the most recently used one.
+		if i == 0 && l.last == nil {
+			l.last = entry
+		}
+	}
+
+	return l.evict()
+}
+
+// removeStore forgets about all the bodies of a store.
+func (l *cacheLimiter) removeStore(s *limitedStore) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	for key, entry := range l.index {
+		if key.store == s {
+			l.drop(entry)
+		}
+	}
+
+	if gluonID := filepath.Base(s.dir); l.stores[gluonID] == s {
+		delete(l.stores, gluonID)
+	}
+}
+
+// isPending returns whether a body of a store is accounted for but wasn't inspected yet.
+func (l *cacheLimiter) isPending(s *limitedStore, id imap.InternalMessageID) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry, ok := l.index[cacheKey{store: s, id: id}]
+
+	return ok && !entry.inspected
+}
+
+// keepWindow returns the current keep window.
+func (l *cacheLimiter) keepWindow() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.keep
+}
+
+func (l *cacheLimiter) put(key cacheKey, size uint64, rec *cacheRecord, recent bool) *cacheEntry {
+	if entry, ok := l.index[key]; ok {
+		l.drop(entry)
+	}
+
+	entry := &cacheEntry{key: key, size: size, heapIdx: -1}
+
+	if rec != nil {
+		entry.setRecord(*rec)
+	}
+
+	l.index[key] = entry
+	l.size += size
+
+	l.classify(entry, recent)
+
+	return entry
+}
+
+// classify places an entry among the recent or the evictable bodies, at the front of the latter if it was just used.
+func (l *cacheLimiter) classify(entry *cacheEntry, used bool) {
+	switch {
+	case !entry.inspected, entry.pinned:
+		// Neither can be evicted.
+
+	case isRecent(entry.date, l.keep):
+		heap.Push(&l.recent, entry)
+
+	case used:
+		entry.elem = l.lru.PushFront(entry)
+
+	default:
+		entry.elem = l.lru.PushBack(entry)
+	}
+}
+
+func (l *cacheLimiter) drop(entry *cacheEntry) {
+	if entry.elem != nil {
+		l.lru.Remove(entry.elem)
+		entry.elem = nil
+	}
+
+	if entry.heapIdx >= 0 {
+		heap.Remove(&l.recent, entry.heapIdx)
+	}
+
+	if l.last == entry {
+		l.last = nil
+	}
+
+	delete(l.index, entry.key)
+
+	l.size -= entry.size
+}
+
+// evict forgets about the least recently used bodies until the cache fits the limit and returns them; they must be
+// deleted from their stores with evictBodies once the lock is released.
+// The most recently used body is always kept, even if it is bigger than the limit on its own, as are the bodies
+// of recent and recovered messages: the cache may exceed the limit if they don't fit.
+func (l *cacheLimiter) evict() []*cacheEntry {
+	if l.limit == 0 {
+		return nil
+	}
+
+	// Bodies of messages that left the keep window can be evicted; they were not used for a while.
+	for l.recent.Len() > 0 && !isRecent(l.recent[0].date, l.keep) {
+		entry := heap.Pop(&l.recent).(*cacheEntry) //nolint:forcetypeassert
+
+		entry.elem = l.lru.PushBack(entry)
+	}
+
+	var victims []*cacheEntry
+
+	for elem := l.lru.Back(); elem != nil && l.size > l.limit; {
+		prev, entry := elem.Prev(), elem.Value.(*cacheEntry) //nolint:forcetypeassert
+
+		if entry != l.last {
+			l.drop(entry)
+
+			victims = append(victims, entry)
+		}
+
+		elem = prev
+	}
+
+	return victims
+}
+
+// evictBodies deletes the given evicted bodies from their stores.
+func evictBodies(victims []*cacheEntry) {
+	for _, entry := range victims {
+		if err := entry.key.store.evict(entry.key.id); err != nil {
+			logIMAP.WithError(err).WithField("messageID", entry.key.id.String()).Warn("Failed to evict cached message body")
+		}
+	}
+}
+
+// isRecent returns whether a message of the given date is in the keep window.
+// Messages without a date are never considered recent.
+func isRecent(date time.Time, keep time.Duration) bool {
+	return keep != 0 && !date.IsZero() && time.Since(date) < keep
+}
+
+func (entry *cacheEntry) setRecord(rec cacheRecord) {
+	entry.inspected = true
+	entry.pinned = rec.Pinned
+	entry.date = rec.date()
+}
+
+// recentHeap holds the bodies of recent messages, the oldest first.
+type recentHeap []*cacheEntry
+
+func (h recentHeap) Len() int {
+	return len(h)
+}
+
+func (h recentHeap) Less(i, j int) bool {
+	return h[i].date.Before(h[j].date)
+}
+
+func (h recentHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapIdx, h[j].heapIdx = i, j
+}
+
+func (h *recentHeap) Push(x any) {
+	entry := x.(*cacheEntry) //nolint:forcetypeassert
+
+	entry.heapIdx = len(*h)
+
+	*h = append(*h, entry)
+}
+
+func (h *recentHeap) Pop() any {
+	old := *h
+
+	entry := old[len(old)-1]
+	entry.heapIdx = -1
+
+	old[len(old)-1] = nil
+
+	*h = old[:len(old)-1]
+
+	return entry
+}
+
+// inspectBody returns the record of a body from the given beginning of its literal.
+// Bodies without the gluon ID header, or whose header can't be parsed, are pinned as they may not be fetched again.
+func inspectBody(id imap.InternalMessageID, literal []byte) cacheRecord {
+	rec := cacheRecord{ID: id.String(), Pinned: true}
+
+	rawHeader, _ := rfc822.Split(literal)
+
+	header, err := rfc822.NewHeader(rawHeader)
+	if err != nil {
+		return rec
+	}
+
+	rec.Pinned = header.Get(gluonIDHeader) != id.String()
+	rec.RemoteID = header.Get(remoteIDHeader)
+
+	if date, err := mail.ParseDate(header.Get("Date")); err == nil {
+		rec.Date = date.Unix()
+	}
+
+	return rec
+}
+
+// cachedBody is a body a store found on disk when it was opened.
+type cachedBody struct {
+	id      imap.InternalMessageID
+	size    uint64
+	modTime time.Time
+}
+
+// limitedStore is a gluon store whose bodies are accounted for by a cache limiter.
+type limitedStore struct {
+	store.Store
+
+	dir     string
+	limiter *cacheLimiter
+	journal *cacheJournal
+
+	// fetcher downloads the evicted bodies again; it's nil until the gluon user of the store is loaded.
+	// It's guarded by the lock of the limiter.
+	fetcher bodyFetcher
+
+	// pending holds the bodies found on disk without a record, from the most recently written one,
+	// and refreshLock serializes the refreshes of the store.
+	pending     []imap.InternalMessageID
+	refreshLock sync.Mutex
+}
+
+func newLimitedStore(base store.Store, dir string, limiter *cacheLimiter) *limitedStore {
+	s := &limitedStore{
+		Store:   base,
+		dir:     dir,
+		limiter: limiter,
+		journal: openCacheJournal(journalPath(dir)),
+	}
+
+	ids, err := base.List()
+	if err != nil {
+		logIMAP.WithError(err).Warn("Failed to list cached message bodies")
+	}
+
+	bodies := make([]cachedBody, 0, len(ids))
+
+	for _, id := range ids {
+		info, err := os.Stat(filepath.Join(dir, id.String()))
+		if err != nil {
+			continue
+		}
+
+		bodies = append(bodies, cachedBody{id: id, size: uint64(info.Size()), modTime: info.ModTime()}) //nolint:gosec // disable G115
+	}
+
+	// Bodies written last are considered the most recently used ones.
+	sort.Slice(bodies, func(i, j int) bool {
+		return bodies[i].modTime.After(bodies[j].modTime)
+	})
+
+	// Bodies that are no longer on disk were evicted, possibly while bridge wasn't running.
+	if err == nil {
+		present := make(map[imap.InternalMessageID]struct{}, len(bodies))
+
+		for _, body := range bodies {
+			present[body.id] = struct{}{}
+		}
+
+		s.journal.compact(present)
+	} else {
+		s.journal.compact(nil)
+	}
+
+	for _, body := range bodies {
+		if rec, ok := s.journal.get(body.id); !ok || rec.Evicted {
+			s.pending = append(s.pending, body.id)
+		}
+	}
+
+	evictBodies(limiter.register(s, bodies))
+
+	return s
+}
+
+func (s *limitedStore) Get(messageID imap.InternalMessageID) ([]byte, error) {
+	literal, err := s.Store.Get(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	rec, ok, victims := s.limiter.touch(s, messageID, s.sizeOf(messageID, uint64(len(literal))), literal)
+	if ok {
+		s.journal.set(rec)
+	}
+
+	evictBodies(victims)
+
+	return literal, nil
+}
+
+func (s *limitedStore) Set(messageID imap.InternalMessageID, literal io.Reader) error {
+	counter := &countingReader{reader: literal}
+
+	if err := s.Store.Set(messageID, counter); err != nil {
+		return err
+	}
+
+	rec := inspectBody(messageID, counter.head.Bytes())
+
+	s.journal.set(rec)
+
+	evictBodies(s.limiter.add(s, messageID, s.sizeOf(messageID, counter.count), rec))
+
+	return nil
+}
+
+func (s *limitedStore) Delete(messageID ...imap.InternalMessageID) error {
+	s.limiter.remove(s, messageID...)
+
+	s.journal.delete(messageID...)
+
+	return s.Store.Delete(messageID...)
+}
+
+func (s *limitedStore) Close() error {
+	s.limiter.removeStore(s)
+
+	s.journal.close()
+
+	return s.Store.Close()
+}
+
+// evict deletes an evicted body, remembering it so that it can be downloaded again if it's needed offline.
+func (s *limitedStore) evict(messageID imap.InternalMessageID) error {
+	s.journal.evict(messageID)
+
+	return s.Store.Delete(messageID)
+}
+
+// refresh inspects the bodies found on disk without a record, which were cached by a version that didn't keep one,
+// and downloads again the evicted bodies of the messages in the keep window. It stops when ctx is done.
+// Bodies are read and downloaded without holding the lock of the limiter.
+func (s *limitedStore) refresh(ctx context.Context) {
+	s.refreshLock.Lock()
+	defer s.refreshLock.Unlock()
+
+	pending := s.pending
+	s.pending = nil
+
+	for i, id := range pending {
+		if ctx.Err() != nil {
+			s.pending = pending[i:]
+			return
+		}
+
+		// The body may have been inspected when it was used in the meantime.
+		if !s.limiter.isPending(s, id) {
+			continue
+		}
+
+		literal, err := s.Store.Get(id)
+		if err != nil {
+			// Bodies which can't be read are of no use.
+			logIMAP.WithError(err).WithField("messageID", id.String()).Warn("Failed to read cached message body")
+
+			if err := s.Delete(id); err != nil {
+				logIMAP.WithError(err).WithField("messageID", id.String()).Warn("Failed to delete unreadable message body")
+			}
+
+			continue
+		}
+
+		rec := inspectBody(id, literal)
+
+		s.journal.set(rec)
+
+		evictBodies(s.limiter.inspected(s, id, rec))
+	}
+
+	s.prefetch(ctx)
+}
+
+// prefetch downloads again the evicted bodies of the messages in the keep window.
+func (s *limitedStore) prefetch(ctx context.Context) {
+	s.limiter.lock.Lock()
+	fetcher := s.fetcher
+	s.limiter.lock.Unlock()
+
+	keep := s.limiter.keepWindow()
+
+	if fetcher == nil || keep == 0 {
+		return
+	}
+
+	for _, rec := range s.journal.evicted() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if rec.RemoteID == "" || !isRecent(rec.date(), keep) {
+			continue
+		}
+
+		id, err := imap.InternalMessageIDFromString(rec.ID)
+		if err != nil {
+			continue
+		}
+
+		literal, err := fetcher.GetMessageLiteral(ctx, imap.MessageID(rec.RemoteID))
+		if err != nil {
+			logIMAP.WithError(err).WithField("messageID", rec.RemoteID).Warn("Failed to download evicted message body")
+			continue
+		}
+
+		literal, err = rfc822.SetHeaderValue(literal, gluonIDHeader, id.String())
+		if err != nil {
+			logIMAP.WithError(err).WithField("messageID", rec.RemoteID).Warn("Failed to set internal ID on downloaded message body")
+			continue
+		}
+
+		// The message may have been deleted in the meantime.
+		if !s.journal.isEvicted(id) {
+			continue
+		}
+
+		if err := s.Set(id, bytes.NewReader(literal)); err != nil {
+			logIMAP.WithError(err).WithField("messageID", rec.RemoteID).Warn("Failed to store downloaded message body")
+		}
+	}
+}
+
+// sizeOf returns the size the body takes on disk, or the given fallback if it can't be determined.
+func (s *limitedStore) sizeOf(messageID imap.InternalMessageID, fallback uint64) uint64 {
+	info, err := os.Stat(filepath.Join(s.dir, messageID.String()))
+	if err != nil {
+		return fallback
+	}
+
+	return uint64(info.Size()) //nolint:gosec // disable G115
+}
+
+// countingReader counts the bytes read and keeps the first ones, holding the header of the message.
+type countingReader struct {
+	reader io.Reader
+	count  uint64
+	head   bytes.Buffer
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+
+	if rem := maxDateHeaderSize - r.head.Len(); rem > 0 {
+		r.head.Write(p[:min(n, rem)])
+	}
+
+	r.count += uint64(n) //nolint:gosec // disable G115
+
+	return n, err
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/gluon/imap"
+)
+
+// cacheRecord is what the journal of a store remembers of a body.
+type cacheRecord struct {
+	ID string `json:"id"`
+
+	// Date is the date of the message in seconds since the epoch, zero if it's unknown.
+	Date int64 `json:"date,omitempty"`
+
+	// Pinned is whether the body can't be fetched again.
+	Pinned bool `json:"pinned,omitempty"`
+
+	// RemoteID is the ID of the message on the server, with which an evicted body can be downloaded again.
+	RemoteID string `json:"remote,omitempty"`
+
+	// Evicted is whether the body was evicted and Deleted whether the message was deleted.
+	Evicted bool `json:"evicted,omitempty"`
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+func (rec cacheRecord) date() time.Time {
+	if rec.Date == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(rec.Date, 0)
+}
+
+// cacheJournal records what is known of the bodies of a store as they are cached, so that their dates are known on the
+// next run without reading them again, and remembers those that were evicted so that they can be downloaded again.
+// Records are appended to a file next to the store, which is compacted when the store is opened. It holds the same
+// metadata as the gluon database and isn't encrypted either.
+type cacheJournal struct {
+	lock sync.Mutex
+
+	path    string
+	file    *os.File
+	records map[imap.InternalMessageID]cacheRecord
+}
+
+// journalPath returns the path of the journal of the store in dir.
+func journalPath(dir string) string {
+	return dir + ".journal"
+}
+
+// openCacheJournal reads the journal at the given path; it must be compacted before records are appended.
+// A journal that can't be read is started over: the bodies it described are inspected again.
+func openCacheJournal(path string) *cacheJournal {
+	j := &cacheJournal{
+		path:    path,
+		records: make(map[imap.InternalMessageID]cacheRecord),
+	}
+
+	file, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			logIMAP.WithError(err).Warn("Failed to open cache journal")
+		}
+
+		return j
+	}
+
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		var rec cacheRecord
+
+		// The last record may have been cut short when bridge stopped.
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			continue
+		}
+
+		id, err := imap.InternalMessageIDFromString(rec.ID)
+		if err != nil {
+			continue
+		}
+
+		if rec.Deleted {
+			delete(j.records, id)
+		} else {
+			j.records[id] = rec
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		logIMAP.WithError(err).Warn("Failed to read cache journal")
+	}
+
+	return j
+}
+
+// compact rewrites the journal with one record per body and opens it to append new ones. The records of the bodies
+// that are not in present, unless it's nil, are marked as evicted.
+func (j *cacheJournal) compact(present map[imap.InternalMessageID]struct{}) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if present != nil {
+		for id, rec := range j.records {
+			if _, ok := present[id]; !ok && !rec.Evicted {
+				rec.Evicted = true
+				j.records[id] = rec
+			}
+		}
+	}
+
+	if err := j.rewrite(); err != nil {
+		logIMAP.WithError(err).Warn("Failed to compact cache journal")
+	}
+
+	file, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600) //nolint:gosec
+	if err != nil {
+		logIMAP.WithError(err).Warn("Failed to open cache journal, cached message bodies will be inspected again next time")
+		return
+	}
+
+	j.file = file
+}
+
+func (j *cacheJournal) rewrite() error {
+	tmpPath := j.path + ".tmp"
+
+	file, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0o600) //nolint:gosec
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+
+	for _, rec := range j.records {
+		if err := encoder.Encode(rec); err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, j.path)
+}
+
+// get returns the record of a body.
+func (j *cacheJournal) get(id imap.InternalMessageID) (cacheRecord, bool) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	rec, ok := j.records[id]
+
+	return rec, ok
+}
+
+// isEvicted returns whether a body was evicted.
+func (j *cacheJournal) isEvicted(id imap.InternalMessageID) bool {
+	rec, ok := j.get(id)
+
+	return ok && rec.Evicted
+}
+
+// evicted returns the records of the evicted bodies.
+func (j *cacheJournal) evicted() []cacheRecord {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	var records []cacheRecord
+
+	for _, rec := range j.records {
+		if rec.Evicted {
+			records = append(records, rec)
+		}
+	}
+
+	return records
+}
+
+// set records a body that was cached.
+func (j *cacheJournal) set(rec cacheRecord) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if id, err := imap.InternalMessageIDFromString(rec.ID); err == nil {
+		j.records[id] = rec
+	}
+
+	j.append(rec)
+}
+
+// evict records that a body was evicted.
+func (j *cacheJournal) evict(id imap.InternalMessageID) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	rec, ok := j.records[id]
+	if !ok {
+		return
+	}
+
+	rec.Evicted = true
+	j.records[id] = rec
+
+	j.append(rec)
+}
+
+// delete forgets about the bodies of deleted messages.
+func (j *cacheJournal) delete(ids ...imap.InternalMessageID) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	for _, id := range ids {
+		if _, ok := j.records[id]; !ok {
+			continue
+		}
+
+		delete(j.records, id)
+
+		j.append(cacheRecord{ID: id.String(), Deleted: true})
+	}
+}
+
+func (j *cacheJournal) append(rec cacheRecord) {
+	if j.file == nil {
+		return
+	}
+
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return
+	}
+
+	if _, err := j.file.Write(append(b, '\n')); err != nil {
+		logIMAP.WithError(err).Warn("Failed to write cache journal")
+	}
+}
+
+func (j *cacheJournal) close() {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.file == nil {
+		return
+	}
+
+	if err := j.file.Close(); err != nil {
+		logIMAP.WithError(err).Warn("Failed to close cache journal")
+	}
+
+	j.file = nil
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheLimiter_KeepRecent(t *testing.T) {
+	base, limiter := newMemStore(), newCacheLimiter(0, 0)
+
+	s := newLimitedStore(base, t.TempDir(), limiter)
+
+	recentID, oldID, newestID := imap.NewInternalMessageID(), imap.NewInternalMessageID(), imap.NewInternalMessageID()
+
+	require.NoError(t, s.Set(recentID, bytes.NewReader(newDatedLiteral(recentID, time.Now().AddDate(0, 0, -1)))))
+	require.NoError(t, s.Set(oldID, bytes.NewReader(newDatedLiteral(oldID, time.Now().AddDate(-1, 0, 0)))))
+	require.NoError(t, s.Set(newestID, bytes.NewReader(newDatedLiteral(newestID, time.Now().AddDate(-1, 0, 0)))))
+
+	// Keep the last month: when the cache is capped, the recent body is kept although it's the least recently used.
+	limiter.setKeep(30 * 24 * time.Hour)
+	limiter.setLimit(1)
+
+	require.Equal(t, []imap.InternalMessageID{recentID, newestID}, must(base.List()))
+
+	// Without the window, the recent body is evicted too.
+	limiter.setKeep(0)
+
+	require.Equal(t, []imap.InternalMessageID{newestID}, must(base.List()))
+}
+
+func TestCacheLimiter_KeepRecentUnknownDate(t *testing.T) {
+	base, dir := newMemStore(), t.TempDir()
+
+	recentID, oldID, undatedID := imap.NewInternalMessageID(), imap.NewInternalMessageID(), imap.NewInternalMessageID()
+
+	// Bodies cached on a previous run: their date is only known once they are read.
+	// The undated body was written last, so it's considered the most recently used one.
+	for i, body := range []struct {
+		id      imap.InternalMessageID
+		literal []byte
+	}{
+		{id: recentID, literal: newDatedLiteral(recentID, time.Now().AddDate(0, 0, -1))},
+		{id: oldID, literal: newDatedLiteral(oldID, time.Now().AddDate(-1, 0, 0))},
+		{id: undatedID, literal: []byte(fmt.Sprintf("X-Pm-Gluon-Id: %v\r\nSubject: no date\r\n\r\nHello!", undatedID))},
+	} {
+		path, modTime := filepath.Join(dir, body.id.String()), time.Now().Add(time.Duration(i)*time.Minute)
+
+		require.NoError(t, base.Set(body.id, bytes.NewReader(body.literal)))
+		require.NoError(t, os.WriteFile(path, body.literal, 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	s := newLimitedStore(base, dir, newCacheLimiter(1, 30*24*time.Hour))
+
+	// Nothing is evicted until the bodies are inspected.
+	require.Len(t, must(base.List()), 3)
+
+	s.refresh(context.Background())
+
+	// Only the recent body and the most recently used one are kept.
+	require.Equal(t, []imap.InternalMessageID{recentID, undatedID}, must(base.List()))
+}
+
+func TestCacheLimiter_KeepRecovered(t *testing.T) {
+	base, limiter := newMemStore(), newCacheLimiter(0, 0)
+
+	s := newLimitedStore(base, t.TempDir(), limiter)
+
+	recoveredID, oldID, newestID := imap.NewInternalMessageID(), imap.NewInternalMessageID(), imap.NewInternalMessageID()
+
+	// Recovered messages are stored as the client sent them, without the gluon ID header.
+	recovered := []byte("Date: Mon, 02 Jan 2006 15:04:05 +0000\r\nSubject: Recovered\r\n\r\nHello!")
+
+	require.NoError(t, s.Set(recoveredID, bytes.NewReader(recovered)))
+	require.NoError(t, s.Set(oldID, bytes.NewReader(newDatedLiteral(oldID, time.Now().AddDate(-1, 0, 0)))))
+	require.NoError(t, s.Set(newestID, bytes.NewReader(newDatedLiteral(newestID, time.Now().AddDate(-1, 0, 0)))))
+
+	// The recovered body is kept although it's the least recently used one, as gluon can't fetch it again.
+	limiter.setLimit(1)
+
+	require.Equal(t, []imap.InternalMessageID{recoveredID, newestID}, must(base.List()))
+	require.Equal(t, recovered, must(s.Get(recoveredID)))
+
+	// The same goes for recovered bodies cached on a previous run.
+	dir := t.TempDir()
+
+	for i, id := range []imap.InternalMessageID{recoveredID, newestID} {
+		path, modTime := filepath.Join(dir, id.String()), time.Now().Add(time.Duration(i)*time.Minute)
+
+		require.NoError(t, os.WriteFile(path, must(base.Get(id)), 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	s = newLimitedStore(base, dir, newCacheLimiter(1, 0))
+	s.refresh(context.Background())
+
+	require.Equal(t, []imap.InternalMessageID{recoveredID, newestID}, must(base.List()))
+	require.Equal(t, recovered, must(s.Get(recoveredID)))
+}
+
+func TestCacheLimiter_Journal(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "gluonID")
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	s := newLimitedStore(must(newOnDiskStore(dir, key)), dir, newCacheLimiter(0, 0))
+
+	recentID, oldID := imap.NewInternalMessageID(), imap.NewInternalMessageID()
+
+	require.NoError(t, s.Set(oldID, bytes.NewReader(newDatedLiteral(oldID, time.Now().AddDate(-1, 0, 0)))))
+	require.NoError(t, s.Set(recentID, bytes.NewReader(newDatedLiteral(recentID, time.Now().AddDate(0, 0, -1)))))
+	require.NoError(t, s.Close())
+
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, oldID.String()), past, past))
+
+	// The dates were recorded when the bodies were cached: the old body is evicted without them being read again.
+	s = newLimitedStore(must(newOnDiskStore(dir, key)), dir, newCacheLimiter(1, 30*24*time.Hour))
+	defer func() { require.NoError(t, s.Close()) }()
+
+	require.Empty(t, s.pending)
+	require.Equal(t, []imap.InternalMessageID{recentID}, must(s.List()))
+	require.True(t, s.journal.isEvicted(oldID))
+
+	// The journal goes away with the store.
+	require.NoError(t, (&storeBuilder{}).Delete(filepath.Dir(dir), "gluonID"))
+	require.NoFileExists(t, journalPath(dir))
+}
+
+func TestCacheLimiter_Prefetch(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "gluonID")
+	base, limiter := newMemStore(), newCacheLimiter(1, 0)
+
+	s := newLimitedStore(base, dir, limiter)
+	defer func() { require.NoError(t, s.Close()) }()
+
+	evictedID, newestID := imap.NewInternalMessageID(), imap.NewInternalMessageID()
+
+	remote := []byte(fmt.Sprintf("X-Pm-Internal-Id: remoteID\r\nDate: %v\r\nSubject: Hello\r\n\r\nHello!", time.Now().AddDate(0, 0, -10).Format(time.RFC1123Z)))
+
+	require.NoError(t, s.Set(evictedID, bytes.NewReader(must(rfc822.SetHeaderValue(remote, gluonIDHeader, evictedID.String())))))
+	require.NoError(t, s.Set(newestID, bytes.NewReader(newDatedLiteral(newestID, time.Now().AddDate(-1, 0, 0)))))
+	require.Equal(t, []imap.InternalMessageID{newestID}, must(base.List()))
+
+	// Nothing is downloaded until the user is loaded.
+	limiter.setFetcher("gluonID", &fakeFetcher{literals: map[imap.MessageID][]byte{"remoteID": remote}})
+
+	// Once the window covers the evicted body, it's downloaded again.
+	require.True(t, limiter.setKeep(30*24*time.Hour))
+
+	limiter.refreshAll(context.Background())
+
+	// It's the most recently used body now, and the old one doesn't fit anymore.
+	require.Equal(t, []imap.InternalMessageID{evictedID}, must(base.List()))
+	require.False(t, s.journal.isEvicted(evictedID))
+
+	literal := must(base.Get(evictedID))
+	header, _ := rfc822.Split(literal)
+	require.Equal(t, evictedID.String(), must(rfc822.NewHeader(header)).Get(gluonIDHeader))
+
+	// Shrinking the window doesn't download anything.
+	require.False(t, limiter.setKeep(20*24*time.Hour))
+}
+
+type fakeFetcher struct {
+	literals map[imap.MessageID][]byte
+}
+
+func (f *fakeFetcher) GetMessageLiteral(_ context.Context, id imap.MessageID) ([]byte, error) {
+	literal, ok := f.literals[id]
+	if !ok {
+		return nil, fmt.Errorf("no such message")
+	}
+
+	return literal, nil
+}
+
+func newDatedLiteral(id imap.InternalMessageID, date time.Time) []byte {
+	return []byte(fmt.Sprintf("X-Pm-Gluon-Id: %v\r\nDate: %v\r\nSubject: Hello\r\n\r\nHello!", id, date.Format(time.RFC1123Z)))
+}
+
+func must[T any](val T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+
+	return val
+}
+
+// memStore is a gluon store keeping the bodies in memory, listing them in the order they were set.
+type memStore struct {
+	ids    []imap.InternalMessageID
+	bodies map[imap.InternalMessageID][]byte
+}
+
+func newMemStore() *memStore {
+	return &memStore{bodies: make(map[imap.InternalMessageID][]byte)}
+}
+
+func (s *memStore) Get(messageID imap.InternalMessageID) ([]byte, error) {
+	literal, ok := s.bodies[messageID]
+	if !ok {
+		return nil, fmt.Errorf("no such message")
+	}
+
+	return literal, nil
+}
+
+func (s *memStore) Set(messageID imap.InternalMessageID, reader io.Reader) error {
+	literal, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := s.bodies[messageID]; !ok {
+		s.ids = append(s.ids, messageID)
+	}
+
+	s.bodies[messageID] = literal
+
+	return nil
+}
+
+func (s *memStore) Delete(messageIDs ...imap.InternalMessageID) error {
+	for _, messageID := range messageIDs {
+		delete(s.bodies, messageID)
+	}
+
+	ids := make([]imap.InternalMessageID, 0, len(s.bodies))
+
+	for _, id := range s.ids {
+		if _, ok := s.bodies[id]; ok {
+			ids = append(ids, id)
+		}
+	}
+
+	s.ids = ids
+
+	return nil
+}
+
+func (s *memStore) Close() error {
+	return nil
+}
+
+func (s *memStore) List() ([]imap.InternalMessageID, error) {
+	return append([]imap.InternalMessageID{}, s.ids...), nil
+}
@@ -20,14 +20,17 @@ package imapsmtpserver
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gluon"
 	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/gluon/db"
 	imapEvents "github.com/ProtonMail/gluon/events"
 	"github.com/ProtonMail/gluon/imap"
 	"github.com/ProtonMail/gluon/reporter"
@@ -46,11 +49,16 @@ type IMAPSettingsProvider interface {
 	TLSConfig() *tls.Config
 	LogClient() bool
 	LogServer() bool
+	SessionAudit() io.Writer
+	BindAddress() string
 	Port() int
 	SetPort(int) error
 	UseSSL() bool
+	ImplicitTLSPort() int
 	DisableIMAPAuthenticate() bool
 	CacheDirectory() string
+	MaxCacheSize() uint64
+	KeepRecentDays() uint32
 	DataDirectory() (string, error)
 	SetCacheDirectory(string) error
 	EventPublisher() IMAPEventPublisher
@@ -75,12 +83,14 @@ func newIMAPServer(
 	tlsConfig *tls.Config,
 	reporter reporter.Reporter,
 	logClient, logServer bool,
+	sessionAudit io.Writer,
 	disableIMAPAuthenticate bool,
 	eventPublisher IMAPEventPublisher,
 	tasks *async.Group,
 	uidValidityGenerator imap.UIDValidityGenerator,
 	panicHandler async.PanicHandler,
 	observabilitySender observability.Sender,
+	cacheLimiter *cacheLimiter,
 ) (*gluon.Server, error) {
 	gluonCacheDir = ApplyGluonCachePathSuffix(gluonCacheDir)
 	gluonConfigDir = ApplyGluonConfigPathSuffix(gluonConfigDir)
@@ -99,12 +109,19 @@ func newIMAPServer(
 		logIMAP.Warning("================================================")
 	}
 
+	// Unless enabled on startup, the IMAP activity is only logged while the IMAP subsystem is being traced.
+	// Gluon takes its loggers when it is created, so the trace writers stay attached and discard everything in the meantime.
 	var imapClientLog io.Writer
 
 	if logClient {
 		imapClientLog = logging.NewIMAPLogger()
 	} else {
-		imapClientLog = io.Discard
+		imapClientLog = logging.NewTraceWriter(logging.SubsystemIMAP, logging.NewIMAPLogger())
+	}
+
+	// The session audit only gets the commands of the clients, from which it records their names.
+	if sessionAudit != nil {
+		imapClientLog = io.MultiWriter(imapClientLog, sessionAudit)
 	}
 
 	var imapServerLog io.Writer
@@ -112,14 +129,14 @@ func newIMAPServer(
 	if logServer {
 		imapServerLog = logging.NewIMAPLogger()
 	} else {
-		imapServerLog = io.Discard
+		imapServerLog = logging.NewTraceWriter(logging.SubsystemIMAP, logging.NewIMAPLogger())
 	}
 
 	options := []gluon.Option{
 		gluon.WithTLS(tlsConfig),
 		gluon.WithDataDir(gluonCacheDir),
 		gluon.WithDatabaseDir(gluonConfigDir),
-		gluon.WithStoreBuilder(new(storeBuilder)),
+		gluon.WithStoreBuilder(&storeBuilder{limiter: cacheLimiter}),
 		gluon.WithLogger(imapClientLog, imapServerLog),
 		getGluonVersionInfo(version),
 		gluon.WithReporter(reporter),
@@ -173,24 +190,75 @@ func getGluonVersionInfo(version *semver.Version) gluon.Option {
 	)
 }
 
-type storeBuilder struct{}
+type storeBuilder struct {
+	limiter *cacheLimiter
+}
+
+func (b *storeBuilder) New(path, userID string, passphrase []byte) (store.Store, error) {
+	dir := filepath.Join(path, userID)
 
-func (*storeBuilder) New(path, userID string, passphrase []byte) (store.Store, error) {
+	// Complete or roll back a key rotation that was interrupted; it is tried again next time if this fails.
+	if err := finishRekey(dir, passphrase); err != nil {
+		logIMAP.WithError(err).Error("Failed to finish store re-encryption")
+	}
+
+	base, err := newOnDiskStore(dir, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return newLimitedStore(base, dir, b.limiter), nil
+}
+
+func newOnDiskStore(dir string, passphrase []byte) (store.Store, error) {
 	return store.NewOnDiskStore(
-		filepath.Join(path, userID),
+		dir,
 		passphrase,
 		store.WithFallback(fallback_v0.NewOnDiskStoreV0WithCompressor(&fallback_v0.GZipCompressor{})),
 	)
 }
 
 func (*storeBuilder) Delete(path, userID string) error {
-	return os.RemoveAll(filepath.Join(path, userID))
+	dir := filepath.Join(path, userID)
+
+	if err := os.Remove(journalPath(dir)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return os.RemoveAll(dir)
+}
+
+// DeleteUserData deletes the messages and the database gluon keeps for a user that is not loaded.
+// The database files are only moved aside; gluon removes them the next time it starts.
+func DeleteUserData(gluonCacheDir, gluonConfigDir, gluonID string) error {
+	if err := (&storeBuilder{}).Delete(ApplyGluonCachePathSuffix(gluonCacheDir), gluonID); err != nil {
+		return fmt.Errorf("failed to delete IMAP user messages: %w", err)
+	}
+
+	if err := db.DeleteDB(ApplyGluonConfigPathSuffix(gluonConfigDir), gluonID); err != nil {
+		return fmt.Errorf("failed to delete IMAP user database: %w", err)
+	}
+
+	return nil
+}
+
+// prepareGluonCacheDir copies the gluon cache to its new location while the IMAP server keeps serving from the old one.
+func prepareGluonCacheDir(oldGluonDir, newGluonDir string) error {
+	logIMAP.WithField("pkg", "service/imap").Infof("gluon cache copying from %s to %s", oldGluonDir, newGluonDir)
+
+	if err := files.SyncDir(ApplyGluonCachePathSuffix(oldGluonDir), ApplyGluonCachePathSuffix(newGluonDir)); err != nil {
+		return fmt.Errorf("failed to copy gluon dir: %w", err)
+	}
+
+	return nil
 }
 
 func moveGluonCacheDir(settings IMAPSettingsProvider, oldGluonDir, newGluonDir string) error {
 	logIMAP.WithField("pkg", "service/imap").Infof("gluon cache moving from %s to %s", oldGluonDir, newGluonDir)
 	oldCacheDir := ApplyGluonCachePathSuffix(oldGluonDir)
-	if err := files.CopyDir(oldCacheDir, ApplyGluonCachePathSuffix(newGluonDir)); err != nil {
+
+	// Most of the data was already copied by prepareGluonCacheDir; only what changed since is transferred.
+	if err := files.SyncDir(oldCacheDir, ApplyGluonCachePathSuffix(newGluonDir)); err != nil {
 		return fmt.Errorf("failed to copy gluon dir: %w", err)
 	}
 
@@ -0,0 +1,644 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// maxIMAPLine is the length of the longest client command or server response line the connection inspects.
+// Longer lines are passed through untouched.
+const maxIMAPLine = 64 * 1024
+
+// maxCapturedLiteral is the size of the longest literal kept to learn the username of a LOGIN.
+const maxCapturedLiteral = 1024
+
+// AppendLimitFunc returns the append limit of the account the given username belongs to and whether it is known.
+type AppendLimitFunc func(username string) (uint64, bool)
+
+// SearchFunc fetches the messages older than the sync cutoff of the account the given username belongs to whose
+// subject contains the given text. It returns whether any message was added.
+type SearchFunc func(username, subject string) bool
+
+// imapListener wraps the connections of the IMAP server to extend the protocol where gluon has no hook for it.
+type imapListener struct {
+	net.Listener
+
+	tlsConfig   *tls.Config
+	appendLimit AppendLimitFunc
+	search      SearchFunc
+}
+
+// newIMAPListener wraps the given listener. If tlsConfig is not nil, STARTTLS is terminated by the listener's
+// connections rather than by gluon, so they keep seeing the commands and responses in the clear.
+func newIMAPListener(listener net.Listener, tlsConfig *tls.Config, appendLimit AppendLimitFunc, search SearchFunc) net.Listener {
+	return &imapListener{Listener: listener, tlsConfig: tlsConfig, appendLimit: appendLimit, search: search}
+}
+
+func (l *imapListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return newIMAPConn(conn, l.tlsConfig, l.appendLimit, l.search), nil
+}
+
+// imapConn sits between an IMAP client and gluon. It follows the LOGIN and AUTHENTICATE commands of the client to
+// learn which account is logged in, and adds the account's APPENDLIMIT (RFC 7889) to the capabilities gluon lists.
+// It also implements the XOAUTH2 and OAUTHBEARER mechanisms gluon lacks, by passing their bearer token to gluon as the
+// password of a LOGIN command, and fetches the older messages a SEARCH by subject may find before gluon runs it, as
+// gluon only searches the messages within the sync window. Closing it closes the underlying connection, which also aborts a pending TLS negotiation.
+type imapConn struct {
+	net.Conn
+
+	tlsConfig   *tls.Config
+	appendLimit AppendLimitFunc
+	search      SearchFunc
+
+	// conn is the connection to the client, which is replaced by a TLS one after STARTTLS.
+	conn     net.Conn
+	connLock sync.RWMutex
+
+	// reader reads the commands of the client; in holds what gluon hasn't read yet.
+	reader      *bufio.Reader
+	in          []byte
+	inLiteral   int64
+	inLongLine  bool
+	inCapture   []byte
+	captureTag  string
+	authTag     string
+	authPending bool
+
+	// oauthTag and oauthMechanism belong to the AUTHENTICATE command waiting for the client's OAuth response.
+	oauthTag       string
+	oauthMechanism string
+
+	// out holds the incomplete response line gluon is writing.
+	out         []byte
+	outLiteral  int64
+	outLongLine bool
+
+	// logins maps the tags of the pending LOGIN and AUTHENTICATE commands to their usernames.
+	logins    map[string]string
+	username  string
+	stateLock sync.Mutex
+
+	// noopTag is the tag of the NOOP the connection sent gluon itself, whose completion the client must not see.
+	noopTag string
+	noops   int
+}
+
+func newIMAPConn(conn net.Conn, tlsConfig *tls.Config, appendLimit AppendLimitFunc, search SearchFunc) *imapConn {
+	return &imapConn{
+		Conn:        conn,
+		tlsConfig:   tlsConfig,
+		appendLimit: appendLimit,
+		search:      search,
+		conn:        conn,
+		reader:      bufio.NewReaderSize(conn, maxIMAPLine),
+		logins:      make(map[string]string),
+	}
+}
+
+func (c *imapConn) Read(b []byte) (int, error) {
+	for len(c.in) == 0 {
+		if err := c.readClient(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(b, c.in)
+
+	c.in = c.in[n:]
+
+	return n, nil
+}
+
+func (c *imapConn) Write(b []byte) (int, error) {
+	c.connLock.RLock()
+	defer c.connLock.RUnlock()
+
+	if _, err := c.conn.Write(c.readServer(b)); err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+// readClient reads the next line or literal chunk sent by the client.
+func (c *imapConn) readClient() error {
+	if c.inLiteral > 0 {
+		chunk := make([]byte, min(c.inLiteral, maxIMAPLine))
+
+		n, err := c.reader.Read(chunk)
+		if n == 0 {
+			return err
+		}
+
+		c.in, c.inLiteral = chunk[:n], c.inLiteral-int64(n)
+
+		if c.captureTag != "" {
+			c.inCapture = append(c.inCapture, chunk[:n]...)
+
+			if c.inLiteral == 0 {
+				c.addLogin(c.captureTag, string(c.inCapture))
+				c.captureTag, c.inCapture = "", nil
+			}
+		}
+
+		return nil
+	}
+
+	line, err := c.reader.ReadSlice('\n')
+	if len(line) == 0 {
+		return err
+	}
+
+	// The line is too long to be a command the connection cares about.
+	if !bytes.HasSuffix(line, []byte("\n")) {
+		c.in, c.inLongLine = bytes.Clone(line), true
+		return nil
+	}
+
+	longLine := c.inLongLine
+
+	c.in, c.inLongLine = bytes.Clone(line), false
+
+	if !longLine {
+		if handled, err := c.handleCommand(line); handled || err != nil {
+			c.in = nil
+			return err
+		}
+	}
+
+	c.inLiteral = literalSize(line)
+
+	return nil
+}
+
+// handleCommand inspects a line of the client. It returns true if the line was handled and must not reach gluon.
+// The line may also be replaced in c.in by the command gluon should read instead.
+func (c *imapConn) handleCommand(line []byte) (bool, error) {
+	// The line is the client's OAuth response, which the connection asked for itself.
+	if c.oauthTag != "" {
+		tag, mechanism := c.oauthTag, c.oauthMechanism
+
+		c.oauthTag, c.oauthMechanism = "", ""
+
+		return c.authenticateOAuth(tag, mechanism, strings.TrimSpace(string(line)))
+	}
+
+	// The line is the client's response to the challenge of an AUTHENTICATE command.
+	if c.authPending {
+		c.authPending = false
+
+		if username, ok := parsePlainResponse(strings.TrimSpace(string(line))); ok {
+			c.addLogin(c.authTag, username)
+		}
+
+		return false, nil
+	}
+
+	tag, rest, ok := strings.Cut(strings.TrimRight(string(line), "\r\n"), " ")
+	if !ok {
+		return false, nil
+	}
+
+	name, args, _ := strings.Cut(rest, " ")
+
+	// UID SEARCH is searched like SEARCH.
+	if strings.EqualFold(name, "UID") {
+		if subName, subArgs, ok := strings.Cut(args, " "); ok && strings.EqualFold(subName, "SEARCH") {
+			name, args = subName, subArgs
+		}
+	}
+
+	switch strings.ToUpper(name) {
+	case "STARTTLS":
+		if c.tlsConfig == nil {
+			return false, nil
+		}
+
+		return true, c.startTLS(tag)
+
+	case "LOGIN":
+		username, literal, ok := parseAString(args)
+		if !ok {
+			return false, nil
+		}
+
+		if literal == 0 {
+			c.addLogin(tag, username)
+		} else if literal <= maxCapturedLiteral {
+			c.captureTag = tag
+		}
+
+	case "SEARCH":
+		// Gluon only sees the added messages once the mailbox is updated, which a NOOP sent before the search does.
+		if subject, ok := searchSubject(args); ok && c.searchOlderMail(subject) {
+			c.in = append([]byte(c.newNoop()), c.in...)
+		}
+
+	case "AUTHENTICATE":
+		mechanism, response, _ := strings.Cut(args, " ")
+
+		if mechanism = strings.ToUpper(mechanism); mechanism == saslXOAuth2 || mechanism == saslOAuthBearer {
+			if response == "" {
+				c.oauthTag, c.oauthMechanism = tag, mechanism
+				return true, c.writeClient("+ \r\n")
+			}
+
+			return c.authenticateOAuth(tag, mechanism, response)
+		}
+
+		if mechanism != "PLAIN" {
+			return false, nil
+		}
+
+		if response == "" {
+			c.authTag, c.authPending = tag, true
+		} else if username, ok := parsePlainResponse(response); ok {
+			c.addLogin(tag, username)
+		}
+	}
+
+	return false, nil
+}
+
+// searchOlderMail fetches the older messages with the given subject of the logged in account, if any.
+// It returns whether any message was added.
+func (c *imapConn) searchOlderMail(subject string) bool {
+	c.stateLock.Lock()
+	username := c.username
+	c.stateLock.Unlock()
+
+	if username == "" || c.search == nil {
+		return false
+	}
+
+	return c.search(username, subject)
+}
+
+// newNoop returns a NOOP command for gluon whose tagged response is dropped.
+func (c *imapConn) newNoop() string {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+
+	c.noops++
+	c.noopTag = fmt.Sprintf("bridge-noop-%v", c.noops)
+
+	return c.noopTag + " NOOP\r\n"
+}
+
+// authenticateOAuth handles the base64 OAuth response of an AUTHENTICATE command. The bearer token is checked by gluon
+// like a password, as it is one of the local auth tokens bridge accepts instead of the bridge password.
+func (c *imapConn) authenticateOAuth(tag, mechanism, response string) (bool, error) {
+	if response == "*" {
+		return true, c.writeClient(tag + " BAD AUTHENTICATE cancelled\r\n")
+	}
+
+	b, err := base64.StdEncoding.DecodeString(response)
+	if err != nil {
+		return true, c.writeClient(tag + " BAD Invalid base64 response\r\n")
+	}
+
+	var username, token string
+
+	if mechanism == saslXOAuth2 {
+		username, token, err = parseXOAuth2Response(b)
+	} else {
+		username, token, err = parseOAuthBearerResponse(b)
+	}
+
+	if err != nil || strings.ContainsAny(username+token, "\r\n") {
+		return true, c.writeClient(tag + " NO [AUTHENTICATIONFAILED] Invalid OAuth response\r\n")
+	}
+
+	c.addLogin(tag, username)
+
+	c.in = []byte(fmt.Sprintf("%v LOGIN %v %v\r\n", tag, quoteString(username), quoteString(token)))
+
+	return false, nil
+}
+
+// writeClient sends a response of the connection itself to the client.
+func (c *imapConn) writeClient(response string) error {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+
+	_, err := io.WriteString(c.conn, response)
+
+	return err
+}
+
+// startTLS answers the STARTTLS command of the client and negotiates TLS over the connection.
+func (c *imapConn) startTLS(tag string) error {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+
+	if _, err := fmt.Fprintf(c.conn, "%v OK Begin TLS negotiation now\r\n", tag); err != nil {
+		return err
+	}
+
+	conn := tls.Server(c.conn, c.tlsConfig)
+
+	if err := conn.Handshake(); err != nil {
+		return err
+	}
+
+	// Anything the client sent before the negotiation is discarded (RFC 3501, 6.2.1).
+	c.conn, c.tlsConfig = conn, nil
+	c.reader.Reset(conn)
+
+	return nil
+}
+
+func (c *imapConn) addLogin(tag, username string) {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+
+	c.logins[tag] = username
+}
+
+// readServer consumes what gluon writes and returns what to send to the client.
+func (c *imapConn) readServer(b []byte) []byte {
+	var res []byte
+
+	for len(b) > 0 {
+		if c.outLiteral > 0 {
+			n := min(c.outLiteral, int64(len(b)))
+
+			res, b, c.outLiteral = append(res, b[:n]...), b[n:], c.outLiteral-n
+
+			continue
+		}
+
+		idx := bytes.IndexByte(b, '\n')
+		if idx < 0 {
+			c.out, b = append(c.out, b...), nil
+
+			// The line is too long to be a response the connection cares about.
+			if len(c.out) > maxIMAPLine {
+				res, c.out, c.outLongLine = append(res, c.out...), nil, true
+			}
+
+			continue
+		}
+
+		line := append(c.out, b[:idx+1]...)
+
+		c.out, b = nil, b[idx+1:]
+
+		if c.outLongLine {
+			c.outLongLine = false
+		} else {
+			line = c.handleResponse(line)
+		}
+
+		res = append(res, line...)
+
+		c.outLiteral = literalSize(line)
+	}
+
+	return res
+}
+
+// handleResponse inspects a line of gluon and returns the line to send to the client instead.
+func (c *imapConn) handleResponse(line []byte) []byte {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+
+	if tag, rest, ok := bytes.Cut(line, []byte(" ")); ok {
+		// The untagged responses of the connection's NOOP are updates the client may see, but not its completion.
+		if c.noopTag != "" && string(tag) == c.noopTag {
+			c.noopTag = ""
+			return nil
+		}
+
+		if username, ok := c.logins[string(tag)]; ok {
+			delete(c.logins, string(tag))
+
+			if bytes.HasPrefix(bytes.ToUpper(rest), []byte("OK")) {
+				c.username = username
+			}
+		}
+	}
+
+	line = addOAuthMechanisms(line)
+
+	if c.username == "" {
+		return line
+	}
+
+	return addAppendLimit(line, func() (uint64, bool) { return c.appendLimit(c.username) })
+}
+
+// addAppendLimit adds APPENDLIMIT to the capabilities listed in the given response line, if any.
+func addAppendLimit(line []byte, appendLimit func() (uint64, bool)) []byte {
+	start, end, ok := capabilityList(line)
+	if !ok || bytes.Contains(bytes.ToUpper(line[start:end]), []byte("APPENDLIMIT")) {
+		return line
+	}
+
+	limit, ok := appendLimit()
+	if !ok {
+		return line
+	}
+
+	return insertCapability(line, end, "APPENDLIMIT="+strconv.FormatUint(limit, 10))
+}
+
+// addOAuthMechanisms adds the OAuth mechanisms the connection implements next to the ones gluon lists, if any.
+func addOAuthMechanisms(line []byte) []byte {
+	start, end, ok := capabilityList(line)
+	if !ok {
+		return line
+	}
+
+	if list := bytes.ToUpper(line[start:end]); !bytes.Contains(list, []byte("AUTH=PLAIN")) || bytes.Contains(list, []byte("AUTH="+saslXOAuth2)) {
+		return line
+	}
+
+	return insertCapability(line, end, "AUTH="+saslXOAuth2+" AUTH="+saslOAuthBearer)
+}
+
+// capabilityList returns the bounds of the capabilities listed in the given response line, if any.
+func capabilityList(line []byte) (int, int, bool) {
+	switch upper := bytes.ToUpper(line); {
+	case bytes.HasPrefix(upper, []byte("* CAPABILITY ")):
+		return 0, len(bytes.TrimRight(line, "\r\n")), true
+
+	case bytes.Contains(upper, []byte("[CAPABILITY ")):
+		start := bytes.Index(upper, []byte("[CAPABILITY "))
+
+		idx := bytes.IndexByte(line[start:], ']')
+		if idx < 0 {
+			return 0, 0, false
+		}
+
+		return start, start + idx, true
+
+	default:
+		return 0, 0, false
+	}
+}
+
+// insertCapability inserts the given capability at the end of the capability list ending at end.
+func insertCapability(line []byte, end int, capability string) []byte {
+	res := make([]byte, 0, len(line)+len(capability)+1)
+
+	res = append(res, line[:end]...)
+	res = append(res, " "+capability...)
+	res = append(res, line[end:]...)
+
+	return res
+}
+
+// literalSize returns the size of the literal announced at the end of the given line, or zero if there is none.
+func literalSize(line []byte) int64 {
+	line = bytes.TrimRight(line, "\r\n")
+
+	if !bytes.HasSuffix(line, []byte("}")) {
+		return 0
+	}
+
+	idx := bytes.LastIndexByte(line, '{')
+	if idx < 0 {
+		return 0
+	}
+
+	size, err := strconv.ParseInt(strings.TrimSuffix(string(line[idx+1:len(line)-1]), "+"), 10, 64)
+	if err != nil || size < 0 {
+		return 0
+	}
+
+	return size
+}
+
+// parseAString parses the astring at the start of the given arguments. If it is a literal, its size is returned
+// instead as its content follows the line.
+func parseAString(args string) (string, int64, bool) {
+	switch {
+	case strings.HasPrefix(args, "\""):
+		var (
+			res     strings.Builder
+			escaped bool
+		)
+
+		for _, r := range args[1:] {
+			switch {
+			case escaped:
+				res.WriteRune(r)
+				escaped = false
+
+			case r == '\\':
+				escaped = true
+
+			case r == '"':
+				return res.String(), 0, true
+
+			default:
+				res.WriteRune(r)
+			}
+		}
+
+		return "", 0, false
+
+	case strings.HasPrefix(args, "{"):
+		end := strings.IndexByte(args, '}')
+		if end < 0 {
+			return "", 0, false
+		}
+
+		size, err := strconv.ParseInt(strings.TrimSuffix(args[1:end], "+"), 10, 64)
+		if err != nil || size <= 0 {
+			return "", 0, false
+		}
+
+		return "", size, true
+
+	default:
+		atom, _, _ := strings.Cut(args, " ")
+
+		return atom, 0, atom != ""
+	}
+}
+
+// searchSubject returns the text of the SUBJECT key of the given SEARCH arguments, if any.
+func searchSubject(args string) (string, bool) {
+	const key = "SUBJECT "
+
+	upper := strings.ToUpper(args)
+
+	for idx := strings.Index(upper, key); idx >= 0; {
+		// The key must not be the end of a longer word.
+		if idx == 0 || args[idx-1] == ' ' || args[idx-1] == '(' {
+			rest := args[idx+len(key):]
+
+			if subject, literal, ok := parseAString(rest); ok && literal == 0 {
+				// An atom may close a parenthesized list of keys.
+				if !strings.HasPrefix(rest, "\"") {
+					subject = strings.TrimRight(subject, ")")
+				}
+
+				if subject != "" {
+					return subject, true
+				}
+			}
+		}
+
+		next := strings.Index(upper[idx+1:], key)
+		if next < 0 {
+			break
+		}
+
+		idx += next + 1
+	}
+
+	return "", false
+}
+
+// quoteString returns the given string as an IMAP quoted string.
+func quoteString(str string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(str) + `"`
+}
+
+// parsePlainResponse returns the authentication identity of a base64 SASL PLAIN response (RFC 4616).
+func parsePlainResponse(response string) (string, bool) {
+	b, err := base64.StdEncoding.DecodeString(response)
+	if err != nil {
+		return "", false
+	}
+
+	fields := bytes.Split(b, []byte{0})
+	if len(fields) != 3 || len(fields[1]) == 0 {
+		return "", false
+	}
+
+	return string(fields[1]), true
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIMAPConn_AppendLimit(t *testing.T) {
+	test := newIMAPConnTest(t, nil)
+
+	// Nobody is logged in yet.
+	test.respond("* CAPABILITY IMAP4rev1 IDLE\r\n", "* CAPABILITY IMAP4rev1 IDLE\r\n")
+
+	// The login fails.
+	test.send("a LOGIN user@pm.me wrong\r\n")
+	test.respond("a NO Invalid credentials\r\n", "a NO Invalid credentials\r\n")
+	test.respond("* CAPABILITY IMAP4rev1 IDLE\r\n", "* CAPABILITY IMAP4rev1 IDLE\r\n")
+
+	// The login succeeds.
+	test.send("b LOGIN \"user@pm.me\" pass\r\n")
+	test.respond("b OK [CAPABILITY IMAP4rev1 IDLE] Logged in\r\n", "b OK [CAPABILITY IMAP4rev1 IDLE APPENDLIMIT=1000] Logged in\r\n")
+	test.respond("* CAPABILITY IMAP4rev1 IDLE\r\n", "* CAPABILITY IMAP4rev1 IDLE APPENDLIMIT=1000\r\n")
+}
+
+func TestIMAPConn_Literals(t *testing.T) {
+	test := newIMAPConnTest(t, nil)
+
+	// The username is sent as a literal.
+	test.send("a LOGIN {10}\r\n")
+	test.send("user@pm.me pass\r\n")
+	test.respond("a OK Logged in\r\n", "a OK Logged in\r\n")
+
+	// A message looking like a CAPABILITY response is left untouched.
+	body := "* CAPABILITY IMAP4rev1\r\n"
+
+	test.respond(
+		fmt.Sprintf("* 1 FETCH (BODY[] {%v}\r\n%v)\r\n", len(body), body),
+		fmt.Sprintf("* 1 FETCH (BODY[] {%v}\r\n%v)\r\n", len(body), body),
+	)
+
+	// So is a message the client appends.
+	test.send(fmt.Sprintf("b APPEND INBOX {%v+}\r\n", len("c STARTTLS\r\n")))
+	test.send("c STARTTLS\r\n")
+	test.send("\r\n")
+
+	test.respond("* CAPABILITY IMAP4rev1\r\n", "* CAPABILITY IMAP4rev1 APPENDLIMIT=1000\r\n")
+}
+
+func TestIMAPConn_AuthenticatePlain(t *testing.T) {
+	test := newIMAPConnTest(t, nil)
+
+	test.send("a AUTHENTICATE PLAIN\r\n")
+	test.respond("+ \r\n", "+ \r\n")
+	test.send(base64.StdEncoding.EncodeToString([]byte("\x00user@pm.me\x00pass")) + "\r\n")
+	test.respond("a OK [CAPABILITY IMAP4rev1] Logged in\r\n", "a OK [CAPABILITY IMAP4rev1 APPENDLIMIT=1000] Logged in\r\n")
+}
+
+func TestIMAPConn_AuthenticateOAuth(t *testing.T) {
+	test := newIMAPConnTest(t, nil)
+
+	// The OAuth mechanisms are listed next to the ones gluon implements.
+	test.respond("* CAPABILITY IMAP4rev1 AUTH=PLAIN\r\n", "* CAPABILITY IMAP4rev1 AUTH=PLAIN AUTH=XOAUTH2 AUTH=OAUTHBEARER\r\n")
+
+	lineCh := test.readGluonLine()
+
+	// A malformed response is rejected without reaching gluon.
+	test.answer("a AUTHENTICATE XOAUTH2 "+base64.StdEncoding.EncodeToString([]byte("auth=Bearer token\x01\x01"))+"\r\n", "a NO [AUTHENTICATIONFAILED] Invalid OAuth response\r\n")
+
+	// Without an initial response, the connection asks for it; the bearer token is passed to gluon as the password.
+	test.answer("b AUTHENTICATE OAUTHBEARER\r\n", "+ \r\n")
+
+	go func() {
+		_, _ = io.WriteString(test.clientConn, base64.StdEncoding.EncodeToString([]byte("n,a=user@pm.me,\x01auth=Bearer bridge-token.1.a\"b\x01\x01"))+"\r\n")
+	}()
+
+	require.Equal(t, "b LOGIN \"user@pm.me\" \"bridge-token.1.a\\\"b\"\r\n", <-lineCh)
+
+	test.respond("b OK [CAPABILITY IMAP4rev1] Logged in\r\n", "b OK [CAPABILITY IMAP4rev1 APPENDLIMIT=1000] Logged in\r\n")
+}
+
+func TestIMAPConn_SearchOlderMail(t *testing.T) {
+	test := newIMAPConnTest(t, nil)
+
+	// Nothing is searched before the login.
+	test.send("a SEARCH SUBJECT hello\r\n")
+	require.Empty(t, test.searches)
+
+	test.send("b LOGIN user@pm.me pass\r\n")
+	test.respond("b OK Logged in\r\n", "b OK Logged in\r\n")
+
+	// The older messages are searched before gluon gets the command, which is passed as is.
+	test.send("c SEARCH SUBJECT \"hello world\"\r\n")
+	test.send("d SEARCH FROM bob\r\n")
+
+	// When messages were added, gluon gets a NOOP first to update the mailbox; the client only sees the updates.
+	go func() { _, _ = io.WriteString(test.clientConn, "e UID SEARCH (FROM alice subject report) UNSEEN\r\n") }()
+
+	want := "bridge-noop-1 NOOP\r\ne UID SEARCH (FROM alice subject report) UNSEEN\r\n"
+	b := make([]byte, len(want))
+
+	_, err := io.ReadFull(test.gluon, b)
+	require.NoError(t, err)
+	require.Equal(t, want, string(b))
+
+	test.respond("* 3 EXISTS\r\nbridge-noop-1 OK NOOP\r\n* SEARCH 3\r\ne OK SEARCH\r\n", "* 3 EXISTS\r\n* SEARCH 3\r\ne OK SEARCH\r\n")
+
+	require.Equal(t, []string{"user@pm.me: hello world", "user@pm.me: report"}, test.searches)
+}
+
+func TestSearchSubject(t *testing.T) {
+	for args, want := range map[string]string{
+		"SUBJECT hello":                  "hello",
+		"UNSEEN subject \"a \\\"b\\\"\"": "a \"b\"",
+		"OR (SUBJECT x) FROM y":          "x",
+		"HEADER X-SUBJECT foo":           "",
+		"SUBJECT {5}":                    "",
+	} {
+		subject, ok := searchSubject(args)
+		require.Equal(t, want != "", ok, args)
+		require.Equal(t, want, subject, args)
+	}
+}
+
+func TestIMAPConn_StartTLS(t *testing.T) {
+	template, err := certs.NewTLSTemplate()
+	require.NoError(t, err)
+
+	certPEM, keyPEM, err := certs.GenerateCert(template)
+	require.NoError(t, err)
+
+	tlsConfig, err := certs.GetConfig(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	test := newIMAPConnTest(t, tlsConfig)
+
+	// The connection answers STARTTLS itself; gluon only sees the commands that follow, in the clear.
+	go func() { _, _ = io.WriteString(test.clientConn, "a STARTTLS\r\n") }()
+
+	lineCh := make(chan string)
+
+	go func() {
+		line, err := test.gluon.ReadString('\n')
+		if err != nil {
+			close(lineCh)
+		} else {
+			lineCh <- line
+		}
+	}()
+
+	line, err := test.client.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "a OK Begin TLS negotiation now\r\n", line)
+
+	clientConn := tls.Client(test.clientConn, &tls.Config{InsecureSkipVerify: true}) //nolint:gosec
+	require.NoError(t, clientConn.Handshake())
+
+	go func() { _, _ = io.WriteString(clientConn, "b LOGIN user@pm.me pass\r\n") }()
+
+	require.Equal(t, "b LOGIN user@pm.me pass\r\n", <-lineCh)
+}
+
+type imapConnTest struct {
+	t *testing.T
+
+	clientConn net.Conn
+	client     *bufio.Reader
+
+	conn  *imapConn
+	gluon *bufio.Reader
+
+	// searches are the searches of older messages the connection made, as "username: subject".
+	searches []string
+}
+
+func newIMAPConnTest(t *testing.T, tlsConfig *tls.Config) *imapConnTest {
+	clientConn, serverConn := net.Pipe()
+
+	test := &imapConnTest{t: t}
+
+	conn := newIMAPConn(serverConn, tlsConfig, func(username string) (uint64, bool) {
+		return 1000, username == "user@pm.me"
+	}, func(username, subject string) bool {
+		test.searches = append(test.searches, username+": "+subject)
+		return subject == "report"
+	})
+
+	t.Cleanup(func() {
+		_ = clientConn.Close()
+		_ = conn.Close()
+	})
+
+	test.clientConn, test.client = clientConn, bufio.NewReader(clientConn)
+	test.conn, test.gluon = conn, bufio.NewReader(conn)
+
+	return test
+}
+
+// send sends the given data from the client and checks gluon receives it as is.
+func (test *imapConnTest) send(data string) {
+	go func() { _, _ = io.WriteString(test.clientConn, data) }()
+
+	b := make([]byte, len(data))
+
+	_, err := io.ReadFull(test.gluon, b)
+	require.NoError(test.t, err)
+	require.Equal(test.t, data, string(b))
+}
+
+// answer sends the given data from the client and checks the connection answers it itself.
+// Gluon must be reading for the connection to see the data.
+func (test *imapConnTest) answer(data, want string) {
+	go func() { _, _ = io.WriteString(test.clientConn, data) }()
+
+	b := make([]byte, len(want))
+
+	_, err := io.ReadFull(test.client, b)
+	require.NoError(test.t, err)
+	require.Equal(test.t, want, string(b))
+}
+
+// readGluonLine reads the next line gluon receives in the background.
+func (test *imapConnTest) readGluonLine() <-chan string {
+	lineCh := make(chan string)
+
+	go func() {
+		line, err := test.gluon.ReadString('\n')
+		if err != nil {
+			close(lineCh)
+		} else {
+			lineCh <- line
+		}
+	}()
+
+	return lineCh
+}
+
+// respond sends the given data from gluon and checks the client receives what is wanted.
+func (test *imapConnTest) respond(data, want string) {
+	go func() { _, _ = io.WriteString(test.conn, data) }()
+
+	b := make([]byte, len(want))
+
+	_, err := io.ReadFull(test.client, b)
+	require.NoError(test.t, err)
+	require.Equal(test.t, want, string(b))
+}
@@ -19,28 +19,182 @@ package imapsmtpserver
 
 import (
 	"crypto/tls"
-	"fmt"
 	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 )
 
-func newListener(port int, useTLS bool, tlsConfig *tls.Config) (net.Listener, error) {
-	if useTLS {
-		tlsListener, err := tls.Listen("tcp", fmt.Sprintf("%v:%v", constants.Host, port), tlsConfig)
-		if err != nil {
-			return nil, err
+// ActivityNotifier is notified whenever an IMAP or SMTP client sends data.
+type ActivityNotifier interface {
+	NotifyActivity()
+}
+
+// ListenFunc creates the listeners the IMAP and SMTP servers accept connections on, e.g. net.Listen.
+type ListenFunc func(network, address string) (net.Listener, error)
+
+// unclaimedTrafficTTL is how long the traffic of a closed connection is kept for the session audit to claim it.
+const unclaimedTrafficTTL = time.Minute
+
+// Traffic counts the bytes transferred over a connection, as seen below TLS.
+type Traffic struct {
+	in, out atomic.Int64
+}
+
+// BytesIn returns the number of bytes received from the client.
+func (t *Traffic) BytesIn() int64 {
+	return t.in.Load()
+}
+
+// BytesOut returns the number of bytes sent to the client.
+func (t *Traffic) BytesOut() int64 {
+	return t.out.Load()
+}
+
+// trafficRegistry keeps the traffic of each connection, by remote address, until it is claimed.
+type trafficRegistry struct {
+	lock  sync.Mutex
+	conns map[string]*trackedTraffic
+}
+
+type trackedTraffic struct {
+	traffic  *Traffic
+	closedAt time.Time
+}
+
+func newTrafficRegistry() *trafficRegistry {
+	return &trafficRegistry{conns: make(map[string]*trackedTraffic)}
+}
+
+func (r *trafficRegistry) add(remoteAddr string) *Traffic {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// Forget the connections closed long ago that were never claimed.
+	for addr, tracked := range r.conns {
+		if !tracked.closedAt.IsZero() && time.Since(tracked.closedAt) > unclaimedTrafficTTL {
+			delete(r.conns, addr)
 		}
+	}
+
+	traffic := &Traffic{}
 
-		return tlsListener, nil
+	r.conns[remoteAddr] = &trackedTraffic{traffic: traffic}
+
+	return traffic
+}
+
+func (r *trafficRegistry) closed(remoteAddr string, traffic *Traffic) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if tracked, ok := r.conns[remoteAddr]; ok && tracked.traffic == traffic {
+		tracked.closedAt = time.Now()
 	}
+}
+
+func (r *trafficRegistry) claim(remoteAddr string) (*Traffic, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
 
-	netListener, err := net.Listen("tcp", fmt.Sprintf("%v:%v", constants.Host, port))
+	tracked, ok := r.conns[remoteAddr]
+	if !ok {
+		return nil, false
+	}
+
+	delete(r.conns, remoteAddr)
+
+	return tracked.traffic, true
+}
+
+// newListener creates a listener for one of the servers. If traffic is not nil, it counts the bytes transferred over each connection.
+func newListener(listen ListenFunc, host string, port int, useTLS bool, tlsConfig *tls.Config, activity ActivityNotifier, traffic *trafficRegistry) (net.Listener, error) {
+	if host == "" {
+		host = constants.Host
+	}
+
+	netListener, err := listen("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
 	if err != nil {
 		return nil, err
 	}
 
-	return netListener, nil
+	// Activity is tracked below TLS so that servers still see TLS connections as such.
+	listener := net.Listener(&activityListener{Listener: netListener, activity: activity, traffic: traffic})
+
+	if useTLS {
+		return tls.NewListener(listener, tlsConfig), nil
+	}
+
+	return listener, nil
+}
+
+type activityListener struct {
+	net.Listener
+
+	activity ActivityNotifier
+	traffic  *trafficRegistry
+}
+
+func (l *activityListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	l.activity.NotifyActivity()
+
+	if l.traffic == nil {
+		return &activityConn{Conn: conn, activity: l.activity}, nil
+	}
+
+	return &activityConn{
+		Conn:     conn,
+		activity: l.activity,
+		registry: l.traffic,
+		traffic:  l.traffic.add(conn.RemoteAddr().String()),
+	}, nil
+}
+
+type activityConn struct {
+	net.Conn
+
+	activity ActivityNotifier
+
+	registry *trafficRegistry
+	traffic  *Traffic
+}
+
+func (c *activityConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.activity.NotifyActivity()
+
+		if c.traffic != nil {
+			c.traffic.in.Add(int64(n))
+		}
+	}
+
+	return n, err
+}
+
+func (c *activityConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 && c.traffic != nil {
+		c.traffic.out.Add(int64(n))
+	}
+
+	return n, err
+}
+
+func (c *activityConn) Close() error {
+	if c.registry != nil {
+		c.registry.closed(c.Conn.RemoteAddr().String(), c.traffic)
+	}
+
+	return c.Conn.Close()
 }
 
 func getPort(addr net.Addr) int {
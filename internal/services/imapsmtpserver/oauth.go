@@ -0,0 +1,131 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+)
+
+// SASL mechanisms used by clients that only support OAuth-style authentication.
+// Bridge doesn't talk to an OAuth provider: the bearer token is a local auth token minted by bridge.
+const (
+	saslXOAuth2     = "XOAUTH2"
+	saslOAuthBearer = "OAUTHBEARER"
+)
+
+var errInvalidOAuthResponse = errors.New("invalid OAuth client response")
+
+// oauthServer is a SASL server for XOAUTH2 and OAUTHBEARER, which carry a username and a bearer token.
+type oauthServer struct {
+	parse        func(response []byte) (string, string, error)
+	authenticate func(username, token string) error
+
+	requested bool
+}
+
+func newXOAuth2Server(authenticate func(username, token string) error) *oauthServer {
+	return &oauthServer{parse: parseXOAuth2Response, authenticate: authenticate}
+}
+
+func newOAuthBearerServer(authenticate func(username, token string) error) *oauthServer {
+	return &oauthServer{parse: parseOAuthBearerResponse, authenticate: authenticate}
+}
+
+func (s *oauthServer) Next(response []byte) ([]byte, bool, error) {
+	// The client didn't send an initial response; ask for it once.
+	if response == nil {
+		if s.requested {
+			return nil, false, errInvalidOAuthResponse
+		}
+
+		s.requested = true
+
+		return []byte{}, false, nil
+	}
+
+	username, token, err := s.parse(response)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := s.authenticate(username, token); err != nil {
+		return nil, false, err
+	}
+
+	return nil, true, nil
+}
+
+// parseXOAuth2Response parses "user=<username>^Aauth=Bearer <token>^A^A".
+func parseXOAuth2Response(response []byte) (string, string, error) {
+	var username, token string
+
+	for _, field := range bytes.Split(response, []byte{0x01}) {
+		key, value, _ := strings.Cut(string(field), "=")
+
+		switch key {
+		case "user":
+			username = value
+
+		case "auth":
+			token = bearerToken(value)
+		}
+	}
+
+	if username == "" || token == "" {
+		return "", "", errInvalidOAuthResponse
+	}
+
+	return username, token, nil
+}
+
+// parseOAuthBearerResponse parses "n,a=<username>,^Aauth=Bearer <token>^A^A" as defined in RFC 7628.
+func parseOAuthBearerResponse(response []byte) (string, string, error) {
+	fields := bytes.Split(response, []byte{0x01})
+
+	var username, token string
+
+	// The GS2 header carries the authorization identity, i.e. the username.
+	for _, attr := range strings.Split(string(fields[0]), ",") {
+		if value, ok := strings.CutPrefix(attr, "a="); ok {
+			username = strings.NewReplacer("=2C", ",", "=3D", "=").Replace(value)
+		}
+	}
+
+	for _, field := range fields[1:] {
+		if value, ok := strings.CutPrefix(string(field), "auth="); ok {
+			token = bearerToken(value)
+		}
+	}
+
+	if username == "" || token == "" {
+		return "", "", errInvalidOAuthResponse
+	}
+
+	return username, token, nil
+}
+
+func bearerToken(auth string) string {
+	scheme, token, ok := strings.Cut(auth, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+
+	return token
+}
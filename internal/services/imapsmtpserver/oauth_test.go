@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseXOAuth2Response(t *testing.T) {
+	username, token, err := parseXOAuth2Response([]byte("user=foo@bar.com\x01auth=Bearer bridge-token.1.abc\x01\x01"))
+	require.NoError(t, err)
+	require.Equal(t, "foo@bar.com", username)
+	require.Equal(t, "bridge-token.1.abc", token)
+
+	_, _, err = parseXOAuth2Response([]byte("user=foo@bar.com\x01auth=Basic abc\x01\x01"))
+	require.ErrorIs(t, err, errInvalidOAuthResponse)
+
+	_, _, err = parseXOAuth2Response([]byte("auth=Bearer abc\x01\x01"))
+	require.ErrorIs(t, err, errInvalidOAuthResponse)
+}
+
+func TestParseOAuthBearerResponse(t *testing.T) {
+	username, token, err := parseOAuthBearerResponse([]byte("n,a=foo=2Cbar@bar.com,\x01host=127.0.0.1\x01port=1025\x01auth=Bearer bridge-token.1.abc\x01\x01"))
+	require.NoError(t, err)
+	require.Equal(t, "foo,bar@bar.com", username)
+	require.Equal(t, "bridge-token.1.abc", token)
+
+	_, _, err = parseOAuthBearerResponse([]byte("n,,\x01auth=Bearer abc\x01\x01"))
+	require.ErrorIs(t, err, errInvalidOAuthResponse)
+}
+
+func TestOAuthServer(t *testing.T) {
+	server := newXOAuth2Server(func(username, token string) error {
+		if username != "foo@bar.com" || token != "token" {
+			return errors.New("invalid credentials")
+		}
+
+		return nil
+	})
+
+	// Without an initial response, the server asks for one.
+	challenge, done, err := server.Next(nil)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Empty(t, challenge)
+
+	_, done, err = server.Next([]byte("user=foo@bar.com\x01auth=Bearer token\x01\x01"))
+	require.NoError(t, err)
+	require.True(t, done)
+
+	// Invalid credentials are rejected.
+	_, _, err = newOAuthBearerServer(func(string, string) error { return errors.New("invalid credentials") }).
+		Next([]byte("n,a=foo@bar.com,\x01auth=Bearer token\x01\x01"))
+	require.Error(t, err)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/gluon/connector"
+)
+
+// olderMailSearchTimeout bounds how long the search of a client waits for older messages to be fetched.
+const olderMailSearchTimeout = 30 * time.Second
+
+// olderMailSettleDelay is how long the IMAP sessions are given to take the added messages into account: gluon queues
+// the updates of the sessions and applies them between their commands.
+const olderMailSettleDelay = 100 * time.Millisecond
+
+// olderMailSearcher fetches the messages older than the sync cutoff of a user that match a search; the gluon
+// connectors implement it.
+type olderMailSearcher interface {
+	HasAddress(email string) bool
+	SearchOlderMail(ctx context.Context, subject string) (int, error)
+}
+
+// olderMailSearchers keeps the searchers of the loaded IMAP users by gluon ID, to find them by username.
+type olderMailSearchers struct {
+	searchers map[string]olderMailSearcher
+	lock      sync.RWMutex
+}
+
+func newOlderMailSearchers() *olderMailSearchers {
+	return &olderMailSearchers{searchers: make(map[string]olderMailSearcher)}
+}
+
+func (s *olderMailSearchers) set(gluonID string, conn connector.Connector) {
+	searcher, ok := conn.(olderMailSearcher)
+	if !ok {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.searchers[gluonID] = searcher
+}
+
+func (s *olderMailSearchers) remove(gluonID string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.searchers, gluonID)
+}
+
+func (s *olderMailSearchers) get(username string) (olderMailSearcher, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for _, searcher := range s.searchers {
+		if searcher.HasAddress(username) {
+			return searcher, true
+		}
+	}
+
+	return nil, false
+}
+
+// searchOlderMail fetches the messages older than the sync cutoff of the given user whose subject contains the given
+// text, before IMAP searches its mailboxes. It returns whether any message was added.
+func (sm *Service) searchOlderMail(username, subject string) bool {
+	searcher, ok := sm.olderMailSearchers.get(username)
+	if !ok {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), olderMailSearchTimeout)
+	defer cancel()
+
+	added, err := searcher.SearchOlderMail(ctx, subject)
+	if err != nil {
+		sm.log.WithError(err).Warn("Failed to search messages older than the sync cutoff")
+	}
+
+	if added == 0 {
+		return false
+	}
+
+	time.Sleep(olderMailSettleDelay)
+
+	return true
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// RekeyGluonStores re-encrypts the message bodies cached for the given gluon users with a new key.
+// The users must not be loaded in the IMAP server. The bodies are first written to temporary directories which then
+// replace the old ones; the commit function is called in between, typically to store the new key in the vault.
+// If bridge stops before the old stores are replaced, the swap is completed or rolled back when the stores are next
+// opened, depending on whether the commit went through; see finishRekey.
+// Bodies which can't be read with the old key are lost anyway and are skipped.
+func RekeyGluonStores(gluonCacheDir string, gluonIDs []string, oldKey, newKey []byte, commit func() error) error {
+	storeDir := ApplyGluonCachePathSuffix(gluonCacheDir)
+
+	var dirs []string
+
+	for _, gluonID := range gluonIDs {
+		dir := filepath.Join(storeDir, gluonID)
+
+		if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err := rekeyStore(dir, rekeyDir(dir), oldKey, newKey); err != nil {
+			for _, dir := range append(dirs, dir) {
+				if err := os.RemoveAll(rekeyDir(dir)); err != nil {
+					logIMAP.WithError(err).Warn("Failed to remove re-encrypted store")
+				}
+			}
+
+			return fmt.Errorf("failed to re-encrypt store of %v: %w", gluonID, err)
+		}
+
+		dirs = append(dirs, dir)
+	}
+
+	if err := commit(); err != nil {
+		for _, dir := range dirs {
+			if err := os.RemoveAll(rekeyDir(dir)); err != nil {
+				logIMAP.WithError(err).Warn("Failed to remove re-encrypted store")
+			}
+		}
+
+		return fmt.Errorf("failed to commit new store key: %w", err)
+	}
+
+	for _, dir := range dirs {
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf("failed to remove old store: %w", err)
+		}
+
+		if err := os.Rename(rekeyDir(dir), dir); err != nil {
+			return fmt.Errorf("failed to replace old store: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// rekeyStore copies the bodies of the store in oldDir, encrypted with oldKey, to a new store in newDir encrypted with newKey.
+func rekeyStore(oldDir, newDir string, oldKey, newKey []byte) error {
+	if err := os.RemoveAll(newDir); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(newDir, 0o700); err != nil {
+		return err
+	}
+
+	oldStore, err := newOnDiskStore(oldDir, oldKey)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := oldStore.Close(); err != nil {
+			logIMAP.WithError(err).Warn("Failed to close old store")
+		}
+	}()
+
+	newStore, err := newOnDiskStore(newDir, newKey)
+	if err != nil {
+		return err
+	}
+
+	ids, err := oldStore.List()
+	if err != nil {
+		_ = newStore.Close()
+		return err
+	}
+
+	for _, id := range ids {
+		literal, err := oldStore.Get(id)
+		if err != nil {
+			logIMAP.WithError(err).WithField("messageID", id.String()).Warn("Failed to read cached message body, skipping")
+			continue
+		}
+
+		if err := newStore.Set(id, bytes.NewReader(literal)); err != nil {
+			_ = newStore.Close()
+			return err
+		}
+	}
+
+	return newStore.Close()
+}
+
+// finishRekey completes or rolls back a re-encryption of the store in dir which was interrupted.
+// The re-encrypted store replaces the old one if its key was committed, i.e. if its bodies can be read with
+// the given current key; otherwise it is dropped and the old store is kept.
+func finishRekey(dir string, passphrase []byte) error {
+	newDir := rekeyDir(dir)
+
+	if _, err := os.Stat(newDir); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	committed, err := canReadStore(newDir, passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to check re-encrypted store: %w", err)
+	}
+
+	if !committed {
+		logIMAP.WithField("dir", dir).Warn("Dropping re-encrypted store whose key was not committed")
+
+		return os.RemoveAll(newDir)
+	}
+
+	logIMAP.WithField("dir", dir).Warn("Completing interrupted store re-encryption")
+
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to remove old store: %w", err)
+	}
+
+	if err := os.Rename(newDir, dir); err != nil {
+		return fmt.Errorf("failed to replace old store: %w", err)
+	}
+
+	return nil
+}
+
+// canReadStore returns whether a body of the store in dir can be read with the given key.
+// An empty store can't be read with any key.
+func canReadStore(dir string, passphrase []byte) (bool, error) {
+	s, err := newOnDiskStore(dir, passphrase)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		if err := s.Close(); err != nil {
+			logIMAP.WithError(err).Warn("Failed to close store")
+		}
+	}()
+
+	ids, err := s.List()
+	if err != nil {
+		return false, err
+	}
+
+	for _, id := range ids {
+		if _, err := s.Get(id); err == nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func rekeyDir(dir string) string {
+	return dir + ".rekey"
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRekeyGluonStores_Interrupted(t *testing.T) {
+	oldKey, newKey := []byte("old key"), []byte("new key")
+
+	tests := []struct {
+		name      string
+		committed bool
+		oldKept   bool
+	}{
+		{name: "before commit", committed: false, oldKept: true},
+		{name: "after commit", committed: true, oldKept: true},
+		{name: "after removing the old store", committed: true, oldKept: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cacheDir := t.TempDir()
+			dir := filepath.Join(ApplyGluonCachePathSuffix(cacheDir), "gluonID")
+
+			id := imap.NewInternalMessageID()
+			writeStore(t, dir, oldKey, id, []byte("Hello!"))
+
+			// Bridge stopped after re-encrypting the store, before it replaced the old one.
+			require.NoError(t, rekeyStore(dir, rekeyDir(dir), oldKey, newKey))
+
+			if !test.oldKept {
+				require.NoError(t, os.RemoveAll(dir))
+			}
+
+			key := oldKey
+			if test.committed {
+				key = newKey
+			}
+
+			s, err := (&storeBuilder{limiter: newCacheLimiter(0, 0)}).New(ApplyGluonCachePathSuffix(cacheDir), "gluonID", key)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, s.Close()) }()
+
+			require.NoDirExists(t, rekeyDir(dir))
+			require.Equal(t, []byte("Hello!"), must(s.Get(id)))
+		})
+	}
+}
+
+func writeStore(t *testing.T, dir string, key []byte, id imap.InternalMessageID, literal []byte) {
+	s, err := newOnDiskStore(dir, key)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Set(id, bytes.NewReader(literal)))
+	require.NoError(t, s.Close())
+}
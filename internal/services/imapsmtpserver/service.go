@@ -19,9 +19,11 @@ package imapsmtpserver
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"path/filepath"
+	"time"
 
 	"github.com/ProtonMail/gluon"
 	"github.com/ProtonMail/gluon/async"
@@ -43,12 +45,14 @@ import (
 type Service struct {
 	requests *cpc.CPC
 
-	imapServer   *gluon.Server
-	imapListener net.Listener
+	imapServer      *gluon.Server
+	imapListener    net.Listener
+	imapTLSListener net.Listener
 
-	smtpServer   *smtp.Server
-	smtpListener net.Listener
-	smtpAccounts *bridgesmtp.Accounts
+	smtpServer      *smtp.Server
+	smtpListener    net.Listener
+	smtpTLSListener net.Listener
+	smtpAccounts    *bridgesmtp.Accounts
 
 	smtpSettings   SMTPSettingsProvider
 	imapSettings   IMAPSettingsProvider
@@ -63,6 +67,20 @@ type Service struct {
 	telemetry            Telemetry
 
 	observabilitySender observability.Sender
+
+	// activity is notified of the data clients send, which keeps bridge from locking itself.
+	activity ActivityNotifier
+
+	// listen creates the listeners of the servers.
+	listen ListenFunc
+
+	// imapTraffic counts the bytes transferred over the IMAP connections for the session audit.
+	imapTraffic *trafficRegistry
+
+	cacheLimiter *cacheLimiter
+
+	// olderMailSearchers fetch the messages older than the sync cutoff that IMAP clients search for.
+	olderMailSearchers *olderMailSearchers
 }
 
 func NewService(
@@ -75,7 +93,13 @@ func NewService(
 	uidValidityGenerator imap.UIDValidityGenerator,
 	telemetry Telemetry,
 	observabilitySender observability.Sender,
+	activity ActivityNotifier,
+	listen ListenFunc,
 ) *Service {
+	if listen == nil {
+		listen = net.Listen
+	}
+
 	return &Service{
 		requests:     cpc.NewCPC(),
 		smtpAccounts: bridgesmtp.NewAccounts(),
@@ -91,6 +115,13 @@ func NewService(
 		telemetry:            telemetry,
 
 		observabilitySender: observabilitySender,
+		activity:            activity,
+		listen:              listen,
+		imapTraffic:         newTrafficRegistry(),
+
+		cacheLimiter: newCacheLimiter(imapSettings.MaxCacheSize(), keepRecentWindow(imapSettings.KeepRecentDays())),
+
+		olderMailSearchers: newOlderMailSearchers(),
 	}
 }
 
@@ -133,6 +164,11 @@ func (sm *Service) CloseServers(ctx context.Context) error {
 	return err
 }
 
+// FlushSMTP waits for the mail currently being sent over SMTP to go out.
+func (sm *Service) FlushSMTP(ctx context.Context) error {
+	return sm.smtpAccounts.Flush(ctx)
+}
+
 func (sm *Service) RestartIMAP(ctx context.Context) error {
 	_, err := sm.requests.Send(ctx, &smRequestRestartIMAP{})
 
@@ -162,6 +198,49 @@ func (sm *Service) AddIMAPUser(
 	return err
 }
 
+// PrepareGluonDir copies the gluon cache to the given directory without interrupting the IMAP server.
+// Calling SetGluonDir afterwards then only has to transfer what changed in between, keeping the downtime short.
+func (sm *Service) PrepareGluonDir(gluonDir string) error {
+	currentGluonDir := sm.imapSettings.CacheDirectory()
+	newGluonDir := filepath.Join(gluonDir, "gluon")
+
+	if newGluonDir == currentGluonDir {
+		return fmt.Errorf("new gluon dir is the same as the old one")
+	}
+
+	return prepareGluonCacheDir(currentGluonDir, newGluonDir)
+}
+
+// ClaimIMAPTraffic returns the bytes transferred over the IMAP connection from the given remote address.
+// The traffic of a connection can only be claimed once.
+func (sm *Service) ClaimIMAPTraffic(remoteAddr string) (*Traffic, bool) {
+	return sm.imapTraffic.claim(remoteAddr)
+}
+
+// SetMaxCacheSize changes the disk space cached message bodies may use, evicting the least recently used ones
+// that no longer fit. Zero means unlimited.
+func (sm *Service) SetMaxCacheSize(maxSize uint64) {
+	sm.cacheLimiter.setLimit(maxSize)
+}
+
+// SetKeepRecentDays changes how many days of recent messages have their bodies kept in the cache regardless of
+// its limit. Zero means none.
+// The evicted bodies of the messages that entered the window are downloaded again in the background.
+func (sm *Service) SetKeepRecentDays(days uint32) {
+	if sm.cacheLimiter.setKeep(keepRecentWindow(days)) {
+		sm.tasks.Once(sm.cacheLimiter.refreshAll)
+	}
+}
+
+func keepRecentWindow(days uint32) time.Duration {
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// GetCacheSize returns the disk space currently used by cached message bodies.
+func (sm *Service) GetCacheSize() uint64 {
+	return sm.cacheLimiter.getSize()
+}
+
 func (sm *Service) SetGluonDir(ctx context.Context, gluonDir string) error {
 	_, err := sm.requests.Send(ctx, &smRequestSetGluonDir{
 		dir: gluonDir,
@@ -326,7 +405,8 @@ func (sm *Service) handleAddIMAPUserImpl(ctx context.Context,
 	})
 	log.Info("Adding user to imap server")
 
-	if gluonID, ok := idProvider.GetGluonID(addrID); ok {
+	gluonID, ok := idProvider.GetGluonID(addrID)
+	if ok {
 		log.WithField("gluonID", gluonID).Info("Loading existing IMAP user")
 
 		// Load the user, checking whether the DB was newly created.
@@ -371,8 +451,7 @@ func (sm *Service) handleAddIMAPUserImpl(ctx context.Context,
 					return fmt.Errorf("failed to remove old IMAP user ID: %w", err)
 				}
 
-				gluonID, err := sm.imapServer.AddUser(ctx, connector, idProvider.GluonKey())
-				if err != nil {
+				if gluonID, err = sm.imapServer.AddUser(ctx, connector, idProvider.GluonKey()); err != nil {
 					return fmt.Errorf("failed to add IMAP user: %w", err)
 				}
 
@@ -391,18 +470,28 @@ func (sm *Service) handleAddIMAPUserImpl(ctx context.Context,
 			return fmt.Errorf("failed to reset sync status: %w", err)
 		}
 
-		gluonID, err := sm.imapServer.AddUser(ctx, connector, idProvider.GluonKey())
+		newGluonID, err := sm.imapServer.AddUser(ctx, connector, idProvider.GluonKey())
 		if err != nil {
 			return fmt.Errorf("failed to add IMAP user: %w", err)
 		}
 
-		if err := idProvider.SetGluonID(addrID, gluonID); err != nil {
+		if err := idProvider.SetGluonID(addrID, newGluonID); err != nil {
 			return fmt.Errorf("failed to set IMAP user ID: %w", err)
 		}
 
-		log.WithField("gluonID", gluonID).Info("Created new IMAP user")
+		log.WithField("gluonID", newGluonID).Info("Created new IMAP user")
+
+		gluonID = newGluonID
 	}
 
+	// Bodies cached before they had a journal are inspected, and the evicted bodies of recent messages downloaded again.
+	sm.cacheLimiter.setFetcher(gluonID, connector)
+	sm.olderMailSearchers.set(gluonID, connector)
+
+	sm.tasks.Once(func(ctx context.Context) {
+		sm.cacheLimiter.refresh(ctx, gluonID)
+	})
+
 	return nil
 }
 
@@ -427,6 +516,8 @@ func (sm *Service) handleRemoveIMAPUser(ctx context.Context, withData bool, idPr
 			return fmt.Errorf("failed to remove IMAP user: %w", err)
 		}
 
+		sm.olderMailSearchers.remove(gluonID)
+
 		if withData {
 			if err := idProvider.RemoveGluonID(addrID, gluonID); err != nil {
 				return fmt.Errorf("failed to remove IMAP user ID: %w", err)
@@ -451,12 +542,14 @@ func (sm *Service) createIMAPServer(ctx context.Context) (*gluon.Server, error)
 		sm.reporter,
 		sm.imapSettings.LogClient(),
 		sm.imapSettings.LogServer(),
+		sm.imapSettings.SessionAudit(),
 		sm.imapSettings.DisableIMAPAuthenticate(),
 		sm.imapSettings.EventPublisher(),
 		sm.tasks,
 		sm.uidValidityGenerator,
 		sm.panicHandler,
 		sm.observabilitySender,
+		sm.cacheLimiter,
 	)
 	if err == nil {
 		sm.eventPublisher.PublishEvent(ctx, events.IMAPServerCreated{})
@@ -484,6 +577,10 @@ func (sm *Service) closeSMTPServer(ctx context.Context) error {
 		sm.smtpListener = nil
 	}
 
+	if err := sm.closeSMTPImplicitTLSListener(); err != nil {
+		return err
+	}
+
 	if sm.smtpServer != nil {
 		sm.log.Info("Closing SMTP server")
 		if err := sm.smtpServer.Close(); err != nil {
@@ -511,6 +608,10 @@ func (sm *Service) closeIMAPServer(ctx context.Context) error {
 		sm.eventPublisher.PublishEvent(ctx, events.IMAPServerStopped{})
 	}
 
+	if err := sm.closeIMAPImplicitTLSListener(); err != nil {
+		return err
+	}
+
 	if sm.imapServer != nil {
 		sm.log.Info("Closing IMAP server")
 		if err := sm.imapServer.Close(ctx); err != nil {
@@ -538,6 +639,10 @@ func (sm *Service) restartIMAP(ctx context.Context) error {
 		sm.eventPublisher.PublishEvent(ctx, events.IMAPServerStopped{})
 	}
 
+	if err := sm.closeIMAPImplicitTLSListener(); err != nil {
+		return err
+	}
+
 	return sm.serveIMAP(ctx)
 }
 
@@ -558,11 +663,12 @@ func (sm *Service) restartSMTP(ctx context.Context) error {
 func (sm *Service) serveSMTP(ctx context.Context) error {
 	port, err := func() (int, error) {
 		sm.log.WithFields(logrus.Fields{
+			"host": sm.smtpSettings.BindAddress(),
 			"port": sm.smtpSettings.Port(),
 			"ssl":  sm.smtpSettings.UseSSL(),
 		}).Info("Starting SMTP server")
 
-		smtpListener, err := newListener(sm.smtpSettings.Port(), sm.smtpSettings.UseSSL(), sm.smtpSettings.TLSConfig())
+		smtpListener, err := newListener(sm.listen, sm.smtpSettings.BindAddress(), sm.smtpSettings.Port(), sm.smtpSettings.UseSSL(), sm.smtpSettings.TLSConfig(), sm.activity, nil)
 		if err != nil {
 			return 0, fmt.Errorf("failed to create SMTP listener: %w", err)
 		}
@@ -579,6 +685,10 @@ func (sm *Service) serveSMTP(ctx context.Context) error {
 			return 0, fmt.Errorf("failed to store SMTP port in vault: %w", err)
 		}
 
+		if err := sm.serveSMTPImplicitTLS(); err != nil {
+			sm.log.WithError(err).Error("Failed to start implicit TLS SMTP listener")
+		}
+
 		return getPort(smtpListener.Addr()), nil
 	}()
 
@@ -604,16 +714,24 @@ func (sm *Service) serveIMAP(ctx context.Context) error {
 		}
 
 		sm.log.WithFields(logrus.Fields{
+			"host": sm.imapSettings.BindAddress(),
 			"port": sm.imapSettings.Port(),
 			"ssl":  sm.imapSettings.UseSSL(),
 		}).Info("Starting IMAP server")
 
-		imapListener, err := newListener(sm.imapSettings.Port(), sm.imapSettings.UseSSL(), sm.imapSettings.TLSConfig())
+		imapListener, err := newListener(sm.listen, sm.imapSettings.BindAddress(), sm.imapSettings.Port(), sm.imapSettings.UseSSL(), sm.imapSettings.TLSConfig(), sm.activity, sm.imapTraffic)
 		if err != nil {
 			return 0, fmt.Errorf("failed to create IMAP listener: %w", err)
 		}
 
-		sm.imapListener = imapListener
+		// Connections over implicit TLS are already in the clear for the wrapper.
+		var startTLSConfig *tls.Config
+
+		if !sm.imapSettings.UseSSL() {
+			startTLSConfig = sm.imapSettings.TLSConfig()
+		}
+
+		sm.imapListener = newIMAPListener(imapListener, startTLSConfig, sm.appendLimit, sm.searchOlderMail)
 
 		if err := sm.imapServer.Serve(ctx, sm.imapListener); err != nil {
 			return 0, fmt.Errorf("failed to serve IMAP: %w", err)
@@ -623,6 +741,10 @@ func (sm *Service) serveIMAP(ctx context.Context) error {
 			return 0, fmt.Errorf("failed to store IMAP port in vault: %w", err)
 		}
 
+		if err := sm.serveIMAPImplicitTLS(ctx); err != nil {
+			sm.log.WithError(err).Error("Failed to start implicit TLS IMAP listener")
+		}
+
 		return getPort(imapListener.Addr()), nil
 	}()
 
@@ -653,6 +775,99 @@ func (sm *Service) stopIMAPListener(ctx context.Context) error {
 		sm.eventPublisher.PublishEvent(ctx, events.IMAPServerStopped{})
 	}
 
+	return sm.closeIMAPImplicitTLSListener()
+}
+
+// appendLimit returns the append limit of the account the given username belongs to, for IMAP to advertise.
+func (sm *Service) appendLimit(username string) (uint64, bool) {
+	return sm.smtpAccounts.AppendLimit(context.Background(), username)
+}
+
+// serveIMAPImplicitTLS starts the optional implicit TLS listener served by the same IMAP server as the main one.
+func (sm *Service) serveIMAPImplicitTLS(ctx context.Context) error {
+	port := sm.imapSettings.ImplicitTLSPort()
+	if port == 0 {
+		return nil
+	}
+
+	sm.log.WithFields(logrus.Fields{
+		"host": sm.imapSettings.BindAddress(),
+		"port": port,
+	}).Info("Starting implicit TLS IMAP listener")
+
+	listener, err := newListener(sm.listen, sm.imapSettings.BindAddress(), port, true, sm.imapSettings.TLSConfig(), sm.activity, sm.imapTraffic)
+	if err != nil {
+		return fmt.Errorf("failed to create implicit TLS IMAP listener: %w", err)
+	}
+
+	sm.imapTLSListener = newIMAPListener(listener, nil, sm.appendLimit, sm.searchOlderMail)
+
+	if err := sm.imapServer.Serve(ctx, sm.imapTLSListener); err != nil {
+		return fmt.Errorf("failed to serve implicit TLS IMAP: %w", err)
+	}
+
+	return nil
+}
+
+func (sm *Service) closeIMAPImplicitTLSListener() error {
+	if sm.imapTLSListener == nil {
+		return nil
+	}
+
+	sm.log.Info("Closing implicit TLS IMAP listener")
+
+	if err := sm.imapTLSListener.Close(); err != nil {
+		return fmt.Errorf("failed to close implicit TLS IMAP listener: %w", err)
+	}
+
+	sm.imapTLSListener = nil
+
+	return nil
+}
+
+// serveSMTPImplicitTLS starts the optional implicit TLS listener served by the same SMTP server as the main one.
+func (sm *Service) serveSMTPImplicitTLS() error {
+	port := sm.smtpSettings.ImplicitTLSPort()
+	if port == 0 {
+		return nil
+	}
+
+	sm.log.WithFields(logrus.Fields{
+		"host": sm.smtpSettings.BindAddress(),
+		"port": port,
+	}).Info("Starting implicit TLS SMTP listener")
+
+	listener, err := newListener(sm.listen, sm.smtpSettings.BindAddress(), port, true, sm.smtpSettings.TLSConfig(), sm.activity, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create implicit TLS SMTP listener: %w", err)
+	}
+
+	sm.smtpTLSListener = listener
+
+	smtpServer := sm.smtpServer
+
+	sm.tasks.Once(func(context.Context) {
+		if err := smtpServer.Serve(listener); err != nil {
+			sm.log.WithError(err).Info("Implicit TLS SMTP listener stopped")
+		}
+	})
+
+	return nil
+}
+
+func (sm *Service) closeSMTPImplicitTLSListener() error {
+	if sm.smtpTLSListener == nil {
+		return nil
+	}
+
+	sm.log.Info("Closing implicit TLS SMTP listener")
+
+	if err := sm.smtpTLSListener.Close(); err != nil {
+		return fmt.Errorf("failed to close implicit TLS SMTP listener: %w", err)
+	}
+
+	sm.smtpTLSListener = nil
+
 	return nil
 }
 
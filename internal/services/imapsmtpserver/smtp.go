@@ -34,9 +34,12 @@ var logSMTP = logrus.WithField("pkg", "server/smtp") //nolint:gochecknoglobals
 type SMTPSettingsProvider interface {
 	TLSConfig() *tls.Config
 	Log() bool
+	BindAddress() string
 	Port() int
 	SetPort(int) error
 	UseSSL() bool
+	ImplicitTLSPort() int
+	RequireTLS() bool
 	Identifier() identifier.UserAgentUpdater
 }
 
@@ -47,7 +50,7 @@ func newSMTPServer(accounts *smtpservice.Accounts, settings SMTPSettingsProvider
 
 	smtpServer.TLSConfig = settings.TLSConfig()
 	smtpServer.Domain = constants.Host
-	smtpServer.AllowInsecureAuth = true
+	smtpServer.AllowInsecureAuth = !settings.RequireTLS()
 	smtpServer.MaxLineLength = 1 << 16
 	smtpServer.ErrorLog = logging.NewSMTPLogger()
 
@@ -58,12 +61,31 @@ func newSMTPServer(accounts *smtpservice.Accounts, settings SMTPSettingsProvider
 		})
 	})
 
+	// Clients that only do OAuth-style authentication present a local auth token minted by bridge.
+	authToken := func(conn *smtp.Conn) func(username, token string) error {
+		return func(username, token string) error {
+			return conn.Session().AuthPlain(username, token)
+		}
+	}
+
+	smtpServer.EnableAuth(saslXOAuth2, func(conn *smtp.Conn) sasl.Server {
+		return newXOAuth2Server(authToken(conn))
+	})
+
+	smtpServer.EnableAuth(saslOAuthBearer, func(conn *smtp.Conn) sasl.Server {
+		return newOAuthBearerServer(authToken(conn))
+	})
+
 	if settings.Log() {
 		logSMTP.Warning("================================================")
 		logSMTP.Warning("THIS LOG WILL CONTAIN **DECRYPTED** MESSAGE DATA")
 		logSMTP.Warning("================================================")
 
 		smtpServer.Debug = logging.NewSMTPDebugLogger()
+	} else {
+		// Otherwise, the SMTP activity is only logged while the SMTP subsystem is being traced.
+		// The trace writer stays attached and discards everything in the meantime.
+		smtpServer.Debug = logging.NewTraceWriter(logging.SubsystemSMTP, logging.NewSMTPDebugLogger())
 	}
 
 	return smtpServer
@@ -25,6 +25,7 @@ import (
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/telemetry"
+	bridgetelemetry "github.com/ProtonMail/proton-bridge/v3/internal/telemetry"
 	"github.com/sirupsen/logrus"
 )
 
@@ -175,6 +176,11 @@ func (s *Service) dispatchViaClient(metricsToSend *[]proton.ObservabilityMetric)
 	s.userClientStoreLock.Lock()
 	defer s.userClientStoreLock.Unlock()
 
+	if bridgetelemetry.IsReportingDisabled() {
+		s.log.Info("Could not send observability data. Reporting is disabled.")
+		return false
+	}
+
 	for _, value := range s.userClientStore {
 		if !value.isTelemetryEnabled(s.ctx) {
 			continue
@@ -216,6 +222,11 @@ func (s *Service) scheduleDispatch() {
 }
 
 func (s *Service) addMetrics(metric ...proton.ObservabilityMetric) {
+	// Metrics are not even stored while reporting is disabled.
+	if bridgetelemetry.IsReportingDisabled() {
+		return
+	}
+
 	s.withMetricStoreLock(func() {
 		metricStoreLength := len(s.metricStore)
 		if metricStoreLength >= maxStorageSize {
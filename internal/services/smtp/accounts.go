@@ -97,6 +97,44 @@ func (s *Accounts) SendMail(ctx context.Context, userID, addrID, from string, to
 	return err
 }
 
+// AppendLimit returns the append limit of the account the given email belongs to and whether such a limit is known.
+func (s *Accounts) AppendLimit(ctx context.Context, email string) (uint64, bool) {
+	s.accountsLock.RLock()
+	defer s.accountsLock.RUnlock()
+
+	for _, account := range s.accounts {
+		limit, err := account.service.appendLimit(ctx, email)
+		if err != nil {
+			continue
+		}
+
+		return limit.limit, limit.ok
+	}
+
+	return 0, false
+}
+
+// Flush waits for the mail currently being sent to go out.
+// Sending holds the accounts read lock, so acquiring the write lock means no send is in flight.
+func (s *Accounts) Flush(ctx context.Context) error {
+	doneCh := make(chan struct{})
+
+	go func() {
+		s.accountsLock.Lock()
+		defer s.accountsLock.Unlock()
+
+		close(doneCh)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+
+	case <-doneCh:
+		return nil
+	}
+}
+
 type smtpAccountState struct {
 	service    *Service
 	errTimeout time.Duration
@@ -18,6 +18,7 @@
 package smtp
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -44,3 +45,22 @@ func TestAccountTimeout(t *testing.T) {
 		return account.canMakeRequest(requestTime) == nil
 	}, 10*time.Second, time.Second)
 }
+
+func TestAccountsFlush(t *testing.T) {
+	accounts := NewAccounts()
+
+	// Nothing is being sent; flushing returns immediately.
+	assert.NoError(t, accounts.Flush(context.Background()))
+
+	// Simulate a send in progress.
+	accounts.accountsLock.RLock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, accounts.Flush(ctx), context.DeadlineExceeded)
+
+	accounts.accountsLock.RUnlock()
+
+	assert.NoError(t, accounts.Flush(context.Background()))
+}
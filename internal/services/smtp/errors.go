@@ -38,3 +38,13 @@ func NewErrCannotSendFromAddress(address string) *ErrCannotSendFromAddress {
 func (e ErrCannotSendFromAddress) Error() string {
 	return fmt.Sprintf("cannot send from address: %v", e.address)
 }
+
+// ErrMessageTooLarge is returned when the attachments of a message exceed the size the user can send.
+type ErrMessageTooLarge struct {
+	size  int64
+	limit int64
+}
+
+func (e ErrMessageTooLarge) Error() string {
+	return fmt.Sprintf("message attachments of %v bytes exceed the limit of %v bytes", e.size, e.limit)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/ProtonMail/gluon/logging"
 	"github.com/ProtonMail/gluon/reporter"
 	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	bridgelogging "github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/observability"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/orderedtasks"
@@ -58,6 +59,8 @@ type Service struct {
 	addressMode   usertypes.AddressMode
 	serverManager ServerManager
 
+	eventPublisher events.EventPublisher
+
 	observabilitySender observability.Sender
 }
 
@@ -73,6 +76,7 @@ func NewService(
 	mode usertypes.AddressMode,
 	identityState *useridentity.State,
 	serverManager ServerManager,
+	eventPublisher events.EventPublisher,
 	observabilitySender observability.Sender,
 ) *Service {
 	subscriberName := fmt.Sprintf("smpt-%v", userID)
@@ -99,6 +103,8 @@ func NewService(
 		addressMode:   mode,
 		serverManager: serverManager,
 
+		eventPublisher: eventPublisher,
+
 		observabilitySender: observabilitySender,
 	}
 }
@@ -114,6 +120,11 @@ func (s *Service) SendMail(ctx context.Context, authID string, from string, to [
 	return err
 }
 
+// appendLimit returns the append limit of the user if the given email is one of its addresses.
+func (s *Service) appendLimit(ctx context.Context, email string) (appendLimit, error) {
+	return cpc.SendTyped[appendLimit](ctx, s.cpc, &appendLimitReq{email: email})
+}
+
 func (s *Service) SetAddressMode(ctx context.Context, mode usertypes.AddressMode) error {
 	_, err := s.cpc.Send(ctx, &setAddressModeReq{mode: mode})
 
@@ -181,15 +192,23 @@ func (s *Service) HandleUserEvent(_ context.Context, user *proton.User) error {
 	return nil
 }
 
+func (s *Service) HandleUsedSpaceEvent(_ context.Context, newSpace int64) error {
+	s.log.Debug("Handling used space event")
+	s.identityState.OnUserSpaceChanged(uint64(newSpace)) //nolint:gosec // disable G115
+
+	return nil
+}
+
 func (s *Service) run(ctx context.Context) {
 	s.log.Info("Starting service main loop")
 	defer s.log.Info("Exiting service main loop")
 	defer s.cpc.Close()
 
 	eventHandler := userevents.EventHandler{
-		AddressHandler: s,
-		RefreshHandler: s,
-		UserHandler:    s,
+		AddressHandler:   s,
+		RefreshHandler:   s,
+		UserHandler:      s,
+		UsedSpaceHandler: s,
 	}
 
 	s.eventService.Subscribe(s.subscription)
@@ -211,6 +230,14 @@ func (s *Service) run(ctx context.Context) {
 				err := s.sendMail(ctx, r)
 				request.Reply(ctx, nil, err)
 
+			case *appendLimitReq:
+				if _, err := s.identityState.GetAddr(r.email); err != nil {
+					request.Reply(ctx, appendLimit{}, err)
+				} else {
+					limit, ok := s.identityState.AppendLimit()
+					request.Reply(ctx, appendLimit{limit: limit, ok: ok}, nil)
+				}
+
 			case *setAddressModeReq:
 				s.log.Debugf("Set address mode %v", r.mode)
 				s.addressMode = r.mode
@@ -264,12 +291,27 @@ func (s *Service) sendMail(ctx context.Context, req *sendMailReq) error {
 			s.log.WithError(apiErr).WithField("Details", apiErr.DetailsToString()).Error("failed to send message")
 		}
 
+		if tooLarge := new(ErrMessageTooLarge); errors.As(err, &tooLarge) {
+			s.eventPublisher.PublishEvent(ctx, events.MessageTooLarge{UserID: s.userID, Size: tooLarge.size, Limit: tooLarge.limit})
+		}
+
+		s.eventPublisher.PublishEvent(ctx, events.MessageSendFailed{UserID: s.userID, Error: err})
+
 		return err
 	}
 
 	return nil
 }
 
+type appendLimitReq struct {
+	email string
+}
+
+type appendLimit struct {
+	limit uint64
+	ok    bool
+}
+
 type setAddressModeReq struct {
 	mode usertypes.AddressMode
 }
@@ -38,6 +38,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/observability"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/sendrecorder"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/smtp/observabilitymetrics"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/useridentity"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/message/parser"
@@ -49,11 +50,6 @@ import (
 
 // smtpSendMail sends an email from the given address to the given recipients.
 func (s *Service) smtpSendMail(ctx context.Context, authID string, from string, to []string, r io.Reader) error {
-	fromAddr, err := s.identityState.GetAddr(from)
-	if err != nil {
-		return ErrInvalidReturnPath
-	}
-
 	emails := xslices.Map(s.identityState.AddressesSorted, func(addr proton.Address) string {
 		return addr.Email
 	})
@@ -94,20 +90,23 @@ func (s *Service) smtpSendMail(ctx context.Context, authID string, from string,
 	}
 
 	// If the message contains a sender, use it instead of the one from the return path.
-	if sender, ok := getMessageSender(parser); ok {
-		from = sender
-		fromAddr, err = s.identityState.GetAddr(from)
-		if err != nil {
-			logrus.WithError(err).Errorf("Failed to get identity for from address %v", sender)
-			return ErrInvalidReturnPath
-		}
+	from, fromAddr, err := getSenderAddress(s.identityState, from, parser)
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to get identity for from address %v", from)
+		s.recorder.RemoveOnFail(hash, srID)
+		return ErrInvalidReturnPath
 	}
 
 	if !fromAddr.Send || fromAddr.Status != proton.AddressStatusEnabled {
 		s.log.Errorf("Cannot send emails from address: %v", fromAddr.Email)
+		s.recorder.RemoveOnFail(hash, srID)
 		return &ErrCannotSendFromAddress{address: fromAddr.Email}
 	}
 
+	if fromAddr.ID != authID {
+		s.log.WithField("addrID", fromAddr.ID).Debug("Sending as a different address than the authenticated one")
+	}
+
 	// Load the user's mail settings.
 	settings, err := s.client.GetMailSettings(ctx)
 	if err != nil {
@@ -152,10 +151,16 @@ func (s *Service) smtpSendMail(ctx context.Context, authID string, from string,
 			return fmt.Errorf("failed to parse message: %w", err)
 		}
 
+		// Fail before uploading anything if the API would reject the attachments.
+		limit, ok := s.identityState.AppendLimit()
+		if err := checkMessageSize(message, limit, ok); err != nil {
+			return err
+		}
+
 		// Send the message using the correct key.
 		sent, err := s.sendWithKey(
 			ctx,
-			authID,
+			fromAddr.ID,
 			s.addressMode,
 			settings,
 			userKR, addrKR,
@@ -187,7 +192,7 @@ func (s *Service) smtpSendMail(ctx context.Context, authID string, from string,
 // sendWithKey sends the message with the given address key.
 func (s *Service) sendWithKey(
 	ctx context.Context,
-	authAddrID string,
+	sendAddrID string,
 	addrMode usertypes.AddressMode,
 	settings proton.MailSettings,
 	userKR, addrKR *crypto.KeyRing,
@@ -200,7 +205,7 @@ func (s *Service) sendWithKey(
 	if message.InReplyTo != "" {
 		references = append(references, message.InReplyTo)
 	}
-	parentID, draftsToDelete, err := getParentID(ctx, s.client, authAddrID, addrMode, references)
+	parentID, draftsToDelete, err := getParentID(ctx, s.client, sendAddrID, addrMode, references)
 	if err != nil {
 		s.observabilitySender.AddDistinctMetrics(observability.SMTPError, observabilitymetrics.GenerateFailedGetParentID())
 		s.log.WithError(err).Warn("Failed to get parent ID")
@@ -276,7 +281,7 @@ func (s *Service) sendWithKey(
 func getParentID(
 	ctx context.Context,
 	client *proton.Client,
-	authAddrID string,
+	sendAddrID string,
 	addrMode usertypes.AddressMode,
 	references []string,
 ) (string, []string, error) {
@@ -301,7 +306,7 @@ func getParentID(
 		var addrID string
 
 		if addrMode == usertypes.AddressModeSplit {
-			addrID = authAddrID
+			addrID = sendAddrID
 		}
 
 		metadata, err := client.GetMessageMetadata(ctx, proton.MessageFilter{
@@ -333,7 +338,7 @@ func getParentID(
 		var addrID string
 
 		if addrMode == usertypes.AddressModeSplit {
-			addrID = authAddrID
+			addrID = sendAddrID
 		}
 
 		metadata, err := client.GetMessageMetadata(ctx, proton.MessageFilter{
@@ -580,6 +585,37 @@ func getContactSettings(
 	return contact.GetSettings(userKR, recipient, proton.CardTypeSigned)
 }
 
+// checkMessageSize returns an error if the attachments of the message exceed the given append limit.
+// If the limit is not known, the check is skipped.
+func checkMessageSize(message message.Message, limit uint64, ok bool) error {
+	if !ok {
+		return nil
+	}
+
+	if size := message.AttachmentSize(); size > int64(limit) { //nolint:gosec // disable G115
+		return &ErrMessageTooLarge{size: size, limit: int64(limit)} //nolint:gosec // disable G115
+	}
+
+	return nil
+}
+
+// getSenderAddress returns the sender of the message and the address it belongs to. The sender is taken from the
+// From header if present, otherwise from the return path. Any address of the account can be used as sender,
+// regardless of the address used to authenticate.
+func getSenderAddress(identityState *useridentity.State, returnPath string, parser *parser.Parser) (string, proton.Address, error) {
+	sender, ok := getMessageSender(parser)
+	if !ok {
+		sender = returnPath
+	}
+
+	addr, err := identityState.GetAddr(sender)
+	if err != nil {
+		return sender, proton.Address{}, err
+	}
+
+	return sender, addr, nil
+}
+
 func getMessageSender(parser *parser.Parser) (string, bool) {
 	address, err := rfc5322.ParseAddressList(parser.Root().Header.Get("From"))
 	if err != nil {
@@ -111,5 +111,18 @@ func (s *smtpSession) Data(r io.Reader) error {
 		logrus.WithField("pkg", "smtp").WithError(err).Error("Send mail failed.")
 	}
 
+	return toSMTPError(err)
+}
+
+// toSMTPError reports oversized messages with a permanent error the client can explain to the user.
+func toSMTPError(err error) error {
+	if tooLarge := new(ErrMessageTooLarge); errors.As(err, &tooLarge) {
+		return &smtp.SMTPError{
+			Code:         552,
+			EnhancedCode: smtp.EnhancedCode{5, 3, 4},
+			Message:      tooLarge.Error(),
+		}
+	}
+
 	return err
 }
@@ -0,0 +1,117 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package smtp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/useridentity"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/message/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSenderAddress(t *testing.T) {
+	state := useridentity.NewState(proton.User{ID: "user"}, []proton.Address{
+		{ID: "addr-1", Email: "primary@pm.me", Status: proton.AddressStatusEnabled, Send: true, Order: 0},
+		{ID: "addr-2", Email: "alias@pm.me", Status: proton.AddressStatusEnabled, Send: true, Order: 1},
+	}, nil)
+
+	tests := []struct {
+		name       string
+		returnPath string
+		literal    string
+		wantSender string
+		wantAddrID string
+		wantErr    bool
+	}{
+		{
+			name:       "sender from return path",
+			returnPath: "primary@pm.me",
+			literal:    "Subject: test\r\n\r\nbody",
+			wantSender: "primary@pm.me",
+			wantAddrID: "addr-1",
+		},
+		{
+			name:       "from header of another address",
+			returnPath: "primary@pm.me",
+			literal:    "From: Alias <alias@pm.me>\r\nSubject: test\r\n\r\nbody",
+			wantSender: "alias@pm.me",
+			wantAddrID: "addr-2",
+		},
+		{
+			name:       "from header with unknown return path",
+			returnPath: "unknown@example.com",
+			literal:    "From: alias+tag@pm.me\r\nSubject: test\r\n\r\nbody",
+			wantSender: "alias+tag@pm.me",
+			wantAddrID: "addr-2",
+		},
+		{
+			name:       "from header not owned by the account",
+			returnPath: "primary@pm.me",
+			literal:    "From: other@example.com\r\nSubject: test\r\n\r\nbody",
+			wantErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := parser.New(strings.NewReader(test.literal))
+			require.NoError(t, err)
+
+			sender, addr, err := getSenderAddress(state, test.returnPath, p)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.wantSender, sender)
+			require.Equal(t, test.wantAddrID, addr.ID)
+		})
+	}
+}
+
+func TestCheckMessageSize(t *testing.T) {
+	msg := message.Message{
+		Attachments: []message.Attachment{
+			{Name: "a.bin", Data: make([]byte, 600)},
+			{Name: "b.bin", Data: make([]byte, 400)},
+		},
+	}
+
+	// No limit provided by the API.
+	require.NoError(t, checkMessageSize(msg, 0, false))
+
+	// Attachments within the limit.
+	require.NoError(t, checkMessageSize(msg, 1000, true))
+
+	// No space left in the user's storage.
+	require.Error(t, checkMessageSize(msg, 0, true))
+
+	// Attachments above the limit.
+	err := checkMessageSize(msg, 999, true)
+	require.Error(t, err)
+
+	tooLarge := new(ErrMessageTooLarge)
+	require.ErrorAs(t, err, &tooLarge)
+	require.Equal(t, int64(1000), tooLarge.size)
+	require.Equal(t, int64(999), tooLarge.limit)
+}
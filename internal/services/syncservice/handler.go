@@ -20,10 +20,12 @@ package syncservice
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
 	"github.com/ProtonMail/proton-bridge/v3/internal/network"
 	"github.com/sirupsen/logrus"
 )
@@ -45,6 +47,8 @@ type Handler struct {
 	syncFinishedCh chan error
 	panicHandler   async.PanicHandler
 	downloadCache  *DownloadCache
+	cutoff         atomic.Int64
+	clock          clock.Clock
 }
 
 func NewHandler(
@@ -54,6 +58,7 @@ func NewHandler(
 	state StateProvider,
 	log *logrus.Entry,
 	panicHandler async.PanicHandler,
+	clock clock.Clock,
 ) *Handler {
 	return &Handler{
 		client:         client,
@@ -65,6 +70,7 @@ func NewHandler(
 		regulator:      regulator,
 		panicHandler:   panicHandler,
 		downloadCache:  newDownloadCache(),
+		clock:          clock,
 	}
 }
 
@@ -81,6 +87,12 @@ func (t *Handler) Cancel() {
 	t.group.Cancel()
 }
 
+// SetCutoff sets the time (unix) before which messages are not synced; zero means all messages are.
+// It applies to the syncs started from now on.
+func (t *Handler) SetCutoff(cutoff int64) {
+	t.cutoff.Store(cutoff)
+}
+
 func (t *Handler) OnSyncFinishedCH() <-chan error {
 	return t.syncFinishedCh
 }
@@ -105,7 +117,7 @@ func (t *Handler) Execute(
 				break
 			} else if err = t.run(ctx, syncReporter, labels, updateApplier, messageBuilder); err != nil {
 				t.log.WithError(err).Error("Failed to sync, will retry later")
-				sleepCtx(ctx, coolDown)
+				clock.Sleep(ctx, t.clock, coolDown)
 			} else {
 				break
 			}
@@ -209,6 +221,7 @@ func (t *Handler) run(ctx context.Context,
 
 		stageContext.metadataFetched = syncStatus.NumSyncedMessages
 		stageContext.totalMessageCount = syncStatus.TotalMessageCount
+		stageContext.cutoff = t.cutoff.Load()
 
 		if err := t.regulator.Sync(ctx, stageContext); err != nil {
 			stageContext.onError(err)
@@ -46,6 +46,14 @@ type Status struct {
 	LastSyncedMessageID string
 	NumSyncedMessages   int64
 	TotalMessageCount   int64
+
+	// ReusedAddressID is set when the messages of this address are already present in the IMAP database, e.g. after
+	// an address mode change. The metadata stage skips them instead of downloading them again.
+	ReusedAddressID string
+
+	// SyncedSince is set when the sync window is widened after the messages were synced: the messages from this time
+	// on (unix) are already present in the IMAP database. The metadata stage skips them instead of downloading them again.
+	SyncedSince int64
 }
 
 func DefaultStatus() Status {
@@ -53,6 +53,9 @@ type Job struct {
 
 	metadataFetched   int64
 	totalMessageCount int64
+
+	// cutoff is the time (unix) before which messages are not synced; zero means all messages are.
+	cutoff int64
 }
 
 func NewJob(ctx context.Context,
@@ -116,6 +119,16 @@ func (j *Job) onJobFinished(ctx context.Context, lastMessageID string, count int
 	j.syncReporter.OnProgress(ctx, count)
 }
 
+// UserID returns the ID of the user whose messages the job syncs.
+func (j *Job) UserID() string {
+	return j.userID
+}
+
+// Done returns a channel which is closed once all the work of the job has completed, successfully or not.
+func (j *Job) Done() <-chan struct{} {
+	return j.jw.finishedCh
+}
+
 // begin is expected to be called once the job enters the pipeline.
 func (j *Job) begin() {
 	j.log.Info("Job started")
@@ -234,6 +247,7 @@ type jobWaiterMessagePair struct {
 type jobWaiter struct {
 	ch           chan jobWaiterMessagePair
 	doneCh       chan error
+	finishedCh   chan struct{}
 	log          *logrus.Entry
 	panicHandler async.PanicHandler
 }
@@ -242,6 +256,7 @@ func newJobWaiter(log *logrus.Entry, panicHandler async.PanicHandler) *jobWaiter
 	return &jobWaiter{
 		ch:           make(chan jobWaiterMessagePair),
 		doneCh:       make(chan error, 2),
+		finishedCh:   make(chan struct{}),
 		log:          log,
 		panicHandler: panicHandler,
 	}
@@ -276,6 +291,7 @@ func (j *jobWaiter) begin() {
 		defer func() {
 			j.doneCh <- err
 			close(j.doneCh)
+			close(j.finishedCh)
 		}()
 
 		for {
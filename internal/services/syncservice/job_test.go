@@ -230,6 +230,31 @@ func TestJob_WithoutChildJobsCanBeTerminated(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJob_DoneIsClosedOnceFinished(t *testing.T) {
+	options := setupGoLeak()
+	defer goleak.VerifyNone(t, options)
+
+	mockCtrl := gomock.NewController(t)
+
+	tj := newTestJob(context.Background(), mockCtrl, "u", getTestLabels())
+	require.Equal(t, "u", tj.job.UserID())
+
+	select {
+	case <-tj.job.Done():
+		t.Fatal("job should not be done before it ends")
+	default:
+	}
+
+	go func() {
+		tj.job.begin()
+		tj.job.end()
+	}()
+
+	<-tj.job.Done()
+
+	require.NoError(t, tj.job.waitAndClose(context.Background()))
+}
+
 type tjob struct {
 	job            *Job
 	client         *MockAPIClient
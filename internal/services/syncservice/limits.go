@@ -28,6 +28,10 @@ const Kilobyte = uint64(1024)
 const Megabyte = 1024 * Kilobyte
 const Gigabyte = 1024 * Megabyte
 
+// buildMemFactor is how many times the size of a downloaded batch is reserved from the memory budget, as the encrypted
+// data is still held while the decrypted attachments and the built literals are being produced.
+const buildMemFactor = 2
+
 func toMB(v uint64) float64 {
 	return float64(v) / float64(Megabyte)
 }
@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/membudget"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/observability"
 )
 
@@ -32,6 +33,7 @@ type Service struct {
 	buildStage    *BuildStage
 	applyStage    *ApplyStage
 	limits        syncLimits
+	memBudget     *membudget.Budget
 	metaCh        *ChannelConsumerProducer[*Job]
 	group         *async.Group
 }
@@ -39,8 +41,10 @@ type Service struct {
 func NewService(
 	panicHandler async.PanicHandler,
 	observabilitySender observability.Sender,
+	maxSyncMemory uint64,
 ) *Service {
-	limits := newSyncLimits(2 * Gigabyte)
+	limits := newSyncLimits(maxSyncMemory)
+	memBudget := membudget.New(limits.MaxSyncMemory)
 
 	metaCh := NewChannelConsumerProducer[*Job]()
 	downloadCh := NewChannelConsumerProducer[DownloadRequest]()
@@ -49,8 +53,9 @@ func NewService(
 
 	return &Service{
 		limits:        limits,
+		memBudget:     memBudget,
 		metadataStage: NewMetadataStage(metaCh, downloadCh, limits.DownloadRequestMem, panicHandler),
-		downloadStage: NewDownloadStage(downloadCh, buildCh, limits.MaxParallelDownloads, panicHandler),
+		downloadStage: NewDownloadStage(downloadCh, buildCh, limits.MaxParallelDownloads, memBudget, panicHandler),
 		buildStage:    NewBuildStage(buildCh, applyCh, limits.MessageBuildMem, panicHandler, observabilitySender),
 		applyStage:    NewApplyStage(applyCh),
 		metaCh:        metaCh,
@@ -65,6 +70,17 @@ func (s *Service) Run() {
 	s.applyStage.Run(s.group)
 }
 
+// MemoryBudget returns the memory budget shared by all syncs, which can also be used by other memory hungry work
+// so that it waits for syncs instead of adding to them.
+func (s *Service) MemoryBudget() *membudget.Budget {
+	return s.memBudget
+}
+
+// SetMaxSyncMemory changes the size of the memory budget. The batch sizes of the stages are only updated on restart.
+func (s *Service) SetMaxSyncMemory(maxSyncMemory uint64) {
+	s.memBudget.SetLimit(newSyncLimits(maxSyncMemory).MaxSyncMemory)
+}
+
 func (s *Service) Sync(ctx context.Context, stage *Job) error {
 	return s.metaCh.Produce(ctx, stage)
 }
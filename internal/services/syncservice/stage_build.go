@@ -28,6 +28,7 @@ import (
 	"github.com/ProtonMail/gluon/logging"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/membudget"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/observability"
 	obsMetrics "github.com/ProtonMail/proton-bridge/v3/internal/services/syncservice/observabilitymetrics"
 	"github.com/bradenaw/juniper/parallel"
@@ -38,6 +39,9 @@ import (
 type BuildRequest struct {
 	childJob
 	batch []proton.FullMessage
+
+	// reservation is the part of the memory budget held by the batch, released once it has been built.
+	reservation *membudget.Reservation
 }
 
 type BuildStageInput = StageInputConsumer[BuildRequest]
@@ -101,10 +105,13 @@ func (b *BuildStage) run(ctx context.Context) {
 		}
 
 		if req.checkCancelled() {
+			req.reservation.Release()
 			continue
 		}
 
 		if len(req.batch) == 0 {
+			req.reservation.Release()
+
 			// it is possible that if one does a mass delete on another client an entire download batch fails,
 			// and we reach this point without any messages to build.
 			req.onStageCompleted(ctx)
@@ -202,6 +209,9 @@ func (b *BuildStage) run(ctx context.Context) {
 
 			return nil
 		})
+
+		req.reservation.Release()
+
 		if err != nil {
 			req.job.onError(err)
 		}
@@ -27,6 +27,7 @@ import (
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/gluon/logging"
 	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/membudget"
 	"github.com/ProtonMail/proton-bridge/v3/internal/network"
 	"github.com/bradenaw/juniper/parallel"
 	"github.com/bradenaw/juniper/xslices"
@@ -35,7 +36,8 @@ import (
 
 type DownloadRequest struct {
 	childJob
-	ids []string
+	ids          []string
+	expectedSize uint64
 }
 
 type DownloadStageInput = StageInputConsumer[DownloadRequest]
@@ -47,6 +49,7 @@ type DownloadStage struct {
 	input                DownloadStageInput
 	output               DownloadStageOutput
 	maxParallelDownloads int
+	memBudget            *membudget.Budget
 	panicHandler         async.PanicHandler
 	log                  *logrus.Entry
 }
@@ -55,12 +58,14 @@ func NewDownloadStage(
 	input DownloadStageInput,
 	output DownloadStageOutput,
 	maxParallelDownloads int,
+	memBudget *membudget.Budget,
 	panicHandler async.PanicHandler,
 ) *DownloadStage {
 	return &DownloadStage{
 		input:                input,
 		output:               output,
 		maxParallelDownloads: maxParallelDownloads * 2,
+		memBudget:            memBudget,
 		panicHandler:         panicHandler,
 		log:                  logrus.WithField("sync-stage", "download"),
 	}
@@ -94,6 +99,13 @@ func (d *DownloadStage) run(ctx context.Context) {
 			continue
 		}
 
+		// Step 0: Wait until the batch fits in the memory budget. It's held until the build stage is done with it.
+		reservation, err := d.memBudget.Acquire(request.getContext(), request.expectedSize*buildMemFactor)
+		if err != nil {
+			request.job.onError(err)
+			continue
+		}
+
 		// Step 1: Download Messages.
 		result, err := autoDownloadRate(
 			request.getContext(),
@@ -125,6 +137,7 @@ func (d *DownloadStage) run(ctx context.Context) {
 			},
 		)
 		if err != nil {
+			reservation.Release()
 			request.job.onError(err)
 			continue
 		}
@@ -169,6 +182,7 @@ func (d *DownloadStage) run(ctx context.Context) {
 			},
 		)
 		if err != nil {
+			reservation.Release()
 			request.job.onError(err)
 			continue
 		}
@@ -185,9 +199,11 @@ func (d *DownloadStage) run(ctx context.Context) {
 		request.onStageCompleted(ctx)
 
 		if err := d.output.Produce(ctx, BuildRequest{
-			batch:    result,
-			childJob: request.childJob,
+			batch:       result,
+			childJob:    request.childJob,
+			reservation: reservation,
 		}); err != nil {
+			reservation.Release()
 			request.job.onError(fmt.Errorf("failed to produce output for next stage: %w", err))
 		}
 	}
@@ -181,7 +181,7 @@ func TestDownloadStage_Run(t *testing.T) {
 	defer tj.job.end()
 	childJob := tj.job.newChildJob("f", 10)
 
-	stage := NewDownloadStage(input, output, 4, &async.NoopPanicHandler{})
+	stage := NewDownloadStage(input, output, 4, nil, &async.NoopPanicHandler{})
 
 	msgIDs, expected := buildDownloadStageData(&tj, 56, false)
 
@@ -224,7 +224,7 @@ func TestDownloadStage_RunWith422(t *testing.T) {
 	defer tj.job.end()
 	childJob := tj.job.newChildJob("f", 10)
 
-	stage := NewDownloadStage(input, output, 4, &async.NoopPanicHandler{})
+	stage := NewDownloadStage(input, output, 4, nil, &async.NoopPanicHandler{})
 
 	msgIDs, expected := buildDownloadStageData(&tj, 56, true)
 
@@ -264,7 +264,7 @@ func TestDownloadStage_CancelledJobIsDiscarded(t *testing.T) {
 	defer tj.job.end()
 	childJob := tj.job.newChildJob("f", 10)
 
-	stage := NewDownloadStage(input, output, 4, &async.NoopPanicHandler{})
+	stage := NewDownloadStage(input, output, 4, nil, &async.NoopPanicHandler{})
 
 	go func() {
 		stage.run(ctx)
@@ -303,7 +303,7 @@ func TestDownloadStage_JobAbortsOnMessageDownloadError(t *testing.T) {
 	childJob := tj.job.newChildJob("f", 10)
 	tj.job.end()
 
-	stage := NewDownloadStage(input, output, 4, &async.NoopPanicHandler{})
+	stage := NewDownloadStage(input, output, 4, nil, &async.NoopPanicHandler{})
 
 	go func() {
 		stage.run(ctx)
@@ -354,7 +354,7 @@ func TestDownloadStage_JobAbortsOnAttachmentDownloadError(t *testing.T) {
 	childJob := tj.job.newChildJob("f", 10)
 	tj.job.end()
 
-	stage := NewDownloadStage(input, output, 4, &async.NoopPanicHandler{})
+	stage := NewDownloadStage(input, output, 4, nil, &async.NoopPanicHandler{})
 
 	go func() {
 		stage.run(ctx)
@@ -26,6 +26,7 @@ import (
 	"github.com/ProtonMail/gluon/logging"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/internal/network"
+	"github.com/bradenaw/juniper/xslices"
 	"github.com/sirupsen/logrus"
 )
 
@@ -136,6 +137,9 @@ type metadataIterator struct {
 	stage          *Job
 	client         *network.ProtonClientRetryWrapper[APIClient]
 	lastMessageID  string
+	reusedAddrID   string
+	syncedSince    int64
+	reachedCutoff  bool
 	remaining      []proton.MessageMetadata
 	downloadReqIDs []string
 	expectedSize   uint64
@@ -150,6 +154,8 @@ func newMetadataIterator(ctx context.Context, stage *Job, metadataPageSize int,
 		stage:          stage,
 		client:         network.NewClientRetryWrapper(stage.client, coolDown),
 		lastMessageID:  syncStatus.LastSyncedMessageID,
+		reusedAddrID:   syncStatus.ReusedAddressID,
+		syncedSince:    syncStatus.SyncedSince,
 		remaining:      nil,
 		downloadReqIDs: make([]string, 0, metadataPageSize),
 	}, nil
@@ -161,7 +167,7 @@ func (m *metadataIterator) Next(maxDownloadMem uint64, metadataPageSize int, max
 			return DownloadRequest{}, false, m.stage.ctx.Err()
 		}
 
-		if len(m.remaining) == 0 {
+		if len(m.remaining) == 0 && !m.reachedCutoff {
 			metadata, err := network.RetryWithClient(m.stage.ctx, m.client, func(ctx context.Context, c APIClient) ([]proton.MessageMetadata, error) {
 				// To get the metadata of the messages in batches we need to initialize the state with a call to
 				// GetMessageMetadata withe filter{Desc:true}.
@@ -206,11 +212,19 @@ func (m *metadataIterator) Next(maxDownloadMem uint64, metadataPageSize int, max
 			if len(m.remaining) != 0 {
 				m.lastMessageID = m.remaining[len(m.remaining)-1].ID
 			}
+
+			m.remaining = m.applyCutoff(m.remaining)
+			m.remaining = m.skipReusedMessages(m.remaining)
+			m.remaining = m.skipSyncedMessages(m.remaining)
 		}
 
 		if len(m.remaining) == 0 {
 			if len(m.downloadReqIDs) != 0 {
-				return DownloadRequest{childJob: m.stage.newChildJob(m.downloadReqIDs[len(m.downloadReqIDs)-1], int64(len(m.downloadReqIDs))), ids: m.downloadReqIDs}, false, nil
+				return DownloadRequest{
+					childJob:     m.stage.newChildJob(m.downloadReqIDs[len(m.downloadReqIDs)-1], int64(len(m.downloadReqIDs))),
+					ids:          m.downloadReqIDs,
+					expectedSize: m.expectedSize,
+				}, false, nil
 			}
 
 			return DownloadRequest{}, false, nil
@@ -219,12 +233,17 @@ func (m *metadataIterator) Next(maxDownloadMem uint64, metadataPageSize int, max
 		for idx, meta := range m.remaining {
 			nextSize := m.expectedSize + uint64(meta.Size) //nolint:gosec // disable G115
 			if nextSize >= maxDownloadMem || len(m.downloadReqIDs) >= maxMessages {
+				expectedSize := m.expectedSize
 				m.expectedSize = 0
 				m.remaining = m.remaining[idx:]
 				downloadReqIDs := m.downloadReqIDs
 				m.downloadReqIDs = make([]string, 0, metadataPageSize)
 
-				return DownloadRequest{childJob: m.stage.newChildJob(downloadReqIDs[len(downloadReqIDs)-1], int64(len(downloadReqIDs))), ids: downloadReqIDs}, true, nil
+				return DownloadRequest{
+					childJob:     m.stage.newChildJob(downloadReqIDs[len(downloadReqIDs)-1], int64(len(downloadReqIDs))),
+					ids:          downloadReqIDs,
+					expectedSize: expectedSize,
+				}, true, nil
 			}
 
 			m.downloadReqIDs = append(m.downloadReqIDs, meta.ID)
@@ -234,3 +253,59 @@ func (m *metadataIterator) Next(maxDownloadMem uint64, metadataPageSize int, max
 		m.remaining = nil
 	}
 }
+
+// applyCutoff drops the messages older than the cutoff of the job. As the metadata is listed from the newest message,
+// all the messages that follow are older too and no more metadata is requested.
+func (m *metadataIterator) applyCutoff(metadata []proton.MessageMetadata) []proton.MessageMetadata {
+	if m.stage.cutoff == 0 {
+		return metadata
+	}
+
+	for idx, meta := range metadata {
+		if meta.Time < m.stage.cutoff {
+			m.stage.log.Debugf("Reached the sync cutoff, skipping the remaining messages")
+			m.reachedCutoff = true
+
+			return metadata[:idx]
+		}
+	}
+
+	return metadata
+}
+
+// skipSyncedMessages drops the messages which were already synced before the sync window was widened and reports
+// them as synced.
+func (m *metadataIterator) skipSyncedMessages(metadata []proton.MessageMetadata) []proton.MessageMetadata {
+	if m.syncedSince == 0 {
+		return metadata
+	}
+
+	remaining := xslices.Filter(metadata, func(meta proton.MessageMetadata) bool {
+		return meta.Time < m.syncedSince
+	})
+
+	if skipped := len(metadata) - len(remaining); skipped != 0 {
+		m.stage.log.Debugf("Skipping %v messages synced before the sync window was widened", skipped)
+		m.stage.syncReporter.OnProgress(m.stage.ctx, int64(skipped)*NumSyncStages)
+	}
+
+	return remaining
+}
+
+// skipReusedMessages drops the messages which already exist in the IMAP database and reports them as synced.
+func (m *metadataIterator) skipReusedMessages(metadata []proton.MessageMetadata) []proton.MessageMetadata {
+	if m.reusedAddrID == "" {
+		return metadata
+	}
+
+	remaining := xslices.Filter(metadata, func(meta proton.MessageMetadata) bool {
+		return meta.AddressID != m.reusedAddrID
+	})
+
+	if skipped := len(metadata) - len(remaining); skipped != 0 {
+		m.stage.log.Debugf("Skipping %v messages already present in the IMAP database", skipped)
+		m.stage.syncReporter.OnProgress(m.stage.ctx, int64(skipped)*NumSyncStages)
+	}
+
+	return remaining
+}
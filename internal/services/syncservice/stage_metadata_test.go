@@ -336,6 +336,165 @@ func TestMetadataIterator_RespectsSizeLimit(t *testing.T) {
 	require.Equal(t, []string{testMsgID(3)}, j.ids)
 }
 
+func TestMetadataIterator_SkipsReusedAddress(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	ctx := context.Background()
+	tj := newTestJob(ctx, mockCtrl, "u", getTestLabels())
+
+	tj.state.EXPECT().GetSyncStatus(gomock.Any()).Return(Status{
+		ReusedAddressID: "addr-1",
+	}, nil)
+
+	// First call.
+	tj.client.EXPECT().GetMessageMetadataPage(
+		gomock.Any(),
+		gomock.Eq(0),
+		gomock.Eq(TestMetadataPageSize),
+		gomock.Eq(proton.MessageFilter{Desc: true}),
+	).Return([]proton.MessageMetadata{
+		{
+			ID:        testMsgID(0),
+			AddressID: "addr-1",
+			Size:      100,
+		},
+		{
+			ID:        testMsgID(1),
+			AddressID: "addr-2",
+			Size:      100,
+		},
+		{
+			ID:        testMsgID(2),
+			AddressID: "addr-1",
+			Size:      100,
+		},
+	}, nil)
+
+	// Second Call
+	tj.client.EXPECT().GetMessageMetadataPage(
+		gomock.Any(),
+		gomock.Eq(0),
+		gomock.Eq(TestMetadataPageSize),
+		gomock.Eq(proton.MessageFilter{Desc: true, EndID: testMsgID(2)}),
+	).Return([]proton.MessageMetadata{
+		{
+			ID:        testMsgID(2),
+			AddressID: "addr-1",
+			Size:      100,
+		},
+	}, nil)
+
+	// Skipped messages are reported as synced for every stage.
+	tj.syncReporter.EXPECT().OnProgress(gomock.Any(), gomock.Eq(int64(2*NumSyncStages)))
+
+	iter, err := newMetadataIterator(ctx, tj.job, TestMetadataPageSize, &network.NoCoolDown{})
+	require.NoError(t, err)
+
+	j, hasMore, err := iter.Next(TestMaxDownloadMem, TestMetadataPageSize, TestMaxMessages)
+	require.NoError(t, err)
+	require.False(t, hasMore)
+	require.Equal(t, []string{testMsgID(1)}, j.ids)
+}
+
+func TestMetadataIterator_StopsAtCutoff(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	ctx := context.Background()
+	tj := newTestJob(ctx, mockCtrl, "u", getTestLabels())
+	tj.job.cutoff = 1000
+
+	tj.state.EXPECT().GetSyncStatus(gomock.Any()).Return(Status{}, nil)
+
+	// Only one call: the messages that follow the first message older than the cutoff are older too.
+	tj.client.EXPECT().GetMessageMetadataPage(
+		gomock.Any(),
+		gomock.Eq(0),
+		gomock.Eq(TestMetadataPageSize),
+		gomock.Eq(proton.MessageFilter{Desc: true}),
+	).Return([]proton.MessageMetadata{
+		{
+			ID:   testMsgID(0),
+			Time: 2000,
+			Size: 100,
+		},
+		{
+			ID:   testMsgID(1),
+			Time: 1000,
+			Size: 100,
+		},
+		{
+			ID:   testMsgID(2),
+			Time: 999,
+			Size: 100,
+		},
+	}, nil)
+
+	iter, err := newMetadataIterator(ctx, tj.job, TestMetadataPageSize, &network.NoCoolDown{})
+	require.NoError(t, err)
+
+	j, hasMore, err := iter.Next(TestMaxDownloadMem, TestMetadataPageSize, TestMaxMessages)
+	require.NoError(t, err)
+	require.False(t, hasMore)
+	require.Equal(t, []string{testMsgID(0), testMsgID(1)}, j.ids)
+}
+
+func TestMetadataIterator_SkipsSyncedMessages(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	ctx := context.Background()
+	tj := newTestJob(ctx, mockCtrl, "u", getTestLabels())
+
+	tj.state.EXPECT().GetSyncStatus(gomock.Any()).Return(Status{
+		SyncedSince: 1000,
+	}, nil)
+
+	// First call.
+	tj.client.EXPECT().GetMessageMetadataPage(
+		gomock.Any(),
+		gomock.Eq(0),
+		gomock.Eq(TestMetadataPageSize),
+		gomock.Eq(proton.MessageFilter{Desc: true}),
+	).Return([]proton.MessageMetadata{
+		{
+			ID:   testMsgID(0),
+			Time: 2000,
+			Size: 100,
+		},
+		{
+			ID:   testMsgID(1),
+			Time: 1000,
+			Size: 100,
+		},
+		{
+			ID:   testMsgID(2),
+			Time: 999,
+			Size: 100,
+		},
+	}, nil)
+
+	// Second Call
+	tj.client.EXPECT().GetMessageMetadataPage(
+		gomock.Any(),
+		gomock.Eq(0),
+		gomock.Eq(TestMetadataPageSize),
+		gomock.Eq(proton.MessageFilter{Desc: true, EndID: testMsgID(2)}),
+	).Return([]proton.MessageMetadata{
+		{
+			ID:   testMsgID(2),
+			Time: 999,
+			Size: 100,
+		},
+	}, nil)
+
+	// Skipped messages are reported as synced for every stage.
+	tj.syncReporter.EXPECT().OnProgress(gomock.Any(), gomock.Eq(int64(2*NumSyncStages)))
+
+	iter, err := newMetadataIterator(ctx, tj.job, TestMetadataPageSize, &network.NoCoolDown{})
+	require.NoError(t, err)
+
+	j, hasMore, err := iter.Next(TestMaxDownloadMem, TestMetadataPageSize, TestMaxMessages)
+	require.NoError(t, err)
+	require.False(t, hasMore)
+	require.Equal(t, []string{testMsgID(2)}, j.ids)
+}
+
 func testMsgID(i int) string {
 	return fmt.Sprintf("msg-id-%v", i)
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/orderedtasks"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/userevents"
+	bridgetelemetry "github.com/ProtonMail/proton-bridge/v3/internal/telemetry"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/cpc"
 	"github.com/sirupsen/logrus"
 )
@@ -149,6 +150,10 @@ func (s *Service) HandleUserSettingsEvent(_ context.Context, settings *proton.Us
 type isTelemetryEnabledReq struct{}
 
 func (s *Service) IsTelemetryEnabled(ctx context.Context) bool {
+	if bridgetelemetry.IsReportingDisabled() {
+		return false
+	}
+
 	enabled, err := cpc.SendTyped[bool](ctx, s.cpc, &isTelemetryEnabledReq{})
 	if err != nil {
 		s.log.WithError(err).Error("Failed to retrieve IsTelemeteryEnabled, assuming no")
@@ -23,6 +23,9 @@ type KeyPassProvider interface {
 
 type BridgePassProvider interface {
 	BridgePass() []byte
+
+	// AppPasses returns the app passwords accepted alongside the bridge password, keyed by name.
+	AppPasses() map[string][]byte
 }
 
 type FixedBridgePassProvider struct {
@@ -33,6 +36,10 @@ func (f FixedBridgePassProvider) BridgePass() []byte {
 	return f.pass
 }
 
+func (f FixedBridgePassProvider) AppPasses() map[string][]byte {
+	return nil
+}
+
 func NewFixedBridgePassProvider(pass []byte) *FixedBridgePassProvider {
 	return &FixedBridgePassProvider{pass: pass}
 }
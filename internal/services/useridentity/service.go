@@ -33,6 +33,9 @@ import (
 	"golang.org/x/exp/slices"
 )
 
+// StorageAlmostFullRatio is the share of the storage quota above which the storage is considered almost full.
+const StorageAlmostFullRatio = 0.9
+
 type IdentityProvider interface {
 	GetUser(ctx context.Context) (proton.User, error)
 	GetAddresses(ctx context.Context) ([]proton.Address, error)
@@ -50,6 +53,9 @@ type Service struct {
 	subscription *userevents.EventChanneledSubscriber
 
 	bridgePassProvider BridgePassProvider
+
+	// storageAlmostFull is whether the user was last seen with its storage almost full.
+	storageAlmostFull bool
 }
 
 func NewService(
@@ -107,6 +113,8 @@ func (s *Service) HandleUsedSpaceEvent(ctx context.Context, newSpace int64) erro
 			UserID:    s.identity.User.ID,
 			UsedSpace: uint64(newSpace), //nolint:gosec // disable G115
 		})
+
+		s.checkStorage(ctx)
 	}
 
 	return nil
@@ -119,6 +127,8 @@ func (s *Service) HandleUserEvent(ctx context.Context, user *proton.User) error
 		UserID: user.ID,
 	})
 
+	s.checkStorage(ctx)
+
 	return nil
 }
 
@@ -205,9 +215,42 @@ func (s *Service) HandleRefreshEvent(ctx context.Context, _ proton.RefreshFlag)
 		CancelEventPool: false,
 	})
 
+	s.checkStorage(ctx)
+
 	return nil
 }
 
+// checkStorage publishes events.UserStorageAlmostFull when the user's storage becomes almost full.
+// The event is published again only once the storage went back below the threshold in between.
+func (s *Service) checkStorage(ctx context.Context) {
+	almostFull := IsStorageAlmostFull(s.identity.User.UsedSpace, s.identity.User.MaxSpace)
+
+	if almostFull && !s.storageAlmostFull {
+		s.log.WithFields(logrus.Fields{
+			"usedSpace": s.identity.User.UsedSpace,
+			"maxSpace":  s.identity.User.MaxSpace,
+		}).Warn("User storage is almost full")
+
+		s.eventPublisher.PublishEvent(ctx, events.UserStorageAlmostFull{
+			UserID:    s.identity.User.ID,
+			UsedSpace: s.identity.User.UsedSpace,
+			MaxSpace:  s.identity.User.MaxSpace,
+		})
+	}
+
+	s.storageAlmostFull = almostFull
+}
+
+// IsStorageAlmostFull returns whether the used space exceeds StorageAlmostFullRatio of the quota.
+// A zero quota is unknown and never considered full.
+func IsStorageAlmostFull(usedSpace, maxSpace uint64) bool {
+	if maxSpace == 0 {
+		return false
+	}
+
+	return float64(usedSpace) >= StorageAlmostFullRatio*float64(maxSpace)
+}
+
 func (s *Service) run(ctx context.Context) {
 	s.log.WithFields(logrus.Fields{
 		"numAddr": len(s.identity.Addresses),
@@ -226,6 +269,8 @@ func (s *Service) run(ctx context.Context) {
 
 	defer s.cpc.Close()
 
+	s.checkStorage(ctx)
+
 	for {
 		select {
 		case <-ctx.Done():
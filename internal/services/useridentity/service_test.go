@@ -57,6 +57,46 @@ func TestService_OnUserSpaceChanged(t *testing.T) {
 	require.Equal(t, uint64(1024), service.identity.User.UsedSpace)
 }
 
+func TestService_OnUserSpaceAlmostFull(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	service, eventPublisher, _ := newTestService(t, mockCtrl)
+
+	user := newTestUser()
+	user.MaxSpace = 1000
+
+	eventPublisher.EXPECT().PublishEvent(gomock.Any(), gomock.Eq(events.UserChanged{UserID: TestUserID})).Times(1)
+	require.NoError(t, service.HandleUserEvent(context.Background(), user))
+
+	// Below the threshold, no warning.
+	eventPublisher.EXPECT().PublishEvent(gomock.Any(), gomock.Eq(events.UsedSpaceChanged{UserID: TestUserID, UsedSpace: 800})).Times(1)
+	require.NoError(t, service.HandleUsedSpaceEvent(context.Background(), 800))
+
+	// Crossing the threshold warns once.
+	eventPublisher.EXPECT().PublishEvent(gomock.Any(), gomock.Eq(events.UsedSpaceChanged{UserID: TestUserID, UsedSpace: 900})).Times(1)
+	eventPublisher.EXPECT().PublishEvent(gomock.Any(), gomock.Eq(events.UserStorageAlmostFull{UserID: TestUserID, UsedSpace: 900, MaxSpace: 1000})).Times(1)
+	require.NoError(t, service.HandleUsedSpaceEvent(context.Background(), 900))
+
+	eventPublisher.EXPECT().PublishEvent(gomock.Any(), gomock.Eq(events.UsedSpaceChanged{UserID: TestUserID, UsedSpace: 950})).Times(1)
+	require.NoError(t, service.HandleUsedSpaceEvent(context.Background(), 950))
+
+	// Once space was freed, crossing the threshold again warns again.
+	eventPublisher.EXPECT().PublishEvent(gomock.Any(), gomock.Eq(events.UsedSpaceChanged{UserID: TestUserID, UsedSpace: 100})).Times(1)
+	require.NoError(t, service.HandleUsedSpaceEvent(context.Background(), 100))
+
+	eventPublisher.EXPECT().PublishEvent(gomock.Any(), gomock.Eq(events.UsedSpaceChanged{UserID: TestUserID, UsedSpace: 990})).Times(1)
+	eventPublisher.EXPECT().PublishEvent(gomock.Any(), gomock.Eq(events.UserStorageAlmostFull{UserID: TestUserID, UsedSpace: 990, MaxSpace: 1000})).Times(1)
+	require.NoError(t, service.HandleUsedSpaceEvent(context.Background(), 990))
+}
+
+func TestIsStorageAlmostFull(t *testing.T) {
+	require.False(t, IsStorageAlmostFull(0, 0))
+	require.False(t, IsStorageAlmostFull(1000, 0))
+	require.False(t, IsStorageAlmostFull(899, 1000))
+	require.True(t, IsStorageAlmostFull(900, 1000))
+	require.True(t, IsStorageAlmostFull(2000, 1000))
+}
+
 func TestService_OnRefreshEvent(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 
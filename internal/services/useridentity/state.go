@@ -22,11 +22,13 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 )
 
@@ -129,6 +131,26 @@ func (s *State) OnUserSpaceChanged(value uint64) bool {
 	return true
 }
 
+// AppendLimit returns the size of the largest message the user can currently store and whether such a limit is known.
+// It is bounded by the upload limit of the user's plan and by the space left in the user's storage.
+func (s *State) AppendLimit() (uint64, bool) {
+	limit, ok := s.User.MaxUpload, s.User.MaxUpload > 0
+
+	if s.User.MaxSpace > 0 {
+		var free uint64
+
+		if s.User.UsedSpace < s.User.MaxSpace {
+			free = s.User.MaxSpace - s.User.UsedSpace
+		}
+
+		if !ok || free < limit {
+			limit, ok = free, true
+		}
+	}
+
+	return limit, ok
+}
+
 type AddressUpdate int
 
 const (
@@ -225,19 +247,19 @@ func (s *State) Clone() *State {
 }
 
 // CheckAuth returns whether the given email and password can be used to authenticate over IMAP or SMTP with this user.
+// The password may also be a local auth token minted with NewAuthToken.
 // It returns the address ID of the authenticated address.
 func (s *State) CheckAuth(email string, password []byte, bridgePassProvider BridgePassProvider) (string, error) {
 	if email == "crash@bandicoot" {
 		panic("your wish is my command.. I crash")
 	}
 
-	dec, err := algo.B64RawDecode(password)
-	if err != nil {
-		return "", fmt.Errorf("failed to decode password: %w", err)
-	}
-
-	if subtle.ConstantTimeCompare(bridgePassProvider.BridgePass(), dec) != 1 {
-		return "", fmt.Errorf("invalid password")
+	if IsAuthToken(password) {
+		if err := checkAuthToken(bridgePassProvider.BridgePass(), s.User.ID, password, time.Now()); err != nil {
+			return "", err
+		}
+	} else if err := s.checkPassword(password, bridgePassProvider); err != nil {
+		return "", err
 	}
 
 	for _, addr := range s.AddressesSorted {
@@ -253,6 +275,39 @@ func (s *State) CheckAuth(email string, password []byte, bridgePassProvider Brid
 	return "", fmt.Errorf("invalid email")
 }
 
+// checkPassword returns an error if the given password is neither the bridge password nor one of the app passwords.
+func (s *State) checkPassword(password []byte, bridgePassProvider BridgePassProvider) error {
+	dec, err := algo.B64RawDecode(password)
+	if err != nil {
+		return fmt.Errorf("failed to decode password: %w", err)
+	}
+
+	if subtle.ConstantTimeCompare(bridgePassProvider.BridgePass(), dec) != 1 {
+		appPassName, ok := checkAppPass(bridgePassProvider.AppPasses(), dec)
+		if !ok {
+			return fmt.Errorf("invalid password")
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"user":        s.User.ID,
+			"appPassword": appPassName,
+		}).Info("Authenticated with app password")
+	}
+
+	return nil
+}
+
+// checkAppPass returns the name of the app password matching the given raw password, if any.
+func checkAppPass(appPasses map[string][]byte, dec []byte) (string, bool) {
+	for name, appPass := range appPasses {
+		if subtle.ConstantTimeCompare(appPass, dec) == 1 {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
 func (s *State) WithAddrKR(addrID string, keyPass []byte, fn func(userKR, addrKR *crypto.KeyRing) error) error {
 	addr, ok := s.Addresses[addrID]
 	if !ok {
@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package useridentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/useridentity/mocks"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type testBridgePassProvider struct {
+	FixedBridgePassProvider
+
+	appPasses map[string][]byte
+}
+
+func (p testBridgePassProvider) AppPasses() map[string][]byte {
+	return p.appPasses
+}
+
+func TestState_CheckAuth(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	state := NewState(*newTestUser(), newTestAddresses(), mocks.NewMockIdentityProvider(mockCtrl))
+
+	provider := testBridgePassProvider{
+		FixedBridgePassProvider: *NewFixedBridgePassProvider([]byte("bridge")),
+		appPasses: map[string][]byte{
+			"thunderbird": []byte("thunderbird"),
+			"phone":       []byte("phone"),
+		},
+	}
+
+	// The bridge password and every app password are accepted.
+	for _, pass := range []string{"bridge", "thunderbird", "phone"} {
+		addrID, err := state.CheckAuth("foo@bar.com", algo.B64RawEncode([]byte(pass)), provider)
+		require.NoError(t, err)
+		require.Equal(t, "Address1", addrID)
+	}
+
+	// Other passwords are rejected.
+	_, err := state.CheckAuth("foo@bar.com", algo.B64RawEncode([]byte("other")), provider)
+	require.Error(t, err)
+
+	// Revoked app passwords are rejected.
+	delete(provider.appPasses, "phone")
+
+	_, err = state.CheckAuth("foo@bar.com", algo.B64RawEncode([]byte("phone")), provider)
+	require.Error(t, err)
+}
+
+func TestState_CheckAuthToken(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	state := NewState(*newTestUser(), newTestAddresses(), mocks.NewMockIdentityProvider(mockCtrl))
+
+	provider := NewFixedBridgePassProvider([]byte("bridge"))
+
+	// A token minted with the bridge password is accepted.
+	token := NewAuthToken([]byte("bridge"), TestUserID, time.Now().Add(time.Hour))
+	require.True(t, IsAuthToken(token))
+
+	addrID, err := state.CheckAuth("foo@bar.com", token, provider)
+	require.NoError(t, err)
+	require.Equal(t, "Address1", addrID)
+
+	// A tampered token is rejected.
+	_, err = state.CheckAuth("foo@bar.com", append(token, 'x'), provider)
+	require.ErrorIs(t, err, ErrInvalidAuthToken)
+
+	// A token minted for another user is rejected.
+	_, err = state.CheckAuth("foo@bar.com", NewAuthToken([]byte("bridge"), "other", time.Now().Add(time.Hour)), provider)
+	require.ErrorIs(t, err, ErrInvalidAuthToken)
+
+	// An expired token is rejected.
+	_, err = state.CheckAuth("foo@bar.com", NewAuthToken([]byte("bridge"), TestUserID, time.Now().Add(-time.Hour)), provider)
+	require.ErrorIs(t, err, ErrExpiredAuthToken)
+
+	// Changing the bridge password invalidates the token.
+	_, err = state.CheckAuth("foo@bar.com", token, NewFixedBridgePassProvider([]byte("changed")))
+	require.ErrorIs(t, err, ErrInvalidAuthToken)
+}
+
+func TestState_AppendLimit(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	state := NewState(*newTestUser(), newTestAddresses(), mocks.NewMockIdentityProvider(mockCtrl))
+
+	requireLimit := func(want uint64) {
+		limit, ok := state.AppendLimit()
+		require.True(t, ok)
+		require.Equal(t, want, limit)
+	}
+
+	// Neither the upload limit nor the storage is known.
+	_, ok := state.AppendLimit()
+	require.False(t, ok)
+
+	// Only the upload limit is known.
+	state.User.MaxUpload = 100
+	requireLimit(100)
+
+	// There is more free space than the upload limit.
+	state.User.MaxSpace = 1000
+	state.User.UsedSpace = 500
+	requireLimit(100)
+
+	// There is less free space than the upload limit.
+	state.User.UsedSpace = 950
+	requireLimit(50)
+
+	// The storage is full.
+	state.User.UsedSpace = 1200
+	requireLimit(0)
+}
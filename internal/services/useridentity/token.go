@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package useridentity
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// authTokenPrefix distinguishes local auth tokens from bridge and app passwords.
+const authTokenPrefix = "bridge-token."
+
+var (
+	ErrInvalidAuthToken = errors.New("invalid auth token")
+	ErrExpiredAuthToken = errors.New("auth token has expired")
+)
+
+// NewAuthToken mints a local token clients can present with XOAUTH2 or OAUTHBEARER instead of a password.
+// The token is signed with the user's bridge password: it is valid until it expires or the bridge password changes.
+func NewAuthToken(bridgePass []byte, userID string, expiresAt time.Time) []byte {
+	expiry := strconv.FormatInt(expiresAt.Unix(), 10)
+
+	return []byte(authTokenPrefix + expiry + "." + signAuthToken(bridgePass, userID, expiry))
+}
+
+// IsAuthToken returns whether the given password is a local auth token.
+func IsAuthToken(password []byte) bool {
+	return bytes.HasPrefix(password, []byte(authTokenPrefix))
+}
+
+// checkAuthToken returns an error if the given token was not minted for the user or has expired.
+func checkAuthToken(bridgePass []byte, userID string, token []byte, now time.Time) error {
+	expiry, mac, ok := bytes.Cut(bytes.TrimPrefix(token, []byte(authTokenPrefix)), []byte("."))
+	if !ok {
+		return ErrInvalidAuthToken
+	}
+
+	if !hmac.Equal(mac, []byte(signAuthToken(bridgePass, userID, string(expiry)))) {
+		return ErrInvalidAuthToken
+	}
+
+	expiresAt, err := strconv.ParseInt(string(expiry), 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAuthToken, err)
+	}
+
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return ErrExpiredAuthToken
+	}
+
+	return nil
+}
+
+func signAuthToken(bridgePass []byte, userID, expiry string) string {
+	h := hmac.New(sha256.New, bridgePass)
+
+	h.Write([]byte(userID + "." + expiry))
+
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
@@ -134,6 +134,11 @@ func (heartbeat *Heartbeat) SetPrevVersion(val string) {
 }
 
 func (heartbeat *Heartbeat) TrySending(ctx context.Context) {
+	if IsReportingDisabled() {
+		heartbeat.log.Debug("Reporting is disabled, not sending heartbeat")
+		return
+	}
+
 	if heartbeat.manager.IsTelemetryAvailable(ctx) {
 		lastSent := heartbeat.manager.GetLastHeartbeatSent()
 		now := time.Now()
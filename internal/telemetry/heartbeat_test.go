@@ -77,6 +77,16 @@ func TestHeartbeat_already_sent_heartbeat(t *testing.T) {
 	})
 }
 
+func TestHeartbeat_reporting_disabled(t *testing.T) {
+	telemetry.SetReportingDisabled(true)
+	defer telemetry.SetReportingDisabled(false)
+
+	withHeartbeat(t, 1143, 1025, "/tmp", "defaultKeychain", func(hb *telemetry.Heartbeat, _ *mocks.MockHeartbeatManager) {
+		// The manager must not be asked anything.
+		hb.TrySending(context.Background())
+	})
+}
+
 func withHeartbeat(t *testing.T, imap, smtp int, cache, keychain string, tests func(hb *telemetry.Heartbeat, mock *mocks.MockHeartbeatManager)) {
 	ctl := gomock.NewController(t)
 	defer ctl.Finish()
@@ -15,17 +15,26 @@
 // You should have received a copy of the GNU General Public License
 // along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
 
-package syncservice
+package telemetry
 
 import (
-	"context"
-	"time"
+	"errors"
+	"sync/atomic"
 )
 
-// sleepCtx sleeps for the given duration, or until the context is canceled.
-func sleepCtx(ctx context.Context, d time.Duration) {
-	select {
-	case <-ctx.Done():
-	case <-time.After(d):
-	}
+// ErrReportingDisabled is returned when something tries to send diagnostic data while reporting is disabled.
+var ErrReportingDisabled = errors.New("reporting is disabled")
+
+// reportingDisabled is the process-wide kill switch for everything that is sent for diagnostic purposes:
+// crash reports, usage telemetry, observability metrics and heartbeats.
+var reportingDisabled atomic.Bool //nolint:gochecknoglobals
+
+// SetReportingDisabled turns the reporting kill switch on or off.
+func SetReportingDisabled(disabled bool) {
+	reportingDisabled.Store(disabled)
+}
+
+// IsReportingDisabled returns whether the reporting kill switch is on.
+func IsReportingDisabled() bool {
+	return reportingDisabled.Load()
 }
@@ -0,0 +1,111 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package updater
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalDownloader(t *testing.T) {
+	kr := newTestKeyRing(t)
+	dir := t.TempDir()
+
+	writeSigned(t, kr, dir, "bridge_2.4.0.tgz", []byte("package"))
+
+	// Files are looked up by the last element of their URL.
+	b, err := NewLocalDownloader(dir, clock.New()).DownloadAndVerify(context.Background(), kr, "https://proton.me/download/bridge_2.4.0.tgz", "https://proton.me/download/bridge_2.4.0.tgz.sig")
+	require.NoError(t, err)
+	require.Equal(t, []byte("package"), b)
+}
+
+func TestLocalDownloader_BadSignature(t *testing.T) {
+	kr := newTestKeyRing(t)
+	dir := t.TempDir()
+
+	// The file is signed by another key.
+	writeSigned(t, newTestKeyRing(t), dir, "bridge_2.4.0.tgz", []byte("package"))
+
+	_, err := NewLocalDownloader(dir, clock.New()).DownloadAndVerify(context.Background(), kr, "bridge_2.4.0.tgz", "bridge_2.4.0.tgz.sig")
+	require.Error(t, err)
+
+	// The file was modified after being signed.
+	writeSigned(t, kr, dir, "bridge_2.4.0.tgz", []byte("package"))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bridge_2.4.0.tgz"), []byte("tampered"), 0o600))
+
+	_, err = NewLocalDownloader(dir, clock.New()).DownloadAndVerify(context.Background(), kr, "bridge_2.4.0.tgz", "bridge_2.4.0.tgz.sig")
+	require.Error(t, err)
+}
+
+func TestLocalDownloader_VerifyTime(t *testing.T) {
+	kr := newTestKeyRing(t)
+	dir := t.TempDir()
+
+	writeSigned(t, kr, dir, "bridge_2.4.0.tgz", []byte("package"))
+
+	// The signature is verified at the clock's time, long before the key and the signature were made.
+	clock := clock.NewFake(time.Now().AddDate(0, 0, -7))
+
+	_, err := NewLocalDownloader(dir, clock).DownloadAndVerify(context.Background(), kr, "bridge_2.4.0.tgz", "bridge_2.4.0.tgz.sig")
+	require.Error(t, err)
+
+	clock.Advance(7 * 24 * time.Hour)
+
+	_, err = NewLocalDownloader(dir, clock).DownloadAndVerify(context.Background(), kr, "bridge_2.4.0.tgz", "bridge_2.4.0.tgz.sig")
+	require.NoError(t, err)
+}
+
+func TestLocalDownloader_MissingFile(t *testing.T) {
+	kr := newTestKeyRing(t)
+	dir := t.TempDir()
+
+	_, err := NewLocalDownloader(dir, clock.New()).DownloadAndVerify(context.Background(), kr, "bridge_2.4.0.tgz", "bridge_2.4.0.tgz.sig")
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// The signature is missing.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bridge_2.4.0.tgz"), []byte("package"), 0o600))
+
+	_, err = NewLocalDownloader(dir, clock.New()).DownloadAndVerify(context.Background(), kr, "bridge_2.4.0.tgz", "bridge_2.4.0.tgz.sig")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func newTestKeyRing(t *testing.T) *crypto.KeyRing {
+	key, err := crypto.GenerateKey("test", "test@proton.me", "x25519", 0)
+	require.NoError(t, err)
+
+	kr, err := crypto.NewKeyRing(key)
+	require.NoError(t, err)
+
+	return kr
+}
+
+// writeSigned writes the file of the given name and its detached signature next to it, as published online.
+func writeSigned(t *testing.T, kr *crypto.KeyRing, dir, name string, b []byte) {
+	sig, err := kr.SignDetached(crypto.NewPlainMessage(b))
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), b, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".sig"), sig.GetBinary(), 0o600))
+}
@@ -26,7 +26,9 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
 	"github.com/ProtonMail/proton-bridge/v3/internal/versioner"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/bspatch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -35,6 +37,7 @@ var (
 	ErrDownloadVerify         = errors.New("failed to download or verify the update")
 	ErrInstall                = errors.New("failed to install the update")
 	ErrUpdateAlreadyInstalled = errors.New("update is already installed")
+	ErrNoDelta                = errors.New("no applicable delta update")
 )
 
 type Downloader interface {
@@ -52,15 +55,19 @@ type Updater struct {
 	verifier  *crypto.KeyRing
 	product   string
 	platform  string
+
+	// clock is the time at which the rebuilt update packages' signatures are verified.
+	clock clock.Clock
 }
 
-func NewUpdater(ver *versioner.Versioner, verifier *crypto.KeyRing, product, platform string) *Updater {
+func NewUpdater(ver *versioner.Versioner, verifier *crypto.KeyRing, product, platform string, clock clock.Clock) *Updater {
 	return &Updater{
 		versioner: ver,
 		installer: NewInstaller(ver),
 		verifier:  verifier,
 		product:   product,
 		platform:  platform,
+		clock:     clock,
 	}
 }
 
@@ -94,14 +101,18 @@ func (u *Updater) InstallUpdate(ctx context.Context, downloader Downloader, upda
 		return ErrUpdateAlreadyInstalled
 	}
 
-	b, err := downloader.DownloadAndVerify(
-		ctx,
-		u.verifier,
-		update.Package,
-		update.Package+".sig",
-	)
+	b, err := u.downloadDelta(ctx, downloader, update)
 	if err != nil {
-		return fmt.Errorf("%w: %w", ErrDownloadVerify, err)
+		logrus.WithError(err).Info("Could not use a delta update, falling back to the full package")
+
+		if b, err = downloader.DownloadAndVerify(
+			ctx,
+			u.verifier,
+			update.Package,
+			update.Package+".sig",
+		); err != nil {
+			return fmt.Errorf("%w: %w", ErrDownloadVerify, err)
+		}
 	}
 
 	if err := u.installer.InstallUpdate(update.Version, bytes.NewReader(b)); err != nil {
@@ -109,13 +120,60 @@ func (u *Updater) InstallUpdate(ctx context.Context, downloader Downloader, upda
 		return ErrInstall
 	}
 
+	// Keep the package so that the next update can be downloaded as a delta.
+	if err := u.versioner.SavePackage(update.Version, b); err != nil {
+		logrus.WithError(err).Warn("Failed to keep update package")
+	}
+
 	return nil
 }
 
+// downloadDelta rebuilds the update package from a delta against a package kept from a previous update.
+// Both the delta and the rebuilt package are verified.
+func (u *Updater) downloadDelta(ctx context.Context, downloader Downloader, update VersionInfo) ([]byte, error) {
+	for _, delta := range update.Deltas {
+		old, err := u.versioner.LoadPackage(delta.From)
+		if err != nil {
+			continue
+		}
+
+		logrus.WithField("from", delta.From).WithField("to", update.Version).Info("Downloading delta update")
+
+		patch, err := downloader.DownloadAndVerify(ctx, u.verifier, delta.Package, delta.Package+".sig")
+		if err != nil {
+			return nil, fmt.Errorf("failed to download delta: %w", err)
+		}
+
+		b, err := bspatch.Patch(old, patch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply delta: %w", err)
+		}
+
+		sig, err := crypto.NewPGPSignatureFromArmored(delta.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read signature of rebuilt package: %w", err)
+		}
+
+		if err := u.verifier.VerifyDetached(crypto.NewPlainMessage(b), sig, u.clock.Now().Unix()); err != nil {
+			return nil, fmt.Errorf("failed to verify rebuilt package: %w", err)
+		}
+
+		return b, nil
+	}
+
+	return nil, ErrNoDelta
+}
+
 func (u *Updater) RemoveOldUpdates() error {
 	return u.versioner.RemoveOldVersions()
 }
 
+// Rollback removes the given installed update so that the previous version on disk is started instead.
+// It returns that previous version, or nil if it is the base installed version.
+func (u *Updater) Rollback(version *semver.Version) (*semver.Version, error) {
+	return u.versioner.Rollback(version)
+}
+
 // getVersionFileURL returns the URL of the version file.
 // For example:
 //   - https://protonmail.com/download/bridge/version_linux.json
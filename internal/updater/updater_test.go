@@ -0,0 +1,185 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !darwin
+// +build !darwin
+
+package updater
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
+	"github.com/ProtonMail/proton-bridge/v3/internal/versioner"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	v2_3_0 = semver.MustParse("2.3.0")
+	v2_4_0 = semver.MustParse("2.4.0")
+)
+
+func TestInstallUpdate_Delta(t *testing.T) {
+	kr := newTestKeyRing(t)
+	ver := newTestVersioner(t)
+	dir := t.TempDir()
+
+	// Only the delta is published, so the update can't fall back to the full package.
+	writeSigned(t, kr, dir, "bridge_2.3.0_2.4.0.bsdiff", readTestData(t, "bridge_2.3.0_2.4.0.bsdiff"))
+
+	u := NewUpdater(ver, kr, "bridge", "linux", clock.New())
+
+	require.NoError(t, u.InstallUpdate(context.Background(), NewLocalDownloader(dir, clock.New()), newTestUpdate(t, kr, readTestData(t, "bridge_2.4.0.tgz"))))
+
+	requireInstalled(t, ver)
+}
+
+func TestInstallUpdate_DeltaFallback(t *testing.T) {
+	tests := map[string]struct {
+		// prepare changes the published files and the update before installing it.
+		prepare func(t *testing.T, kr *crypto.KeyRing, dir string, update *VersionInfo)
+	}{
+		"corrupt delta": {
+			prepare: func(t *testing.T, kr *crypto.KeyRing, dir string, _ *VersionInfo) {
+				writeSigned(t, kr, dir, "bridge_2.3.0_2.4.0.bsdiff", []byte("not a patch"))
+			},
+		},
+
+		"missing delta": {
+			prepare: func(t *testing.T, _ *crypto.KeyRing, dir string, _ *VersionInfo) {
+				require.NoError(t, os.Remove(filepath.Join(dir, "bridge_2.3.0_2.4.0.bsdiff")))
+			},
+		},
+
+		"delta signed by another key": {
+			prepare: func(t *testing.T, _ *crypto.KeyRing, dir string, _ *VersionInfo) {
+				writeSigned(t, newTestKeyRing(t), dir, "bridge_2.3.0_2.4.0.bsdiff", readTestData(t, "bridge_2.3.0_2.4.0.bsdiff"))
+			},
+		},
+
+		"bad signature of rebuilt package": {
+			prepare: func(t *testing.T, kr *crypto.KeyRing, _ string, update *VersionInfo) {
+				update.Deltas[0].Signature = signArmored(t, kr, readTestData(t, "bridge_2.3.0.tgz"))
+			},
+		},
+
+		"no package of older version": {
+			prepare: func(_ *testing.T, _ *crypto.KeyRing, _ string, update *VersionInfo) {
+				update.Deltas[0].From = semver.MustParse("2.2.0")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			kr := newTestKeyRing(t)
+			ver := newTestVersioner(t)
+			dir := t.TempDir()
+
+			writeSigned(t, kr, dir, "bridge_2.3.0_2.4.0.bsdiff", readTestData(t, "bridge_2.3.0_2.4.0.bsdiff"))
+			writeSigned(t, kr, dir, "bridge_2.4.0.tgz", readTestData(t, "bridge_2.4.0.tgz"))
+
+			update := newTestUpdate(t, kr, readTestData(t, "bridge_2.4.0.tgz"))
+
+			test.prepare(t, kr, dir, &update)
+
+			u := NewUpdater(ver, kr, "bridge", "linux", clock.New())
+
+			require.NoError(t, u.InstallUpdate(context.Background(), NewLocalDownloader(dir, clock.New()), update))
+
+			requireInstalled(t, ver)
+		})
+	}
+}
+
+func TestInstallUpdate_DeltaFallbackFails(t *testing.T) {
+	kr := newTestKeyRing(t)
+	dir := t.TempDir()
+
+	// The delta can't be verified and there is no full package to fall back to.
+	writeSigned(t, newTestKeyRing(t), dir, "bridge_2.3.0_2.4.0.bsdiff", readTestData(t, "bridge_2.3.0_2.4.0.bsdiff"))
+
+	u := NewUpdater(newTestVersioner(t), kr, "bridge", "linux", clock.New())
+
+	require.ErrorIs(t, u.InstallUpdate(context.Background(), NewLocalDownloader(dir, clock.New()), newTestUpdate(t, kr, readTestData(t, "bridge_2.4.0.tgz"))), ErrDownloadVerify)
+}
+
+// newTestVersioner returns a versioner which kept the update package of version 2.3.0.
+func newTestVersioner(t *testing.T) *versioner.Versioner {
+	ver := versioner.New(t.TempDir())
+
+	require.NoError(t, ver.SavePackage(v2_3_0, readTestData(t, "bridge_2.3.0.tgz")))
+
+	return ver
+}
+
+// newTestUpdate returns the update to version 2.4.0 with a delta from version 2.3.0.
+func newTestUpdate(t *testing.T, kr *crypto.KeyRing, pkg []byte) VersionInfo {
+	return VersionInfo{
+		Version: v2_4_0,
+		MinAuto: v2_3_0,
+		Package: "https://proton.me/download/bridge/bridge_2.4.0.tgz",
+		Deltas: []Delta{{
+			From:      v2_3_0,
+			Package:   "https://proton.me/download/bridge/bridge_2.3.0_2.4.0.bsdiff",
+			Signature: signArmored(t, kr, pkg),
+		}},
+		RolloutProportion: 1.0,
+	}
+}
+
+// requireInstalled checks that version 2.4.0 is installed and its update package is kept for the next delta.
+func requireInstalled(t *testing.T, ver *versioner.Versioner) {
+	pkg, err := ver.LoadPackage(v2_4_0)
+	require.NoError(t, err)
+	require.Equal(t, readTestData(t, "bridge_2.4.0.tgz"), pkg)
+
+	versions, err := ver.ListVersions()
+	require.NoError(t, err)
+	require.True(t, versions.HasVersion(v2_4_0))
+
+	exe, err := versions[0].GetExecutable("proton-bridge")
+	require.NoError(t, err)
+
+	b, err := os.ReadFile(exe) //nolint:gosec
+	require.NoError(t, err)
+	require.Contains(t, string(b), "proton-bridge 2.4.0")
+}
+
+func signArmored(t *testing.T, kr *crypto.KeyRing, b []byte) string {
+	sig, err := kr.SignDetached(crypto.NewPlainMessage(b))
+	require.NoError(t, err)
+
+	armored, err := sig.GetArmored()
+	require.NoError(t, err)
+
+	return armored
+}
+
+func readTestData(t *testing.T, name string) []byte {
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	return b
+}
@@ -33,6 +33,9 @@ type VersionInfo struct {
 	// Package is the location of the update package.
 	Package string
 
+	// Deltas are binary diffs that rebuild the update package from the update package of an older version.
+	Deltas []Delta
+
 	// Installers are the locations of installer files (for manual installation).
 	Installers []string
 
@@ -46,6 +49,18 @@ type VersionInfo struct {
 	RolloutProportion float64
 }
 
+// Delta is a bsdiff patch from the update package of an older version to the update package of a version.
+type Delta struct {
+	// From is the version whose update package the patch applies to.
+	From *semver.Version
+
+	// Package is the location of the patch. Like the update package, it has a detached signature next to it.
+	Package string
+
+	// Signature is the armored detached signature of the rebuilt update package.
+	Signature string
+}
+
 // VersionMap represents the structure of the version.json file.
 // It looks like this:
 //
@@ -53,6 +68,13 @@ type VersionInfo struct {
 //	  "stable": {
 //	    "Version": "2.3.4",
 //	    "Package": "https://proton.me/.../bridge_2.3.4_linux.tgz",
+//	    "Deltas": [
+//	      {
+//	        "From": "2.3.3",
+//	        "Package": "https://proton.me/.../bridge_2.3.3_2.3.4_linux.bsdiff",
+//	        "Signature": "-----BEGIN PGP SIGNATURE-----..."
+//	      }
+//	    ],
 //	    "Installers": [
 //	      "https://proton.me/.../something.deb",
 //	      "https://proton.me/.../something.rpm",
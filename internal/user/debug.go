@@ -260,6 +260,8 @@ func TryBuildDebugMessage(path string) error {
 		Attachments: da,
 	}
 
+	defer func() { _ = decryptedMessage.Close() }()
+
 	if bodyDecrypted {
 		decryptedMessage.Body.Write(body)
 	} else {
@@ -537,11 +539,13 @@ func loadAttachments(dir string, meta *DebugMetadata) ([]bmessage.DecryptedAttac
 		da := bmessage.DecryptedAttachment{
 			Packet:    nil,
 			Encrypted: nil,
-			Data:      bytes.Buffer{},
+			Data:      bmessage.SpillBuffer{},
 			Err:       nil,
 		}
 
-		da.Data.Write(data)
+		if _, err := da.Data.Write(data); err != nil {
+			return nil, fmt.Errorf("failed to load attachment (%v,%v): %w", a.ID, a.Name, err)
+		}
 
 		attDecrypted = append(attDecrypted, da)
 	}
@@ -0,0 +1,292 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/gluon/rfc5322"
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
+)
+
+const (
+	// readReceiptLookupInterval is the minimum time between two lookups of read messages that bridge
+	// didn't build recently and so can't tell whether they request a read receipt.
+	readReceiptLookupInterval = time.Second
+
+	// readReceiptLookupQueueSize bounds the pending lookups; messages read beyond it are not looked up.
+	readReceiptLookupQueueSize = 1000
+)
+
+// ErrNoReadReceiptRequested is returned when sending a read receipt for a message which didn't ask for one.
+var ErrNoReadReceiptRequested = errors.New("the message did not request a read receipt")
+
+// readReceiptRequest is a request for a read receipt found in a received message.
+type readReceiptRequest struct {
+	messageID string
+	addrID    string
+	subject   string
+	from      *mail.Address
+	to        *mail.Address
+
+	// externalID is the Message-ID of the message.
+	externalID string
+
+	// trusted is false if the receipt would not go back to the sender (Return-Path) of the message;
+	// such receipts are never sent without asking the user, as recommended by RFC 8098.
+	trusted bool
+}
+
+// GetReadReceiptMode returns how read receipt requests of the user's received messages are handled.
+func (user *User) GetReadReceiptMode() vault.ReadReceiptMode {
+	return user.vault.ReadReceiptMode()
+}
+
+// SetReadReceiptMode sets how read receipt requests of the user's received messages are handled.
+func (user *User) SetReadReceiptMode(mode vault.ReadReceiptMode) error {
+	return user.vault.SetReadReceiptMode(mode)
+}
+
+// OnMessagesRead handles the read receipt requests of the messages an IMAP client marked as read.
+// Messages whose header is known are handled right away; the others are queued to be looked up one at a time.
+func (user *User) OnMessagesRead(messages []imapservice.ReadMessage) {
+	if user.vault.ReadReceiptMode() == vault.ReadReceiptIgnore {
+		return
+	}
+
+	var known []proton.Message
+
+	for _, msg := range messages {
+		if user.vault.IsReadReceiptHandled(msg.ID) {
+			continue
+		}
+
+		if msg.Message != nil {
+			known = append(known, *msg.Message)
+			continue
+		}
+
+		select {
+		case user.readReceiptLookupCh <- msg.ID:
+
+		default:
+			user.log.WithField("messageID", msg.ID).Warn("Too many pending read receipt lookups, ignoring message")
+		}
+	}
+
+	if len(known) == 0 {
+		return
+	}
+
+	user.tasks.Once(func(ctx context.Context) {
+		for _, msg := range known {
+			if err := user.handleReadReceipt(ctx, msg); err != nil {
+				user.log.WithField("messageID", msg.ID).WithError(err).Warn("Failed to handle read receipt request")
+			}
+		}
+	})
+}
+
+// OnMessageDeleted forgets about the read receipt request of a deleted message, if it was handled.
+func (user *User) OnMessageDeleted(messageID string) {
+	if !user.vault.IsReadReceiptHandled(messageID) {
+		return
+	}
+
+	if err := user.vault.ForgetReadReceiptHandled(messageID); err != nil {
+		user.log.WithField("messageID", messageID).WithError(err).Warn("Failed to forget handled read receipt request")
+	}
+}
+
+// lookupReadReceipts fetches the queued read messages, at most one per readReceiptLookupInterval,
+// and handles their read receipt requests.
+func (user *User) lookupReadReceipts(ctx context.Context) {
+	for {
+		var messageID string
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case messageID = <-user.readReceiptLookupCh:
+		}
+
+		if err := user.lookupReadReceipt(ctx, messageID); err != nil {
+			user.log.WithField("messageID", messageID).WithError(err).Warn("Failed to handle read receipt request")
+		}
+
+		if !clock.Sleep(ctx, user.clock, readReceiptLookupInterval) {
+			return
+		}
+	}
+}
+
+func (user *User) lookupReadReceipt(ctx context.Context, messageID string) error {
+	if user.vault.ReadReceiptMode() == vault.ReadReceiptIgnore || user.vault.IsReadReceiptHandled(messageID) {
+		return nil
+	}
+
+	msg, err := user.client.GetMessage(ctx, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to get message: %w", err)
+	}
+
+	return user.handleReadReceipt(ctx, msg)
+}
+
+// SendReadReceipt sends the read receipt requested by the given message.
+func (user *User) SendReadReceipt(ctx context.Context, messageID string) error {
+	req, ok, err := user.getReadReceiptRequest(ctx, messageID)
+	if err != nil {
+		return err
+	} else if !ok {
+		return ErrNoReadReceiptRequested
+	}
+
+	if err := user.sendReadReceipt(ctx, req, false); err != nil {
+		return err
+	}
+
+	return user.vault.SetReadReceiptHandled(messageID)
+}
+
+func (user *User) handleReadReceipt(ctx context.Context, msg proton.Message) error {
+	if user.vault.IsReadReceiptHandled(msg.ID) {
+		return nil
+	}
+
+	req, ok, err := user.newReadReceiptRequest(ctx, msg)
+	if err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
+	if user.vault.ReadReceiptMode() == vault.ReadReceiptAuto && req.trusted {
+		if err := user.sendReadReceipt(ctx, req, true); err != nil {
+			return err
+		}
+	} else {
+		user.eventCh.Enqueue(events.UserReadReceiptRequested{
+			UserID:    user.id,
+			MessageID: req.messageID,
+			Recipient: req.to.Address,
+			Subject:   req.subject,
+		})
+	}
+
+	return user.vault.SetReadReceiptHandled(msg.ID)
+}
+
+// getReadReceiptRequest returns the read receipt requested by the given message, if it is a received message
+// with a Disposition-Notification-To header.
+func (user *User) getReadReceiptRequest(ctx context.Context, messageID string) (readReceiptRequest, bool, error) {
+	msg, err := user.client.GetMessage(ctx, messageID)
+	if err != nil {
+		return readReceiptRequest{}, false, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	return user.newReadReceiptRequest(ctx, msg)
+}
+
+// newReadReceiptRequest returns the read receipt requested by the given message, as getReadReceiptRequest.
+func (user *User) newReadReceiptRequest(ctx context.Context, msg proton.Message) (readReceiptRequest, bool, error) {
+	if msg.Flags&proton.MessageFlagReceived == 0 {
+		return readReceiptRequest{}, false, nil
+	}
+
+	header, err := rfc822.NewHeader([]byte(msg.Header))
+	if err != nil {
+		return readReceiptRequest{}, false, fmt.Errorf("failed to parse message header: %w", err)
+	}
+
+	value, ok := header.GetChecked("Disposition-Notification-To")
+	if !ok {
+		return readReceiptRequest{}, false, nil
+	}
+
+	to, err := rfc5322.ParseAddressList(value)
+	if err != nil || len(to) == 0 {
+		user.log.WithField("messageID", msg.ID).WithError(err).Warn("Ignoring invalid read receipt request")
+		return readReceiptRequest{}, false, nil
+	}
+
+	addrs, err := user.identityService.GetAddresses(ctx)
+	if err != nil {
+		return readReceiptRequest{}, false, fmt.Errorf("failed to get addresses: %w", err)
+	}
+
+	addr, ok := addrs[msg.AddressID]
+	if !ok {
+		return readReceiptRequest{}, false, ErrNoSuchAddress
+	}
+
+	return readReceiptRequest{
+		messageID:  msg.ID,
+		addrID:     addr.ID,
+		subject:    msg.Subject,
+		from:       &mail.Address{Name: addr.DisplayName, Address: addr.Email},
+		to:         to[0],
+		externalID: msg.ExternalID,
+		trusted:    isReturnPath(header, to[0].Address),
+	}, true, nil
+}
+
+func (user *User) sendReadReceipt(ctx context.Context, req readReceiptRequest, automatic bool) error {
+	literal, err := message.BuildReadReceipt(message.ReadReceipt{
+		From:              req.from,
+		To:                req.to,
+		OriginalMessageID: req.externalID,
+		OriginalSubject:   req.subject,
+		Automatic:         automatic,
+		Date:              user.clock.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build read receipt: %w", err)
+	}
+
+	if err := user.smtpService.SendMail(ctx, req.addrID, req.from.Address, []string{req.to.Address}, bytes.NewReader(literal)); err != nil {
+		return fmt.Errorf("failed to send read receipt: %w", err)
+	}
+
+	user.log.WithField("messageID", req.messageID).WithField("automatic", automatic).Info("Sent read receipt")
+
+	return nil
+}
+
+// isReturnPath returns whether the given address is the Return-Path of the message with the given header.
+func isReturnPath(header *rfc822.Header, address string) bool {
+	returnPath, err := rfc5322.ParseAddressList(header.Get("Return-Path"))
+	if err != nil || len(returnPath) == 0 {
+		return false
+	}
+
+	return strings.EqualFold(returnPath[0].Address, address)
+}
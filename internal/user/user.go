@@ -26,8 +26,11 @@ import (
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/gluon/reporter"
 	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/membudget"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/eventjournal"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/notifications"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/observability"
@@ -75,7 +78,9 @@ type User struct {
 
 	tasks *async.Group
 
-	maxSyncMemory uint64
+	readReceiptLookupCh chan string
+
+	memBudget *membudget.Budget
 
 	panicHandler     async.PanicHandler
 	telemetryManager telemetry.Availability
@@ -86,10 +91,14 @@ type User struct {
 	imapService         *imapservice.Service
 	telemetryService    *telemetryservice.Service
 	notificationService *notifications.Service
+	eventJournalService *eventjournal.Service
 
 	observabilityService *observability.Service
 
 	serviceGroup *orderedtasks.OrderedCancelGroup
+
+	// clock paces the user's own timers: read receipt lookups and sync retries.
+	clock clock.Clock
 }
 
 func New(
@@ -100,7 +109,7 @@ func New(
 	apiUser proton.User,
 	crashHandler async.PanicHandler,
 	showAllMail bool,
-	maxSyncMemory uint64,
+	memBudget *membudget.Budget,
 	telemetryManager telemetry.Availability,
 	imapServerManager imapservice.IMAPServerManager,
 	smtpServerManager smtp.ServerManager,
@@ -110,7 +119,10 @@ func New(
 	syncConfigDir string,
 	isNew bool,
 	notificationStore *notifications.Store,
+	eventJournal *eventjournal.Journal,
 	getFlagValFn unleash.GetFlagValueFn,
+	spillDir func() string,
+	clock clock.Clock,
 ) (*User, error) {
 	user, err := newImpl(
 		ctx,
@@ -120,7 +132,7 @@ func New(
 		apiUser,
 		crashHandler,
 		showAllMail,
-		maxSyncMemory,
+		memBudget,
 		telemetryManager,
 		imapServerManager,
 		smtpServerManager,
@@ -130,7 +142,10 @@ func New(
 		syncConfigDir,
 		isNew,
 		notificationStore,
+		eventJournal,
 		getFlagValFn,
+		spillDir,
+		clock,
 	)
 	if err != nil {
 		// Cleanup any pending resources on error
@@ -153,7 +168,7 @@ func newImpl(
 	apiUser proton.User,
 	crashHandler async.PanicHandler,
 	showAllMail bool,
-	maxSyncMemory uint64,
+	memBudget *membudget.Budget,
 	telemetryManager telemetry.Availability,
 	imapServerManager imapservice.IMAPServerManager,
 	smtpServerManager smtp.ServerManager,
@@ -163,7 +178,10 @@ func newImpl(
 	syncConfigDir string,
 	isNew bool,
 	notificationStore *notifications.Store,
+	eventJournal *eventjournal.Journal,
 	getFlagValueFn unleash.GetFlagValueFn,
+	spillDir func() string,
+	clock clock.Clock,
 ) (*User, error) {
 	logrus.WithField("userID", apiUser.ID).Info("Creating new user")
 
@@ -219,7 +237,9 @@ func newImpl(
 
 		tasks: async.NewGroup(context.Background(), crashHandler),
 
-		maxSyncMemory: maxSyncMemory,
+		readReceiptLookupCh: make(chan string, readReceiptLookupQueueSize),
+
+		memBudget: memBudget,
 
 		panicHandler: crashHandler,
 
@@ -229,6 +249,8 @@ func newImpl(
 		smtpService:  nil,
 
 		observabilityService: observabilityService,
+
+		clock: clock,
 	}
 
 	user.eventService = userevents.NewService(
@@ -261,6 +283,7 @@ func newImpl(
 		addressMode,
 		identityState.Clone(),
 		smtpServerManager,
+		user,
 		observabilityService,
 	)
 
@@ -271,6 +294,7 @@ func newImpl(
 		user.eventService,
 		imapServerManager,
 		user,
+		user,
 		encVault,
 		encVault,
 		crashHandler,
@@ -279,13 +303,19 @@ func newImpl(
 		addressMode,
 		eventSubscription,
 		syncConfigDir,
-		user.maxSyncMemory,
-		showAllMail,
+		user.memBudget,
+		encVault.RepairMIME(),
+		spillDir,
+		showAllMail && !encVault.HideAllMail(),
+		unixSyncCutoff(encVault.SyncCutoff()),
 		observabilityService,
+		clock,
 	)
 
 	user.notificationService = notifications.NewService(user.id, user.eventService, user, notificationStore, getFlagValueFn, observabilityService)
 
+	user.eventJournalService = eventjournal.NewService(user.id, user.eventService, eventJournal, apiLabels)
+
 	// When we receive an auth object, we update it in the vault.
 	// This will be used to authorize the user on the next run.
 	user.client.AddAuthHandler(func(auth proton.Auth) {
@@ -316,6 +346,9 @@ func newImpl(
 		}
 	}
 
+	// Look up the read receipt requests of read messages bridge didn't build recently.
+	user.tasks.Once(user.lookupReadReceipts)
+
 	// Start Event Service
 	lastEventID, err := user.eventService.Start(ctx, user.serviceGroup)
 	if err != nil {
@@ -334,6 +367,9 @@ func newImpl(
 	// Start Notification service
 	user.notificationService.Start(ctx, user.serviceGroup)
 
+	// Start Event Journal service
+	user.eventJournalService.Start(ctx, user.serviceGroup)
+
 	// Start SMTP Service
 	if err := user.smtpService.Start(ctx, user.serviceGroup); err != nil {
 		return user, fmt.Errorf("failed to start smtp service: %w", err)
@@ -423,6 +459,63 @@ func (user *User) Emails() []string {
 	})
 }
 
+// GetHideAllMail returns whether the All Mail mailbox is hidden for this user.
+func (user *User) GetHideAllMail() bool {
+	return user.vault.HideAllMail()
+}
+
+// SetHideAllMail sets whether the All Mail mailbox is hidden for this user. It only stores the setting;
+// the All Mail visibility is recomputed by SetShowAllMail, which bridge.SetUserHideAllMail calls right away.
+func (user *User) SetHideAllMail(hide bool) error {
+	return user.vault.SetHideAllMail(hide)
+}
+
+// GetRepairMIME returns whether malformed MIME is repaired when building this user's messages.
+func (user *User) GetRepairMIME() bool {
+	return user.vault.RepairMIME()
+}
+
+// SetRepairMIME sets whether malformed MIME is repaired when building this user's messages.
+// The messages already downloaded were built with the previous setting, so a change triggers a resync to rebuild them.
+func (user *User) SetRepairMIME(repair bool) error {
+	if repair == user.vault.RepairMIME() {
+		return nil
+	}
+
+	if err := user.vault.SetRepairMIME(repair); err != nil {
+		return err
+	}
+
+	user.imapService.SetRepairMIME(repair)
+
+	return user.resyncIMAP()
+}
+
+// GetSyncCutoff returns the time before which this user's messages are not synced; zero means all messages are.
+func (user *User) GetSyncCutoff() time.Time {
+	return user.vault.SyncCutoff()
+}
+
+// SetSyncCutoff sets the time before which this user's messages are not synced; zero means all messages are.
+// The local store is adjusted right away: the messages the sync window no longer covers are removed, and those it
+// now covers are synced.
+func (user *User) SetSyncCutoff(ctx context.Context, cutoff time.Time) error {
+	if err := user.vault.SetSyncCutoff(cutoff); err != nil {
+		return err
+	}
+
+	return user.imapService.SetSyncCutoff(ctx, unixSyncCutoff(cutoff))
+}
+
+// unixSyncCutoff returns the sync cutoff as a unix time, zero meaning all messages are synced.
+func unixSyncCutoff(cutoff time.Time) int64 {
+	if cutoff.IsZero() {
+		return 0
+	}
+
+	return cutoff.Unix()
+}
+
 // GetAddressMode returns the user's current address mode.
 func (user *User) GetAddressMode() vault.AddressMode {
 	return user.vault.AddressMode()
@@ -481,11 +574,13 @@ func (user *User) OnBadEvent(ctx context.Context) {
 	}
 }
 
-// SetShowAllMail sets whether to show the All Mail mailbox.
+// SetShowAllMail sets whether to show the All Mail mailbox. The mailbox stays hidden if the user chose to hide it.
 func (user *User) SetShowAllMail(show bool) {
 	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Minute))
 	defer cancel()
 
+	show = show && !user.vault.HideAllMail()
+
 	user.log.WithField("show", show).Info("Setting show all mail")
 
 	if err := user.imapService.ShowAllMail(ctx, show); err != nil {
@@ -521,6 +616,20 @@ func (user *User) GetGluonID(addrID string) (string, bool) {
 	return "", false
 }
 
+// GetGluonIDAddress returns the email of the address served by the given gluon ID.
+// In combined mode, all addresses share the gluon ID and the primary address is returned.
+func (user *User) GetGluonIDAddress(gluonID string) (string, bool) {
+	gluonIDs := user.vault.GetGluonIDs()
+
+	for _, addr := range user.protonAddresses() {
+		if gluonIDs[addr.ID] == gluonID {
+			return addr.Email, true
+		}
+	}
+
+	return "", false
+}
+
 // SetGluonID sets the gluon ID for the given address.
 func (user *User) SetGluonID(addrID, gluonID string) error {
 	user.log.WithFields(logrus.Fields{
@@ -551,6 +660,32 @@ func (user *User) BridgePass() []byte {
 	return algo.B64RawEncode(user.vault.BridgePass())
 }
 
+// AppPasswords returns the user's app passwords, each of which can also be used for authentication over SMTP and IMAP.
+func (user *User) AppPasswords() []vault.AppPassword {
+	return user.vault.AppPasswords()
+}
+
+// AddAppPassword generates a new app password with the given name and returns it.
+func (user *User) AddAppPassword(name string) ([]byte, error) {
+	pass, err := user.vault.AddAppPassword(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return algo.B64RawEncode(pass), nil
+}
+
+// RemoveAppPassword revokes the app password with the given name.
+func (user *User) RemoveAppPassword(name string) error {
+	return user.vault.RemoveAppPassword(name)
+}
+
+// NewAuthToken mints a local auth token that IMAP and SMTP clients can use instead of the bridge password,
+// e.g. with XOAUTH2, until it expires or the bridge password changes.
+func (user *User) NewAuthToken(expiresAt time.Time) []byte {
+	return useridentity.NewAuthToken(user.vault.BridgePass(), user.id, expiresAt)
+}
+
 // UsedSpace returns the total space used by the user on the API.
 func (user *User) UsedSpace() uint64 {
 	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Minute))
@@ -591,6 +726,22 @@ func (user *User) CheckAuth(email string, password []byte) (string, error) {
 	return user.identityService.CheckAuth(ctx, email, password)
 }
 
+// Lock removes the user from the IMAP and SMTP servers, keeping its vault secrets and IMAP data.
+// The user must be closed afterwards; it is loaded again from the vault when bridge is unlocked.
+func (user *User) Lock(ctx context.Context) error {
+	user.log.Info("Locking user")
+
+	if err := user.smtpService.OnLogout(ctx); err != nil {
+		return fmt.Errorf("failed to remove user from smtp server: %w", err)
+	}
+
+	if err := user.imapService.OnLogout(ctx); err != nil {
+		return fmt.Errorf("failed to remove user from imap server: %w", err)
+	}
+
+	return nil
+}
+
 // Logout logs the user out from the API.
 func (user *User) Logout(ctx context.Context, withAPI, withData, withDataDisabledKillSwitch bool) error {
 	user.log.WithFields(
@@ -684,6 +835,11 @@ func (user *User) Close() {
 	}
 }
 
+// GetEventJournal returns the journal of the events processed for this user, from the oldest to the most recent.
+func (user *User) GetEventJournal() []eventjournal.Entry {
+	return user.eventJournalService.Entries()
+}
+
 // IsTelemetryEnabled check if the telemetry is enabled or disabled for this user.
 func (user *User) IsTelemetryEnabled(ctx context.Context) bool {
 	return user.telemetryService.IsTelemetryEnabled(ctx)
@@ -691,6 +847,10 @@ func (user *User) IsTelemetryEnabled(ctx context.Context) bool {
 
 // SendTelemetry send telemetry request.
 func (user *User) SendTelemetry(ctx context.Context, data []byte) error {
+	if telemetry.IsReportingDisabled() {
+		return telemetry.ErrReportingDisabled
+	}
+
 	var req proton.SendStatsReq
 	if err := json.Unmarshal(data, &req); err != nil {
 		user.log.WithError(err).Error("Failed to build telemetry request.")
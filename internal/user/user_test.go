@@ -27,7 +27,9 @@ import (
 	"github.com/ProtonMail/go-proton-api/server"
 	"github.com/ProtonMail/go-proton-api/server/backend"
 	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/clock"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/eventjournal"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/notifications"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/observability"
@@ -159,7 +161,7 @@ func withUser(tb testing.TB, ctx context.Context, _ *server.Server, m *proton.Ma
 		apiUser,
 		nil,
 		true,
-		vault.DefaultMaxSyncMemory,
+		nil,
 		manager,
 		nullIMAPServerManager,
 		nullSMTPServerManager,
@@ -171,9 +173,14 @@ func withUser(tb testing.TB, ctx context.Context, _ *server.Server, m *proton.Ma
 		notifications.NewStore(func() (string, error) {
 			return "", nil
 		}),
+		eventjournal.Open(tb.TempDir(), apiUser.ID),
 		func(_ string) bool {
 			return false
 		},
+		func() string {
+			return tb.TempDir()
+		},
+		clock.New(),
 	)
 	require.NoError(tb, err)
 	defer user.Close()
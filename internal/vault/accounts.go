@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/bradenaw/juniper/xslices"
+)
+
+// accountsFileName is the name of the copy of the vault's accounts, without their secrets, in the vault directory.
+const accountsFileName = "accounts.enc"
+
+// AccountsPath returns the path of the copy of the vault's accounts in the given vault directory.
+func AccountsPath(vaultDir string) string {
+	return filepath.Join(vaultDir, accountsFileName)
+}
+
+// KeepAccounts makes the vault keep a copy of its accounts at the given path, updated whenever the vault is.
+// The users' secrets are left out, so unlike the vault, the copy isn't protected by the vault key: it can be read
+// when the keychain holding the key can't be reached.
+func (vault *Vault) KeepAccounts(path string) error {
+	vault.lock.Lock()
+	defer vault.lock.Unlock()
+
+	vault.accountsPath = path
+
+	return vault.writeAccountsUnsafe(vault.getUnsafe())
+}
+
+// OpenAccounts opens the copy of the accounts of a vault kept at the given path.
+// The returned vault is locked: it can't be modified and its users have no secrets.
+func OpenAccounts(path string) (*Vault, error) {
+	gcm, err := newGCM(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	enc, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var data Data
+
+	if err := unmarshalFile(gcm, enc, &data); err != nil {
+		return nil, err
+	}
+
+	return &Vault{
+		path:   path,
+		enc:    enc,
+		ref:    make(map[string]int),
+		locked: &data,
+	}, nil
+}
+
+// writeAccountsUnsafe writes the copy of the accounts of the given vault data, if the vault keeps one.
+func (vault *Vault) writeAccountsUnsafe(data Data) error {
+	if vault.accountsPath == "" {
+		return nil
+	}
+
+	gcm, err := newGCM(nil)
+	if err != nil {
+		return err
+	}
+
+	enc, err := marshalFile(gcm, Data{Users: xslices.Map(data.Users, func(user UserData) UserData {
+		user.AppPasswords = slices.Clone(user.AppPasswords)
+		user.wipeSecrets()
+
+		return user
+	})})
+	if err != nil {
+		return err
+	}
+
+	tmpFile := vault.accountsPath + ".tmp"
+
+	if err := os.WriteFile(tmpFile, enc, 0o600); err != nil {
+		return fmt.Errorf("failed to write accounts to disk: %w", err)
+	}
+
+	if err := os.Rename(tmpFile, vault.accountsPath); err != nil {
+		return fmt.Errorf("failed to overwrite old accounts: %w", err)
+	}
+
+	return nil
+}
@@ -18,11 +18,11 @@
 package vault
 
 import (
-	"crypto/tls"
 	"fmt"
 	"os"
 	"path/filepath"
 
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
 	"github.com/sirupsen/logrus"
 )
 
@@ -58,6 +58,21 @@ func (vault *Vault) SetBridgeTLSCertPath(certPath, keyPath string) error {
 	})
 }
 
+// GetBridgeTLSCertPath returns the paths to the PEM-encoded certificate and key files, if any.
+func (vault *Vault) GetBridgeTLSCertPath() (string, string) {
+	data := vault.getSafe().Certs
+
+	return data.CustomCertPath, data.CustomKeyPath
+}
+
+// ClearBridgeTLSCertPath stops using certificate files; the bridge certificate stored in the vault is used instead.
+func (vault *Vault) ClearBridgeTLSCertPath() error {
+	return vault.modSafe(func(data *Data) {
+		data.Certs.CustomCertPath = ""
+		data.Certs.CustomKeyPath = ""
+	})
+}
+
 // SetBridgeTLSCertKey sets the path to PEM-encoded certificates for the bridge.
 func (vault *Vault) SetBridgeTLSCertKey(cert, key []byte) error {
 	return vault.modSafe(func(data *Data) {
@@ -77,7 +92,7 @@ func readPEMCert(certPEMPath, keyPEMPath string) ([]byte, []byte, error) {
 		return nil, nil, err
 	}
 
-	if _, err := tls.X509KeyPair(certPEM, keyPEM); err != nil {
+	if err := certs.ValidateKeyPair(certPEM, keyPEM); err != nil {
 		return nil, nil, err
 	}
 
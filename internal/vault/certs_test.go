@@ -18,8 +18,11 @@
 package vault_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,3 +35,40 @@ func TestVault_TLSCerts(t *testing.T) {
 	require.NotEmpty(t, cert)
 	require.NotEmpty(t, key)
 }
+
+func TestVault_TLSCertPath(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default bridge TLS cert paths.
+	certPath, keyPath := s.GetBridgeTLSCertPath()
+	require.Empty(t, certPath)
+	require.Empty(t, keyPath)
+
+	// Write a certificate to files.
+	template, err := certs.NewTLSTemplate()
+	require.NoError(t, err)
+
+	certPEM, keyPEM, err := certs.GenerateCert(template)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cert.pem"), certPEM, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key.pem"), keyPEM, 0o600))
+
+	// Files that do not hold a key pair are rejected.
+	require.Error(t, s.SetBridgeTLSCertPath(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "cert.pem")))
+
+	// Use the certificate files.
+	require.NoError(t, s.SetBridgeTLSCertPath(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")))
+
+	cert, key := s.GetBridgeTLSCert()
+	require.Equal(t, certPEM, cert)
+	require.Equal(t, keyPEM, key)
+
+	// Stop using the certificate files.
+	require.NoError(t, s.ClearBridgeTLSCertPath())
+
+	cert, _ = s.GetBridgeTLSCert()
+	require.NotEqual(t, certPEM, cert)
+}
@@ -72,6 +72,27 @@ func SetHelper(vaultDir, helper string) error {
 	return settings.Save(vaultDir)
 }
 
+// GetUseFileVault returns whether the vault is kept in a file with no key rather than keyed in the keychain.
+func GetUseFileVault(vaultDir string) (bool, error) {
+	settings, err := LoadKeychainSettings(vaultDir)
+	if err != nil {
+		return false, err
+	}
+
+	return settings.FileVault, nil
+}
+
+// SetUseFileVault sets whether the vault is kept in a file with no key rather than keyed in the keychain.
+func SetUseFileVault(vaultDir string, fileVault bool) error {
+	settings, err := LoadKeychainSettings(vaultDir)
+	if err != nil {
+		return err
+	}
+
+	settings.FileVault = fileVault
+	return settings.Save(vaultDir)
+}
+
 func GetVaultKey(kc *keychain.Keychain) ([]byte, error) {
 	_, keyEnc, err := kc.Get(vaultSecretName)
 	if err != nil {
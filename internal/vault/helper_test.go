@@ -34,6 +34,17 @@ func TestShouldSkipKeychainTestAccessors(t *testing.T) {
 	require.True(t, skip)
 }
 
+func TestUseFileVaultAccessors(t *testing.T) {
+	dir := t.TempDir()
+	fileVault, err := GetUseFileVault(dir)
+	require.NoError(t, err)
+	require.False(t, fileVault)
+	require.NoError(t, SetUseFileVault(dir, true))
+	fileVault, err = GetUseFileVault(dir)
+	require.NoError(t, err)
+	require.True(t, fileVault)
+}
+
 func TestHelperAccessors(t *testing.T) {
 	dir := t.TempDir()
 	helper, err := GetHelper(dir)
@@ -33,6 +33,7 @@ const keychainSettingsFileName = "keychain.json"
 type KeychainSettings struct {
 	Helper      string // The helper used for keychain.
 	DisableTest bool   // Is the keychain test on startup disabled?
+	FileVault   bool   // Is the vault kept in a file with no key, leaving the keychain unused?
 }
 
 // LoadKeychainSettings load keychain settings from the vaultDir folder, or returns a default one if the file
@@ -28,6 +28,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
 )
 
 const (
@@ -82,6 +83,66 @@ func (vault *Vault) SetSMTPSSL(ssl bool) error {
 	})
 }
 
+// GetIMAPSSLRequired returns whether the IMAP server rejects authentication over unencrypted connections.
+func (vault *Vault) GetIMAPSSLRequired() bool {
+	return vault.getSafe().Settings.IMAPSSLRequired
+}
+
+// SetIMAPSSLRequired sets whether the IMAP server rejects authentication over unencrypted connections.
+func (vault *Vault) SetIMAPSSLRequired(required bool) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.IMAPSSLRequired = required
+	})
+}
+
+// GetSMTPSSLRequired returns whether the SMTP server rejects authentication over unencrypted connections.
+func (vault *Vault) GetSMTPSSLRequired() bool {
+	return vault.getSafe().Settings.SMTPSSLRequired
+}
+
+// SetSMTPSSLRequired sets whether the SMTP server rejects authentication over unencrypted connections.
+func (vault *Vault) SetSMTPSSLRequired(required bool) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.SMTPSSLRequired = required
+	})
+}
+
+// GetIMAPImplicitTLSPort returns the port of the additional implicit TLS IMAP listener, zero meaning it is disabled.
+func (vault *Vault) GetIMAPImplicitTLSPort() int {
+	return vault.getSafe().Settings.IMAPImplicitTLSPort
+}
+
+// SetIMAPImplicitTLSPort sets the port of the additional implicit TLS IMAP listener, zero meaning it is disabled.
+func (vault *Vault) SetIMAPImplicitTLSPort(port int) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.IMAPImplicitTLSPort = port
+	})
+}
+
+// GetSMTPImplicitTLSPort returns the port of the additional implicit TLS SMTP listener, zero meaning it is disabled.
+func (vault *Vault) GetSMTPImplicitTLSPort() int {
+	return vault.getSafe().Settings.SMTPImplicitTLSPort
+}
+
+// SetSMTPImplicitTLSPort sets the port of the additional implicit TLS SMTP listener, zero meaning it is disabled.
+func (vault *Vault) SetSMTPImplicitTLSPort(port int) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.SMTPImplicitTLSPort = port
+	})
+}
+
+// GetBindAddress returns the address the IMAP and SMTP servers listen on, empty meaning the loopback address.
+func (vault *Vault) GetBindAddress() string {
+	return vault.getSafe().Settings.BindAddress
+}
+
+// SetBindAddress sets the address the IMAP and SMTP servers listen on, empty meaning the loopback address.
+func (vault *Vault) SetBindAddress(address string) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.BindAddress = address
+	})
+}
+
 // GetGluonCacheDir sets the directory where the gluon should store its data.
 func (vault *Vault) GetGluonCacheDir() string {
 	return vault.getSafe().Settings.GluonDir
@@ -152,6 +213,115 @@ func (vault *Vault) SetProxyAllowed(allowed bool) error {
 	})
 }
 
+// GetSOCKSProxy returns the URL of the SOCKS5 proxy used to reach the API, if any.
+func (vault *Vault) GetSOCKSProxy() string {
+	return vault.getSafe().Settings.SOCKSProxy
+}
+
+// SetSOCKSProxy sets the URL of the SOCKS5 proxy used to reach the API.
+func (vault *Vault) SetSOCKSProxy(proxyURL string) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.SOCKSProxy = proxyURL
+	})
+}
+
+// GetPACSource returns the URL or file path of the PAC script used to select the proxy to reach the API, if any.
+func (vault *Vault) GetPACSource() string {
+	return vault.getSafe().Settings.PACSource
+}
+
+// SetPACSource sets the URL or file path of the PAC script used to select the proxy to reach the API.
+func (vault *Vault) SetPACSource(source string) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.PACSource = source
+	})
+}
+
+// GetAPIHost returns the API address to use instead of the production one, if any.
+func (vault *Vault) GetAPIHost() string {
+	return vault.getSafe().Settings.APIHost
+}
+
+// SetAPIHost sets the API address to use instead of the production one.
+func (vault *Vault) SetAPIHost(host string) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.APIHost = host
+	})
+}
+
+// GetDoHProviders returns the custom DoH providers used to find proxies, and the disabled default ones.
+func (vault *Vault) GetDoHProviders() (custom, disabled []string) {
+	settings := vault.getSafe().Settings
+
+	return settings.DoHProviders, settings.DisabledDoHProviders
+}
+
+// SetDoHProviders sets the custom DoH providers used to find proxies, and the disabled default ones.
+func (vault *Vault) SetDoHProviders(custom, disabled []string) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.DoHProviders = custom
+		data.Settings.DisabledDoHProviders = disabled
+	})
+}
+
+// GetWebhooks returns the URLs notified of bridge events.
+func (vault *Vault) GetWebhooks() []Webhook {
+	return vault.getSafe().Settings.Webhooks
+}
+
+// SetWebhooks sets the URLs notified of bridge events.
+func (vault *Vault) SetWebhooks(webhooks []Webhook) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.Webhooks = webhooks
+	})
+}
+
+// GetAutoLockTimeout returns the inactivity after which bridge locks itself; zero means never.
+func (vault *Vault) GetAutoLockTimeout() time.Duration {
+	return vault.getSafe().Settings.AutoLockTimeout
+}
+
+// SetAutoLockTimeout sets the inactivity after which bridge locks itself; zero means never.
+func (vault *Vault) SetAutoLockTimeout(timeout time.Duration) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.AutoLockTimeout = timeout
+	})
+}
+
+// HasUnlockPassphrase returns whether a passphrase to unlock bridge is set.
+func (vault *Vault) HasUnlockPassphrase() bool {
+	return len(vault.getSafe().Settings.UnlockPassphraseHash) != 0
+}
+
+// SetUnlockPassphrase sets the passphrase which unlocks bridge; an empty passphrase removes it.
+// Only its hash is stored, so it can be checked while the vault is locked.
+func (vault *Vault) SetUnlockPassphrase(passphrase []byte) error {
+	var hash []byte
+
+	if len(passphrase) != 0 {
+		h, err := bcrypt.GenerateFromPassword(passphrase, bcrypt.DefaultCost)
+		if err != nil {
+			return fmt.Errorf("failed to hash unlock passphrase: %w", err)
+		}
+
+		hash = h
+	}
+
+	return vault.modSafe(func(data *Data) {
+		data.Settings.UnlockPassphraseHash = hash
+	})
+}
+
+// CheckUnlockPassphrase returns whether the given passphrase is the one which unlocks bridge.
+func (vault *Vault) CheckUnlockPassphrase(passphrase []byte) bool {
+	hash := vault.getSafe().Settings.UnlockPassphraseHash
+	if len(hash) == 0 {
+		return false
+	}
+
+	return bcrypt.CompareHashAndPassword(hash, passphrase) == nil
+}
+
 // GetShowAllMail sets whether the bridge should show the All Mail folder.
 func (vault *Vault) GetShowAllMail() bool {
 	return vault.getSafe().Settings.ShowAllMail
@@ -164,6 +334,42 @@ func (vault *Vault) SetShowAllMail(showAllMail bool) error {
 	})
 }
 
+// GetIPCOverTCP returns whether the focus and gRPC services should listen on TCP ports instead of sockets.
+func (vault *Vault) GetIPCOverTCP() bool {
+	return vault.getSafe().Settings.IPCOverTCP
+}
+
+// SetIPCOverTCP sets whether the focus and gRPC services should listen on TCP ports instead of sockets.
+func (vault *Vault) SetIPCOverTCP(overTCP bool) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.IPCOverTCP = overTCP
+	})
+}
+
+// GetReauthOnDeauth returns whether the user is asked to sign in again when a session is revoked.
+func (vault *Vault) GetReauthOnDeauth() bool {
+	return vault.getSafe().Settings.ReauthOnDeauth
+}
+
+// SetReauthOnDeauth sets whether the user is asked to sign in again when a session is revoked.
+func (vault *Vault) SetReauthOnDeauth(reauth bool) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.ReauthOnDeauth = reauth
+	})
+}
+
+// GetSessionAuditLog returns whether the IMAP sessions are recorded in the audit log.
+func (vault *Vault) GetSessionAuditLog() bool {
+	return vault.getSafe().Settings.SessionAuditLog
+}
+
+// SetSessionAuditLog sets whether the IMAP sessions are recorded in the audit log.
+func (vault *Vault) SetSessionAuditLog(enabled bool) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.SessionAuditLog = enabled
+	})
+}
+
 // GetAutostart sets whether the bridge should autostart.
 func (vault *Vault) GetAutostart() bool {
 	return vault.getSafe().Settings.Autostart
@@ -200,6 +406,18 @@ func (vault *Vault) SetTelemetryDisabled(telemetryDisabled bool) error {
 	})
 }
 
+// GetReportingDisabled checks whether all reporting (crash reports, telemetry and heartbeats) is disabled.
+func (vault *Vault) GetReportingDisabled() bool {
+	return vault.getSafe().Settings.ReportingDisabled
+}
+
+// SetReportingDisabled sets whether all reporting (crash reports, telemetry and heartbeats) is disabled.
+func (vault *Vault) SetReportingDisabled(reportingDisabled bool) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.ReportingDisabled = reportingDisabled
+	})
+}
+
 // GetLastVersion returns the last version of the bridge that was run.
 func (vault *Vault) GetLastVersion() *semver.Version {
 	lastVersion := vault.getSafe().Settings.LastVersion
@@ -219,6 +437,29 @@ func (vault *Vault) SetLastVersion(version *semver.Version) error {
 	})
 }
 
+// GetRolledBackVersion returns the version that was last rolled back from, or nil if there was no rollback.
+func (vault *Vault) GetRolledBackVersion() *semver.Version {
+	rolledBackVersion := vault.getSafe().Settings.RolledBackVersion
+	if rolledBackVersion == "" {
+		return nil
+	}
+
+	version, err := semver.NewVersion(rolledBackVersion)
+	if err != nil {
+		logrus.WithError(err).Error(fmt.Sprintf("Error encountered when trying to get rolled back version from vault: %s", rolledBackVersion))
+		return nil
+	}
+
+	return version
+}
+
+// SetRolledBackVersion sets the version that was last rolled back from.
+func (vault *Vault) SetRolledBackVersion(version *semver.Version) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.RolledBackVersion = version.String()
+	})
+}
+
 // GetFirstStart returns whether this is the first time the bridge has been started.
 func (vault *Vault) GetFirstStart() bool {
 	return vault.getSafe().Settings.FirstStart
@@ -249,6 +490,30 @@ func (vault *Vault) SetMaxSyncMemory(maxMemory uint64) error {
 	})
 }
 
+// GetMaxCacheSize returns the maximum disk space cached message bodies may use, zero meaning unlimited.
+func (vault *Vault) GetMaxCacheSize() uint64 {
+	return vault.getSafe().Settings.MaxCacheSize
+}
+
+// SetMaxCacheSize sets the maximum disk space cached message bodies may use, zero meaning unlimited.
+func (vault *Vault) SetMaxCacheSize(maxSize uint64) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.MaxCacheSize = maxSize
+	})
+}
+
+// GetKeepRecentDays returns how many days of recent messages have their bodies kept in the cache, zero meaning none.
+func (vault *Vault) GetKeepRecentDays() uint32 {
+	return vault.getSafe().Settings.KeepRecentDays
+}
+
+// SetKeepRecentDays sets how many days of recent messages have their bodies kept in the cache, zero meaning none.
+func (vault *Vault) SetKeepRecentDays(days uint32) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.KeepRecentDays = days
+	})
+}
+
 // GetLastUserAgent returns the last user agent recorded by bridge.
 func (vault *Vault) GetLastUserAgent() string {
 	v := vault.getSafe().Settings.LastUserAgent
@@ -279,3 +544,21 @@ func (vault *Vault) SetLastHeartbeatSent(timestamp time.Time) error {
 		data.Settings.LastHeartbeatSent = timestamp
 	})
 }
+
+// GetConfigApplied returns the value last applied for the given setting from the configuration file.
+func (vault *Vault) GetConfigApplied(key string) (string, bool) {
+	value, ok := vault.getSafe().Settings.ConfigApplied[key]
+
+	return value, ok
+}
+
+// SetConfigApplied records the value applied for the given setting from the configuration file.
+func (vault *Vault) SetConfigApplied(key, value string) error {
+	return vault.modSafe(func(data *Data) {
+		if data.Settings.ConfigApplied == nil {
+			data.Settings.ConfigApplied = make(map[string]string)
+		}
+
+		data.Settings.ConfigApplied[key] = value
+	})
+}
@@ -20,6 +20,7 @@ package vault_test
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gluon/async"
@@ -64,6 +65,54 @@ func TestVault_Settings_SMTP(t *testing.T) {
 	require.Equal(t, true, s.GetSMTPSSL())
 }
 
+func TestVault_Settings_SSLRequired(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default SSL requirements.
+	require.False(t, s.GetIMAPSSLRequired())
+	require.False(t, s.GetSMTPSSLRequired())
+
+	// Modify the SSL requirements.
+	require.NoError(t, s.SetIMAPSSLRequired(true))
+	require.NoError(t, s.SetSMTPSSLRequired(true))
+
+	// Check the new SSL requirements.
+	require.True(t, s.GetIMAPSSLRequired())
+	require.True(t, s.GetSMTPSSLRequired())
+}
+
+func TestVault_Settings_ImplicitTLSPorts(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default implicit TLS ports.
+	require.Equal(t, 0, s.GetIMAPImplicitTLSPort())
+	require.Equal(t, 0, s.GetSMTPImplicitTLSPort())
+
+	// Modify the implicit TLS ports.
+	require.NoError(t, s.SetIMAPImplicitTLSPort(1993))
+	require.NoError(t, s.SetSMTPImplicitTLSPort(1465))
+
+	// Check the new implicit TLS ports.
+	require.Equal(t, 1993, s.GetIMAPImplicitTLSPort())
+	require.Equal(t, 1465, s.GetSMTPImplicitTLSPort())
+}
+
+func TestVault_Settings_BindAddress(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default bind address.
+	require.Equal(t, "", s.GetBindAddress())
+
+	// Modify the bind address.
+	require.NoError(t, s.SetBindAddress("0.0.0.0"))
+
+	// Check the new bind address.
+	require.Equal(t, "0.0.0.0", s.GetBindAddress())
+}
+
 func TestVault_Settings_GluonDir(t *testing.T) {
 	// create a new test vault.
 	s, corrupt, err := vault.New(t.TempDir(), "/path/to/gluon", []byte("my secret key"), async.NoopPanicHandler{})
@@ -141,6 +190,120 @@ func TestVault_Settings_ProxyAllowed(t *testing.T) {
 	require.Equal(t, true, s.GetProxyAllowed())
 }
 
+func TestVault_Settings_SOCKSProxy(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default SOCKS5 proxy setting.
+	require.Equal(t, "", s.GetSOCKSProxy())
+
+	// Modify the SOCKS5 proxy setting.
+	require.NoError(t, s.SetSOCKSProxy("socks5://127.0.0.1:1080"))
+
+	// Check the new SOCKS5 proxy setting.
+	require.Equal(t, "socks5://127.0.0.1:1080", s.GetSOCKSProxy())
+}
+
+func TestVault_Settings_PACSource(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default PAC source setting.
+	require.Equal(t, "", s.GetPACSource())
+
+	// Modify the PAC source setting.
+	require.NoError(t, s.SetPACSource("http://wpad.example/proxy.pac"))
+
+	// Check the new PAC source setting.
+	require.Equal(t, "http://wpad.example/proxy.pac", s.GetPACSource())
+}
+
+func TestVault_Settings_APIHost(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default API host setting.
+	require.Equal(t, "", s.GetAPIHost())
+
+	// Modify the API host setting.
+	require.NoError(t, s.SetAPIHost("https://mail-api.mirror.example"))
+
+	// Check the new API host setting.
+	require.Equal(t, "https://mail-api.mirror.example", s.GetAPIHost())
+}
+
+func TestVault_Settings_DoHProviders(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default DoH providers setting.
+	custom, disabled := s.GetDoHProviders()
+	require.Empty(t, custom)
+	require.Empty(t, disabled)
+
+	// Modify the DoH providers setting.
+	require.NoError(t, s.SetDoHProviders([]string{"https://doh.example/dns-query"}, []string{"https://dns.google/dns-query"}))
+
+	// Check the new DoH providers setting.
+	custom, disabled = s.GetDoHProviders()
+	require.Equal(t, []string{"https://doh.example/dns-query"}, custom)
+	require.Equal(t, []string{"https://dns.google/dns-query"}, disabled)
+}
+
+func TestVault_Settings_Webhooks(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default webhooks.
+	require.Empty(t, s.GetWebhooks())
+
+	// Modify the webhooks.
+	webhooks := []vault.Webhook{{URL: "https://hooks.example/bridge", Secret: "secret", Events: []string{"message_received"}}}
+	require.NoError(t, s.SetWebhooks(webhooks))
+
+	// Check the new webhooks.
+	require.Equal(t, webhooks, s.GetWebhooks())
+}
+
+func TestVault_Settings_AutoLockTimeout(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default auto-lock timeout.
+	require.Zero(t, s.GetAutoLockTimeout())
+
+	// Modify the auto-lock timeout.
+	require.NoError(t, s.SetAutoLockTimeout(15*time.Minute))
+
+	// Check the new auto-lock timeout.
+	require.Equal(t, 15*time.Minute, s.GetAutoLockTimeout())
+}
+
+func TestVault_Settings_UnlockPassphrase(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// By default, no passphrase unlocks bridge.
+	require.False(t, s.HasUnlockPassphrase())
+	require.False(t, s.CheckUnlockPassphrase(nil))
+
+	// Set the unlock passphrase.
+	require.NoError(t, s.SetUnlockPassphrase([]byte("passphrase")))
+	require.True(t, s.HasUnlockPassphrase())
+
+	// It can be checked while the vault is locked.
+	require.NoError(t, s.Lock())
+	require.True(t, s.CheckUnlockPassphrase([]byte("passphrase")))
+	require.False(t, s.CheckUnlockPassphrase([]byte("other passphrase")))
+	require.ErrorIs(t, s.SetUnlockPassphrase(nil), vault.ErrLocked)
+	require.NoError(t, s.Unlock([]byte("my secret key")))
+
+	// Remove the unlock passphrase.
+	require.NoError(t, s.SetUnlockPassphrase(nil))
+	require.False(t, s.HasUnlockPassphrase())
+	require.False(t, s.CheckUnlockPassphrase([]byte("passphrase")))
+}
+
 func TestVault_Settings_ShowAllMail(t *testing.T) {
 	// create a new test vault.
 	s := newVault(t)
@@ -155,6 +318,48 @@ func TestVault_Settings_ShowAllMail(t *testing.T) {
 	require.Equal(t, false, s.GetShowAllMail())
 }
 
+func TestVault_Settings_IPCOverTCP(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default IPC over TCP setting.
+	require.Equal(t, false, s.GetIPCOverTCP())
+
+	// Modify the IPC over TCP setting.
+	require.NoError(t, s.SetIPCOverTCP(true))
+
+	// Check the new IPC over TCP setting.
+	require.Equal(t, true, s.GetIPCOverTCP())
+}
+
+func TestVault_Settings_ReauthOnDeauth(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default re-auth on deauth setting.
+	require.Equal(t, false, s.GetReauthOnDeauth())
+
+	// Modify the re-auth on deauth setting.
+	require.NoError(t, s.SetReauthOnDeauth(true))
+
+	// Check the new re-auth on deauth setting.
+	require.Equal(t, true, s.GetReauthOnDeauth())
+}
+
+func TestVault_Settings_SessionAuditLog(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default session audit log setting.
+	require.Equal(t, false, s.GetSessionAuditLog())
+
+	// Modify the session audit log setting.
+	require.NoError(t, s.SetSessionAuditLog(true))
+
+	// Check the new session audit log setting.
+	require.Equal(t, true, s.GetSessionAuditLog())
+}
+
 func TestVault_Settings_TelemetryDisabled(t *testing.T) {
 	// create a new test vault.
 	s := newVault(t)
@@ -169,6 +374,20 @@ func TestVault_Settings_TelemetryDisabled(t *testing.T) {
 	require.Equal(t, true, s.GetTelemetryDisabled())
 }
 
+func TestVault_Settings_ReportingDisabled(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default reporting setting.
+	require.Equal(t, false, s.GetReportingDisabled())
+
+	// Modify the reporting setting.
+	require.NoError(t, s.SetReportingDisabled(true))
+
+	// Check the new reporting setting.
+	require.Equal(t, true, s.GetReportingDisabled())
+}
+
 func TestVault_Settings_Autostart(t *testing.T) {
 	// create a new test vault.
 	s := newVault(t)
@@ -233,6 +452,34 @@ func TestVault_Settings_MaxSyncMemory(t *testing.T) {
 	require.Equal(t, vault.DefaultMaxSyncMemory, s.GetMaxSyncMemory())
 }
 
+func TestVault_Settings_MaxCacheSize(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default cache size limit.
+	require.Equal(t, uint64(0), s.GetMaxCacheSize())
+
+	// Modify the cache size limit.
+	require.NoError(t, s.SetMaxCacheSize(512*1024*1024))
+
+	// Check the new cache size limit.
+	require.Equal(t, uint64(512*1024*1024), s.GetMaxCacheSize())
+}
+
+func TestVault_Settings_KeepRecentDays(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default window of recent messages.
+	require.Equal(t, uint32(0), s.GetKeepRecentDays())
+
+	// Modify the window of recent messages.
+	require.NoError(t, s.SetKeepRecentDays(30))
+
+	// Check the new window of recent messages.
+	require.Equal(t, uint32(30), s.GetKeepRecentDays())
+}
+
 func TestVault_Settings_LastUserAgent(t *testing.T) {
 	// create a new test vault.
 	s := newVault(t)
@@ -267,3 +514,20 @@ func Test_Settings_PasswordArchive(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, user.BridgePass(), bridgePass)
 }
+
+func TestVault_Settings_ConfigApplied(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Nothing has been applied from a config file yet.
+	_, ok := s.GetConfigApplied("imap.port")
+	require.False(t, ok)
+
+	// Record an applied value.
+	require.NoError(t, s.SetConfigApplied("imap.port", "1143"))
+
+	// Check the new value.
+	value, ok := s.GetConfigApplied("imap.port")
+	require.True(t, ok)
+	require.Equal(t, "1143", value)
+}
@@ -35,32 +35,90 @@ type Settings struct {
 	IMAPSSL  bool
 	SMTPSSL  bool
 
+	// IMAPImplicitTLSPort and SMTPImplicitTLSPort are extra implicit TLS listeners next to the main ones; zero disables them.
+	IMAPImplicitTLSPort int
+	SMTPImplicitTLSPort int
+
+	// IMAPSSLRequired and SMTPSSLRequired reject authentication attempts made over unencrypted connections.
+	IMAPSSLRequired bool
+	SMTPSSLRequired bool
+
+	// BindAddress is the address the IMAP and SMTP servers listen on; empty means the loopback address.
+	BindAddress string
+
 	UpdateChannel updater.Channel
 	UpdateRollout float64
 
 	ColorScheme       string
 	ProxyAllowed      bool
+	SOCKSProxy        string
+	PACSource         string
 	ShowAllMail       bool
 	Autostart         bool
 	AutoUpdate        bool
 	TelemetryDisabled bool
 
+	// APIHost is the API address to use instead of the production one; empty means the production API.
+	APIHost string
+
+	// IPCOverTCP makes the focus and gRPC services listen on localhost TCP ports instead of user-only sockets.
+	IPCOverTCP bool
+
+	// ReauthOnDeauth asks the user to sign in again when a session is revoked, resuming from the user's local data.
+	ReauthOnDeauth bool
+
+	// SessionAuditLog records the IMAP sessions, without message content, in an audit log rotated along with the logs.
+	SessionAuditLog bool
+
+	// ReportingDisabled disables crash reports, usage telemetry, observability metrics and heartbeats altogether.
+	ReportingDisabled bool
+
 	LastVersion string
 	FirstStart  bool
 
+	RolledBackVersion string
+
 	MaxSyncMemory uint64
 
+	// MaxCacheSize caps the disk space used by cached message bodies; zero means unlimited.
+	MaxCacheSize uint64
+
+	// KeepRecentDays is how many days of recent messages have their bodies kept in the cache regardless of its limit.
+	KeepRecentDays uint32
+
 	LastUserAgent string
 
 	LastHeartbeatSent time.Time
 
 	PasswordArchive PasswordArchive
 
+	// DoHProviders are queried before the default ones, which are skipped if listed in DisabledDoHProviders.
+	DoHProviders         []string
+	DisabledDoHProviders []string
+
+	Webhooks []Webhook
+
+	// AutoLockTimeout is the inactivity after which bridge locks itself; zero disables auto-lock.
+	AutoLockTimeout time.Duration
+
+	// UnlockPassphraseHash is the bcrypt hash of the passphrase which unlocks bridge; empty if none is set.
+	UnlockPassphraseHash []byte
+
+	// ConfigApplied records the values last applied from the configuration file, keyed by setting name.
+	ConfigApplied map[string]string
+
 	// **WARNING**: These entry can't be removed until they vault has proper migration support.
 	SyncWorkers int
 	SyncAttPool int
 }
 
+// Webhook is a URL notified of the selected bridge events; requests are signed with the secret when one is set.
+type Webhook struct {
+	URL    string
+	Secret string
+	Events []string
+}
+
 const DefaultMaxSyncMemory = 2 * 1024 * uint64(1024*1024)
 
 func GetDefaultSyncWorkerCount() int {
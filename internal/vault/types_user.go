@@ -17,7 +17,11 @@
 
 package vault
 
-import "github.com/ProtonMail/gluon/imap"
+import (
+	"time"
+
+	"github.com/ProtonMail/gluon/imap"
+)
 
 // UserData holds information about a single bridge user.
 // The user may or may not be logged in.
@@ -42,6 +46,28 @@ type UserData struct {
 	UIDValidity map[string]imap.UID
 
 	ShouldResync bool // Whether user should re-sync on log-in (this is triggered by the `repair` button)
+
+	AppPasswords []AppPassword // Additional named bridge passwords, each revocable on its own.
+
+	HideAllMail bool // Whether the All Mail mailbox is hidden for this user, regardless of the global setting.
+
+	RepairMIME bool // Whether malformed MIME is repaired when building messages, keeping the original as an attachment.
+
+	Removed bool // Whether the user was removed from bridge with its local data kept, to be re-linked on the next login.
+
+	PendingReauth bool // Whether the user's session was revoked and the user was asked to sign in again.
+
+	ReadReceiptMode     ReadReceiptMode // How read receipt requests of received messages are handled.
+	HandledReadReceipts []string        // IDs of the messages whose read receipt request was already handled, oldest first.
+
+	SyncCutoff time.Time // Messages older than this are not synced for this user; zero means all messages are.
+}
+
+// AppPassword is a named bridge password generated for a single client.
+type AppPassword struct {
+	Name      string
+	Pass      []byte // raw token represented as byte slice (needs to be encoded)
+	CreatedAt time.Time
 }
 
 type AddressMode int
@@ -64,6 +90,32 @@ func (mode AddressMode) String() string {
 	}
 }
 
+// ReadReceiptMode is how requests for a read receipt (Disposition-Notification-To) are handled
+// when a received message is read.
+type ReadReceiptMode int
+
+const (
+	ReadReceiptIgnore ReadReceiptMode = iota // The request is ignored; this is the default.
+	ReadReceiptPrompt                        // An event asks the user whether to send the receipt.
+	ReadReceiptAuto                          // The receipt is sent automatically.
+)
+
+func (mode ReadReceiptMode) String() string {
+	switch mode {
+	case ReadReceiptIgnore:
+		return "ignore"
+
+	case ReadReceiptPrompt:
+		return "prompt"
+
+	case ReadReceiptAuto:
+		return "auto"
+
+	default:
+		return "unknown"
+	}
+}
+
 type SyncStatus struct {
 	HasLabels        bool
 	HasMessages      bool
@@ -94,3 +146,16 @@ func newDefaultUser(userID, username, primaryEmail, authUID, authRef string, key
 		ShouldResync: false,
 	}
 }
+
+// wipeSecrets clears the user's credentials and keys.
+func (data *UserData) wipeSecrets() {
+	data.GluonKey = nil
+	data.BridgePass = nil
+	data.AuthUID = ""
+	data.AuthRef = ""
+	data.KeyPass = nil
+
+	for idx := range data.AppPasswords {
+		data.AppPasswords[idx].Pass = nil
+	}
+}
@@ -20,11 +20,15 @@ package vault
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/bradenaw/juniper/xslices"
 	"golang.org/x/exp/slices"
 )
 
+// maxHandledReadReceipts is how many messages with a handled read receipt request are remembered per user.
+const maxHandledReadReceipts = 1000
+
 type User struct {
 	vault  *Vault
 	userID string
@@ -59,6 +63,14 @@ func (user *User) GluonKey() []byte {
 	return user.vault.getUser(user.userID).GluonKey
 }
 
+// SetGluonKey sets the key needed to decrypt the user's gluon database.
+// The caller is responsible for re-encrypting the data encrypted with the previous key.
+func (user *User) SetGluonKey(key []byte) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.GluonKey = key
+	})
+}
+
 func (user *User) GetGluonIDs() map[string]string {
 	return user.vault.getUser(user.userID).GluonIDs
 }
@@ -90,6 +102,108 @@ func (user *User) AddressMode() AddressMode {
 	return user.vault.getUser(user.userID).AddressMode
 }
 
+// HideAllMail returns whether the All Mail mailbox is hidden for this user.
+func (user *User) HideAllMail() bool {
+	return user.vault.getUser(user.userID).HideAllMail
+}
+
+// SetHideAllMail sets whether the All Mail mailbox is hidden for this user.
+func (user *User) SetHideAllMail(hide bool) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.HideAllMail = hide
+	})
+}
+
+// RepairMIME returns whether malformed MIME is repaired when building this user's messages.
+func (user *User) RepairMIME() bool {
+	return user.vault.getUser(user.userID).RepairMIME
+}
+
+// SetRepairMIME sets whether malformed MIME is repaired when building this user's messages.
+func (user *User) SetRepairMIME(repair bool) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.RepairMIME = repair
+	})
+}
+
+// SyncCutoff returns the time before which this user's messages are not synced; zero means all messages are.
+func (user *User) SyncCutoff() time.Time {
+	return user.vault.getUser(user.userID).SyncCutoff
+}
+
+// SetSyncCutoff sets the time before which this user's messages are not synced; zero means all messages are.
+func (user *User) SetSyncCutoff(cutoff time.Time) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.SyncCutoff = cutoff
+	})
+}
+
+// Removed returns whether the user was removed from bridge with its local data kept.
+func (user *User) Removed() bool {
+	return user.vault.getUser(user.userID).Removed
+}
+
+// SetRemoved sets whether the user was removed from bridge with its local data kept.
+func (user *User) SetRemoved(removed bool) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.Removed = removed
+	})
+}
+
+// PendingReauth returns whether the user's session was revoked and the user was asked to sign in again.
+func (user *User) PendingReauth() bool {
+	return user.vault.getUser(user.userID).PendingReauth
+}
+
+// SetPendingReauth sets whether the user's session was revoked and the user was asked to sign in again.
+func (user *User) SetPendingReauth(pending bool) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.PendingReauth = pending
+	})
+}
+
+// ReadReceiptMode returns how read receipt requests of the user's received messages are handled.
+func (user *User) ReadReceiptMode() ReadReceiptMode {
+	return user.vault.getUser(user.userID).ReadReceiptMode
+}
+
+// SetReadReceiptMode sets how read receipt requests of the user's received messages are handled.
+func (user *User) SetReadReceiptMode(mode ReadReceiptMode) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.ReadReceiptMode = mode
+	})
+}
+
+// IsReadReceiptHandled returns whether the read receipt request of the given message was already handled.
+func (user *User) IsReadReceiptHandled(messageID string) bool {
+	return slices.Contains(user.vault.getUser(user.userID).HandledReadReceipts, messageID)
+}
+
+// SetReadReceiptHandled records that the read receipt request of the given message was handled.
+// Only the most recent maxHandledReadReceipts messages are remembered.
+func (user *User) SetReadReceiptHandled(messageID string) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		if slices.Contains(data.HandledReadReceipts, messageID) {
+			return
+		}
+
+		data.HandledReadReceipts = append(data.HandledReadReceipts, messageID)
+
+		if n := len(data.HandledReadReceipts); n > maxHandledReadReceipts {
+			data.HandledReadReceipts = slices.Clone(data.HandledReadReceipts[n-maxHandledReadReceipts:])
+		}
+	})
+}
+
+// ForgetReadReceiptHandled forgets about the read receipt request of the given message, which was deleted.
+func (user *User) ForgetReadReceiptHandled(messageID string) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		if idx := slices.Index(data.HandledReadReceipts, messageID); idx >= 0 {
+			data.HandledReadReceipts = slices.Delete(data.HandledReadReceipts, idx, idx+1)
+		}
+	})
+}
+
 // SetAddressMode sets the address mode for the given user.
 func (user *User) SetAddressMode(mode AddressMode) error {
 	return user.vault.modUser(user.userID, func(data *UserData) {
@@ -109,6 +223,48 @@ func (user *User) SetBridgePass(newPass []byte) error {
 	})
 }
 
+// AppPasswords returns the user's app passwords.
+func (user *User) AppPasswords() []AppPassword {
+	return slices.Clone(user.vault.getUser(user.userID).AppPasswords)
+}
+
+// AppPasses returns the user's app passwords as raw token bytes (unencoded), keyed by name.
+func (user *User) AppPasses() map[string][]byte {
+	appPasses := make(map[string][]byte)
+
+	for _, appPass := range user.vault.getUser(user.userID).AppPasswords {
+		appPasses[appPass.Name] = appPass.Pass
+	}
+
+	return appPasses
+}
+
+// AddAppPassword generates a new app password with the given name and returns it as raw token bytes (unencoded).
+func (user *User) AddAppPassword(name string) ([]byte, error) {
+	pass := newRandomToken(16)
+
+	if err := user.vault.modUser(user.userID, func(data *UserData) {
+		data.AppPasswords = append(data.AppPasswords, AppPassword{
+			Name:      name,
+			Pass:      pass,
+			CreatedAt: time.Now(),
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return pass, nil
+}
+
+// RemoveAppPassword removes the app password with the given name.
+func (user *User) RemoveAppPassword(name string) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.AppPasswords = xslices.Filter(data.AppPasswords, func(appPass AppPassword) bool {
+			return appPass.Name != name
+		})
+	})
+}
+
 // AuthUID returns the user's auth UID.
 func (user *User) AuthUID() string {
 	return user.vault.getUser(user.userID).AuthUID
@@ -18,10 +18,13 @@
 package vault_test
 
 import (
+	"fmt"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
 	"github.com/stretchr/testify/require"
 )
 
@@ -271,3 +274,198 @@ func TestUser_ShouldResync(t *testing.T) {
 	// Check whether it matches the correct value
 	require.True(t, user.GetShouldResync())
 }
+
+func TestUser_AppPasswords(t *testing.T) {
+	// Replace the token generator with a dummy one.
+	vault.RandomToken = func(_ int) ([]byte, error) {
+		return []byte("token"), nil
+	}
+
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// New users have no app passwords.
+	require.Empty(t, user.AppPasswords())
+
+	// Add two app passwords.
+	pass, err := user.AddAppPassword("thunderbird")
+	require.NoError(t, err)
+	require.Equal(t, "token", string(pass))
+
+	_, err = user.AddAppPassword("phone")
+	require.NoError(t, err)
+
+	// Check both are listed.
+	require.Equal(t, []string{"thunderbird", "phone"}, xslices.Map(user.AppPasswords(), func(appPass vault.AppPassword) string {
+		return appPass.Name
+	}))
+
+	// Revoke one of them.
+	require.NoError(t, user.RemoveAppPassword("thunderbird"))
+
+	// Check only the other remains.
+	require.Len(t, user.AppPasswords(), 1)
+	require.Equal(t, "phone", user.AppPasswords()[0].Name)
+}
+
+func TestUser_HideAllMail(t *testing.T) {
+	// Replace the token generator with a dummy one.
+	vault.RandomToken = func(_ int) ([]byte, error) {
+		return []byte("token"), nil
+	}
+
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// All Mail is not hidden for new users.
+	require.False(t, user.HideAllMail())
+
+	// Hide it.
+	require.NoError(t, user.SetHideAllMail(true))
+
+	// The setting is stored per user.
+	require.True(t, user.HideAllMail())
+}
+
+func TestUser_RepairMIME(t *testing.T) {
+	// Replace the token generator with a dummy one.
+	vault.RandomToken = func(_ int) ([]byte, error) {
+		return []byte("token"), nil
+	}
+
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// MIME repair is disabled for new users.
+	require.False(t, user.RepairMIME())
+
+	// Enable it.
+	require.NoError(t, user.SetRepairMIME(true))
+
+	// The setting is stored per user.
+	require.True(t, user.RepairMIME())
+}
+
+func TestUser_SyncCutoff(t *testing.T) {
+	// Replace the token generator with a dummy one.
+	vault.RandomToken = func(_ int) ([]byte, error) {
+		return []byte("token"), nil
+	}
+
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// All messages are synced for new users.
+	require.True(t, user.SyncCutoff().IsZero())
+
+	// Only sync the messages since the given date.
+	cutoff := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, user.SetSyncCutoff(cutoff))
+
+	// The setting is stored per user.
+	require.True(t, cutoff.Equal(user.SyncCutoff()))
+}
+
+func TestUser_Removed(t *testing.T) {
+	// Replace the token generator with a dummy one.
+	vault.RandomToken = func(_ int) ([]byte, error) {
+		return []byte("token"), nil
+	}
+
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// New users are not removed.
+	require.False(t, user.Removed())
+
+	// Remove the user, keeping its data.
+	require.NoError(t, user.SetRemoved(true))
+	require.True(t, user.Removed())
+
+	// The user is still in the vault.
+	require.True(t, s.HasUser("userID"))
+}
+
+func TestUser_PendingReauth(t *testing.T) {
+	// Replace the token generator with a dummy one.
+	vault.RandomToken = func(_ int) ([]byte, error) {
+		return []byte("token"), nil
+	}
+
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+	require.NoError(t, user.SetEventID("eventID"))
+
+	// New users are not waiting to sign in again.
+	require.False(t, user.PendingReauth())
+
+	// The session is revoked: the secrets are cleared but the user's data is kept.
+	require.NoError(t, user.Clear())
+	require.NoError(t, user.SetPendingReauth(true))
+	require.True(t, user.PendingReauth())
+	require.Equal(t, "eventID", user.EventID())
+}
+
+func TestUser_ReadReceipts(t *testing.T) {
+	// Replace the token generator with a dummy one.
+	vault.RandomToken = func(_ int) ([]byte, error) {
+		return []byte("token"), nil
+	}
+
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// Read receipt requests are ignored by default.
+	require.Equal(t, vault.ReadReceiptIgnore, user.ReadReceiptMode())
+
+	// The mode can be changed.
+	require.NoError(t, user.SetReadReceiptMode(vault.ReadReceiptPrompt))
+	require.Equal(t, vault.ReadReceiptPrompt, user.ReadReceiptMode())
+
+	// Handled requests are remembered.
+	require.False(t, user.IsReadReceiptHandled("messageID"))
+	require.NoError(t, user.SetReadReceiptHandled("messageID"))
+	require.NoError(t, user.SetReadReceiptHandled("messageID"))
+	require.True(t, user.IsReadReceiptHandled("messageID"))
+
+	// Only the most recent ones are kept.
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, user.SetReadReceiptHandled(fmt.Sprintf("messageID-%v", i)))
+	}
+
+	require.False(t, user.IsReadReceiptHandled("messageID"))
+	require.True(t, user.IsReadReceiptHandled("messageID-999"))
+
+	// Deleted messages are forgotten.
+	require.NoError(t, user.ForgetReadReceiptHandled("messageID-999"))
+	require.False(t, user.IsReadReceiptHandled("messageID-999"))
+	require.True(t, user.IsReadReceiptHandled("messageID-998"))
+}
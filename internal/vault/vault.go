@@ -44,13 +44,23 @@ type Vault struct {
 
 	ref map[string]int
 
+	// locked holds the data of a locked vault, without the users' secrets, until the vault is unlocked.
+	locked *Data
+
+	// accountsPath is where the copy of the accounts is kept, if any; see KeepAccounts.
+	accountsPath string
+
 	lock sync.RWMutex
 
 	panicHandler async.PanicHandler
 }
 
+// vaultFileName is the name of the vault file in the vault directory.
+const vaultFileName = "vault.enc"
+
 var ErrDecryptFailed = errors.New("failed to decrypt vault")
 var ErrUnmarshal = errors.New("vault contents are corrupt")
+var ErrLocked = errors.New("vault is locked")
 
 // New constructs a new encrypted data vault at the given filepath using the given encryption key.
 // The first error is a corruption error for an existing vault, the second errors refrain to all other errors.
@@ -59,19 +69,12 @@ func New(vaultDir, gluonCacheDir string, key []byte, panicHandler async.PanicHan
 		return nil, nil, err
 	}
 
-	hash256 := sha256.Sum256(key)
-
-	aes, err := aes.NewCipher(hash256[:])
-	if err != nil {
-		return nil, nil, err
-	}
-
-	gcm, err := cipher.NewGCM(aes)
+	gcm, err := newGCM(key)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	vault, corrupt, err := newVault(filepath.Join(vaultDir, "vault.enc"), gluonCacheDir, gcm)
+	vault, corrupt, err := newVault(FilePath(vaultDir), gluonCacheDir, gcm)
 	if err != nil {
 		return nil, corrupt, err
 	}
@@ -81,6 +84,193 @@ func New(vaultDir, gluonCacheDir string, key []byte, panicHandler async.PanicHan
 	return vault, corrupt, nil
 }
 
+// FilePath returns the path of the vault file in the given vault directory.
+func FilePath(vaultDir string) string {
+	return filepath.Join(vaultDir, vaultFileName)
+}
+
+// CheckKey returns whether the given key is the one the vault is encrypted with.
+func (vault *Vault) CheckKey(key []byte) bool {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return false
+	}
+
+	vault.lock.RLock()
+	defer vault.lock.RUnlock()
+
+	return unmarshalFile(gcm, vault.enc, new(Data)) == nil
+}
+
+// Lock drops the vault key from memory until Unlock is called with it.
+// In the meantime, the vault can't be modified and its data is served without the users' credentials and keys.
+func (vault *Vault) Lock() error {
+	vault.lock.Lock()
+	defer vault.lock.Unlock()
+
+	if vault.locked != nil {
+		return nil
+	}
+
+	var data Data
+
+	if err := unmarshalFile(vault.gcm, vault.enc, &data); err != nil {
+		return err
+	}
+
+	for idx := range data.Users {
+		data.Users[idx].wipeSecrets()
+	}
+
+	vault.locked = &data
+	vault.gcm = nil
+
+	return nil
+}
+
+// Unlock restores the vault key dropped by Lock. It fails if the given key is not the one the vault is encrypted with.
+func (vault *Vault) Unlock(key []byte) error {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	vault.lock.Lock()
+	defer vault.lock.Unlock()
+
+	if vault.locked == nil {
+		return nil
+	}
+
+	if err := unmarshalFile(gcm, vault.enc, new(Data)); err != nil {
+		return err
+	}
+
+	vault.gcm = gcm
+	vault.locked = nil
+
+	return nil
+}
+
+// IsLocked returns whether the vault is locked.
+func (vault *Vault) IsLocked() bool {
+	vault.lock.RLock()
+	defer vault.lock.RUnlock()
+
+	return vault.locked != nil
+}
+
+// Rekey re-encrypts the vault with the given key.
+// The commit function is called once the vault has been written with the new key but before it replaces the old one,
+// typically to store the new key in the keychain; if it fails, the vault keeps its old key.
+func (vault *Vault) Rekey(key []byte, commit func() error) error {
+	vault.lock.Lock()
+	defer vault.lock.Unlock()
+
+	if vault.locked != nil {
+		return ErrLocked
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	var data Data
+
+	if err := unmarshalFile(vault.gcm, vault.enc, &data); err != nil {
+		return err
+	}
+
+	enc, err := marshalFile(gcm, data)
+	if err != nil {
+		return err
+	}
+
+	tmpFile := vault.path + ".tmp"
+
+	if err := os.WriteFile(tmpFile, enc, 0o600); err != nil {
+		return fmt.Errorf("failed write new vault to disk: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		if removeErr := os.Remove(tmpFile); removeErr != nil {
+			logrus.WithError(removeErr).Warn("Failed to remove new vault")
+		}
+
+		return fmt.Errorf("failed to commit new vault key: %w", err)
+	}
+
+	if err := os.Rename(tmpFile, vault.path); err != nil {
+		return fmt.Errorf("failed to overwrite old vault data: %w", err)
+	}
+
+	vault.gcm = gcm
+	vault.enc = enc
+
+	return nil
+}
+
+// Import replaces the contents of the vault with those of the vault file at the given path, encrypted with the given key.
+// The contents are re-encrypted with the key of this vault. No user of this vault may be in use.
+func (vault *Vault) Import(path string, key []byte) error {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	enc, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("failed to read vault to import: %w", err)
+	}
+
+	var imported Data
+
+	if err := unmarshalFile(gcm, enc, &imported); err != nil {
+		return err
+	}
+
+	vault.lock.Lock()
+	defer vault.lock.Unlock()
+
+	if len(vault.ref) > 0 {
+		return errors.New("cannot import a vault while its users are in use")
+	}
+
+	return vault.modUnsafe(func(data *Data) {
+		*data = imported
+	})
+}
+
+// Reopen switches the vault to the vault file at the given path, encrypted with the given key. The vault stops keeping
+// a copy of its accounts. No user of this vault may be in use.
+func (vault *Vault) Reopen(path string, key []byte) error {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	enc, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("failed to read vault to reopen: %w", err)
+	}
+
+	if err := unmarshalFile(gcm, enc, new(Data)); err != nil {
+		return err
+	}
+
+	vault.lock.Lock()
+	defer vault.lock.Unlock()
+
+	if len(vault.ref) > 0 {
+		return errors.New("cannot reopen a vault while its users are in use")
+	}
+
+	vault.path, vault.gcm, vault.enc, vault.locked, vault.accountsPath = path, gcm, enc, nil, ""
+
+	return nil
+}
+
 // GetUserIDs returns the user IDs and usernames of all users in the vault.
 func (vault *Vault) GetUserIDs() []string {
 	vault.lock.RLock()
@@ -392,6 +582,10 @@ func (vault *Vault) getSafe() Data {
 }
 
 func (vault *Vault) getUnsafe() Data {
+	if vault.locked != nil {
+		return *vault.locked
+	}
+
 	var data Data
 
 	if err := unmarshalFile(vault.gcm, vault.enc, &data); err != nil {
@@ -409,6 +603,10 @@ func (vault *Vault) modSafe(fn func(data *Data)) error {
 }
 
 func (vault *Vault) modUnsafe(fn func(data *Data)) error {
+	if vault.locked != nil {
+		return ErrLocked
+	}
+
 	var data Data
 
 	if err := unmarshalFile(vault.gcm, vault.enc, &data); err != nil {
@@ -434,6 +632,10 @@ func (vault *Vault) modUnsafe(fn func(data *Data)) error {
 		return fmt.Errorf("failed to overwrite old vault data: %w", err)
 	}
 
+	if err := vault.writeAccountsUnsafe(data); err != nil {
+		logrus.WithError(err).Error("Failed to update the copy of the vault accounts")
+	}
+
 	return nil
 }
 
@@ -499,3 +701,14 @@ func initVault(path, gluonDir string, gcm cipher.AEAD) ([]byte, error) {
 
 	return enc, nil
 }
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	hash256 := sha256.Sum256(key)
+
+	aes, err := aes.NewCipher(hash256[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(aes)
+}
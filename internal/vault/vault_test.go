@@ -18,6 +18,7 @@
 package vault_test
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -98,6 +99,171 @@ func TestVault_Reset(t *testing.T) {
 	require.Equal(t, ports.FindFreePortFrom(1025), s.GetSMTPPort())
 }
 
+func TestVault_CheckKey(t *testing.T) {
+	s := newVault(t)
+
+	// Only the key the vault was created with is accepted.
+	require.True(t, s.CheckKey([]byte("my secret key")))
+	require.False(t, s.CheckKey([]byte("some other key")))
+	require.False(t, s.CheckKey(nil))
+}
+
+func TestVault_Rekey(t *testing.T) {
+	dir := t.TempDir()
+
+	s, corrupt, err := vault.New(dir, t.TempDir(), []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, corrupt)
+
+	require.NoError(t, s.SetIMAPPort(1234))
+
+	// If the new key can't be committed, the vault keeps its old key.
+	require.Error(t, s.Rekey([]byte("my new key"), func() error { return errors.New("keychain unavailable") }))
+	require.True(t, s.CheckKey([]byte("my secret key")))
+
+	// Otherwise the vault is re-encrypted with the new key.
+	require.NoError(t, s.Rekey([]byte("my new key"), func() error { return nil }))
+	require.True(t, s.CheckKey([]byte("my new key")))
+	require.False(t, s.CheckKey([]byte("my secret key")))
+	require.Equal(t, 1234, s.GetIMAPPort())
+	require.NoError(t, s.Close())
+
+	// The vault is opened with the new key from now on.
+	s, corrupt, err = vault.New(dir, t.TempDir(), []byte("my new key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, corrupt)
+	require.Equal(t, 1234, s.GetIMAPPort())
+}
+
+func TestVault_Lock(t *testing.T) {
+	s := newVault(t)
+
+	require.NoError(t, s.SetIMAPPort(1234))
+
+	user, err := s.AddUser("userID", "username", "user@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+	require.NoError(t, user.Close())
+
+	require.NoError(t, s.Lock())
+	require.True(t, s.IsLocked())
+
+	// While locked, the settings can be read but not changed.
+	require.Equal(t, 1234, s.GetIMAPPort())
+	require.ErrorIs(t, s.SetIMAPPort(5678), vault.ErrLocked)
+
+	// The users' credentials are not available.
+	require.NoError(t, s.GetUser("userID", func(user *vault.User) {
+		require.Equal(t, "username", user.Username())
+		require.Empty(t, user.AuthUID())
+		require.Empty(t, user.KeyPass())
+		require.Empty(t, user.BridgePass())
+	}))
+
+	// Only the vault key unlocks the vault.
+	require.ErrorIs(t, s.Unlock([]byte("some other key")), vault.ErrDecryptFailed)
+	require.ErrorIs(t, s.Unlock(nil), vault.ErrDecryptFailed)
+	require.True(t, s.IsLocked())
+
+	require.NoError(t, s.Unlock([]byte("my secret key")))
+	require.False(t, s.IsLocked())
+
+	require.NoError(t, s.GetUser("userID", func(user *vault.User) {
+		require.Equal(t, "authUID", user.AuthUID())
+		require.Equal(t, []byte("keyPass"), user.KeyPass())
+	}))
+
+	require.NoError(t, s.SetIMAPPort(5678))
+}
+
+func TestVault_Import(t *testing.T) {
+	srcDir := t.TempDir()
+
+	src, corrupt, err := vault.New(srcDir, t.TempDir(), []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, corrupt)
+	require.NoError(t, src.SetIMAPPort(1234))
+	require.NoError(t, src.Close())
+
+	dst, corrupt, err := vault.New(t.TempDir(), t.TempDir(), nil, async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, corrupt)
+
+	// The vault can't be imported with the wrong key.
+	require.Error(t, dst.Import(src.Path(), []byte("some other key")))
+	require.NotEqual(t, 1234, dst.GetIMAPPort())
+
+	// Otherwise its contents are taken over, keeping the key of the importing vault.
+	require.NoError(t, dst.Import(src.Path(), []byte("my secret key")))
+	require.Equal(t, 1234, dst.GetIMAPPort())
+	require.True(t, dst.CheckKey(nil))
+}
+
+func TestVault_Reopen(t *testing.T) {
+	srcDir := t.TempDir()
+
+	src, corrupt, err := vault.New(srcDir, t.TempDir(), []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, corrupt)
+	require.NoError(t, src.SetIMAPPort(1234))
+	require.NoError(t, src.Close())
+
+	dst, corrupt, err := vault.New(t.TempDir(), t.TempDir(), nil, async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, corrupt)
+
+	// The vault can't be reopened with the wrong key.
+	require.Error(t, dst.Reopen(src.Path(), []byte("some other key")))
+	require.NotEqual(t, 1234, dst.GetIMAPPort())
+
+	// Otherwise it's the reopened vault from now on, with its key.
+	require.NoError(t, dst.Reopen(src.Path(), []byte("my secret key")))
+	require.Equal(t, src.Path(), dst.Path())
+	require.Equal(t, 1234, dst.GetIMAPPort())
+	require.True(t, dst.CheckKey([]byte("my secret key")))
+
+	require.NoError(t, dst.SetIMAPPort(5678))
+
+	reopened, corrupt, err := vault.New(srcDir, t.TempDir(), []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, corrupt)
+	require.Equal(t, 5678, reopened.GetIMAPPort())
+}
+
+func TestVault_KeepAccounts(t *testing.T) {
+	s := newVault(t)
+
+	path := vault.AccountsPath(t.TempDir())
+
+	require.NoError(t, s.KeepAccounts(path))
+
+	// The copy is updated with the vault.
+	user, err := s.AddUser("userID", "username", "user@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+	require.NoError(t, user.Close())
+
+	accounts, err := vault.OpenAccounts(path)
+	require.NoError(t, err)
+	require.True(t, accounts.IsLocked())
+	require.Equal(t, []string{"userID"}, accounts.GetUserIDs())
+
+	// It holds none of the users' secrets and can't be changed.
+	require.NoError(t, accounts.GetUser("userID", func(user *vault.User) {
+		require.Equal(t, "username", user.Username())
+		require.Equal(t, "user@pm.me", user.PrimaryEmail())
+		require.Empty(t, user.AuthUID())
+		require.Empty(t, user.KeyPass())
+		require.Empty(t, user.BridgePass())
+	}))
+
+	require.ErrorIs(t, accounts.DeleteUser("userID"), vault.ErrLocked)
+
+	// The vault keeps its secrets.
+	require.NoError(t, s.GetUser("userID", func(user *vault.User) {
+		require.Equal(t, "authUID", user.AuthUID())
+		require.NotEmpty(t, user.BridgePass())
+	}))
+}
+
 func newVault(t *testing.T) *vault.Vault {
 	t.Helper()
 
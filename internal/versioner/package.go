@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package versioner
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const packageExt = ".tgz"
+
+// SavePackage keeps the update package of the given version so that later updates can be applied as a diff against it.
+// Packages of other versions are removed.
+func (v *Versioner) SavePackage(version *semver.Version, b []byte) error {
+	if err := os.WriteFile(v.getPackagePath(version), b, 0o600); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(v.root)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), packageExt) || entry.Name() == version.Original()+packageExt {
+			continue
+		}
+
+		if err := os.Remove(filepath.Join(v.root, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadPackage returns the update package of the given version, if it was kept.
+func (v *Versioner) LoadPackage(version *semver.Version) ([]byte, error) {
+	return os.ReadFile(v.getPackagePath(version))
+}
+
+func (v *Versioner) getPackagePath(version *semver.Version) string {
+	return filepath.Join(v.root, version.Original()+packageExt)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package versioner
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavePackage(t *testing.T) {
+	v := newTestVersioner(t, "myCoolApp", t.TempDir(), "2.3.5", "2.4.0")
+
+	// Nothing has been kept yet.
+	_, err := v.LoadPackage(semver.MustParse("2.3.5"))
+	require.Error(t, err)
+
+	require.NoError(t, v.SavePackage(semver.MustParse("2.3.5"), []byte("package 2.3.5")))
+	require.NoError(t, v.SavePackage(semver.MustParse("2.4.0"), []byte("package 2.4.0")))
+
+	// Only the latest package is kept.
+	_, err = v.LoadPackage(semver.MustParse("2.3.5"))
+	require.Error(t, err)
+
+	b, err := v.LoadPackage(semver.MustParse("2.4.0"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("package 2.4.0"), b)
+
+	// Packages are not mistaken for versions.
+	versions, err := v.ListVersions()
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+}
@@ -28,19 +28,19 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// RemoveOldVersions removes all but the latest app version.
+// RemoveOldVersions removes all but the latest app version and the one before it, which a rollback returns to.
 func (v *Versioner) RemoveOldVersions() error {
 	versions, err := v.ListVersions()
 	if err != nil {
 		return err
 	}
 
-	// darwin does not currently use the versioner.
-	if len(versions) == 0 {
+	// darwin does not currently use the versioner, so there may be nothing to remove.
+	if len(versions) <= 2 {
 		return nil
 	}
 
-	for _, version := range versions[1:] {
+	for _, version := range versions[2:] {
 		if err := os.RemoveAll(version.path); err != nil {
 			logrus.WithError(err).Error("Failed to remove old app version")
 		}
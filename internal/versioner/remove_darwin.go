@@ -22,7 +22,7 @@ package versioner
 
 import "github.com/Masterminds/semver/v3"
 
-// RemoveOldVersions removes all but the latest app version.
+// RemoveOldVersions removes all but the latest app version and the one before it, which a rollback returns to.
 func (v *Versioner) RemoveOldVersions() error {
 	// darwin does not use the versioner; removal is a noop.
 	return nil
@@ -30,6 +30,7 @@ var (
 	ErrNoVersions   = errors.New("no available versions")
 	ErrNoExecutable = errors.New("no executable found")
 	ErrNoRemoveBase = errors.New("can't remove base version")
+	ErrNoRollback   = errors.New("version is not an installed update")
 )
 
 // Versioner manages a directory of versioned app directories.
@@ -84,3 +85,38 @@ func getExecutableInDirectory(name, directory string) (string, error) {
 
 	return exe, nil
 }
+
+// Rollback removes the given installed version so that the launcher starts the previous one instead.
+// It returns the newest remaining version older than the removed one,
+// or nil if the launcher will fall back to the base installed version.
+func (v *Versioner) Rollback(version *semver.Version) (*semver.Version, error) {
+	versions, err := v.ListVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		removed  bool
+		previous *semver.Version
+	)
+
+	for _, candidate := range versions {
+		switch {
+		case candidate.Equal(version):
+			if err := candidate.Remove(); err != nil {
+				return nil, err
+			}
+
+			removed = true
+
+		case removed && previous == nil:
+			previous = candidate.SemVer()
+		}
+	}
+
+	if !removed {
+		return nil, ErrNoRollback
+	}
+
+	return previous, nil
+}
@@ -44,8 +44,12 @@ func TestRemoveOldVersions(t *testing.T) {
 
 	cleanedVersions, err := v.ListVersions()
 	assert.NoError(t, err)
-	assert.Len(t, cleanedVersions, 1)
+	assert.Len(t, cleanedVersions, 2)
 
 	assert.Equal(t, semver.MustParse("2.4.0"), cleanedVersions[0].version)
 	assert.Equal(t, filepath.Join(tempDir, "2.4.0"), cleanedVersions[0].path)
+
+	// The previous version is kept so that the latest one can be rolled back.
+	assert.Equal(t, semver.MustParse("2.3.5"), cleanedVersions[1].version)
+	assert.Equal(t, filepath.Join(tempDir, "2.3.5"), cleanedVersions[1].path)
 }
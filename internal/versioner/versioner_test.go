@@ -48,6 +48,31 @@ func TestListVersions(t *testing.T) {
 	assert.Equal(t, filepath.Join(dir, "2.3.4-beta"), versions[3].path)
 }
 
+func TestRollback(t *testing.T) {
+	dir := t.TempDir()
+
+	v := newTestVersioner(t, "myCoolApp", dir, "2.3.4", "2.3.5", "2.4.0")
+
+	// Roll back from the latest version; the launcher then picks the previous one.
+	previous, err := v.Rollback(semver.MustParse("2.4.0"))
+	require.NoError(t, err)
+	assert.Equal(t, semver.MustParse("2.3.5"), previous)
+
+	versions, err := v.ListVersions()
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, semver.MustParse("2.3.5"), versions[0].version)
+
+	// Roll back from the oldest version; the launcher then falls back to the base installed version.
+	previous, err = v.Rollback(semver.MustParse("2.3.4"))
+	require.NoError(t, err)
+	assert.Nil(t, previous)
+
+	// A version that is not installed as an update can't be rolled back.
+	_, err = v.Rollback(semver.MustParse("2.4.0"))
+	assert.ErrorIs(t, err, ErrNoRollback)
+}
+
 func newTestVersioner(t *testing.T, exeName, updates string, versions ...string) *Versioner {
 	for _, version := range versions {
 		makeDummyVersionDirectory(t, exeName, updates, version)
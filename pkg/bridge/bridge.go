@@ -0,0 +1,283 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package bridge runs Proton Mail Bridge inside another Go program, e.g. a mail migration tool, so that it can
+// log users in, get their bridge passwords and follow their synchronization without going through the CLI.
+//
+// Only this package's exported API is covered by semantic versioning: it doesn't break within a major version.
+// Everything it is built on lives in internal packages and may change at any time.
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+)
+
+// ErrNoSuchUser is returned when the given user is not known to bridge.
+var ErrNoSuchUser = bridge.ErrNoSuchUser
+
+// Config configures a bridge.
+type Config struct {
+	// Dir holds everything bridge stores: settings, the vault, the message cache and the logs.
+	Dir string
+
+	// VaultKey encrypts the vault; the same key must be given to open the vault again.
+	VaultKey []byte
+
+	// APIURL is the URL of the API to use; empty means the production API.
+	APIURL string
+
+	// InsecureAPIURL skips verifying the certificate of the API given in APIURL, e.g. for a self-signed test server.
+	InsecureAPIURL bool
+}
+
+// User describes a user known to bridge.
+type User struct {
+	ID        string
+	Username  string
+	Addresses []string
+
+	// Connected is true when the user is logged in and served by bridge.
+	Connected bool
+}
+
+// Bridge is a running bridge.
+type Bridge struct {
+	bridge *bridge.Bridge
+	vault  *vault.Vault
+}
+
+// New starts a bridge with the given configuration. It must be closed when done.
+func New(cfg Config) (*Bridge, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("no directory given")
+	}
+
+	locator := locations.New(dirProvider(cfg.Dir), constants.ConfigName)
+
+	vaultDir, err := locator.ProvideSettingsPath()
+	if err != nil {
+		return nil, fmt.Errorf("could not provide settings path: %w", err)
+	}
+
+	gluonCacheDir, err := locator.ProvideGluonCachePath()
+	if err != nil {
+		return nil, fmt.Errorf("could not provide gluon path: %w", err)
+	}
+
+	v, _, err := vault.New(vaultDir, gluonCacheDir, cfg.VaultKey, async.NoopPanicHandler{})
+	if err != nil {
+		return nil, fmt.Errorf("could not create vault: %w", err)
+	}
+
+	version, err := semver.NewVersion(constants.Version)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse version: %w", err)
+	}
+
+	var opts []bridge.Option
+
+	if cfg.APIURL != "" {
+		opts = append(opts, bridge.WithAPIURL(cfg.APIURL))
+	}
+
+	if cfg.InsecureAPIURL {
+		opts = append(opts, bridge.WithInsecureAPIURL())
+	}
+
+	b, eventCh, err := bridge.NewWithOptions(locator, v, version, opts...)
+	if err != nil {
+		_ = v.Close()
+		return nil, fmt.Errorf("could not create bridge: %w", err)
+	}
+
+	// Events are handed out through Events; the channel of all events is closed when the bridge is.
+	go func() {
+		for range eventCh {
+		}
+	}()
+
+	return &Bridge{bridge: b, vault: v}, nil
+}
+
+// Close stops the bridge.
+func (b *Bridge) Close(ctx context.Context) error {
+	b.bridge.Close(ctx)
+
+	return b.vault.Close()
+}
+
+// Login logs the given user in and returns its ID. getTOTP is called if the account has two-factor authentication
+// enabled, and getMailboxPassword if it uses a separate mailbox password; either may be nil otherwise.
+func (b *Bridge) Login(
+	ctx context.Context,
+	username string,
+	password []byte,
+	getTOTP func() (string, error),
+	getMailboxPassword func() ([]byte, error),
+) (string, error) {
+	return b.bridge.LoginFull(ctx, username, password, getTOTP, getMailboxPassword)
+}
+
+// Logout logs the given user out; its data is kept until it is deleted.
+func (b *Bridge) Logout(ctx context.Context, userID string) error {
+	return b.bridge.LogoutUser(ctx, userID)
+}
+
+// DeleteUser logs the given user out and deletes its data.
+func (b *Bridge) DeleteUser(ctx context.Context, userID string) error {
+	return b.bridge.DeleteUser(ctx, userID)
+}
+
+// Users returns the users known to bridge.
+func (b *Bridge) Users() ([]User, error) {
+	var users []User
+
+	for _, userID := range b.bridge.GetUserIDs() {
+		info, err := b.bridge.GetUserInfo(userID)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, User{
+			ID:        info.UserID,
+			Username:  info.Username,
+			Addresses: info.Addresses,
+			Connected: info.State == bridge.Connected,
+		})
+	}
+
+	return users, nil
+}
+
+// BridgePassword returns the password the given user's mail clients log in to IMAP and SMTP with.
+func (b *Bridge) BridgePassword(userID string) ([]byte, error) {
+	if !b.bridge.HasUser(userID) {
+		return nil, ErrNoSuchUser
+	}
+
+	info, err := b.bridge.GetUserInfo(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return info.BridgePass, nil
+}
+
+// IMAPPort returns the port the IMAP server listens on.
+func (b *Bridge) IMAPPort() int {
+	return b.bridge.GetIMAPPort()
+}
+
+// SMTPPort returns the port the SMTP server listens on.
+func (b *Bridge) SMTPPort() int {
+	return b.bridge.GetSMTPPort()
+}
+
+// Resync synchronizes all users again from scratch.
+func (b *Bridge) Resync() {
+	b.bridge.Repair()
+}
+
+// Events returns a channel of the bridge's events, starting with those published after the call.
+// The channel is closed when the given context is done or the bridge is closed.
+func (b *Bridge) Events(ctx context.Context) <-chan Event {
+	eventCh, cancel := b.bridge.GetEvents()
+	outCh := make(chan Event)
+
+	go func() {
+		defer close(outCh)
+		defer cancel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case event, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				out, ok := newEvent(event)
+				if !ok {
+					continue
+				}
+
+				select {
+				case outCh <- out:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return outCh
+}
+
+// dirProvider keeps everything under a single directory.
+type dirProvider string
+
+func (p dirProvider) UserConfig() string { return filepath.Join(string(p), "config") }
+func (p dirProvider) UserData() string   { return filepath.Join(string(p), "data") }
+func (p dirProvider) UserCache() string  { return filepath.Join(string(p), "cache") }
+
+// newEvent converts the events covered by this package.
+func newEvent(event events.Event) (Event, bool) {
+	switch event := event.(type) {
+	case events.UserLoggedIn:
+		return Event{Type: UserLoggedIn, UserID: event.UserID}, true
+
+	case events.UserLoggedOut:
+		return Event{Type: UserLoggedOut, UserID: event.UserID}, true
+
+	case events.UserDeauth:
+		return Event{Type: UserLoggedOut, UserID: event.UserID}, true
+
+	case events.SyncStarted:
+		return Event{Type: SyncStarted, UserID: event.UserID}, true
+
+	case events.SyncProgress:
+		return Event{Type: SyncProgress, UserID: event.UserID, Progress: event.Progress}, true
+
+	case events.SyncFinished:
+		return Event{Type: SyncFinished, UserID: event.UserID, Progress: 1}, true
+
+	case events.SyncFailed:
+		return Event{Type: SyncFailed, UserID: event.UserID, Err: event.Error}, true
+
+	case events.ConnStatusUp:
+		return Event{Type: Connected}, true
+
+	case events.ConnStatusDown:
+		return Event{Type: Disconnected}, true
+
+	default:
+		return Event{}, false
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/go-proton-api/server/backend"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/bridge"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	backend.GenerateKey = backend.FastGenerateKey
+}
+
+func TestBridge_LoginAndSync(t *testing.T) {
+	s := server.New()
+	defer s.Close()
+
+	_, _, err := s.CreateUser("username", []byte("password"))
+	require.NoError(t, err)
+
+	b, err := bridge.New(bridge.Config{
+		Dir:      t.TempDir(),
+		VaultKey: make([]byte, 32),
+		APIURL:   s.GetHostURL(),
+
+		// The test server's certificate is self-signed.
+		InsecureAPIURL: true,
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, b.Close(context.Background())) }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventCh := b.Events(ctx)
+
+	userID, err := b.Login(context.Background(), "username", []byte("password"), nil, nil)
+	require.NoError(t, err)
+
+	// The user is listed with its bridge password.
+	users, err := b.Users()
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, userID, users[0].ID)
+	require.True(t, users[0].Connected)
+
+	pass, err := b.BridgePassword(userID)
+	require.NoError(t, err)
+	require.NotEmpty(t, pass)
+
+	// The user is synchronized.
+	for event := range eventCh {
+		if event.Type == bridge.SyncFinished {
+			require.Equal(t, userID, event.UserID)
+			break
+		}
+	}
+
+	// Unknown users are reported as such.
+	_, err = b.BridgePassword("unknown")
+	require.ErrorIs(t, err, bridge.ErrNoSuchUser)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+// EventType is the type of an event.
+type EventType int
+
+const (
+	// UserLoggedIn is emitted when a user logs in.
+	UserLoggedIn EventType = iota
+
+	// UserLoggedOut is emitted when a user logs out, or is logged out by the API.
+	UserLoggedOut
+
+	// SyncStarted is emitted when bridge starts synchronizing a user.
+	SyncStarted
+
+	// SyncProgress is emitted as the synchronization of a user progresses.
+	SyncProgress
+
+	// SyncFinished is emitted when bridge has synchronized a user.
+	SyncFinished
+
+	// SyncFailed is emitted when the synchronization of a user fails; bridge retries it later.
+	SyncFailed
+
+	// Connected is emitted when bridge can reach the API again.
+	Connected
+
+	// Disconnected is emitted when bridge can't reach the API.
+	Disconnected
+)
+
+func (t EventType) String() string {
+	switch t {
+	case UserLoggedIn:
+		return "UserLoggedIn"
+
+	case UserLoggedOut:
+		return "UserLoggedOut"
+
+	case SyncStarted:
+		return "SyncStarted"
+
+	case SyncProgress:
+		return "SyncProgress"
+
+	case SyncFinished:
+		return "SyncFinished"
+
+	case SyncFailed:
+		return "SyncFailed"
+
+	case Connected:
+		return "Connected"
+
+	case Disconnected:
+		return "Disconnected"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// Event is something that happened in bridge.
+type Event struct {
+	Type EventType
+
+	// UserID is the user the event is about, if any.
+	UserID string
+
+	// Progress is the synchronization progress, between 0 and 1, of SyncProgress and SyncFinished events.
+	Progress float64
+
+	// Err is the error of SyncFailed events.
+	Err error
+}
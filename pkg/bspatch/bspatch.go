@@ -0,0 +1,111 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package bspatch applies binary diffs produced by bsdiff (the BSDIFF40 format).
+package bspatch
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	magic      = "BSDIFF40"
+	headerSize = 32
+)
+
+var ErrCorruptPatch = errors.New("corrupt patch")
+
+// Patch applies the given bsdiff patch to old and returns the new content.
+func Patch(old, patch []byte) ([]byte, error) {
+	if len(patch) < headerSize || string(patch[:len(magic)]) != magic {
+		return nil, fmt.Errorf("%w: bad header", ErrCorruptPatch)
+	}
+
+	ctrlLen := offtin(patch[8:16])
+	diffLen := offtin(patch[16:24])
+	newSize := offtin(patch[24:32])
+
+	if ctrlLen < 0 || diffLen < 0 || newSize < 0 || headerSize+ctrlLen+diffLen > int64(len(patch)) {
+		return nil, fmt.Errorf("%w: bad header", ErrCorruptPatch)
+	}
+
+	ctrl := bzip2.NewReader(bytes.NewReader(patch[headerSize : headerSize+ctrlLen]))
+	diff := bzip2.NewReader(bytes.NewReader(patch[headerSize+ctrlLen : headerSize+ctrlLen+diffLen]))
+	extra := bzip2.NewReader(bytes.NewReader(patch[headerSize+ctrlLen+diffLen:]))
+
+	newData := make([]byte, newSize)
+
+	var (
+		oldPos, newPos int64
+		buf            [24]byte
+	)
+
+	for newPos < newSize {
+		// Each control entry is: bytes to add from diff, bytes to copy from extra, and how far to seek in old.
+		if _, err := io.ReadFull(ctrl, buf[:]); err != nil {
+			return nil, fmt.Errorf("%w: failed to read control block: %w", ErrCorruptPatch, err)
+		}
+
+		addLen, copyLen, seekLen := offtin(buf[0:8]), offtin(buf[8:16]), offtin(buf[16:24])
+
+		if addLen < 0 || copyLen < 0 || newPos+addLen > newSize {
+			return nil, fmt.Errorf("%w: bad control entry", ErrCorruptPatch)
+		}
+
+		if _, err := io.ReadFull(diff, newData[newPos:newPos+addLen]); err != nil {
+			return nil, fmt.Errorf("%w: failed to read diff block: %w", ErrCorruptPatch, err)
+		}
+
+		for i := int64(0); i < addLen; i++ {
+			if pos := oldPos + i; pos >= 0 && pos < int64(len(old)) {
+				newData[newPos+i] += old[pos]
+			}
+		}
+
+		newPos += addLen
+		oldPos += addLen
+
+		if newPos+copyLen > newSize {
+			return nil, fmt.Errorf("%w: bad control entry", ErrCorruptPatch)
+		}
+
+		if _, err := io.ReadFull(extra, newData[newPos:newPos+copyLen]); err != nil {
+			return nil, fmt.Errorf("%w: failed to read extra block: %w", ErrCorruptPatch, err)
+		}
+
+		newPos += copyLen
+		oldPos += seekLen
+	}
+
+	return newData, nil
+}
+
+// offtin decodes the sign-magnitude little-endian integers used by bsdiff.
+func offtin(b []byte) int64 {
+	v := int64(binary.LittleEndian.Uint64(b) &^ (1 << 63))
+
+	if b[7]&0x80 != 0 {
+		return -v
+	}
+
+	return v
+}
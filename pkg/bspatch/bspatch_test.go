@@ -0,0 +1,58 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bspatch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readTestData(t *testing.T, name string) []byte {
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	return b
+}
+
+func TestPatch(t *testing.T) {
+	newData, err := Patch(readTestData(t, "old.bin"), readTestData(t, "old_to_new.patch"))
+	require.NoError(t, err)
+	require.Equal(t, readTestData(t, "new.bin"), newData)
+}
+
+func TestPatch_Corrupt(t *testing.T) {
+	old, patch := readTestData(t, "old.bin"), readTestData(t, "old_to_new.patch")
+
+	_, err := Patch(old, []byte("BSDIFF"))
+	require.ErrorIs(t, err, ErrCorruptPatch)
+
+	_, err = Patch(old, append([]byte("NOTBSDIF"), patch[8:]...))
+	require.ErrorIs(t, err, ErrCorruptPatch)
+
+	_, err = Patch(old, patch[:len(patch)/2])
+	require.ErrorIs(t, err, ErrCorruptPatch)
+}
+
+func TestOfftin(t *testing.T) {
+	require.Equal(t, int64(0), offtin([]byte{0, 0, 0, 0, 0, 0, 0, 0}))
+	require.Equal(t, int64(300), offtin([]byte{0x2c, 0x01, 0, 0, 0, 0, 0, 0}))
+	require.Equal(t, int64(-200), offtin([]byte{0xc8, 0, 0, 0, 0, 0, 0, 0x80}))
+}
@@ -20,6 +20,7 @@ package message
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"mime"
 	"net/mail"
 	"strings"
@@ -47,6 +48,10 @@ var (
 const InternalIDDomain = `protonmail.internalid`
 
 func BuildRFC822Into(kr *crypto.KeyRing, decrypted *DecryptedMessage, opts JobOptions, buf *bytes.Buffer) error {
+	if opts.RepairMIME {
+		return buildRepairedRFC822(kr, decrypted, opts, buf)
+	}
+
 	if opts.SanitizeMBOXHeaderLine {
 		if err := sanitizeMBOXHeaderLine(decrypted); err != nil {
 			return fmt.Errorf("failed to sanitize MBOX header: %w", err)
@@ -161,7 +166,8 @@ func writeAttachmentPart(
 	opts JobOptions,
 ) error {
 	if decryptedAttachment.Err != nil {
-		if !opts.IgnoreDecryptionErrors {
+		// Failing to write the attachment to disk says nothing about the message, so it is never built as undecryptable.
+		if !opts.IgnoreDecryptionErrors || errors.Is(decryptedAttachment.Err, ErrSpillFailed) {
 			return decryptedAttachment.Err
 		}
 
@@ -178,7 +184,7 @@ func writeAttachmentPart(
 		return writeCustomAttachmentPart(w, att, &crypto.PGPMessage{Data: pgpMessageBuffer.Bytes()}, decryptedAttachment.Err)
 	}
 
-	return writePart(w, getAttachmentPartHeader(att), decryptedAttachment.Data.Bytes())
+	return writePartFrom(w, getAttachmentPartHeader(att), decryptedAttachment.Data.NewReader())
 }
 
 func writeRelatedParts(
@@ -576,6 +582,17 @@ func writePart(w *message.Writer, hdr message.Header, body []byte) error {
 	})
 }
 
+// writePartFrom writes a part whose body is streamed from the given reader, so it never needs to be held in memory.
+func writePartFrom(w *message.Writer, hdr message.Header, body io.Reader) error {
+	return createPart(w, hdr, func(part *message.Writer) error {
+		if _, err := io.Copy(part, body); err != nil {
+			return errors.Wrap(err, "failed to write part body")
+		}
+
+		return nil
+	})
+}
+
 type boundary struct {
 	val string
 }
@@ -30,7 +30,7 @@ import (
 type DecryptedAttachment struct {
 	Packet    []byte
 	Encrypted []byte
-	Data      bytes.Buffer
+	Data      SpillBuffer
 	Err       error
 }
 
@@ -41,9 +41,24 @@ type DecryptedMessage struct {
 	Attachments []DecryptedAttachment
 }
 
+// Close releases the decrypted attachment data, removing any temporary file holding it.
+func (msg *DecryptedMessage) Close() error {
+	var closeErr error
+
+	for i := range msg.Attachments {
+		if err := msg.Attachments[i].Data.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+
+	return closeErr
+}
+
 var ErrInvalidAttachmentPacket = errors.New("invalid attachment packet")
 
-func DecryptMessage(kr *crypto.KeyRing, msg proton.Message, attData [][]byte) DecryptedMessage {
+// DecryptMessage decrypts the body and attachments of msg.
+// Large attachments are decrypted to temporary files in spillDir, or the system temporary directory if empty.
+func DecryptMessage(kr *crypto.KeyRing, msg proton.Message, attData [][]byte, spillDir string) DecryptedMessage {
 	result := DecryptedMessage{
 		Msg: msg,
 	}
@@ -66,6 +81,7 @@ func DecryptMessage(kr *crypto.KeyRing, msg proton.Message, attData [][]byte) De
 		}
 
 		result.Attachments[i].Packet = kps
+		result.Attachments[i].Data = SpillBuffer{dir: spillDir}
 
 		// Use io.Multi
 		attachmentReader := io.MultiReader(bytes.NewReader(kps), bytes.NewReader(attData[i]))
@@ -76,10 +92,14 @@ func DecryptMessage(kr *crypto.KeyRing, msg proton.Message, attData [][]byte) De
 			continue
 		}
 
-		result.Attachments[i].Data.Grow(len(kps) + len(attData))
-
+		// Large attachments are decrypted to disk rather than held in memory.
 		if _, err := result.Attachments[i].Data.ReadFrom(stream); err != nil {
-			result.Attachments[i].Err = errors.Wrap(ErrDecryptionFailed, err.Error())
+			if errors.Is(err, ErrSpillFailed) {
+				result.Attachments[i].Err = err
+			} else {
+				result.Attachments[i].Err = errors.Wrap(ErrDecryptionFailed, err.Error())
+			}
+
 			continue
 		}
 	}
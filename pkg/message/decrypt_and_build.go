@@ -34,7 +34,12 @@ func DecryptAndBuildRFC822(kr *crypto.KeyRing, msg proton.Message, attData [][]b
 }
 
 func DecryptAndBuildRFC822Into(kr *crypto.KeyRing, msg proton.Message, attData [][]byte, opts JobOptions, buf *bytes.Buffer) error {
-	decrypted := DecryptMessage(kr, msg, attData)
+	decrypted := DecryptMessage(kr, msg, attData, opts.SpillDir)
+	defer func() {
+		if err := decrypted.Close(); err != nil {
+			log.WithError(err).Warn("Failed to release decrypted attachments")
+		}
+	}()
 
 	return BuildRFC822Into(kr, &decrypted, opts, buf)
 }
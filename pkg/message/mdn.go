@@ -0,0 +1,165 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package message
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"time"
+)
+
+// ReadReceiptReportingUA is the user agent named in the read receipts built by BuildReadReceipt.
+const ReadReceiptReportingUA = "Proton Mail Bridge"
+
+// ReadReceipt describes a read receipt (a message disposition notification, RFC 8098) telling the sender
+// of a message that it was displayed to its recipient.
+type ReadReceipt struct {
+	// From is the recipient of the original message, who sends the receipt.
+	From *mail.Address
+
+	// To is the address the original message asked the receipt to be sent to (Disposition-Notification-To).
+	To *mail.Address
+
+	// OriginalMessageID is the Message-ID of the original message, without angle brackets.
+	OriginalMessageID string
+
+	// OriginalSubject is the subject of the original message.
+	OriginalSubject string
+
+	// Automatic is true if the receipt is sent without asking the user.
+	Automatic bool
+
+	// Date is when the original message was displayed.
+	Date time.Time
+}
+
+// BuildReadReceipt builds the literal of the given read receipt as a multipart/report message with
+// a human-readable part and a machine-readable message/disposition-notification part.
+func BuildReadReceipt(receipt ReadReceipt) ([]byte, error) {
+	if receipt.From == nil || receipt.To == nil {
+		return nil, fmt.Errorf("read receipt needs a sender and a recipient")
+	}
+
+	buf := new(bytes.Buffer)
+
+	body := new(bytes.Buffer)
+
+	mw := multipart.NewWriter(body)
+
+	header := textproto.MIMEHeader{}
+
+	header.Set("From", receipt.From.String())
+	header.Set("To", receipt.To.String())
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", "Read: "+receipt.OriginalSubject))
+	header.Set("Date", receipt.Date.Format(time.RFC1123Z))
+	header.Set("Mime-Version", "1.0")
+	header.Set("Content-Type", mime.FormatMediaType("multipart/report", map[string]string{
+		"report-type": "disposition-notification",
+		"boundary":    mw.Boundary(),
+	}))
+
+	if receipt.OriginalMessageID != "" {
+		header.Set("In-Reply-To", "<"+receipt.OriginalMessageID+">")
+		header.Set("References", "<"+receipt.OriginalMessageID+">")
+	}
+
+	if err := writeReadReceiptText(mw, receipt); err != nil {
+		return nil, err
+	}
+
+	if err := writeReadReceiptNotification(mw, receipt); err != nil {
+		return nil, err
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	for _, key := range []string{"From", "To", "Subject", "Date", "In-Reply-To", "References", "Mime-Version", "Content-Type"} {
+		if value := header.Get(key); value != "" {
+			fmt.Fprintf(buf, "%v: %v\r\n", key, value)
+		}
+	}
+
+	buf.WriteString("\r\n")
+	buf.Write(body.Bytes())
+
+	return buf.Bytes(), nil
+}
+
+func writeReadReceiptText(mw *multipart.Writer, receipt ReadReceipt) error {
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=utf-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	qp := quotedprintable.NewWriter(part)
+
+	if _, err := fmt.Fprintf(qp,
+		"This is a read receipt for the message sent to %v with the subject \"%v\".\r\n\r\n"+
+			"It was displayed on %v. This only means that the message was shown on the recipient's "+
+			"computer; it doesn't guarantee that it was read or understood.\r\n",
+		receipt.From.Address,
+		receipt.OriginalSubject,
+		receipt.Date.Format(time.RFC1123Z),
+	); err != nil {
+		return err
+	}
+
+	return qp.Close()
+}
+
+func writeReadReceiptNotification(mw *multipart.Writer, receipt ReadReceipt) error {
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"message/disposition-notification"},
+	})
+	if err != nil {
+		return err
+	}
+
+	disposition := "manual-action/MDN-sent-manually; displayed"
+	if receipt.Automatic {
+		disposition = "automatic-action/MDN-sent-automatically; displayed"
+	}
+
+	if _, err := fmt.Fprintf(part, "Reporting-UA: %v\r\n", ReadReceiptReportingUA); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(part, "Final-Recipient: rfc822; %v\r\n", receipt.From.Address); err != nil {
+		return err
+	}
+
+	if receipt.OriginalMessageID != "" {
+		if _, err := fmt.Fprintf(part, "Original-Message-ID: <%v>\r\n", receipt.OriginalMessageID); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(part, "Disposition: %v\r\n", disposition)
+
+	return err
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package message
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildReadReceipt(t *testing.T) {
+	literal, err := BuildReadReceipt(ReadReceipt{
+		From:              &mail.Address{Name: "Recipient", Address: "recipient@pm.me"},
+		To:                &mail.Address{Address: "sender@example.com"},
+		OriginalMessageID: "original@example.com",
+		OriginalSubject:   "Héllo",
+		Date:              time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(literal))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "Read: Héllo", subject)
+	require.Equal(t, "<sender@example.com>", msg.Header.Get("To"))
+	require.Equal(t, "<original@example.com>", msg.Header.Get("In-Reply-To"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/report", mediaType)
+	require.Equal(t, "disposition-notification", params["report-type"])
+
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+
+	text, err := mr.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "text/plain; charset=utf-8", text.Header.Get("Content-Type"))
+
+	notification, err := mr.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "message/disposition-notification", notification.Header.Get("Content-Type"))
+
+	fields, err := io.ReadAll(notification)
+	require.NoError(t, err)
+	require.Contains(t, string(fields), "Final-Recipient: rfc822; recipient@pm.me\r\n")
+	require.Contains(t, string(fields), "Original-Message-ID: <original@example.com>\r\n")
+	require.Contains(t, string(fields), "Disposition: manual-action/MDN-sent-manually; displayed\r\n")
+
+	_, err = mr.NextPart()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestBuildReadReceipt_Automatic(t *testing.T) {
+	literal, err := BuildReadReceipt(ReadReceipt{
+		From:      &mail.Address{Address: "recipient@pm.me"},
+		To:        &mail.Address{Address: "sender@example.com"},
+		Automatic: true,
+		Date:      time.Now(),
+	})
+	require.NoError(t, err)
+	require.Contains(t, string(literal), "Disposition: automatic-action/MDN-sent-automatically; displayed\r\n")
+	require.NotContains(t, string(literal), "In-Reply-To")
+}
+
+func TestBuildReadReceipt_MissingRecipient(t *testing.T) {
+	_, err := BuildReadReceipt(ReadReceipt{From: &mail.Address{Address: "recipient@pm.me"}})
+	require.Error(t, err)
+}
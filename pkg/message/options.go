@@ -25,4 +25,7 @@ type JobOptions struct {
 	AddMessageDate         bool // Whether to include message time as X-Pm-Date.
 	AddMessageIDReference  bool // Whether to include the MessageID in References.
 	SanitizeMBOXHeaderLine bool // Whether to ignore header line representing MBOX delimiter
+	RepairMIME             bool // Whether to repair malformed MIME, keeping the original message as an attachment.
+
+	SpillDir string // Directory in which large attachments are decrypted to temporary files; the system one if empty.
 }
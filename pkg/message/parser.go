@@ -73,6 +73,18 @@ type Attachment struct {
 	Data        []byte
 }
 
+// AttachmentSize returns the decoded size of the attachments of the message.
+// This is what the API counts against the upload limit of the user.
+func (m *Message) AttachmentSize() int64 {
+	var size int64
+
+	for _, att := range m.Attachments {
+		size += int64(len(att.Data))
+	}
+
+	return size
+}
+
 // Parse parses an RFC822 message.
 func Parse(r io.Reader) (m Message, err error) {
 	return parseIOReaderImpl(r, false)
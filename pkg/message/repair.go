@@ -0,0 +1,536 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package message
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	pmmime "github.com/ProtonMail/proton-bridge/v3/pkg/mime"
+)
+
+// RepairedOriginalName is the name of the attachment holding the original message when it had to be repaired.
+const RepairedOriginalName = "original.eml"
+
+// maxRepairDepth limits how deep nested multiparts are repaired.
+const maxRepairDepth = 32
+
+// RepairMIME fixes common problems of malformed messages which otherwise make clients show an empty body or fail to
+// fetch them: multipart boundaries which are missing or don't match the body, unterminated multiparts, raw 8-bit
+// header values and text parts without a charset. It returns the repaired literal and whether anything was fixed.
+func RepairMIME(literal []byte) ([]byte, bool) {
+	return repairEntity(literal, 0)
+}
+
+func repairEntity(raw []byte, depth int) ([]byte, bool) {
+	rawHeader, body, err := splitHeaderBody(raw)
+	if err != nil {
+		return raw, false
+	}
+
+	lines := HeaderLines(rawHeader)
+	if !isHeaderValid(lines) {
+		return raw, false
+	}
+
+	lines, changed := repairHeaderLines(lines)
+
+	mediaType, params := parseContentTypeLenient(getHeaderLine(lines, "Content-Type"))
+
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/") && mediaType != "multipart/signed" && mediaType != "multipart/encrypted":
+		if depth >= maxRepairDepth {
+			break
+		}
+
+		if repaired, boundary, ok := repairMultipart(body, params["boundary"], depth); ok {
+			if boundary != params["boundary"] {
+				params["boundary"] = boundary
+				lines = setHeaderLine(lines, "Content-Type", mime.FormatMediaType(mediaType, params))
+			}
+
+			body, changed = repaired, true
+		}
+
+	case strings.HasPrefix(mediaType, "text/") || mediaType == "":
+		disposition, _, _ := mime.ParseMediaType(getHeaderLine(lines, "Content-Disposition"))
+		if disposition == "attachment" {
+			break
+		}
+
+		if repaired, cte, ok := repairCharset(body, mediaType, params, getHeaderLine(lines, "Content-Transfer-Encoding")); ok {
+			if mediaType == "" {
+				mediaType = "text/plain"
+			}
+
+			params["charset"] = "utf-8"
+			lines = setHeaderLine(lines, "Content-Type", mime.FormatMediaType(mediaType, params))
+			lines = setHeaderLine(lines, "Content-Transfer-Encoding", cte)
+			body, changed = repaired, true
+		}
+	}
+
+	if !changed {
+		return raw, false
+	}
+
+	return append(bytes.Join(lines, nil), body...), true
+}
+
+// repairHeaderLines turns raw 8-bit header values into valid UTF-8, RFC2047-encoding them where the field allows it.
+func repairHeaderLines(lines [][]byte) ([][]byte, bool) {
+	var changed bool
+
+	for i, line := range lines {
+		if isASCII(line) {
+			continue
+		}
+
+		key, value, ok := splitHeaderLine(line)
+		if !ok {
+			continue
+		}
+
+		if repaired := repairHeaderValue(key, value); repaired != value {
+			lines[i] = []byte(key + ": " + repaired + "\r\n")
+			changed = true
+		}
+	}
+
+	return lines, changed
+}
+
+func repairHeaderValue(key, value string) string {
+	value = toUTF8(value)
+
+	switch strings.ToLower(key) {
+	case "from", "sender", "reply-to", "to", "cc", "bcc", "resent-from", "resent-sender", "resent-to", "resent-cc", "resent-bcc":
+		// mail.Address.String() will RFC2047-encode the names.
+		if addrs, err := mail.ParseAddressList(value); err == nil {
+			return toAddressList(addrs)
+		}
+
+		return value
+
+	case "content-type", "content-disposition":
+		// Non-ASCII parameters are RFC2231-encoded.
+		if mediaType, params, err := mime.ParseMediaType(value); err == nil {
+			if formatted := mime.FormatMediaType(mediaType, params); formatted != "" {
+				return formatted
+			}
+		}
+
+		return value
+
+	case "subject", "comments", "keywords", "content-description", "thread-topic":
+		return mime.QEncoding.Encode("utf-8", value)
+
+	default:
+		if strings.HasPrefix(strings.ToLower(key), "x-") {
+			return mime.QEncoding.Encode("utf-8", value)
+		}
+
+		return value
+	}
+}
+
+// repairMultipart finds the boundary really used by the body if the declared one is missing or unused, terminates the
+// multipart if needed and repairs each of its parts.
+func repairMultipart(body []byte, boundary string, depth int) ([]byte, string, bool) {
+	var changed bool
+
+	if boundary == "" || !hasDelimiterLine(body, boundary) {
+		if boundary = detectBoundary(body); boundary == "" {
+			return body, boundary, false
+		}
+
+		changed = true
+	}
+
+	preamble, parts, epilogue, closed := splitMultipart(body, boundary)
+	if len(parts) == 0 {
+		return body, boundary, false
+	}
+
+	if !closed {
+		changed = true
+	}
+
+	for i, part := range parts {
+		if repaired, ok := repairEntity(part, depth+1); ok {
+			parts[i], changed = repaired, true
+		}
+	}
+
+	if !changed {
+		return body, boundary, false
+	}
+
+	var buf bytes.Buffer
+
+	if len(preamble) > 0 {
+		buf.Write(preamble)
+		buf.WriteString("\r\n")
+	}
+
+	for _, part := range parts {
+		buf.WriteString("--" + boundary + "\r\n")
+		buf.Write(part)
+		buf.WriteString("\r\n")
+	}
+
+	buf.WriteString("--" + boundary + "--\r\n")
+	buf.Write(epilogue)
+
+	return buf.Bytes(), boundary, true
+}
+
+// repairCharset converts a text body without charset to UTF-8 if it isn't plain ASCII.
+// It returns the body encoded with the returned content transfer encoding.
+func repairCharset(body []byte, mediaType string, params map[string]string, cte string) ([]byte, string, bool) {
+	if params["charset"] != "" {
+		return body, cte, false
+	}
+
+	cte = strings.ToLower(strings.TrimSpace(cte))
+
+	decoded, err := decodeTransferEncoding(body, cte)
+	if err != nil || isASCII(decoded) {
+		return body, cte, false
+	}
+
+	converted, err := pmmime.DecodeCharset(decoded, mediaType)
+	if err != nil || !utf8.Valid(converted) {
+		return body, cte, false
+	}
+
+	if cte != "base64" {
+		cte = "quoted-printable"
+	}
+
+	encoded, err := encodeTransferEncoding(converted, cte)
+	if err != nil {
+		return body, cte, false
+	}
+
+	return encoded, cte, true
+}
+
+// buildRepairedRFC822 builds the message as usual and then repairs its MIME structure if needed.
+// If the message was repaired, the original is attached to the repaired message.
+func buildRepairedRFC822(kr *crypto.KeyRing, decrypted *DecryptedMessage, opts JobOptions, buf *bytes.Buffer) error {
+	opts.RepairMIME = false
+
+	var original bytes.Buffer
+
+	if err := BuildRFC822Into(kr, decrypted, opts, &original); err != nil {
+		return err
+	}
+
+	repaired, ok := RepairMIME(original.Bytes())
+	if !ok {
+		_, err := buf.Write(original.Bytes())
+		return err
+	}
+
+	return writeRepairedRFC822(repaired, original.Bytes(), newBoundary(decrypted.Msg.ID+"-repaired").gen(), buf)
+}
+
+// writeRepairedRFC822 writes the repaired message as a multipart/mixed whose first part holds the repaired content
+// and second part the original message as a message/rfc822 attachment.
+func writeRepairedRFC822(repaired, original []byte, boundary string, buf *bytes.Buffer) error {
+	rawHeader, body, err := splitHeaderBody(repaired)
+	if err != nil {
+		return err
+	}
+
+	var contentLines, messageLines [][]byte
+
+	for _, line := range HeaderLines(rawHeader) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		if key, _, ok := splitHeaderLine(line); ok && strings.HasPrefix(strings.ToLower(key), "content-") {
+			contentLines = append(contentLines, line)
+		} else {
+			messageLines = append(messageLines, line)
+		}
+	}
+
+	for _, line := range messageLines {
+		buf.Write(line)
+	}
+
+	buf.WriteString("Content-Type: " + mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": boundary}) + "\r\n")
+	buf.WriteString("\r\n")
+
+	buf.WriteString("--" + boundary + "\r\n")
+
+	for _, line := range contentLines {
+		buf.Write(line)
+	}
+
+	buf.WriteString("\r\n")
+	buf.Write(body)
+	buf.WriteString("\r\n--" + boundary + "\r\n")
+
+	// A message/rfc822 part can't be base64 encoded, so the original is embedded as-is.
+	cte := "7bit"
+	if !isASCII(original) {
+		cte = "8bit"
+	}
+
+	buf.WriteString("Content-Type: " + mime.FormatMediaType("message/rfc822", map[string]string{"name": RepairedOriginalName}) + "\r\n")
+	buf.WriteString("Content-Disposition: " + mime.FormatMediaType("attachment", map[string]string{"filename": RepairedOriginalName}) + "\r\n")
+	buf.WriteString("Content-Description: Original message before repair\r\n")
+	buf.WriteString("Content-Transfer-Encoding: " + cte + "\r\n")
+	buf.WriteString("\r\n")
+
+	buf.Write(original)
+	buf.WriteString("\r\n--" + boundary + "--\r\n")
+
+	return nil
+}
+
+// detectBoundary returns the most used delimiter line in the body which is followed by a part header.
+func detectBoundary(body []byte) string {
+	var (
+		lines  = bytes.Split(body, []byte("\n"))
+		counts = make(map[string]int)
+		best   string
+	)
+
+	for i := 0; i < len(lines)-1; i++ {
+		line := bytes.TrimRight(lines[i], " \t\r")
+		if len(line) <= 2 || !bytes.HasPrefix(line, []byte("--")) {
+			continue
+		}
+
+		if !bytes.HasPrefix(bytes.ToLower(lines[i+1]), []byte("content-")) {
+			continue
+		}
+
+		candidate := string(line[2:])
+
+		if counts[candidate]++; counts[candidate] > counts[best] {
+			best = candidate
+		}
+	}
+
+	return best
+}
+
+func hasDelimiterLine(body []byte, boundary string) bool {
+	delimiter := []byte("--" + boundary)
+
+	for _, line := range bytes.Split(body, []byte("\n")) {
+		if bytes.Equal(bytes.TrimRight(line, " \t\r"), delimiter) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitMultipart splits a multipart body along its delimiter lines. It reports whether the closing delimiter was found.
+func splitMultipart(body []byte, boundary string) (preamble []byte, parts [][]byte, epilogue []byte, closed bool) {
+	var (
+		delimiter = []byte("--" + boundary)
+		closing   = []byte("--" + boundary + "--")
+		partStart = -1
+	)
+
+	for lineStart := 0; lineStart < len(body); {
+		lineEnd := len(body)
+		if idx := bytes.IndexByte(body[lineStart:], '\n'); idx >= 0 {
+			lineEnd = lineStart + idx + 1
+		}
+
+		line := bytes.TrimRight(body[lineStart:lineEnd], " \t\r\n")
+
+		if isDelimiter, isClosing := bytes.Equal(line, delimiter), bytes.Equal(line, closing); isDelimiter || isClosing {
+			// The line break before a delimiter belongs to the delimiter.
+			if partStart < 0 {
+				preamble = trimLineBreak(body[:lineStart])
+			} else {
+				parts = append(parts, trimLineBreak(body[partStart:lineStart]))
+			}
+
+			if isClosing {
+				return preamble, parts, body[lineEnd:], true
+			}
+
+			partStart = lineEnd
+		}
+
+		lineStart = lineEnd
+	}
+
+	if partStart < 0 {
+		return body, nil, nil, false
+	}
+
+	return preamble, append(parts, body[partStart:]), nil, false
+}
+
+func trimLineBreak(b []byte) []byte {
+	if bytes.HasSuffix(b, []byte("\r\n")) {
+		return b[:len(b)-2]
+	}
+
+	return bytes.TrimSuffix(b, []byte("\n"))
+}
+
+// parseContentTypeLenient parses the content type, falling back to splitting parameters by hand if it's malformed.
+func parseContentTypeLenient(value string) (string, map[string]string) {
+	if value == "" {
+		return "", make(map[string]string)
+	}
+
+	if mediaType, params, err := mime.ParseMediaType(value); err == nil {
+		return mediaType, params
+	}
+
+	fields := strings.Split(value, ";")
+	params := make(map[string]string)
+
+	for _, field := range fields[1:] {
+		if key, val, ok := strings.Cut(field, "="); ok {
+			params[strings.ToLower(strings.TrimSpace(key))] = strings.Trim(strings.TrimSpace(val), `"`)
+		}
+	}
+
+	return strings.ToLower(strings.TrimSpace(fields[0])), params
+}
+
+func splitHeaderLine(line []byte) (string, string, bool) {
+	key, value, ok := strings.Cut(string(line), ":")
+	if !ok {
+		return "", "", false
+	}
+
+	// Unfold the value.
+	value = strings.NewReplacer("\r\n", "", "\n", "").Replace(value)
+
+	return strings.TrimSpace(key), strings.TrimSpace(value), true
+}
+
+func getHeaderLine(lines [][]byte, key string) string {
+	for _, line := range lines {
+		if k, v, ok := splitHeaderLine(line); ok && strings.EqualFold(k, key) {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// setHeaderLine replaces the first field with the given key, or adds it at the end of the header.
+func setHeaderLine(lines [][]byte, key, value string) [][]byte {
+	line := []byte(key + ": " + value + "\r\n")
+
+	for i, l := range lines {
+		if k, _, ok := splitHeaderLine(l); ok && strings.EqualFold(k, key) {
+			lines[i] = line
+			return lines
+		}
+	}
+
+	// Keep the blank line ending the header last.
+	if n := len(lines); n > 0 && len(bytes.TrimSpace(lines[n-1])) == 0 {
+		return append(lines[:n-1], line, lines[n-1])
+	}
+
+	return append(lines, line)
+}
+
+func decodeTransferEncoding(body []byte, cte string) ([]byte, error) {
+	switch cte {
+	case "base64":
+		return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(body)), ""))
+
+	case "quoted-printable":
+		return io.ReadAll(quotedprintable.NewReader(bytes.NewReader(body)))
+
+	default:
+		return body, nil
+	}
+}
+
+func encodeTransferEncoding(body []byte, cte string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	switch cte {
+	case "base64":
+		encoded := base64.StdEncoding.EncodeToString(body)
+
+		for len(encoded) > 76 {
+			buf.WriteString(encoded[:76] + "\r\n")
+			encoded = encoded[76:]
+		}
+
+		buf.WriteString(encoded + "\r\n")
+
+	case "quoted-printable":
+		w := quotedprintable.NewWriter(&buf)
+
+		if _, err := w.Write(body); err != nil {
+			return nil, err
+		}
+
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+
+	default:
+		buf.Write(body)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// toUTF8 returns the value as is if it's valid UTF-8, or converted from the most likely charset otherwise.
+func toUTF8(value string) string {
+	if utf8.ValidString(value) {
+		return value
+	}
+
+	if converted, err := pmmime.DecodeCharset([]byte(value), ""); err == nil && utf8.Valid(converted) {
+		return string(converted)
+	}
+
+	return strings.ToValidUTF8(value, string(utf8.RuneError))
+}
+
+func isASCII(b []byte) bool {
+	for _, c := range b {
+		if c >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package message
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepairMIME_WellFormed(t *testing.T) {
+	literal := crlf(`From: Sender <sender@pm.me>
+Subject: Hello
+Content-Type: multipart/mixed; boundary="abc"
+
+--abc
+Content-Type: text/plain; charset=utf-8
+
+body
+--abc--
+`)
+
+	repaired, ok := RepairMIME(literal)
+	require.False(t, ok)
+	require.Equal(t, literal, repaired)
+}
+
+func TestRepairMIME_WrongBoundary(t *testing.T) {
+	repaired, ok := RepairMIME(crlf(`Subject: Hello
+Content-Type: multipart/mixed; boundary="abc"
+
+--xyz
+Content-Type: text/plain; charset=utf-8
+
+first
+--xyz
+Content-Type: text/html; charset=utf-8
+
+<p>second</p>
+--xyz--
+`))
+	require.True(t, ok)
+
+	require.Equal(t, []string{"first", "<p>second</p>"}, readParts(t, repaired))
+}
+
+func TestRepairMIME_MissingBoundary(t *testing.T) {
+	repaired, ok := RepairMIME(crlf(`Subject: Hello
+Content-Type: multipart/alternative
+
+--xyz
+Content-Type: text/plain; charset=utf-8
+
+first
+--xyz--
+`))
+	require.True(t, ok)
+
+	require.Equal(t, []string{"first"}, readParts(t, repaired))
+}
+
+func TestRepairMIME_Unterminated(t *testing.T) {
+	repaired, ok := RepairMIME(crlf(`Subject: Hello
+Content-Type: multipart/mixed; boundary="abc"
+
+--abc
+Content-Type: text/plain; charset=utf-8
+
+first
+--abc
+Content-Type: text/plain; charset=utf-8
+
+second
+`))
+	require.True(t, ok)
+
+	require.Equal(t, []string{"first", "second\r\n"}, readParts(t, repaired))
+}
+
+func TestRepairMIME_8BitHeaders(t *testing.T) {
+	repaired, ok := RepairMIME(crlf("From: J\xf6rg <jorg@pm.me>\nSubject: Caf\xe9 \xe0 la carte\nX-Custom: na\xefve\nContent-Type: text/plain; charset=utf-8\n\nbody\n"))
+	require.True(t, ok)
+	require.True(t, isASCII(repaired))
+
+	msg, err := mail.ReadMessage(bytes.NewReader(repaired))
+	require.NoError(t, err)
+
+	from, err := msg.Header.AddressList("From")
+	require.NoError(t, err)
+	require.Equal(t, "Jörg", from[0].Name)
+	require.Equal(t, "jorg@pm.me", from[0].Address)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "Café à la carte", subject)
+
+	custom, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("X-Custom"))
+	require.NoError(t, err)
+	require.Equal(t, "naïve", custom)
+}
+
+func TestRepairMIME_MissingCharset(t *testing.T) {
+	repaired, ok := RepairMIME(crlf(`Subject: Hello
+Content-Type: multipart/mixed; boundary="abc"
+
+--abc
+Content-Type: text/plain
+Content-Transfer-Encoding: quoted-printable
+
+caf=E9
+--abc
+Content-Type: text/plain
+Content-Disposition: attachment; filename="notes.txt"
+Content-Transfer-Encoding: quoted-printable
+
+caf=E9
+--abc--
+`))
+	require.True(t, ok)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(repaired))
+	require.NoError(t, err)
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+
+	// The text part is converted to UTF-8.
+	part, err := reader.NextPart()
+	require.NoError(t, err)
+
+	_, params, err = mime.ParseMediaType(part.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "utf-8", params["charset"])
+
+	body, err := io.ReadAll(part)
+	require.NoError(t, err)
+	require.Equal(t, "café", string(body))
+
+	// Attachments are left untouched.
+	part, err = reader.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "text/plain", part.Header.Get("Content-Type"))
+
+	body, err = io.ReadAll(part)
+	require.NoError(t, err)
+	require.Equal(t, "caf\xe9", string(body))
+}
+
+func TestWriteRepairedRFC822(t *testing.T) {
+	original := crlf(`Subject: Hello
+Content-Type: multipart/mixed; boundary="abc"
+
+--xyz
+Content-Type: text/plain; charset=utf-8
+
+first
+--xyz--
+`)
+
+	repaired, ok := RepairMIME(original)
+	require.True(t, ok)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, writeRepairedRFC822(repaired, original, "repaired", &buf))
+
+	msg, err := mail.ReadMessage(&buf)
+	require.NoError(t, err)
+	require.Equal(t, "Hello", msg.Header.Get("Subject"))
+
+	reader := multipart.NewReader(msg.Body, "repaired")
+
+	// The first part is the repaired content.
+	part, err := reader.NextPart()
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+	require.Equal(t, "xyz", params["boundary"])
+
+	// The second part is the original message.
+	part, err = reader.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, RepairedOriginalName, part.FileName())
+	require.Equal(t, "7bit", part.Header.Get("Content-Transfer-Encoding"))
+
+	mediaType, _, err = mime.ParseMediaType(part.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "message/rfc822", mediaType)
+
+	body, err := io.ReadAll(part)
+	require.NoError(t, err)
+	require.Equal(t, original, body)
+}
+
+func TestBuildRepairedMessage_WellFormed(t *testing.T) {
+	kr := utils.MakeKeyRing(t)
+	msg := newTestMessage(t, kr, "messageID", "addressID", "text/plain", "body", time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+	res, err := DecryptAndBuildRFC822(kr, msg, nil, JobOptions{})
+	require.NoError(t, err)
+
+	repaired, err := DecryptAndBuildRFC822(kr, msg, nil, JobOptions{RepairMIME: true})
+	require.NoError(t, err)
+	require.Equal(t, res, repaired)
+}
+
+// readParts returns the bodies of the parts of the given multipart message.
+func readParts(t *testing.T, literal []byte) []string {
+	msg, err := mail.ReadMessage(bytes.NewReader(literal))
+	require.NoError(t, err)
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+
+	var bodies []string
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+
+		bodies = append(bodies, string(body))
+	}
+
+	return bodies
+}
+
+func crlf(s string) []byte {
+	return []byte(strings.ReplaceAll(s, "\n", "\r\n"))
+}
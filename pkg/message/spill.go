@@ -0,0 +1,239 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package message
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// DefaultSpillLimit is the size above which the data of a SpillBuffer is moved from memory to a temporary file.
+const DefaultSpillLimit = 4 << 20
+
+// spillFilePattern is the name pattern of the temporary files created by SpillBuffers.
+const spillFilePattern = "bridge-attachment-*"
+
+// ErrSpillFailed is returned when the data of a SpillBuffer can't be written to or read from its temporary file.
+var ErrSpillFailed = errors.New("failed to spill data to disk")
+
+// SpillBuffer holds data in memory up to a limit and in a temporary file beyond it,
+// so that large attachments don't need to be kept in memory while a message is built.
+// The temporary file is encrypted with a random key which is only held in memory,
+// so its content can't be recovered once the buffer is gone, even if the file is left behind.
+// The zero value is an empty buffer using DefaultSpillLimit and the system temporary directory.
+// The buffer must be closed to remove its temporary file.
+type SpillBuffer struct {
+	limit int
+	dir   string
+	mem   bytes.Buffer
+	file  *os.File
+	size  int64
+
+	block  cipher.Block
+	iv     []byte
+	stream cipher.Stream
+}
+
+// NewSpillBuffer returns an empty buffer which moves its data to a temporary file in dir once it exceeds limit bytes.
+// If dir is empty, the system temporary directory is used.
+func NewSpillBuffer(limit int, dir string) *SpillBuffer {
+	return &SpillBuffer{limit: limit, dir: dir}
+}
+
+// RemoveSpillFiles removes the temporary files left in dir by SpillBuffers which were never closed,
+// e.g. because the application crashed. It must not be called while SpillBuffers using dir are in use.
+func RemoveSpillFiles(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, spillFilePattern))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Write appends p to the buffer, moving the data to a temporary file if the buffer grows above its limit.
+func (b *SpillBuffer) Write(p []byte) (int, error) {
+	if b.file == nil && b.mem.Len()+len(p) > b.getLimit() {
+		if err := b.spill(); err != nil {
+			return 0, err
+		}
+	}
+
+	var (
+		n   int
+		err error
+	)
+
+	if b.file != nil {
+		n, err = b.writeFile(p)
+	} else {
+		n, err = b.mem.Write(p)
+	}
+
+	b.size += int64(n)
+
+	return n, err
+}
+
+// ReadFrom reads data from r until EOF and appends it to the buffer.
+func (b *SpillBuffer) ReadFrom(r io.Reader) (int64, error) {
+	// Hide our own ReadFrom so that io.Copy uses a bounded intermediate buffer.
+	return io.Copy(struct{ io.Writer }{b}, r)
+}
+
+// Len returns the number of bytes held by the buffer.
+func (b *SpillBuffer) Len() int64 {
+	return b.size
+}
+
+// IsSpilled returns whether the data of the buffer is held in a temporary file.
+func (b *SpillBuffer) IsSpilled() bool {
+	return b.file != nil
+}
+
+// NewReader returns a reader of the data held by the buffer. The buffer must not be written to while it is read.
+func (b *SpillBuffer) NewReader() io.Reader {
+	if b.file != nil {
+		return &spillReader{r: cipher.StreamReader{
+			S: cipher.NewCTR(b.block, b.iv),
+			R: io.NewSectionReader(b.file, 0, b.size),
+		}}
+	}
+
+	return bytes.NewReader(b.mem.Bytes())
+}
+
+// Bytes returns a copy of the data held by the buffer.
+func (b *SpillBuffer) Bytes() ([]byte, error) {
+	if b.file == nil {
+		return b.mem.Bytes(), nil
+	}
+
+	data := make([]byte, b.size)
+
+	if _, err := io.ReadFull(b.NewReader(), data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Close releases the data of the buffer and removes its temporary file, if any.
+func (b *SpillBuffer) Close() error {
+	b.mem = bytes.Buffer{}
+	b.size = 0
+
+	if b.file == nil {
+		return nil
+	}
+
+	file := b.file
+	b.file = nil
+	b.block, b.iv, b.stream = nil, nil, nil
+
+	return errors.Join(file.Close(), os.Remove(file.Name()))
+}
+
+func (b *SpillBuffer) getLimit() int {
+	if b.limit <= 0 {
+		return DefaultSpillLimit
+	}
+
+	return b.limit
+}
+
+// spill moves the data held in memory to a new temporary file encrypted with a new random key.
+func (b *SpillBuffer) spill() error {
+	key := make([]byte, 32)
+
+	if _, err := rand.Read(key); err != nil {
+		return fmt.Errorf("%w: %w", ErrSpillFailed, err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrSpillFailed, err)
+	}
+
+	iv := make([]byte, block.BlockSize())
+
+	if _, err := rand.Read(iv); err != nil {
+		return fmt.Errorf("%w: %w", ErrSpillFailed, err)
+	}
+
+	file, err := os.CreateTemp(b.dir, spillFilePattern)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrSpillFailed, err)
+	}
+
+	b.file = file
+	b.block = block
+	b.iv = iv
+	b.stream = cipher.NewCTR(block, iv)
+
+	if _, err := b.writeFile(b.mem.Bytes()); err != nil {
+		return errors.Join(err, b.Close())
+	}
+
+	b.mem = bytes.Buffer{}
+
+	return nil
+}
+
+// writeFile encrypts p and appends it to the temporary file.
+func (b *SpillBuffer) writeFile(p []byte) (int, error) {
+	enc := make([]byte, len(p))
+
+	b.stream.XORKeyStream(enc, p)
+
+	n, err := b.file.Write(enc)
+	if err != nil {
+		return n, fmt.Errorf("%w: %w", ErrSpillFailed, err)
+	}
+
+	return n, nil
+}
+
+// spillReader marks the errors of reading a temporary file as spill failures.
+type spillReader struct {
+	r io.Reader
+}
+
+func (r *spillReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return n, fmt.Errorf("%w: %w", ErrSpillFailed, err)
+	}
+
+	return n, err
+}
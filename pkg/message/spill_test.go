@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package message
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpillBuffer_InMemory(t *testing.T) {
+	buf := NewSpillBuffer(16, t.TempDir())
+	defer func() { require.NoError(t, buf.Close()) }()
+
+	_, err := buf.Write([]byte("hello world"))
+	require.NoError(t, err)
+
+	require.False(t, buf.IsSpilled())
+	require.Equal(t, int64(11), buf.Len())
+
+	data, err := io.ReadAll(buf.NewReader())
+	require.NoError(t, err)
+	require.Equal(t, "hello world", string(data))
+}
+
+func TestSpillBuffer_Spilled(t *testing.T) {
+	dir := t.TempDir()
+
+	want := bytes.Repeat([]byte("0123456789"), 1000)
+
+	buf := NewSpillBuffer(1024, dir)
+
+	n, err := buf.ReadFrom(bytes.NewReader(want))
+	require.NoError(t, err)
+	require.Equal(t, int64(len(want)), n)
+
+	require.True(t, buf.IsSpilled())
+	require.Equal(t, int64(len(want)), buf.Len())
+
+	// The data can be read several times.
+	for i := 0; i < 2; i++ {
+		data, err := io.ReadAll(buf.NewReader())
+		require.NoError(t, err)
+		require.Equal(t, want, data)
+	}
+
+	data, err := buf.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, want, data)
+
+	// The temporary file doesn't hold the data in the clear.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	onDisk, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	require.NoError(t, err)
+	require.Len(t, onDisk, len(want))
+	require.False(t, bytes.Contains(onDisk, []byte("0123456789")))
+
+	// The temporary file is removed when the buffer is closed.
+	require.NoError(t, buf.Close())
+
+	entries, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestSpillBuffer_SpillFailed(t *testing.T) {
+	buf := NewSpillBuffer(16, filepath.Join(t.TempDir(), "missing"))
+	defer func() { require.NoError(t, buf.Close()) }()
+
+	_, err := buf.Write(bytes.Repeat([]byte("x"), 32))
+	require.ErrorIs(t, err, ErrSpillFailed)
+}
+
+func TestRemoveSpillFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	// A buffer which is never closed leaves its temporary file behind.
+	buf := NewSpillBuffer(16, dir)
+
+	_, err := buf.Write(bytes.Repeat([]byte("x"), 32))
+	require.NoError(t, err)
+	require.True(t, buf.IsSpilled())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other"), []byte("other"), 0o600))
+
+	require.NoError(t, RemoveSpillFiles(dir))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "other", entries[0].Name())
+}
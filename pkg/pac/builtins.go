@@ -0,0 +1,273 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package pac
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+)
+
+// resolveTimeout bounds the DNS lookups made by dnsResolve, isResolvable and isInNet.
+const resolveTimeout = 2 * time.Second
+
+var weekdays = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"} //nolint:gochecknoglobals
+
+// registerBuiltins provides the standard PAC helper functions to the script.
+// The rarely used dateRange is not provided.
+func (pac *PAC) registerBuiltins() error {
+	builtins := map[string]any{
+		"isPlainHostName":     isPlainHostName,
+		"dnsDomainIs":         dnsDomainIs,
+		"localHostOrDomainIs": localHostOrDomainIs,
+		"dnsDomainLevels":     dnsDomainLevels,
+		"shExpMatch":          shExpMatch,
+		"convert_addr":        convertAddr,
+		"isResolvable":        pac.isResolvable,
+		"isInNet":             pac.isInNet,
+		"dnsResolve":          pac.dnsResolve,
+		"myIpAddress":         myIPAddress,
+		"weekdayRange":        pac.weekdayRange,
+		"timeRange":           pac.timeRange,
+	}
+
+	for name, fn := range builtins {
+		if err := pac.vm.Set(name, fn); err != nil {
+			return fmt.Errorf("failed to register %v: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func isPlainHostName(host string) bool {
+	return !strings.Contains(host, ".")
+}
+
+func dnsDomainIs(host, domain string) bool {
+	return strings.HasSuffix(strings.ToLower(host), strings.ToLower(domain))
+}
+
+func localHostOrDomainIs(host, hostDomain string) bool {
+	host, hostDomain = strings.ToLower(host), strings.ToLower(hostDomain)
+
+	if host == hostDomain {
+		return true
+	}
+
+	return !strings.Contains(host, ".") && strings.HasPrefix(hostDomain, host+".")
+}
+
+func dnsDomainLevels(host string) int {
+	return strings.Count(host, ".")
+}
+
+// shExpMatch matches str against a shell expression in which * and ? are the only special characters.
+func shExpMatch(str, shExp string) bool {
+	var pattern strings.Builder
+
+	pattern.WriteString("^")
+
+	for _, r := range shExp {
+		switch r {
+		case '*':
+			pattern.WriteString(".*")
+
+		case '?':
+			pattern.WriteString(".")
+
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	pattern.WriteString("$")
+
+	return regexp.MustCompile(pattern.String()).MatchString(str)
+}
+
+func convertAddr(addr string) uint32 {
+	ip := net.ParseIP(addr).To4()
+	if ip == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint32(ip)
+}
+
+func (pac *PAC) isResolvable(host string) bool {
+	_, err := pac.resolve(host)
+
+	return err == nil
+}
+
+func (pac *PAC) isInNet(host, pattern, mask string) bool {
+	ip, err := pac.resolve(host)
+	if err != nil {
+		return false
+	}
+
+	patternIP, maskIP := net.ParseIP(pattern).To4(), net.ParseIP(mask).To4()
+	if patternIP == nil || maskIP == nil {
+		return false
+	}
+
+	return ip.Mask(net.IPMask(maskIP)).Equal(patternIP.Mask(net.IPMask(maskIP)))
+}
+
+func (pac *PAC) dnsResolve(host string) goja.Value {
+	ip, err := pac.resolve(host)
+	if err != nil {
+		return goja.Null()
+	}
+
+	return pac.vm.ToValue(ip.String())
+}
+
+// myIPAddress returns the address of the interface used to reach the internet.
+// No packet is sent: connecting a UDP socket only selects the route.
+func myIPAddress() string {
+	conn, err := net.Dial("udp4", "198.51.100.1:80")
+	if err != nil {
+		return "127.0.0.1"
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	addr, ok := conn.LocalAddr().(*net.UDPAddr)
+	if !ok {
+		return "127.0.0.1"
+	}
+
+	return addr.IP.String()
+}
+
+// weekdayRange implements weekdayRange(wd1[, wd2][, "GMT"]).
+func (pac *PAC) weekdayRange(call goja.FunctionCall) goja.Value {
+	args, now := pac.splitGMT(call.Arguments)
+
+	if len(args) == 0 || len(args) > 2 {
+		return pac.vm.ToValue(false)
+	}
+
+	from := weekdayIndex(args[0].String())
+	to := from
+
+	if len(args) == 2 {
+		to = weekdayIndex(args[1].String())
+	}
+
+	if from < 0 || to < 0 {
+		return pac.vm.ToValue(false)
+	}
+
+	return pac.vm.ToValue(inRange(from, int(now.Weekday()), to))
+}
+
+// timeRange implements timeRange(hour1[, hour2]), timeRange(hour1, min1, hour2, min2)
+// and timeRange(hour1, min1, sec1, hour2, min2, sec2), each with an optional trailing "GMT".
+func (pac *PAC) timeRange(call goja.FunctionCall) goja.Value {
+	args, now := pac.splitGMT(call.Arguments)
+
+	values := make([]int, len(args))
+
+	for i, arg := range args {
+		values[i] = int(arg.ToInteger())
+	}
+
+	seconds := now.Hour()*3600 + now.Minute()*60 + now.Second()
+
+	switch len(values) {
+	case 1:
+		return pac.vm.ToValue(now.Hour() == values[0])
+
+	case 2:
+		return pac.vm.ToValue(inHalfOpenRange(values[0]*3600, seconds, values[1]*3600))
+
+	case 4:
+		return pac.vm.ToValue(inHalfOpenRange(values[0]*3600+values[1]*60, seconds, values[2]*3600+values[3]*60))
+
+	case 6:
+		from := values[0]*3600 + values[1]*60 + values[2]
+		to := values[3]*3600 + values[4]*60 + values[5]
+
+		return pac.vm.ToValue(inHalfOpenRange(from, seconds, to))
+
+	default:
+		return pac.vm.ToValue(false)
+	}
+}
+
+// splitGMT strips the optional trailing "GMT" argument and returns the current time in the requested zone.
+func (pac *PAC) splitGMT(args []goja.Value) ([]goja.Value, time.Time) {
+	if len(args) > 0 && strings.EqualFold(args[len(args)-1].String(), "GMT") {
+		return args[:len(args)-1], pac.now().UTC()
+	}
+
+	return args, pac.now()
+}
+
+func weekdayIndex(day string) int {
+	for i, weekday := range weekdays {
+		if strings.EqualFold(day, weekday) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// inRange returns whether from <= value <= to, where the range may wrap around.
+func inRange(from, value, to int) bool {
+	if from <= to {
+		return from <= value && value <= to
+	}
+
+	return value >= from || value <= to
+}
+
+// inHalfOpenRange returns whether from <= value < to, where the range may wrap around.
+func inHalfOpenRange(from, value, to int) bool {
+	if from <= to {
+		return from <= value && value < to
+	}
+
+	return value >= from || value < to
+}
+
+func resolveIPv4(host string) (net.IP, error) {
+	if ip := net.ParseIP(host).To4(); ip != nil {
+		return ip, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancel()
+
+	ips, err := net.DefaultResolver.LookupIP(ctx, "ip4", host)
+	if err != nil {
+		return nil, err
+	}
+
+	return ips[0].To4(), nil
+}
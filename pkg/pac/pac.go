@@ -0,0 +1,155 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package pac evaluates proxy auto-config (PAC) scripts.
+package pac
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+)
+
+// evalTimeout bounds the time a single call to FindProxyForURL may take.
+const evalTimeout = 5 * time.Second
+
+var ErrNoFindProxyForURL = errors.New("PAC script does not define FindProxyForURL")
+
+// PAC is a compiled PAC script. It is safe for concurrent use.
+type PAC struct {
+	lock sync.Mutex
+	vm   *goja.Runtime
+	fn   goja.Callable
+
+	// now and resolve are replaced in tests.
+	now     func() time.Time
+	resolve func(host string) (net.IP, error)
+}
+
+// Proxy is one of the entries returned by FindProxyForURL.
+type Proxy struct {
+	// Scheme is http, https or socks5, or empty for a direct connection.
+	Scheme string
+
+	// Host is the host:port of the proxy.
+	Host string
+}
+
+// IsDirect returns whether the entry means connecting without a proxy.
+func (p Proxy) IsDirect() bool {
+	return p.Scheme == ""
+}
+
+// URL returns the URL of the proxy, or nil for a direct connection.
+func (p Proxy) URL() *url.URL {
+	if p.IsDirect() {
+		return nil
+	}
+
+	return &url.URL{Scheme: p.Scheme, Host: p.Host}
+}
+
+// Parse compiles the given PAC script.
+func Parse(script string) (*PAC, error) {
+	pac := &PAC{
+		vm:      goja.New(),
+		now:     time.Now,
+		resolve: resolveIPv4,
+	}
+
+	if err := pac.registerBuiltins(); err != nil {
+		return nil, err
+	}
+
+	if _, err := pac.vm.RunString(script); err != nil {
+		return nil, fmt.Errorf("failed to run PAC script: %w", err)
+	}
+
+	fn, ok := goja.AssertFunction(pac.vm.Get("FindProxyForURL"))
+	if !ok {
+		return nil, ErrNoFindProxyForURL
+	}
+
+	pac.fn = fn
+
+	return pac, nil
+}
+
+// FindProxyForURL returns the proxies to try, in order, when requesting the given URL.
+func (pac *PAC) FindProxyForURL(u *url.URL) ([]Proxy, error) {
+	pac.lock.Lock()
+	defer pac.lock.Unlock()
+
+	timer := time.AfterFunc(evalTimeout, func() {
+		pac.vm.Interrupt("timeout")
+	})
+	defer timer.Stop()
+
+	defer pac.vm.ClearInterrupt()
+
+	res, err := pac.fn(goja.Undefined(), pac.vm.ToValue(u.String()), pac.vm.ToValue(u.Hostname()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate PAC script: %w", err)
+	}
+
+	return ParseResult(res.String())
+}
+
+// ParseResult parses the return value of FindProxyForURL, e.g. "PROXY proxy:8080; DIRECT".
+// Entries of unsupported types are skipped.
+func ParseResult(result string) ([]Proxy, error) {
+	var proxies []Proxy
+
+	for _, entry := range strings.Split(result, ";") {
+		fields := strings.Fields(entry)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch kind := strings.ToUpper(fields[0]); kind {
+		case "DIRECT":
+			proxies = append(proxies, Proxy{})
+
+		case "PROXY", "HTTP", "HTTPS", "SOCKS", "SOCKS5":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("invalid PAC entry %q", entry)
+			}
+
+			proxies = append(proxies, Proxy{Scheme: schemes[kind], Host: fields[1]})
+		}
+	}
+
+	if len(proxies) == 0 {
+		return nil, fmt.Errorf("no usable entry in PAC result %q", result)
+	}
+
+	return proxies, nil
+}
+
+var schemes = map[string]string{ //nolint:gochecknoglobals
+	"PROXY":  "http",
+	"HTTP":   "http",
+	"HTTPS":  "https",
+	"SOCKS":  "socks5",
+	"SOCKS5": "socks5",
+}
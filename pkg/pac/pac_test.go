@@ -0,0 +1,145 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package pac
+
+import (
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testScript = `
+function FindProxyForURL(url, host) {
+	if (isPlainHostName(host) || dnsDomainIs(host, ".intranet.example")) {
+		return "DIRECT";
+	}
+
+	if (isInNet(host, "10.0.0.0", "255.0.0.0")) {
+		return "SOCKS5 socks.example:1080";
+	}
+
+	if (shExpMatch(url, "https://*.proton.me/*") && weekdayRange("MON", "FRI")) {
+		return "PROXY office.example:3128; DIRECT";
+	}
+
+	return "PROXY fallback.example:8080";
+}
+`
+
+func newTestPAC(t *testing.T, script string) *PAC {
+	pac, err := Parse(script)
+	require.NoError(t, err)
+
+	// Wednesday.
+	pac.now = func() time.Time { return time.Date(2024, time.January, 3, 10, 30, 0, 0, time.UTC) }
+
+	pac.resolve = func(host string) (net.IP, error) {
+		if ip := net.ParseIP(host); ip != nil {
+			return ip.To4(), nil
+		}
+
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+
+	return pac
+}
+
+func TestPAC_FindProxyForURL(t *testing.T) {
+	pac := newTestPAC(t, testScript)
+
+	tests := []struct {
+		url  string
+		want []Proxy
+	}{
+		{url: "https://server/", want: []Proxy{{}}},
+		{url: "https://mail.intranet.example/", want: []Proxy{{}}},
+		{url: "https://10.1.2.3/", want: []Proxy{{Scheme: "socks5", Host: "socks.example:1080"}}},
+		{url: "https://mail-api.proton.me/tests/ping", want: []Proxy{{Scheme: "http", Host: "office.example:3128"}, {}}},
+		{url: "https://example.com/", want: []Proxy{{Scheme: "http", Host: "fallback.example:8080"}}},
+	}
+
+	for _, test := range tests {
+		u, err := url.Parse(test.url)
+		require.NoError(t, err)
+
+		proxies, err := pac.FindProxyForURL(u)
+		require.NoError(t, err)
+		require.Equal(t, test.want, proxies, test.url)
+	}
+}
+
+func TestPAC_Invalid(t *testing.T) {
+	_, err := Parse("function FindProxyForURL(url, host) {")
+	require.Error(t, err)
+
+	_, err = Parse("var x = 1;")
+	require.ErrorIs(t, err, ErrNoFindProxyForURL)
+}
+
+func TestPAC_Timeout(t *testing.T) {
+	pac := newTestPAC(t, `function FindProxyForURL(url, host) { for (;;) {} }`)
+
+	_, err := pac.FindProxyForURL(&url.URL{Scheme: "https", Host: "example.com"})
+	require.Error(t, err)
+}
+
+func TestPAC_TimeRange(t *testing.T) {
+	pac := newTestPAC(t, `function FindProxyForURL(url, host) {
+		if (timeRange(9, 17) && timeRange(10, 0, 11, 0) && !timeRange(10, 31, 0, 23, 0, 0) && !weekdayRange("SAT", "SUN")) {
+			return "HTTPS proxy.example:443";
+		}
+
+		return "DIRECT";
+	}`)
+
+	proxies, err := pac.FindProxyForURL(&url.URL{Scheme: "https", Host: "example.com"})
+	require.NoError(t, err)
+	require.Equal(t, []Proxy{{Scheme: "https", Host: "proxy.example:443"}}, proxies)
+	require.Equal(t, "https://proxy.example:443", proxies[0].URL().String())
+}
+
+func TestParseResult(t *testing.T) {
+	proxies, err := ParseResult("PROXY a:1; SOCKS4 b:2;  DIRECT ;")
+	require.NoError(t, err)
+	require.Equal(t, []Proxy{{Scheme: "http", Host: "a:1"}, {}}, proxies)
+	require.True(t, proxies[1].IsDirect())
+	require.Nil(t, proxies[1].URL())
+
+	_, err = ParseResult("SOCKS4 b:2")
+	require.Error(t, err)
+
+	_, err = ParseResult("PROXY")
+	require.Error(t, err)
+}
+
+func TestShExpMatch(t *testing.T) {
+	require.True(t, shExpMatch("http://home.netscape.com/people/ari/index.html", "*/ari/*"))
+	require.False(t, shExpMatch("http://home.netscape.com/people/montulli/index.html", "*/ari/*"))
+	require.True(t, shExpMatch("a.b", "?.b"))
+	require.False(t, shExpMatch("axb", "a.b"))
+}
+
+func TestLocalHostOrDomainIs(t *testing.T) {
+	require.True(t, localHostOrDomainIs("www.netscape.com", "www.netscape.com"))
+	require.True(t, localHostOrDomainIs("www", "www.netscape.com"))
+	require.False(t, localHostOrDomainIs("www.mcom.com", "www.netscape.com"))
+	require.False(t, localHostOrDomainIs("home.netscape.com", "www.netscape.com"))
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package sdnotify implements the systemd service notification protocol (sd_notify).
+package sdnotify
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// Ready tells systemd that the service has finished starting up.
+	Ready = "READY=1"
+
+	// Stopping tells systemd that the service is beginning its shutdown.
+	Stopping = "STOPPING=1"
+
+	// Watchdog keeps the service alive when the systemd watchdog is enabled.
+	Watchdog = "WATCHDOG=1"
+)
+
+// Notify sends the given state to systemd.
+// It returns false, with no error, if the process was not started by systemd with notification support.
+func Notify(state string) (bool, error) {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return false, nil
+	}
+
+	// A leading @ denotes a socket in the abstract namespace.
+	if socket[0] == '@' {
+		socket = "\x00" + socket[1:]
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return false, fmt.Errorf("failed to connect to notify socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if _, err := conn.Write([]byte(state)); err != nil {
+		return false, fmt.Errorf("failed to write to notify socket: %w", err)
+	}
+
+	return true, nil
+}
+
+// WatchdogInterval returns the interval within which systemd expects a Watchdog notification.
+// It returns zero if the watchdog is not enabled for this process.
+func WatchdogInterval() (time.Duration, error) {
+	usecStr := os.Getenv("WATCHDOG_USEC")
+	if usecStr == "" {
+		return 0, nil
+	}
+
+	usec, err := strconv.ParseInt(usecStr, 10, 64)
+	if err != nil || usec <= 0 {
+		return 0, fmt.Errorf("invalid WATCHDOG_USEC %q", usecStr)
+	}
+
+	// The watchdog may be meant for another process. We accept it if it is meant for our parent,
+	// which is the case when we are started by a launcher that systemd is supervising
+	// (the unit then needs NotifyAccess=all).
+	if pidStr := os.Getenv("WATCHDOG_PID"); pidStr != "" {
+		pid, err := strconv.Atoi(pidStr)
+		if err != nil {
+			return 0, fmt.Errorf("invalid WATCHDOG_PID %q", pidStr)
+		}
+
+		if pid != os.Getpid() && pid != os.Getppid() {
+			return 0, nil
+		}
+	}
+
+	return time.Duration(usec) * time.Microsecond, nil
+}
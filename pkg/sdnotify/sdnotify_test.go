@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sdnotify
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotify_NoSocket(t *testing.T) {
+	t.Setenv("NOTIFY_SOCKET", "")
+
+	ok, err := Notify(Ready)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestNotify(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "notify.sock")
+
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	require.NoError(t, err)
+	defer conn.Close() //nolint:errcheck
+
+	t.Setenv("NOTIFY_SOCKET", socket)
+
+	ok, err := Notify(Ready)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	buf := make([]byte, 64)
+
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, Ready, string(buf[:n]))
+}
+
+func TestWatchdogInterval(t *testing.T) {
+	t.Setenv("WATCHDOG_USEC", "")
+	t.Setenv("WATCHDOG_PID", "")
+
+	interval, err := WatchdogInterval()
+	require.NoError(t, err)
+	require.Zero(t, interval)
+
+	t.Setenv("WATCHDOG_USEC", "30000000")
+
+	interval, err = WatchdogInterval()
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, interval)
+
+	// The watchdog is meant for our parent, e.g. the launcher.
+	t.Setenv("WATCHDOG_PID", strconv.Itoa(os.Getppid()))
+
+	interval, err = WatchdogInterval()
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, interval)
+
+	// The watchdog is meant for another process.
+	t.Setenv("WATCHDOG_PID", "-1")
+
+	interval, err = WatchdogInterval()
+	require.NoError(t, err)
+	require.Zero(t, interval)
+
+	t.Setenv("WATCHDOG_USEC", "forever")
+
+	_, err = WatchdogInterval()
+	require.Error(t, err)
+}
@@ -20,6 +20,7 @@ package tests
 import (
 	"context"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -28,11 +29,15 @@ import (
 
 type scenario struct {
 	t *testCtx
+
+	// isolatePorts is set when the scenario runs in parallel with others.
+	isolatePorts bool
 }
 
 // reset resets the test context for a new scenario.
 func (s *scenario) reset(tb testing.TB) {
 	s.t = newTestCtx(tb)
+	s.t.isolatePorts = s.isolatePorts
 }
 
 // replace replaces the placeholders in the scenario with the values from the test context.
@@ -62,8 +67,19 @@ func (s *scenario) close(_ testing.TB) {
 }
 
 func TestFeatures(testingT *testing.T) {
-	var s scenario
+	concurrency := getFeatureConcurrency()
+
+	if concurrency <= 1 {
+		runFeatures(testingT, getFeatureTags(), 1)
+		return
+	}
 
+	// Scenarios tagged @serial rely on fixed ports; they run on their own once the others are done.
+	runFeatures(testingT, andTags(getFeatureTags(), "~@serial"), concurrency)
+	runFeatures(testingT, andTags(getFeatureTags(), "@serial"), 1)
+}
+
+func runFeatures(testingT *testing.T, tags string, concurrency int) {
 	suite := godog.TestSuite{
 		TestSuiteInitializer: func(ctx *godog.TestSuiteContext) {
 			ctx.BeforeSuite(func() {
@@ -75,7 +91,10 @@ func TestFeatures(testingT *testing.T) {
 			})
 		},
 
+		// The scenario initializer is called for each scenario, so each one gets its own test context.
 		ScenarioInitializer: func(ctx *godog.ScenarioContext) {
+			s := &scenario{isolatePorts: concurrency > 1}
+
 			ctx.Before(func(ctx context.Context, sc *godog.Scenario) (context.Context, error) {
 				s.reset(testingT)
 				s.replace(sc)
@@ -100,10 +119,11 @@ func TestFeatures(testingT *testing.T) {
 			s.steps(ctx)
 		},
 		Options: &godog.Options{
-			Format:   "pretty",
-			Paths:    getFeaturePaths(),
-			TestingT: testingT,
-			Tags:     getFeatureTags(),
+			Format:      "pretty",
+			Paths:       getFeaturePaths(),
+			TestingT:    testingT,
+			Tags:        tags,
+			Concurrency: concurrency,
 		},
 	}
 
@@ -112,6 +132,24 @@ func TestFeatures(testingT *testing.T) {
 	}
 }
 
+// getFeatureConcurrency returns the number of scenarios to run in parallel, set with FEATURE_TEST_CONCURRENCY.
+func getFeatureConcurrency() int {
+	concurrency, err := strconv.Atoi(os.Getenv("FEATURE_TEST_CONCURRENCY"))
+	if err != nil || concurrency < 1 {
+		return 1
+	}
+
+	return concurrency
+}
+
+func andTags(tags, other string) string {
+	if tags == "" {
+		return other
+	}
+
+	return tags + " && " + other
+}
+
 func getFeaturePaths() []string {
 	var paths []string
 
@@ -442,6 +442,14 @@ func (s *scenario) theUserShowsAllMail() error {
 	return s.t.bridge.SetShowAllMail(true)
 }
 
+func (s *scenario) theUserHidesAllMailForUser(user string) error {
+	return s.t.bridge.SetUserHideAllMail(s.t.getUserByName(user).getUserID(), true)
+}
+
+func (s *scenario) theUserShowsAllMailForUser(user string) error {
+	return s.t.bridge.SetUserHideAllMail(s.t.getUserByName(user).getUserID(), false)
+}
+
 func (s *scenario) theUserDisablesTelemetryInBridgeSettings() error {
 	return s.t.bridge.SetTelemetryDisabled(true)
 }
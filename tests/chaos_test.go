@@ -0,0 +1,223 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// chaosFault is a failure injected by the chaos mode into a request made to the API.
+type chaosFault int
+
+const (
+	// chaosNone lets the request through.
+	chaosNone chaosFault = iota
+
+	// chaosServerError fails the request with a 500 without it reaching the API.
+	chaosServerError
+
+	// chaosRateLimited fails the request with a 429 without it reaching the API.
+	chaosRateLimited
+
+	// chaosTimeout fails the request with a timeout without it reaching the API.
+	chaosTimeout
+
+	// chaosLostResponse lets the request reach the API but replaces its response by a 500,
+	// so that bridge can't know whether the request was applied.
+	chaosLostResponse
+
+	// chaosDisconnect lets the request reach the API but cuts the connection halfway through the response body.
+	chaosDisconnect
+
+	chaosFaultCount
+)
+
+// chaosTimeoutDelay is how long a request failing with chaosTimeout hangs before failing.
+const chaosTimeoutDelay = 2 * time.Second
+
+// apiChaos makes a fraction of the requests made by bridge to the API fail in random ways.
+type apiChaos struct {
+	failRate float64
+	rand     *rand.Rand
+	lock     sync.Mutex
+}
+
+func newAPIChaos() *apiChaos {
+	seed := time.Now().UnixNano()
+
+	if env, err := strconv.ParseInt(os.Getenv("FEATURE_TEST_CHAOS_SEED"), 10, 64); err == nil {
+		seed = env
+	}
+
+	return &apiChaos{rand: rand.New(rand.NewSource(seed))} //nolint:gosec
+}
+
+func (c *apiChaos) setFailRate(rate float64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.failRate = rate
+}
+
+// next returns the fault to inject into the next request.
+func (c *apiChaos) next() chaosFault {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.failRate == 0 || c.rand.Float64() >= c.failRate {
+		return chaosNone
+	}
+
+	return chaosFault(1 + c.rand.Intn(int(chaosFaultCount)-1))
+}
+
+// roundTrip performs the request with the given round tripper, injecting a fault if needed.
+func (c *apiChaos) roundTrip(rt http.RoundTripper, req *http.Request) (*http.Response, error) {
+	fault := c.next()
+
+	if fault != chaosNone {
+		logrus.WithField("fault", fault).Debugf("Injecting chaos into %v %v", req.Method, req.URL.Path)
+	}
+
+	switch fault {
+	case chaosServerError:
+		return newChaosResponse(req, http.StatusInternalServerError), nil
+
+	case chaosRateLimited:
+		res := newChaosResponse(req, http.StatusTooManyRequests)
+		res.Header.Set("Retry-After", "1")
+
+		return res, nil
+
+	case chaosTimeout:
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+
+		case <-time.After(chaosTimeoutDelay):
+			return nil, fmt.Errorf("chaos: %w", os.ErrDeadlineExceeded)
+		}
+
+	case chaosLostResponse:
+		res, err := rt.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		_ = res.Body.Close()
+
+		return newChaosResponse(req, http.StatusInternalServerError), nil
+
+	case chaosDisconnect:
+		res, err := rt.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		_ = res.Body.Close()
+
+		res.Body = io.NopCloser(io.MultiReader(
+			bytes.NewReader(body[:len(body)/2]),
+			&failingReader{err: io.ErrUnexpectedEOF},
+		))
+
+		return res, nil
+
+	default:
+		return rt.RoundTrip(req)
+	}
+}
+
+func (fault chaosFault) String() string {
+	switch fault {
+	case chaosServerError:
+		return "server error"
+
+	case chaosRateLimited:
+		return "rate limited"
+
+	case chaosTimeout:
+		return "timeout"
+
+	case chaosLostResponse:
+		return "lost response"
+
+	case chaosDisconnect:
+		return "disconnect"
+
+	default:
+		return "none"
+	}
+}
+
+// newChaosResponse returns an API error response with the given status.
+func newChaosResponse(req *http.Request, status int) *http.Response {
+	body := fmt.Sprintf(`{"Code":%d,"Error":"Injected by chaos mode"}`, status)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader([]byte(body))),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// failingReader always fails with the given error.
+type failingReader struct {
+	err error
+}
+
+func (r *failingReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+func (s *scenario) theAPIIsFlakyWithOfRequestsFailing(percent int) error {
+	if percent < 0 || percent > 100 {
+		return fmt.Errorf("invalid failure rate %d%%", percent)
+	}
+
+	s.t.chaos.setFailRate(float64(percent) / 100)
+
+	return nil
+}
+
+func (s *scenario) theAPIIsNoLongerFlaky() error {
+	s.t.chaos.setFailRate(0)
+
+	return nil
+}
@@ -113,9 +113,11 @@ func (c *eventCollector) getEventCh(ofType events.Event) <-chan events.Event {
 }
 
 func (c *eventCollector) close() {
+	// Wait for the forwarding goroutines before taking the lock, as they need it to push their last events.
+	c.wg.Wait()
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	c.wg.Wait()
 
 	for _, eventCh := range c.events {
 		eventCh.CloseAndDiscardQueued()
@@ -28,6 +28,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/ProtonMail/gluon/async"
@@ -42,6 +43,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -119,6 +121,17 @@ func (t *testCtx) initBridge() (<-chan events.Event, error) {
 	}
 	t.vault = vault
 
+	// Bridge would pick the same default ports in scenarios running in parallel.
+	if t.isolatePorts && vault.GetFirstStart() {
+		if err := vault.SetIMAPPort(allocatePort()); err != nil {
+			return nil, fmt.Errorf("could not set IMAP port: %w", err)
+		}
+
+		if err := vault.SetSMTPPort(allocatePort()); err != nil {
+			return nil, fmt.Errorf("could not set SMTP port: %w", err)
+		}
+	}
+
 	// Create the underlying cookie jar.
 	jar, err := cookiejar.New(nil)
 	if err != nil {
@@ -178,7 +191,7 @@ func (t *testCtx) initBridge() (<-chan events.Event, error) {
 		persister,
 		useragent.New(),
 		t.mocks.TLSReporter,
-		rt,
+		&faultyRoundTripper{rt: rt, faults: t.faults, chaos: t.chaos},
 		t.mocks.ProxyCtl,
 		t.mocks.CrashHandler,
 		t.reporter,
@@ -200,6 +213,24 @@ func (t *testCtx) initBridge() (<-chan events.Event, error) {
 	return t.events.collectFrom(eventCh), nil
 }
 
+// nextPort is the port from which allocatePort looks for a free port.
+var (
+	nextPort     = 20000
+	nextPortLock sync.Mutex
+)
+
+// allocatePort returns a free port that wasn't returned before during this run of the tests.
+func allocatePort() int {
+	nextPortLock.Lock()
+	defer nextPortLock.Unlock()
+
+	port := ports.FindFreePortFrom(nextPort)
+
+	nextPort = port + 1
+
+	return port
+}
+
 func (t *testCtx) closeBridge(ctx context.Context) error {
 	if t.bridge == nil {
 		return fmt.Errorf("bridge is not started")
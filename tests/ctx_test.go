@@ -121,6 +121,8 @@ type testCtx struct {
 	dir       string
 	api       API
 	netCtl    *proton.NetCtl
+	faults    *netFaults
+	chaos     *apiChaos
 	locator   *locations.Locations
 	storeKey  []byte
 	version   *semver.Version
@@ -152,10 +154,16 @@ type testCtx struct {
 	imapClients map[string]*imapClient
 	smtpClients map[string]*smtpClient
 
+	// snapshots holds the mailbox snapshots taken during the test.
+	snapshots map[string]mailboxSnapshot
+
 	// calls holds calls made to the API during each step of the test.
 	calls     [][]server.Call
 	callsLock sync.RWMutex
 
+	// recordFrom is the index of the first step whose calls are recorded, or -1 if calls are not recorded.
+	recordFrom int
+
 	// errors holds test-related errors encountered while running test steps.
 	errors     [][]error
 	errorsLock sync.RWMutex
@@ -166,6 +174,9 @@ type testCtx struct {
 	imapServerStarted bool
 	smtpServerStarted bool
 
+	// isolatePorts is set when bridge must not use the default ports because other scenarios run in parallel.
+	isolatePorts bool
+
 	rt *http.RoundTripper
 }
 
@@ -186,6 +197,8 @@ func newTestCtx(tb testing.TB) *testCtx {
 		dir:       dir,
 		api:       newTestAPI(),
 		netCtl:    proton.NewNetCtl(),
+		faults:    &netFaults{},
+		chaos:     newAPIChaos(),
 		locator:   locations.New(bridge.NewTestLocationsProvider(dir), "config-name"),
 		storeKey:  []byte("super-secret-store-key"),
 		version:   defaultVersion,
@@ -200,7 +213,10 @@ func newTestCtx(tb testing.TB) *testCtx {
 		addrUUIDByName: make(map[string]string),
 
 		imapClients: make(map[string]*imapClient),
+		snapshots:   make(map[string]mailboxSnapshot),
 		smtpClients: make(map[string]*smtpClient),
+
+		recordFrom: -1,
 	}
 
 	t.api.AddCallWatcher(func(call server.Call) {
@@ -347,6 +363,31 @@ func (t *testCtx) getMBoxID(userID string, name string) string {
 	return labelID
 }
 
+// getMessageMetadata returns the metadata of the messages the API has in the given mailbox of the given user.
+func (t *testCtx) getMessageMetadata(username, mailbox string) ([]proton.MessageMetadata, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mboxID := t.getMBoxID(t.getUserByName(username).getUserID(), mailbox)
+
+	var messages []proton.MessageMetadata
+
+	if err := t.withClient(ctx, username, func(ctx context.Context, client *proton.Client) error {
+		metadata, err := client.GetMessageMetadata(ctx, proton.MessageFilter{LabelID: mboxID})
+		if err != nil {
+			return fmt.Errorf("failed to get message metadata: %w", err)
+		}
+
+		messages = metadata
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
 // getDraftID will return the API ID of draft message with draftIndex, where
 // draftIndex is similar to sequential ID i.e. 1 represents the first message
 // of draft folder sorted by API creation time.
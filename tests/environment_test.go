@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
@@ -79,6 +80,38 @@ func (s *scenario) internetIsTurnedOn() error {
 	return nil
 }
 
+func (s *scenario) theNetworkDropsEveryNthRead(n int) error {
+	if n < 1 {
+		return fmt.Errorf("invalid read interval %d", n)
+	}
+
+	s.t.faults.setDropEvery(n)
+
+	return nil
+}
+
+func (s *scenario) theNetworkLatencyIs(ms int) error {
+	s.t.faults.setLatency(time.Duration(ms) * time.Millisecond)
+
+	return nil
+}
+
+func (s *scenario) theNetworkBandwidthIsLimitedTo(kbps int) error {
+	if kbps < 1 {
+		return fmt.Errorf("invalid bandwidth %d KB/s", kbps)
+	}
+
+	s.t.faults.setBandwidth(kbps * 1024)
+
+	return nil
+}
+
+func (s *scenario) theNetworkIsRestored() error {
+	s.t.faults.reset()
+
+	return nil
+}
+
 func (s *scenario) theUserAgentIs(userAgent string) error {
 	return eventually(func() error {
 		if haveUserAgent := s.t.bridge.GetCurrentUserAgent(); haveUserAgent != userAgent {
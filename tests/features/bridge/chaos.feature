@@ -0,0 +1,50 @@
+Feature: Bridge keeps a consistent state when the API is flaky
+  Background:
+    Given there exists an account with username "[user:user]" and password "password"
+    And there exists an account with username "[user:to]" and password "password"
+    And the address "[user:user]@[domain]" of account "[user:user]" has 20 messages in "Inbox"
+    Then it succeeds
+    When bridge starts
+    Then it succeeds
+
+  Scenario: Sync completes when the API is flaky
+    Given the user logs in with username "[user:user]" and password "password"
+    And the API is flaky with 20% of requests failing
+    When user "[user:user]" finishes syncing
+    And the API is no longer flaky
+    And user "[user:user]" connects and authenticates IMAP client "1"
+    Then IMAP client "1" eventually sees 20 messages in "INBOX"
+    When bridge restarts
+    And user "[user:user]" connects and authenticates IMAP client "2"
+    Then IMAP client "2" eventually sees 20 messages in "INBOX"
+
+  Scenario: Messages are never sent twice when the API is flaky
+    Given the user logs in with username "[user:user]" and password "password"
+    And user "[user:user]" finishes syncing
+    And user "[user:user]" connects and authenticates SMTP client "1"
+    And the API is flaky with 30% of requests failing
+    When SMTP client "1" sends the following message from "[user:user]@[domain]" to "[user:to]@[domain]" until it succeeds:
+      """
+      From: Bridge Test <[user:user]@[domain]>
+      To: Internal Bridge <[user:to]@[domain]>
+      Subject: Chaos one
+
+      hello
+
+      """
+    And SMTP client "1" sends the following message from "[user:user]@[domain]" to "[user:to]@[domain]" until it succeeds:
+      """
+      From: Bridge Test <[user:user]@[domain]>
+      To: Internal Bridge <[user:to]@[domain]>
+      Subject: Chaos two
+
+      hello
+
+      """
+    And the API is no longer flaky
+    Then the account "[user:user]" eventually has exactly one message with subject "Chaos one" in "Sent"
+    And the account "[user:user]" eventually has exactly one message with subject "Chaos two" in "Sent"
+    And the account "[user:to]" eventually has exactly one message with subject "Chaos one" in "Inbox"
+    And the account "[user:to]" eventually has exactly one message with subject "Chaos two" in "Inbox"
+    And the account "[user:user]" has no duplicate messages in "Sent"
+    And the account "[user:to]" has no duplicate messages in "Inbox"
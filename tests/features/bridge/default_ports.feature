@@ -1,3 +1,4 @@
+@serial
 Feature: Bridge picks default ports wisely
 
   Scenario: bridge picks ports for IMAP and SMTP using default values.
@@ -1,3 +1,4 @@
+@serial
 Feature: Send Telemetry Heartbeat
   Background:
     Given there exists an account with username "[user:user1]" and password "password"
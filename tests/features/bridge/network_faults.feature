@@ -0,0 +1,47 @@
+Feature: Bridge is resilient to a degraded network
+  Background:
+    Given there exists an account with username "[user:user]" and password "password"
+    And there exists an account with username "[user:to]" and password "password"
+    And the address "[user:user]@[domain]" of account "[user:user]" has 20 messages in "Inbox"
+    Then it succeeds
+    When bridge starts
+    Then it succeeds
+
+  Scenario: Sync resumes when the network drops reads
+    Given the user logs in with username "[user:user]" and password "password"
+    When the network drops every 7th read
+    And user "[user:user]" finishes syncing
+    And the network is restored
+    And user "[user:user]" connects and authenticates IMAP client "1"
+    Then IMAP client "1" eventually sees 20 messages in "INBOX"
+
+  Scenario: Sync completes on a slow network
+    Given the network latency is 200ms
+    And the network bandwidth is limited to 64 KB/s
+    When the user logs in with username "[user:user]" and password "password"
+    Then bridge sends sync started and finished events for user "[user:user]"
+    When the network is restored
+    And user "[user:user]" connects and authenticates IMAP client "1"
+    Then IMAP client "1" eventually sees 20 messages in "INBOX"
+
+  Scenario: Messages are sent on a slow network
+    Given the user logs in with username "[user:user]" and password "password"
+    And user "[user:user]" finishes syncing
+    And user "[user:user]" connects and authenticates SMTP client "1"
+    When the network latency is 500ms
+    And the network bandwidth is limited to 16 KB/s
+    And SMTP client "1" sends the following message from "[user:user]@[domain]" to "[user:to]@[domain]":
+      """
+      From: Bridge Test <[user:user]@[domain]>
+      To: Internal Bridge <[user:to]@[domain]>
+      Subject: Degraded network
+
+      hello
+
+      """
+    Then it succeeds
+    When the network is restored
+    And user "[user:user]" connects and authenticates IMAP client "1"
+    Then IMAP client "1" eventually sees the following messages in "Sent":
+      | from                 | to                 | subject          |
+      | [user:user]@[domain] | [user:to]@[domain] | Degraded network |
@@ -18,6 +18,12 @@ Feature: IMAP create mailbox
     When IMAP client "1" creates "Folders/mbox"
     Then IMAP client "1" sees "Folders/mbox"
 
+  Scenario: Creating a folder makes a single API call
+    Given API calls are recorded
+    When IMAP client "1" creates "Folders/mbox"
+    Then it succeeds
+    And the recorded API calls match the golden file "imap_create_folder"
+
   Scenario: Create label
     When IMAP client "1" creates "Labels/mbox"
     Then IMAP client "1" sees "Labels/mbox"
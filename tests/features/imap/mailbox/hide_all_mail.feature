@@ -46,3 +46,42 @@ Feature: IMAP Hide All Mail
       | All Mail |
       | Folders  |
       | Labels   |
+
+  Scenario: Hide All Mail Mailbox for a single user
+    When the user hides All Mail for user "[user:user]"
+    Then IMAP client "1" eventually sees the following mailbox info:
+      | name    |
+      | INBOX   |
+      | Drafts  |
+      | Sent    |
+      | Starred |
+      | Archive |
+      | Spam    |
+      | Trash   |
+      | Folders |
+      | Labels  |
+    When the user shows All Mail
+    Then IMAP client "1" eventually sees the following mailbox info:
+      | name    |
+      | INBOX   |
+      | Drafts  |
+      | Sent    |
+      | Starred |
+      | Archive |
+      | Spam    |
+      | Trash   |
+      | Folders |
+      | Labels  |
+    When the user shows All Mail for user "[user:user]"
+    Then IMAP client "1" eventually sees the following mailbox info:
+      | name     |
+      | INBOX    |
+      | Drafts   |
+      | Sent     |
+      | Starred  |
+      | Archive  |
+      | Spam     |
+      | Trash    |
+      | All Mail |
+      | Folders  |
+      | Labels   |
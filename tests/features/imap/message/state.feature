@@ -57,3 +57,26 @@ Feature: IMAP change state of message in mailbox
     When IMAP client "1" marks all messages as "unread"
     And it succeeds
     Then IMAP client "1" eventually sees that all the messages do not have the flag "\Seen"
+
+  Scenario: Only the changed messages differ from a snapshot
+    Given IMAP client "1" takes a snapshot "before" of "INBOX"
+    When IMAP client "1" selects "INBOX"
+    And IMAP client "1" marks the message with subject "one" as "read"
+    And IMAP client "1" marks the message with subject "two" as "starred"
+    And it succeeds
+    Then IMAP client "1" eventually sees snapshot "before" with the following changes:
+      | subject | change  | flag     |
+      | one     | flagged | \Seen    |
+      | two     | flagged | \Flagged |
+
+  Scenario: A moved message is removed from the snapshot of its origin
+    Given IMAP client "1" takes a snapshot "inbox" of "INBOX"
+    And IMAP client "1" takes a snapshot "two" of "Folders/two"
+    When IMAP client "1" moves the message with subject "one" from "INBOX" to "Folders/two"
+    And it succeeds
+    Then IMAP client "1" eventually sees snapshot "inbox" with the following changes:
+      | subject | change  |
+      | one     | removed |
+    And IMAP client "1" eventually sees snapshot "two" with the following changes:
+      | subject | change  |
+      | one     | added   |
@@ -1,3 +1,4 @@
+@serial
 Feature: A user can connect an IMAP client to custom ports
   Background:
     Given there exists an account with username "[user:user]" and password "password"
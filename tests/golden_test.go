@@ -0,0 +1,280 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/cucumber/godog"
+	"golang.org/x/exp/slices"
+)
+
+// goldenDir is the directory holding the golden files of recorded API calls.
+const goldenDir = "testdata/golden"
+
+// updateGolden makes the steps matching recorded API calls write their golden file instead.
+var updateGolden = flag.Bool("update", false, "write the golden files of recorded API calls") //nolint:gochecknoglobals
+
+// recordedCall is the normalized form of an API call stored in a golden file.
+// IDs in the path are replaced by a placeholder and the body is reduced to its shape.
+type recordedCall struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Body   any    `json:"body,omitempty"`
+}
+
+// ignoredCallPaths are the paths of calls made in the background, whose number depends on timing.
+var ignoredCallPaths = []*regexp.Regexp{
+	regexp.MustCompile(`^/core/v4/events`),
+	regexp.MustCompile(`^/tests/ping$`),
+	regexp.MustCompile(`^/data/v1/`),
+}
+
+// idSegment matches path segments holding an API ID.
+var idSegment = regexp.MustCompile(`^[A-Za-z0-9_-]{20,}={0,2}$`)
+
+// startRecordingCalls marks the start of the sequence of recorded API calls.
+func (t *testCtx) startRecordingCalls() {
+	t.callsLock.Lock()
+	defer t.callsLock.Unlock()
+
+	// The calls of the current step are not recorded.
+	t.recordFrom = len(t.calls)
+}
+
+// getRecordedCalls returns the normalized API calls made since recording started, in a stable order.
+func (t *testCtx) getRecordedCalls() ([]recordedCall, error) {
+	t.callsLock.RLock()
+	defer t.callsLock.RUnlock()
+
+	if t.recordFrom < 0 {
+		return nil, errors.New("API calls are not being recorded")
+	}
+
+	root, err := url.Parse(t.api.GetHostURL())
+	if err != nil {
+		return nil, err
+	}
+
+	// Calls made during the step that checks the recording are not part of it.
+	calls := xslices.Join(t.calls[t.recordFrom : len(t.calls)-1]...)
+
+	recorded := make([]recordedCall, 0, len(calls))
+
+	for _, call := range calls {
+		path := strings.TrimPrefix(call.URL.Path, root.Path)
+
+		if xslices.Any(ignoredCallPaths, func(re *regexp.Regexp) bool { return re.MatchString(path) }) {
+			continue
+		}
+
+		recorded = append(recorded, newRecordedCall(call.Method, path, call.RequestBody))
+	}
+
+	// Bridge makes some calls concurrently, so their order is not meaningful.
+	slices.SortStableFunc(recorded, func(a, b recordedCall) bool {
+		return a.key() < b.key()
+	})
+
+	return recorded, nil
+}
+
+func newRecordedCall(method, path string, body []byte) recordedCall {
+	segments := strings.Split(path, "/")
+
+	for idx, segment := range segments {
+		if idSegment.MatchString(segment) {
+			segments[idx] = ":id"
+		}
+	}
+
+	call := recordedCall{
+		Method: method,
+		Path:   strings.Join(segments, "/"),
+	}
+
+	if len(body) > 0 {
+		var value any
+
+		if err := json.Unmarshal(body, &value); err != nil {
+			call.Body = "<binary>"
+		} else {
+			call.Body = bodyShape(value)
+		}
+	}
+
+	return call
+}
+
+func (call recordedCall) key() string {
+	b, err := json.Marshal(call)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(b)
+}
+
+// bodyShape replaces the values of a JSON document by the names of their types.
+// Arrays are reduced to the distinct shapes of their elements.
+func bodyShape(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		shape := make(map[string]any, len(value))
+
+		for key, val := range value {
+			shape[key] = bodyShape(val)
+		}
+
+		return shape
+
+	case []any:
+		shape := make([]any, 0, len(value))
+		seen := make(map[string]struct{})
+
+		for _, val := range value {
+			elem := bodyShape(val)
+
+			b, err := json.Marshal(elem)
+			if err != nil {
+				panic(err)
+			}
+
+			if _, ok := seen[string(b)]; !ok {
+				seen[string(b)] = struct{}{}
+				shape = append(shape, elem)
+			}
+		}
+
+		return shape
+
+	case string:
+		return "string"
+
+	case float64:
+		return "number"
+
+	case bool:
+		return "bool"
+
+	default:
+		return "null"
+	}
+}
+
+// matchGoldenCalls compares the recorded calls with those of the golden file of the given name.
+// With the -update flag, the golden file is written instead.
+func matchGoldenCalls(name string, have []recordedCall) error {
+	path := filepath.Join(goldenDir, name+".json")
+
+	b, err := json.MarshalIndent(have, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return err
+		}
+
+		return os.WriteFile(path, append(b, '\n'), 0o600)
+	}
+
+	golden, err := os.ReadFile(path) //nolint:gosec
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("missing golden file %q, run the tests with -update to write it", path)
+	} else if err != nil {
+		return err
+	}
+
+	var want []recordedCall
+
+	if err := json.Unmarshal(golden, &want); err != nil {
+		return fmt.Errorf("invalid golden file %q: %w", path, err)
+	}
+
+	if diff := diffRecordedCalls(want, have); len(diff) > 0 {
+		return fmt.Errorf("API calls differ from golden file %q:\n%s", path, strings.Join(diff, "\n"))
+	}
+
+	return nil
+}
+
+// diffRecordedCalls returns the calls missing from have ("-") and the unexpected calls of have ("+").
+func diffRecordedCalls(want, have []recordedCall) []string {
+	haveKeys := xslices.Map(have, recordedCall.key)
+
+	var diff []string
+
+	for _, call := range want {
+		if idx := slices.Index(haveKeys, call.key()); idx >= 0 {
+			haveKeys = slices.Delete(haveKeys, idx, idx+1)
+		} else {
+			diff = append(diff, "- "+call.key())
+		}
+	}
+
+	for _, key := range haveKeys {
+		diff = append(diff, "+ "+key)
+	}
+
+	return diff
+}
+
+func (s *scenario) apiCallsAreRecorded() error {
+	s.t.startRecordingCalls()
+
+	return nil
+}
+
+func (s *scenario) theRecordedAPICallsMatchTheGoldenFile(name string) error {
+	recorded, err := s.t.getRecordedCalls()
+	if err != nil {
+		return err
+	}
+
+	return matchGoldenCalls(name, recorded)
+}
+
+func (s *scenario) theRecordedAPICallsAre(value *godog.DocString) error {
+	recorded, err := s.t.getRecordedCalls()
+	if err != nil {
+		return err
+	}
+
+	var want []recordedCall
+
+	if err := json.Unmarshal([]byte(value.Content), &want); err != nil {
+		return err
+	}
+
+	if diff := diffRecordedCalls(want, recorded); len(diff) > 0 {
+		return fmt.Errorf("API calls differ from the expected calls:\n%s", strings.Join(diff, "\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// errDroppedRead is returned by reads dropped by the network fault injector.
+var errDroppedRead = errors.New("connection reset by fault injector")
+
+// netFaults holds the network faults injected into the connection between bridge and the API.
+type netFaults struct {
+	dropEvery int
+	latency   time.Duration
+	bandwidth int
+
+	reads int
+	lock  sync.Mutex
+}
+
+func (f *netFaults) setDropEvery(n int) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.dropEvery = n
+	f.reads = 0
+}
+
+func (f *netFaults) setLatency(latency time.Duration) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.latency = latency
+}
+
+func (f *netFaults) setBandwidth(bytesPerSec int) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.bandwidth = bytesPerSec
+}
+
+func (f *netFaults) reset() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.dropEvery = 0
+	f.latency = 0
+	f.bandwidth = 0
+	f.reads = 0
+}
+
+func (f *netFaults) getLatency() time.Duration {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.latency
+}
+
+// onRead returns whether the read should be dropped and how long it should be delayed to respect the bandwidth.
+func (f *netFaults) onRead(n int) (bool, time.Duration) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.reads++
+
+	if f.dropEvery > 0 && f.reads%f.dropEvery == 0 {
+		return true, 0
+	}
+
+	if f.bandwidth > 0 {
+		return false, time.Duration(n) * time.Second / time.Duration(f.bandwidth)
+	}
+
+	return false, 0
+}
+
+// faultyRoundTripper injects the configured network faults and API chaos into the requests made by bridge.
+type faultyRoundTripper struct {
+	rt     http.RoundTripper
+	faults *netFaults
+	chaos  *apiChaos
+}
+
+func (rt *faultyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if latency := rt.faults.getLatency(); latency > 0 {
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+
+		case <-time.After(latency):
+		}
+	}
+
+	res, err := rt.chaos.roundTrip(rt.rt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Body = &faultyReader{ReadCloser: res.Body, faults: rt.faults}
+
+	return res, nil
+}
+
+// faultyReader drops and throttles reads of a response body.
+type faultyReader struct {
+	io.ReadCloser
+	faults *netFaults
+}
+
+func (r *faultyReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+
+	drop, delay := r.faults.onRead(n)
+	if drop {
+		return 0, errDroppedRead
+	}
+
+	time.Sleep(delay)
+
+	return n, err
+}
@@ -19,6 +19,7 @@ package tests
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/smtp"
 	"os"
@@ -142,6 +143,19 @@ func (s *scenario) smtpClientSendsTheFollowingMessageFromTo(clientID, from, to s
 	return nil
 }
 
+// smtpClientSendsTheFollowingMessageFromToUntilItSucceeds retries a failed send like a mail client would.
+func (s *scenario) smtpClientSendsTheFollowingMessageFromToUntilItSucceeds(clientID, from, to string, message *godog.DocString) error {
+	_, client := s.t.getSMTPClient(clientID)
+
+	return eventually(func() error {
+		if err := clientSend(client, from, to, message.Content); err != nil {
+			return errors.Join(err, client.Reset())
+		}
+
+		return nil
+	})
+}
+
 func (s *scenario) smtpClientSendsTheFollowingEmlFromTo(clientID, file, from, to string) error {
 	_, client := s.t.getSMTPClient(clientID)
 
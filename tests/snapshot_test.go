@@ -0,0 +1,259 @@
+// Copyright (c) 2024 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/cucumber/godog"
+	"github.com/emersion/go-imap"
+	"golang.org/x/exp/slices"
+)
+
+// mailboxSnapshot is the state of an IMAP mailbox captured at some point of a scenario.
+type mailboxSnapshot struct {
+	mailbox  string
+	messages []snapshotMessage
+}
+
+// snapshotMessage is the state of a single message of a mailbox snapshot.
+type snapshotMessage struct {
+	UID       uint32
+	Subject   string
+	Flags     []string
+	Structure string
+}
+
+func (msg snapshotMessage) String() string {
+	return fmt.Sprintf("%d %q [%s] %s", msg.UID, msg.Subject, strings.Join(msg.Flags, " "), msg.Structure)
+}
+
+func (snap mailboxSnapshot) String() string {
+	lines := make([]string, 0, len(snap.messages))
+
+	for _, msg := range snap.messages {
+		lines = append(lines, msg.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// SnapshotChange is an expected difference between a mailbox snapshot and the current state of the mailbox.
+type SnapshotChange struct {
+	Subject string `bdd:"subject"`
+	Change  string `bdd:"change"`
+	Flag    string `bdd:"flag"`
+}
+
+// takeMailboxSnapshot captures the state of the given mailbox as seen by the given IMAP client.
+func (t *testCtx) takeMailboxSnapshot(clientID, mailbox string) (mailboxSnapshot, error) {
+	_, client := t.getIMAPClient(clientID)
+
+	fetch, err := clientFetch(client, mailbox)
+	if err != nil {
+		return mailboxSnapshot{}, err
+	}
+
+	snap := mailboxSnapshot{mailbox: mailbox}
+
+	for _, msg := range fetch {
+		// The recent flag depends on the session rather than on the state of the mailbox.
+		flags := xslices.Filter(msg.Flags, func(flag string) bool { return flag != imap.RecentFlag })
+		sort.Strings(flags)
+
+		snap.messages = append(snap.messages, snapshotMessage{
+			UID:       msg.Uid,
+			Subject:   msg.Envelope.Subject,
+			Flags:     flags,
+			Structure: formatSectionStructure(newMessageStructFromIMAP(msg).Content),
+		})
+	}
+
+	slices.SortFunc(snap.messages, func(a, b snapshotMessage) bool {
+		return a.UID < b.UID
+	})
+
+	return snap, nil
+}
+
+func (t *testCtx) setMailboxSnapshot(name string, snap mailboxSnapshot) {
+	t.snapshots[name] = snap
+}
+
+func (t *testCtx) getMailboxSnapshot(name string) (mailboxSnapshot, error) {
+	snap, ok := t.snapshots[name]
+	if !ok {
+		return mailboxSnapshot{}, fmt.Errorf("no snapshot named %q", name)
+	}
+
+	return snap, nil
+}
+
+// formatSectionStructure renders the MIME structure of a message section, e.g. "multipart/mixed(text/plain,image/png)".
+func formatSectionStructure(section MessageSection) string {
+	if len(section.Sections) == 0 {
+		return section.ContentType
+	}
+
+	children := make([]string, 0, len(section.Sections))
+
+	for _, child := range section.Sections {
+		children = append(children, formatSectionStructure(child))
+	}
+
+	return section.ContentType + "(" + strings.Join(children, ",") + ")"
+}
+
+// applySnapshotChanges returns the snapshot expected after applying the given changes to it.
+// Added messages match any UID and structure; their flags are given by "flag" changes.
+func applySnapshotChanges(snap mailboxSnapshot, changes []SnapshotChange) (mailboxSnapshot, error) {
+	want := mailboxSnapshot{mailbox: snap.mailbox, messages: slices.Clone(snap.messages)}
+
+	for _, change := range changes {
+		idx := slices.IndexFunc(want.messages, func(msg snapshotMessage) bool { return msg.Subject == change.Subject })
+
+		switch change.Change {
+		case "added":
+			want.messages = append(want.messages, snapshotMessage{Subject: change.Subject, Flags: []string{}})
+
+		case "removed":
+			if idx < 0 {
+				return mailboxSnapshot{}, fmt.Errorf("no message with subject %q in snapshot", change.Subject)
+			}
+
+			want.messages = slices.Delete(want.messages, idx, idx+1)
+
+		case "flagged", "unflagged":
+			if idx < 0 {
+				return mailboxSnapshot{}, fmt.Errorf("no message with subject %q in snapshot", change.Subject)
+			}
+
+			flags := slices.Clone(want.messages[idx].Flags)
+
+			if change.Change == "flagged" {
+				if !slices.Contains(flags, change.Flag) {
+					flags = append(flags, change.Flag)
+				}
+			} else if i := slices.Index(flags, change.Flag); i >= 0 {
+				flags = slices.Delete(flags, i, i+1)
+			}
+
+			sort.Strings(flags)
+
+			want.messages[idx].Flags = flags
+
+		default:
+			return mailboxSnapshot{}, fmt.Errorf("unknown snapshot change %q", change.Change)
+		}
+	}
+
+	return want, nil
+}
+
+// diffMailboxSnapshots returns a description of each difference between the wanted and the actual snapshot.
+// Messages are matched by UID; messages expected without a UID are matched by subject.
+func diffMailboxSnapshots(want, have mailboxSnapshot) []string {
+	var diff []string
+
+	remaining := slices.Clone(have.messages)
+
+	for _, wantMsg := range want.messages {
+		idx := slices.IndexFunc(remaining, func(msg snapshotMessage) bool {
+			if wantMsg.UID != 0 {
+				return msg.UID == wantMsg.UID
+			}
+
+			return msg.Subject == wantMsg.Subject
+		})
+
+		if idx < 0 {
+			diff = append(diff, "- "+wantMsg.String())
+			continue
+		}
+
+		haveMsg := remaining[idx]
+		remaining = slices.Delete(remaining, idx, idx+1)
+
+		if wantMsg.UID == 0 {
+			// The message was added after the snapshot; only its subject and flags are known.
+			haveMsg.UID, haveMsg.Structure = 0, ""
+		}
+
+		if haveMsg.String() != wantMsg.String() {
+			diff = append(diff, "- "+wantMsg.String(), "+ "+haveMsg.String())
+		}
+	}
+
+	for _, haveMsg := range remaining {
+		diff = append(diff, "+ "+haveMsg.String())
+	}
+
+	return diff
+}
+
+func (s *scenario) imapClientTakesASnapshotOf(clientID, name, mailbox string) error {
+	snap, err := s.t.takeMailboxSnapshot(clientID, mailbox)
+	if err != nil {
+		return err
+	}
+
+	s.t.setMailboxSnapshot(name, snap)
+
+	return nil
+}
+
+func (s *scenario) imapClientEventuallySeesSnapshotUnchanged(clientID, name string) error {
+	return s.imapClientEventuallySeesSnapshotWithChanges(clientID, name, nil)
+}
+
+func (s *scenario) imapClientEventuallySeesSnapshotWithTheFollowingChanges(clientID, name string, table *godog.Table) error {
+	changes, err := unmarshalTable[SnapshotChange](table)
+	if err != nil {
+		return err
+	}
+
+	return s.imapClientEventuallySeesSnapshotWithChanges(clientID, name, changes)
+}
+
+func (s *scenario) imapClientEventuallySeesSnapshotWithChanges(clientID, name string, changes []SnapshotChange) error {
+	snap, err := s.t.getMailboxSnapshot(name)
+	if err != nil {
+		return err
+	}
+
+	want, err := applySnapshotChanges(snap, changes)
+	if err != nil {
+		return err
+	}
+
+	return eventually(func() error {
+		have, err := s.t.takeMailboxSnapshot(clientID, snap.mailbox)
+		if err != nil {
+			return err
+		}
+
+		if diff := diffMailboxSnapshots(want, have); len(diff) > 0 {
+			return fmt.Errorf("mailbox %q differs from snapshot %q:\n%s", snap.mailbox, name, strings.Join(diff, "\n"))
+		}
+
+		return nil
+	})
+}
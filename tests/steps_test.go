@@ -26,6 +26,12 @@ func (s *scenario) steps(ctx *godog.ScenarioContext) {
 	ctx.Step(`^it fails with error "([^"]*)"$`, s.itFailsWithError)
 	ctx.Step(`^the internet is turned off$`, s.internetIsTurnedOff)
 	ctx.Step(`^the internet is turned on$`, s.internetIsTurnedOn)
+	ctx.Step(`^the network drops every (\d+)(?:st|nd|rd|th) read$`, s.theNetworkDropsEveryNthRead)
+	ctx.Step(`^the network latency is (\d+)ms$`, s.theNetworkLatencyIs)
+	ctx.Step(`^the network bandwidth is limited to (\d+) KB/s$`, s.theNetworkBandwidthIsLimitedTo)
+	ctx.Step(`^the network is restored$`, s.theNetworkIsRestored)
+	ctx.Step(`^the API is flaky with (\d+)% of requests failing$`, s.theAPIIsFlakyWithOfRequestsFailing)
+	ctx.Step(`^the API is no longer flaky$`, s.theAPIIsNoLongerFlaky)
 	ctx.Step(`^the user agent is "([^"]*)"$`, s.theUserAgentIs)
 	ctx.Step(`^the header in the "([^"]*)" request to "([^"]*)" has "([^"]*)" set to "([^"]*)"$`, s.theHeaderInTheRequestToHasSetTo)
 	ctx.Step(`^the header in the "([^"]*)" multipart request to "([^"]*)" has "([^"]*)" set to "([^"]*)"$`, s.theHeaderInTheMultipartRequestToHasSetTo)
@@ -33,6 +39,9 @@ func (s *scenario) steps(ctx *godog.ScenarioContext) {
 	ctx.Step(`^the header in the "([^"]*)" multipart request to "([^"]*)" has no file "([^"]*)"$`, s.theHeaderInTheMultipartRequestToHasNoFile)
 	ctx.Step(`^the body in the "([^"]*)" request to "([^"]*)" is:$`, s.theBodyInTheRequestToIs)
 	ctx.Step(`^the body in the "([^"]*)" response to "([^"]*)" is:$`, s.theBodyInTheResponseToIs)
+	ctx.Step(`^API calls are recorded$`, s.apiCallsAreRecorded)
+	ctx.Step(`^the recorded API calls match the golden file "([^"]*)"$`, s.theRecordedAPICallsMatchTheGoldenFile)
+	ctx.Step(`^the recorded API calls are:$`, s.theRecordedAPICallsAre)
 	ctx.Step(`^the API requires bridge version at least "([^"]*)"$`, s.theAPIRequiresBridgeVersion)
 	ctx.Step(`^the network port (\d+) is busy$`, s.networkPortIsBusy)
 	ctx.Step(`^the network port range (\d+)-(\d+) is busy$`, s.networkPortRangeIsBusy)
@@ -56,6 +65,9 @@ func (s *scenario) steps(ctx *godog.ScenarioContext) {
 	ctx.Step(`^the address "([^"]*)" of account "([^"]*)" has (\d+) messages in "([^"]*)"$`, s.theAddressOfAccountHasMessagesInMailbox)
 	ctx.Step(`^the following fields were changed in draft (\d+) for address "([^"]*)" of account "([^"]*)":$`, s.theFollowingFieldsWereChangedInDraftForAddressOfAccount)
 	ctx.Step(`^draft (\d+) for address "([^"]*)" of account "([^"]*)" was moved to trash$`, s.drafAtIndexWasMovedToTrashForAddressOfAccount)
+	ctx.Step(`^the account "([^"]*)" eventually has (\d+) messages in "([^"]*)"$`, s.theAccountEventuallyHasMessagesInMailbox)
+	ctx.Step(`^the account "([^"]*)" has no duplicate messages in "([^"]*)"$`, s.theAccountHasNoDuplicateMessagesInMailbox)
+	ctx.Step(`^the account "([^"]*)" eventually has exactly one message with subject "([^"]*)" in "([^"]*)"$`, s.theAccountEventuallyHasExactlyOneMessageWithSubjectInMailbox)
 
 	// === REPORTER ===
 	ctx.Step(`^test skips reporter checks$`, s.skipReporterChecks)
@@ -83,6 +95,8 @@ func (s *scenario) steps(ctx *godog.ScenarioContext) {
 	ctx.Step(`^the description "([^"]*)" provides the following KB suggestions:$`, s.theDescriptionInputProvidesKnowledgeBaseArticles)
 	ctx.Step(`^the user hides All Mail$`, s.theUserHidesAllMail)
 	ctx.Step(`^the user shows All Mail$`, s.theUserShowsAllMail)
+	ctx.Step(`^the user hides All Mail for user "([^"]*)"$`, s.theUserHidesAllMailForUser)
+	ctx.Step(`^the user shows All Mail for user "([^"]*)"$`, s.theUserShowsAllMailForUser)
 	ctx.Step(`^the user disables telemetry in bridge settings$`, s.theUserDisablesTelemetryInBridgeSettings)
 	ctx.Step(`^the user enables telemetry in bridge settings$`, s.theUserEnablesTelemetryInBridgeSettings)
 	ctx.Step(`^bridge sends a connection up event$`, s.bridgeSendsAConnectionUpEvent)
@@ -161,6 +175,9 @@ func (s *scenario) steps(ctx *godog.ScenarioContext) {
 	ctx.Step(`^IMAP client "([^"]*)" eventually sees the following messages in "([^"]*)":$`, s.imapClientEventuallySeesTheFollowingMessagesInMailbox)
 	ctx.Step(`^IMAP client "([^"]*)" eventually sees the following message in "([^"]*)" with this structure:$`, s.imapClientSeesMessageInMailboxWithStructure)
 	ctx.Step(`^IMAP client "([^"]*)" eventually sees (\d+) messages in "([^"]*)"$`, s.imapClientEventuallySeesMessagesInMailbox)
+	ctx.Step(`^IMAP client "([^"]*)" takes a snapshot "([^"]*)" of "([^"]*)"$`, s.imapClientTakesASnapshotOf)
+	ctx.Step(`^IMAP client "([^"]*)" eventually sees snapshot "([^"]*)" unchanged$`, s.imapClientEventuallySeesSnapshotUnchanged)
+	ctx.Step(`^IMAP client "([^"]*)" eventually sees snapshot "([^"]*)" with the following changes:$`, s.imapClientEventuallySeesSnapshotWithTheFollowingChanges)
 	ctx.Step(`^IMAP client "([^"]*)" marks message (\d+) as deleted$`, s.imapClientMarksMessageAsDeleted)
 	ctx.Step(`^IMAP client "([^"]*)" marks the message with subject "([^"]*)" as deleted$`, s.imapClientMarksTheMessageWithSubjectAsDeleted)
 	ctx.Step(`^IMAP client "([^"]*)" marks message (\d+) as not deleted$`, s.imapClientMarksMessageAsNotDeleted)
@@ -196,6 +213,7 @@ func (s *scenario) steps(ctx *godog.ScenarioContext) {
 	ctx.Step(`^SMTP client "([^"]*)" sends DATA:$`, s.smtpClientSendsData)
 	ctx.Step(`^SMTP client "([^"]*)" sends RSET$`, s.smtpClientSendsReset)
 	ctx.Step(`^SMTP client "([^"]*)" sends the following message from "([^"]*)" to "([^"]*)":$`, s.smtpClientSendsTheFollowingMessageFromTo)
+	ctx.Step(`^SMTP client "([^"]*)" sends the following message from "([^"]*)" to "([^"]*)" until it succeeds:$`, s.smtpClientSendsTheFollowingMessageFromToUntilItSucceeds)
 	ctx.Step(`^SMTP client "([^"]*)" sends the following EML "([^"]*)" from "([^"]*)" to "([^"]*)"$`, s.smtpClientSendsTheFollowingEmlFromTo)
 	ctx.Step(`^SMTP client "([^"]*)" logs out$`, s.smtpClientLogsOut)
 
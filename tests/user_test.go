@@ -236,6 +236,65 @@ func (s *scenario) theAddressOfAccountHasMessagesInMailbox(address, username str
 	})))
 }
 
+func (s *scenario) theAccountEventuallyHasMessagesInMailbox(username string, count int, mailbox string) error {
+	return eventually(func() error {
+		messages, err := s.t.getMessageMetadata(username, mailbox)
+		if err != nil {
+			return err
+		}
+
+		if len(messages) != count {
+			return fmt.Errorf("expected %v messages in %q, got %v", count, mailbox, len(messages))
+		}
+
+		return nil
+	})
+}
+
+func (s *scenario) theAccountHasNoDuplicateMessagesInMailbox(username, mailbox string) error {
+	messages, err := s.t.getMessageMetadata(username, mailbox)
+	if err != nil {
+		return err
+	}
+
+	subjects := make(map[string]int)
+
+	for _, message := range messages {
+		subjects[message.Subject]++
+	}
+
+	for subject, count := range subjects {
+		if count > 1 {
+			return fmt.Errorf("message with subject %q is in %q %v times", subject, mailbox, count)
+		}
+	}
+
+	return nil
+}
+
+func (s *scenario) theAccountEventuallyHasExactlyOneMessageWithSubjectInMailbox(username, subject, mailbox string) error {
+	return eventually(func() error {
+		messages, err := s.t.getMessageMetadata(username, mailbox)
+		if err != nil {
+			return err
+		}
+
+		var count int
+
+		for _, message := range messages {
+			if message.Subject == subject {
+				count++
+			}
+		}
+
+		if count != 1 {
+			return fmt.Errorf("expected one message with subject %q in %q, got %v", subject, mailbox, count)
+		}
+
+		return nil
+	})
+}
+
 func flagsForMailbox(mailboxName string) proton.MessageFlag {
 	if strings.EqualFold(mailboxName, "Sent") {
 		return proton.MessageFlagSent